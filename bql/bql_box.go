@@ -1,11 +1,14 @@
 package bql
 
 import (
+	"fmt"
 	"github.com/Sirupsen/logrus"
 	"gopkg.in/sensorbee/sensorbee.v0/bql/execution"
 	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
 	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
 	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"io/ioutil"
 	"math/rand"
 	"sync"
 	"time"
@@ -50,6 +53,48 @@ type bqlBox struct {
 	// removeMe is a function to remove this bqlBox from its
 	// topology. A nil check must be done before calling.
 	removeMe func()
+	// lateWriter receives tuples that arrived too late to be processed
+	// by the execution plan. A nil check must be done before calling.
+	lateWriter core.Writer
+	// removeLateStream is a function to remove the stream specified by
+	// a LATE TUPLES TO clause from the topology. A nil check must be
+	// done before calling.
+	removeLateStream func()
+	// checkpoint holds the configuration of the periodic checkpoints
+	// of the state of this box. It is nil if no checkpoint is saved.
+	checkpoint *boxCheckpoint
+}
+
+// boxCheckpoint specifies where and how often the state of a bqlBox,
+// i.e., the input tuples held by its execution plan and the counters
+// of its emitter, is saved.
+type boxCheckpoint struct {
+	storage  udf.UDSStorage
+	topology string
+	name     string
+	interval time.Duration
+	// disabled is true when no further checkpoint must be saved. It is
+	// protected by bqlBox.mutex. A box replacing another box starts
+	// with disabled being true so that it neither restores the
+	// checkpoint nor overwrites the one of the box it's replacing
+	// until the replacement is completed.
+	disabled bool
+	stopCh   chan struct{}
+}
+
+// checkpointTag is the tag under which checkpoints of bqlBoxes are
+// saved in the UDSStorage. The name of the state is the name of the
+// stream.
+const checkpointTag = "sensorbee_checkpoint"
+
+func newBoxCheckpoint(storage udf.UDSStorage, topology, name string, interval time.Duration) *boxCheckpoint {
+	return &boxCheckpoint{
+		storage:  storage,
+		topology: topology,
+		name:     name,
+		interval: interval,
+		stopCh:   make(chan struct{}),
+	}
 }
 
 func NewBQLBox(stmt *parser.SelectStmt, reg udf.FunctionRegistry) *bqlBox {
@@ -73,6 +118,24 @@ func (b *bqlBox) Init(ctx *core.Context) error {
 	if err != nil {
 		return err
 	}
+	if b.checkpoint != nil {
+		// a box replacing another box doesn't restore the checkpoint
+		if !b.checkpoint.disabled {
+			if err := b.restoreCheckpoint(); err != nil {
+				// a broken checkpoint must not prevent the stream from
+				// being created, so it starts with an empty state. The
+				// plan is created again because it might have processed
+				// some of the tuples in the checkpoint.
+				ctx.ErrLog(err).WithField("node_name", b.checkpoint.name).
+					Error("Cannot restore the checkpoint of the stream")
+				b.execPlan, err = optimizedPlan.MakePhysicalPlan(b.reg)
+				if err != nil {
+					return err
+				}
+			}
+		}
+		go b.checkpointer(ctx)
+	}
 	if b.emitterSamplingType == parser.TimeBasedSampling {
 		go b.timeEmitter(ctx)
 	}
@@ -99,6 +162,15 @@ func (b *bqlBox) Process(ctx *core.Context, t *core.Tuple, s core.Writer) error
 		return err
 	}
 
+	// route tuples that were too late for their window to the side stream
+	if r, ok := b.execPlan.(execution.LateTupleReporter); ok && b.lateWriter != nil {
+		for _, late := range r.LateTuples() {
+			if err := b.lateWriter.Write(ctx, late.ShallowCopy()); err != nil {
+				return err
+			}
+		}
+	}
+
 	// emit result data as tuples
 	for _, data := range resultData {
 		tup := t.ShallowCopy()
@@ -225,6 +297,20 @@ func (b *bqlBox) Terminate(ctx *core.Context) error {
 	b.timeEmitterMutex.Lock()
 	b.stopped = true
 	b.timeEmitterMutex.Unlock()
+	if b.removeLateStream != nil {
+		b.removeLateStream()
+	}
+	if b.checkpoint != nil {
+		close(b.checkpoint.stopCh)
+		// save the state of the box when the topology is stopped
+		b.mutex.Lock()
+		defer b.mutex.Unlock()
+		if err := b.saveCheckpoint(); err != nil {
+			ctx.ErrLog(err).WithField("node_name", b.checkpoint.name).
+				Error("Cannot save the checkpoint of the stream")
+		}
+		b.checkpoint.disabled = true
+	}
 	return nil
 }
 
@@ -234,3 +320,309 @@ func (b *bqlBox) callRemoveMeIgnoringPanic() {
 	}()
 	b.removeMe()
 }
+
+// checkpointer periodically saves the state of the box until the box
+// is terminated.
+func (b *bqlBox) checkpointer(ctx *core.Context) {
+	ticker := time.NewTicker(b.checkpoint.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-b.checkpoint.stopCh:
+			return
+		case <-ticker.C:
+		}
+
+		b.mutex.Lock()
+		err := b.saveCheckpoint()
+		b.mutex.Unlock()
+		if err != nil {
+			ctx.ErrLog(err).WithField("node_name", b.checkpoint.name).
+				Error("Cannot save the checkpoint of the stream")
+		}
+	}
+}
+
+// saveCheckpoint saves the input tuples held by the execution plan and
+// the counters of the emitter to the UDSStorage. When the box has hit
+// its emitter limit, the checkpoint is cleared instead because the
+// stream doesn't have any state to restore. The caller must hold
+// b.mutex.
+func (b *bqlBox) saveCheckpoint() error {
+	cp := b.checkpoint
+	if cp.disabled {
+		return nil
+	}
+
+	b.timeEmitterMutex.Lock()
+	emitCount, genCount := b.emitCount, b.genCount
+	finished := b.emitterLimit >= 0 && b.emitCount >= b.emitterLimit
+	b.timeEmitterMutex.Unlock()
+	if finished {
+		return b.clearCheckpoint()
+	}
+
+	var tuples []*core.Tuple
+	if c, ok := b.execPlan.(execution.Checkpointer); ok {
+		tuples = c.Checkpoint()
+	}
+	ts := make(data.Array, len(tuples))
+	for i, t := range tuples {
+		ts[i] = data.Map{
+			"data":           encodeCheckpointValue(t.Data),
+			"input_name":     data.String(t.InputName),
+			"timestamp":      data.Int(t.Timestamp.UnixNano()),
+			"proc_timestamp": data.Int(t.ProcTimestamp.UnixNano()),
+		}
+	}
+	buf, err := data.MarshalMsgpack(data.Map{
+		"stmt":       data.String(b.stmt.String()),
+		"emit_count": data.Int(emitCount),
+		"gen_count":  data.Int(genCount),
+		"tuples":     ts,
+	})
+	if err != nil {
+		return err
+	}
+
+	w, err := cp.storage.Save(cp.topology, cp.name, checkpointTag)
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(buf); err != nil {
+		w.Abort()
+		return err
+	}
+	return w.Commit()
+}
+
+// encodeCheckpointValue converts v into a value which keeps its type when
+// it's serialized with msgpack, which stores Timestamp and Blob values as
+// Int and String values. Each value is converted into an array having its
+// TypeID and its payload. Timestamp and Blob values are stored as strings
+// having their binary representations.
+func encodeCheckpointValue(v data.Value) data.Value {
+	var payload data.Value
+	switch v.Type() {
+	case data.TypeBlob:
+		b, _ := data.AsBlob(v)
+		payload = data.String(b)
+	case data.TypeTimestamp:
+		t, _ := data.AsTimestamp(v)
+		b, _ := t.MarshalBinary()
+		payload = data.String(b)
+	case data.TypeArray:
+		a, _ := data.AsArray(v)
+		ea := make(data.Array, len(a))
+		for i, e := range a {
+			ea[i] = encodeCheckpointValue(e)
+		}
+		payload = ea
+	case data.TypeMap:
+		m, _ := data.AsMap(v)
+		em := make(data.Map, len(m))
+		for k, e := range m {
+			em[k] = encodeCheckpointValue(e)
+		}
+		payload = em
+	default:
+		payload = v
+	}
+	return data.Array{data.Int(v.Type()), payload}
+}
+
+// decodeCheckpointValue restores a value converted by
+// encodeCheckpointValue.
+func decodeCheckpointValue(v data.Value) (data.Value, error) {
+	a, err := data.AsArray(v)
+	if err != nil {
+		return nil, err
+	}
+	if len(a) != 2 {
+		return nil, fmt.Errorf("an encoded value must have 2 elements: %v", len(a))
+	}
+	typ, err := data.AsInt(a[0])
+	if err != nil {
+		return nil, err
+	}
+	payload := a[1]
+
+	switch data.TypeID(typ) {
+	case data.TypeNull:
+		return data.Null{}, nil
+	case data.TypeBool:
+		b, err := data.AsBool(payload)
+		return data.Bool(b), err
+	case data.TypeInt:
+		i, err := data.AsInt(payload)
+		return data.Int(i), err
+	case data.TypeFloat:
+		f, err := data.ToFloat(payload)
+		return data.Float(f), err
+	case data.TypeString:
+		s, err := data.AsString(payload)
+		return data.String(s), err
+	case data.TypeBlob:
+		s, err := data.AsString(payload)
+		return data.Blob(s), err
+	case data.TypeTimestamp:
+		s, err := data.AsString(payload)
+		if err != nil {
+			return nil, err
+		}
+		var t time.Time
+		if err := t.UnmarshalBinary([]byte(s)); err != nil {
+			return nil, err
+		}
+		return data.Timestamp(t), nil
+	case data.TypeArray:
+		ea, err := data.AsArray(payload)
+		if err != nil {
+			return nil, err
+		}
+		a := make(data.Array, len(ea))
+		for i, e := range ea {
+			if a[i], err = decodeCheckpointValue(e); err != nil {
+				return nil, err
+			}
+		}
+		return a, nil
+	case data.TypeMap:
+		em, err := data.AsMap(payload)
+		if err != nil {
+			return nil, err
+		}
+		m := make(data.Map, len(em))
+		for k, e := range em {
+			if m[k], err = decodeCheckpointValue(e); err != nil {
+				return nil, err
+			}
+		}
+		return m, nil
+	default:
+		return nil, fmt.Errorf("unknown type of an encoded value: %v", typ)
+	}
+}
+
+// clearCheckpoint removes the checkpoint of the box so that a stream
+// created later with the same name doesn't restore it. The caller must
+// hold b.mutex.
+func (b *bqlBox) clearCheckpoint() error {
+	cp := b.checkpoint
+	if err := cp.storage.Remove(cp.topology, cp.name, checkpointTag); err != nil && !core.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// setCheckpointEnabled enables or disables saving checkpoints of the box.
+// It's used to make only the current box of a stream save its checkpoint
+// while the box is being replaced.
+func (b *bqlBox) setCheckpointEnabled(enabled bool) {
+	if b.checkpoint == nil {
+		return
+	}
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.checkpoint.disabled = !enabled
+}
+
+// dropCheckpoint clears the checkpoint of the box and stops saving new
+// ones. It's called when the stream is dropped.
+func (b *bqlBox) dropCheckpoint() error {
+	if b.checkpoint == nil {
+		return nil
+	}
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.checkpoint.disabled = true
+	return b.clearCheckpoint()
+}
+
+// restoreCheckpoint loads the checkpoint of the box from the UDSStorage
+// and passes the saved tuples to the execution plan to restore its
+// state. The results are discarded because they have already been
+// emitted before the checkpoint was saved. A checkpoint saved for a
+// different statement is ignored. When an error is returned, the
+// execution plan might have processed some of the tuples and the
+// caller has to discard it.
+func (b *bqlBox) restoreCheckpoint() error {
+	cp := b.checkpoint
+	r, err := cp.storage.Load(cp.topology, cp.name, checkpointTag)
+	if err != nil {
+		if core.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	defer r.Close()
+	buf, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	m, err := data.UnmarshalMsgpack(buf)
+	if err != nil {
+		return err
+	}
+	if stmt, _ := data.AsString(m["stmt"]); stmt != b.stmt.String() {
+		return nil
+	}
+
+	emitCount, err := data.AsInt(m["emit_count"])
+	if err != nil {
+		return err
+	}
+	genCount, err := data.AsInt(m["gen_count"])
+	if err != nil {
+		return err
+	}
+	tuples, err := data.AsArray(m["tuples"])
+	if err != nil {
+		return err
+	}
+	// all tuples are decoded before any of them is processed so that a
+	// broken checkpoint doesn't leave a partial state in the plan
+	ts := make([]*core.Tuple, len(tuples))
+	for i, v := range tuples {
+		tm, err := data.AsMap(v)
+		if err != nil {
+			return err
+		}
+		dv, err := decodeCheckpointValue(tm["data"])
+		if err != nil {
+			return err
+		}
+		d, err := data.AsMap(dv)
+		if err != nil {
+			return err
+		}
+		inputName, err := data.AsString(tm["input_name"])
+		if err != nil {
+			return err
+		}
+		timestamp, err := data.AsInt(tm["timestamp"])
+		if err != nil {
+			return err
+		}
+		procTs, err := data.AsInt(tm["proc_timestamp"])
+		if err != nil {
+			return err
+		}
+		ts[i] = &core.Tuple{
+			Data:          d,
+			InputName:     inputName,
+			Timestamp:     time.Unix(0, timestamp).In(time.UTC),
+			ProcTimestamp: time.Unix(0, procTs).In(time.UTC),
+		}
+	}
+
+	for _, t := range ts {
+		if _, err := b.execPlan.Process(t); err != nil {
+			return err
+		}
+	}
+	b.timeEmitterMutex.Lock()
+	b.emitCount, b.genCount = emitCount, genCount
+	b.timeEmitterMutex.Unlock()
+	return nil
+}
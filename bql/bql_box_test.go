@@ -6,6 +6,7 @@ import (
 	_ "gopkg.in/sensorbee/sensorbee.v0/bql/udf/builtin"
 	"gopkg.in/sensorbee/sensorbee.v0/core"
 	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"sync"
 	"testing"
 	"time"
 )
@@ -677,6 +678,40 @@ func TestBQLBoxUDSF(t *testing.T) {
 	})
 }
 
+func TestBQLBoxUnnestUDSF(t *testing.T) {
+	Convey("Given a topology using the unnest UDSF", t, func() {
+		tb, err := setupTopology(`CREATE STREAM arr AS SELECT RSTREAM int, [int, int * 10] AS vals FROM source [RANGE 1 TUPLES];
+			CREATE STREAM box AS SELECT RSTREAM int, vals FROM unnest("arr", "vals") [RANGE 1 TUPLES]`, false)
+		So(err, ShouldBeNil)
+		dt := tb.Topology()
+		Reset(func() {
+			dt.Stop()
+		})
+
+		sin, err := dt.Sink("snk")
+		So(err, ShouldBeNil)
+		si := sin.Sink().(*tupleCollectorSink)
+
+		Convey("When 4 tuples are emitted by the source", func() {
+			Convey("Then the sink should receive one tuple per array element", func() {
+				si.Wait(8)
+				So(si.len(), ShouldEqual, 8)
+				si.m.Lock()
+				defer si.m.Unlock()
+				for i, t := range si.Tuples {
+					n := data.Int(i/2 + 1)
+					So(t.Data["int"], ShouldEqual, n)
+					if i%2 == 0 {
+						So(t.Data["vals"], ShouldEqual, n)
+					} else {
+						So(t.Data["vals"], ShouldEqual, n*10)
+					}
+				}
+			})
+		})
+	})
+}
+
 func TestBQLBoxSourceUDSF(t *testing.T) {
 	Convey("Given a topology using a UDSF running in the source mode", t, func() {
 		// TODO: This is a super dirty hack. Although pause/resume of streams
@@ -710,3 +745,117 @@ func TestBQLBoxSourceUDSF(t *testing.T) {
 		})
 	})
 }
+
+func TestBQLBoxLateTuples(t *testing.T) {
+	Convey("Given a topology with a source emitting tuples out of order", t, func() {
+		dt := newTestTopology()
+		Reset(func() {
+			dt.Stop()
+		})
+		tb, err := NewTopologyBuilder(dt)
+		So(err, ShouldBeNil)
+
+		tuples := mkTuples(4)
+		s := &tupleEmitterSource{Tuples: []*core.Tuple{tuples[1], tuples[2], tuples[0], tuples[3]}}
+		s.c = sync.NewCond(&s.m)
+		src, err := dt.AddSource("source", s, &core.SourceConfig{
+			PausedOnStartup: true,
+		})
+		So(err, ShouldBeNil)
+
+		Convey("When a stream routes late tuples to a side stream", func() {
+			err := addBQLToTopology(tb, `
+				CREATE STREAM box AS SELECT RSTREAM int FROM source
+					[RANGE 1 TUPLES, WATERMARK DELAY 1 SECONDS, LATE TUPLES TO late];
+				CREATE SINK snk TYPE collector;
+				INSERT INTO snk FROM box;
+				CREATE SINK late_snk TYPE collector;
+				INSERT INTO late_snk FROM late;`)
+			So(err, ShouldBeNil)
+			So(src.Resume(), ShouldBeNil)
+
+			sin, err := dt.Sink("snk")
+			So(err, ShouldBeNil)
+			si := sin.Sink().(*tupleCollectorSink)
+			lateSin, err := dt.Sink("late_snk")
+			So(err, ShouldBeNil)
+			lateSi := lateSin.Sink().(*tupleCollectorSink)
+
+			Convey("Then the late tuple should be written to the side stream", func() {
+				lateSi.Wait(1)
+				So(lateSi.len(), ShouldEqual, 1)
+				So(lateSi.get(0).Data, ShouldResemble, data.Map{"int": data.Int(1)})
+
+				Convey("And the other tuples should be emitted in order", func() {
+					si.Wait(2)
+					So(si.len(), ShouldEqual, 2)
+					So(si.get(0).Data, ShouldResemble, data.Map{"int": data.Int(2)})
+					So(si.get(1).Data, ShouldResemble, data.Map{"int": data.Int(3)})
+				})
+			})
+
+			Convey("And the stream is dropped", func() {
+				So(addBQLToTopology(tb, `DROP STREAM box;`), ShouldBeNil)
+
+				Convey("Then the side stream should also be removed", func() {
+					for i := 0; i < 100; i++ {
+						if _, err := dt.Node("late"); err != nil {
+							break
+						}
+						time.Sleep(10 * time.Millisecond)
+					}
+					_, err := dt.Node("late")
+					So(err, ShouldNotBeNil)
+				})
+			})
+		})
+
+		Convey("When the side stream has the same name as an existing node", func() {
+			err := addBQLToTopology(tb, `CREATE STREAM box AS SELECT RSTREAM int FROM source
+				[RANGE 1 TUPLES, WATERMARK DELAY 1 SECONDS, LATE TUPLES TO source]`)
+
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "already")
+			})
+		})
+	})
+}
+
+func TestCheckpointValueEncoding(t *testing.T) {
+	Convey("Given a value having all types", t, func() {
+		now := time.Date(2016, 1, 2, 3, 4, 5, 6000, time.UTC)
+		v := data.Map{
+			"null":      data.Null{},
+			"bool":      data.True,
+			"int":       data.Int(1),
+			"float":     data.Float(2),
+			"string":    data.String("str"),
+			"blob":      data.Blob("blob"),
+			"timestamp": data.Timestamp(now),
+			"array":     data.Array{data.Timestamp(now), data.Blob("b")},
+			"map":       data.Map{"ts": data.Timestamp(now)},
+		}
+
+		Convey("When serializing and deserializing it with msgpack", func() {
+			b, err := data.MarshalMsgpack(data.Map{"v": encodeCheckpointValue(v)})
+			So(err, ShouldBeNil)
+			m, err := data.UnmarshalMsgpack(b)
+			So(err, ShouldBeNil)
+			d, err := decodeCheckpointValue(m["v"])
+
+			Convey("Then it should have the same types", func() {
+				So(err, ShouldBeNil)
+				So(d, ShouldResemble, v)
+			})
+		})
+
+		Convey("When decoding a broken value", func() {
+			_, err := decodeCheckpointValue(data.Array{data.Int(data.TypeTimestamp), data.Int(1)})
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
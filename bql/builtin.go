@@ -1,15 +1,16 @@
 package bql
 
 import (
-	"bufio"
-	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"gopkg.in/sensorbee/sensorbee.v0/core"
 	"gopkg.in/sensorbee/sensorbee.v0/data"
 	"io"
+	"io/ioutil"
 	"os"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 )
@@ -40,6 +41,7 @@ func init() {
 
 type readerSource struct {
 	filename string
+	codec    Codec
 	tsField  data.Path
 	ioParams *IOParams
 
@@ -54,9 +56,30 @@ type readerSource struct {
 	// tuples as fast as possible.
 	interval time.Duration
 	stopCh   chan struct{}
+
+	// skip is the number of records skipped at the beginning of the file in
+	// the first run. It's the offset restored from offsetFile.
+	skip int64
+
+	// tail is true when the source keeps following data appended to the
+	// file like "tail -F". pollInterval is the interval of checking new
+	// data, truncation, and rotation of the file in tail mode.
+	tail         bool
+	pollInterval time.Duration
+
+	// tailResume is the offset restored from offsetFile in tail mode.
+	tailResume *tailOffset
+
+	// tailGens has files followed in tail mode which might have tuples that
+	// haven't been acknowledged yet. It's protected by tailM.
+	tailM    sync.Mutex
+	tailGens []*tailGeneration
 }
 
 func (s *readerSource) GenerateStream(ctx *core.Context, w core.Writer) error {
+	if s.tail {
+		return s.tailStream(ctx, w)
+	}
 	for r := int64(0); s.repeat < 0 || r <= s.repeat; r++ {
 		if err := s.generateStream(ctx, w); err != nil {
 			return err
@@ -77,70 +100,90 @@ func (s *readerSource) generateStream(ctx *core.Context, w core.Writer) error {
 		}
 	}()
 
-	r := bufio.NewReader(f)
 	next := time.Now()
-	for lineNumber := 0; ; lineNumber++ {
-		line, err := r.ReadBytes('\n')
-		if err != nil && err != io.EOF {
-			return err
-		}
+	skip := s.skip
+	s.skip = 0
+	return s.readRecords(ctx, w, f, skip, &next)
+}
 
-		line = bytes.TrimSpace(line)
-		if len(line) == 0 {
-			if err == io.EOF {
-				break
+// readRecords decodes all records in r and writes them as tuples to w. The
+// first skip records are ignored.
+func (s *readerSource) readRecords(ctx *core.Context, w core.Writer, r io.Reader, skip int64, next *time.Time) error {
+	dec := s.codec.NewDecoder(r)
+	for recordNumber := int64(0); ; recordNumber++ {
+		m, err := dec.Decode()
+		if err == io.EOF {
+			break
+		}
+		if recordNumber < skip {
+			if core.IsFatalError(err) {
+				return err
 			}
 			continue
 		}
-
-		m := data.Map{}
-		if err := json.Unmarshal(line, &m); err != nil {
+		if err != nil {
+			if core.IsFatalError(err) {
+				return err
+			}
 			ctx.ErrLog(err).WithField("node_name", s.ioParams.Name).
-				WithField("jsonl_line_number", lineNumber).
-				WithField("body", string(line)).Warning("Ignoring the line due to a json parse error")
+				WithField("record_number", recordNumber).
+				Warning("Ignoring the record due to a parse error")
 			continue
 		}
 
 		t := core.NewTuple(m)
-		if s.interval > 0 {
-			// When the interval parameter is given, a proper application
-			// timestamp should be assigned to each tuple.
-			t.Timestamp = next
-		}
-		if s.tsField != nil {
-			if v, err := t.Data.Get(s.tsField); err == nil {
-				if ts, err := data.ToTimestamp(v); err != nil {
-					ctx.ErrLog(err).WithField("node_name", s.ioParams.Name).
-						WithField("jsonl_line_number", lineNumber).
-						WithField("timestamp_field", s.tsField).
-						WithField("timestamp_field_value", v).
-						Warning("Cannot convert a value in timestamp_field to a timestamp")
-				} else {
-					t.Timestamp = ts
-				}
-			}
-		}
-
-		if err := w.Write(ctx, t); err != nil {
+		// BatchID is the number of records read so far so that the source
+		// can resume after the record when the tuple is acknowledged.
+		t.BatchID = recordNumber + 1
+		if err := s.emit(ctx, w, t, recordNumber, next); err != nil {
 			return err
 		}
+	}
+	return nil
+}
 
-		if s.interval > 0 {
-			// wait as accurate as possible
-			now := time.Now()
-			next = next.Add(s.interval)
-			if next.Before(now) {
-				// delayed too much and should be rescheduled.
-				next = now.Add(s.interval)
+// emit writes a tuple to w after assigning its timestamp. It waits for the
+// interval after writing the tuple. next is the timestamp of the tuple when
+// the interval is given and it's updated for the next tuple.
+func (s *readerSource) emit(ctx *core.Context, w core.Writer, t *core.Tuple, recordNumber int64, next *time.Time) error {
+	if s.interval > 0 {
+		// When the interval parameter is given, a proper application
+		// timestamp should be assigned to each tuple.
+		t.Timestamp = *next
+	}
+	if s.tsField != nil {
+		if v, err := t.Data.Get(s.tsField); err == nil {
+			if ts, err := data.ToTimestamp(v); err != nil {
+				ctx.ErrLog(err).WithField("node_name", s.ioParams.Name).
+					WithField("record_number", recordNumber).
+					WithField("timestamp_field", s.tsField).
+					WithField("timestamp_field_value", v).
+					Warning("Cannot convert a value in timestamp_field to a timestamp")
+			} else {
+				t.Timestamp = ts
 			}
+		}
+	}
 
-			select {
-			case <-s.stopCh:
-				// This works as long as createFileSource returns a source
-				// wrapped with core.NewRewindableSource or core.ImplementSourceStop.
-				return core.ErrSourceStopped
-			case <-time.After(next.Sub(now)):
-			}
+	if err := w.Write(ctx, t); err != nil {
+		return err
+	}
+
+	if s.interval > 0 {
+		// wait as accurate as possible
+		now := time.Now()
+		*next = next.Add(s.interval)
+		if next.Before(now) {
+			// delayed too much and should be rescheduled.
+			*next = now.Add(s.interval)
+		}
+
+		select {
+		case <-s.stopCh:
+			// This works as long as createFileSource returns a source
+			// wrapped with core.NewRewindableSource or core.ImplementSourceStop.
+			return core.ErrSourceStopped
+		case <-time.After(next.Sub(now)):
 		}
 	}
 	return nil
@@ -151,21 +194,78 @@ func (s *readerSource) Stop(ctx *core.Context) error {
 	return nil
 }
 
-func createFileSource(ctx *core.Context, ioParams *IOParams, params data.Map) (core.Source, error) {
-	// TODO: add format parameter
+// ackableReaderSource is a readerSource which saves the offset of the record
+// acknowledged last to a file. The source resumes from the record after it
+// when it's created again.
+type ackableReaderSource struct {
+	*readerSource
+	offsetFile string
+}
 
-	fpath, err := extractPathParameter(params)
+func (s *ackableReaderSource) Ack(ctx *core.Context, batchID int64) error {
+	b := []byte(strconv.FormatInt(batchID, 10))
+	if s.tail {
+		// The offset in tail mode is a byte offset in the file.
+		o, err := s.tailOffset(batchID)
+		if err != nil {
+			return err
+		}
+		if b, err = json.Marshal(o); err != nil {
+			return err
+		}
+	}
+
+	// write to a temporary file first so that the offset file is never
+	// broken even if the process crashes while writing it
+	tmp := s.offsetFile + ".tmp"
+	if err := writeFileSync(tmp, b); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.offsetFile)
+}
+
+// writeFileSync writes b to the file and flushes it to the storage device.
+// Without the flush, the file renamed after a crash could be empty.
+func writeFileSync(path string, b []byte) (err error) {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
 	if err != nil {
-		return nil, err
+		return err
+	}
+	defer func() {
+		if e := f.Close(); err == nil {
+			err = e
+		}
+	}()
+	if _, err := f.Write(b); err != nil {
+		return err
 	}
+	return f.Sync()
+}
 
-	rewindable := false
-	if v, ok := params["rewindable"]; ok {
-		r, err := data.AsBool(v)
-		if err != nil {
-			return nil, fmt.Errorf("'rewindable' parameter must be bool: %v", err)
+// readOffsetFile reads the offset saved by ackableReaderSource. It returns 0
+// when the file doesn't exist.
+func readOffsetFile(path string) (int64, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, nil
 		}
-		rewindable = r
+		return 0, err
+	}
+	offset, err := strconv.ParseInt(strings.TrimSpace(string(b)), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("the offset file '%v' is broken: %v", path, err)
+	}
+	return offset, nil
+}
+
+// newReaderSource creates a readerSource having parameters common to sources
+// reading records from files: format (and its parameters), timestamp_field,
+// and interval.
+func newReaderSource(ioParams *IOParams, params data.Map) (*readerSource, error) {
+	codec, err := createCodec(params, "jsonl")
+	if err != nil {
+		return nil, err
 	}
 
 	var tsField data.Path
@@ -179,15 +279,6 @@ func createFileSource(ctx *core.Context, ioParams *IOParams, params data.Map) (c
 		}
 	}
 
-	var repeat int64
-	if v, ok := params["repeat"]; ok {
-		r, err := data.AsInt(v)
-		if err != nil {
-			return nil, fmt.Errorf("'repeat' parameter must be an integer: %v", err)
-		}
-		repeat = r
-	}
-
 	var interval time.Duration
 	if v, ok := params["interval"]; ok {
 		i, err := data.ToDuration(v)
@@ -196,18 +287,125 @@ func createFileSource(ctx *core.Context, ioParams *IOParams, params data.Map) (c
 		}
 		interval = i
 	}
-	s := &readerSource{
-		filename: fpath,
+
+	return &readerSource{
+		codec:    codec,
 		tsField:  tsField,
 		ioParams: ioParams,
-		repeat:   repeat,
 		interval: interval,
 		stopCh:   make(chan struct{}),
+	}, nil
+}
+
+// tailFormats are the formats which can be read in tail mode. The offset
+// of a record in tail mode is computed by counting the lines read, so only
+// formats whose records end with a newline are supported.
+var tailFormats = map[string]bool{
+	"jsonl": true,
+	"raw":   true,
+	"csv":   true,
+	"tsv":   true,
+}
+
+func createFileSource(ctx *core.Context, ioParams *IOParams, params data.Map) (core.Source, error) {
+	fpath, err := extractPathParameter(params)
+	if err != nil {
+		return nil, err
+	}
+
+	rewindable := false
+	if v, ok := params["rewindable"]; ok {
+		r, err := data.AsBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("'rewindable' parameter must be bool: %v", err)
+		}
+		rewindable = r
+	}
+
+	var repeat int64
+	if v, ok := params["repeat"]; ok {
+		r, err := data.AsInt(v)
+		if err != nil {
+			return nil, fmt.Errorf("'repeat' parameter must be an integer: %v", err)
+		}
+		repeat = r
+	}
+
+	tail := false
+	if v, ok := params["tail"]; ok {
+		t, err := data.AsBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("'tail' parameter must be bool: %v", err)
+		}
+		if t && repeat != 0 {
+			return nil, errors.New("'tail' parameter cannot be used with 'repeat' parameter")
+		}
+		if t && rewindable {
+			return nil, errors.New("'tail' parameter cannot be used with 'rewindable' parameter")
+		}
+		if t {
+			format := "jsonl"
+			if v, ok := params["format"]; ok {
+				format, _ = data.AsString(v)
+			}
+			if !tailFormats[strings.ToLower(format)] {
+				return nil, fmt.Errorf("'tail' parameter cannot be used with '%v' format", format)
+			}
+		}
+		tail = t
+	}
+
+	pollInterval, err := extractPollIntervalParameter(params)
+	if err != nil {
+		return nil, err
+	}
+
+	var offsetFile string
+	if v, ok := params["offset_file"]; ok {
+		f, err := data.AsString(v)
+		if err != nil {
+			return nil, fmt.Errorf("'offset_file' parameter must be a string: %v", err)
+		}
+		if repeat != 0 {
+			return nil, errors.New("'offset_file' parameter cannot be used with 'repeat' parameter")
+		}
+		offsetFile = f
+	}
+
+	s, err := newReaderSource(ioParams, params)
+	if err != nil {
+		return nil, err
+	}
+	s.filename = fpath
+	s.repeat = repeat
+	s.tail = tail
+	s.pollInterval = pollInterval
+	var src core.Source = s
+	if offsetFile != "" {
+		// The source resumes from the record acknowledged last. After it's
+		// rewound, it reads the file from the beginning again.
+		if tail {
+			o, err := readTailOffsetFile(offsetFile)
+			if err != nil {
+				return nil, err
+			}
+			s.tailResume = o
+		} else {
+			offset, err := readOffsetFile(offsetFile)
+			if err != nil {
+				return nil, err
+			}
+			s.skip = offset
+		}
+		src = &ackableReaderSource{
+			readerSource: s,
+			offsetFile:   offsetFile,
+		}
 	}
 	if rewindable {
-		return core.NewRewindableSource(s), nil
+		return core.NewRewindableSource(src), nil
 	}
-	return core.ImplementSourceStop(s), nil
+	return core.ImplementSourceStop(src), nil
 }
 
 // extractPathParameter retrieve 'path' parameter in the WITH clause of
@@ -224,6 +422,23 @@ func extractPathParameter(params data.Map) (string, error) {
 	return f, nil
 }
 
+// extractPollIntervalParameter retrieves 'poll_interval' parameter of sources
+// polling files. Its default value is 1 second.
+func extractPollIntervalParameter(params data.Map) (time.Duration, error) {
+	v, ok := params["poll_interval"]
+	if !ok {
+		return time.Second, nil
+	}
+	i, err := data.ToDuration(v)
+	if err != nil {
+		return 0, fmt.Errorf("'poll_interval' parameter should have a duration: %v", err)
+	}
+	if i <= 0 {
+		return 0, errors.New("'poll_interval' parameter must be positive")
+	}
+	return i, nil
+}
+
 func init() {
 	MustRegisterGlobalSourceCreator("file", SourceCreatorFunc(createFileSource))
 }
@@ -231,25 +446,22 @@ func init() {
 type writerSink struct {
 	m           sync.Mutex
 	w           io.Writer
+	enc         Encoder
 	shouldClose bool
 }
 
 func (s *writerSink) Write(ctx *core.Context, t *core.Tuple) error {
-	// TODO: support custom formatting. There're several things that need to
-	// be considered such as concurrent formatting, zero-copy write, and so on.
-	// While encoding tuples outside the lock supports concurrent formatting,
-	// it makes it difficult to support zero-copy write.
+	// TODO: support concurrent formatting and zero-copy write. Encoding
+	// records outside the lock isn't possible for formats which have states
+	// such as a header of CSV.
 
-	js := t.Data.String() // Format this outside the lock
-
-	// This lock is required to avoid interleaving JSONs.
+	// This lock is required to avoid interleaving records.
 	s.m.Lock()
 	defer s.m.Unlock()
 	if s.w == nil {
 		return errors.New("the sink is already closed")
 	}
-	_, err := fmt.Fprintln(s.w, js)
-	return err
+	return s.enc.Encode(t.Data)
 }
 
 func (s *writerSink) Close(ctx *core.Context) error {
@@ -267,16 +479,19 @@ func (s *writerSink) Close(ctx *core.Context) error {
 }
 
 func createStdoutSink(ctx *core.Context, ioParams *IOParams, params data.Map) (core.Sink, error) {
+	codec, err := createCodec(params, "jsonl")
+	if err != nil {
+		return nil, err
+	}
 	return &writerSink{
-		w: os.Stdout,
+		w:   os.Stdout,
+		enc: codec.NewEncoder(os.Stdout),
 	}, nil
 }
 
 func createFileSink(ctx *core.Context, ioParams *IOParams, params data.Map) (core.Sink, error) {
 	// TODO: currently this sink isn't secure because it accepts any path.
 	// TODO: support buffering
-	// TODO: provide "format" parameter to support output formats other than "jsonl".
-	//       "jsonl" should be the default value.
 	// TODO: support "compression" parameter with values like "gz".
 
 	fpath, err := extractPathParameter(params)
@@ -285,6 +500,7 @@ func createFileSink(ctx *core.Context, ioParams *IOParams, params data.Map) (cor
 	}
 
 	flags := os.O_WRONLY | os.O_APPEND | os.O_CREATE
+	truncate := false
 	if v, ok := params["truncate"]; ok {
 		t, err := data.AsBool(v)
 		if err != nil {
@@ -293,6 +509,20 @@ func createFileSink(ctx *core.Context, ioParams *IOParams, params data.Map) (cor
 		if t {
 			flags |= os.O_TRUNC
 		}
+		truncate = t
+	}
+
+	if !truncate {
+		// A header shouldn't be written again when appending records to
+		// a file which already has some.
+		if fi, err := os.Stat(fpath); err == nil && fi.Size() > 0 {
+			params = params.Copy()
+			params["header"] = data.False
+		}
+	}
+	codec, err := createCodec(params, "jsonl")
+	if err != nil {
+		return nil, err
 	}
 
 	file, err := os.OpenFile(fpath, flags, 0644)
@@ -301,6 +531,7 @@ func createFileSink(ctx *core.Context, ioParams *IOParams, params data.Map) (cor
 	}
 	return &writerSink{
 		w:           file,
+		enc:         codec.NewEncoder(file),
 		shouldClose: true,
 	}, nil
 }
@@ -318,6 +549,17 @@ func init() {
 	MustRegisterGlobalSourceCreator("dropped_tuples", SourceCreatorFunc(createDroppedTupleCollectorSource))
 }
 
+// createDeadLetterSource creates a source receiving tuples which sinks failed
+// to write or streams failed to process. Sinks and streams write tuples to it
+// when they're created with a parameter on_error="dlq:<name of the source>".
+func createDeadLetterSource(ctx *core.Context, ioParams *IOParams, params data.Map) (core.Source, error) {
+	return core.NewDeadLetterSource(), nil
+}
+
+func init() {
+	MustRegisterGlobalSourceCreator("dead_letter", SourceCreatorFunc(createDeadLetterSource))
+}
+
 type nodeStatusSource struct {
 	topology core.Topology
 	interval time.Duration
@@ -0,0 +1,364 @@
+package bql
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/ugorji/go/codec"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"io"
+	"reflect"
+	"sort"
+	"unicode/utf8"
+)
+
+// jsonlCodec reads and writes JSON Lines. Blank lines are ignored.
+type jsonlCodec struct {
+}
+
+func (c *jsonlCodec) NewDecoder(r io.Reader) Decoder {
+	return &jsonlDecoder{
+		r: bufio.NewReader(r),
+	}
+}
+
+func (c *jsonlCodec) NewEncoder(w io.Writer) Encoder {
+	return &jsonlEncoder{
+		w: w,
+	}
+}
+
+type jsonlDecoder struct {
+	r *bufio.Reader
+}
+
+func (d *jsonlDecoder) Decode() (data.Map, error) {
+	for {
+		line, err := d.r.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return nil, core.FatalError(err)
+		}
+
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			if err == io.EOF {
+				return nil, io.EOF
+			}
+			continue
+		}
+
+		m := data.Map{}
+		if err := json.Unmarshal(line, &m); err != nil {
+			return nil, fmt.Errorf("cannot parse a line as JSON: %v: %s", err, line)
+		}
+		return m, nil
+	}
+}
+
+type jsonlEncoder struct {
+	w io.Writer
+}
+
+func (e *jsonlEncoder) Encode(m data.Map) error {
+	_, err := fmt.Fprintln(e.w, m.String())
+	return err
+}
+
+// rawCodec reads each line as a map having the line in "line" field. It
+// writes "line" field of each map as a line.
+type rawCodec struct {
+}
+
+func (c *rawCodec) NewDecoder(r io.Reader) Decoder {
+	return &rawDecoder{
+		r: bufio.NewReader(r),
+	}
+}
+
+func (c *rawCodec) NewEncoder(w io.Writer) Encoder {
+	return &rawEncoder{
+		w: w,
+	}
+}
+
+type rawDecoder struct {
+	r *bufio.Reader
+}
+
+func (d *rawDecoder) Decode() (data.Map, error) {
+	line, err := d.r.ReadBytes('\n')
+	if err != nil {
+		if err != io.EOF {
+			return nil, core.FatalError(err)
+		}
+		if len(line) == 0 {
+			return nil, io.EOF
+		}
+	}
+	line = bytes.TrimSuffix(line, []byte{'\n'})
+	line = bytes.TrimSuffix(line, []byte{'\r'})
+	return data.Map{
+		"line": data.String(line),
+	}, nil
+}
+
+type rawEncoder struct {
+	w io.Writer
+}
+
+func (e *rawEncoder) Encode(m data.Map) error {
+	v, ok := m["line"]
+	if !ok {
+		return errors.New("the tuple doesn't have 'line' field")
+	}
+	s, err := data.ToString(v)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(e.w, s)
+	return err
+}
+
+// csvCodec reads and writes CSV. All values read from CSV are strings. When
+// the codec doesn't have column names, columns are named as col_0, col_1, and
+// so on.
+type csvCodec struct {
+	delimiter rune
+
+	// header is true when the first line is a header line having column
+	// names.
+	header bool
+
+	// columns has column names given as a parameter. When it's given, the
+	// header line is ignored on decoding and the columns are written in the
+	// order on encoding.
+	columns []string
+}
+
+// createCSVCodecCreator returns a CodecCreator creating a csvCodec which
+// uses the delimiter by default. It supports the following parameters:
+//
+//	- delimiter: a string having exactly one character
+//	- header: true when the first line has column names (default: false)
+//	- columns: an array of column names
+func createCSVCodecCreator(delimiter rune) CodecCreator {
+	return CodecCreatorFunc(func(params data.Map) (Codec, error) {
+		c := &csvCodec{
+			delimiter: delimiter,
+		}
+
+		if v, ok := params["delimiter"]; ok {
+			d, err := data.AsString(v)
+			if err != nil {
+				return nil, fmt.Errorf("'delimiter' parameter must be a string: %v", err)
+			}
+			r, size := utf8.DecodeRuneInString(d)
+			if size == 0 || size != len(d) || r == utf8.RuneError || r == '"' || r == '\r' || r == '\n' {
+				return nil, fmt.Errorf("'delimiter' parameter must be one character except quotes and line breaks: %v", d)
+			}
+			c.delimiter = r
+		}
+
+		if v, ok := params["header"]; ok {
+			h, err := data.AsBool(v)
+			if err != nil {
+				return nil, fmt.Errorf("'header' parameter must be bool: %v", err)
+			}
+			c.header = h
+		}
+
+		if v, ok := params["columns"]; ok {
+			a, err := data.AsArray(v)
+			if err != nil {
+				return nil, fmt.Errorf("'columns' parameter must be an array: %v", err)
+			}
+			for _, e := range a {
+				s, err := data.AsString(e)
+				if err != nil {
+					return nil, fmt.Errorf("'columns' parameter must only have strings: %v", err)
+				}
+				c.columns = append(c.columns, s)
+			}
+		}
+		return c, nil
+	})
+}
+
+func (c *csvCodec) NewDecoder(r io.Reader) Decoder {
+	cr := csv.NewReader(r)
+	cr.Comma = c.delimiter
+	cr.FieldsPerRecord = -1
+	return &csvDecoder{
+		c:       c,
+		r:       cr,
+		columns: c.columns,
+	}
+}
+
+func (c *csvCodec) NewEncoder(w io.Writer) Encoder {
+	cw := csv.NewWriter(w)
+	cw.Comma = c.delimiter
+	return &csvEncoder{
+		c:       c,
+		w:       cw,
+		columns: c.columns,
+	}
+}
+
+type csvDecoder struct {
+	c          *csvCodec
+	r          *csv.Reader
+	columns    []string
+	headerRead bool
+}
+
+func (d *csvDecoder) Decode() (data.Map, error) {
+	if d.c.header && !d.headerRead {
+		rec, err := d.r.Read()
+		if err != nil {
+			if err == io.EOF {
+				return nil, err
+			}
+			// Records cannot be decoded without the header.
+			return nil, core.FatalError(fmt.Errorf("cannot read the header: %v", err))
+		}
+		d.headerRead = true
+		if d.columns == nil {
+			d.columns = rec
+		}
+	}
+
+	rec, err := d.r.Read()
+	if err != nil {
+		if _, ok := err.(*csv.ParseError); ok {
+			return nil, err
+		}
+		if err == io.EOF {
+			return nil, err
+		}
+		return nil, core.FatalError(err)
+	}
+
+	m := make(data.Map, len(rec))
+	for i, v := range rec {
+		m[csvColumnName(d.columns, i)] = data.String(v)
+	}
+	return m, nil
+}
+
+func csvColumnName(columns []string, i int) string {
+	if i < len(columns) {
+		return columns[i]
+	}
+	return fmt.Sprintf("col_%v", i)
+}
+
+type csvEncoder struct {
+	c       *csvCodec
+	w       *csv.Writer
+	columns []string
+	started bool
+}
+
+func (e *csvEncoder) Encode(m data.Map) error {
+	if !e.started {
+		if e.columns == nil {
+			// The first map decides columns when they aren't given.
+			for k := range m {
+				e.columns = append(e.columns, k)
+			}
+			sort.Strings(e.columns)
+		}
+		if e.c.header {
+			if err := e.w.Write(e.columns); err != nil {
+				return err
+			}
+		}
+		e.started = true
+	}
+
+	rec := make([]string, len(e.columns))
+	for i, col := range e.columns {
+		v, ok := m[col]
+		if !ok {
+			continue
+		}
+		s, err := data.ToString(v)
+		if err != nil {
+			return err
+		}
+		rec[i] = s
+	}
+	if err := e.w.Write(rec); err != nil {
+		return err
+	}
+	e.w.Flush()
+	return e.w.Error()
+}
+
+// msgpackCodec reads and writes a stream of msgpack maps.
+type msgpackCodec struct {
+}
+
+var msgpackHandle = &codec.MsgpackHandle{}
+
+func init() {
+	// This configuration is same as the one used in data package.
+	msgpackHandle.MapType = reflect.TypeOf(map[string]interface{}(nil))
+	msgpackHandle.RawToString = true
+	msgpackHandle.WriteExt = false
+}
+
+func (c *msgpackCodec) NewDecoder(r io.Reader) Decoder {
+	return &msgpackDecoder{
+		d: codec.NewDecoder(bufio.NewReader(r), msgpackHandle),
+	}
+}
+
+func (c *msgpackCodec) NewEncoder(w io.Writer) Encoder {
+	return &msgpackEncoder{
+		e: codec.NewEncoder(w, msgpackHandle),
+	}
+}
+
+type msgpackDecoder struct {
+	d *codec.Decoder
+}
+
+func (d *msgpackDecoder) Decode() (data.Map, error) {
+	var m map[string]interface{}
+	if err := d.d.Decode(&m); err != nil {
+		if err == io.EOF {
+			return nil, err
+		}
+		// The rest of the stream cannot be decoded once an error occurs.
+		return nil, core.FatalError(err)
+	}
+	return data.NewMap(m)
+}
+
+type msgpackEncoder struct {
+	e *codec.Encoder
+}
+
+func (e *msgpackEncoder) Encode(m data.Map) error {
+	return e.e.Encode(data.NewIMap(m))
+}
+
+func init() {
+	MustRegisterGlobalCodecCreator("jsonl", CodecCreatorFunc(func(params data.Map) (Codec, error) {
+		return &jsonlCodec{}, nil
+	}))
+	MustRegisterGlobalCodecCreator("raw", CodecCreatorFunc(func(params data.Map) (Codec, error) {
+		return &rawCodec{}, nil
+	}))
+	MustRegisterGlobalCodecCreator("csv", createCSVCodecCreator(','))
+	MustRegisterGlobalCodecCreator("tsv", createCSVCodecCreator('\t'))
+	MustRegisterGlobalCodecCreator("msgpack", CodecCreatorFunc(func(params data.Map) (Codec, error) {
+		return &msgpackCodec{}, nil
+	}))
+}
@@ -0,0 +1,262 @@
+package bql
+
+import (
+	"bytes"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"io"
+	"strings"
+	"testing"
+)
+
+// decodeAll decodes all records. Errors of broken records are counted.
+func decodeAll(d Decoder) ([]data.Map, int, error) {
+	var ms []data.Map
+	broken := 0
+	for {
+		m, err := d.Decode()
+		if err == io.EOF {
+			return ms, broken, nil
+		}
+		if err != nil {
+			if core.IsFatalError(err) {
+				return ms, broken, err
+			}
+			broken++
+			continue
+		}
+		ms = append(ms, m)
+	}
+}
+
+func mustCreateCodec(format string, params data.Map) Codec {
+	params = params.Copy()
+	params["format"] = data.String(format)
+	c, err := createCodec(params, "jsonl")
+	So(err, ShouldBeNil)
+	return c
+}
+
+func TestJSONLCodec(t *testing.T) {
+	Convey("Given a jsonl codec", t, func() {
+		c := mustCreateCodec("jsonl", data.Map{})
+
+		Convey("When decoding lines having a broken line and blank lines", func() {
+			ms, broken, err := decodeAll(c.NewDecoder(strings.NewReader(
+				"{\"a\":1}\n\n  {\"a\":2}  \n{\"a\":\n{\"a\":3}")))
+
+			Convey("Then it should skip the broken line and blank lines", func() {
+				So(err, ShouldBeNil)
+				So(broken, ShouldEqual, 1)
+				So(ms, ShouldResemble, []data.Map{
+					{"a": data.Int(1)}, {"a": data.Int(2)}, {"a": data.Int(3)},
+				})
+			})
+		})
+
+		Convey("When encoding maps", func() {
+			b := bytes.NewBuffer(nil)
+			e := c.NewEncoder(b)
+			So(e.Encode(data.Map{"a": data.Int(1)}), ShouldBeNil)
+			So(e.Encode(data.Map{"b": data.String("c")}), ShouldBeNil)
+
+			Convey("Then it should write JSON Lines", func() {
+				So(b.String(), ShouldEqual, "{\"a\":1}\n{\"b\":\"c\"}\n")
+			})
+		})
+	})
+}
+
+func TestRawCodec(t *testing.T) {
+	Convey("Given a raw codec", t, func() {
+		c := mustCreateCodec("raw", data.Map{})
+
+		Convey("When decoding lines", func() {
+			ms, _, err := decodeAll(c.NewDecoder(strings.NewReader("a b\r\n\n c\n")))
+
+			Convey("Then each line should be a record", func() {
+				So(err, ShouldBeNil)
+				So(ms, ShouldResemble, []data.Map{
+					{"line": data.String("a b")},
+					{"line": data.String("")},
+					{"line": data.String(" c")},
+				})
+			})
+		})
+
+		Convey("When encoding maps", func() {
+			b := bytes.NewBuffer(nil)
+			e := c.NewEncoder(b)
+			So(e.Encode(data.Map{"line": data.String("a b")}), ShouldBeNil)
+			So(e.Encode(data.Map{"line": data.Int(1)}), ShouldBeNil)
+
+			Convey("Then it should write line fields", func() {
+				So(b.String(), ShouldEqual, "a b\n1\n")
+			})
+
+			Convey("Then a map without line field should fail", func() {
+				So(e.Encode(data.Map{"a": data.Int(1)}), ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestCSVCodec(t *testing.T) {
+	Convey("Given a csv codec with a header", t, func() {
+		c := mustCreateCodec("csv", data.Map{"header": data.True})
+
+		Convey("When decoding records", func() {
+			ms, broken, err := decodeAll(c.NewDecoder(strings.NewReader(
+				"a,b\n1,\"x,y\"\n2\n3,\"z\"w\n4,5,6\n")))
+
+			Convey("Then it should use the header as column names", func() {
+				So(err, ShouldBeNil)
+				So(broken, ShouldEqual, 1)
+				So(ms, ShouldResemble, []data.Map{
+					{"a": data.String("1"), "b": data.String("x,y")},
+					{"a": data.String("2")},
+					{"a": data.String("4"), "b": data.String("5"), "col_2": data.String("6")},
+				})
+			})
+		})
+
+		Convey("When encoding maps", func() {
+			b := bytes.NewBuffer(nil)
+			e := c.NewEncoder(b)
+			So(e.Encode(data.Map{"b": data.String("x,y"), "a": data.Int(1)}), ShouldBeNil)
+			So(e.Encode(data.Map{"a": data.Int(2), "c": data.Int(3)}), ShouldBeNil)
+
+			Convey("Then it should write the header and records", func() {
+				So(b.String(), ShouldEqual, "a,b\n1,\"x,y\"\n2,\n")
+			})
+		})
+	})
+
+	Convey("Given a csv codec without a header", t, func() {
+		c := mustCreateCodec("csv", data.Map{"delimiter": data.String(";")})
+
+		Convey("When decoding records", func() {
+			ms, _, err := decodeAll(c.NewDecoder(strings.NewReader("1;2\n3;4\n")))
+
+			Convey("Then columns should have default names", func() {
+				So(err, ShouldBeNil)
+				So(ms, ShouldResemble, []data.Map{
+					{"col_0": data.String("1"), "col_1": data.String("2")},
+					{"col_0": data.String("3"), "col_1": data.String("4")},
+				})
+			})
+		})
+
+		Convey("When encoding maps", func() {
+			b := bytes.NewBuffer(nil)
+			e := c.NewEncoder(b)
+			So(e.Encode(data.Map{"a": data.Int(1), "b": data.Array{data.Int(2)}}), ShouldBeNil)
+
+			Convey("Then it should only write records", func() {
+				So(b.String(), ShouldEqual, "1;[2]\n")
+			})
+		})
+	})
+
+	Convey("Given a csv codec with columns", t, func() {
+		c := mustCreateCodec("csv", data.Map{
+			"header":  data.True,
+			"columns": data.Array{data.String("y"), data.String("x")},
+		})
+
+		Convey("When decoding records", func() {
+			ms, _, err := decodeAll(c.NewDecoder(strings.NewReader("a,b\n1,2\n")))
+
+			Convey("Then it should ignore the header", func() {
+				So(err, ShouldBeNil)
+				So(ms, ShouldResemble, []data.Map{
+					{"y": data.String("1"), "x": data.String("2")},
+				})
+			})
+		})
+
+		Convey("When encoding maps", func() {
+			b := bytes.NewBuffer(nil)
+			e := c.NewEncoder(b)
+			So(e.Encode(data.Map{"x": data.Int(1), "y": data.Int(2), "z": data.Int(3)}), ShouldBeNil)
+
+			Convey("Then it should write the columns in the order", func() {
+				So(b.String(), ShouldEqual, "y,x\n2,1\n")
+			})
+		})
+	})
+
+	Convey("Given a tsv codec", t, func() {
+		c := mustCreateCodec("tsv", data.Map{"header": data.True})
+
+		Convey("When decoding records", func() {
+			ms, _, err := decodeAll(c.NewDecoder(strings.NewReader("a\tb\n1\t2,3\n")))
+
+			Convey("Then it should split them by tabs", func() {
+				So(err, ShouldBeNil)
+				So(ms, ShouldResemble, []data.Map{
+					{"a": data.String("1"), "b": data.String("2,3")},
+				})
+			})
+		})
+	})
+
+	Convey("Given invalid csv parameters", t, func() {
+		params := data.Map{"format": data.String("csv")}
+
+		Convey("Then a delimiter having multiple characters should be rejected", func() {
+			params["delimiter"] = data.String(",,")
+			_, err := createCodec(params, "jsonl")
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("Then a line break delimiter should be rejected", func() {
+			params["delimiter"] = data.String("\n")
+			_, err := createCodec(params, "jsonl")
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("Then a non-bool header should be rejected", func() {
+			params["header"] = data.Int(1)
+			_, err := createCodec(params, "jsonl")
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("Then non-string columns should be rejected", func() {
+			params["columns"] = data.Array{data.Int(1)}
+			_, err := createCodec(params, "jsonl")
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
+
+func TestMsgpackCodec(t *testing.T) {
+	Convey("Given a msgpack codec", t, func() {
+		c := mustCreateCodec("msgpack", data.Map{})
+
+		Convey("When encoding and decoding maps", func() {
+			b := bytes.NewBuffer(nil)
+			e := c.NewEncoder(b)
+			So(e.Encode(data.Map{"a": data.Int(1), "b": data.String("c")}), ShouldBeNil)
+			So(e.Encode(data.Map{"a": data.Array{data.Float(1.5)}}), ShouldBeNil)
+			ms, _, err := decodeAll(c.NewDecoder(b))
+
+			Convey("Then the maps should be restored", func() {
+				So(err, ShouldBeNil)
+				So(ms, ShouldResemble, []data.Map{
+					{"a": data.Int(1), "b": data.String("c")},
+					{"a": data.Array{data.Float(1.5)}},
+				})
+			})
+		})
+
+		Convey("When decoding a stream having a value other than a map", func() {
+			_, _, err := decodeAll(c.NewDecoder(strings.NewReader("\x01")))
+
+			Convey("Then it should fail with a fatal error", func() {
+				So(core.IsFatalError(err), ShouldBeTrue)
+			})
+		})
+	})
+}
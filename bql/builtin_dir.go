@@ -0,0 +1,267 @@
+package bql
+
+import (
+	"errors"
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// dirSource watches a directory and reads each new file matching the pattern
+// with the codec of the readerSource. A file is moved to processedDir or
+// renamed with processedSuffix after all records in it are written so that
+// it isn't read again even after the source is recreated.
+//
+// A file is read after its size and modification time haven't changed for
+// one polling interval so that a file being transferred isn't read. Because
+// a file is marked as processed after it's entirely read, a file read when
+// the source is stopped will be read again from the beginning when the source
+// is recreated.
+type dirSource struct {
+	*readerSource
+	dir     string
+	pattern string
+
+	// processedDir is the directory to which processed files are moved. When
+	// it's empty, processed files are renamed with processedSuffix and files
+	// having the suffix are ignored.
+	processedDir    string
+	processedSuffix string
+
+	// pending has files found in the previous scan which haven't been read
+	// yet.
+	pending map[string]dirFileState
+
+	// ignored has files which couldn't be read or marked as processed. They
+	// will be read again when they're modified.
+	ignored map[string]dirFileState
+}
+
+// dirFileState is the state of a file in the directory used to check if the
+// file is being modified.
+type dirFileState struct {
+	size    int64
+	modTime time.Time
+}
+
+func newDirFileState(fi os.FileInfo) dirFileState {
+	return dirFileState{
+		size:    fi.Size(),
+		modTime: fi.ModTime(),
+	}
+}
+
+func (s dirFileState) same(o dirFileState) bool {
+	return s.size == o.size && s.modTime.Equal(o.modTime)
+}
+
+func (s *dirSource) GenerateStream(ctx *core.Context, w core.Writer) error {
+	next := time.Now()
+	for {
+		for _, path := range s.scan(ctx) {
+			select {
+			case <-s.stopCh:
+				return nil
+			default:
+			}
+			if err := s.readFile(ctx, w, path, &next); err != nil {
+				return err
+			}
+		}
+
+		select {
+		case <-s.stopCh:
+			return nil
+		case <-time.After(s.pollInterval):
+		}
+	}
+}
+
+// scan returns files which are ready to be read in the order of their names.
+func (s *dirSource) scan(ctx *core.Context) []string {
+	// The pattern has been validated in createDirSource, so Glob doesn't
+	// fail.
+	matches, _ := filepath.Glob(filepath.Join(s.dir, s.pattern))
+
+	var ready []string
+	pending := map[string]dirFileState{}
+	ignored := map[string]dirFileState{}
+	for _, path := range matches {
+		if s.processedSuffix != "" && strings.HasSuffix(path, s.processedSuffix) {
+			continue
+		}
+		fi, err := os.Stat(path)
+		if err != nil {
+			// The file could be removed after Glob.
+			if !os.IsNotExist(err) {
+				ctx.ErrLog(err).WithField("node_name", s.ioParams.Name).
+					WithField("file", path).Warning("Cannot get the state of the file")
+			}
+			continue
+		}
+		if fi.IsDir() {
+			continue
+		}
+
+		st := newDirFileState(fi)
+		if prev, ok := s.ignored[path]; ok && prev.same(st) {
+			ignored[path] = prev
+			continue
+		}
+		if prev, ok := s.pending[path]; ok && prev.same(st) {
+			ready = append(ready, path)
+			continue
+		}
+		pending[path] = st
+	}
+	s.pending = pending
+	s.ignored = ignored
+	return ready
+}
+
+// readFile reads all records in the file and marks the file as processed. It
+// only returns an error returned from w. Other errors are logged and the file
+// will be ignored until it's modified.
+func (s *dirSource) readFile(ctx *core.Context, w core.Writer, path string, next *time.Time) error {
+	f, err := os.Open(path)
+	if err != nil {
+		ctx.ErrLog(err).WithField("node_name", s.ioParams.Name).
+			WithField("file", path).Error("Cannot open the file")
+		s.ignore(path)
+		return nil
+	}
+	err = s.readRecords(ctx, w, f, 0, next)
+	if err := f.Close(); err != nil {
+		ctx.ErrLog(err).WithField("node_name", s.ioParams.Name).
+			WithField("file", path).Warning("Cannot close the file")
+	}
+	if err != nil {
+		if !core.IsFatalError(err) {
+			return err
+		}
+		ctx.ErrLog(err).WithField("node_name", s.ioParams.Name).
+			WithField("file", path).Error("Cannot read the rest of the file")
+		s.ignore(path)
+		return nil
+	}
+
+	if err := s.markProcessed(path); err != nil {
+		// The file is ignored so that it won't be read twice as long as
+		// the source is running.
+		ctx.ErrLog(err).WithField("node_name", s.ioParams.Name).
+			WithField("file", path).Error("Cannot mark the file as processed")
+		s.ignore(path)
+	}
+	return nil
+}
+
+func (s *dirSource) markProcessed(path string) error {
+	if s.processedDir != "" {
+		return os.Rename(path, filepath.Join(s.processedDir, filepath.Base(path)))
+	}
+	return os.Rename(path, path+s.processedSuffix)
+}
+
+func (s *dirSource) ignore(path string) {
+	if fi, err := os.Stat(path); err == nil {
+		s.ignored[path] = newDirFileState(fi)
+	}
+}
+
+// createDirSource creates a source reading files put into a directory. In
+// addition to the parameters of newReaderSource, it supports the following
+// parameters:
+//
+//	- path: the directory to be watched
+//	- pattern: the glob pattern of files to be read (default: "*")
+//	- poll_interval: the interval of scanning the directory (default: 1s)
+//	- processed_dir: the directory to which processed files are moved
+//	- processed_suffix: the suffix appended to the names of processed files
+//	  when processed_dir isn't given (default: ".processed")
+func createDirSource(ctx *core.Context, ioParams *IOParams, params data.Map) (core.Source, error) {
+	dir, err := extractPathParameter(params)
+	if err != nil {
+		return nil, err
+	}
+	if fi, err := os.Stat(dir); err != nil {
+		return nil, err
+	} else if !fi.IsDir() {
+		return nil, fmt.Errorf("'%v' is not a directory", dir)
+	}
+
+	pattern := "*"
+	if v, ok := params["pattern"]; ok {
+		p, err := data.AsString(v)
+		if err != nil {
+			return nil, fmt.Errorf("'pattern' parameter must be a string: %v", err)
+		}
+		if _, err := filepath.Match(p, ""); err != nil {
+			return nil, fmt.Errorf("'pattern' parameter has an invalid pattern: %v", err)
+		}
+		pattern = p
+	}
+
+	pollInterval, err := extractPollIntervalParameter(params)
+	if err != nil {
+		return nil, err
+	}
+
+	var processedDir string
+	if v, ok := params["processed_dir"]; ok {
+		d, err := data.AsString(v)
+		if err != nil {
+			return nil, fmt.Errorf("'processed_dir' parameter must be a string: %v", err)
+		}
+		if filepath.Clean(d) == filepath.Clean(dir) {
+			return nil, errors.New("'processed_dir' parameter must be different from 'path' parameter")
+		}
+		processedDir = d
+	}
+
+	processedSuffix := ".processed"
+	if v, ok := params["processed_suffix"]; ok {
+		if processedDir != "" {
+			return nil, errors.New("'processed_suffix' parameter cannot be used with 'processed_dir' parameter")
+		}
+		sfx, err := data.AsString(v)
+		if err != nil {
+			return nil, fmt.Errorf("'processed_suffix' parameter must be a string: %v", err)
+		}
+		if sfx == "" {
+			return nil, errors.New("'processed_suffix' parameter must not be empty")
+		}
+		processedSuffix = sfx
+	}
+	if processedDir != "" {
+		processedSuffix = ""
+	}
+
+	rs, err := newReaderSource(ioParams, params)
+	if err != nil {
+		return nil, err
+	}
+	rs.pollInterval = pollInterval
+	if processedDir != "" {
+		if err := os.MkdirAll(processedDir, 0755); err != nil {
+			return nil, err
+		}
+	}
+	s := &dirSource{
+		readerSource:    rs,
+		dir:             dir,
+		pattern:         pattern,
+		processedDir:    processedDir,
+		processedSuffix: processedSuffix,
+		pending:         map[string]dirFileState{},
+		ignored:         map[string]dirFileState{},
+	}
+	return core.ImplementSourceStop(s), nil
+}
+
+func init() {
+	MustRegisterGlobalSourceCreator("dir", SourceCreatorFunc(createDirSource))
+}
@@ -0,0 +1,253 @@
+package bql
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestDirSource(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sbtest_bql_dir_source")
+	if err != nil {
+		t.Fatal("Cannot create a temp directory:", err)
+	}
+	defer os.RemoveAll(dir)
+	in := filepath.Join(dir, "in")
+	done := filepath.Join(dir, "done")
+
+	writeFile := func(name, content string) {
+		So(ioutil.WriteFile(filepath.Join(in, name), []byte(content), 0644), ShouldBeNil)
+	}
+	exists := func(path string) bool {
+		_, err := os.Stat(path)
+		return err == nil
+	}
+	waitFor := func(f func() bool) bool {
+		for i := 0; i < 1000; i++ {
+			if f() {
+				return true
+			}
+			time.Sleep(5 * time.Millisecond)
+		}
+		return false
+	}
+
+	Convey("Given a directory having files", t, func() {
+		ctx := core.NewContext(nil)
+		So(os.MkdirAll(in, 0755), ShouldBeNil)
+		Reset(func() {
+			os.RemoveAll(in)
+			os.RemoveAll(done)
+		})
+		writeFile("a.jsonl", "{\"int\":1}\n{\"int\":2}\n")
+		writeFile("b.jsonl", "{\"int\":3}\n")
+		writeFile("c.txt", "{\"int\":100}\n")
+
+		params := data.Map{
+			"path":          data.String(in),
+			"pattern":       data.String("*.jsonl"),
+			"poll_interval": data.Float(0.001),
+		}
+		si := &tupleCollectorSink{}
+		si.c = sync.NewCond(&si.m)
+		run := func() (core.Source, func()) {
+			s, err := createDirSource(ctx, &IOParams{}, params)
+			So(err, ShouldBeNil)
+			ch := make(chan error, 1)
+			go func() {
+				ch <- s.GenerateStream(ctx, si)
+			}()
+			return s, func() {
+				So(s.Stop(ctx), ShouldBeNil)
+				So(<-ch, ShouldBeNil)
+			}
+		}
+		ints := func() []int64 {
+			var is []int64
+			si.forEachTuple(func(t *core.Tuple) {
+				i, _ := t.Data.Get(data.MustCompilePath("int"))
+				n, _ := data.AsInt(i)
+				is = append(is, n)
+			})
+			return is
+		}
+
+		Convey("When reading the directory", func() {
+			_, stop := run()
+			defer stop()
+			si.Wait(3)
+
+			Convey("Then it should read files matching the pattern in order", func() {
+				So(ints(), ShouldResemble, []int64{1, 2, 3})
+			})
+
+			Convey("Then it should rename processed files", func() {
+				So(waitFor(func() bool {
+					return exists(filepath.Join(in, "b.jsonl.processed"))
+				}), ShouldBeTrue)
+				So(exists(filepath.Join(in, "a.jsonl.processed")), ShouldBeTrue)
+				So(exists(filepath.Join(in, "a.jsonl")), ShouldBeFalse)
+				So(exists(filepath.Join(in, "c.txt")), ShouldBeTrue)
+			})
+
+			Convey("Then it should read a file added later", func() {
+				writeFile("d.jsonl", "{\"int\":4}\n")
+				si.Wait(4)
+				So(ints(), ShouldResemble, []int64{1, 2, 3, 4})
+			})
+		})
+
+		Convey("When reading the directory with processed_dir", func() {
+			params["processed_dir"] = data.String(done)
+			_, stop := run()
+			defer stop()
+			si.Wait(3)
+
+			Convey("Then it should move processed files", func() {
+				So(waitFor(func() bool {
+					return exists(filepath.Join(done, "b.jsonl"))
+				}), ShouldBeTrue)
+				So(exists(filepath.Join(done, "a.jsonl")), ShouldBeTrue)
+				So(exists(filepath.Join(in, "a.jsonl")), ShouldBeFalse)
+			})
+		})
+
+		Convey("When the source is paused", func() {
+			s, stop := run()
+			defer stop()
+			si.Wait(3)
+			So(waitFor(func() bool {
+				return exists(filepath.Join(in, "b.jsonl.processed"))
+			}), ShouldBeTrue)
+			So(s.(core.Resumable).Pause(ctx), ShouldBeNil)
+			writeFile("d.jsonl", "{\"int\":4}\n")
+			time.Sleep(50 * time.Millisecond)
+
+			Convey("Then it should neither read nor mark a new file", func() {
+				So(si.len(), ShouldEqual, 3)
+				So(exists(filepath.Join(in, "d.jsonl")), ShouldBeTrue)
+
+				Convey("And it should read the file after it's resumed", func() {
+					So(s.(core.Resumable).Resume(ctx), ShouldBeNil)
+					si.Wait(4)
+					So(ints(), ShouldResemble, []int64{1, 2, 3, 4})
+				})
+			})
+		})
+
+		Convey("When a file is broken", func() {
+			params["format"] = data.String("msgpack")
+			params["pattern"] = data.String("*.msgpack")
+			writeFile("a.msgpack", "\x01")
+			writeFile("b.msgpack", "\x81\xa3int\x05")
+			_, stop := run()
+			defer stop()
+			si.Wait(1)
+
+			Convey("Then it should skip the broken file and leave it", func() {
+				So(ints(), ShouldResemble, []int64{5})
+				So(waitFor(func() bool {
+					return exists(filepath.Join(in, "b.msgpack.processed"))
+				}), ShouldBeTrue)
+				So(exists(filepath.Join(in, "a.msgpack")), ShouldBeTrue)
+			})
+		})
+
+		Convey("When stopping the source which is paused", func() {
+			s, err := createDirSource(ctx, &IOParams{}, params)
+			So(err, ShouldBeNil)
+			So(s.(core.Resumable).Pause(ctx), ShouldBeNil)
+			ch := make(chan error, 1)
+			go func() {
+				ch <- s.GenerateStream(ctx, si)
+			}()
+			So(s.Stop(ctx), ShouldBeNil)
+
+			Convey("Then it should stop without reading files", func() {
+				So(<-ch, ShouldBeNil)
+				So(si.len(), ShouldEqual, 0)
+			})
+		})
+
+		Convey("When stopping the source while it's writing a tuple", func() {
+			s, err := createDirSource(ctx, &IOParams{}, params)
+			So(err, ShouldBeNil)
+			writing := make(chan struct{})
+			release := make(chan struct{})
+			w := core.WriterFunc(func(ctx *core.Context, t *core.Tuple) error {
+				select {
+				case <-writing:
+				default:
+					close(writing)
+				}
+				<-release
+				return nil
+			})
+			ch := make(chan error, 1)
+			go func() {
+				ch <- s.GenerateStream(ctx, w)
+			}()
+			<-writing
+			stopped := make(chan error, 1)
+			go func() {
+				stopped <- s.Stop(ctx)
+			}()
+			close(release)
+
+			Convey("Then Stop should return after GenerateStream returns", func() {
+				So(<-stopped, ShouldBeNil)
+				select {
+				case err := <-ch:
+					So(err, ShouldBeNil)
+				default:
+					So("GenerateStream is still running", ShouldBeEmpty)
+				}
+			})
+		})
+
+		Convey("When creating a source with invalid parameters", func() {
+			Convey("Then a missing directory should be rejected", func() {
+				params["path"] = data.String(filepath.Join(dir, "missing"))
+				_, err := createDirSource(ctx, &IOParams{}, params)
+				So(err, ShouldNotBeNil)
+			})
+
+			Convey("Then a file should be rejected", func() {
+				params["path"] = data.String(filepath.Join(in, "a.jsonl"))
+				_, err := createDirSource(ctx, &IOParams{}, params)
+				So(err, ShouldNotBeNil)
+			})
+
+			Convey("Then an invalid pattern should be rejected", func() {
+				params["pattern"] = data.String("[")
+				_, err := createDirSource(ctx, &IOParams{}, params)
+				So(err, ShouldNotBeNil)
+			})
+
+			Convey("Then processed_dir same as path should be rejected", func() {
+				params["processed_dir"] = data.String(in)
+				_, err := createDirSource(ctx, &IOParams{}, params)
+				So(err, ShouldNotBeNil)
+			})
+
+			Convey("Then processed_suffix with processed_dir should be rejected", func() {
+				params["processed_dir"] = data.String(done)
+				params["processed_suffix"] = data.String(".done")
+				_, err := createDirSource(ctx, &IOParams{}, params)
+				So(err, ShouldNotBeNil)
+			})
+
+			Convey("Then an empty processed_suffix should be rejected", func() {
+				params["processed_suffix"] = data.String("")
+				_, err := createDirSource(ctx, &IOParams{}, params)
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
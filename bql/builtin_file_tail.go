@@ -0,0 +1,355 @@
+package bql
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+	"os"
+	"time"
+)
+
+const (
+	// tailHeadSize is the maximum size of the beginning of a file used to
+	// check if the file is the one which the offset was saved for.
+	tailHeadSize = 256
+)
+
+// tailOffset is an offset of a file saved by the file source in tail mode.
+// Because the file could be rotated or truncated while the source isn't
+// running, the offset has the checksum of the beginning of the file to
+// check if the file is still the same one.
+type tailOffset struct {
+	// Offset is the byte offset of the end of the record acknowledged last.
+	Offset int64 `json:"offset"`
+
+	// HeadSize is the number of bytes used to compute HeadCRC.
+	HeadSize int `json:"head_size"`
+
+	// HeadCRC is the CRC-32 checksum of the first HeadSize bytes.
+	HeadCRC uint32 `json:"head_crc"`
+}
+
+// readTailOffsetFile reads the offset saved in tail mode. It returns nil when
+// the file doesn't exist.
+func readTailOffsetFile(path string) (*tailOffset, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	o := &tailOffset{}
+	if err := json.Unmarshal(b, o); err != nil {
+		return nil, fmt.Errorf("the offset file '%v' is broken: %v", path, err)
+	}
+	if o.Offset < 0 || o.HeadSize < 0 || o.HeadSize > tailHeadSize {
+		return nil, fmt.Errorf("the offset file '%v' has invalid values", path)
+	}
+	return o, nil
+}
+
+// tailGeneration is a file, or a part of a file after it was truncated,
+// followed by the source in tail mode. BatchIDs of tuples read from the file
+// are offsets in the file plus base so that they always increase even after
+// the file is rotated.
+type tailGeneration struct {
+	base int64
+
+	// head is the first tailHeadSize bytes of the file at most.
+	head []byte
+}
+
+// offset converts the BatchID to the offset in the file.
+func (g *tailGeneration) offset(batchID int64) *tailOffset {
+	o := &tailOffset{
+		Offset: batchID - g.base,
+	}
+	o.HeadSize = len(g.head)
+	if int64(o.HeadSize) > o.Offset {
+		o.HeadSize = int(o.Offset)
+	}
+	o.HeadCRC = crc32.ChecksumIEEE(g.head[:o.HeadSize])
+	return o
+}
+
+// tailReader is an io.Reader following a file like "tail -F". It returns
+// io.EOF when the file is rotated or truncated, or when the source is
+// stopped. Otherwise, it waits for new data at the end of the file.
+//
+// Read returns data up to the next line break at most so that a Decoder
+// doesn't read data beyond the record it decodes as long as the Decoder
+// reads lines. This allows the source to know the offset of the end of each
+// record.
+type tailReader struct {
+	s   *readerSource
+	f   *os.File
+	gen *tailGeneration
+
+	rbuf []byte
+	buf  []byte
+
+	// readPos is the position in the file which the next Read of f reads.
+	readPos int64
+
+	// delivered is the position in the file of the end of data returned
+	// from Read.
+	delivered int64
+
+	// rotated is true when the file was renamed and another file was created
+	// with the same name. draining is true while the reader reads the rest of
+	// the renamed file.
+	rotated  bool
+	draining bool
+
+	truncated bool
+	stopped   bool
+}
+
+func (r *tailReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		n, err := r.f.Read(r.rbuf)
+		if n > 0 {
+			r.buf = r.rbuf[:n]
+			r.s.appendTailHead(r.gen, r.readPos, r.buf)
+			r.readPos += int64(n)
+			break
+		}
+		if err != nil && err != io.EOF {
+			return 0, err
+		}
+		if r.waitForData() {
+			return 0, io.EOF
+		}
+	}
+
+	n := len(r.buf)
+	if i := bytes.IndexByte(r.buf, '\n'); i >= 0 {
+		n = i + 1
+	}
+	n = copy(p, r.buf[:n])
+	r.buf = r.buf[n:]
+	r.delivered += int64(n)
+	return n, nil
+}
+
+// waitForData is called when the reader reached the end of the file. It
+// returns true when the reader shouldn't read the file anymore.
+func (r *tailReader) waitForData() bool {
+	if r.draining {
+		// The rest of the rotated file has been read.
+		return true
+	}
+
+	if cur, err := r.f.Stat(); err == nil {
+		if st, err := os.Stat(r.s.filename); err == nil && !os.SameFile(cur, st) {
+			// Data could be written to the file after the last Read and
+			// before the rotation. So, the file has to be read once more.
+			r.rotated = true
+			r.draining = true
+			return false
+		}
+		// When the file doesn't exist, it was renamed but a new file
+		// hasn't been created yet. The reader keeps waiting in that case.
+
+		// Truncation can only be detected when the file is smaller than
+		// the position which the reader has read.
+		if cur.Size() < r.readPos {
+			r.truncated = true
+			return true
+		}
+	}
+
+	select {
+	case <-r.s.stopCh:
+		r.stopped = true
+		return true
+	case <-time.After(r.s.pollInterval):
+	}
+	return false
+}
+
+// openTailFile opens the file. When the file doesn't exist, it waits until
+// the file is created. It returns nil when the source is stopped.
+func (s *readerSource) openTailFile() (*os.File, error) {
+	for {
+		f, err := os.Open(s.filename)
+		if err == nil {
+			return f, nil
+		}
+		if !os.IsNotExist(err) {
+			return nil, err
+		}
+
+		select {
+		case <-s.stopCh:
+			return nil, nil
+		case <-time.After(s.pollInterval):
+		}
+	}
+}
+
+// resumeTailOffset returns the offset at which the source starts to read the
+// file and the beginning of the file. It returns 0 when the file isn't the one
+// the offset was saved for.
+func (s *readerSource) resumeTailOffset(ctx *core.Context, f *os.File) (int64, []byte, error) {
+	o := s.tailResume
+	s.tailResume = nil
+	if o == nil || o.Offset == 0 {
+		return 0, nil, nil
+	}
+
+	head := make([]byte, tailHeadSize)
+	n, err := io.ReadFull(f, head)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return 0, nil, err
+	}
+	head = head[:n]
+
+	fi, err := f.Stat()
+	if err != nil {
+		return 0, nil, err
+	}
+	if fi.Size() < o.Offset || len(head) < o.HeadSize || crc32.ChecksumIEEE(head[:o.HeadSize]) != o.HeadCRC {
+		ctx.Log().WithField("node_name", s.ioParams.Name).
+			Info("Reading the file from the beginning because it isn't the one which the offset was saved for")
+		return 0, nil, nil
+	}
+
+	if int64(len(head)) > o.Offset {
+		// The rest of the head will be appended when it's read.
+		head = head[:o.Offset]
+	}
+	return o.Offset, head, nil
+}
+
+// tailStream follows the file until the source is stopped.
+func (s *readerSource) tailStream(ctx *core.Context, w core.Writer) error {
+	var (
+		base         int64
+		recordNumber int64
+	)
+	next := time.Now()
+	for {
+		f, err := s.openTailFile()
+		if err != nil {
+			return err
+		}
+		if f == nil {
+			return nil
+		}
+
+		r, err := func() (*tailReader, error) {
+			defer func() {
+				if err := f.Close(); err != nil {
+					ctx.ErrLog(err).WithField("node_name", s.ioParams.Name).
+						Warning("Cannot close the file")
+				}
+			}()
+
+			offset, head, err := s.resumeTailOffset(ctx, f)
+			if err != nil {
+				return nil, err
+			}
+			if _, err := f.Seek(offset, 0); err != nil {
+				return nil, err
+			}
+
+			gen := s.addTailGeneration(base, head)
+			r := &tailReader{
+				s:         s,
+				f:         f,
+				gen:       gen,
+				rbuf:      make([]byte, 64*1024),
+				readPos:   offset,
+				delivered: offset,
+			}
+
+			dec := s.codec.NewDecoder(r)
+			for ; ; recordNumber++ {
+				m, err := dec.Decode()
+				if err == io.EOF {
+					return r, nil
+				}
+				if err != nil {
+					if core.IsFatalError(err) {
+						return nil, err
+					}
+					ctx.ErrLog(err).WithField("node_name", s.ioParams.Name).
+						WithField("record_number", recordNumber).
+						Warning("Ignoring the record due to a parse error")
+					continue
+				}
+
+				t := core.NewTuple(m)
+				t.BatchID = base + r.delivered
+				if err := s.emit(ctx, w, t, recordNumber, &next); err != nil {
+					return nil, err
+				}
+			}
+		}()
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case r.stopped:
+			return nil
+		case r.rotated:
+			ctx.Log().WithField("node_name", s.ioParams.Name).Info("The file was rotated")
+		case r.truncated:
+			ctx.Log().WithField("node_name", s.ioParams.Name).Info("The file was truncated")
+		}
+		// The next file starts after the largest BatchID of this file so
+		// that BatchIDs always increase.
+		base += r.delivered
+	}
+}
+
+func (s *readerSource) addTailGeneration(base int64, head []byte) *tailGeneration {
+	s.tailM.Lock()
+	defer s.tailM.Unlock()
+	g := &tailGeneration{
+		base: base,
+		head: head,
+	}
+	s.tailGens = append(s.tailGens, g)
+	return g
+}
+
+// appendTailHead records data read from the file at the position if it's
+// a part of the beginning of the file.
+func (s *readerSource) appendTailHead(g *tailGeneration, pos int64, b []byte) {
+	if pos >= tailHeadSize {
+		return
+	}
+	s.tailM.Lock()
+	defer s.tailM.Unlock()
+	if int64(len(g.head)) != pos {
+		return
+	}
+	if n := tailHeadSize - len(g.head); len(b) > n {
+		b = b[:n]
+	}
+	g.head = append(g.head, b...)
+}
+
+// tailOffset returns the offset of the file corresponding to the BatchID.
+func (s *readerSource) tailOffset(batchID int64) (*tailOffset, error) {
+	s.tailM.Lock()
+	defer s.tailM.Unlock()
+	for i := len(s.tailGens) - 1; i >= 0; i-- {
+		g := s.tailGens[i]
+		if g.base < batchID {
+			// Generations before this one will never be acknowledged
+			// because acknowledgements are done in the order of BatchIDs.
+			s.tailGens = s.tailGens[i:]
+			return g.offset(batchID), nil
+		}
+	}
+	return nil, fmt.Errorf("the file containing the tuple having the BatchID %v was not found", batchID)
+}
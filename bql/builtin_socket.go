@@ -0,0 +1,458 @@
+package bql
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"io"
+	"net"
+	"sync"
+	"time"
+)
+
+const (
+	// maxUDPDatagramSize is the maximum size of a payload of a UDP datagram.
+	maxUDPDatagramSize = 65507
+)
+
+// extractAddressParameter retrieves 'address' parameter of sources and sinks
+// using sockets.
+func extractAddressParameter(params data.Map) (string, error) {
+	v, ok := params["address"]
+	if !ok {
+		return "", errors.New("'address' parameter is missing")
+	}
+	a, err := data.AsString(v)
+	if err != nil {
+		return "", fmt.Errorf("'address' parameter must be a string: %v", err)
+	}
+	return a, nil
+}
+
+// extractRemoteAddressFieldParameter retrieves 'remote_address_field'
+// parameter which is the path of the field to which the address of the peer
+// is set. It returns nil when the parameter is an empty string.
+func extractRemoteAddressFieldParameter(params data.Map) (data.Path, error) {
+	f := "remote_address"
+	if v, ok := params["remote_address_field"]; ok {
+		s, err := data.AsString(v)
+		if err != nil {
+			return nil, fmt.Errorf("'remote_address_field' parameter must be a string: %v", err)
+		}
+		f = s
+	}
+	if f == "" {
+		return nil, nil
+	}
+	p, err := data.CompilePath(f)
+	if err != nil {
+		return nil, fmt.Errorf("'remote_address_field' parameter doesn't have a valid path: %v", err)
+	}
+	return p, nil
+}
+
+// socketRecordReader decodes records received from a socket and writes them
+// as tuples having the address of the peer.
+type socketRecordReader struct {
+	*readerSource
+	addrField data.Path
+
+	recordM      sync.Mutex
+	recordNumber int64
+	next         time.Time
+}
+
+// readRecords decodes all records in r and writes them to w. It returns nil
+// when r reaches EOF or r has an error which Decoder cannot recover from.
+func (s *socketRecordReader) readRecords(ctx *core.Context, w core.Writer, r io.Reader, addr net.Addr) error {
+	remote := data.String(addr.String())
+	dec := s.codec.NewDecoder(r)
+	for {
+		m, err := dec.Decode()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			if core.IsFatalError(err) {
+				select {
+				case <-s.stopCh:
+					// The socket was closed by Stop.
+				default:
+					ctx.ErrLog(err).WithField("node_name", s.ioParams.Name).
+						WithField("remote_address", remote).Warning("Cannot read records anymore")
+				}
+				return nil
+			}
+			ctx.ErrLog(err).WithField("node_name", s.ioParams.Name).
+				WithField("remote_address", remote).Warning("Ignoring the record due to a parse error")
+			continue
+		}
+
+		if s.addrField != nil {
+			if err := m.Set(s.addrField, remote); err != nil {
+				ctx.ErrLog(err).WithField("node_name", s.ioParams.Name).
+					WithField("remote_address_field", s.addrField).
+					Warning("Cannot set the remote address to the record")
+			}
+		}
+		if err := s.write(ctx, w, core.NewTuple(m)); err != nil {
+			return err
+		}
+	}
+}
+
+// write writes a tuple with emit. Tuples of all connections are serialized
+// so that the interval parameter is applied to the source as a whole.
+func (s *socketRecordReader) write(ctx *core.Context, w core.Writer, t *core.Tuple) error {
+	s.recordM.Lock()
+	defer s.recordM.Unlock()
+	n := s.recordNumber
+	s.recordNumber++
+	return s.emit(ctx, w, t, n, &s.next)
+}
+
+// tcpListenSource accepts TCP connections and reads records from them. Each
+// connection has its own Decoder.
+type tcpListenSource struct {
+	*socketRecordReader
+	l net.Listener
+
+	m       sync.Mutex
+	conns   map[net.Conn]struct{}
+	stopped bool
+
+	// wg waits for goroutines reading records from connections.
+	wg sync.WaitGroup
+}
+
+func (s *tcpListenSource) GenerateStream(ctx *core.Context, w core.Writer) error {
+	errCh := make(chan error, 1)
+	s.next = time.Now()
+	var retErr error
+acceptLoop:
+	for {
+		conn, err := s.l.Accept()
+		if err != nil {
+			if s.isStopped() {
+				break
+			}
+			if ne, ok := err.(net.Error); ok && ne.Temporary() {
+				ctx.ErrLog(err).WithField("node_name", s.ioParams.Name).
+					Warning("Cannot accept a connection")
+				select {
+				case <-s.stopCh:
+					break acceptLoop
+				case <-time.After(100 * time.Millisecond):
+				}
+				continue
+			}
+			retErr = err
+			break
+		}
+		if !s.addConn(conn) {
+			break
+		}
+
+		go func() {
+			defer s.wg.Done()
+			defer s.removeConn(conn)
+			if err := s.readRecords(ctx, w, conn, conn.RemoteAddr()); err != nil {
+				// An error returned from the writer stops the source.
+				select {
+				case errCh <- err:
+				default:
+				}
+				s.stop()
+			}
+		}()
+	}
+
+	// The source also stops without Stop when it fails, so connections
+	// have to be closed here before waiting for their goroutines.
+	s.stop()
+	s.wg.Wait()
+
+	select {
+	case err := <-errCh:
+		return err
+	default:
+		return retErr
+	}
+}
+
+func (s *tcpListenSource) isStopped() bool {
+	s.m.Lock()
+	defer s.m.Unlock()
+	return s.stopped
+}
+
+// addConn adds an accepted connection so that Stop can close it and
+// GenerateStream can wait for the goroutine reading from it. It returns
+// false and closes the connection when the source is already stopped.
+func (s *tcpListenSource) addConn(conn net.Conn) bool {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if s.stopped {
+		conn.Close()
+		return false
+	}
+	s.conns[conn] = struct{}{}
+	s.wg.Add(1)
+	return true
+}
+
+func (s *tcpListenSource) removeConn(conn net.Conn) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	delete(s.conns, conn)
+	conn.Close()
+}
+
+func (s *tcpListenSource) Stop(ctx *core.Context) error {
+	return s.stop()
+}
+
+// stop closes the listener and all open connections so that GenerateStream
+// and goroutines reading from connections return.
+func (s *tcpListenSource) stop() error {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if s.stopped {
+		return nil
+	}
+	s.stopped = true
+	close(s.stopCh)
+	err := s.l.Close()
+	for conn := range s.conns {
+		conn.Close()
+	}
+	return err
+}
+
+func (s *tcpListenSource) Status() data.Map {
+	s.m.Lock()
+	defer s.m.Unlock()
+	return data.Map{
+		"address":         data.String(s.l.Addr().String()),
+		"num_connections": data.Int(len(s.conns)),
+	}
+}
+
+// udpListenSource reads records from UDP datagrams. Each datagram is decoded
+// separately, so a record must not be split into multiple datagrams.
+type udpListenSource struct {
+	*socketRecordReader
+	conn net.PacketConn
+
+	stopOnce sync.Once
+}
+
+func (s *udpListenSource) GenerateStream(ctx *core.Context, w core.Writer) error {
+	buf := make([]byte, maxUDPDatagramSize)
+	s.next = time.Now()
+	for {
+		n, addr, err := s.conn.ReadFrom(buf)
+		if err != nil {
+			select {
+			case <-s.stopCh:
+				return nil
+			default:
+			}
+			if ne, ok := err.(net.Error); ok && ne.Temporary() {
+				ctx.ErrLog(err).WithField("node_name", s.ioParams.Name).
+					Warning("Cannot receive a datagram")
+				continue
+			}
+			return err
+		}
+
+		// The datagram is copied because a Decoder could refer to the buffer
+		// after it decodes records.
+		b := make([]byte, n)
+		copy(b, buf[:n])
+		if err := s.readRecords(ctx, w, bytes.NewReader(b), addr); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *udpListenSource) Stop(ctx *core.Context) error {
+	var err error
+	s.stopOnce.Do(func() {
+		close(s.stopCh)
+		err = s.conn.Close()
+	})
+	return err
+}
+
+func (s *udpListenSource) Status() data.Map {
+	return data.Map{
+		"address": data.String(s.conn.LocalAddr().String()),
+	}
+}
+
+// newSocketRecordReader creates a socketRecordReader having parameters of
+// newReaderSource and remote_address_field.
+func newSocketRecordReader(ioParams *IOParams, params data.Map) (*socketRecordReader, error) {
+	rs, err := newReaderSource(ioParams, params)
+	if err != nil {
+		return nil, err
+	}
+	addrField, err := extractRemoteAddressFieldParameter(params)
+	if err != nil {
+		return nil, err
+	}
+	return &socketRecordReader{
+		readerSource: rs,
+		addrField:    addrField,
+	}, nil
+}
+
+// createTCPListenSource creates a source listening on a TCP address. In
+// addition to the parameters of newReaderSource, it supports the following
+// parameters:
+//
+//	- address: the address to listen on, e.g. ":9000"
+//	- remote_address_field: the path of the field having the address of the
+//	  peer (default: "remote_address"). An empty string disables it.
+func createTCPListenSource(ctx *core.Context, ioParams *IOParams, params data.Map) (core.Source, error) {
+	addr, err := extractAddressParameter(params)
+	if err != nil {
+		return nil, err
+	}
+	r, err := newSocketRecordReader(ioParams, params)
+	if err != nil {
+		return nil, err
+	}
+
+	// The address is listened on here so that an error such as "address
+	// already in use" is reported when the source is created.
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	return core.ImplementSourceStop(&tcpListenSource{
+		socketRecordReader: r,
+		l:                  l,
+		conns:              map[net.Conn]struct{}{},
+	}), nil
+}
+
+// createUDPListenSource creates a source receiving UDP datagrams. It has the
+// same parameters as createTCPListenSource.
+func createUDPListenSource(ctx *core.Context, ioParams *IOParams, params data.Map) (core.Source, error) {
+	addr, err := extractAddressParameter(params)
+	if err != nil {
+		return nil, err
+	}
+	r, err := newSocketRecordReader(ioParams, params)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := net.ListenPacket("udp", addr)
+	if err != nil {
+		return nil, err
+	}
+	return core.ImplementSourceStop(&udpListenSource{
+		socketRecordReader: r,
+		conn:               conn,
+	}), nil
+}
+
+func init() {
+	MustRegisterGlobalSourceCreator("tcp_listen", SourceCreatorFunc(createTCPListenSource))
+	MustRegisterGlobalSourceCreator("udp_listen", SourceCreatorFunc(createUDPListenSource))
+}
+
+// socketSink writes records to a TCP or UDP address. It connects to the
+// address when it writes the first tuple and reconnects after the connection
+// has an error. Errors of the connection are returned as temporary errors so
+// that the sink can retry writing the tuple with on_error parameters.
+//
+// A record is encoded into a buffer first so that a tuple which cannot be
+// encoded doesn't break the connection. When the network is UDP, each record
+// is sent as a datagram.
+type socketSink struct {
+	network string
+	address string
+
+	m    sync.Mutex
+	conn net.Conn
+	buf  *bytes.Buffer
+	enc  Encoder
+}
+
+func (s *socketSink) Write(ctx *core.Context, t *core.Tuple) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	s.buf.Reset()
+	if err := s.enc.Encode(t.Data); err != nil {
+		return err
+	}
+	if s.network == "udp" && s.buf.Len() > maxUDPDatagramSize {
+		return fmt.Errorf("the record is too large to be sent as a datagram: %v bytes", s.buf.Len())
+	}
+
+	if s.conn == nil {
+		conn, err := net.Dial(s.network, s.address)
+		if err != nil {
+			return core.TemporaryError(err)
+		}
+		s.conn = conn
+	}
+	if _, err := s.conn.Write(s.buf.Bytes()); err != nil {
+		s.conn.Close()
+		s.conn = nil
+		return core.TemporaryError(err)
+	}
+	return nil
+}
+
+func (s *socketSink) Close(ctx *core.Context) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if s.conn == nil {
+		return nil
+	}
+	err := s.conn.Close()
+	s.conn = nil
+	return err
+}
+
+// createSocketSinkCreator returns a SinkCreator creating a sink writing
+// records to the network. The sink has the following parameters in addition
+// to "format" and its parameters:
+//
+//	- address: the address to which records are sent, e.g. "localhost:9000"
+func createSocketSinkCreator(network string) SinkCreator {
+	return SinkCreatorFunc(func(ctx *core.Context, ioParams *IOParams, params data.Map) (core.Sink, error) {
+		addr, err := extractAddressParameter(params)
+		if err != nil {
+			return nil, err
+		}
+		if _, _, err := net.SplitHostPort(addr); err != nil {
+			return nil, fmt.Errorf("'address' parameter must have a host and a port: %v", err)
+		}
+		codec, err := createCodec(params, "jsonl")
+		if err != nil {
+			return nil, err
+		}
+
+		buf := bytes.NewBuffer(nil)
+		return &socketSink{
+			network: network,
+			address: addr,
+			buf:     buf,
+			enc:     codec.NewEncoder(buf),
+		}, nil
+	})
+}
+
+func init() {
+	MustRegisterGlobalSinkCreator("tcp", createSocketSinkCreator("tcp"))
+	MustRegisterGlobalSinkCreator("udp", createSocketSinkCreator("udp"))
+}
@@ -0,0 +1,330 @@
+package bql
+
+import (
+	"bufio"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"io"
+	"net"
+	"sync"
+	"testing"
+	"time"
+)
+
+// socketSourceAddress returns the address on which the source is listening.
+func socketSourceAddress(s core.Source) string {
+	st := s.(core.Statuser).Status()
+	addr, _ := data.AsString(st["internal_source"].(data.Map)["address"])
+	return addr
+}
+
+func TestTCPListenSource(t *testing.T) {
+	Convey("Given a tcp_listen source", t, func() {
+		ctx := core.NewContext(nil)
+		params := data.Map{
+			"address": data.String("127.0.0.1:0"),
+		}
+		si := &tupleCollectorSink{}
+		si.c = sync.NewCond(&si.m)
+		run := func() (string, func()) {
+			s, err := createTCPListenSource(ctx, &IOParams{}, params)
+			So(err, ShouldBeNil)
+			ch := make(chan error, 1)
+			go func() {
+				ch <- s.GenerateStream(ctx, si)
+			}()
+			return socketSourceAddress(s), func() {
+				So(s.Stop(ctx), ShouldBeNil)
+				So(<-ch, ShouldBeNil)
+			}
+		}
+
+		Convey("When sending records from two connections", func() {
+			addr, stop := run()
+			defer stop()
+			c1, err := net.Dial("tcp", addr)
+			So(err, ShouldBeNil)
+			defer c1.Close()
+			c2, err := net.Dial("tcp", addr)
+			So(err, ShouldBeNil)
+			defer c2.Close()
+
+			_, err = io.WriteString(c1, "{\"int\":1}\n{\"int\":\n")
+			So(err, ShouldBeNil)
+			si.Wait(1)
+			_, err = io.WriteString(c2, "{\"int\":2}\n")
+			So(err, ShouldBeNil)
+			si.Wait(2)
+
+			Convey("Then tuples should have the remote addresses", func() {
+				So(si.get(0).Data, ShouldResemble, data.Map{
+					"int":            data.Int(1),
+					"remote_address": data.String(c1.LocalAddr().String()),
+				})
+				So(si.get(1).Data, ShouldResemble, data.Map{
+					"int":            data.Int(2),
+					"remote_address": data.String(c2.LocalAddr().String()),
+				})
+			})
+
+			Convey("Then the broken record should be ignored", func() {
+				_, err = io.WriteString(c1, "{\"int\":3}\n")
+				So(err, ShouldBeNil)
+				si.Wait(3)
+				i, _ := si.get(2).Data.Get(data.MustCompilePath("int"))
+				So(i, ShouldEqual, data.Int(3))
+			})
+		})
+
+		Convey("When sending records with a custom remote_address_field", func() {
+			params["remote_address_field"] = data.String("meta.addr")
+			params["format"] = data.String("raw")
+			addr, stop := run()
+			defer stop()
+			c, err := net.Dial("tcp", addr)
+			So(err, ShouldBeNil)
+			defer c.Close()
+			_, err = io.WriteString(c, "a b\n")
+			So(err, ShouldBeNil)
+			si.Wait(1)
+
+			Convey("Then the address should be set to the field", func() {
+				So(si.get(0).Data, ShouldResemble, data.Map{
+					"line": data.String("a b"),
+					"meta": data.Map{
+						"addr": data.String(c.LocalAddr().String()),
+					},
+				})
+			})
+		})
+
+		Convey("When disabling remote_address_field", func() {
+			params["remote_address_field"] = data.String("")
+			addr, stop := run()
+			defer stop()
+			c, err := net.Dial("tcp", addr)
+			So(err, ShouldBeNil)
+			defer c.Close()
+			_, err = io.WriteString(c, "{\"int\":1}\n")
+			So(err, ShouldBeNil)
+			si.Wait(1)
+
+			Convey("Then tuples shouldn't have the address", func() {
+				So(si.get(0).Data, ShouldResemble, data.Map{"int": data.Int(1)})
+			})
+		})
+
+		Convey("When stopping the source having a connection", func() {
+			addr, stop := run()
+			c, err := net.Dial("tcp", addr)
+			So(err, ShouldBeNil)
+			defer c.Close()
+			_, err = io.WriteString(c, "{\"int\":1}\n")
+			So(err, ShouldBeNil)
+			si.Wait(1)
+			stop()
+
+			Convey("Then the connection should be closed", func() {
+				_, err := bufio.NewReader(c).ReadByte()
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When the writer fails while the source has two connections", func() {
+			s, err := createTCPListenSource(ctx, &IOParams{}, params)
+			So(err, ShouldBeNil)
+			ch := make(chan error, 1)
+			go func() {
+				ch <- s.GenerateStream(ctx, &failingSink{})
+			}()
+			addr := socketSourceAddress(s)
+			c1, err := net.Dial("tcp", addr)
+			So(err, ShouldBeNil)
+			defer c1.Close()
+			c2, err := net.Dial("tcp", addr)
+			So(err, ShouldBeNil)
+			defer c2.Close()
+			for s.(core.Statuser).Status()["internal_source"].(data.Map)["num_connections"] != data.Int(2) {
+				time.Sleep(time.Millisecond)
+			}
+			_, err = io.WriteString(c1, "{\"int\":1}\n")
+			So(err, ShouldBeNil)
+
+			Convey("Then the source should stop with the error", func() {
+				So(<-ch, ShouldNotBeNil)
+
+				Convey("And the other connection should be closed", func() {
+					_, err := bufio.NewReader(c2).ReadByte()
+					So(err, ShouldNotBeNil)
+					So(s.Stop(ctx), ShouldBeNil)
+				})
+			})
+		})
+
+		Convey("When creating a source with invalid parameters", func() {
+			Convey("Then a missing address should be rejected", func() {
+				delete(params, "address")
+				_, err := createTCPListenSource(ctx, &IOParams{}, params)
+				So(err, ShouldNotBeNil)
+			})
+
+			Convey("Then an address in use should be rejected", func() {
+				l, err := net.Listen("tcp", "127.0.0.1:0")
+				So(err, ShouldBeNil)
+				defer l.Close()
+				params["address"] = data.String(l.Addr().String())
+				_, err = createTCPListenSource(ctx, &IOParams{}, params)
+				So(err, ShouldNotBeNil)
+			})
+
+			Convey("Then an invalid remote_address_field should be rejected", func() {
+				params["remote_address_field"] = data.Int(1)
+				_, err := createTCPListenSource(ctx, &IOParams{}, params)
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestUDPListenSource(t *testing.T) {
+	Convey("Given a udp_listen source", t, func() {
+		ctx := core.NewContext(nil)
+		s, err := createUDPListenSource(ctx, &IOParams{}, data.Map{
+			"address": data.String("127.0.0.1:0"),
+		})
+		So(err, ShouldBeNil)
+		si := &tupleCollectorSink{}
+		si.c = sync.NewCond(&si.m)
+		ch := make(chan error, 1)
+		go func() {
+			ch <- s.GenerateStream(ctx, si)
+		}()
+		Reset(func() {
+			So(s.Stop(ctx), ShouldBeNil)
+			So(<-ch, ShouldBeNil)
+		})
+
+		Convey("When sending a datagram having two records", func() {
+			c, err := net.Dial("udp", socketSourceAddress(s))
+			So(err, ShouldBeNil)
+			defer c.Close()
+			_, err = io.WriteString(c, "{\"int\":1}\n{\"int\":2}")
+			So(err, ShouldBeNil)
+			si.Wait(2)
+
+			Convey("Then both records should have the remote address", func() {
+				for i := 0; i < 2; i++ {
+					So(si.get(i).Data, ShouldResemble, data.Map{
+						"int":            data.Int(i + 1),
+						"remote_address": data.String(c.LocalAddr().String()),
+					})
+				}
+			})
+		})
+	})
+}
+
+func TestSocketSink(t *testing.T) {
+	ctx := core.NewContext(nil)
+
+	Convey("Given a tcp sink connecting to a listener", t, func() {
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		So(err, ShouldBeNil)
+		defer l.Close()
+		si, err := createSocketSinkCreator("tcp").CreateSink(ctx, &IOParams{}, data.Map{
+			"address": data.String(l.Addr().String()),
+		})
+		So(err, ShouldBeNil)
+		defer si.Close(ctx)
+
+		Convey("When writing tuples", func() {
+			So(si.Write(ctx, core.NewTuple(data.Map{"int": data.Int(1)})), ShouldBeNil)
+			So(si.Write(ctx, core.NewTuple(data.Map{"int": data.Int(2)})), ShouldBeNil)
+
+			Convey("Then the listener should receive JSON Lines", func() {
+				c, err := l.Accept()
+				So(err, ShouldBeNil)
+				defer c.Close()
+				r := bufio.NewReader(c)
+				for _, exp := range []string{"{\"int\":1}\n", "{\"int\":2}\n"} {
+					line, err := r.ReadString('\n')
+					So(err, ShouldBeNil)
+					So(line, ShouldEqual, exp)
+				}
+			})
+		})
+
+		Convey("When writing a tuple which cannot be encoded", func() {
+			si, err := createSocketSinkCreator("tcp").CreateSink(ctx, &IOParams{}, data.Map{
+				"address": data.String(l.Addr().String()),
+				"format":  data.String("raw"),
+			})
+			So(err, ShouldBeNil)
+			defer si.Close(ctx)
+			err = si.Write(ctx, core.NewTuple(data.Map{"int": data.Int(1)}))
+
+			Convey("Then it should fail with a non-temporary error", func() {
+				So(err, ShouldNotBeNil)
+				So(core.IsTemporaryError(err), ShouldBeFalse)
+			})
+		})
+	})
+
+	Convey("Given a tcp sink without a listener", t, func() {
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		So(err, ShouldBeNil)
+		addr := l.Addr().String()
+		So(l.Close(), ShouldBeNil)
+		si, err := createSocketSinkCreator("tcp").CreateSink(ctx, &IOParams{}, data.Map{
+			"address": data.String(addr),
+		})
+		So(err, ShouldBeNil)
+		defer si.Close(ctx)
+
+		Convey("When writing a tuple", func() {
+			err := si.Write(ctx, core.NewTuple(data.Map{"int": data.Int(1)}))
+
+			Convey("Then it should fail with a temporary error", func() {
+				So(err, ShouldNotBeNil)
+				So(core.IsTemporaryError(err), ShouldBeTrue)
+			})
+		})
+	})
+
+	Convey("Given a udp sink", t, func() {
+		conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+		So(err, ShouldBeNil)
+		defer conn.Close()
+		si, err := createSocketSinkCreator("udp").CreateSink(ctx, &IOParams{}, data.Map{
+			"address": data.String(conn.LocalAddr().String()),
+		})
+		So(err, ShouldBeNil)
+		defer si.Close(ctx)
+
+		Convey("When writing a tuple", func() {
+			So(si.Write(ctx, core.NewTuple(data.Map{"int": data.Int(1)})), ShouldBeNil)
+
+			Convey("Then it should be sent as a datagram", func() {
+				buf := make([]byte, 1024)
+				n, _, err := conn.ReadFrom(buf)
+				So(err, ShouldBeNil)
+				So(string(buf[:n]), ShouldEqual, "{\"int\":1}\n")
+			})
+		})
+	})
+
+	Convey("Given invalid parameters of a socket sink", t, func() {
+		c := createSocketSinkCreator("tcp")
+
+		Convey("Then a missing address should be rejected", func() {
+			_, err := c.CreateSink(ctx, &IOParams{}, data.Map{})
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("Then an address without a port should be rejected", func() {
+			_, err := c.CreateSink(ctx, &IOParams{}, data.Map{"address": data.String("localhost")})
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
@@ -8,6 +8,7 @@ import (
 	"io"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"sync"
 	"testing"
 	"time"
@@ -18,6 +19,7 @@ type testFileWriter struct {
 	c   *sync.Cond
 	cnt int
 	tss []time.Time
+	ids []int64
 }
 
 func (w *testFileWriter) Write(ctx *core.Context, t *core.Tuple) error {
@@ -25,6 +27,7 @@ func (w *testFileWriter) Write(ctx *core.Context, t *core.Tuple) error {
 	defer w.m.Unlock()
 	w.cnt++
 	w.tss = append(w.tss, t.Timestamp)
+	w.ids = append(w.ids, t.BatchID)
 	w.c.Broadcast()
 	return nil
 }
@@ -216,6 +219,63 @@ func TestFileSource(t *testing.T) {
 			})
 		})
 
+		Convey("When reading the file with an offset_file parameter", func() {
+			offsetFile := name + ".offset"
+			params["offset_file"] = data.String(offsetFile)
+			Reset(func() {
+				os.Remove(offsetFile)
+			})
+
+			Convey("Then the source should be ackable", func() {
+				s, err := createFileSource(ctx, &IOParams{}, params)
+				So(err, ShouldBeNil)
+				So(s, ShouldImplement, (*core.AckableSource)(nil))
+			})
+
+			Convey("Then it should emit all tuples when the offset file doesn't exist", func() {
+				s, err := createFileSource(ctx, &IOParams{}, params)
+				So(err, ShouldBeNil)
+				So(s.GenerateStream(ctx, w), ShouldBeNil)
+				So(w.cnt, ShouldEqual, 3)
+			})
+
+			Convey("Then Ack should save the offset to the file", func() {
+				s, err := createFileSource(ctx, &IOParams{}, params)
+				So(err, ShouldBeNil)
+				So(s.(core.AckableSource).Ack(ctx, 2), ShouldBeNil)
+				b, err := ioutil.ReadFile(offsetFile)
+				So(err, ShouldBeNil)
+				So(string(b), ShouldEqual, "2")
+
+				Convey("And a new source should resume after the acknowledged record", func() {
+					s, err := createFileSource(ctx, &IOParams{}, params)
+					So(err, ShouldBeNil)
+					So(s.GenerateStream(ctx, w), ShouldBeNil)
+					So(w.cnt, ShouldEqual, 1)
+				})
+			})
+
+			Convey("Then it should emit tuples having BatchIDs of their record numbers", func() {
+				s, err := createFileSource(ctx, &IOParams{}, params)
+				So(err, ShouldBeNil)
+				So(s.GenerateStream(ctx, w), ShouldBeNil)
+				// the empty line isn't a record
+				So(w.ids, ShouldResemble, []int64{1, 2, 3})
+			})
+
+			Convey("Then it should fail with a repeat parameter", func() {
+				params["repeat"] = data.Int(1)
+				_, err := createFileSource(ctx, &IOParams{}, params)
+				So(err, ShouldNotBeNil)
+			})
+
+			Convey("Then it should fail with a broken offset file", func() {
+				So(ioutil.WriteFile(offsetFile, []byte("a"), 0644), ShouldBeNil)
+				_, err := createFileSource(ctx, &IOParams{}, params)
+				So(err, ShouldNotBeNil)
+			})
+		})
+
 		Convey("When creating a file source with invalid parameters", func() {
 			Convey("Then missing path parameter should result in an error", func() {
 				delete(params, "path")
@@ -255,3 +315,409 @@ func TestFileSource(t *testing.T) {
 		})
 	})
 }
+
+func TestFileSourceWithFormat(t *testing.T) {
+	f, err := ioutil.TempFile("", "sbtest_bql_file_source_csv")
+	if err != nil {
+		t.Fatal("Cannot create a temp file:", err)
+	}
+	name := f.Name()
+	defer func() {
+		os.Remove(name)
+	}()
+	_, err = io.WriteString(f, "int,str\n1,a\n2,\"b,c\"\n3,d\n")
+	f.Close()
+	if err != nil {
+		t.Fatal("Cannot write to the temp file:", err)
+	}
+
+	Convey("Given a CSV file", t, func() {
+		ctx := core.NewContext(nil)
+		params := data.Map{
+			"path":   data.String(name),
+			"format": data.String("csv"),
+			"header": data.True,
+		}
+		si := &tupleCollectorSink{}
+		si.c = sync.NewCond(&si.m)
+
+		Convey("When reading the file by file source", func() {
+			s, err := createFileSource(ctx, &IOParams{}, params)
+			So(err, ShouldBeNil)
+			So(s.GenerateStream(ctx, si), ShouldBeNil)
+
+			Convey("Then it should emit all records", func() {
+				So(si.len(), ShouldEqual, 3)
+				So(si.get(1).Data, ShouldResemble, data.Map{
+					"int": data.String("2"),
+					"str": data.String("b,c"),
+				})
+			})
+		})
+
+		Convey("When reading the file with an offset_file parameter", func() {
+			offsetFile := name + ".offset"
+			params["offset_file"] = data.String(offsetFile)
+			Reset(func() {
+				os.Remove(offsetFile)
+			})
+			So(ioutil.WriteFile(offsetFile, []byte("2"), 0644), ShouldBeNil)
+
+			s, err := createFileSource(ctx, &IOParams{}, params)
+			So(err, ShouldBeNil)
+			So(s.GenerateStream(ctx, si), ShouldBeNil)
+
+			Convey("Then it should skip the header and acknowledged records", func() {
+				So(si.len(), ShouldEqual, 1)
+				So(si.get(0).Data["int"], ShouldEqual, data.String("3"))
+				So(si.get(0).BatchID, ShouldEqual, 3)
+			})
+		})
+
+		Convey("When creating a file source with an unknown format", func() {
+			params["format"] = data.String("xml")
+			_, err := createFileSource(ctx, &IOParams{}, params)
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When creating a file source with an invalid format parameter", func() {
+			params["delimiter"] = data.String("ab")
+			_, err := createFileSource(ctx, &IOParams{}, params)
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestFileSink(t *testing.T) {
+	f, err := ioutil.TempFile("", "sbtest_bql_file_sink")
+	if err != nil {
+		t.Fatal("Cannot create a temp file:", err)
+	}
+	name := f.Name()
+	f.Close()
+	defer func() {
+		os.Remove(name)
+	}()
+
+	Convey("Given a file sink", t, func() {
+		ctx := core.NewContext(nil)
+		params := data.Map{
+			"path":     data.String(name),
+			"truncate": data.True,
+		}
+		write := func(ms ...data.Map) {
+			s, err := createFileSink(ctx, &IOParams{}, params)
+			So(err, ShouldBeNil)
+			for _, m := range ms {
+				So(s.Write(ctx, core.NewTuple(m)), ShouldBeNil)
+			}
+			So(s.Close(ctx), ShouldBeNil)
+		}
+		read := func() string {
+			b, err := ioutil.ReadFile(name)
+			So(err, ShouldBeNil)
+			return string(b)
+		}
+
+		Convey("When writing tuples with the default format", func() {
+			write(data.Map{"a": data.Int(1)}, data.Map{"a": data.Int(2)})
+
+			Convey("Then the file should have JSON Lines", func() {
+				So(read(), ShouldEqual, "{\"a\":1}\n{\"a\":2}\n")
+			})
+		})
+
+		Convey("When writing tuples in CSV with a header", func() {
+			params["format"] = data.String("csv")
+			params["header"] = data.True
+			write(data.Map{"a": data.Int(1), "b": data.String("x")})
+
+			Convey("Then the file should have the header and records", func() {
+				So(read(), ShouldEqual, "a,b\n1,x\n")
+			})
+
+			Convey("And appending tuples to the file", func() {
+				delete(params, "truncate")
+				write(data.Map{"a": data.Int(2), "b": data.String("y")})
+
+				Convey("Then the header shouldn't be written again", func() {
+					So(read(), ShouldEqual, "a,b\n1,x\n2,y\n")
+				})
+			})
+		})
+
+		Convey("When writing tuples in TSV", func() {
+			params["format"] = data.String("tsv")
+			params["columns"] = data.Array{data.String("b"), data.String("a")}
+			write(data.Map{"a": data.Int(1), "b": data.String("x")})
+
+			Convey("Then the file should have tab separated records", func() {
+				So(read(), ShouldEqual, "x\t1\n")
+			})
+		})
+
+		Convey("When writing tuples in msgpack", func() {
+			params["format"] = data.String("msgpack")
+			write(data.Map{"a": data.Int(1)}, data.Map{"a": data.Int(2)})
+
+			Convey("Then the file source should read them", func() {
+				s, err := createFileSource(ctx, &IOParams{}, params)
+				So(err, ShouldBeNil)
+				si := &tupleCollectorSink{}
+				si.c = sync.NewCond(&si.m)
+				So(s.GenerateStream(ctx, si), ShouldBeNil)
+				So(si.len(), ShouldEqual, 2)
+				So(si.get(1).Data, ShouldResemble, data.Map{"a": data.Int(2)})
+			})
+		})
+
+		Convey("When creating a file sink with an unknown format", func() {
+			params["format"] = data.String("xml")
+			_, err := createFileSink(ctx, &IOParams{}, params)
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestFileSourceTail(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sbtest_bql_file_source_tail")
+	if err != nil {
+		t.Fatal("Cannot create a temp directory:", err)
+	}
+	defer os.RemoveAll(dir)
+	name := filepath.Join(dir, "log")
+	offsetFile := filepath.Join(dir, "offset")
+
+	appendLines := func(lines string) {
+		f, err := os.OpenFile(name, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+		So(err, ShouldBeNil)
+		defer f.Close()
+		_, err = io.WriteString(f, lines)
+		So(err, ShouldBeNil)
+	}
+
+	Convey("Given a file followed by file source in tail mode", t, func() {
+		ctx := core.NewContext(nil)
+		Reset(func() {
+			os.Remove(name)
+			os.Remove(name + ".1")
+			os.Remove(offsetFile)
+		})
+		appendLines("{\"int\":1}\n{\"int\":2}\n")
+
+		params := data.Map{
+			"path":          data.String(name),
+			"tail":          data.True,
+			"poll_interval": data.Float(0.001),
+			"offset_file":   data.String(offsetFile),
+		}
+		si := &tupleCollectorSink{}
+		si.c = sync.NewCond(&si.m)
+		s, err := createFileSource(ctx, &IOParams{}, params)
+		So(err, ShouldBeNil)
+
+		ch := make(chan error, 1)
+		go func() {
+			ch <- s.GenerateStream(ctx, si)
+		}()
+		stop := func() {
+			So(s.Stop(ctx), ShouldBeNil)
+			So(<-ch, ShouldBeNil)
+		}
+
+		Convey("When reading the existing lines", func() {
+			si.Wait(2)
+
+			Convey("Then it should keep running", func() {
+				select {
+				case <-ch:
+					So("The source should not have stopped yet", ShouldBeNil)
+				default:
+				}
+				stop()
+			})
+
+			Convey("Then it should have BatchIDs of byte offsets", func() {
+				So(si.get(0).BatchID, ShouldEqual, 10)
+				So(si.get(1).BatchID, ShouldEqual, 20)
+				stop()
+			})
+		})
+
+		Convey("When appending lines to the file", func() {
+			si.Wait(2)
+			appendLines("{\"int\":3}\n{\"int\"")
+			si.Wait(3)
+			appendLines(":4}\n")
+			si.Wait(4)
+			stop()
+
+			Convey("Then it should emit the appended lines", func() {
+				So(si.len(), ShouldEqual, 4)
+				So(si.get(2).Data["int"], ShouldEqual, data.Int(3))
+				So(si.get(3).Data["int"], ShouldEqual, data.Int(4))
+			})
+		})
+
+		Convey("When the file is truncated", func() {
+			si.Wait(2)
+			So(os.Truncate(name, 0), ShouldBeNil)
+			time.Sleep(10 * time.Millisecond)
+			appendLines("{\"int\":3}\n")
+			si.Wait(3)
+			stop()
+
+			Convey("Then it should read the file from the beginning", func() {
+				So(si.get(2).Data["int"], ShouldEqual, data.Int(3))
+
+				Convey("And BatchIDs should still increase", func() {
+					So(si.get(2).BatchID, ShouldBeGreaterThan, si.get(1).BatchID)
+				})
+			})
+		})
+
+		Convey("When the file is rotated", func() {
+			si.Wait(2)
+			So(os.Rename(name, name+".1"), ShouldBeNil)
+			appendLines("{\"int\":3}\n")
+			si.Wait(3)
+			stop()
+
+			Convey("Then it should follow the new file", func() {
+				So(si.get(2).Data["int"], ShouldEqual, data.Int(3))
+				So(si.get(2).BatchID, ShouldEqual, 30)
+			})
+		})
+
+		Convey("When tuples are acknowledged", func() {
+			si.Wait(2)
+			So(s.(core.AckableSource).Ack(ctx, si.get(0).BatchID), ShouldBeNil)
+			stop()
+
+			Convey("Then the offset file should have the byte offset", func() {
+				o, err := readTailOffsetFile(offsetFile)
+				So(err, ShouldBeNil)
+				So(o.Offset, ShouldEqual, 10)
+				So(o.HeadSize, ShouldEqual, 10)
+			})
+
+			Convey("And restarting the source", func() {
+				appendLines("{\"int\":3}\n")
+				si2 := &tupleCollectorSink{}
+				si2.c = sync.NewCond(&si2.m)
+				s, err := createFileSource(ctx, &IOParams{}, params)
+				So(err, ShouldBeNil)
+				ch := make(chan error, 1)
+				go func() {
+					ch <- s.GenerateStream(ctx, si2)
+				}()
+				si2.Wait(2)
+				So(s.Stop(ctx), ShouldBeNil)
+				So(<-ch, ShouldBeNil)
+
+				Convey("Then it should resume after the acknowledged line", func() {
+					So(si2.len(), ShouldEqual, 2)
+					So(si2.get(0).Data["int"], ShouldEqual, data.Int(2))
+					So(si2.get(1).Data["int"], ShouldEqual, data.Int(3))
+					So(si2.get(0).BatchID, ShouldEqual, 20)
+				})
+			})
+
+			Convey("And restarting the source after the file was replaced", func() {
+				So(os.Remove(name), ShouldBeNil)
+				appendLines("{\"int\":5}\n{\"int\":6}\n")
+				si2 := &tupleCollectorSink{}
+				si2.c = sync.NewCond(&si2.m)
+				s, err := createFileSource(ctx, &IOParams{}, params)
+				So(err, ShouldBeNil)
+				ch := make(chan error, 1)
+				go func() {
+					ch <- s.GenerateStream(ctx, si2)
+				}()
+				si2.Wait(2)
+				So(s.Stop(ctx), ShouldBeNil)
+				So(<-ch, ShouldBeNil)
+
+				Convey("Then it should read the new file from the beginning", func() {
+					So(si2.get(0).Data["int"], ShouldEqual, data.Int(5))
+				})
+			})
+		})
+	})
+
+	Convey("Given a file source in tail mode with a missing file", t, func() {
+		ctx := core.NewContext(nil)
+		Reset(func() {
+			os.Remove(name)
+		})
+		s, err := createFileSource(ctx, &IOParams{}, data.Map{
+			"path":          data.String(name),
+			"tail":          data.True,
+			"poll_interval": data.Float(0.001),
+		})
+		So(err, ShouldBeNil)
+		si := &tupleCollectorSink{}
+		si.c = sync.NewCond(&si.m)
+		ch := make(chan error, 1)
+		go func() {
+			ch <- s.GenerateStream(ctx, si)
+		}()
+
+		Convey("When the file is created", func() {
+			appendLines("{\"int\":1}\n")
+
+			Convey("Then the source should read it", func() {
+				si.Wait(1)
+				So(s.Stop(ctx), ShouldBeNil)
+				So(<-ch, ShouldBeNil)
+			})
+		})
+	})
+
+	Convey("Given invalid parameters for tail mode", t, func() {
+		ctx := core.NewContext(nil)
+		params := data.Map{
+			"path": data.String(name),
+			"tail": data.True,
+		}
+
+		Convey("Then tail with repeat should result in an error", func() {
+			params["repeat"] = data.Int(1)
+			_, err := createFileSource(ctx, &IOParams{}, params)
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("Then tail with rewindable should result in an error", func() {
+			params["rewindable"] = data.True
+			_, err := createFileSource(ctx, &IOParams{}, params)
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("Then invalid tail value should result in an error", func() {
+			params["tail"] = data.Int(1)
+			_, err := createFileSource(ctx, &IOParams{}, params)
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("Then tail with a format not based on lines should result in an error", func() {
+			params["format"] = data.String("msgpack")
+			_, err := createFileSource(ctx, &IOParams{}, params)
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("Then non-positive poll_interval should result in an error", func() {
+			params["poll_interval"] = data.Int(0)
+			_, err := createFileSource(ctx, &IOParams{}, params)
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
@@ -0,0 +1,179 @@
+package bql
+
+import (
+	"errors"
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"io"
+	"strings"
+	"sync"
+)
+
+// Decoder reads records from an io.Reader and converts them into data.Map.
+type Decoder interface {
+	// Decode reads the next record. It returns io.EOF when there's no more
+	// record. When a record is broken, Decode returns an error and the next
+	// call of Decode reads the record after it. When the Decoder cannot
+	// continue reading records (e.g. due to an I/O error), Decode returns a
+	// fatal error (i.e. core.IsFatalError(err) == true).
+	Decode() (data.Map, error)
+}
+
+// Encoder converts data.Map into records and writes them to an io.Writer.
+type Encoder interface {
+	// Encode writes a record converted from the given map. The record must
+	// be written to the io.Writer before Encode returns.
+	Encode(m data.Map) error
+}
+
+// Codec creates Decoders and Encoders of a record format. A Codec is
+// created for each source or sink and it can have format specific
+// parameters such as a delimiter of CSV.
+type Codec interface {
+	// NewDecoder returns a new Decoder reading records from r.
+	NewDecoder(r io.Reader) Decoder
+
+	// NewEncoder returns a new Encoder writing records to w.
+	NewEncoder(w io.Writer) Encoder
+}
+
+// CodecCreator is an interface which creates instances of a Codec.
+type CodecCreator interface {
+	// CreateCodec creates a new Codec using given parameters. params has all
+	// parameters given to a source or a sink, so CreateCodec must ignore
+	// parameters which it doesn't know.
+	CreateCodec(params data.Map) (Codec, error)
+}
+
+type codecCreatorFunc func(data.Map) (Codec, error)
+
+func (f codecCreatorFunc) CreateCodec(params data.Map) (Codec, error) {
+	return f(params)
+}
+
+// CodecCreatorFunc creates a CodecCreator from a function.
+func CodecCreatorFunc(f func(data.Map) (Codec, error)) CodecCreator {
+	return codecCreatorFunc(f)
+}
+
+// CodecCreatorRegistry manages creators of Codecs.
+type CodecCreatorRegistry interface {
+	// Register adds a Codec creator to the registry. It returns an error if
+	// the format name is already registered.
+	Register(format string, c CodecCreator) error
+
+	// Lookup returns a Codec creator having the format name. It returns
+	// core.NotExistError if it doesn't have the creator.
+	Lookup(format string) (CodecCreator, error)
+
+	// List returns all creators the registry has. The caller can safely modify
+	// the map returned from this method.
+	List() (map[string]CodecCreator, error)
+
+	// Unregister removes a creator from the registry. It returns
+	// core.NotExistError when the registry doesn't have a creator having the
+	// format name.
+	Unregister(format string) error
+}
+
+type defaultCodecCreatorRegistry struct {
+	m        sync.RWMutex
+	creators map[string]CodecCreator
+}
+
+// NewDefaultCodecCreatorRegistry returns a CodecCreatorRegistry having a
+// default implementation.
+func NewDefaultCodecCreatorRegistry() CodecCreatorRegistry {
+	return &defaultCodecCreatorRegistry{
+		creators: map[string]CodecCreator{},
+	}
+}
+
+func (r *defaultCodecCreatorRegistry) Register(format string, c CodecCreator) error {
+	// A format name isn't validated by core.ValidateSymbol because it's
+	// always given as a string and some reserved words like "raw" are
+	// natural names of formats.
+	if format == "" {
+		return errors.New("the name of a format must not be empty")
+	}
+
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	lowerName := strings.ToLower(format)
+	if _, ok := r.creators[lowerName]; ok {
+		return fmt.Errorf("format '%v' is already registered", format)
+	}
+	r.creators[lowerName] = c
+	return nil
+}
+
+func (r *defaultCodecCreatorRegistry) Lookup(format string) (CodecCreator, error) {
+	r.m.RLock()
+	defer r.m.RUnlock()
+	if c, ok := r.creators[strings.ToLower(format)]; ok {
+		return c, nil
+	}
+	return nil, core.NotExistError(fmt.Errorf("format '%v' is not registered", format))
+}
+
+func (r *defaultCodecCreatorRegistry) List() (map[string]CodecCreator, error) {
+	r.m.RLock()
+	defer r.m.RUnlock()
+
+	m := make(map[string]CodecCreator, len(r.creators))
+	for f, c := range r.creators {
+		m[f] = c
+	}
+	return m, nil
+}
+
+func (r *defaultCodecCreatorRegistry) Unregister(format string) error {
+	r.m.Lock()
+	defer r.m.Unlock()
+	f := strings.ToLower(format)
+	if _, ok := r.creators[f]; !ok {
+		return core.NotExistError(fmt.Errorf("format '%v' is not registered", format))
+	}
+	delete(r.creators, f)
+	return nil
+}
+
+var (
+	globalCodecCreatorRegistry = NewDefaultCodecCreatorRegistry()
+)
+
+// RegisterGlobalCodecCreator adds a CodecCreator which can be referred from
+// all sources and sinks supporting "format" parameter. Call it from init
+// functions so that the format is available before topologies start.
+func RegisterGlobalCodecCreator(format string, c CodecCreator) error {
+	return globalCodecCreatorRegistry.Register(format, c)
+}
+
+// MustRegisterGlobalCodecCreator is like RegisterGlobalCodecCreator but
+// panics if an error occurred.
+func MustRegisterGlobalCodecCreator(format string, c CodecCreator) {
+	if err := globalCodecCreatorRegistry.Register(format, c); err != nil {
+		panic(fmt.Errorf("bql.MustRegisterGlobalCodecCreator: cannot register '%v': %v", format, err))
+	}
+}
+
+// createCodec creates a Codec of the format given as "format" parameter.
+// defaultFormat is used when the parameter is missing.
+func createCodec(params data.Map, defaultFormat string) (Codec, error) {
+	format := defaultFormat
+	if v, ok := params["format"]; ok {
+		f, err := data.AsString(v)
+		if err != nil {
+			return nil, fmt.Errorf("'format' parameter must be a string: %v", err)
+		}
+		format = f
+	}
+
+	c, err := globalCodecCreatorRegistry.Lookup(format)
+	if err != nil {
+		return nil, err
+	}
+	return c.CreateCodec(params)
+}
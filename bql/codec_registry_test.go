@@ -0,0 +1,121 @@
+package bql
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"testing"
+)
+
+func createTestCodec(params data.Map) (Codec, error) {
+	return &jsonlCodec{}, nil
+}
+
+func TestDefaultCodecCreatorRegistry(t *testing.T) {
+	Convey("Given an default Codec registry having two formats", t, func() {
+		r := NewDefaultCodecCreatorRegistry()
+		So(r.Register("TEST_format", CodecCreatorFunc(createTestCodec)), ShouldBeNil)
+		So(r.Register("TEST_format2", CodecCreatorFunc(createTestCodec)), ShouldBeNil)
+
+		Convey("When adding a new format having the registered name", func() {
+			err := r.Register("TEST_FORMAT", CodecCreatorFunc(createTestCodec))
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When adding a format having an empty name", func() {
+			err := r.Register("", CodecCreatorFunc(createTestCodec))
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When looking up a creator", func() {
+			c, err := r.Lookup("TEST_FORMAT2")
+
+			Convey("Then it should succeed", func() {
+				So(err, ShouldBeNil)
+
+				Convey("And it should have the expected type", func() {
+					codec, err := c.CreateCodec(nil)
+					So(err, ShouldBeNil)
+					So(codec, ShouldHaveSameTypeAs, &jsonlCodec{})
+				})
+			})
+		})
+
+		Convey("When looking up a nonexistent creator", func() {
+			_, err := r.Lookup("test_format3")
+
+			Convey("Then it should fail", func() {
+				So(core.IsNotExist(err), ShouldBeTrue)
+			})
+		})
+
+		Convey("When retrieving a list of creators", func() {
+			m, err := r.List()
+
+			Convey("Then it should succeed", func() {
+				So(err, ShouldBeNil)
+
+				Convey("And the list should have all creators", func() {
+					So(len(m), ShouldEqual, 2)
+					So(m["test_format"], ShouldNotBeNil)
+					So(m["test_format2"], ShouldNotBeNil)
+				})
+			})
+		})
+
+		Convey("When unregistering a creator", func() {
+			So(r.Unregister("test_FORMAT"), ShouldBeNil)
+
+			Convey("Then the creator shouldn't be found", func() {
+				_, err := r.Lookup("TEST_format")
+				So(core.IsNotExist(err), ShouldBeTrue)
+			})
+
+			Convey("Then unregistering it again should fail", func() {
+				So(core.IsNotExist(r.Unregister("test_format")), ShouldBeTrue)
+			})
+		})
+	})
+
+	Convey("Given the global Codec registry", t, func() {
+		Convey("When creating a codec without format parameter", func() {
+			c, err := createCodec(data.Map{}, "jsonl")
+
+			Convey("Then it should create the default one", func() {
+				So(err, ShouldBeNil)
+				So(c, ShouldHaveSameTypeAs, &jsonlCodec{})
+			})
+		})
+
+		Convey("When creating a codec with format parameter", func() {
+			c, err := createCodec(data.Map{"format": data.String("CSV")}, "jsonl")
+
+			Convey("Then it should create the codec of the format", func() {
+				So(err, ShouldBeNil)
+				So(c, ShouldHaveSameTypeAs, &csvCodec{})
+			})
+		})
+
+		Convey("When creating a codec with an unknown format", func() {
+			_, err := createCodec(data.Map{"format": data.String("xml")}, "jsonl")
+
+			Convey("Then it should fail", func() {
+				So(core.IsNotExist(err), ShouldBeTrue)
+			})
+		})
+
+		Convey("When creating a codec with an invalid format parameter", func() {
+			_, err := createCodec(data.Map{"format": data.Int(1)}, "jsonl")
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
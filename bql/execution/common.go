@@ -6,6 +6,7 @@ import (
 	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
 	"gopkg.in/sensorbee/sensorbee.v0/core"
 	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"strings"
 )
 
 type aliasedEvaluator struct {
@@ -46,7 +47,14 @@ func prepareProjections(projections []aliasedExpression, reg udf.FunctionRegistr
 			}
 		}
 		var path data.Path
-		if proj.alias != "*" && proj.alias != ":having:" {
+		if strings.HasPrefix(proj.alias, ":order:") {
+			// hidden columns of the ORDER BY clause are stored
+			// in the result under their alias
+			path, err = data.CompilePath(fmt.Sprintf(`["%s"]`, proj.alias))
+			if err != nil {
+				return nil, err
+			}
+		} else if proj.alias != "*" && proj.alias != ":having:" {
 			path, err = data.CompilePath(proj.alias)
 			if err != nil {
 				return nil, err
@@ -9,6 +9,9 @@ import (
 
 type defaultSelectExecutionPlan struct {
 	streamRelationStreamExecutionPlan
+	// windowFuncs holds the evaluators of the window functions
+	// used in the projections.
+	windowFuncs []*windowFuncEvaluator
 }
 
 // CanBuildDefaultSelectExecutionPlan checks whether the given statement
@@ -31,8 +34,13 @@ func NewDefaultSelectExecutionPlan(lp *LogicalPlan, reg udf.FunctionRegistry) (P
 	if err != nil {
 		return nil, err
 	}
+	windowFuncs, err := prepareWindowFuncs(lp.Projections, reg)
+	if err != nil {
+		return nil, err
+	}
 	return &defaultSelectExecutionPlan{
 		*underlying,
+		windowFuncs,
 	}, nil
 }
 
@@ -108,6 +116,15 @@ func (ep *defaultSelectExecutionPlan) performQueryOnBuffer() error {
 		return nil
 	}
 
+	if len(ep.windowFuncs) > 0 {
+		if err := ep.evalWithWindowFuncs(&output); err != nil {
+			rollback()
+			return err
+		}
+		ep.curResults = output
+		return nil
+	}
+
 	// compute the output for each item in ep.filteredInputRows
 	for e := ep.filteredInputRows.Front(); e != nil; e = e.Next() {
 		item := e.Value.(*inputRowWithCachedResult)
@@ -120,3 +137,46 @@ func (ep *defaultSelectExecutionPlan) performQueryOnBuffer() error {
 	ep.curResults = output
 	return nil
 }
+
+// evalWithWindowFuncs computes the projections for each item in
+// ep.filteredInputRows when window functions are used. The value of a
+// window function in one row depends on the other rows in the window,
+// so results cannot be cached per row.
+func (ep *defaultSelectExecutionPlan) evalWithWindowFuncs(output *[]resultRow) error {
+	var rows []data.Map
+	for e := ep.filteredInputRows.Front(); e != nil; e = e.Next() {
+		item := e.Value.(*inputRowWithCachedResult)
+		rows = append(rows, *item.input)
+	}
+
+	// the values of the window functions are added to a copy of
+	// each input row so that the projections can access them
+	inputs := make([]data.Map, len(rows))
+	for j, row := range rows {
+		input := make(data.Map, len(row)+len(ep.windowFuncs))
+		for k, v := range row {
+			input[k] = v
+		}
+		inputs[j] = input
+	}
+	for _, w := range ep.windowFuncs {
+		if err := w.eval(rows, inputs); err != nil {
+			return err
+		}
+	}
+
+	for _, input := range inputs {
+		result := data.Map(make(map[string]data.Value, len(ep.projections)))
+		for _, proj := range ep.projections {
+			value, err := proj.evaluator.Eval(input)
+			if err != nil {
+				return err
+			}
+			if err := assignOutputValue(result, proj.alias, proj.aliasPath, value); err != nil {
+				return err
+			}
+		}
+		*output = append(*output, resultRow{row: result, hash: data.Hash(result)})
+	}
+	return nil
+}
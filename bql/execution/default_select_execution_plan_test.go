@@ -798,6 +798,153 @@ func TestDefaultSelectExecutionPlan(t *testing.T) {
 
 		})
 	})
+
+	// Use a tumbling window
+	Convey("Given a SELECT clause with a time-based tumbling window", t, func() {
+		tuples := getTuples(6)
+		s := `CREATE STREAM box AS SELECT RSTREAM int FROM src [RANGE 2 SECONDS SLIDE 2 SECONDS]`
+		plan, err := createDefaultSelectPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			for idx, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+
+				Convey(fmt.Sprintf("Then those values should appear in %v", idx), func() {
+					// the tuples have timestamps 10:23:00, 10:23:01, ...
+					// so windows are closed by tuples 2 and 4
+					if idx == 2 || idx == 4 {
+						So(out, ShouldResemble, []data.Map{
+							{"int": data.Int(idx - 1)},
+							{"int": data.Int(idx)},
+						})
+					} else {
+						So(out, ShouldBeEmpty)
+					}
+				})
+			}
+
+		})
+	})
+
+	// Use a hopping window
+	Convey("Given a SELECT clause with a time-based hopping window", t, func() {
+		tuples := getTuples(4)
+		s := `CREATE STREAM box AS SELECT RSTREAM int FROM src [RANGE 2 SECONDS SLIDE 1 SECONDS]`
+		plan, err := createDefaultSelectPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			for idx, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+
+				Convey(fmt.Sprintf("Then those values should appear in %v", idx), func() {
+					if idx == 0 {
+						So(out, ShouldBeEmpty)
+					} else if idx == 1 {
+						So(out, ShouldResemble, []data.Map{
+							{"int": data.Int(1)},
+						})
+					} else {
+						So(out, ShouldResemble, []data.Map{
+							{"int": data.Int(idx - 1)},
+							{"int": data.Int(idx)},
+						})
+					}
+				})
+			}
+
+		})
+
+		Convey("When feeding it with tuples with a gap in between", func() {
+			tuples[3].Timestamp = tuples[3].Timestamp.Add(time.Hour)
+			for idx, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+
+				if idx == 3 {
+					Convey("Then only windows with data should be emitted", func() {
+						So(out, ShouldResemble, []data.Map{
+							{"int": data.Int(2)}, {"int": data.Int(3)},
+							{"int": data.Int(3)},
+						})
+					})
+				}
+			}
+		})
+	})
+
+	// Use a watermark
+	Convey("Given a SELECT clause with a watermark", t, func() {
+		tuples := getTuples(4)
+		s := `CREATE STREAM box AS SELECT RSTREAM int FROM src [RANGE 2 TUPLES, WATERMARK DELAY 1 SECONDS]`
+		plan, err := createDefaultSelectPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples out of order", func() {
+			order := []int{1, 0, 3, 2}
+			outs := make([][]data.Map, len(order))
+			for i, idx := range order {
+				out, err := plan.Process(tuples[idx])
+				So(err, ShouldBeNil)
+				outs[i] = out
+			}
+
+			Convey("Then the tuples should be processed in timestamp order", func() {
+				So(outs[0], ShouldBeEmpty)
+				So(outs[1], ShouldResemble, []data.Map{{"int": data.Int(1)}})
+				So(outs[2], ShouldResemble, []data.Map{{"int": data.Int(1)}, {"int": data.Int(2)}})
+				So(outs[3], ShouldResemble, []data.Map{{"int": data.Int(2)}, {"int": data.Int(3)}})
+			})
+
+			Convey("And a tuple behind the watermark arrives", func() {
+				late := getTuples(1)[0]
+				out, err := plan.Process(late)
+				So(err, ShouldBeNil)
+
+				Convey("Then it should be reported as a late tuple", func() {
+					So(out, ShouldBeEmpty)
+					So(plan, ShouldImplement, (*LateTupleReporter)(nil))
+					lateTuples := plan.(LateTupleReporter).LateTuples()
+					So(len(lateTuples), ShouldEqual, 1)
+					So(lateTuples[0], ShouldPointTo, late)
+				})
+			})
+		})
+	})
+
+	// Use a tuple-based slide
+	Convey("Given a SELECT clause with a tuple-based sliding window", t, func() {
+		tuples := getTuples(6)
+		s := `CREATE STREAM box AS SELECT RSTREAM int FROM src [RANGE 3 TUPLES SLIDE 2 TUPLES]`
+		plan, err := createDefaultSelectPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			for idx, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+
+				Convey(fmt.Sprintf("Then those values should appear in %v", idx), func() {
+					if idx == 1 {
+						So(out, ShouldResemble, []data.Map{
+							{"int": data.Int(1)}, {"int": data.Int(2)},
+						})
+					} else if idx%2 == 1 {
+						So(out, ShouldResemble, []data.Map{
+							{"int": data.Int(idx - 1)}, {"int": data.Int(idx)},
+							{"int": data.Int(idx + 1)},
+						})
+					} else {
+						So(out, ShouldBeEmpty)
+					}
+				})
+			}
+
+		})
+	})
 }
 
 func TestDefaultSelectExecutionPlanEmitters(t *testing.T) {
@@ -2048,6 +2195,391 @@ func TestDefaultSelectExecutionPlanJoin(t *testing.T) {
 	})
 }
 
+func TestDefaultSelectExecutionPlanExplicitJoin(t *testing.T) {
+	makeTuple := func(src string, key string, value string, k int64) *core.Tuple {
+		return &core.Tuple{
+			Data: data.Map{
+				key: data.String(value),
+				"k": data.Int(k),
+			},
+			InputName: src,
+			Timestamp: time.Date(2015, time.April, 10, 10, 23, 0, 0, time.UTC),
+		}
+	}
+
+	Convey("Given an INNER JOIN on a key", t, func() {
+		tuples := []*core.Tuple{
+			makeTuple("src1", "l", "a", 1),
+			makeTuple("src2", "r", "x", 2),
+			makeTuple("src2", "r", "y", 1),
+			makeTuple("src1", "l", "b", 2),
+			makeTuple("src1", "l", "c", 1),
+			makeTuple("src2", "r", "z", 3),
+		}
+		s := `CREATE STREAM box AS SELECT ISTREAM src1:l, src2:r FROM src1 [RANGE 3 TUPLES] ` +
+			`INNER JOIN src2 [RANGE 3 TUPLES] ON src1:k = src2:k`
+		plan, err := createDefaultSelectPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			expected := [][]data.Map{
+				nil,
+				nil,
+				{{"l": data.String("a"), "r": data.String("y")}},
+				{{"l": data.String("b"), "r": data.String("x")}},
+				{{"l": data.String("c"), "r": data.String("y")}},
+				nil,
+			}
+			for idx, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+
+				Convey(fmt.Sprintf("Then only tuples with the same key should be joined in %v", idx), func() {
+					So(len(out), ShouldEqual, len(expected[idx]))
+					for i, m := range expected[idx] {
+						So(out[i], ShouldResemble, m)
+					}
+				})
+			}
+		})
+	})
+
+	Convey("Given an INNER JOIN with an additional condition", t, func() {
+		tuples := []*core.Tuple{
+			makeTuple("src1", "l", "a", 1),
+			makeTuple("src2", "r", "a", 1),
+			makeTuple("src2", "r", "b", 1),
+		}
+		s := `CREATE STREAM box AS SELECT ISTREAM src1:l, src2:r FROM src1 [RANGE 3 TUPLES] ` +
+			`JOIN src2 [RANGE 3 TUPLES] ON src1:k = src2:k AND src1:l != src2:r`
+		plan, err := createDefaultSelectPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			var out []data.Map
+			for _, inTup := range tuples {
+				o, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+				out = append(out, o...)
+			}
+
+			Convey("Then only tuples matching the whole ON clause should be joined", func() {
+				So(out, ShouldResemble, []data.Map{
+					{"l": data.String("a"), "r": data.String("b")},
+				})
+			})
+		})
+	})
+
+	Convey("Given a LEFT OUTER JOIN on a key", t, func() {
+		tuples := []*core.Tuple{
+			makeTuple("src1", "l", "a", 1),
+			makeTuple("src2", "r", "x", 2),
+			makeTuple("src2", "r", "y", 1),
+			makeTuple("src2", "r", "z", 3),
+			makeTuple("src1", "l", "b", 3),
+		}
+		s := `CREATE STREAM box AS SELECT RSTREAM src1:l, src2:r FROM src1 [RANGE 1 TUPLES] ` +
+			`LEFT OUTER JOIN src2 [RANGE 1 TUPLES] ON src1:k = src2:k`
+		plan, err := createDefaultSelectPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			expected := [][]data.Map{
+				{{"l": data.String("a"), "r": data.Null{}}},
+				{{"l": data.String("a"), "r": data.Null{}}},
+				{{"l": data.String("a"), "r": data.String("y")}},
+				{{"l": data.String("a"), "r": data.Null{}}},
+				{{"l": data.String("b"), "r": data.String("z")}},
+			}
+			for idx, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+
+				Convey(fmt.Sprintf("Then unmatched tuples should be joined with NULL in %v", idx), func() {
+					So(out, ShouldResemble, expected[idx])
+				})
+			}
+		})
+	})
+
+	Convey("Given a LEFT OUTER JOIN with a WHERE clause", t, func() {
+		tuples := []*core.Tuple{
+			makeTuple("src1", "l", "a", 1),
+			makeTuple("src1", "l", "b", 2),
+			makeTuple("src2", "r", "x", 2),
+		}
+		s := `CREATE STREAM box AS SELECT RSTREAM src1:l, src2:r FROM src1 [RANGE 2 TUPLES] ` +
+			`LEFT JOIN src2 [RANGE 2 TUPLES] ON src1:k = src2:k WHERE src2:r IS NULL`
+		plan, err := createDefaultSelectPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			var out []data.Map
+			for _, inTup := range tuples {
+				o, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+				out = o
+			}
+
+			Convey("Then only the unmatched tuples should be emitted", func() {
+				So(out, ShouldResemble, []data.Map{
+					{"l": data.String("a"), "r": data.Null{}},
+				})
+			})
+		})
+	})
+}
+
+func TestDefaultSelectExecutionPlanLookupJoin(t *testing.T) {
+	createPlan := func(ctx *core.Context, s string) (PhysicalPlan, error) {
+		p := parser.New()
+		reg := udf.CopyGlobalUDFRegistry(ctx)
+		_stmt, _, err := p.ParseStmt(s)
+		So(err, ShouldBeNil)
+		stmt := _stmt.(parser.CreateStreamAsSelectStmt).Select
+		logicalPlan, err := Analyze(stmt, reg)
+		if err != nil {
+			return nil, err
+		}
+		return NewDefaultSelectExecutionPlan(logicalPlan, reg)
+	}
+	makeTuple := func(l string, k int64) *core.Tuple {
+		return &core.Tuple{
+			Data:      data.Map{"l": data.String(l), "k": data.Int(k)},
+			InputName: "src",
+			Timestamp: time.Date(2015, time.April, 10, 10, 23, 0, 0, time.UTC),
+		}
+	}
+
+	Convey("Given a keyed table", t, func() {
+		ctx := core.NewContext(nil)
+		creators, err := udf.CopyGlobalUDSCreatorRegistry()
+		So(err, ShouldBeNil)
+		c, err := creators.Lookup("keyed_table")
+		So(err, ShouldBeNil)
+		state, err := c.CreateState(ctx, data.Map{})
+		So(err, ShouldBeNil)
+		So(ctx.SharedStates.Add("devices", "keyed_table", state), ShouldBeNil)
+		table := state.(core.Writer)
+		for i, name := range []string{"x", "y"} {
+			So(table.Write(ctx, core.NewTuple(data.Map{
+				"id":   data.Int(i + 1),
+				"name": data.String(name),
+			})), ShouldBeNil)
+		}
+
+		Convey("When joining it with INNER JOIN on its key", func() {
+			plan, err := createPlan(ctx, `CREATE STREAM box AS SELECT RSTREAM src:l, devices:name `+
+				`FROM src [RANGE 1 TUPLES] INNER JOIN devices ON src:k = devices:id`)
+			So(err, ShouldBeNil)
+
+			Convey("Then tuples should be joined with the row having the same key", func() {
+				out, err := plan.Process(makeTuple("a", 2))
+				So(err, ShouldBeNil)
+				So(out, ShouldResemble, []data.Map{{"l": data.String("a"), "name": data.String("y")}})
+
+				out, err = plan.Process(makeTuple("b", 3))
+				So(err, ShouldBeNil)
+				So(out, ShouldBeEmpty)
+			})
+
+			Convey("Then updates of the table should be used by later tuples", func() {
+				So(table.Write(ctx, core.NewTuple(data.Map{
+					"id":   data.Int(1),
+					"name": data.String("z"),
+				})), ShouldBeNil)
+				out, err := plan.Process(makeTuple("a", 1))
+				So(err, ShouldBeNil)
+				So(out, ShouldResemble, []data.Map{{"l": data.String("a"), "name": data.String("z")}})
+			})
+		})
+
+		Convey("When joining it with LEFT OUTER JOIN on another column", func() {
+			plan, err := createPlan(ctx, `CREATE STREAM box AS SELECT RSTREAM src:l, devices:id `+
+				`FROM src [RANGE 1 TUPLES] LEFT OUTER JOIN devices ON src:l = devices:name`)
+			So(err, ShouldBeNil)
+
+			Convey("Then tuples should be joined with the matching rows", func() {
+				out, err := plan.Process(makeTuple("x", 0))
+				So(err, ShouldBeNil)
+				So(out, ShouldResemble, []data.Map{{"l": data.String("x"), "id": data.Int(1)}})
+
+				out, err = plan.Process(makeTuple("w", 0))
+				So(err, ShouldBeNil)
+				So(out, ShouldResemble, []data.Map{{"l": data.String("w"), "id": data.Null{}}})
+			})
+		})
+
+		Convey("When referring to the table with the name of the relation", func() {
+			_, err := createPlan(ctx, `CREATE STREAM box AS SELECT RSTREAM src:l `+
+				`FROM src [RANGE 1 TUPLES] INNER JOIN src ON src:k = src:id`)
+
+			Convey("Then creating the plan should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When joining a table that doesn't exist", func() {
+			_, err := createPlan(ctx, `CREATE STREAM box AS SELECT RSTREAM src:l `+
+				`FROM src [RANGE 1 TUPLES] INNER JOIN machines ON src:k = machines:id`)
+
+			Convey("Then creating the plan should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestDefaultSelectExecutionPlanOrderBy(t *testing.T) {
+	Convey("Given a SELECT clause with ORDER BY, LIMIT and OFFSET", t, func() {
+		tuples := getTuples(4)
+
+		s := `CREATE STREAM box AS SELECT RSTREAM int FROM src [RANGE 3 TUPLES]
+			ORDER BY int DESC LIMIT 2 OFFSET 1`
+		plan, err := createDefaultSelectPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			expected := [][]data.Map{
+				nil,
+				{{"int": data.Int(1)}},
+				{{"int": data.Int(2)}, {"int": data.Int(1)}},
+				{{"int": data.Int(3)}, {"int": data.Int(2)}},
+			}
+			for idx, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+
+				Convey(fmt.Sprintf("Then the selected rows should appear in order in %v", idx), func() {
+					So(out, ShouldResemble, expected[idx])
+				})
+			}
+		})
+	})
+
+	Convey("Given an ISTREAM SELECT clause with ORDER BY and LIMIT", t, func() {
+		tuples := getTuples(4)
+
+		s := `CREATE STREAM box AS SELECT ISTREAM int FROM src [RANGE 2 TUPLES]
+			ORDER BY int LIMIT 1`
+		plan, err := createDefaultSelectPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			expected := [][]data.Map{
+				{{"int": data.Int(1)}},
+				nil,
+				{{"int": data.Int(2)}},
+				{{"int": data.Int(3)}},
+			}
+			for idx, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+
+				Convey(fmt.Sprintf("Then only a new minimum should appear in %v", idx), func() {
+					So(out, ShouldResemble, expected[idx])
+				})
+			}
+		})
+	})
+
+	Convey("Given a SELECT clause with a wildcard and ORDER BY", t, func() {
+		tuples := getTuples(2)
+
+		s := `CREATE STREAM box AS SELECT RSTREAM * FROM src [RANGE 2 TUPLES]
+			ORDER BY int * -1`
+		plan, err := createDefaultSelectPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			_, err := plan.Process(tuples[0])
+			So(err, ShouldBeNil)
+			out, err := plan.Process(tuples[1])
+			So(err, ShouldBeNil)
+
+			Convey("Then the rows should be sorted without the sort keys", func() {
+				So(out, ShouldResemble, []data.Map{{"int": data.Int(2)}, {"int": data.Int(1)}})
+			})
+		})
+	})
+}
+
+func TestDefaultSelectExecutionPlanWindowFunctions(t *testing.T) {
+	Convey("Given a SELECT clause with window functions", t, func() {
+		readings := []struct {
+			device string
+			x      int64
+		}{{"a", 1}, {"b", 10}, {"a", 4}, {"b", 15}, {"a", 5}}
+		tuples := getTuples(len(readings))
+		for i, r := range readings {
+			tuples[i].Data["device"] = data.String(r.device)
+			tuples[i].Data["x"] = data.Int(r.x)
+		}
+
+		s := `CREATE STREAM box AS SELECT RSTREAM device,
+			x - lag(x) OVER (PARTITION BY device ORDER BY ts()) AS delta,
+			row_number() OVER (PARTITION BY device ORDER BY ts()) AS n,
+			lead(x, 1, -1) OVER (PARTITION BY device ORDER BY ts()) AS next,
+			first_value(x) OVER (ORDER BY x DESC) AS max
+			FROM src [RANGE 5 TUPLES]`
+		plan, err := createDefaultSelectPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			var out []data.Map
+			for _, inTup := range tuples {
+				out, err = plan.Process(inTup)
+				So(err, ShouldBeNil)
+			}
+
+			Convey("Then the values should be computed per partition", func() {
+				So(out, ShouldResemble, []data.Map{
+					{"device": data.String("a"), "delta": data.Null{}, "n": data.Int(1),
+						"next": data.Int(4), "max": data.Int(15)},
+					{"device": data.String("b"), "delta": data.Null{}, "n": data.Int(1),
+						"next": data.Int(15), "max": data.Int(15)},
+					{"device": data.String("a"), "delta": data.Int(3), "n": data.Int(2),
+						"next": data.Int(5), "max": data.Int(15)},
+					{"device": data.String("b"), "delta": data.Int(5), "n": data.Int(2),
+						"next": data.Int(-1), "max": data.Int(15)},
+					{"device": data.String("a"), "delta": data.Int(1), "n": data.Int(3),
+						"next": data.Int(-1), "max": data.Int(15)},
+				})
+			})
+		})
+	})
+
+	Convey("Given an ISTREAM SELECT clause with a window function", t, func() {
+		tuples := getTuples(4)
+
+		s := `CREATE STREAM box AS SELECT ISTREAM int,
+			int - lag(int) OVER () AS delta FROM src [RANGE 2 TUPLES]`
+		plan, err := createDefaultSelectPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			expected := [][]data.Map{
+				{{"int": data.Int(1), "delta": data.Null{}}},
+				{{"int": data.Int(2), "delta": data.Int(1)}},
+				// the first row in the window has no predecessor anymore
+				{{"int": data.Int(2), "delta": data.Null{}},
+					{"int": data.Int(3), "delta": data.Int(1)}},
+				{{"int": data.Int(3), "delta": data.Null{}},
+					{"int": data.Int(4), "delta": data.Int(1)}},
+			}
+			for idx, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+
+				Convey(fmt.Sprintf("Then the values should be computed over the window in %v", idx), func() {
+					So(out, ShouldResemble, expected[idx])
+				})
+			}
+		})
+	})
+}
+
 func createDefaultSelectPlan2(s string) (PhysicalPlan, error) {
 	p := parser.New()
 	reg := udf.CopyGlobalUDFRegistry(core.NewContext(nil))
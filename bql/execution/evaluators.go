@@ -8,8 +8,10 @@ import (
 	"gopkg.in/sensorbee/sensorbee.v0/data"
 	"math"
 	"reflect"
+	"regexp"
 	"sort"
 	"strings"
+	"time"
 )
 
 // An Evaluator represents an expression such as `colX + 2` or
@@ -96,27 +98,59 @@ func ExpressionToEvaluator(ast FlatExpression, reg udf.FunctionRegistry) (Evalua
 		}
 	case rowValue:
 		path := obj.Column
-		if obj.Relation != "" {
-			if strings.HasPrefix(path, "[") {
-				path = obj.Relation + path
-			} else {
-				path = obj.Relation + "." + path
-			}
+		if obj.Relation == "" {
+			return newPathAccess(path)
+		}
+		if strings.HasPrefix(path, "[") {
+			path = obj.Relation + path
+		} else {
+			path = obj.Relation + "." + path
+		}
+		pa, err := newPathAccess(path)
+		if err != nil {
+			return nil, err
 		}
-		return newPathAccess(path)
+		return &relationPathAccess{obj.Relation, pa}, nil
 	case aggInputRef:
 		return newPathAccess(obj.Ref)
+	case windowFuncAppAST:
+		// the values of window functions are computed over all rows
+		// beforehand and stored in the row under the function's key
+		return newPathAccess(obj.Key())
 	case nullLiteral:
 		return &nullConstant{}, nil
 	case numericLiteral:
 		return &intConstant{obj.Value}, nil
 	case floatLiteral:
 		return &floatConstant{obj.Value}, nil
+	case intervalLiteral:
+		return &intConstant{obj.Microseconds}, nil
 	case boolLiteral:
 		return &boolConstant{obj.Value}, nil
 	case stringLiteral:
 		return &stringConstant{obj.Value}, nil
 	case binaryOpAST:
+		// an interval can only be added to or subtracted from a timestamp
+		if i, ok := obj.Right.(intervalLiteral); ok &&
+			(obj.Op == parser.Plus || obj.Op == parser.Minus) {
+			left, err := ExpressionToEvaluator(obj.Left, reg)
+			if err != nil {
+				return nil, err
+			}
+			d := time.Duration(i.Microseconds) * time.Microsecond
+			if obj.Op == parser.Minus {
+				d = -d
+			}
+			return &addInterval{left, d}, nil
+		}
+		if i, ok := obj.Left.(intervalLiteral); ok && obj.Op == parser.Plus {
+			right, err := ExpressionToEvaluator(obj.Right, reg)
+			if err != nil {
+				return nil, err
+			}
+			return &addInterval{right, time.Duration(i.Microseconds) * time.Microsecond}, nil
+		}
+
 		// recurse
 		left, err := ExpressionToEvaluator(obj.Left, reg)
 		if err != nil {
@@ -148,6 +182,34 @@ func ExpressionToEvaluator(ast FlatExpression, reg udf.FunctionRegistry) (Evalua
 			return newGreaterOrnewEqual(bo), nil
 		case parser.NotEqual:
 			return newNot(newEqual(bo)), nil
+		case parser.In:
+			return newIn(bo), nil
+		case parser.NotIn:
+			return newNot(newIn(bo)), nil
+		case parser.Like:
+			return newLike(bo, false)
+		case parser.NotLike:
+			e, err := newLike(bo, false)
+			if err != nil {
+				return nil, err
+			}
+			return newNot(e), nil
+		case parser.ILike:
+			return newLike(bo, true)
+		case parser.NotILike:
+			e, err := newLike(bo, true)
+			if err != nil {
+				return nil, err
+			}
+			return newNot(e), nil
+		case parser.RegexpMatch:
+			return newRegexpMatch(bo)
+		case parser.NotRegexpMatch:
+			e, err := newRegexpMatch(bo)
+			if err != nil {
+				return nil, err
+			}
+			return newNot(e), nil
 		case parser.Concat:
 			return &concat{bo}, nil
 		case parser.Is:
@@ -173,6 +235,17 @@ func ExpressionToEvaluator(ast FlatExpression, reg udf.FunctionRegistry) (Evalua
 		case parser.Modulo:
 			return newModulo(bo), nil
 		}
+	case betweenAST:
+		// recurse
+		evals := make([]Evaluator, 3)
+		for i, ast := range []FlatExpression{obj.Expr, obj.Lower, obj.Upper} {
+			e, err := ExpressionToEvaluator(ast, reg)
+			if err != nil {
+				return nil, err
+			}
+			evals[i] = e
+		}
+		return &between{evals[0], evals[1], evals[2], obj.Op == parser.NotBetween}, nil
 	case unaryOpAST:
 		// recurse
 		expr, err := ExpressionToEvaluator(obj.Expr, reg)
@@ -210,6 +283,9 @@ func ExpressionToEvaluator(ast FlatExpression, reg udf.FunctionRegistry) (Evalua
 		// (the registry will decide if the requested function
 		// is callable with the given number of arguments).
 		fName := string(obj.Function)
+		if _, ok := higherOrderFuncs[fName]; ok {
+			return newHigherOrderFuncApp(obj, reg)
+		}
 		f, err := reg.Lookup(fName, len(obj.Expressions))
 		if err != nil {
 			return nil, err
@@ -226,6 +302,11 @@ func ExpressionToEvaluator(ast FlatExpression, reg udf.FunctionRegistry) (Evalua
 		return FuncApp(fName, f, reg.Context(), evals), nil
 	case aggregateInputSorter:
 		return newSortedInputAggFuncApp(obj.funcAppAST, obj.ID, obj.Ordering, reg)
+	case lambdaAST:
+		return nil, fmt.Errorf("lambda expressions can only be used as " +
+			"arguments of higher-order functions")
+	case lambdaVariable:
+		return newPathAccess(fmt.Sprintf(`["%s"]%s`, lambdaVariableKey(obj.Name), obj.Path))
 	case arrayAST:
 		// compute child Evaluators
 		evals := make([]Evaluator, len(obj.Expressions))
@@ -355,6 +436,28 @@ func newPathAccess(s string) (Evaluator, error) {
 	return &pathAccess{path}, nil
 }
 
+// relationPathAccess works like pathAccess, but returns NULL if the
+// relation containing the accessed value is NULL, as it is for the
+// missing side of a LEFT OUTER JOIN.
+type relationPathAccess struct {
+	relation string
+	Evaluator
+}
+
+func (ra *relationPathAccess) Eval(input data.Value) (data.Value, error) {
+	v, err := ra.Evaluator.Eval(input)
+	if err != nil {
+		// only check for a NULL relation if the access failed, so
+		// that the common case is not slowed down
+		if aMap, e := data.AsMap(input); e == nil {
+			if rel, ok := aMap[ra.relation]; ok && rel.Type() == data.TypeNull {
+				return data.Null{}, nil
+			}
+		}
+	}
+	return v, err
+}
+
 type missingPathCheck struct {
 	eval   pathAccess
 	negate bool
@@ -372,6 +475,9 @@ func (m *missingPathCheck) Eval(input data.Value) (data.Value, error) {
 }
 
 func newMissingPathCheck(eval Evaluator, negate bool) (Evaluator, error) {
+	if ra, ok := eval.(*relationPathAccess); ok {
+		eval = ra.Evaluator
+	}
 	pa, ok := eval.(*pathAccess)
 	if !ok {
 		return nil, fmt.Errorf("expected pathAccess before IS [NOT] MISSING, not %v", eval)
@@ -466,6 +572,10 @@ func (t *timestampCast) Eval(input data.Value) (data.Value, error) {
 	if err != nil {
 		return nil, err
 	}
+	if val.Type() == data.TypeNull {
+		// this is the case for the missing side of a LEFT OUTER JOIN
+		return val, nil
+	}
 	if val.Type() != data.TypeTimestamp {
 		return nil, fmt.Errorf("value %v was %T, not Time", val, val)
 	}
@@ -673,54 +783,57 @@ func newEqual(bo binOp) Evaluator {
 	return &compBinOp{bo, cmpOp}
 }
 
-func newLess(bo binOp) Evaluator {
-	cmpOp := func(leftVal data.Value, rightVal data.Value) (bool, error) {
-		leftType := leftVal.Type()
-		rightType := rightVal.Type()
-		stdErr := fmt.Errorf("cannot compare %T and %T", leftVal, rightVal)
-		if leftType == rightType {
-			retVal := false
-			switch leftType {
-			default:
-				return false, stdErr
-			case data.TypeInt:
-				l, _ := data.AsInt(leftVal)
-				r, _ := data.AsInt(rightVal)
-				retVal = l < r
-			case data.TypeFloat:
-				l, _ := data.AsFloat(leftVal)
-				r, _ := data.AsFloat(rightVal)
-				retVal = l < r
-			case data.TypeString:
-				l, _ := data.AsString(leftVal)
-				r, _ := data.AsString(rightVal)
-				retVal = l < r
-			case data.TypeBool:
-				l, _ := data.AsBool(leftVal)
-				r, _ := data.AsBool(rightVal)
-				retVal = (l == false) && (r == true)
-			case data.TypeTimestamp:
-				l, _ := data.AsTimestamp(leftVal)
-				r, _ := data.AsTimestamp(rightVal)
-				retVal = l.Before(r)
-			}
-			return retVal, nil
-		} else if leftType == data.TypeInt && rightType == data.TypeFloat {
-			// left is integer
+// lessThan returns whether leftVal is less than rightVal. Both values
+// must not be NULL.
+func lessThan(leftVal data.Value, rightVal data.Value) (bool, error) {
+	leftType := leftVal.Type()
+	rightType := rightVal.Type()
+	stdErr := fmt.Errorf("cannot compare %T and %T", leftVal, rightVal)
+	if leftType == rightType {
+		retVal := false
+		switch leftType {
+		default:
+			return false, stdErr
+		case data.TypeInt:
 			l, _ := data.AsInt(leftVal)
-			// right is float; also convert left to float to avoid overflow
-			r, _ := data.AsFloat(rightVal)
-			return float64(l) < r, nil
-		} else if leftType == data.TypeFloat && rightType == data.TypeInt {
-			// left is float
-			l, _ := data.AsFloat(leftVal)
-			// right is int; convert right to float to avoid overflow
 			r, _ := data.AsInt(rightVal)
-			return l < float64(r), nil
-		}
-		return false, stdErr
+			retVal = l < r
+		case data.TypeFloat:
+			l, _ := data.AsFloat(leftVal)
+			r, _ := data.AsFloat(rightVal)
+			retVal = l < r
+		case data.TypeString:
+			l, _ := data.AsString(leftVal)
+			r, _ := data.AsString(rightVal)
+			retVal = l < r
+		case data.TypeBool:
+			l, _ := data.AsBool(leftVal)
+			r, _ := data.AsBool(rightVal)
+			retVal = (l == false) && (r == true)
+		case data.TypeTimestamp:
+			l, _ := data.AsTimestamp(leftVal)
+			r, _ := data.AsTimestamp(rightVal)
+			retVal = l.Before(r)
+		}
+		return retVal, nil
+	} else if leftType == data.TypeInt && rightType == data.TypeFloat {
+		// left is integer
+		l, _ := data.AsInt(leftVal)
+		// right is float; also convert left to float to avoid overflow
+		r, _ := data.AsFloat(rightVal)
+		return float64(l) < r, nil
+	} else if leftType == data.TypeFloat && rightType == data.TypeInt {
+		// left is float
+		l, _ := data.AsFloat(leftVal)
+		// right is int; convert right to float to avoid overflow
+		r, _ := data.AsInt(rightVal)
+		return l < float64(r), nil
 	}
-	return &compBinOp{bo, cmpOp}
+	return false, stdErr
+}
+
+func newLess(bo binOp) Evaluator {
+	return &compBinOp{bo, lessThan}
 }
 
 func newLessOrEqual(bo binOp) Evaluator {
@@ -739,6 +852,52 @@ func newNotEqual(bo binOp) Evaluator {
 	return newNot(newEqual(bo))
 }
 
+// between evaluates `expr BETWEEN lower AND upper` (or NOT BETWEEN if
+// negate is true). It has the same semantics as `expr >= lower AND
+// expr <= upper`, but evaluates expr only once.
+type between struct {
+	expr   Evaluator
+	lower  Evaluator
+	upper  Evaluator
+	negate bool
+}
+
+func (b *between) Eval(input data.Value) (data.Value, error) {
+	val, err := b.expr.Eval(input)
+	if err != nil {
+		return nil, err
+	}
+	lower, err := b.lower.Eval(input)
+	if err != nil {
+		return nil, err
+	}
+	// NULL propagation works like in `expr >= lower AND expr <= upper`,
+	// i.e., a false comparison wins over a NULL one
+	isNull := false
+	if val.Type() == data.TypeNull || lower.Type() == data.TypeNull {
+		isNull = true
+	} else if less, err := lessThan(val, lower); err != nil {
+		return nil, err
+	} else if less {
+		return data.Bool(b.negate), nil
+	}
+	upper, err := b.upper.Eval(input)
+	if err != nil {
+		return nil, err
+	}
+	if val.Type() == data.TypeNull || upper.Type() == data.TypeNull {
+		isNull = true
+	} else if less, err := lessThan(val, upper); err != nil {
+		return nil, err
+	} else if !less && !data.Equal(val, upper) {
+		return data.Bool(b.negate), nil
+	}
+	if isNull {
+		return data.Null{}, nil
+	}
+	return data.Bool(!b.negate), nil
+}
+
 /// A Unary Comparison Operation
 
 type isNull struct {
@@ -757,6 +916,140 @@ func newIsNull(e Evaluator) Evaluator {
 	return &isNull{e}
 }
 
+/// Membership and Pattern Matching Operations
+
+// in checks whether the left value is contained in the array
+// that the right side evaluates to.
+type in struct {
+	binOp
+}
+
+func (i *in) Eval(input data.Value) (data.Value, error) {
+	leftVal, rightVal, err := i.evalLeftAndRight(input)
+	if err != nil {
+		return nil, err
+	}
+	// NULL propagation
+	if leftVal.Type() == data.TypeNull || rightVal.Type() == data.TypeNull {
+		return data.Null{}, nil
+	}
+	arr, err := data.AsArray(rightVal)
+	if err != nil {
+		return nil, fmt.Errorf("right operand of IN must be an array: %v", rightVal)
+	}
+	// like in SQL, the result is NULL rather than false if the
+	// value is not found, but the array contains NULL
+	foundNull := false
+	for _, v := range arr {
+		if v.Type() == data.TypeNull {
+			foundNull = true
+		} else if data.Equal(leftVal, v) {
+			return data.Bool(true), nil
+		}
+	}
+	if foundNull {
+		return data.Null{}, nil
+	}
+	return data.Bool(false), nil
+}
+
+func newIn(bo binOp) Evaluator {
+	return &in{bo}
+}
+
+// patternMatch checks whether the left string matches the pattern
+// that the right side evaluates to. If the pattern is a constant, it
+// is compiled only once when the evaluator is created.
+type patternMatch struct {
+	binOp
+	verb    string
+	compile func(pattern string) (*regexp.Regexp, error)
+	re      *regexp.Regexp
+}
+
+func (pm *patternMatch) Eval(input data.Value) (data.Value, error) {
+	leftVal, rightVal, err := pm.evalLeftAndRight(input)
+	if err != nil {
+		return nil, err
+	}
+	// NULL propagation
+	if leftVal.Type() == data.TypeNull || rightVal.Type() == data.TypeNull {
+		return data.Null{}, nil
+	}
+	str, err := data.AsString(leftVal)
+	if err != nil {
+		return nil, fmt.Errorf("left operand of %s must be string: %v", pm.verb, leftVal)
+	}
+	re := pm.re
+	if re == nil {
+		pattern, err := data.AsString(rightVal)
+		if err != nil {
+			return nil, fmt.Errorf("right operand of %s must be string: %v", pm.verb, rightVal)
+		}
+		re, err = pm.compile(pattern)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return data.Bool(re.MatchString(str)), nil
+}
+
+func newPatternMatch(bo binOp, verb string, compile func(string) (*regexp.Regexp, error)) (Evaluator, error) {
+	pm := &patternMatch{binOp: bo, verb: verb, compile: compile}
+	if c, ok := bo.right.(*stringConstant); ok {
+		re, err := compile(c.value)
+		if err != nil {
+			return nil, err
+		}
+		pm.re = re
+	}
+	return pm, nil
+}
+
+// newLike creates an Evaluator for `LIKE` or, if caseInsensitive is
+// true, `ILIKE`. In the pattern, `%` matches any sequence of characters
+// and `_` matches any single character. A backslash matches the
+// following character literally.
+func newLike(bo binOp, caseInsensitive bool) (Evaluator, error) {
+	verb := "LIKE"
+	flags := "(?s)"
+	if caseInsensitive {
+		verb = "ILIKE"
+		flags = "(?is)"
+	}
+	return newPatternMatch(bo, verb, func(pattern string) (*regexp.Regexp, error) {
+		expr := []string{flags, "^"}
+		escaped := false
+		for _, r := range pattern {
+			switch {
+			case escaped:
+				expr = append(expr, regexp.QuoteMeta(string(r)))
+				escaped = false
+			case r == '\\':
+				escaped = true
+			case r == '%':
+				expr = append(expr, ".*")
+			case r == '_':
+				expr = append(expr, ".")
+			default:
+				expr = append(expr, regexp.QuoteMeta(string(r)))
+			}
+		}
+		if escaped {
+			return nil, fmt.Errorf("%s pattern must not end with an escape character: %s",
+				verb, pattern)
+		}
+		expr = append(expr, "$")
+		return regexp.Compile(strings.Join(expr, ""))
+	})
+}
+
+// newRegexpMatch creates an Evaluator for `~`, which checks whether
+// the string contains a match of the regular expression.
+func newRegexpMatch(bo binOp) (Evaluator, error) {
+	return newPatternMatch(bo, "~", regexp.Compile)
+}
+
 /// Binary Numerical Operations
 
 // numBinOp provides functionality for evaluating binary operations
@@ -873,6 +1166,30 @@ func newModulo(bo binOp) Evaluator {
 	return &numBinOp{bo, "compute modulo for", intOp, floatOp}
 }
 
+// addInterval adds a time interval given by an INTERVAL literal to a
+// timestamp. It's used for `ts + INTERVAL ...`, `INTERVAL ... + ts`, and
+// `ts - INTERVAL ...` (with a negative interval). The timestamp operand
+// must be a Timestamp or NULL.
+type addInterval struct {
+	expr     Evaluator
+	interval time.Duration
+}
+
+func (a *addInterval) Eval(input data.Value) (data.Value, error) {
+	v, err := a.expr.Eval(input)
+	if err != nil {
+		return nil, err
+	}
+	switch v.Type() {
+	case data.TypeNull:
+		return data.Null{}, nil
+	case data.TypeTimestamp:
+		t, _ := data.AsTimestamp(v)
+		return data.Timestamp(t.Add(a.interval)), nil
+	}
+	return nil, fmt.Errorf("cannot add an interval to %T", v)
+}
+
 /// Other Binary Operations
 
 type concat struct {
@@ -1215,6 +1532,10 @@ func (w *wildcard) Eval(input data.Value) (data.Value, error) {
 		if !exists {
 			return nil, fmt.Errorf("there is no entry with key '%s'", w.Relation)
 		}
+		if subElement.Type() == data.TypeNull {
+			// the missing side of a LEFT OUTER JOIN
+			return output, nil
+		}
 		subMap, err := data.AsMap(subElement)
 		if err != nil {
 			return nil, err
@@ -1225,7 +1546,7 @@ func (w *wildcard) Eval(input data.Value) (data.Value, error) {
 	} else {
 		// if we have *, take items from all submaps
 		for alias, subElement := range aMap {
-			if strings.Contains(alias, ":meta:") {
+			if strings.Contains(alias, ":meta:") || subElement.Type() == data.TypeNull {
 				continue
 			}
 			subMap, err := data.AsMap(subElement)
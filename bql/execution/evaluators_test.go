@@ -53,6 +53,33 @@ func TestEvaluators(t *testing.T) {
 	}
 }
 
+type countingEvaluator struct {
+	val   data.Value
+	count int
+}
+
+func (c *countingEvaluator) Eval(input data.Value) (data.Value, error) {
+	c.count++
+	return c.val, nil
+}
+
+func TestBetween(t *testing.T) {
+	Convey("Given a BETWEEN evaluator", t, func() {
+		expr := &countingEvaluator{val: data.Int(2)}
+		b := &between{expr, &intConstant{1}, &intConstant{3}, false}
+
+		Convey("When evaluating it", func() {
+			v, err := b.Eval(data.Map{})
+			So(err, ShouldBeNil)
+
+			Convey("Then the expression should be evaluated only once", func() {
+				So(v, ShouldEqual, data.Bool(true))
+				So(expr.count, ShouldEqual, 1)
+			})
+		})
+	})
+}
+
 func TestFoldableExecution(t *testing.T) {
 	testCases := []struct {
 		ast      parser.Expression
@@ -1311,6 +1338,52 @@ func getTestCases() []struct {
 				// left and right present and not comparable => error
 			}, incomparables...),
 		},
+		// Between
+		{parser.BetweenAST{parser.Between, parser.RowValue{"", "a"},
+			parser.RowValue{"", "b"}, parser.RowValue{"", "c"}},
+			[]evalTest{
+				// not a map:
+				{data.Int(17), nil},
+				// keys not present:
+				{data.Map{"a": data.Int(2), "b": data.Int(1)}, nil},
+				// in range => true
+				{data.Map{"a": data.Int(2), "b": data.Int(1), "c": data.Int(3)}, data.Bool(true)},
+				{data.Map{"a": data.Int(1), "b": data.Int(1), "c": data.Int(3)}, data.Bool(true)},
+				{data.Map{"a": data.Int(3), "b": data.Int(1), "c": data.Float(3.0)}, data.Bool(true)},
+				{data.Map{"a": data.String("b"), "b": data.String("a"), "c": data.String("c")}, data.Bool(true)},
+				// out of range => false
+				{data.Map{"a": data.Int(0), "b": data.Int(1), "c": data.Int(3)}, data.Bool(false)},
+				{data.Map{"a": data.Float(3.14), "b": data.Int(1), "c": data.Int(3)}, data.Bool(false)},
+				{data.Map{"a": data.Int(2), "b": data.Int(3), "c": data.Int(1)}, data.Bool(false)},
+				// NULL involved => NULL unless a bound is violated
+				{data.Map{"a": data.Null{}, "b": data.Int(1), "c": data.Int(3)}, data.Null{}},
+				{data.Map{"a": data.Int(2), "b": data.Null{}, "c": data.Int(3)}, data.Null{}},
+				{data.Map{"a": data.Int(2), "b": data.Int(1), "c": data.Null{}}, data.Null{}},
+				{data.Map{"a": data.Int(0), "b": data.Int(1), "c": data.Null{}}, data.Bool(false)},
+				{data.Map{"a": data.Int(4), "b": data.Null{}, "c": data.Int(3)}, data.Bool(false)},
+				// not comparable => error
+				{data.Map{"a": data.Int(2), "b": data.String("a"), "c": data.Int(3)}, nil},
+				{data.Map{"a": data.Int(2), "b": data.Int(1), "c": data.String("c")}, nil},
+			},
+		},
+		// NotBetween
+		{parser.BetweenAST{parser.NotBetween, parser.RowValue{"", "a"},
+			parser.RowValue{"", "b"}, parser.RowValue{"", "c"}},
+			[]evalTest{
+				// in range => false
+				{data.Map{"a": data.Int(2), "b": data.Int(1), "c": data.Int(3)}, data.Bool(false)},
+				{data.Map{"a": data.Int(3), "b": data.Int(1), "c": data.Int(3)}, data.Bool(false)},
+				// out of range => true
+				{data.Map{"a": data.Int(0), "b": data.Int(1), "c": data.Int(3)}, data.Bool(true)},
+				{data.Map{"a": data.Int(4), "b": data.Int(1), "c": data.Int(3)}, data.Bool(true)},
+				// NULL involved => NULL unless a bound is violated
+				{data.Map{"a": data.Null{}, "b": data.Int(1), "c": data.Int(3)}, data.Null{}},
+				{data.Map{"a": data.Int(2), "b": data.Int(1), "c": data.Null{}}, data.Null{}},
+				{data.Map{"a": data.Int(0), "b": data.Int(1), "c": data.Null{}}, data.Bool(true)},
+				// not comparable => error
+				{data.Map{"a": data.Int(2), "b": data.String("a"), "c": data.Int(3)}, nil},
+			},
+		},
 		// NotEqual
 		{parser.BinaryOpAST{parser.NotEqual, parser.RowValue{"", "a"}, parser.RowValue{"", "b"}},
 			append([]evalTest{
@@ -1422,6 +1495,118 @@ func getTestCases() []struct {
 					"b": data.String("b")}, data.String("ab")},
 			}, nullOps...),
 		},
+		// In
+		{parser.BinaryOpAST{parser.In, parser.RowValue{"", "a"}, parser.RowValue{"", "b"}},
+			append([]evalTest{
+				// not a map:
+				{data.Int(17), nil},
+				// keys not present:
+				{data.Map{"x": data.Int(17)}, nil},
+				// right side is not an array => error
+				{data.Map{"a": data.Int(1),
+					"b": data.Int(1)}, nil},
+				{data.Map{"a": data.Int(1),
+					"b": data.String("1")}, nil},
+				// left and right present
+				{data.Map{"a": data.Int(1),
+					"b": data.Array{}}, data.Bool(false)},
+				{data.Map{"a": data.Int(1),
+					"b": data.Array{data.Int(2), data.Float(1.0)}}, data.Bool(true)},
+				{data.Map{"a": data.String("x"),
+					"b": data.Array{data.String("y"), data.String("x")}}, data.Bool(true)},
+				{data.Map{"a": data.String("x"),
+					"b": data.Array{data.String("y")}}, data.Bool(false)},
+				// not found, but NULL in the array => NULL
+				{data.Map{"a": data.String("x"),
+					"b": data.Array{data.String("y"), data.Null{}}}, data.Null{}},
+				{data.Map{"a": data.String("x"),
+					"b": data.Array{data.String("x"), data.Null{}}}, data.Bool(true)},
+			}, nullOps...),
+		},
+		{parser.BinaryOpAST{parser.NotIn, parser.RowValue{"", "a"},
+			parser.ArrayAST{parser.ExpressionsAST{[]parser.Expression{
+				parser.NumericLiteral{2}, parser.NumericLiteral{3}}}}},
+			[]evalTest{
+				{data.Map{"a": data.Int(1)}, data.Bool(true)},
+				{data.Map{"a": data.Int(3)}, data.Bool(false)},
+				{data.Map{"a": data.Null{}}, data.Null{}},
+			},
+		},
+		// Like
+		{parser.BinaryOpAST{parser.Like, parser.RowValue{"", "a"}, parser.RowValue{"", "b"}},
+			append([]evalTest{
+				// not a map:
+				{data.Int(17), nil},
+				// keys not present:
+				{data.Map{"x": data.Int(17)}, nil},
+				// not a string => error
+				{data.Map{"a": data.Int(1),
+					"b": data.String("1")}, nil},
+				{data.Map{"a": data.String("1"),
+					"b": data.Int(1)}, nil},
+				// invalid pattern => error
+				{data.Map{"a": data.String("a"),
+					"b": data.String(`a\`)}, nil},
+				// left and right present
+				{data.Map{"a": data.String("dev-01"),
+					"b": data.String("dev-%")}, data.Bool(true)},
+				{data.Map{"a": data.String("DEV-01"),
+					"b": data.String("dev-%")}, data.Bool(false)},
+				{data.Map{"a": data.String("dev-01"),
+					"b": data.String("dev-_")}, data.Bool(false)},
+				{data.Map{"a": data.String("dev-1"),
+					"b": data.String("dev-_")}, data.Bool(true)},
+				{data.Map{"a": data.String("a.b"),
+					"b": data.String("a.b")}, data.Bool(true)},
+				{data.Map{"a": data.String("axb"),
+					"b": data.String("a.b")}, data.Bool(false)},
+				{data.Map{"a": data.String("100%"),
+					"b": data.String(`%\%`)}, data.Bool(true)},
+				{data.Map{"a": data.String("100"),
+					"b": data.String(`%\%`)}, data.Bool(false)},
+				{data.Map{"a": data.String("日本\n語"),
+					"b": data.String("日本%")}, data.Bool(true)},
+			}, nullOps...),
+		},
+		{parser.BinaryOpAST{parser.ILike, parser.RowValue{"", "a"}, parser.StringLiteral{"dev-%"}},
+			[]evalTest{
+				{data.Map{"a": data.String("DEV-01")}, data.Bool(true)},
+				{data.Map{"a": data.String("sensor")}, data.Bool(false)},
+				{data.Map{"a": data.Null{}}, data.Null{}},
+			},
+		},
+		{parser.BinaryOpAST{parser.NotLike, parser.RowValue{"", "a"}, parser.StringLiteral{"dev-%"}},
+			[]evalTest{
+				{data.Map{"a": data.String("dev-01")}, data.Bool(false)},
+				{data.Map{"a": data.String("sensor")}, data.Bool(true)},
+			},
+		},
+		// RegexpMatch
+		{parser.BinaryOpAST{parser.RegexpMatch, parser.RowValue{"", "a"}, parser.RowValue{"", "b"}},
+			append([]evalTest{
+				// not a map:
+				{data.Int(17), nil},
+				// keys not present:
+				{data.Map{"x": data.Int(17)}, nil},
+				// invalid pattern => error
+				{data.Map{"a": data.String("a"),
+					"b": data.String("(")}, nil},
+				// left and right present
+				{data.Map{"a": data.String("E1042"),
+					"b": data.String("^E1[0-9]+")}, data.Bool(true)},
+				{data.Map{"a": data.String("E2042"),
+					"b": data.String("^E1[0-9]+")}, data.Bool(false)},
+				// the pattern does not need to match the whole string
+				{data.Map{"a": data.String("alarm E1042"),
+					"b": data.String("E1")}, data.Bool(true)},
+			}, nullOps...),
+		},
+		{parser.BinaryOpAST{parser.NotRegexpMatch, parser.RowValue{"", "a"}, parser.StringLiteral{"^E1"}},
+			[]evalTest{
+				{data.Map{"a": data.String("E1042")}, data.Bool(false)},
+				{data.Map{"a": data.String("E2042")}, data.Bool(true)},
+			},
+		},
 		// IsNull
 		{parser.BinaryOpAST{parser.Is, parser.RowValue{"", "a"}, parser.NullLiteral{}},
 			[]evalTest{
@@ -1533,6 +1718,41 @@ func getTestCases() []struct {
 				// left and right present and not comparable => error
 			}, incomparables...),
 		},
+		// Intervals
+		{parser.BinaryOpAST{parser.Plus, parser.RowValue{"", "a"}, parser.IntervalLiteral{1.5, parser.Milliseconds}},
+			[]evalTest{
+				{data.Map{"a": data.Timestamp(now)}, data.Timestamp(now.Add(1500 * time.Microsecond))},
+				{data.Map{"a": data.Null{}}, data.Null{}},
+				// only timestamps can be added to intervals
+				{data.Map{"a": data.Int(1)}, nil},
+				{data.Map{"a": data.String("2016-01-01T00:00:00Z")}, nil},
+				{data.Map{"b": data.Timestamp(now)}, nil},
+			},
+		},
+		{parser.BinaryOpAST{parser.Plus, parser.IntervalLiteral{2, parser.Seconds}, parser.RowValue{"", "a"}},
+			[]evalTest{
+				{data.Map{"a": data.Timestamp(now)}, data.Timestamp(now.Add(2 * time.Second))},
+				{data.Map{"a": data.Float(1)}, nil},
+			},
+		},
+		{parser.BinaryOpAST{parser.Minus, parser.RowValue{"", "a"}, parser.IntervalLiteral{5, parser.Minutes}},
+			[]evalTest{
+				{data.Map{"a": data.Timestamp(now)}, data.Timestamp(now.Add(-5 * time.Minute))},
+				{data.Map{"a": data.Int(1)}, nil},
+			},
+		},
+		{parser.BinaryOpAST{parser.Minus, parser.IntervalLiteral{5, parser.Minutes}, parser.RowValue{"", "a"}},
+			[]evalTest{
+				// a timestamp cannot be subtracted from an interval
+				{data.Map{"a": data.Timestamp(now)}, nil},
+			},
+		},
+		{parser.IntervalLiteral{1, parser.Hours},
+			[]evalTest{
+				// an interval alone is the number of microseconds
+				{data.Map{}, data.Int(3600 * 1000 * 1000)},
+			},
+		},
 		// Multiply
 		{parser.BinaryOpAST{parser.Multiply, parser.RowValue{"", "a"}, parser.RowValue{"", "b"}},
 			append([]evalTest{
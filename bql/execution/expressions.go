@@ -97,6 +97,8 @@ func ParserExprToFlatExpr(e parser.Expression, reg udf.FunctionRegistry) (FlatEx
 		return numericLiteral{obj.Value}, nil
 	case parser.FloatLiteral:
 		return floatLiteral{obj.Value}, nil
+	case parser.IntervalLiteral:
+		return intervalLiteral{obj.Microseconds()}, nil
 	case parser.BoolLiteral:
 		return boolLiteral{obj.Value}, nil
 	case parser.StringLiteral:
@@ -132,6 +134,16 @@ func ParserExprToFlatExpr(e parser.Expression, reg udf.FunctionRegistry) (FlatEx
 			return nil, err
 		}
 		return binaryOpAST{obj.Op, left, right}, nil
+	case parser.BetweenAST:
+		exprs := make([]FlatExpression, 3)
+		for i, ast := range []parser.Expression{obj.Expr, obj.Lower, obj.Upper} {
+			expr, err := ParserExprToFlatExpr(ast, reg)
+			if err != nil {
+				return nil, err
+			}
+			exprs[i] = expr
+		}
+		return betweenAST{obj.Op, exprs[0], exprs[1], exprs[2]}, nil
 	case parser.UnaryOpAST:
 		// recurse
 		expr, err := ParserExprToFlatExpr(obj.Expr, reg)
@@ -151,6 +163,21 @@ func ParserExprToFlatExpr(e parser.Expression, reg udf.FunctionRegistry) (FlatEx
 		if string(obj.Function) == "now" && len(obj.Expressions) == 0 && len(obj.Ordering) == 0 {
 			return stmtMeta{parser.NowMeta}, nil
 		}
+		// higher-order functions are not in the registry
+		if _, ok := higherOrderFuncs[string(obj.Function)]; ok {
+			if err := checkHigherOrderFuncApp(obj); err != nil {
+				return nil, err
+			}
+			exprs := make([]FlatExpression, len(obj.Expressions))
+			for i, ast := range obj.Expressions {
+				expr, err := ParserExprToFlatExpr(ast, reg)
+				if err != nil {
+					return nil, err
+				}
+				exprs[i] = expr
+			}
+			return funcAppAST{obj.Function, exprs}, nil
+		}
 		// look up the function
 		function, err := reg.Lookup(string(obj.Function), len(obj.Expressions))
 		if err != nil {
@@ -176,6 +203,12 @@ func ParserExprToFlatExpr(e parser.Expression, reg udf.FunctionRegistry) (FlatEx
 			exprs[i] = expr
 		}
 		return funcAppAST{obj.Function, exprs}, nil
+	case parser.WindowFuncAppAST:
+		return windowFuncAppToFlatExpr(obj, reg)
+	case parser.LambdaAST:
+		return lambdaToFlatExpr(obj, reg)
+	case parser.LambdaVariable:
+		return lambdaVariable{obj.Name, obj.Path}, nil
 	case parser.ArrayAST:
 		// compute child expressions
 		exprs := make([]FlatExpression, len(obj.Expressions))
@@ -292,6 +325,25 @@ func ParserExprToMaybeAggregate(e parser.Expression, aggIdx int, reg udf.Functio
 			returnAgg = rightAgg
 		}
 		return binaryOpAST{obj.Op, left, right}, returnAgg, nil
+	case parser.BetweenAST:
+		exprs := make([]FlatExpression, 3)
+		returnAgg := map[string]FlatExpression{}
+		for i, ast := range []parser.Expression{obj.Expr, obj.Lower, obj.Upper} {
+			// compute the correct aggIdx
+			newAggIdx := aggIdx + len(returnAgg)
+			expr, agg, err := ParserExprToMaybeAggregate(ast, newAggIdx, reg)
+			if err != nil {
+				return nil, nil, err
+			}
+			for key, val := range agg {
+				returnAgg[key] = val
+			}
+			exprs[i] = expr
+		}
+		if len(returnAgg) == 0 {
+			returnAgg = nil
+		}
+		return betweenAST{obj.Op, exprs[0], exprs[1], exprs[2]}, returnAgg, nil
 	case parser.UnaryOpAST:
 		// recurse
 		expr, agg, err := ParserExprToMaybeAggregate(obj.Expr, aggIdx, reg)
@@ -311,6 +363,40 @@ func ParserExprToMaybeAggregate(e parser.Expression, aggIdx int, reg udf.Functio
 		if string(obj.Function) == "now" && len(obj.Expressions) == 0 {
 			return stmtMeta{parser.NowMeta}, nil, nil
 		}
+		// higher-order functions are not in the registry; their
+		// arguments (except for the lambda expression) may contain
+		// aggregates
+		if _, ok := higherOrderFuncs[string(obj.Function)]; ok {
+			if err := checkHigherOrderFuncApp(obj); err != nil {
+				return nil, nil, err
+			}
+			exprs := make([]FlatExpression, len(obj.Expressions))
+			returnAgg := map[string]FlatExpression{}
+			for i, ast := range obj.Expressions {
+				if lambda, ok := ast.(parser.LambdaAST); ok {
+					expr, err := lambdaToFlatExpr(lambda, reg)
+					if err != nil {
+						return nil, nil, err
+					}
+					exprs[i] = expr
+					continue
+				}
+				// compute the correct aggIdx
+				newAggIdx := aggIdx + len(returnAgg)
+				expr, agg, err := ParserExprToMaybeAggregate(ast, newAggIdx, reg)
+				if err != nil {
+					return nil, nil, err
+				}
+				for key, val := range agg {
+					returnAgg[key] = val
+				}
+				exprs[i] = expr
+			}
+			if len(returnAgg) == 0 {
+				returnAgg = nil
+			}
+			return funcAppAST{obj.Function, exprs}, returnAgg, nil
+		}
 		// look up the function
 		function, err := reg.Lookup(string(obj.Function), len(obj.Expressions))
 		if err != nil {
@@ -636,6 +722,38 @@ func (b binaryOpAST) ContainsWildcard() bool {
 	return b.Left.ContainsWildcard() || b.Right.ContainsWildcard()
 }
 
+type betweenAST struct {
+	Op    parser.Operator
+	Expr  FlatExpression
+	Lower FlatExpression
+	Upper FlatExpression
+}
+
+func (b betweenAST) Repr() string {
+	return fmt.Sprintf("(%s)%s(%s)AND(%s)", b.Expr.Repr(), b.Op, b.Lower.Repr(), b.Upper.Repr())
+}
+
+func (b betweenAST) Columns() []rowValue {
+	cols := append(b.Expr.Columns(), b.Lower.Columns()...)
+	return append(cols, b.Upper.Columns()...)
+}
+
+func (b betweenAST) Volatility() VolatilityType {
+	// take the lowest level of all sub-expressions
+	v := b.Expr.Volatility()
+	for _, e := range []FlatExpression{b.Lower, b.Upper} {
+		if ev := e.Volatility(); ev < v {
+			v = ev
+		}
+	}
+	return v
+}
+
+func (b betweenAST) ContainsWildcard() bool {
+	return b.Expr.ContainsWildcard() || b.Lower.ContainsWildcard() ||
+		b.Upper.ContainsWildcard()
+}
+
 type unaryOpAST struct {
 	Op   parser.Operator
 	Expr FlatExpression
@@ -744,6 +862,104 @@ func (a aggregateInputSorter) Repr() string {
 		strings.Join(reprs, ","), strings.Join(ordering, ","))
 }
 
+type windowSortExpression struct {
+	Value     FlatExpression
+	Ascending bool
+}
+
+type windowFuncAppAST struct {
+	Function    parser.FuncName
+	Expressions []FlatExpression
+	Partition   []FlatExpression
+	Ordering    []windowSortExpression
+}
+
+func (w windowFuncAppAST) Repr() string {
+	reprs := make([]string, len(w.Expressions))
+	for i, e := range w.Expressions {
+		reprs[i] = e.Repr()
+	}
+	var clauses []string
+	if len(w.Partition) > 0 {
+		partition := make([]string, len(w.Partition))
+		for i, e := range w.Partition {
+			partition[i] = e.Repr()
+		}
+		clauses = append(clauses, "PARTITION BY "+strings.Join(partition, ","))
+	}
+	if len(w.Ordering) > 0 {
+		ordering := make([]string, len(w.Ordering))
+		for i, e := range w.Ordering {
+			ordering[i] = e.Value.Repr()
+			if e.Ascending {
+				ordering[i] += " ASC"
+			} else {
+				ordering[i] += " DESC"
+			}
+		}
+		clauses = append(clauses, "ORDER BY "+strings.Join(ordering, ","))
+	}
+	return fmt.Sprintf("%s(%s) OVER (%s)", w.Function,
+		strings.Join(reprs, ","), strings.Join(clauses, " "))
+}
+
+// Key returns the key under which the values of the window function
+// are stored in the row that is passed to the projection evaluators.
+func (w windowFuncAppAST) Key() string {
+	h := sha1.New()
+	h.Write([]byte(w.Repr()))
+	return "w_" + hex.EncodeToString(h.Sum(nil))[:8]
+}
+
+func (w windowFuncAppAST) Columns() []rowValue {
+	var allColumns []rowValue
+	for _, e := range w.Expressions {
+		allColumns = append(allColumns, e.Columns()...)
+	}
+	for _, e := range w.Partition {
+		allColumns = append(allColumns, e.Columns()...)
+	}
+	for _, e := range w.Ordering {
+		allColumns = append(allColumns, e.Value.Columns()...)
+	}
+	return allColumns
+}
+
+func (w windowFuncAppAST) Volatility() VolatilityType {
+	// the value depends on the other rows in the window
+	return Volatile
+}
+
+func (w windowFuncAppAST) ContainsWildcard() bool {
+	for _, e := range w.Expressions {
+		if e.ContainsWildcard() {
+			return true
+		}
+	}
+	return false
+}
+
+type lambdaAST struct {
+	Params []string
+	Body   FlatExpression
+}
+
+func (l lambdaAST) Repr() string {
+	return fmt.Sprintf("(%s)->%s", strings.Join(l.Params, ","), l.Body.Repr())
+}
+
+func (l lambdaAST) Columns() []rowValue {
+	return l.Body.Columns()
+}
+
+func (l lambdaAST) Volatility() VolatilityType {
+	return l.Body.Volatility()
+}
+
+func (l lambdaAST) ContainsWildcard() bool {
+	return l.Body.ContainsWildcard()
+}
+
 type arrayAST struct {
 	Expressions []FlatExpression
 }
@@ -968,6 +1184,27 @@ func (rv rowValue) ContainsWildcard() bool {
 	return false
 }
 
+type lambdaVariable struct {
+	Name string
+	Path string
+}
+
+func (v lambdaVariable) Repr() string {
+	return fmt.Sprintf("%s%s", v.Name, v.Path)
+}
+
+func (v lambdaVariable) Columns() []rowValue {
+	return nil
+}
+
+func (v lambdaVariable) Volatility() VolatilityType {
+	return Immutable
+}
+
+func (v lambdaVariable) ContainsWildcard() bool {
+	return false
+}
+
 type stmtMeta struct {
 	MetaType parser.MetaInformation
 }
@@ -1049,6 +1286,29 @@ func (l floatLiteral) ContainsWildcard() bool {
 	return false
 }
 
+// intervalLiteral is a time interval given by an INTERVAL literal. It can
+// be added to or subtracted from a timestamp. In other places, it's
+// evaluated to the number of microseconds in the interval.
+type intervalLiteral struct {
+	Microseconds int64
+}
+
+func (l intervalLiteral) Repr() string {
+	return fmt.Sprintf("INTERVAL %v MICROSECONDS", l.Microseconds)
+}
+
+func (l intervalLiteral) Columns() []rowValue {
+	return nil
+}
+
+func (l intervalLiteral) Volatility() VolatilityType {
+	return Immutable
+}
+
+func (l intervalLiteral) ContainsWildcard() bool {
+	return false
+}
+
 type nullLiteral struct {
 }
 
@@ -16,13 +16,17 @@ type filterPlan struct {
 // CanBuildFilterPlan checks whether the given statement
 // allows to use a filterPlan.
 func CanBuildFilterPlan(lp *LogicalPlan, reg udf.FunctionRegistry) bool {
-	if len(lp.Relations) != 1 {
+	if len(lp.Relations) != 1 || lp.Join.Type != parser.UnspecifiedJoinType {
 		return false
 	}
 	return !lp.GroupingStmt &&
+		len(lp.OrderAscending) == 0 && !lp.HasLimit &&
+		!containsWindowFuncApp(lp.Projections) &&
 		lp.EmitterType == parser.Rstream &&
 		lp.Relations[0].Unit == parser.Tuples &&
-		lp.Relations[0].Value == 1
+		lp.Relations[0].Value == 1 &&
+		lp.Relations[0].Slide.Unit == parser.UnspecifiedIntervalUnit &&
+		lp.Relations[0].Watermark.Delay.Unit == parser.UnspecifiedIntervalUnit
 }
 
 // NewFilterPlan creates a fast and simple plan for the case where the
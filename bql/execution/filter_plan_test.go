@@ -341,6 +341,23 @@ func TestFilterPlan(t *testing.T) {
 
 		compareWithRef(t, plan, refPlan, tuples)
 	})
+
+	Convey("Given SELECT clauses with ORDER BY or LIMIT", t, func() {
+		reg := udf.CopyGlobalUDFRegistry(core.NewContext(nil))
+		for _, s := range []string{
+			`CREATE STREAM box AS SELECT RSTREAM int FROM src [RANGE 1 TUPLES] ORDER BY int`,
+			`CREATE STREAM box AS SELECT RSTREAM int FROM src [RANGE 1 TUPLES] LIMIT 0`,
+		} {
+			stmt, _, err := parser.New().ParseStmt(s)
+			So(err, ShouldBeNil)
+			lp, err := Analyze(stmt.(parser.CreateStreamAsSelectStmt).Select, reg)
+			So(err, ShouldBeNil)
+
+			Convey("Then the filter plan should not be used for "+s, func() {
+				So(CanBuildFilterPlan(lp, reg), ShouldBeFalse)
+			})
+		}
+	})
 }
 
 func TestFilterPlanEmitters(t *testing.T) {
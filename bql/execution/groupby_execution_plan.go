@@ -9,6 +9,9 @@ import (
 
 type groupbyExecutionPlan struct {
 	streamRelationStreamExecutionPlan
+	// incremental is non-nil if all aggregate functions in the statement
+	// can be computed incrementally.
+	incremental *incrementalGroupby
 }
 
 // tmpGroupData is an intermediate data structure to represent
@@ -41,13 +44,23 @@ func CanBuildGroupbyExecutionPlan(lp *LogicalPlan, reg udf.FunctionRegistry) boo
 // - perform a SELECT query on that data,
 // - compute the data that need to be emitted by comparison with
 //   the previous run's results.
+//
+// If all aggregate functions are udf.IncrementalAggregates, their results
+// are not computed from all rows in the window every time, but updated
+// with the rows that were added to or removed from the window.
 func NewGroupbyExecutionPlan(lp *LogicalPlan, reg udf.FunctionRegistry) (PhysicalPlan, error) {
 	underlying, err := newStreamRelationStreamExecutionPlan(lp, reg)
 	if err != nil {
 		return nil, err
 	}
+	incremental, err := newIncrementalGroupby(lp, reg)
+	if err != nil {
+		return nil, err
+	}
+	underlying.trackRowChanges = incremental != nil
 	return &groupbyExecutionPlan{
 		*underlying,
+		incremental,
 	}, nil
 }
 
@@ -71,6 +84,10 @@ func (ep *groupbyExecutionPlan) Process(input *core.Tuple) ([]data.Map, error) {
 // if no error had happened), but the contents of ep.curResults are
 // undefined.
 func (ep *groupbyExecutionPlan) performQueryOnBuffer() error {
+	if ep.incremental != nil && ep.trackRowChanges {
+		return ep.performIncrementalQuery()
+	}
+
 	// reuse the allocated memory
 	output := ep.prevResults[0:0]
 	// remember the previous results
@@ -163,28 +180,9 @@ func (ep *groupbyExecutionPlan) performQueryOnBuffer() error {
 	// function to compute the grouping expressions and store the
 	// input for aggregate functions in the correct group.
 	evalItem := func(io *inputRowWithCachedResult) error {
-		var itemGroupValues data.Array
-		// if we have a cached result, use this
-		if io.cache != nil {
-			cachedGroupValues, err := data.AsArray(io.cache)
-			if err != nil {
-				return fmt.Errorf("cached data was not an array: %v", io.cache)
-			}
-			itemGroupValues = cachedGroupValues
-		} else {
-			// otherwise, compute the expressions in the GROUP BY to find
-			// the correct group to append to
-			itemGroupValues = make([]data.Value, len(ep.groupList))
-			for i, eval := range ep.groupList {
-				// ordinary "flat" expression
-				value, err := eval.Eval(*io.input)
-				if err != nil {
-					return err
-				}
-				itemGroupValues[i] = value
-			}
-			io.cache = itemGroupValues
-			io.hash = data.Hash(io.cache)
+		itemGroupValues, err := ep.groupValues(io)
+		if err != nil {
+			return err
 		}
 
 		itemGroup, err := findOrCreateGroup(itemGroupValues, io.hash, *io.input)
@@ -206,87 +204,19 @@ func (ep *groupbyExecutionPlan) performQueryOnBuffer() error {
 	}
 
 	evalGroup := func(group *tmpGroupData) error {
-		result := data.Map(make(map[string]data.Value, len(ep.projections)))
 		// collect input for aggregate functions into an array
 		// within each group
 		for key := range allAggEvaluators {
 			group.nonAggData[key] = data.Array(group.aggData[key])
 			delete(group.aggData, key)
 		}
-		// evaluate HAVING condition, if there is one
-		for _, proj := range ep.projections {
-			if proj.alias == ":having:" {
-				havingResult, err := proj.evaluator.Eval(group.nonAggData)
-				if err != nil {
-					return err
-				}
-				// a NULL value is definitely not "true", so since we
-				// have only a binary decision, we should drop tuples
-				// where the condition evaluates to NULL
-				havingResultBool := false
-				if havingResult.Type() != data.TypeNull {
-					havingResultBool, err = data.AsBool(havingResult)
-					if err != nil {
-						return err
-					}
-				}
-				// if it evaluated to false, do not further process this group
-				if !havingResultBool {
-					return nil
-				}
-				break
-			}
+		result, err := ep.evalGroup(ep.projections, group.nonAggData)
+		if err != nil {
+			return err
 		}
-		// now evaluate all other projections
-		for _, proj := range ep.projections {
-			if proj.alias == ":having:" {
-				continue
-			}
-			// now evaluate this projection on the flattened data
-			value, err := proj.evaluator.Eval(group.nonAggData)
-			if err != nil {
-				return err
-			}
-			if err := assignOutputValue(result, proj.alias, proj.aliasPath, value); err != nil {
-				return err
-			}
+		if result != nil {
+			output = append(output, resultRow{row: result, hash: data.Hash(result)})
 		}
-		output = append(output, resultRow{row: result, hash: data.Hash(result)})
-		return nil
-	}
-
-	evalNoGroup := func() error {
-		// if we have an empty group list *and* a GROUP BY clause,
-		// we have to return an empty result (because there are no
-		// rows with "the same values"). but if the list is empty and
-		// we *don't* have a GROUP BY clause, then we need to compute
-		// all foldables and aggregates with an empty input
-		if len(ep.groupList) > 0 {
-			return nil
-		}
-		input := data.Map{}
-		result := data.Map(make(map[string]data.Value, len(ep.projections)))
-		for _, proj := range ep.projections {
-			// collect input for aggregate functions
-			if proj.hasAggregate {
-				for key := range proj.aggrEvals {
-					input[key] = data.Array{}
-				}
-			}
-			// now evaluate this projection on the flattened data.
-			// note that input has *only* the keys of the empty
-			// arrays, no other columns, but we cannot have other
-			// columns involved in the projection (since we know
-			// that GROUP BY is empty).
-			value, err := proj.evaluator.Eval(input)
-			if err != nil {
-				return err
-			}
-			if err := assignOutputValue(result, proj.alias, proj.aliasPath, value); err != nil {
-				return err
-			}
-		}
-		output = append(output, resultRow{row: result, hash: data.Hash(result)})
 		return nil
 	}
 
@@ -312,12 +242,125 @@ func (ep *groupbyExecutionPlan) performQueryOnBuffer() error {
 		}
 	}
 	if len(groups) == 0 {
-		if err := evalNoGroup(); err != nil {
+		result, err := ep.evalNoGroup()
+		if err != nil {
 			rollback()
 			return err
 		}
+		if result != nil {
+			output = append(output, resultRow{row: result, hash: data.Hash(result)})
+		}
 	}
 
 	ep.curResults = output
 	return nil
 }
+
+// groupValues returns the values of the GROUP BY expressions of a row.
+// The values are cached in the row.
+func (ep *groupbyExecutionPlan) groupValues(io *inputRowWithCachedResult) (data.Array, error) {
+	// if we have a cached result, use this
+	if io.cache != nil {
+		cachedGroupValues, err := data.AsArray(io.cache)
+		if err != nil {
+			return nil, fmt.Errorf("cached data was not an array: %v", io.cache)
+		}
+		return cachedGroupValues, nil
+	}
+	// otherwise, compute the expressions in the GROUP BY to find
+	// the correct group to append to
+	itemGroupValues := make(data.Array, len(ep.groupList))
+	for i, eval := range ep.groupList {
+		// ordinary "flat" expression
+		value, err := eval.Eval(*io.input)
+		if err != nil {
+			return nil, err
+		}
+		itemGroupValues[i] = value
+	}
+	io.cache = itemGroupValues
+	io.hash = data.Hash(io.cache)
+	return itemGroupValues, nil
+}
+
+// evalGroup evaluates the HAVING clause and the projections on the data
+// of a group, which must hold the results or the inputs of the aggregate
+// functions. It returns nil if the HAVING clause doesn't hold.
+func (ep *groupbyExecutionPlan) evalGroup(projections []aliasedEvaluator, groupData data.Map) (data.Map, error) {
+	result := data.Map(make(map[string]data.Value, len(projections)))
+	// evaluate HAVING condition, if there is one
+	for _, proj := range projections {
+		if proj.alias == ":having:" {
+			havingResult, err := proj.evaluator.Eval(groupData)
+			if err != nil {
+				return nil, err
+			}
+			// a NULL value is definitely not "true", so since we
+			// have only a binary decision, we should drop tuples
+			// where the condition evaluates to NULL
+			havingResultBool := false
+			if havingResult.Type() != data.TypeNull {
+				havingResultBool, err = data.AsBool(havingResult)
+				if err != nil {
+					return nil, err
+				}
+			}
+			// if it evaluated to false, do not further process this group
+			if !havingResultBool {
+				return nil, nil
+			}
+			break
+		}
+	}
+	// now evaluate all other projections
+	for _, proj := range projections {
+		if proj.alias == ":having:" {
+			continue
+		}
+		// now evaluate this projection on the flattened data
+		value, err := proj.evaluator.Eval(groupData)
+		if err != nil {
+			return nil, err
+		}
+		if err := assignOutputValue(result, proj.alias, proj.aliasPath, value); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// evalNoGroup computes the result of a statement when there is no
+// input row. It returns nil if there is no result.
+func (ep *groupbyExecutionPlan) evalNoGroup() (data.Map, error) {
+	// if we have an empty group list *and* a GROUP BY clause,
+	// we have to return an empty result (because there are no
+	// rows with "the same values"). but if the list is empty and
+	// we *don't* have a GROUP BY clause, then we need to compute
+	// all foldables and aggregates with an empty input
+	if len(ep.groupList) > 0 {
+		return nil, nil
+	}
+	input := data.Map{}
+	result := data.Map(make(map[string]data.Value, len(ep.projections)))
+	for _, proj := range ep.projections {
+		// collect input for aggregate functions
+		if proj.hasAggregate {
+			for key := range proj.aggrEvals {
+				input[key] = data.Array{}
+			}
+		}
+		// now evaluate this projection on the flattened data.
+		// note that input has *only* the keys of the empty
+		// arrays, no other columns, but we cannot have other
+		// columns involved in the projection (since we know
+		// that GROUP BY is empty).
+		value, err := proj.evaluator.Eval(input)
+		if err != nil {
+			return nil, err
+		}
+		if err := assignOutputValue(result, proj.alias, proj.aliasPath, value); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
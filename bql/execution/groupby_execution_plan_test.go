@@ -16,6 +16,7 @@ func createGroupbyPlan(s string, t *testing.T) (PhysicalPlan, error) {
 	p := parser.New()
 	reg := udf.CopyGlobalUDFRegistry(core.NewContext(nil))
 	reg.Register("udaf", &dummyAggregate{})
+	reg.Register("count_distinct", udf.ConvertUDAF(&countDistinctUDAF{}))
 	_stmt, _, err := p.ParseStmt(s)
 	if err != nil {
 		return nil, err
@@ -34,6 +35,31 @@ func createGroupbyPlan(s string, t *testing.T) (PhysicalPlan, error) {
 	return NewGroupbyExecutionPlan(logicalPlan, reg)
 }
 
+// countDistinctUDAF is a udf.UDAF counting distinct values.
+type countDistinctUDAF struct{}
+
+type countDistinctState map[data.HashValue]bool
+
+func (u *countDistinctUDAF) Init(ctx *core.Context) (udf.UDAFState, error) {
+	return countDistinctState{}, nil
+}
+
+func (s countDistinctState) Accumulate(ctx *core.Context, v data.Value) error {
+	s[data.Hash(v)] = true
+	return nil
+}
+
+func (s countDistinctState) Merge(ctx *core.Context, other udf.UDAFState) error {
+	for h := range other.(countDistinctState) {
+		s[h] = true
+	}
+	return nil
+}
+
+func (s countDistinctState) Finalize(ctx *core.Context) (data.Value, error) {
+	return data.Int(len(s)), nil
+}
+
 func getOtherTuples() []*core.Tuple {
 	tuples := getTuples(4)
 	tuples[0].Data["foo"] = data.Int(1)
@@ -834,6 +860,256 @@ func TestGroupbyExecutionPlan(t *testing.T) {
 		So(err, ShouldNotBeNil)
 		So(err.Error(), ShouldEqual, `using metadata 'TS' in GROUP BY statements is not supported yet`)
 	})
+
+	Convey("Given a SELECT clause with GROUP BY and a tumbling window", t, func() {
+		tuples := getOtherTuples()
+
+		s := `CREATE STREAM box AS SELECT RSTREAM foo, count(int) AS c FROM src
+			[RANGE 2 SECONDS SLIDE 2 SECONDS] GROUP BY foo`
+		plan, err := createGroupbyPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			for idx, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+
+				Convey(fmt.Sprintf("Then results should only appear on window boundaries in %v", idx), func() {
+					if idx == 2 {
+						So(out, ShouldResemble, []data.Map{
+							{"foo": data.Int(1), "c": data.Int(2)},
+						})
+					} else {
+						So(out, ShouldBeEmpty)
+					}
+				})
+			}
+
+		})
+	})
+
+	Convey("Given a SELECT clause with a tumbling window and an allowed lateness", t, func() {
+		tuples := getTuples(5)
+
+		s := `CREATE STREAM box AS SELECT RSTREAM count(int) AS c FROM src
+			[RANGE 2 SECONDS SLIDE 2 SECONDS, WATERMARK DELAY 0 SECONDS, ALLOWED LATENESS 4 SECONDS]`
+		plan, err := createGroupbyPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			for idx, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+				if idx == 2 || idx == 4 {
+					So(out, ShouldResemble, []data.Map{{"c": data.Int(2)}})
+				} else {
+					So(out, ShouldBeEmpty)
+				}
+			}
+
+			Convey("And a late tuple for a closed window arrives", func() {
+				late := getTuples(2)[1]
+				out, err := plan.Process(late)
+				So(err, ShouldBeNil)
+
+				Convey("Then the corrected result should be emitted", func() {
+					So(out, ShouldResemble, []data.Map{{"c": data.Int(3)}})
+					So(plan.(LateTupleReporter).LateTuples(), ShouldBeEmpty)
+				})
+
+				Convey("And the next window should not be affected", func() {
+					tuples := getTuples(7)
+					plan.Process(tuples[5])
+					out, err := plan.Process(tuples[6])
+					So(err, ShouldBeNil)
+					So(out, ShouldResemble, []data.Map{{"c": data.Int(2)}})
+				})
+			})
+
+			Convey("And a tuple later than the allowed lateness arrives", func() {
+				late := getTuples(1)[0]
+				late.Timestamp = late.Timestamp.Add(-time.Minute)
+				out, err := plan.Process(late)
+				So(err, ShouldBeNil)
+
+				Convey("Then it should be reported as a late tuple", func() {
+					So(out, ShouldBeEmpty)
+					So(plan.(LateTupleReporter).LateTuples(), ShouldResemble, []*core.Tuple{late})
+				})
+			})
+		})
+	})
+
+	Convey("Given a SELECT clause with GROUP BY and a session window", t, func() {
+		base := time.Date(2015, time.April, 10, 10, 23, 0, 0, time.UTC)
+		mkTuple := func(sec int, machine string) *core.Tuple {
+			return &core.Tuple{
+				Data: data.Map{
+					"machine": data.String(machine),
+				},
+				InputName: "src",
+				Timestamp: base.Add(time.Duration(sec) * time.Second),
+			}
+		}
+		tuples := []*core.Tuple{
+			mkTuple(0, "a"), mkTuple(1, "b"), mkTuple(2, "a"), mkTuple(10, "a"),
+			mkTuple(11, "b"), mkTuple(20, "c"),
+		}
+
+		s := `CREATE STREAM box AS SELECT RSTREAM machine, count(*) AS c FROM src
+			[SESSION GAP 3 SECONDS] WHERE machine != "x" GROUP BY machine`
+		plan, err := createGroupbyPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			for idx, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+
+				Convey(fmt.Sprintf("Then results should appear when sessions are closed in %v", idx), func() {
+					if idx == 3 {
+						So(out, ShouldResemble, []data.Map{
+							{"machine": data.String("b"), "c": data.Int(1)},
+							{"machine": data.String("a"), "c": data.Int(2)},
+						})
+					} else if idx == 5 {
+						So(out, ShouldResemble, []data.Map{
+							{"machine": data.String("a"), "c": data.Int(1)},
+							{"machine": data.String("b"), "c": data.Int(1)},
+						})
+					} else {
+						So(out, ShouldBeEmpty)
+					}
+				})
+			}
+		})
+
+		Convey("When feeding it with tuples not matching the WHERE clause", func() {
+			for _, inTup := range []*core.Tuple{mkTuple(0, "a"), mkTuple(2, "x")} {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+				So(out, ShouldBeEmpty)
+			}
+
+			Convey("Then they should not extend the session", func() {
+				out, err := plan.Process(mkTuple(5, "a"))
+				So(err, ShouldBeNil)
+				So(out, ShouldResemble, []data.Map{
+					{"machine": data.String("a"), "c": data.Int(1)},
+				})
+			})
+		})
+	})
+
+	Convey("Given a SELECT clause with a session window and a watermark", t, func() {
+		tuples := getTuples(4)
+		tuples[1], tuples[2] = tuples[2], tuples[1]
+
+		s := `CREATE STREAM box AS SELECT RSTREAM count(*) AS c FROM src
+			[SESSION GAP 2 SECONDS, WATERMARK DELAY 1 SECONDS]`
+		plan, err := createGroupbyPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples out of order", func() {
+			for _, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+				So(out, ShouldBeEmpty)
+			}
+
+			Convey("Then the session should be closed when the watermark passes the gap", func() {
+				later := getTuples(1)[0]
+				later.Timestamp = tuples[3].Timestamp.Add(3 * time.Second)
+				out, err := plan.Process(later)
+				So(err, ShouldBeNil)
+				So(out, ShouldBeEmpty)
+
+				later = later.Copy()
+				later.Timestamp = later.Timestamp.Add(time.Millisecond)
+				out, err = plan.Process(later)
+				So(err, ShouldBeNil)
+				So(out, ShouldResemble, []data.Map{{"c": data.Int(4)}})
+			})
+		})
+	})
+}
+
+func TestGroupbyExecutionPlanOrderBy(t *testing.T) {
+	getRoomTuples := func() []*core.Tuple {
+		tuples := getTuples(5)
+		rooms := []string{"a", "b", "c", "a", "b"}
+		temps := []int64{20, 25, 22, 30, 15}
+		for i, t := range tuples {
+			t.Data["room"] = data.String(rooms[i])
+			t.Data["temp"] = data.Int(temps[i])
+		}
+		return tuples
+	}
+
+	Convey("Given a SELECT clause with GROUP BY, ORDER BY and LIMIT", t, func() {
+		tuples := getRoomTuples()
+
+		s := `CREATE STREAM box AS SELECT RSTREAM room, avg(temp) AS t FROM src [RANGE 5 TUPLES]
+			GROUP BY room ORDER BY t DESC, room LIMIT 2`
+		plan, err := createGroupbyPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			expected := [][]data.Map{
+				{{"room": data.String("a"), "t": data.Float(20)}},
+				{{"room": data.String("b"), "t": data.Float(25)}, {"room": data.String("a"), "t": data.Float(20)}},
+				{{"room": data.String("b"), "t": data.Float(25)}, {"room": data.String("c"), "t": data.Float(22)}},
+				{{"room": data.String("a"), "t": data.Float(25)}, {"room": data.String("b"), "t": data.Float(25)}},
+				{{"room": data.String("a"), "t": data.Float(25)}, {"room": data.String("c"), "t": data.Float(22)}},
+			}
+			for idx, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+
+				Convey(fmt.Sprintf("Then the top rows should appear in order in %v", idx), func() {
+					So(out, ShouldResemble, expected[idx])
+				})
+			}
+		})
+	})
+
+	Convey("Given a SELECT clause ordering by an aggregate that isn't projected", t, func() {
+		tuples := getRoomTuples()
+
+		s := `CREATE STREAM box AS SELECT ISTREAM room FROM src [RANGE 2 TUPLES]
+			GROUP BY room ORDER BY max(temp) LIMIT 1`
+		plan, err := createGroupbyPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			expected := [][]data.Map{
+				{{"room": data.String("a")}},
+				nil,
+				{{"room": data.String("c")}},
+				nil,
+				{{"room": data.String("b")}},
+			}
+			for idx, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+
+				Convey(fmt.Sprintf("Then only a new coldest room should appear in %v", idx), func() {
+					So(out, ShouldResemble, expected[idx])
+				})
+			}
+		})
+	})
+
+	Convey("Given a SELECT clause ordering by a column that isn't grouped", t, func() {
+		s := `CREATE STREAM box AS SELECT RSTREAM room, avg(temp) AS t FROM src [RANGE 5 TUPLES]
+			GROUP BY room ORDER BY temp`
+		_, err := createGroupbyPlan(s, t)
+
+		Convey("Then the plan should not be created", func() {
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, `column "src:temp" must appear in the GROUP BY clause`)
+		})
+	})
 }
 
 func TestAggregateFunctions(t *testing.T) {
@@ -1179,6 +1455,7 @@ func createGroupbyPlan2(s string) (PhysicalPlan, error) {
 	p := parser.New()
 	reg := udf.CopyGlobalUDFRegistry(core.NewContext(nil))
 	reg.Register("udaf", &dummyAggregate{})
+	reg.Register("count_distinct", udf.ConvertUDAF(&countDistinctUDAF{}))
 	_stmt, _, err := p.ParseStmt(s)
 	if err != nil {
 		return nil, err
@@ -1317,3 +1594,108 @@ func BenchmarkComplicatedGroupExecution(b *testing.B) {
 		}
 	}
 }
+
+func TestIncrementalGroupbyExecutionPlan(t *testing.T) {
+	getIncTuples := func() []*core.Tuple {
+		tuples := getTuples(20)
+		for i, t := range tuples {
+			t.Data["foo"] = data.Int(i % 3)
+			if i%4 == 1 {
+				t.Data["f"] = data.Null{}
+			} else {
+				t.Data["f"] = data.Float(float64(i*7%5) + 0.5)
+			}
+		}
+		return tuples
+	}
+
+	stmts := []string{
+		`CREATE STREAM box AS SELECT ISTREAM foo, count(int) AS c, sum(int) AS s,
+			avg(f) AS a, min(f) AS mi, max(int) AS ma
+			FROM src [RANGE 5 TUPLES] GROUP BY foo`,
+		`CREATE STREAM box AS SELECT RSTREAM sum(int) + 1 AS s, count(*) AS c
+			FROM src [RANGE 3 SECONDS] WHERE foo != 1`,
+		`CREATE STREAM box AS SELECT RSTREAM foo, sum(int) AS s, sum(int) * 2 AS t
+			FROM src [RANGE 4 TUPLES] GROUP BY foo HAVING count(int) > 1`,
+		`CREATE STREAM box AS SELECT RSTREAM foo, {"m": max(f), "n": [min(int)]} AS x
+			FROM src [RANGE 6 SECONDS SLIDE 2 SECONDS] GROUP BY foo`,
+		`CREATE STREAM box AS SELECT RSTREAM l:foo, count(r:int) AS c
+			FROM src [RANGE 3 TUPLES] AS l LEFT JOIN src [RANGE 2 TUPLES] AS r
+			ON l:foo = r:foo GROUP BY l:foo`,
+		`CREATE STREAM box AS SELECT RSTREAM foo, count_distinct(f) AS d, count_distinct(int % 2) AS e
+			FROM src [RANGE 7 TUPLES] GROUP BY foo`,
+		`CREATE STREAM box AS SELECT RSTREAM foo, top_k(int % 4, 2) AS k, approx_percentile(f, 0.5) AS p
+			FROM src [RANGE 8 TUPLES] GROUP BY foo`,
+	}
+
+	for i, s := range stmts {
+		s := s
+		Convey(fmt.Sprintf("Given a SELECT statement with incremental aggregates [%d]", i), t, func() {
+			plan, err := createGroupbyPlan(s, t)
+			So(err, ShouldBeNil)
+			So(plan.(*groupbyExecutionPlan).incremental, ShouldNotBeNil)
+
+			// the same statement computed from all rows in the window
+			recomputed, err := createGroupbyPlan(s, t)
+			So(err, ShouldBeNil)
+			recomputed.(*groupbyExecutionPlan).incremental = nil
+			recomputed.(*groupbyExecutionPlan).trackRowChanges = false
+
+			Convey("When feeding it with tuples", func() {
+				tuples := getIncTuples()
+				other := getIncTuples()
+
+				Convey("Then the results should be the same as the ones computed from all rows", func() {
+					for idx := range tuples {
+						out, err := plan.Process(tuples[idx])
+						So(err, ShouldBeNil)
+						expected, err := recomputed.Process(other[idx])
+						So(err, ShouldBeNil)
+						So(out, ShouldResemble, expected)
+					}
+				})
+			})
+		})
+	}
+
+	Convey("Given a SELECT statement with incremental aggregates on invalid input", t, func() {
+		tuples := getTuples(4)
+		tuples[1].Data["int"] = data.String("hoge")
+
+		s := `CREATE STREAM box AS SELECT RSTREAM sum(int) AS s FROM src [RANGE 2 TUPLES]`
+		plan, err := createGroupbyPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			Convey("Then it should fail while the invalid tuple is in the window", func() {
+				out, err := plan.Process(tuples[0])
+				So(err, ShouldBeNil)
+				So(out, ShouldResemble, []data.Map{{"s": data.Int(1)}})
+				_, err = plan.Process(tuples[1])
+				So(err, ShouldNotBeNil)
+				_, err = plan.Process(tuples[2])
+				So(err, ShouldNotBeNil)
+				out, err = plan.Process(tuples[3])
+				So(err, ShouldBeNil)
+				So(out, ShouldResemble, []data.Map{{"s": data.Int(7)}})
+			})
+		})
+	})
+
+	for _, s := range []string{
+		`CREATE STREAM box AS SELECT RSTREAM array_agg(int) AS a, count(int) AS c FROM src [RANGE 2 TUPLES]`,
+		`CREATE STREAM box AS SELECT RSTREAM udaf(int) AS a FROM src [RANGE 2 TUPLES]`,
+		`CREATE STREAM box AS SELECT RSTREAM array_agg(int ORDER BY int) AS a FROM src [RANGE 2 TUPLES]`,
+		`CREATE STREAM box AS SELECT RSTREAM top_k(int, foo + 1) AS k FROM src [RANGE 2 TUPLES] GROUP BY foo`,
+	} {
+		s := s
+		Convey(fmt.Sprintf("Given a SELECT statement with non-incremental aggregates: %v", s), t, func() {
+			plan, err := createGroupbyPlan(s, t)
+			So(err, ShouldBeNil)
+
+			Convey("Then it should compute the aggregates from all rows", func() {
+				So(plan.(*groupbyExecutionPlan).incremental, ShouldBeNil)
+			})
+		})
+	}
+}
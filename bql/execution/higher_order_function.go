@@ -0,0 +1,285 @@
+package execution
+
+import (
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// higherOrderFunc is a function that takes a lambda expression as its
+// last argument, such as
+//  SELECT RSTREAM transform(values, v -> v * 0.1 + offset) AS calibrated
+//      FROM s [RANGE 1 TUPLES]
+// Unlike a UDF, it receives the lambda expression as a function that
+// evaluates the body for the given values of the lambda's parameters.
+// args holds the values of all other arguments.
+type higherOrderFunc struct {
+	arity       int
+	lambdaArity int
+	call        func(args []data.Value, f func(...data.Value) (data.Value, error)) (data.Value, error)
+}
+
+// higherOrderFuncs are looked up before the function registry. Their
+// names are reserved in the udf package so that no UDF can be shadowed.
+var higherOrderFuncs = map[string]higherOrderFunc{
+	"transform": {2, 1, transform},
+	"filter":    {2, 1, filter},
+	"reduce":    {3, 2, reduce},
+	"any_match": {2, 1, func(args []data.Value, f func(...data.Value) (data.Value, error)) (data.Value, error) {
+		return match(args, f, true)
+	}},
+	"all_match": {2, 1, func(args []data.Value, f func(...data.Value) (data.Value, error)) (data.Value, error) {
+		return match(args, f, false)
+	}},
+}
+
+// lambdaInputArray returns the array passed to a higher-order function.
+// It returns nil if the value is NULL.
+func lambdaInputArray(v data.Value) (data.Array, error) {
+	if v.Type() == data.TypeNull {
+		return nil, nil
+	}
+	arr, err := data.AsArray(v)
+	if err != nil {
+		return nil, fmt.Errorf("%v is not an array", v)
+	}
+	return arr, nil
+}
+
+// transform applies the lambda expression to each element of an array
+// and returns an array of the results.
+func transform(args []data.Value, f func(...data.Value) (data.Value, error)) (data.Value, error) {
+	arr, err := lambdaInputArray(args[0])
+	if err != nil || arr == nil {
+		return data.Null{}, err
+	}
+	res := make(data.Array, len(arr))
+	for i, elem := range arr {
+		v, err := f(elem)
+		if err != nil {
+			return nil, err
+		}
+		res[i] = v
+	}
+	return res, nil
+}
+
+// filter returns the elements of an array for which the lambda
+// expression returns true. Elements for which it returns NULL
+// are removed.
+func filter(args []data.Value, f func(...data.Value) (data.Value, error)) (data.Value, error) {
+	arr, err := lambdaInputArray(args[0])
+	if err != nil || arr == nil {
+		return data.Null{}, err
+	}
+	res := data.Array{}
+	for _, elem := range arr {
+		v, err := f(elem)
+		if err != nil {
+			return nil, err
+		}
+		if v.Type() == data.TypeNull {
+			continue
+		}
+		b, err := data.AsBool(v)
+		if err != nil {
+			return nil, err
+		}
+		if b {
+			res = append(res, elem)
+		}
+	}
+	return res, nil
+}
+
+// reduce computes a single value from an array by calling the lambda
+// expression with the current state and each element. The initial
+// state is given as the second argument.
+func reduce(args []data.Value, f func(...data.Value) (data.Value, error)) (data.Value, error) {
+	arr, err := lambdaInputArray(args[0])
+	if err != nil || arr == nil {
+		return data.Null{}, err
+	}
+	acc := args[1]
+	for _, elem := range arr {
+		acc, err = f(acc, elem)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return acc, nil
+}
+
+// match returns true if the lambda expression returns true for any
+// element (any_match) or for all elements (all_match) of an array.
+// As with OR and AND, the result is NULL if it depends on elements
+// for which the lambda expression returns NULL.
+func match(args []data.Value, f func(...data.Value) (data.Value, error), any bool) (data.Value, error) {
+	arr, err := lambdaInputArray(args[0])
+	if err != nil || arr == nil {
+		return data.Null{}, err
+	}
+	sawNull := false
+	for _, elem := range arr {
+		v, err := f(elem)
+		if err != nil {
+			return nil, err
+		}
+		if v.Type() == data.TypeNull {
+			sawNull = true
+			continue
+		}
+		b, err := data.AsBool(v)
+		if err != nil {
+			return nil, err
+		}
+		if b == any {
+			return data.Bool(any), nil
+		}
+	}
+	if sawNull {
+		return data.Null{}, nil
+	}
+	return data.Bool(!any), nil
+}
+
+// checkHigherOrderFuncApp checks that a higher-order function is called
+// with the correct number of arguments and a lambda expression with the
+// correct number of parameters as its last argument.
+func checkHigherOrderFuncApp(obj parser.FuncAppAST) error {
+	name := string(obj.Function)
+	f := higherOrderFuncs[name]
+	if len(obj.Expressions) != f.arity {
+		return fmt.Errorf("function '%s' cannot take %d arguments",
+			name, len(obj.Expressions))
+	}
+	if len(obj.Ordering) > 0 {
+		return fmt.Errorf("you cannot use ORDER BY in non-aggregate "+
+			"function '%s'", name)
+	}
+	for _, ast := range obj.Expressions[:f.arity-1] {
+		if _, ok := ast.(parser.LambdaAST); ok {
+			return fmt.Errorf("only the last argument of function '%s' "+
+				"can be a lambda expression", name)
+		}
+	}
+	lambda, ok := obj.Expressions[f.arity-1].(parser.LambdaAST)
+	if !ok {
+		return fmt.Errorf("the last argument of function '%s' must be "+
+			"a lambda expression", name)
+	}
+	if len(lambda.Params) != f.lambdaArity {
+		return fmt.Errorf("the lambda expression passed to function '%s' "+
+			"must have %d parameters", name, f.lambdaArity)
+	}
+	return nil
+}
+
+// lambdaToFlatExpr converts a lambda expression to a FlatExpression.
+func lambdaToFlatExpr(l parser.LambdaAST, reg udf.FunctionRegistry) (FlatExpression, error) {
+	seen := map[string]bool{}
+	for _, p := range l.Params {
+		if seen[p] {
+			return nil, fmt.Errorf("duplicate parameter '%s' in lambda expression", p)
+		}
+		seen[p] = true
+	}
+	body, err := ParserExprToFlatExpr(l.Body, reg)
+	if err != nil {
+		return nil, err
+	}
+	if len(windowFuncApps(body)) > 0 {
+		return nil, fmt.Errorf("window functions cannot be used in lambda expressions")
+	}
+	return lambdaAST{l.Params, body}, nil
+}
+
+// lambdaVariableKey returns the key under which the value of a parameter
+// of a lambda expression is stored in the input of the lambda's body.
+// It cannot conflict with relation names.
+func lambdaVariableKey(name string) string {
+	return ":lambda:" + name
+}
+
+// higherOrderFuncApp evaluates a higher-order function.
+type higherOrderFuncApp struct {
+	name   string
+	f      higherOrderFunc
+	args   []Evaluator
+	keys   []string
+	body   Evaluator
+	values []data.Value
+}
+
+func newHigherOrderFuncApp(obj funcAppAST, reg udf.FunctionRegistry) (Evaluator, error) {
+	name := string(obj.Function)
+	f := higherOrderFuncs[name]
+	if len(obj.Expressions) != f.arity {
+		return nil, fmt.Errorf("function '%s' cannot take %d arguments",
+			name, len(obj.Expressions))
+	}
+	lambda, ok := obj.Expressions[f.arity-1].(lambdaAST)
+	if !ok {
+		return nil, fmt.Errorf("the last argument of function '%s' must be "+
+			"a lambda expression", name)
+	}
+	args := make([]Evaluator, f.arity-1)
+	for i, ast := range obj.Expressions[:f.arity-1] {
+		eval, err := ExpressionToEvaluator(ast, reg)
+		if err != nil {
+			return nil, err
+		}
+		args[i] = eval
+	}
+	body, err := ExpressionToEvaluator(lambda.Body, reg)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]string, len(lambda.Params))
+	for i, p := range lambda.Params {
+		keys[i] = lambdaVariableKey(p)
+	}
+	return &higherOrderFuncApp{
+		name:   name,
+		f:      f,
+		args:   args,
+		keys:   keys,
+		body:   body,
+		values: make([]data.Value, len(args)),
+	}, nil
+}
+
+func (h *higherOrderFuncApp) Eval(input data.Value) (data.Value, error) {
+	for i, arg := range h.args {
+		v, err := arg.Eval(input)
+		if err != nil {
+			return nil, err
+		}
+		h.values[i] = v
+	}
+
+	// the body of the lambda expression can refer to the input row
+	// as well as to its parameters, which are added to a copy of it
+	bound := data.Map{}
+	if m, ok := input.(data.Map); ok {
+		for k, v := range m {
+			bound[k] = v
+		}
+	}
+	apply := func(params ...data.Value) (data.Value, error) {
+		if len(params) != len(h.keys) {
+			return nil, fmt.Errorf("the lambda expression takes %d parameters, not %d",
+				len(h.keys), len(params))
+		}
+		for i, k := range h.keys {
+			bound[k] = params[i]
+		}
+		return h.body.Eval(bound)
+	}
+	res, err := h.f.call(h.values, apply)
+	if err != nil {
+		return nil, fmt.Errorf("evaluating '%s' failed: %v", h.name, err)
+	}
+	return res, nil
+}
@@ -0,0 +1,140 @@
+package execution
+
+import (
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"testing"
+)
+
+func TestHigherOrderFunctions(t *testing.T) {
+	reg := &testFuncRegistry{ctx: core.NewContext(nil)}
+	input := data.Map{
+		"a":      data.Array{data.Int(1), data.Int(2), data.Null{}, data.Int(4)},
+		"b":      data.Int(10),
+		"nested": data.Array{data.Map{"v": data.Array{data.Int(1), data.Int(2)}}, data.Map{"v": data.Array{data.Int(3)}}},
+		"bools":  data.Array{data.Bool(false), data.Null{}},
+		"empty":  data.Array{},
+		"n":      data.Null{},
+	}
+
+	testCases := []struct {
+		expr     string
+		expected data.Value
+	}{
+		// transform
+		{"transform(a, x -> x * 2)", data.Array{data.Int(2), data.Int(4), data.Null{}, data.Int(8)}},
+		{"transform(a, x -> x + b)", data.Array{data.Int(11), data.Int(12), data.Null{}, data.Int(14)}},
+		{"transform(a, a -> a + 1)", data.Array{data.Int(2), data.Int(3), data.Null{}, data.Int(5)}},
+		{"transform(nested, x -> x.v[0])", data.Array{data.Int(1), data.Int(3)}},
+		{"transform(nested, x -> transform(x.v, y -> y * b + x.v[0]))",
+			data.Array{data.Array{data.Int(11), data.Int(21)}, data.Array{data.Int(33)}}},
+		{"transform(nested, x -> transform(x.v, x -> x * 2))",
+			data.Array{data.Array{data.Int(2), data.Int(4)}, data.Array{data.Int(6)}}},
+		{"transform(n, x -> x)", data.Null{}},
+		{"transform(b, x -> x)", nil},
+		{`transform(a, x -> x || "s")`, nil},
+		// filter
+		{"filter(a, x -> x > 1)", data.Array{data.Int(2), data.Int(4)}},
+		{"filter(a, x -> x % 2 = 0 AND x < b)", data.Array{data.Int(2), data.Int(4)}},
+		{"filter(empty, x -> true)", data.Array{}},
+		{"filter(a, x -> x)", nil},
+		// reduce
+		{"reduce(a, 0, (acc, x) -> CASE WHEN x IS NULL THEN acc ELSE acc + x END)", data.Int(7)},
+		{"reduce(a, b, (acc, x) -> CASE WHEN x IS NULL THEN acc ELSE acc + x END)", data.Int(17)},
+		{"reduce(empty, 1.5, (acc, x) -> acc + x)", data.Float(1.5)},
+		{"reduce(nested, 0, (acc, x) -> acc + x.v[0])", data.Int(4)},
+		// any_match / all_match
+		{"any_match(a, x -> x = 4)", data.Bool(true)},
+		{"any_match(a, x -> x > 4)", data.Null{}},
+		{"any_match(bools, x -> x)", data.Null{}},
+		{"any_match(empty, x -> x)", data.Bool(false)},
+		{"any_match(nested, x -> x.v[0] > 5)", data.Bool(false)},
+		{"all_match(a, x -> x > 1)", data.Bool(false)},
+		{"all_match(a, x -> x > 0)", data.Null{}},
+		{"all_match(nested, x -> plusone(x.v[0]) > 1)", data.Bool(true)},
+		{"all_match(empty, x -> x)", data.Bool(true)},
+		{"all_match(a, x -> 1)", nil},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		Convey(fmt.Sprintf("Given the expression %s", tc.expr), t, func() {
+			p := parser.New()
+			stmt, _, err := p.ParseStmt("EVAL " + tc.expr)
+			So(err, ShouldBeNil)
+			ast := stmt.(parser.EvalStmt).Expr
+
+			Convey("When it is evaluated", func() {
+				flatExpr, err := ParserExprToFlatExpr(ast, reg)
+				So(err, ShouldBeNil)
+				eval, err := ExpressionToEvaluator(flatExpr, reg)
+				So(err, ShouldBeNil)
+				actual, err := eval.Eval(input)
+
+				if tc.expected == nil {
+					Convey("Then evaluation should fail", func() {
+						So(err, ShouldNotBeNil)
+					})
+				} else {
+					Convey("Then the result should be correct", func() {
+						So(err, ShouldBeNil)
+						So(actual, ShouldResemble, tc.expected)
+					})
+				}
+			})
+		})
+	}
+
+	Convey("Given a function registry", t, func() {
+		fr := udf.NewDefaultFunctionRegistry(core.NewContext(nil))
+
+		Convey("When registering UDFs with the names of higher-order functions", func() {
+			Convey("Then it should fail", func() {
+				for name, f := range higherOrderFuncs {
+					err := fr.Register(name, udf.VariadicFunc(func(ctx *core.Context, vs ...data.Value) (data.Value, error) {
+						return data.Null{}, nil
+					}))
+					So(err, ShouldNotBeNil)
+					_, err = fr.Lookup(name, f.arity)
+					So(core.IsNotExist(err), ShouldBeTrue)
+				}
+			})
+		})
+	})
+
+	Convey("Given invalid uses of lambda expressions", t, func() {
+		for expr, msg := range map[string]string{
+			"transform(a)":                                "function 'transform' cannot take 1 arguments",
+			"transform(a, b)":                             "the last argument of function 'transform' must be a lambda expression",
+			"transform(x -> x, x -> x)":                   "only the last argument of function 'transform' can be a lambda expression",
+			"transform(a, (x, y) -> x)":                   "the lambda expression passed to function 'transform' must have 1 parameters",
+			"reduce(a, 0, (x, x) -> x)":                   "duplicate parameter 'x' in lambda expression",
+			"plusone(x -> x)":                             "lambda expressions can only be used as arguments of higher-order functions",
+			"transform(a, x -> count(x))":                 "no such function: count",
+			"transform(a, x -> x ORDER BY b)":             "you cannot use ORDER BY in non-aggregate function 'transform'",
+			"transform(a, x -> lag(x) OVER (ORDER BY x))": "window functions cannot be used in lambda expressions",
+		} {
+			expr, msg := expr, msg
+			Convey(fmt.Sprintf("When converting %s", expr), func() {
+				p := parser.New()
+				stmt, _, err := p.ParseStmt("EVAL " + expr)
+				So(err, ShouldBeNil)
+				ast := stmt.(parser.EvalStmt).Expr
+
+				flatExpr, err := ParserExprToFlatExpr(ast, reg)
+				if err == nil {
+					_, err = ExpressionToEvaluator(flatExpr, reg)
+				}
+
+				Convey("Then it should fail", func() {
+					So(err, ShouldNotBeNil)
+					So(err.Error(), ShouldEqual, msg)
+				})
+			})
+		}
+	})
+}
@@ -0,0 +1,489 @@
+package execution
+
+import (
+	"container/list"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"sort"
+	"strings"
+)
+
+// incrementalGroupby holds the state of a groupbyExecutionPlan whose
+// aggregate functions are all udf.IncrementalAggregates. Instead of
+// collecting the input values of all rows in the window and calling the
+// aggregate functions with them, an udf.AggregateState is kept for each
+// aggregate function in each group and only the rows that were added to
+// or removed from the window are passed to it.
+type incrementalGroupby struct {
+	ctx *core.Context
+	// aggregates holds the aggregate function calls in all projections.
+	aggregates []incrementalAggregate
+	// inputKeys holds the keys of the aggregate inputs, inputs holds
+	// the respective evaluators.
+	inputKeys []string
+	inputs    []Evaluator
+	// projections holds the projections where the aggregate function
+	// calls are replaced by references to their results.
+	projections []aliasedEvaluator
+
+	// valid is false if the state has to be rebuilt from all rows
+	// in the window, e.g. after an error.
+	valid bool
+	// groups holds the groups of the rows in the window.
+	groups map[data.HashValue][]*incrementalGroup
+	// rows maps the rows in the window to their groups.
+	rows map[*inputRowWithCachedResult]*incrementalRow
+	// nextSeq is the sequence number of the next row added.
+	nextSeq int64
+}
+
+// incrementalAggregate is an aggregate function call whose result is
+// stored in the group data under key.
+type incrementalAggregate struct {
+	key string
+	// input is the index of the input in incrementalGroupby.inputs.
+	input int
+	// newState creates a new state of the aggregate function.
+	newState func(ctx *core.Context) (udf.AggregateState, error)
+}
+
+type incrementalGroup struct {
+	group  data.Array
+	hash   data.HashValue
+	states []udf.AggregateState
+	// rows holds the rows (as *incrementalRow) of this group in the
+	// order in which they were added.
+	rows *list.List
+}
+
+type incrementalRow struct {
+	input  *inputRowWithCachedResult
+	group  *incrementalGroup
+	values []data.Value
+	elem   *list.Element
+	seq    int64
+}
+
+// newIncrementalGroupby returns nil if the statement contains an aggregate
+// function call that cannot be computed incrementally. Only calls with a
+// single aggregation parameter, and calls of udf.ParameterizedIncrementalAggregates
+// whose other parameters are literals, are computed incrementally. An ORDER
+// BY clause within a call is not supported.
+func newIncrementalGroupby(lp *LogicalPlan, reg udf.FunctionRegistry) (*incrementalGroupby, error) {
+	inc := &incrementalGroupby{
+		ctx: reg.Context(),
+	}
+	inputIdx := map[string]int{}
+	aggKeys := map[string]string{}
+	var rewrite func(expr FlatExpression) (FlatExpression, bool)
+	rewriteAll := func(exprs []FlatExpression) ([]FlatExpression, bool) {
+		out := make([]FlatExpression, len(exprs))
+		for i, e := range exprs {
+			r, ok := rewrite(e)
+			if !ok {
+				return nil, false
+			}
+			out[i] = r
+		}
+		return out, true
+	}
+	rewrite = func(expr FlatExpression) (FlatExpression, bool) {
+		switch obj := expr.(type) {
+		case funcAppAST:
+			if len(obj.Expressions) == 0 {
+				return expr, true
+			}
+			if ref, ok := obj.Expressions[0].(aggInputRef); ok {
+				newState, ok := newIncrementalAggregateState(obj, reg)
+				if !ok {
+					return nil, false
+				}
+				// the same function with the same input and parameters
+				// is only computed once
+				args := []string{ref.Ref}
+				for _, e := range obj.Expressions[1:] {
+					args = append(args, e.Repr())
+				}
+				id := fmt.Sprintf("%s(%s)", obj.Function, strings.Join(args, ", "))
+				if key, ok := aggKeys[id]; ok {
+					return aggInputRef{key}, true
+				}
+				h := sha1.New()
+				h.Write([]byte(id))
+				key := "a_" + hex.EncodeToString(h.Sum(nil))[:8]
+				aggKeys[id] = key
+
+				idx, ok := inputIdx[ref.Ref]
+				if !ok {
+					idx = len(inc.inputKeys)
+					inputIdx[ref.Ref] = idx
+					inc.inputKeys = append(inc.inputKeys, ref.Ref)
+				}
+				inc.aggregates = append(inc.aggregates, incrementalAggregate{key, idx, newState})
+				return aggInputRef{key}, true
+			}
+			exprs, ok := rewriteAll(obj.Expressions)
+			if !ok {
+				return nil, false
+			}
+			return funcAppAST{obj.Function, exprs}, true
+		case binaryOpAST:
+			left, ok := rewrite(obj.Left)
+			if !ok {
+				return nil, false
+			}
+			right, ok := rewrite(obj.Right)
+			if !ok {
+				return nil, false
+			}
+			return binaryOpAST{obj.Op, left, right}, true
+		case betweenAST:
+			exprs, ok := rewriteAll([]FlatExpression{obj.Expr, obj.Lower, obj.Upper})
+			if !ok {
+				return nil, false
+			}
+			return betweenAST{obj.Op, exprs[0], exprs[1], exprs[2]}, true
+		case unaryOpAST:
+			e, ok := rewrite(obj.Expr)
+			if !ok {
+				return nil, false
+			}
+			return unaryOpAST{obj.Op, e}, true
+		case typeCastAST:
+			e, ok := rewrite(obj.Expr)
+			if !ok {
+				return nil, false
+			}
+			return typeCastAST{e, obj.Target}, true
+		case arrayAST:
+			exprs, ok := rewriteAll(obj.Expressions)
+			if !ok {
+				return nil, false
+			}
+			return arrayAST{exprs}, true
+		case mapAST:
+			entries := make([]keyValuePair, len(obj.Entries))
+			for i, pair := range obj.Entries {
+				v, ok := rewrite(pair.Value)
+				if !ok {
+					return nil, false
+				}
+				entries[i] = keyValuePair{pair.Key, v}
+			}
+			return mapAST{entries}, true
+		case caseAST:
+			c := caseAST{Checks: make([]whenThenPair, len(obj.Checks))}
+			var ok bool
+			if c.Reference, ok = rewrite(obj.Reference); !ok {
+				return nil, false
+			}
+			for i, check := range obj.Checks {
+				if c.Checks[i].When, ok = rewrite(check.When); !ok {
+					return nil, false
+				}
+				if c.Checks[i].Then, ok = rewrite(check.Then); !ok {
+					return nil, false
+				}
+			}
+			if c.Default, ok = rewrite(obj.Default); !ok {
+				return nil, false
+			}
+			return c, true
+		case lambdaAST:
+			// lambda expressions cannot contain aggregates
+			return expr, true
+		case rowValue, rowMeta, stmtMeta, numericLiteral, floatLiteral,
+			intervalLiteral, nullLiteral, missing, boolLiteral, stringLiteral,
+			lambdaVariable:
+			return expr, true
+		}
+		// aggregate inputs that are not the only parameter of an
+		// aggregate function, aggregate functions with ORDER BY,
+		// and wildcards
+		return nil, false
+	}
+
+	projections := make([]aliasedExpression, len(lp.Projections))
+	for i, proj := range lp.Projections {
+		expr, ok := rewrite(proj.expr)
+		if !ok {
+			return nil, nil
+		}
+		projections[i] = aliasedExpression{proj.alias, expr, nil}
+	}
+	projs, err := prepareProjections(projections, reg)
+	if err != nil {
+		return nil, err
+	}
+	inc.projections = projs
+
+	for _, key := range inc.inputKeys {
+		var input FlatExpression
+		for _, proj := range lp.Projections {
+			if e, ok := proj.aggrInputs[key]; ok {
+				input = e
+				break
+			}
+		}
+		if input == nil {
+			return nil, fmt.Errorf("aggregate input %v not found", key)
+		}
+		eval, err := ExpressionToEvaluator(input, reg)
+		if err != nil {
+			return nil, err
+		}
+		inc.inputs = append(inc.inputs, eval)
+	}
+	inc.reset()
+	return inc, nil
+}
+
+// reset discards the state so that it is rebuilt from all rows in
+// the window when the query is performed the next time.
+func (inc *incrementalGroupby) reset() {
+	inc.valid = false
+	inc.groups = map[data.HashValue][]*incrementalGroup{}
+	inc.rows = map[*inputRowWithCachedResult]*incrementalRow{}
+}
+
+// performIncrementalQuery works like performQueryOnBuffer, but updates
+// the state of ep.incremental with the rows that were added to or removed
+// from ep.filteredInputRows since the last run.
+func (ep *groupbyExecutionPlan) performIncrementalQuery() error {
+	inc := ep.incremental
+	changes := ep.rowChanges
+	defer func() {
+		// reuse the allocated memory
+		ep.rowChanges = changes[0:0]
+	}()
+
+	// reuse the allocated memory
+	output := ep.prevResults[0:0]
+	// remember the previous results
+	ep.prevResults = ep.curResults
+
+	rollback := func() {
+		// see groupbyExecutionPlan.performQueryOnBuffer
+		ep.prevResults = output
+		inc.reset()
+	}
+
+	if !inc.valid {
+		for e := ep.filteredInputRows.Front(); e != nil; e = e.Next() {
+			if err := ep.addIncrementalRow(e.Value.(*inputRowWithCachedResult)); err != nil {
+				rollback()
+				return err
+			}
+		}
+		inc.valid = true
+	} else {
+		for _, c := range changes {
+			var err error
+			if c.added {
+				err = ep.addIncrementalRow(c.row)
+			} else {
+				err = ep.removeIncrementalRow(c.row)
+			}
+			if err != nil {
+				rollback()
+				return err
+			}
+		}
+	}
+
+	// compute the results in the order in which the groups would be
+	// created from ep.filteredInputRows
+	groups := make([]*incrementalGroup, 0, len(inc.groups))
+	for _, gs := range inc.groups {
+		groups = append(groups, gs...)
+	}
+	sort.Sort(incrementalGroupsByAge(groups))
+	for _, group := range groups {
+		first := group.rows.Front().Value.(*incrementalRow)
+		groupData := (*first.input.input).Copy()
+		for i, agg := range inc.aggregates {
+			v, err := group.states[i].Result(inc.ctx)
+			if err != nil {
+				rollback()
+				return err
+			}
+			groupData[agg.key] = v
+		}
+		result, err := ep.evalGroup(inc.projections, groupData)
+		if err != nil {
+			rollback()
+			return err
+		}
+		if result != nil {
+			output = append(output, resultRow{row: result, hash: data.Hash(result)})
+		}
+	}
+	if len(groups) == 0 {
+		result, err := ep.evalNoGroup()
+		if err != nil {
+			rollback()
+			return err
+		}
+		if result != nil {
+			output = append(output, resultRow{row: result, hash: data.Hash(result)})
+		}
+	}
+
+	ep.curResults = output
+	return nil
+}
+
+// newIncrementalAggregateState returns a function creating a state of the
+// aggregate function called by f, whose first parameter is an aggregate
+// input. It returns false when the call cannot be computed incrementally.
+func newIncrementalAggregateState(f funcAppAST, reg udf.FunctionRegistry) (func(*core.Context) (udf.AggregateState, error), bool) {
+	fn, err := reg.Lookup(string(f.Function), len(f.Expressions))
+	if err != nil || !fn.IsAggregationParameter(0) {
+		return nil, false
+	}
+	if len(f.Expressions) == 1 {
+		if incAgg, ok := fn.(udf.IncrementalAggregate); ok {
+			return incAgg.NewAggregateState, true
+		}
+	}
+	incAgg, ok := fn.(udf.ParameterizedIncrementalAggregate)
+	if !ok {
+		return nil, false
+	}
+
+	// the other parameters must be literals
+	params := make([]data.Value, len(f.Expressions)-1)
+	for i, e := range f.Expressions[1:] {
+		switch e.(type) {
+		case numericLiteral, floatLiteral, intervalLiteral, nullLiteral,
+			boolLiteral, stringLiteral:
+		default:
+			return nil, false
+		}
+		if fn.IsAggregationParameter(i + 1) {
+			return nil, false
+		}
+		eval, err := ExpressionToEvaluator(e, reg)
+		if err != nil {
+			return nil, false
+		}
+		if params[i], err = eval.Eval(data.Map{}); err != nil {
+			return nil, false
+		}
+	}
+	return func(ctx *core.Context) (udf.AggregateState, error) {
+		return incAgg.NewParameterizedAggregateState(ctx, params...)
+	}, true
+}
+
+// addIncrementalRow adds a row to its group and to the aggregate states of
+// the group. The group is created if it doesn't exist yet.
+func (ep *groupbyExecutionPlan) addIncrementalRow(io *inputRowWithCachedResult) error {
+	inc := ep.incremental
+	groupValues, err := ep.groupValues(io)
+	if err != nil {
+		return err
+	}
+	values := make([]data.Value, len(inc.inputs))
+	for i, eval := range inc.inputs {
+		v, err := eval.Eval(*io.input)
+		if err != nil {
+			return err
+		}
+		values[i] = v
+	}
+
+	var group *incrementalGroup
+	for _, g := range inc.groups[io.hash] {
+		if data.Equal(groupValues, g.group) {
+			group = g
+			break
+		}
+	}
+	if group == nil {
+		group = &incrementalGroup{
+			group:  groupValues,
+			hash:   io.hash,
+			states: make([]udf.AggregateState, len(inc.aggregates)),
+			rows:   list.New(),
+		}
+		for i, agg := range inc.aggregates {
+			s, err := agg.newState(inc.ctx)
+			if err != nil {
+				return err
+			}
+			group.states[i] = s
+		}
+		inc.groups[io.hash] = append(inc.groups[io.hash], group)
+	}
+
+	for i, agg := range inc.aggregates {
+		if err := group.states[i].Add(inc.ctx, values[agg.input]); err != nil {
+			return err
+		}
+	}
+	row := &incrementalRow{
+		input:  io,
+		group:  group,
+		values: values,
+		seq:    inc.nextSeq,
+	}
+	inc.nextSeq++
+	row.elem = group.rows.PushBack(row)
+	inc.rows[io] = row
+	return nil
+}
+
+// removeIncrementalRow removes a row from its group and from the aggregate
+// states of the group. The group is removed when it becomes empty.
+func (ep *groupbyExecutionPlan) removeIncrementalRow(io *inputRowWithCachedResult) error {
+	inc := ep.incremental
+	row, ok := inc.rows[io]
+	if !ok {
+		return fmt.Errorf("the row to be removed is not in any group")
+	}
+	group := row.group
+	for i, agg := range inc.aggregates {
+		if err := group.states[i].Remove(inc.ctx, row.values[agg.input]); err != nil {
+			return err
+		}
+	}
+	group.rows.Remove(row.elem)
+	delete(inc.rows, io)
+
+	if group.rows.Len() == 0 {
+		gs := inc.groups[group.hash]
+		for i, g := range gs {
+			if g == group {
+				gs = append(gs[:i], gs[i+1:]...)
+				break
+			}
+		}
+		if len(gs) == 0 {
+			delete(inc.groups, group.hash)
+		} else {
+			inc.groups[group.hash] = gs
+		}
+	}
+	return nil
+}
+
+// incrementalGroupsByAge sorts groups by their oldest row.
+type incrementalGroupsByAge []*incrementalGroup
+
+func (g incrementalGroupsByAge) Len() int {
+	return len(g)
+}
+
+func (g incrementalGroupsByAge) Less(i, j int) bool {
+	return g[i].rows.Front().Value.(*incrementalRow).seq <
+		g[j].rows.Front().Value.(*incrementalRow).seq
+}
+
+func (g incrementalGroupsByAge) Swap(i, j int) {
+	g[i], g[j] = g[j], g[i]
+}
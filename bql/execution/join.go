@@ -0,0 +1,473 @@
+package execution
+
+import (
+	"container/list"
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// joinSpec holds the evaluators of a JOIN clause. Tuples of the two
+// relations are joined using a hash index on the values of the equality
+// conditions in the ON clause (the join key), so that only tuples with
+// the same join key need to be compared. If the ON clause does not have
+// such conditions, all tuples have the same (empty) join key.
+//
+// In a lookup join, the relation is joined with the rows of a table (a
+// udf.TableSharedState) instead of a second relation. Only the tuples of
+// the relation are buffered and each of them is joined with the rows the
+// table has when the tuple arrives.
+type joinSpec struct {
+	joinType parser.JoinType
+	// left and right are the aliases of the two relations (right is
+	// the name of the table in a lookup join)
+	left  string
+	right string
+	// leftKeys and rightKeys compute the join key of a tuple of the
+	// left and right relation, respectively
+	leftKeys  []Evaluator
+	rightKeys []Evaluator
+	// filter holds the part of the ON clause that is not covered by
+	// the join key (or nil if there is none)
+	filter Evaluator
+
+	// lookup is true for a lookup join
+	lookup bool
+	// tableColumns holds the column of the table that each join key
+	// refers to, or "" if the key is not a plain column of the table.
+	// It is used to find out whether the key of the table can be used
+	// to look up rows (only with a lookup join).
+	tableColumns []string
+	// ctx is used to get the table (only with a lookup join)
+	ctx *core.Context
+}
+
+func newJoinSpec(lp *LogicalPlan, reg udf.FunctionRegistry) (*joinSpec, error) {
+	if lp.Join.Type == parser.UnspecifiedJoinType {
+		return nil, nil
+	}
+	j := &joinSpec{
+		joinType:  lp.Join.Type,
+		left:      lp.Relations[0].Alias,
+		right:     lp.Join.Table,
+		leftKeys:  make([]Evaluator, len(lp.JoinKeys)),
+		rightKeys: make([]Evaluator, len(lp.JoinKeys)),
+		lookup:    lp.Join.Table != "",
+	}
+	if !j.lookup {
+		j.right = lp.Relations[1].Alias
+	}
+	for i, key := range lp.JoinKeys {
+		left, err := ExpressionToEvaluator(key[0], reg)
+		if err != nil {
+			return nil, err
+		}
+		right, err := ExpressionToEvaluator(key[1], reg)
+		if err != nil {
+			return nil, err
+		}
+		j.leftKeys[i] = left
+		j.rightKeys[i] = right
+	}
+	filter, err := prepareFilter(lp.JoinFilter, reg)
+	if err != nil {
+		return nil, err
+	}
+	j.filter = filter
+
+	if j.lookup {
+		j.tableColumns = make([]string, len(lp.JoinKeys))
+		for i, key := range lp.JoinKeys {
+			if rv, ok := key[1].(rowValue); ok {
+				j.tableColumns[i] = rv.Column
+			}
+		}
+		j.ctx = reg.Context()
+		// the table can be replaced while the statement is running, but
+		// it must exist when the statement is created
+		if _, err := j.lookupTable(); err != nil {
+			return nil, err
+		}
+	}
+	return j, nil
+}
+
+// lookupTable returns the table of a lookup join.
+func (j *joinSpec) lookupTable() (udf.TableSharedState, error) {
+	state, err := j.ctx.SharedStates.Get(j.right)
+	if err != nil {
+		return nil, err
+	}
+	table, ok := state.(udf.TableSharedState)
+	if !ok {
+		return nil, fmt.Errorf("'%v' state cannot be joined because it isn't a table", j.right)
+	}
+	return table, nil
+}
+
+// joinState holds the information on a tuple that is required to
+// compute a JOIN.
+type joinState struct {
+	// key holds the join key of the tuple. It is nil if one of the
+	// values is NULL, so that the tuple cannot be joined.
+	key  data.Array
+	hash data.HashValue
+	// numMatches is the number of tuples of the right relation that
+	// are currently joined with a tuple of the left relation.
+	numMatches int
+	// matched holds the tuples of the left relation that a tuple of
+	// the right relation was joined with (only with LEFT OUTER JOIN).
+	matched []*tupleWithDerivedInputRows
+	// nullRow is the row emitted for a tuple of the left relation with
+	// NULL values for the right relation when there is no matching
+	// tuple (only with LEFT OUTER JOIN).
+	nullRow *inputRowWithCachedResult
+	// removed is true when the tuple was removed from its buffer.
+	removed bool
+}
+
+// filterJoinedInputTuples works like filterInputTuples for statements
+// with a JOIN clause. It joins the tuple that was added last with the
+// tuples of the other relation that have the same join key and writes
+// the rows that match the ON and WHERE clauses to ep.filteredInputRows.
+// With LEFT OUTER JOIN, a tuple of the left relation that is not joined
+// with any tuple is written with NULL values for the right relation,
+// and that row is removed again as soon as a matching tuple arrives.
+func (ep *streamRelationStreamExecutionPlan) filterJoinedInputTuples() error {
+	j := ep.join
+	if j.lookup {
+		return ep.filterLookupJoinedInputTuples()
+	}
+	ep.filteredInputRowsBuffer = list.New()
+
+	// on a self-join, the new tuple was added to both buffers
+	var newLeft, newRight *tupleWithDerivedInputRows
+	if ep.lastTupleBuffers[j.left] {
+		newLeft = ep.buffers[j.left].tuples.Back().Value.(*tupleWithDerivedInputRows)
+		if err := ep.indexJoinTuple(j.left, j.leftKeys, newLeft); err != nil {
+			return err
+		}
+	}
+	if ep.lastTupleBuffers[j.right] {
+		newRight = ep.buffers[j.right].tuples.Back().Value.(*tupleWithDerivedInputRows)
+		if err := ep.indexJoinTuple(j.right, j.rightKeys, newRight); err != nil {
+			return err
+		}
+	}
+
+	if newLeft != nil {
+		for _, r := range ep.joinCandidates(j.right, newLeft) {
+			if _, err := ep.joinTuples(newLeft, r); err != nil {
+				return err
+			}
+		}
+		if j.joinType == parser.LeftOuterJoin && newLeft.join.numMatches == 0 {
+			if err := ep.addNullRow(newLeft); err != nil {
+				return err
+			}
+		}
+	}
+	retracted := map[*inputRowWithCachedResult]bool{}
+	if newRight != nil {
+		for _, l := range ep.joinCandidates(j.left, newRight) {
+			if l == newLeft {
+				// this pair has already been joined above
+				continue
+			}
+			matched, err := ep.joinTuples(l, newRight)
+			if err != nil {
+				return err
+			}
+			if matched && l.join.numMatches == 1 && l.join.nullRow != nil {
+				// the tuple is not unmatched anymore
+				retracted[l.join.nullRow] = true
+				l.rows = removeInputRow(l.rows, l.join.nullRow)
+				l.join.nullRow = nil
+			}
+		}
+	}
+
+	if len(retracted) > 0 {
+		var next *list.Element
+		for e := ep.filteredInputRows.Front(); e != nil; e = next {
+			next = e.Next()
+			if retracted[e.Value.(*inputRowWithCachedResult)] {
+				ep.removeFilteredInputRow(e)
+			}
+		}
+	}
+	ep.appendFilteredInputRows()
+	return nil
+}
+
+// filterLookupJoinedInputTuples works like filterJoinedInputTuples for
+// a lookup join. It joins the tuple that was added last with the rows of
+// the table. Rows that are written to the table later are not joined with
+// tuples that are already in the window.
+func (ep *streamRelationStreamExecutionPlan) filterLookupJoinedInputTuples() error {
+	j := ep.join
+	ep.filteredInputRowsBuffer = list.New()
+
+	l := ep.buffers[j.left].tuples.Back().Value.(*tupleWithDerivedInputRows)
+	l.join = &joinState{}
+	key, err := ep.joinKey(j.left, j.leftKeys, l)
+	if err != nil {
+		return err
+	}
+	if key != nil {
+		table, err := j.lookupTable()
+		if err != nil {
+			return err
+		}
+		join := func(row data.Map) error {
+			_, err := ep.joinTableRow(l, key, row)
+			return err
+		}
+
+		// use the key of the table if the ON clause compares it
+		keyIdx := -1
+		for i, column := range j.tableColumns {
+			if column == table.Key() {
+				keyIdx = i
+				break
+			}
+		}
+		if keyIdx >= 0 {
+			row, err := table.Lookup(j.ctx, key[keyIdx])
+			if err != nil {
+				return err
+			}
+			if row != nil {
+				if err := join(row); err != nil {
+					return err
+				}
+			}
+		} else if err := table.Scan(j.ctx, join); err != nil {
+			return err
+		}
+	}
+	if j.joinType == parser.LeftOuterJoin && l.join.numMatches == 0 {
+		if err := ep.addNullRow(l); err != nil {
+			return err
+		}
+	}
+
+	ep.appendFilteredInputRows()
+	return nil
+}
+
+// joinKey computes the join key of a tuple of the given relation. It
+// returns nil if one of the values is NULL.
+func (ep *streamRelationStreamExecutionPlan) joinKey(alias string, keys []Evaluator, t *tupleWithDerivedInputRows) (data.Array, error) {
+	dataHolder := data.Map{alias: t.tuple.Data[alias]}
+	setMetadata(dataHolder, alias, t.tuple)
+	dataHolder[":meta:NOW"] = data.Timestamp(ep.now)
+
+	key := make(data.Array, len(keys))
+	for i, eval := range keys {
+		value, err := eval.Eval(dataHolder)
+		if err != nil {
+			return nil, err
+		}
+		if value.Type() == data.TypeNull {
+			// NULL is not equal to anything
+			return nil, nil
+		}
+		key[i] = value
+	}
+	return key, nil
+}
+
+// indexJoinTuple computes the join key of a tuple and adds the tuple to
+// the hash index of the buffer of the given relation.
+func (ep *streamRelationStreamExecutionPlan) indexJoinTuple(alias string, keys []Evaluator, t *tupleWithDerivedInputRows) error {
+	t.join = &joinState{}
+	key, err := ep.joinKey(alias, keys, t)
+	if err != nil || key == nil {
+		return err
+	}
+	t.join.key = key
+	t.join.hash = data.Hash(key)
+
+	buffer := ep.buffers[alias]
+	if buffer.index == nil {
+		buffer.index = map[data.HashValue][]*tupleWithDerivedInputRows{}
+	}
+	buffer.index[t.join.hash] = append(buffer.index[t.join.hash], t)
+	return nil
+}
+
+// joinCandidates returns the tuples in the buffer of the given relation
+// that have the same join key as the given tuple.
+func (ep *streamRelationStreamExecutionPlan) joinCandidates(alias string, t *tupleWithDerivedInputRows) []*tupleWithDerivedInputRows {
+	if t.join.key == nil {
+		return nil
+	}
+	var candidates []*tupleWithDerivedInputRows
+	for _, other := range ep.buffers[alias].index[t.join.hash] {
+		if data.Equal(t.join.key, other.join.key) {
+			candidates = append(candidates, other)
+		}
+	}
+	return candidates
+}
+
+// joinTuples evaluates the ON clause on the combination of a tuple of
+// the left and a tuple of the right relation and returns whether they
+// match. If they do and the combination also matches the WHERE clause,
+// it is written to ep.filteredInputRowsBuffer.
+func (ep *streamRelationStreamExecutionPlan) joinTuples(l, r *tupleWithDerivedInputRows) (bool, error) {
+	j := ep.join
+	dataHolder := data.Map{
+		j.left:      l.tuple.Data[j.left],
+		j.right:     r.tuple.Data[j.right],
+		":meta:NOW": data.Timestamp(ep.now),
+	}
+	setMetadata(dataHolder, j.left, l.tuple)
+	setMetadata(dataHolder, j.right, r.tuple)
+	return ep.joinRow(l, r, dataHolder)
+}
+
+// joinTableRow works like joinTuples for a row of the table in a lookup
+// join. The row is only joined if it has the given join key.
+func (ep *streamRelationStreamExecutionPlan) joinTableRow(l *tupleWithDerivedInputRows, key data.Array, row data.Map) (bool, error) {
+	j := ep.join
+	dataHolder := data.Map{
+		j.left:      l.tuple.Data[j.left],
+		j.right:     row,
+		":meta:NOW": data.Timestamp(ep.now),
+	}
+	setMetadata(dataHolder, j.left, l.tuple)
+	// rows of a table don't have a timestamp
+	dataHolder[fmt.Sprintf("%s:meta:%s", j.right, parser.TimestampMeta)] = data.Null{}
+
+	for i, eval := range j.rightKeys {
+		value, err := eval.Eval(dataHolder)
+		if err != nil || !data.Equal(key[i], value) {
+			// a row that doesn't have the key cannot be joined
+			return false, nil
+		}
+	}
+	return ep.joinRow(l, nil, dataHolder)
+}
+
+// joinRow evaluates the ON clause on a combined row and writes the row
+// to ep.filteredInputRowsBuffer if it also matches the WHERE clause. r is
+// the tuple of the right relation, which is nil in a lookup join.
+func (ep *streamRelationStreamExecutionPlan) joinRow(l, r *tupleWithDerivedInputRows, dataHolder data.Map) (bool, error) {
+	j := ep.join
+	if j.filter != nil {
+		ok, err := evalCondition(j.filter, dataHolder)
+		if err != nil || !ok {
+			return false, err
+		}
+	}
+	l.join.numMatches++
+	if r != nil && j.joinType == parser.LeftOuterJoin {
+		r.join.matched = append(r.join.matched, l)
+	}
+
+	if ep.filter != nil {
+		ok, err := evalCondition(ep.filter, dataHolder)
+		if err != nil || !ok {
+			return true, err
+		}
+	}
+	row := &inputRowWithCachedResult{input: &dataHolder}
+	l.rows = append(l.rows, row)
+	if r != nil {
+		r.rows = append(r.rows, row)
+	}
+	ep.filteredInputRowsBuffer.PushBack(row)
+	return true, nil
+}
+
+// addNullRow writes the row for an unmatched tuple of the left relation
+// to ep.filteredInputRowsBuffer if it matches the WHERE clause.
+func (ep *streamRelationStreamExecutionPlan) addNullRow(l *tupleWithDerivedInputRows) error {
+	j := ep.join
+	dataHolder := data.Map{
+		j.left:      l.tuple.Data[j.left],
+		j.right:     data.Null{},
+		":meta:NOW": data.Timestamp(ep.now),
+	}
+	setMetadata(dataHolder, j.left, l.tuple)
+	dataHolder[fmt.Sprintf("%s:meta:%s", j.right, parser.TimestampMeta)] = data.Null{}
+
+	if ep.filter != nil {
+		ok, err := evalCondition(ep.filter, dataHolder)
+		if err != nil || !ok {
+			return err
+		}
+	}
+	row := &inputRowWithCachedResult{input: &dataHolder}
+	l.rows = append(l.rows, row)
+	l.join.nullRow = row
+	ep.filteredInputRowsBuffer.PushBack(row)
+	return nil
+}
+
+// removeJoinTuple removes a tuple that was removed from the buffer of
+// the given relation from the hash index. If it was a tuple of the right
+// relation, the tuples of the left relation that are not joined with
+// any tuple anymore are returned.
+func (ep *streamRelationStreamExecutionPlan) removeJoinTuple(alias string, t *tupleWithDerivedInputRows) []*tupleWithDerivedInputRows {
+	if t.join == nil {
+		return nil
+	}
+	t.join.removed = true
+	buffer := ep.buffers[alias]
+	if t.join.key != nil {
+		others := buffer.index[t.join.hash]
+		for i, other := range others {
+			if other == t {
+				others = append(others[:i], others[i+1:]...)
+				break
+			}
+		}
+		if len(others) == 0 {
+			delete(buffer.index, t.join.hash)
+		} else {
+			buffer.index[t.join.hash] = others
+		}
+	}
+
+	var unmatched []*tupleWithDerivedInputRows
+	if alias == ep.join.right {
+		for _, l := range t.join.matched {
+			l.join.numMatches--
+			if l.join.numMatches == 0 {
+				unmatched = append(unmatched, l)
+			}
+		}
+	}
+	return unmatched
+}
+
+// evalCondition evaluates a condition such as the WHERE clause on
+// the given data. NULL is treated as false.
+func evalCondition(cond Evaluator, d data.Map) (bool, error) {
+	result, err := cond.Eval(d)
+	if err != nil {
+		return false, err
+	}
+	// a NULL value is definitely not "true", so since we
+	// have only a binary decision, we should drop tuples
+	// where the filter condition evaluates to NULL
+	if result.Type() == data.TypeNull {
+		return false, nil
+	}
+	return data.AsBool(result)
+}
+
+// removeInputRow removes a row from a slice of rows.
+func removeInputRow(rows []*inputRowWithCachedResult, row *inputRowWithCachedResult) []*inputRowWithCachedResult {
+	for i, r := range rows {
+		if r == row {
+			return append(rows[:i], rows[i+1:]...)
+		}
+	}
+	return rows
+}
@@ -7,6 +7,7 @@ import (
 	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
 	"gopkg.in/sensorbee/sensorbee.v0/core"
 	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"sort"
 	"time"
 )
 
@@ -14,16 +15,36 @@ type inputBuffer struct {
 	tuples     *list.List
 	windowSize float64
 	windowType parser.IntervalUnit
+	// index is a hash index on the join keys of the tuples in the
+	// buffer. It is only used with a JOIN clause.
+	index map[data.HashValue][]*tupleWithDerivedInputRows
 }
 
 type tupleWithDerivedInputRows struct {
 	tuple *core.Tuple
 	rows  []*inputRowWithCachedResult
+	// join is only used with a JOIN clause
+	join *joinState
 }
 
 func (i *inputBuffer) isTimeBased() bool {
 	return i.windowType == parser.Seconds ||
-		i.windowType == parser.Milliseconds
+		i.windowType == parser.Milliseconds ||
+		i.windowType == parser.Minutes
+}
+
+// intervalToDuration converts a time-based interval to a time.Duration.
+// It returns 0 for tuple-based or unspecified intervals.
+func intervalToDuration(i parser.IntervalAST) time.Duration {
+	switch i.Unit {
+	case parser.Minutes:
+		return time.Duration(i.Value * float64(time.Minute))
+	case parser.Seconds:
+		return time.Duration(i.Value * float64(time.Second))
+	case parser.Milliseconds:
+		return time.Duration(i.Value * float64(time.Millisecond))
+	}
+	return 0
 }
 
 // inputRowWithCachedResult holds an input tuple plus space for
@@ -51,6 +72,20 @@ type resultRowCount struct {
 	count int
 }
 
+// sessionWindow holds the tuples of the session window of a single
+// group key.
+type sessionWindow struct {
+	// key holds the values of the GROUP BY clause shared by all
+	// tuples of the session
+	key  data.Array
+	hash data.HashValue
+	// tuples holds the tuples of the session in the order in which
+	// they were processed
+	tuples []*core.Tuple
+	// last is the largest timestamp of all tuples of the session
+	last time.Time
+}
+
 // partialList is a data structure representing a continuous sublist
 // of a linked list. An iteration must start at the `start` item
 // and must go on until `end` is reached (not including `end`!)
@@ -70,6 +105,13 @@ type partialList struct {
 // - perform a SELECT query on that data,
 // - compute the data that need to be emitted by comparison with
 //   the previous run's results.
+//
+// If the windows have a SLIDE clause, the last two steps are only
+// performed when a window boundary has been passed. If the windows
+// have a WATERMARK clause, tuples are held back and reordered by
+// their timestamp until the watermark has passed them. A SESSION
+// window is kept for every group key and the query is performed
+// on it when no tuple of that key arrived within the session gap.
 type streamRelationStreamExecutionPlan struct {
 	commonExecutionPlan
 	// store name->alias mapping
@@ -104,6 +146,66 @@ type streamRelationStreamExecutionPlan struct {
 	// the last tuple was appended to. this is valid after
 	// `addTupleToBuffer` has returned.
 	lastTupleBuffers map[string]bool
+	// slide holds the SLIDE specification shared by all windows. If
+	// its unit is unspecified, results are computed for every tuple.
+	slide parser.IntervalAST
+	// nextBoundary is the (exclusive) end of the current window when
+	// a time-based SLIDE is used. It is zero until the first tuple
+	// arrives.
+	nextBoundary time.Time
+	// tuplesSinceEmit counts the tuples that arrived since the
+	// last emission when a tuple-based SLIDE is used.
+	tuplesSinceEmit int64
+	// watermark holds the WATERMARK specification shared by all
+	// windows. If the unit of its delay is unspecified, tuples are
+	// processed in the order in which they arrive.
+	watermark parser.WatermarkAST
+	// maxTimestamp is the largest timestamp of all tuples seen so far.
+	// The current watermark is maxTimestamp minus the watermark delay.
+	maxTimestamp time.Time
+	// pendingTuples holds tuples (as *core.Tuple, sorted by timestamp)
+	// that have arrived, but have not been passed by the watermark yet.
+	pendingTuples *list.List
+	// releasedTuples holds tuples (as *core.Tuple, sorted by timestamp)
+	// that have been processed and may still be required to correct
+	// the results of a closed window. It is only used if there is
+	// an ALLOWED LATENESS clause.
+	releasedTuples *list.List
+	// lateTuples holds the tuples that were too late to be processed
+	// in the last call to process.
+	lateTuples []*core.Tuple
+	// gap holds the SESSION GAP specification of the window. If its
+	// unit is unspecified, the window is not a session window.
+	gap parser.IntervalAST
+	// sessions holds the open session windows (as *sessionWindow),
+	// sorted by the timestamp of their last tuple.
+	sessions *list.List
+	// sessionsByHash maps the hash of a group key to the elements
+	// of `sessions` whose key has that hash.
+	sessionsByHash map[data.HashValue][]*list.Element
+	// join holds the JOIN clause of the statement. If it is nil, the
+	// relations are combined by a cross product.
+	join *joinSpec
+	// trackRowChanges is true if the rows added to and removed from
+	// filteredInputRows are recorded in rowChanges. It is set by plans
+	// that compute their results incrementally.
+	trackRowChanges bool
+	// rowChanges holds the changes of filteredInputRows in the order in
+	// which they happened. It must be cleared by the plan that uses it.
+	rowChanges []rowChange
+	// orderAscending holds the direction of each expression in the
+	// ORDER BY clause. The values to sort by are stored in the results
+	// under the keys returned by orderingKey.
+	orderAscending []bool
+	// limit holds the LIMIT and OFFSET clauses of the statement.
+	limit parser.LimitAST
+}
+
+// rowChange records that a row was added to or removed from
+// filteredInputRows.
+type rowChange struct {
+	row   *inputRowWithCachedResult
+	added bool
 }
 
 func newStreamRelationStreamExecutionPlan(lp *LogicalPlan, reg udf.FunctionRegistry) (*streamRelationStreamExecutionPlan, error) {
@@ -122,8 +224,14 @@ func newStreamRelationStreamExecutionPlan(lp *LogicalPlan, reg udf.FunctionRegis
 	if err != nil {
 		return nil, err
 	}
+	join, err := newJoinSpec(lp, reg)
+	if err != nil {
+		return nil, err
+	}
 	// for compatibility with the old syntax, take the last RANGE
 	// specification as valid for all buffers
+	var slide, gap parser.IntervalAST
+	var watermark parser.WatermarkAST
 
 	// initialize buffers (one per declared input relation)
 	buffers := make(map[string]*inputBuffer, len(lp.Relations))
@@ -133,8 +241,15 @@ func newStreamRelationStreamExecutionPlan(lp *LogicalPlan, reg udf.FunctionRegis
 		rangeUnit := rel.Unit
 		// the alias of the relation is the key of the buffer
 		buffers[rel.Alias] = &inputBuffer{
-			tuples, rangeValue, rangeUnit,
+			tuples, rangeValue, rangeUnit, nil,
 		}
+		// all relations have the same SLIDE and WATERMARK
+		// specification (this is checked in Analyze)
+		slide = rel.Slide
+		watermark = rel.Watermark
+		// a session window can only be used with a single
+		// relation (this is checked in Analyze)
+		gap = rel.Gap
 	}
 
 	return &streamRelationStreamExecutionPlan{
@@ -150,13 +265,23 @@ func newStreamRelationStreamExecutionPlan(lp *LogicalPlan, reg udf.FunctionRegis
 		prevResults:          []resultRow{},
 		prevHashesForIstream: map[data.HashValue][]resultRowCount{},
 		filteredInputRows:    list.New(),
+		slide:                slide,
+		watermark:            watermark,
+		pendingTuples:        list.New(),
+		releasedTuples:       list.New(),
+		gap:                  gap,
+		sessions:             list.New(),
+		sessionsByHash:       map[data.HashValue][]*list.Element{},
+		join:                 join,
+		orderAscending:       lp.OrderAscending,
+		limit:                lp.LimitAST,
 	}, nil
 }
 
 // relationKey computes the InputName that belongs to a relation.
 // For a real stream this equals the stream's name (independent of)
-// the alias, but for a UDSF we need to use the same method that
-// was used in topologyBuilder.
+// the alias, but for a UDSF or a subquery we need to use the same
+// method that was used in topologyBuilder.
 func (ep *streamRelationStreamExecutionPlan) relationKey(rel *parser.AliasedStreamWindowAST) string {
 	if rel.Type == parser.ActualStream {
 		return rel.Name
@@ -218,7 +343,8 @@ func (ep *streamRelationStreamExecutionPlan) addTupleToBuffer(t *core.Tuple) err
 // specification.
 func (ep *streamRelationStreamExecutionPlan) removeOutdatedTuplesFromBuffer(curTupTime time.Time) error {
 	expiredInputRows := map[*inputRowWithCachedResult]bool{}
-	for _, buffer := range ep.buffers {
+	var unmatched []*tupleWithDerivedInputRows
+	for alias, buffer := range ep.buffers {
 		curBufSize := int64(buffer.tuples.Len())
 		if buffer.windowType == parser.Tuples { // tuple-based window
 			windowSizeInt := int64(buffer.windowSize)
@@ -237,6 +363,9 @@ func (ep *streamRelationStreamExecutionPlan) removeOutdatedTuplesFromBuffer(curT
 					for _, inputRow := range tupCont.rows {
 						expiredInputRows[inputRow] = true
 					}
+					if ep.join != nil {
+						unmatched = append(unmatched, ep.removeJoinTuple(alias, tupCont)...)
+					}
 					buffer.tuples.Remove(e)
 				}
 			}
@@ -245,6 +374,8 @@ func (ep *streamRelationStreamExecutionPlan) removeOutdatedTuplesFromBuffer(curT
 			windowSizeSeconds := float64(buffer.windowSize)
 			if buffer.windowType == parser.Milliseconds {
 				windowSizeSeconds = windowSizeSeconds / 1000
+			} else if buffer.windowType == parser.Minutes {
+				windowSizeSeconds = windowSizeSeconds * 60
 			}
 			// we have to remove all items from the list that are
 			// older than the specified window length
@@ -259,6 +390,9 @@ func (ep *streamRelationStreamExecutionPlan) removeOutdatedTuplesFromBuffer(curT
 					for _, inputRow := range tupCont.rows {
 						expiredInputRows[inputRow] = true
 					}
+					if ep.join != nil {
+						unmatched = append(unmatched, ep.removeJoinTuple(alias, tupCont)...)
+					}
 					buffer.tuples.Remove(e)
 				}
 			}
@@ -272,10 +406,23 @@ func (ep *streamRelationStreamExecutionPlan) removeOutdatedTuplesFromBuffer(curT
 		next = e.Next()
 		itemPtr := e.Value.(*inputRowWithCachedResult)
 		if toDelete := expiredInputRows[itemPtr]; toDelete {
-			ep.filteredInputRows.Remove(e)
+			ep.removeFilteredInputRow(e)
 		}
 	}
 
+	// with a LEFT OUTER JOIN, tuples of the left relation that are not
+	// joined with any tuple anymore are used with NULL values again
+	if len(unmatched) > 0 {
+		ep.filteredInputRowsBuffer = list.New()
+		for _, l := range unmatched {
+			if !l.join.removed && l.join.numMatches == 0 && l.join.nullRow == nil {
+				if err := ep.addNullRow(l); err != nil {
+					return err
+				}
+			}
+		}
+		ep.appendFilteredInputRows()
+	}
 	return nil
 }
 
@@ -409,6 +556,200 @@ func (ep *streamRelationStreamExecutionPlan) computeResultTuples() ([]data.Map,
 // order of items in the returned slice is undefined and cannot be relied on.
 func (ep *streamRelationStreamExecutionPlan) process(input *core.Tuple, performQueryOnBuffer func() error) ([]data.Map, error) {
 	ep.now = time.Now().In(time.UTC)
+	ep.lateTuples = nil
+
+	if len(ep.orderAscending) > 0 || ep.limit.HasLimit {
+		query := performQueryOnBuffer
+		performQueryOnBuffer = func() error {
+			if err := query(); err != nil {
+				return err
+			}
+			return ep.sortAndLimitResults()
+		}
+	}
+
+	if ep.watermark.Delay.Unit != parser.UnspecifiedIntervalUnit {
+		return ep.processWithWatermark(input, performQueryOnBuffer)
+	}
+	return ep.processInOrder(input, performQueryOnBuffer)
+}
+
+// sortAndLimitResults sorts the results of a query over the buffer by the
+// ORDER BY clause and keeps only the rows selected by the LIMIT and
+// OFFSET clauses. The hidden columns holding the values to sort by are
+// removed from the results.
+func (ep *streamRelationStreamExecutionPlan) sortAndLimitResults() error {
+	results := ep.curResults
+	if len(ep.orderAscending) > 0 {
+		keys := make([]string, len(ep.orderAscending))
+		ordering := make([]sortArray, len(ep.orderAscending))
+		for i, asc := range ep.orderAscending {
+			keys[i] = orderingKey(i)
+			ordering[i] = sortArray{make(data.Array, len(results)), asc}
+		}
+		indexes := make([]int, len(results))
+		for j, res := range results {
+			indexes[j] = j
+			// result rows may be cached by the plan, so the hidden
+			// columns are removed from a copy
+			row := make(data.Map, len(res.row))
+			for k, v := range res.row {
+				row[k] = v
+			}
+			for i, key := range keys {
+				v, ok := row[key]
+				if !ok {
+					v = data.Null{}
+				}
+				ordering[i].values[j] = v
+				delete(row, key)
+			}
+			results[j] = resultRow{row: row, hash: data.Hash(row)}
+		}
+		sort.Stable(&indexSlice{indexes, ordering})
+		sorted := make([]resultRow, len(results))
+		for j, idx := range indexes {
+			sorted[j] = results[idx]
+		}
+		results = sorted
+	}
+
+	if ep.limit.HasLimit {
+		offset, limit := ep.limit.Offset, ep.limit.Limit
+		if offset > int64(len(results)) {
+			offset = int64(len(results))
+		}
+		results = results[offset:]
+		if limit < int64(len(results)) {
+			results = results[:limit]
+		}
+	}
+	ep.curResults = results
+	return nil
+}
+
+// LateTuples returns the tuples that were passed to the last call of
+// Process, but arrived too late to be used in any window.
+func (ep *streamRelationStreamExecutionPlan) LateTuples() []*core.Tuple {
+	return ep.lateTuples
+}
+
+// Checkpoint returns the tuples in the current windows and the tuples
+// waiting for the watermark in the order in which they have to be
+// processed to restore the state of the plan.
+func (ep *streamRelationStreamExecutionPlan) Checkpoint() []*core.Tuple {
+	var lists [][]*core.Tuple
+	if ep.isSession() {
+		for e := ep.sessions.Front(); e != nil; e = e.Next() {
+			lists = append(lists, e.Value.(*sessionWindow).tuples)
+		}
+	} else {
+		// with an ALLOWED LATENESS clause, the released tuples contain
+		// all tuples in the buffers except for those which are older
+		// than the oldest released tuple
+		var oldest time.Time
+		if e := ep.releasedTuples.Front(); e != nil {
+			oldest = e.Value.(*core.Tuple).Timestamp
+			released := make([]*core.Tuple, 0, ep.releasedTuples.Len())
+			for ; e != nil; e = e.Next() {
+				released = append(released, e.Value.(*core.Tuple))
+			}
+			lists = append(lists, released)
+		}
+		for _, buffered := range ep.bufferedTuples() {
+			if !oldest.IsZero() {
+				n := 0
+				for n < len(buffered) && buffered[n].Timestamp.Before(oldest) {
+					n++
+				}
+				buffered = buffered[:n]
+			}
+			lists = append(lists, buffered)
+		}
+	}
+
+	tuples := mergeByTimestamp(lists)
+	for e := ep.pendingTuples.Front(); e != nil; e = e.Next() {
+		tuples = append(tuples, e.Value.(*core.Tuple))
+	}
+	return tuples
+}
+
+// bufferedTuples returns the tuples in the buffers as they were passed
+// to Process, one list per input. When an input is used by more than
+// one relation, the tuples of the largest buffer are returned because
+// all other buffers of the input hold a suffix of them.
+func (ep *streamRelationStreamExecutionPlan) bufferedTuples() [][]*core.Tuple {
+	largest := map[string]string{}
+	for _, rel := range ep.relations {
+		key := ep.relationKey(&rel)
+		if prev, ok := largest[key]; ok &&
+			ep.buffers[prev].tuples.Len() >= ep.buffers[rel.Alias].tuples.Len() {
+			continue
+		}
+		largest[key] = rel.Alias
+	}
+
+	lists := make([][]*core.Tuple, 0, len(largest))
+	for _, rel := range ep.relations {
+		key := ep.relationKey(&rel)
+		alias, ok := largest[key]
+		if !ok {
+			continue
+		}
+		delete(largest, key)
+		buffer := ep.buffers[alias]
+		tuples := make([]*core.Tuple, 0, buffer.tuples.Len())
+		for e := buffer.tuples.Front(); e != nil; e = e.Next() {
+			t := e.Value.(*tupleWithDerivedInputRows).tuple.ShallowCopy()
+			// remove the nesting done by addTupleToBuffer
+			t.Data, _ = t.Data[alias].(data.Map)
+			tuples = append(tuples, t)
+		}
+		lists = append(lists, tuples)
+	}
+	return lists
+}
+
+// mergeByTimestamp merges lists of tuples into one list which is sorted
+// by the timestamps of the tuples as long as the lists are sorted. The
+// order of the tuples within a list is always kept.
+func mergeByTimestamp(lists [][]*core.Tuple) []*core.Tuple {
+	n := 0
+	for _, l := range lists {
+		n += len(l)
+	}
+	merged := make([]*core.Tuple, 0, n)
+	for len(merged) < n {
+		next := -1
+		for i, l := range lists {
+			if len(l) == 0 {
+				continue
+			}
+			if next < 0 || l[0].Timestamp.Before(lists[next][0].Timestamp) {
+				next = i
+			}
+		}
+		merged = append(merged, lists[next][0])
+		lists[next] = lists[next][1:]
+	}
+	return merged
+}
+
+// hasTimeSlide returns true if the windows have a time-based SLIDE clause.
+func (ep *streamRelationStreamExecutionPlan) hasTimeSlide() bool {
+	return ep.slide.Unit != parser.UnspecifiedIntervalUnit && ep.slide.Unit != parser.Tuples
+}
+
+// processInOrder processes a tuple assuming that it did not arrive earlier
+// than any tuple processed before.
+func (ep *streamRelationStreamExecutionPlan) processInOrder(input *core.Tuple, performQueryOnBuffer func() error) ([]data.Map, error) {
+	if ep.isSession() {
+		return ep.processWithSession(input, performQueryOnBuffer)
+	}
+	if ep.hasTimeSlide() {
+		return ep.processWithTimeSlide(input, performQueryOnBuffer)
+	}
 
 	// stream-to-relation:
 	// updates the internal buffer with correct window data
@@ -425,6 +766,15 @@ func (ep *streamRelationStreamExecutionPlan) process(input *core.Tuple, performQ
 	if err := ep.filterInputTuples(); err != nil {
 		return nil, err
 	}
+	if ep.slide.Unit == parser.Tuples {
+		// with a tuple-based SLIDE, the query is only performed
+		// once every `slide` tuples
+		ep.tuplesSinceEmit++
+		if ep.tuplesSinceEmit < int64(ep.slide.Value) {
+			return nil, nil
+		}
+		ep.tuplesSinceEmit = 0
+	}
 	if err := performQueryOnBuffer(); err != nil {
 		return nil, err
 	}
@@ -434,11 +784,459 @@ func (ep *streamRelationStreamExecutionPlan) process(input *core.Tuple, performQ
 	return ep.computeResultTuples()
 }
 
+// processWithTimeSlide works like process, but performs the query only
+// when the timestamp of the input tuple lies behind the end of the
+// current window. The window that is closed this way does not contain
+// the input tuple, which is added to the buffer afterwards. Windows are
+// aligned to multiples of the slide interval, so with
+// `[RANGE 1 MINUTES SLIDE 10 SECONDS]` results are computed for the
+// windows ending at :00, :10, :20 etc. of every minute.
+func (ep *streamRelationStreamExecutionPlan) processWithTimeSlide(input *core.Tuple, performQueryOnBuffer func() error) ([]data.Map, error) {
+	if ep.nextBoundary.IsZero() {
+		slide := intervalToDuration(ep.slide)
+		ep.nextBoundary = input.Timestamp.Truncate(slide).Add(slide)
+	}
+
+	output, err := ep.closeWindowsUntil(input.Timestamp, performQueryOnBuffer)
+	if err != nil {
+		return nil, err
+	}
+
+	// stream-to-relation for the window that is still open
+	if err := ep.addTupleToBuffer(input); err != nil {
+		return nil, err
+	}
+	if err := ep.removeOutdatedTuplesFromBuffer(input.Timestamp); err != nil {
+		return nil, err
+	}
+	if err := ep.filterInputTuples(); err != nil {
+		return nil, err
+	}
+	return output, nil
+}
+
+// closeWindowsUntil performs the query for all windows that end at or
+// before the given time and returns the concatenated results.
+func (ep *streamRelationStreamExecutionPlan) closeWindowsUntil(until time.Time, performQueryOnBuffer func() error) ([]data.Map, error) {
+	if ep.nextBoundary.IsZero() {
+		// no tuple has been processed yet
+		return nil, nil
+	}
+	slide := intervalToDuration(ep.slide)
+
+	var output []data.Map
+	for !until.Before(ep.nextBoundary) {
+		// the window ending at ep.nextBoundary is complete
+		if err := ep.removeOutdatedTuplesFromBuffer(ep.nextBoundary); err != nil {
+			return nil, err
+		}
+		if ep.buffersEmpty() {
+			// there is nothing to compute for the windows in between,
+			// so skip directly to the window that is still open
+			ep.nextBoundary = until.Truncate(slide).Add(slide)
+			break
+		}
+		if err := performQueryOnBuffer(); err != nil {
+			return nil, err
+		}
+		res, err := ep.computeResultTuples()
+		if err != nil {
+			return nil, err
+		}
+		output = append(output, res...)
+		ep.nextBoundary = ep.nextBoundary.Add(slide)
+	}
+	return output, nil
+}
+
+// currentWatermark returns the point in time before which no more
+// tuples are expected to arrive.
+func (ep *streamRelationStreamExecutionPlan) currentWatermark() time.Time {
+	return ep.maxTimestamp.Add(-intervalToDuration(ep.watermark.Delay))
+}
+
+// processWithWatermark holds the input tuple back until the watermark
+// has passed its timestamp and then processes all tuples that have been
+// passed by the watermark in the order of their timestamps. Tuples that
+// arrive after the watermark has passed them are handled by
+// processLateTuple.
+func (ep *streamRelationStreamExecutionPlan) processWithWatermark(input *core.Tuple, performQueryOnBuffer func() error) ([]data.Map, error) {
+	if !ep.maxTimestamp.IsZero() && input.Timestamp.Before(ep.currentWatermark()) {
+		return ep.processLateTuple(input, performQueryOnBuffer)
+	}
+
+	// the tuple is cached, so ShallowCopy is required here
+	insertByTimestamp(ep.pendingTuples, input.ShallowCopy())
+	if input.Timestamp.After(ep.maxTimestamp) {
+		ep.maxTimestamp = input.Timestamp
+	}
+	watermark := ep.currentWatermark()
+	keepReleased := intervalToDuration(ep.watermark.AllowedLateness) > 0
+
+	var output []data.Map
+	for e := ep.pendingTuples.Front(); e != nil; e = ep.pendingTuples.Front() {
+		t := e.Value.(*core.Tuple)
+		if t.Timestamp.After(watermark) {
+			break
+		}
+		ep.pendingTuples.Remove(e)
+		res, err := ep.processInOrder(t, performQueryOnBuffer)
+		if err != nil {
+			return nil, err
+		}
+		output = append(output, res...)
+		if keepReleased {
+			ep.releasedTuples.PushBack(t)
+		}
+	}
+
+	if ep.hasTimeSlide() {
+		// windows are complete as soon as the watermark has passed
+		// their end, even if no later tuple has been processed yet
+		res, err := ep.closeWindowsUntil(watermark, performQueryOnBuffer)
+		if err != nil {
+			return nil, err
+		}
+		output = append(output, res...)
+	} else if ep.isSession() {
+		res, err := ep.closeSessionsUntil(watermark, performQueryOnBuffer)
+		if err != nil {
+			return nil, err
+		}
+		output = append(output, res...)
+	}
+	if keepReleased {
+		ep.removeOutdatedReleasedTuples(watermark)
+	}
+	return output, nil
+}
+
+// processLateTuple handles a tuple that arrived after the watermark had
+// passed it. With a time-based SLIDE, the tuple is added to the window
+// that is still open (if it belongs there) and the results of all closed
+// windows containing it are computed again and emitted (as with RSTREAM)
+// if the watermark has not passed their end by more than the allowed
+// lateness. All other late tuples are dropped and can be obtained via
+// LateTuples.
+func (ep *streamRelationStreamExecutionPlan) processLateTuple(input *core.Tuple, performQueryOnBuffer func() error) ([]data.Map, error) {
+	if !ep.hasTimeSlide() || ep.nextBoundary.IsZero() {
+		ep.lateTuples = append(ep.lateTuples, input)
+		return nil, nil
+	}
+	ts := input.Timestamp
+	slide := intervalToDuration(ep.slide)
+	lateness := intervalToDuration(ep.watermark.AllowedLateness)
+	watermark := ep.currentWatermark()
+	windowSize := ep.maxWindowDuration()
+
+	// find the closed windows that contain the tuple and can still be
+	// corrected (in ascending order)
+	var boundaries []time.Time
+	for b := ep.nextBoundary.Add(-slide); b.After(ts); b = b.Add(-slide) {
+		if !b.Add(lateness).After(watermark) {
+			// the watermark has passed the end of this and all
+			// earlier windows by more than the allowed lateness
+			break
+		}
+		if !b.Add(-windowSize).After(ts) {
+			boundaries = append([]time.Time{b}, boundaries...)
+		}
+	}
+	inOpenWindow := !ts.Before(ep.nextBoundary.Add(-windowSize))
+	if !inOpenWindow && len(boundaries) == 0 {
+		ep.lateTuples = append(ep.lateTuples, input)
+		return nil, nil
+	}
+
+	if inOpenWindow {
+		if err := ep.addTupleToBuffer(input); err != nil {
+			return nil, err
+		}
+		if err := ep.filterInputTuples(); err != nil {
+			return nil, err
+		}
+	}
+	if lateness > 0 {
+		// the tuple is cached, so ShallowCopy is required here
+		insertByTimestamp(ep.releasedTuples, input.ShallowCopy())
+	}
+
+	var output []data.Map
+	for _, b := range boundaries {
+		res, err := ep.recomputeWindow(b, performQueryOnBuffer)
+		if err != nil {
+			return nil, err
+		}
+		output = append(output, res...)
+	}
+	return output, nil
+}
+
+// recomputeWindow performs the query on the released tuples that belong
+// to the window ending at the given boundary and returns all results.
+// The state of the plan is not changed by this method.
+func (ep *streamRelationStreamExecutionPlan) recomputeWindow(boundary time.Time, performQueryOnBuffer func() error) ([]data.Map, error) {
+	return ep.queryIsolated(func() error {
+		for e := ep.releasedTuples.Front(); e != nil; e = e.Next() {
+			t := e.Value.(*core.Tuple)
+			if !t.Timestamp.Before(boundary) {
+				break
+			}
+			if err := ep.addTupleToBuffer(t); err != nil {
+				return err
+			}
+			if err := ep.filterInputTuples(); err != nil {
+				return err
+			}
+		}
+		return ep.removeOutdatedTuplesFromBuffer(boundary)
+	}, performQueryOnBuffer)
+}
+
+// queryIsolated performs the query on empty buffers that are filled by
+// the given function and returns all results. The state of the plan is
+// not changed by this method.
+func (ep *streamRelationStreamExecutionPlan) queryIsolated(fill func() error, performQueryOnBuffer func() error) ([]data.Map, error) {
+	// save the current state and restore it after the computation
+	buffers, filteredInputRows := ep.buffers, ep.filteredInputRows
+	curResults, prevResults := ep.curResults, ep.prevResults
+	lastTupleBuffers := ep.lastTupleBuffers
+	trackRowChanges, rowChanges := ep.trackRowChanges, ep.rowChanges
+	defer func() {
+		ep.buffers, ep.filteredInputRows = buffers, filteredInputRows
+		ep.curResults, ep.prevResults = curResults, prevResults
+		ep.lastTupleBuffers = lastTupleBuffers
+		ep.trackRowChanges, ep.rowChanges = trackRowChanges, rowChanges
+	}()
+
+	ep.buffers = make(map[string]*inputBuffer, len(buffers))
+	for alias, buffer := range buffers {
+		ep.buffers[alias] = &inputBuffer{list.New(), buffer.windowSize, buffer.windowType, nil}
+	}
+	ep.filteredInputRows = list.New()
+	// the changes of the isolated rows must not be applied to the state
+	// of an incremental computation
+	ep.trackRowChanges, ep.rowChanges = false, nil
+	ep.curResults = []resultRow{}
+	ep.prevResults = []resultRow{}
+
+	if err := fill(); err != nil {
+		return nil, err
+	}
+	if err := performQueryOnBuffer(); err != nil {
+		return nil, err
+	}
+	output := make([]data.Map, len(ep.curResults))
+	for i, res := range ep.curResults {
+		output[i] = res.row
+	}
+	return output, nil
+}
+
+// isSession returns true if the window is a session window.
+func (ep *streamRelationStreamExecutionPlan) isSession() bool {
+	return ep.gap.Unit != parser.UnspecifiedIntervalUnit
+}
+
+// processWithSession adds the input tuple to the session window of its
+// group key, i.e., the values of the GROUP BY clause. Before that, the
+// query is performed on every session window whose last tuple is older
+// than the input tuple by more than the session gap, and the results
+// are returned (as with RSTREAM). Tuples that do not match the WHERE
+// clause do not belong to any session.
+func (ep *streamRelationStreamExecutionPlan) processWithSession(input *core.Tuple, performQueryOnBuffer func() error) ([]data.Map, error) {
+	output, err := ep.closeSessionsUntil(input.Timestamp, performQueryOnBuffer)
+	if err != nil {
+		return nil, err
+	}
+
+	key, ok, err := ep.sessionKey(input)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return output, nil
+	}
+	hash := data.Hash(key)
+	var elem *list.Element
+	for _, e := range ep.sessionsByHash[hash] {
+		if data.Equal(key, e.Value.(*sessionWindow).key) {
+			elem = e
+			break
+		}
+	}
+	if elem == nil {
+		elem = ep.sessions.PushBack(&sessionWindow{
+			key:  key,
+			hash: hash,
+			last: input.Timestamp,
+		})
+		ep.sessionsByHash[hash] = append(ep.sessionsByHash[hash], elem)
+	}
+
+	session := elem.Value.(*sessionWindow)
+	// the tuple is cached, so ShallowCopy is required here
+	session.tuples = append(session.tuples, input.ShallowCopy())
+	if input.Timestamp.After(session.last) {
+		session.last = input.Timestamp
+		// keep the sessions sorted by the timestamp of their last tuple
+		for e := ep.sessions.Back(); e != elem; e = e.Prev() {
+			if !e.Value.(*sessionWindow).last.After(session.last) {
+				ep.sessions.MoveAfter(elem, e)
+				break
+			}
+		}
+	}
+	return output, nil
+}
+
+// sessionKey evaluates the GROUP BY clause on the input tuple and
+// returns the group key of the session the tuple belongs to. The
+// second return value is false if the tuple does not match the
+// WHERE clause.
+func (ep *streamRelationStreamExecutionPlan) sessionKey(input *core.Tuple) (data.Array, bool, error) {
+	alias := ep.relations[0].Alias
+	d := data.Map{alias: input.Data}
+	setMetadata(d, alias, input)
+	d[":meta:NOW"] = data.Timestamp(ep.now)
+
+	if ep.filter != nil {
+		filterResult, err := ep.filter.Eval(d)
+		if err != nil {
+			return nil, false, err
+		}
+		// a NULL value is definitely not "true", so since we
+		// have only a binary decision, we should drop tuples
+		// where the filter condition evaluates to NULL
+		filterResultBool := false
+		if filterResult.Type() != data.TypeNull {
+			filterResultBool, err = data.AsBool(filterResult)
+			if err != nil {
+				return nil, false, err
+			}
+		}
+		if !filterResultBool {
+			return nil, false, nil
+		}
+	}
+
+	key := make(data.Array, len(ep.groupList))
+	for i, eval := range ep.groupList {
+		value, err := eval.Eval(d)
+		if err != nil {
+			return nil, false, err
+		}
+		key[i] = value
+	}
+	return key, true, nil
+}
+
+// closeSessionsUntil performs the query on all session windows whose
+// last tuple is older than the given time by more than the session gap,
+// removes them and returns the concatenated results.
+func (ep *streamRelationStreamExecutionPlan) closeSessionsUntil(until time.Time, performQueryOnBuffer func() error) ([]data.Map, error) {
+	gap := intervalToDuration(ep.gap)
+
+	var output []data.Map
+	for e := ep.sessions.Front(); e != nil; e = ep.sessions.Front() {
+		session := e.Value.(*sessionWindow)
+		if until.Sub(session.last) <= gap {
+			// all other sessions have a later last tuple
+			break
+		}
+		ep.removeSession(e)
+
+		res, err := ep.queryIsolated(func() error {
+			for _, t := range session.tuples {
+				if err := ep.addTupleToBuffer(t); err != nil {
+					return err
+				}
+				if err := ep.filterInputTuples(); err != nil {
+					return err
+				}
+			}
+			return nil
+		}, performQueryOnBuffer)
+		if err != nil {
+			return nil, err
+		}
+		output = append(output, res...)
+	}
+	return output, nil
+}
+
+// removeSession removes the given element from the open sessions.
+func (ep *streamRelationStreamExecutionPlan) removeSession(e *list.Element) {
+	session := ep.sessions.Remove(e).(*sessionWindow)
+	elems := ep.sessionsByHash[session.hash]
+	for i, other := range elems {
+		if other == e {
+			elems = append(elems[:i], elems[i+1:]...)
+			break
+		}
+	}
+	if len(elems) == 0 {
+		delete(ep.sessionsByHash, session.hash)
+	} else {
+		ep.sessionsByHash[session.hash] = elems
+	}
+}
+
+// removeOutdatedReleasedTuples removes all tuples from releasedTuples
+// that cannot be part of a window which can still be corrected.
+func (ep *streamRelationStreamExecutionPlan) removeOutdatedReleasedTuples(watermark time.Time) {
+	lateness := intervalToDuration(ep.watermark.AllowedLateness)
+	oldest := watermark.Add(-lateness).Add(-ep.maxWindowDuration())
+	for e := ep.releasedTuples.Front(); e != nil; e = ep.releasedTuples.Front() {
+		if !e.Value.(*core.Tuple).Timestamp.Before(oldest) {
+			break
+		}
+		ep.releasedTuples.Remove(e)
+	}
+}
+
+// maxWindowDuration returns the size of the largest time-based window.
+func (ep *streamRelationStreamExecutionPlan) maxWindowDuration() time.Duration {
+	max := time.Duration(0)
+	for _, rel := range ep.relations {
+		if d := intervalToDuration(rel.IntervalAST); d > max {
+			max = d
+		}
+	}
+	return max
+}
+
+// insertByTimestamp inserts a tuple into a list of tuples sorted by
+// their timestamps. Tuples having the same timestamp keep the order
+// in which they were inserted.
+func insertByTimestamp(l *list.List, t *core.Tuple) {
+	for e := l.Back(); e != nil; e = e.Prev() {
+		if !e.Value.(*core.Tuple).Timestamp.After(t.Timestamp) {
+			l.InsertAfter(t, e)
+			return
+		}
+	}
+	l.PushFront(t)
+}
+
+// buffersEmpty returns true if none of the input buffers holds a tuple.
+func (ep *streamRelationStreamExecutionPlan) buffersEmpty() bool {
+	for _, buffer := range ep.buffers {
+		if buffer.tuples.Len() > 0 {
+			return false
+		}
+	}
+	return true
+}
+
 func (ep *streamRelationStreamExecutionPlan) filterInputTuples() error {
 	// we need to make a cross product of the data in all buffers,
 	// combine it to get an input like
 	//  {"streamA": {data}, "streamB": {data}, "streamC": {data}}
 	// and evalute the filter on each of these items
+	if ep.join != nil {
+		return ep.filterJoinedInputTuples()
+	}
 
 	dataHolder := data.Map{}
 
@@ -520,10 +1318,30 @@ func (ep *streamRelationStreamExecutionPlan) filterInputTuples() error {
 	// write only the items matching the filter to ep.filteredInputRows
 	// (NB. the items appended here will be cleaned up in future
 	// runs by `removeOutdatedTuplesFromBuffer`)
-	ep.filteredInputRows.PushBackList(ep.filteredInputRowsBuffer)
+	ep.appendFilteredInputRows()
 	return nil
 }
 
+// appendFilteredInputRows moves the rows in ep.filteredInputRowsBuffer
+// to the end of ep.filteredInputRows.
+func (ep *streamRelationStreamExecutionPlan) appendFilteredInputRows() {
+	if ep.trackRowChanges {
+		for e := ep.filteredInputRowsBuffer.Front(); e != nil; e = e.Next() {
+			row := e.Value.(*inputRowWithCachedResult)
+			ep.rowChanges = append(ep.rowChanges, rowChange{row, true})
+		}
+	}
+	ep.filteredInputRows.PushBackList(ep.filteredInputRowsBuffer)
+}
+
+// removeFilteredInputRow removes an element from ep.filteredInputRows.
+func (ep *streamRelationStreamExecutionPlan) removeFilteredInputRow(e *list.Element) {
+	row := ep.filteredInputRows.Remove(e).(*inputRowWithCachedResult)
+	if ep.trackRowChanges {
+		ep.rowChanges = append(ep.rowChanges, rowChange{row, false})
+	}
+}
+
 // preprocessCartesianProduct computes the cartesian product,
 // applies this plan's filter/join condition to each item and
 // appends it to `ep.filteredInputRows`
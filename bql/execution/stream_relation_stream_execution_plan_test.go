@@ -2,6 +2,7 @@ package execution
 
 import (
 	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
 	"gopkg.in/sensorbee/sensorbee.v0/data"
 	"testing"
 )
@@ -49,3 +50,136 @@ func TestMultiplicityHandling(t *testing.T) {
 		})
 	})
 }
+
+func TestStreamRelationStreamExecutionPlanCheckpoint(t *testing.T) {
+	Convey("Given a SELECT statement with a tuple-based window", t, func() {
+		tuples := getTuples(6)
+		s := `CREATE STREAM box AS SELECT ISTREAM int FROM src [RANGE 3 TUPLES]`
+		plan, err := createDefaultSelectPlan(s, t)
+		So(err, ShouldBeNil)
+		So(plan, ShouldImplement, (*Checkpointer)(nil))
+
+		Convey("When feeding it with tuples", func() {
+			for _, inTup := range tuples[:4] {
+				_, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+			}
+			cp := plan.(Checkpointer).Checkpoint()
+
+			Convey("Then the checkpoint should have the tuples in the window", func() {
+				So(len(cp), ShouldEqual, 3)
+				for i, t := range cp {
+					So(t.Data, ShouldResemble, tuples[i+1].Data)
+					So(t.InputName, ShouldEqual, "src")
+					So(t.Timestamp, ShouldResemble, tuples[i+1].Timestamp)
+				}
+			})
+
+			Convey("And restoring another plan from the checkpoint", func() {
+				restored, err := createDefaultSelectPlan(s, t)
+				So(err, ShouldBeNil)
+				for _, t := range cp {
+					_, err := restored.Process(t)
+					So(err, ShouldBeNil)
+				}
+
+				Convey("Then both plans should return the same results", func() {
+					for _, inTup := range tuples[4:] {
+						out, err := plan.Process(inTup)
+						So(err, ShouldBeNil)
+						res, err := restored.Process(inTup)
+						So(err, ShouldBeNil)
+						So(res, ShouldResemble, out)
+					}
+				})
+			})
+		})
+	})
+
+	Convey("Given a SELECT statement with a self-join", t, func() {
+		tuples := getTuples(4)
+		s := `CREATE STREAM box AS SELECT ISTREAM a:int AS a, b:int AS b FROM src [RANGE 1 TUPLES] AS a, src [RANGE 2 TUPLES] AS b`
+		plan, err := createDefaultSelectPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			for _, inTup := range tuples {
+				_, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+			}
+
+			Convey("Then the checkpoint should have the tuples of the largest window once", func() {
+				cp := plan.(Checkpointer).Checkpoint()
+				So(len(cp), ShouldEqual, 2)
+				So(cp[0].Data, ShouldResemble, tuples[2].Data)
+				So(cp[1].Data, ShouldResemble, tuples[3].Data)
+			})
+		})
+	})
+
+	Convey("Given a SELECT statement with a watermark", t, func() {
+		tuples := getTuples(4)
+		s := `CREATE STREAM box AS SELECT RSTREAM int FROM src [RANGE 2 TUPLES, WATERMARK DELAY 1 SECONDS]`
+		plan, err := createDefaultSelectPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			for _, inTup := range tuples {
+				_, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+			}
+
+			Convey("Then the checkpoint should also have the pending tuples", func() {
+				cp := plan.(Checkpointer).Checkpoint()
+				So(len(cp), ShouldEqual, 3)
+				for i, t := range cp {
+					So(t.Data, ShouldResemble, tuples[i+1].Data)
+				}
+			})
+		})
+	})
+
+	Convey("Given a SELECT statement with a session window", t, func() {
+		tuples := getTuples(4)
+		for _, t := range tuples {
+			t.Data["key"] = data.Int(t.Data["int"].(data.Int) % 2)
+		}
+		s := `CREATE STREAM box AS SELECT RSTREAM key, count(*) AS c FROM src [SESSION GAP 5 SECONDS] GROUP BY key`
+		plan, err := createGroupbyPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			for _, inTup := range tuples {
+				_, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+			}
+
+			Convey("Then the checkpoint should have the tuples of all sessions in order", func() {
+				cp := plan.(Checkpointer).Checkpoint()
+				So(cp, ShouldHaveLength, 4)
+				for i, t := range cp {
+					So(t.Data, ShouldResemble, tuples[i].Data)
+				}
+			})
+		})
+	})
+}
+
+func TestMergeByTimestamp(t *testing.T) {
+	Convey("Given lists of tuples", t, func() {
+		tuples := getTuples(5)
+		lists := [][]*core.Tuple{
+			{tuples[0], tuples[3]},
+			{},
+			{tuples[1], tuples[2], tuples[4]},
+		}
+
+		Convey("When merging them", func() {
+			merged := mergeByTimestamp(lists)
+
+			Convey("Then the result should be sorted by timestamp", func() {
+				So(merged, ShouldResemble, tuples)
+			})
+		})
+	})
+}
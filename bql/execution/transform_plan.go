@@ -13,6 +13,7 @@ import (
 
 const (
 	MaxRangeTuples   float64 = 1<<20 - 1
+	MaxRangeMin      float64 = 60 * 24
 	MaxRangeSec      float64 = 60 * 60 * 24
 	MaxRangeMillisec float64 = 60 * 60 * 24 * 1000
 )
@@ -44,9 +45,27 @@ type LogicalPlan struct {
 	EmitterSamplingType parser.EmitterSamplingType
 	Projections         []aliasedExpression
 	parser.WindowedFromAST
-	Filter    FlatExpression
-	GroupList []FlatExpression
+	Filter FlatExpression
+	// JoinKeys holds pairs of expressions on the first and the second
+	// relation (or the joined table), respectively, that must be equal
+	// for two tuples to be joined by a JOIN clause.
+	JoinKeys [][2]FlatExpression
+	// JoinFilter holds the part of the ON clause of a JOIN clause that
+	// is not covered by JoinKeys.
+	JoinFilter FlatExpression
+	GroupList  []FlatExpression
 	parser.HavingAST
+	// OrderAscending holds the direction of each expression in the
+	// ORDER BY clause. The expressions themselves are computed by
+	// hidden projections whose aliases are returned by orderingKey.
+	OrderAscending []bool
+	parser.LimitAST
+}
+
+// orderingKey returns the alias of the hidden projection that computes
+// the i-th expression of the ORDER BY clause.
+func orderingKey(i int) string {
+	return fmt.Sprintf(":order:%d", i)
 }
 
 // PhysicalPlan is a physical interface that is capable of
@@ -70,6 +89,28 @@ type PhysicalPlan interface {
 	Process(input *core.Tuple) ([]data.Map, error)
 }
 
+// LateTupleReporter is an optional interface of a PhysicalPlan. It is
+// implemented by plans that may drop tuples because they arrived after
+// the watermark of their window had passed them.
+type LateTupleReporter interface {
+	// LateTuples returns the tuples that were passed to the last call
+	// of Process, but were dropped because they arrived too late. The
+	// returned tuples must not be modified.
+	LateTuples() []*core.Tuple
+}
+
+// Checkpointer is an optional interface of a PhysicalPlan. It is
+// implemented by plans that hold input tuples, e.g., in their windows,
+// so that their state can be saved and restored after a restart.
+type Checkpointer interface {
+	// Checkpoint returns the input tuples held by the plan. Passing
+	// them to Process of a new plan created from the same statement
+	// in the returned order restores the state of the plan (the
+	// results of these calls must be discarded). The returned tuples
+	// must not be modified.
+	Checkpoint() []*core.Tuple
+}
+
 // Analyze checks the given SELECT statement for logical errors
 // (references to unknown tables etc.) and creates a LogicalPlan
 // that is internally consistent.
@@ -93,6 +134,8 @@ func Analyze(s parser.SelectStmt, reg udf.FunctionRegistry) (*LogicalPlan, error
 		return nil, err
 	}
 
+	resolveOrderingAliases(&s)
+
 	if err := validateReferences(&s); err != nil {
 		return nil, err
 	}
@@ -187,6 +230,23 @@ func flattenExpressions(s *parser.SelectStmt, reg udf.FunctionRegistry) (*Logica
 		groupingMode = true
 	}
 
+	orderAscending := make([]bool, len(s.Ordering))
+	for i, expr := range s.Ordering {
+		// convert the parser Expression to a FlatExpression
+		flatExpr, aggrs, err := ParserExprToMaybeAggregate(expr.Expr, numAggParams, reg)
+		numAggParams += len(aggrs)
+		if err != nil {
+			return nil, err
+		}
+		if len(aggrs) > 0 {
+			groupingMode = true
+		}
+		// the value to sort by is computed as a hidden column
+		flatProjExprs = append(flatProjExprs,
+			aliasedExpression{orderingKey(i), flatExpr, aggrs})
+		orderAscending[i] = expr.Ascending != parser.No
+	}
+
 	var filterExpr FlatExpression
 	if s.Filter != nil {
 		filterFlatExpr, err := ParserExprToFlatExpr(s.Filter, reg)
@@ -197,9 +257,57 @@ func flattenExpressions(s *parser.SelectStmt, reg udf.FunctionRegistry) (*Logica
 			}
 			return nil, err
 		}
+		if len(windowFuncApps(filterFlatExpr)) > 0 {
+			err := fmt.Errorf("window functions not allowed in WHERE clause")
+			return nil, err
+		}
 		filterExpr = filterFlatExpr
 	}
 
+	var joinKeys [][2]FlatExpression
+	var joinFilterExpr FlatExpression
+	if s.Join.Type != parser.UnspecifiedJoinType {
+		right := s.Join.Table
+		if right == "" {
+			right = s.Relations[1].Alias
+		}
+		keys, rest := splitJoinCondition(s.Join.On, s.Relations[0].Alias, right)
+		// convert the parser Expressions to FlatExpressions
+		toFlat := func(e parser.Expression) (FlatExpression, error) {
+			flatExpr, err := ParserExprToFlatExpr(e, reg)
+			if err != nil {
+				// return a prettier error message
+				if strings.HasPrefix(err.Error(), "you cannot use aggregate") {
+					err = fmt.Errorf("aggregates not allowed in ON clause")
+				}
+				return nil, err
+			}
+			if len(windowFuncApps(flatExpr)) > 0 {
+				err := fmt.Errorf("window functions not allowed in ON clause")
+				return nil, err
+			}
+			return flatExpr, nil
+		}
+		for _, key := range keys {
+			left, err := toFlat(key[0])
+			if err != nil {
+				return nil, err
+			}
+			right, err := toFlat(key[1])
+			if err != nil {
+				return nil, err
+			}
+			joinKeys = append(joinKeys, [2]FlatExpression{left, right})
+		}
+		if rest != nil {
+			flatExpr, err := toFlat(rest)
+			if err != nil {
+				return nil, err
+			}
+			joinFilterExpr = flatExpr
+		}
+	}
+
 	groupCols := make([]rowValue, len(s.GroupList))
 	flatGroupExprs := make([]FlatExpression, len(s.GroupList))
 	for i, expr := range s.GroupList {
@@ -226,6 +334,13 @@ func flattenExpressions(s *parser.SelectStmt, reg udf.FunctionRegistry) (*Logica
 
 	// check if grouping is done correctly
 	if groupingMode {
+		// window functions are computed over the rows of the window,
+		// which are not available after grouping
+		if containsWindowFuncApp(flatProjExprs) {
+			err := fmt.Errorf("window functions cannot be used in GROUP BY " +
+				"statements or together with aggregate functions")
+			return nil, err
+		}
 		for _, expr := range flatProjExprs {
 			// the wildcard operator cannot be used with GROUP BY
 			if expr.expr.ContainsWildcard() {
@@ -316,11 +431,82 @@ func flattenExpressions(s *parser.SelectStmt, reg udf.FunctionRegistry) (*Logica
 		flatProjExprs,
 		s.WindowedFromAST,
 		filterExpr,
+		joinKeys,
+		joinFilterExpr,
 		flatGroupExprs,
 		s.HavingAST,
+		orderAscending,
+		s.LimitAST,
 	}, nil
 }
 
+// splitJoinCondition splits the ON clause of a JOIN clause into the
+// equality conditions whose sides each refer to only one of the two
+// given relations and the rest of the conditions, which is nil if
+// there are no such conditions. The returned pairs have the expression
+// on the left relation first.
+func splitJoinCondition(on parser.Expression, left, right string) ([][2]parser.Expression, parser.Expression) {
+	refersTo := func(e parser.Expression, rel string) bool {
+		rels := e.ReferencedRelations()
+		return len(rels) == 1 && rels[rel]
+	}
+
+	var keys [][2]parser.Expression
+	var rest parser.Expression
+	var visit func(e parser.Expression)
+	visit = func(e parser.Expression) {
+		if b, ok := e.(parser.BinaryOpAST); ok {
+			switch b.Op {
+			case parser.And:
+				visit(b.Left)
+				visit(b.Right)
+				return
+			case parser.Equal:
+				if refersTo(b.Left, left) && refersTo(b.Right, right) {
+					keys = append(keys, [2]parser.Expression{b.Left, b.Right})
+					return
+				}
+				if refersTo(b.Left, right) && refersTo(b.Right, left) {
+					keys = append(keys, [2]parser.Expression{b.Right, b.Left})
+					return
+				}
+			}
+		}
+		if rest == nil {
+			rest = e
+		} else {
+			rest = parser.BinaryOpAST{parser.And, rest, e}
+		}
+	}
+	visit(on)
+	return keys, rest
+}
+
+// resolveOrderingAliases replaces each expression in the ORDER BY clause
+// that is just the alias of a projection (as in `SELECT avg(a) AS x ...
+// ORDER BY x`) by the aliased expression.
+func resolveOrderingAliases(s *parser.SelectStmt) {
+	if len(s.Ordering) == 0 {
+		return
+	}
+	aliased := map[string]parser.Expression{}
+	for _, proj := range s.Projections {
+		if a, ok := proj.(parser.AliasAST); ok {
+			aliased[a.Alias] = a.Expr
+		}
+	}
+	newOrdering := make([]parser.SortedExpressionAST, len(s.Ordering))
+	for i, expr := range s.Ordering {
+		if rv, ok := expr.Expr.(parser.RowValue); ok && rv.Relation == "" {
+			if e, ok := aliased[rv.Column]; ok {
+				expr.Expr = e
+			}
+		}
+		newOrdering[i] = expr
+	}
+	s.Ordering = newOrdering
+}
+
 // makeRelationAliases will assign an internal alias to every relation
 // does not yet have one (given by the user). It will also detect if
 // there is a conflict between aliases.
@@ -346,7 +532,7 @@ func makeRelationAliases(s *parser.SelectStmt) error {
 }
 
 // validateReferences checks if the references to input relations
-// in SELECT, WHERE, GROUP BY and HAVING clauses of the given
+// in SELECT, WHERE, GROUP BY, HAVING, ORDER BY and ON clauses of the given
 // statement are matching the relations mentioned in the FROM
 // clause.
 func validateReferences(s *parser.SelectStmt) error {
@@ -360,6 +546,26 @@ func validateReferences(s *parser.SelectStmt) error {
 	   the input relations (as in `SELECT a.col, b.col FROM a, b`).
 	*/
 
+	// a table joined with a relation can be referred to like a relation
+	aliases := make([]string, 0, len(s.Relations)+1)
+	for _, rel := range s.Relations {
+		aliases = append(aliases, rel.Alias)
+	}
+	if s.Join.Table != "" {
+		if len(s.Relations) != 1 {
+			return fmt.Errorf("%v with a table needs exactly one relation, not %d",
+				s.Join.Type, len(s.Relations))
+		}
+		if s.Join.Table == s.Relations[0].Alias {
+			return fmt.Errorf("cannot use relation '%s' and table '%s' with the "+
+				"same alias '%s'", s.Relations[0].Name, s.Join.Table, s.Join.Table)
+		}
+		aliases = append(aliases, s.Join.Table)
+	} else if s.Join.Type != parser.UnspecifiedJoinType && len(s.Relations) != 2 {
+		return fmt.Errorf("%v needs exactly two relations, not %d",
+			s.Join.Type, len(s.Relations))
+	}
+
 	// collect the referenced relations in SELECT, WHERE, GROUP BY clauses
 	// and store them in the given map
 	refRels := map[string]bool{}
@@ -383,6 +589,16 @@ func validateReferences(s *parser.SelectStmt) error {
 			refRels[rel] = true
 		}
 	}
+	for _, expr := range s.Ordering {
+		for rel := range expr.ReferencedRelations() {
+			refRels[rel] = true
+		}
+	}
+	if s.Join.On != nil {
+		for rel := range s.Join.On.ReferencedRelations() {
+			refRels[rel] = true
+		}
+	}
 
 	// do the correctness check for SELECT, WHERE, GROUP BY clauses
 	if len(s.Relations) == 0 {
@@ -390,7 +606,7 @@ func validateReferences(s *parser.SelectStmt) error {
 		// this case should never happen due to parser setup
 		return fmt.Errorf("need at least one relation to select from")
 
-	} else if len(s.Relations) == 1 {
+	} else if len(aliases) == 1 {
 		inputRel := s.Relations[0].Alias
 		if len(refRels) == 1 {
 			// Sample: SELECT a FROM b // SELECT b.a FROM b
@@ -422,6 +638,11 @@ func validateReferences(s *parser.SelectStmt) error {
 			if s.Having != nil {
 				s.Having = s.Having.RenameReferencedRelation("", inputRel)
 			}
+			newOrdering := make([]parser.SortedExpressionAST, len(s.Ordering))
+			for i, expr := range s.Ordering {
+				newOrdering[i] = expr.RenameReferencedRelation("", inputRel).(parser.SortedExpressionAST)
+			}
+			s.Ordering = newOrdering
 
 		} else if len(refRels) > 1 {
 			// Sample: SELECT a, b.a FROM b // SELECT b.a, x.a FROM b
@@ -438,21 +659,21 @@ func validateReferences(s *parser.SelectStmt) error {
 		// if we arrive here, the only referenced relation is valid or
 		// we do not actually reference anything
 
-	} else if len(s.Relations) > 1 {
+	} else if len(aliases) > 1 {
 		// Sample: SELECT b.a, c.d FROM b, c
 		// check if all referenced relations are actually listed in FROM
 		for rel := range refRels {
 			found := false
-			for _, inputRel := range s.Relations {
-				if rel == inputRel.Alias {
+			for _, alias := range aliases {
+				if rel == alias {
 					found = true
 					break
 				}
 			}
 			if !found {
-				prettyRels := make([]string, 0, len(s.Relations))
-				for _, inRel := range s.Relations {
-					prettyRels = append(prettyRels, fmt.Sprintf("'%s'", inRel.Alias))
+				prettyRels := make([]string, 0, len(aliases))
+				for _, alias := range aliases {
+					prettyRels = append(prettyRels, fmt.Sprintf("'%s'", alias))
 				}
 				prettyRelsStr := strings.Join(prettyRels, ", ")
 				err := fmt.Errorf("cannot reference relation '%s' "+
@@ -465,6 +686,15 @@ func validateReferences(s *parser.SelectStmt) error {
 	}
 
 	for _, rel := range s.Relations {
+		if rel.IsSession() {
+			if err := validateSession(s, &rel.StreamWindowAST); err != nil {
+				return err
+			}
+			if err := validateWatermark(&rel.StreamWindowAST); err != nil {
+				return err
+			}
+			continue
+		}
 		if rel.Value <= 0 {
 			err := fmt.Errorf("number in RANGE clause must be positive, not %v", rel.Value)
 			return err
@@ -489,6 +719,12 @@ func validateReferences(s *parser.SelectStmt) error {
 					rel.Value, int64(MaxRangeSec))
 				return err
 			}
+		case parser.Minutes:
+			if rel.Value > MaxRangeMin {
+				err := fmt.Errorf("RANGE value %v is too large for MINUTES (must be at most %d)",
+					rel.Value, int64(MaxRangeMin))
+				return err
+			}
 		case parser.Milliseconds:
 			if rel.Value > MaxRangeMillisec {
 				err := fmt.Errorf("RANGE value %v is too large for MILLISECONDS (must be at most %d)",
@@ -496,8 +732,97 @@ func validateReferences(s *parser.SelectStmt) error {
 				return err
 			}
 		}
+		if err := validateSlide(&rel.StreamWindowAST); err != nil {
+			return err
+		}
+		if err := validateWatermark(&rel.StreamWindowAST); err != nil {
+			return err
+		}
 	}
 
+	// the SLIDE and WATERMARK clauses control when results are emitted,
+	// so all relations of a statement must agree on them
+	for _, rel := range s.Relations[1:] {
+		if rel.Slide != s.Relations[0].Slide {
+			return fmt.Errorf("all relations must have the same SLIDE specification")
+		}
+		if rel.Watermark != s.Relations[0].Watermark {
+			return fmt.Errorf("all relations must have the same WATERMARK specification")
+		}
+	}
+
+	return nil
+}
+
+// validateSlide checks if the SLIDE clause of a window (if any) is
+// compatible with its RANGE clause.
+func validateSlide(w *parser.StreamWindowAST) error {
+	if w.Slide.Unit == parser.UnspecifiedIntervalUnit {
+		return nil
+	}
+	if w.Slide.Value <= 0 {
+		return fmt.Errorf("number in SLIDE clause must be positive, not %v", w.Slide.Value)
+	}
+	if (w.Unit == parser.Tuples) != (w.Slide.Unit == parser.Tuples) {
+		return fmt.Errorf("SLIDE unit %s cannot be used with RANGE unit %s",
+			w.Slide.Unit, w.Unit)
+	}
+	if w.Unit == parser.Tuples {
+		if w.Slide.Value > w.Value {
+			return fmt.Errorf("SLIDE value %v must not be larger than RANGE value %v",
+				w.Slide.Value, w.Value)
+		}
+		return nil
+	}
+	if intervalToDuration(w.Slide) > intervalToDuration(w.IntervalAST) {
+		return fmt.Errorf("SLIDE interval %v %s must not be larger than RANGE interval %v %s",
+			w.Slide.Value, w.Slide.Unit, w.Value, w.Unit)
+	}
+	if intervalToDuration(w.Slide) <= 0 {
+		return fmt.Errorf("SLIDE interval %v %s is too small", w.Slide.Value, w.Slide.Unit)
+	}
+	return nil
+}
+
+// validateSession checks if a SESSION window can be used in the
+// given statement.
+func validateSession(s *parser.SelectStmt, w *parser.StreamWindowAST) error {
+	if w.Gap.Value <= 0 {
+		return fmt.Errorf("number in SESSION GAP clause must be positive, not %v", w.Gap.Value)
+	}
+	if intervalToDuration(w.Gap) <= 0 {
+		return fmt.Errorf("SESSION GAP interval %v %s is too small", w.Gap.Value, w.Gap.Unit)
+	}
+	if len(s.Relations) != 1 {
+		return fmt.Errorf("SESSION windows cannot be used with more than one relation")
+	}
+	if s.EmitterType != parser.Rstream {
+		return fmt.Errorf("SESSION windows can only be used with RSTREAM")
+	}
+	return nil
+}
+
+// validateWatermark checks if the WATERMARK clause of a window (if any)
+// is compatible with the rest of the window specification.
+func validateWatermark(w *parser.StreamWindowAST) error {
+	wm := w.Watermark
+	if wm.Delay.Unit == parser.UnspecifiedIntervalUnit {
+		return nil
+	}
+	if wm.Delay.Value < 0 {
+		return fmt.Errorf("number in WATERMARK DELAY clause must not be negative, not %v",
+			wm.Delay.Value)
+	}
+	if wm.AllowedLateness.Unit == parser.UnspecifiedIntervalUnit {
+		return nil
+	}
+	if wm.AllowedLateness.Value <= 0 {
+		return fmt.Errorf("number in ALLOWED LATENESS clause must be positive, not %v",
+			wm.AllowedLateness.Value)
+	}
+	if w.Unit == parser.Tuples || w.Slide.Unit == parser.UnspecifiedIntervalUnit {
+		return fmt.Errorf("ALLOWED LATENESS can only be used with a time-based SLIDE clause")
+	}
 	return nil
 }
 
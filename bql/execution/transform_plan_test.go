@@ -46,13 +46,13 @@ type analyzeTest struct {
 func TestRelationChecker(t *testing.T) {
 	r := parser.IntervalAST{parser.FloatLiteral{2}, parser.Tuples}
 	singleFrom := parser.WindowedFromAST{
-		[]parser.AliasedStreamWindowAST{
-			{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "t", nil}, r, 0, parser.Wait}, ""},
+		Relations: []parser.AliasedStreamWindowAST{
+			{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "t", nil, nil}, r, parser.IntervalAST{}, parser.IntervalAST{}, parser.WatermarkAST{}, 0, parser.Wait}, ""},
 		},
 	}
 	singleFromAlias := parser.WindowedFromAST{
-		[]parser.AliasedStreamWindowAST{
-			{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "s", nil}, r, 0, parser.Wait}, "t"},
+		Relations: []parser.AliasedStreamWindowAST{
+			{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "s", nil, nil}, r, parser.IntervalAST{}, parser.IntervalAST{}, parser.WatermarkAST{}, 0, parser.Wait}, "t"},
 		},
 	}
 	two := parser.NumericLiteral{2}
@@ -532,52 +532,52 @@ func TestRelationAliasing(t *testing.T) {
 		{&parser.SelectStmt{
 			ProjectionsAST: proj,
 			WindowedFromAST: parser.WindowedFromAST{
-				[]parser.AliasedStreamWindowAST{
-					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil}, r, 0, parser.Wait}, ""},
+				Relations: []parser.AliasedStreamWindowAST{
+					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil, nil}, r, parser.IntervalAST{}, parser.IntervalAST{}, parser.WatermarkAST{}, 0, parser.Wait}, ""},
 				}},
 		}, ""},
 		// SELECT 2 FROM a AS b         -> OK
 		{&parser.SelectStmt{
 			ProjectionsAST: proj,
 			WindowedFromAST: parser.WindowedFromAST{
-				[]parser.AliasedStreamWindowAST{
-					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil}, r, 0, parser.Wait}, "b"},
+				Relations: []parser.AliasedStreamWindowAST{
+					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil, nil}, r, parser.IntervalAST{}, parser.IntervalAST{}, parser.WatermarkAST{}, 0, parser.Wait}, "b"},
 				}},
 		}, ""},
 		// SELECT 2 FROM a AS b, a      -> OK
 		{&parser.SelectStmt{
 			ProjectionsAST: proj,
 			WindowedFromAST: parser.WindowedFromAST{
-				[]parser.AliasedStreamWindowAST{
-					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil}, r, 0, parser.Wait}, "b"},
-					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil}, r, 0, parser.Wait}, ""},
+				Relations: []parser.AliasedStreamWindowAST{
+					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil, nil}, r, parser.IntervalAST{}, parser.IntervalAST{}, parser.WatermarkAST{}, 0, parser.Wait}, "b"},
+					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil, nil}, r, parser.IntervalAST{}, parser.IntervalAST{}, parser.WatermarkAST{}, 0, parser.Wait}, ""},
 				}},
 		}, ""},
 		// SELECT 2 FROM a AS b, c AS a -> OK
 		{&parser.SelectStmt{
 			ProjectionsAST: proj,
 			WindowedFromAST: parser.WindowedFromAST{
-				[]parser.AliasedStreamWindowAST{
-					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil}, r, 0, parser.Wait}, "b"},
-					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "c", nil}, r, 0, parser.Wait}, "a"},
+				Relations: []parser.AliasedStreamWindowAST{
+					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil, nil}, r, parser.IntervalAST{}, parser.IntervalAST{}, parser.WatermarkAST{}, 0, parser.Wait}, "b"},
+					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "c", nil, nil}, r, parser.IntervalAST{}, parser.IntervalAST{}, parser.WatermarkAST{}, 0, parser.Wait}, "a"},
 				}},
 		}, ""},
 		// SELECT 2 FROM a, a           -> NG
 		{&parser.SelectStmt{
 			ProjectionsAST: proj,
 			WindowedFromAST: parser.WindowedFromAST{
-				[]parser.AliasedStreamWindowAST{
-					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil}, r, 0, parser.Wait}, ""},
-					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil}, r, 0, parser.Wait}, ""},
+				Relations: []parser.AliasedStreamWindowAST{
+					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil, nil}, r, parser.IntervalAST{}, parser.IntervalAST{}, parser.WatermarkAST{}, 0, parser.Wait}, ""},
+					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil, nil}, r, parser.IntervalAST{}, parser.IntervalAST{}, parser.WatermarkAST{}, 0, parser.Wait}, ""},
 				}},
 		}, "cannot use relations"},
 		// SELECT 2 FROM a, b AS a      -> NG
 		{&parser.SelectStmt{
 			ProjectionsAST: proj,
 			WindowedFromAST: parser.WindowedFromAST{
-				[]parser.AliasedStreamWindowAST{
-					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil}, r, 0, parser.Wait}, ""},
-					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "b", nil}, r, 0, parser.Wait}, "a"},
+				Relations: []parser.AliasedStreamWindowAST{
+					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil, nil}, r, parser.IntervalAST{}, parser.IntervalAST{}, parser.WatermarkAST{}, 0, parser.Wait}, ""},
+					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "b", nil, nil}, r, parser.IntervalAST{}, parser.IntervalAST{}, parser.WatermarkAST{}, 0, parser.Wait}, "a"},
 				}},
 		}, "cannot use relations"},
 	}
@@ -820,12 +820,43 @@ func TestAggregateChecker(t *testing.T) {
 		{"a FROM x [RANGE 1 TUPLES] GROUP BY count(a)",
 			"aggregates not allowed in GROUP BY clause", nil, nil},
 
+		{"x:a FROM x [RANGE 1 TUPLES] JOIN y [RANGE 1 TUPLES] ON count(x:a) = y:a",
+			"aggregates not allowed in ON clause", nil, nil},
+
 		{"f(*) FROM x [RANGE 1 TUPLES] GROUP BY a",
 			"* cannot be used in GROUP BY statements", nil, nil},
 
 		{"a FROM x [RANGE 1 TUPLES] GROUP BY a + 2",
 			"grouping by expressions is not supported yet", nil, nil},
 
+		// window functions
+		{"a - lag(a) OVER (PARTITION BY b ORDER BY c DESC) FROM x [RANGE 1 TUPLES]", "",
+			binaryOpAST{parser.Minus,
+				rowValue{"x", "a"},
+				windowFuncAppAST{"lag", []FlatExpression{rowValue{"x", "a"}},
+					[]FlatExpression{rowValue{"x", "b"}},
+					[]windowSortExpression{{rowValue{"x", "c"}, false}}},
+			},
+			nil},
+
+		{"a FROM x [RANGE 1 TUPLES] WHERE row_number() OVER () = 1",
+			"window functions not allowed in WHERE clause", nil, nil},
+
+		{"row_number() OVER (), count(a) FROM x [RANGE 1 TUPLES]",
+			"window functions cannot be used in GROUP BY statements or together with aggregate functions", nil, nil},
+
+		{"lag(a) OVER () FROM x [RANGE 1 TUPLES] GROUP BY a",
+			"window functions cannot be used in GROUP BY statements or together with aggregate functions", nil, nil},
+
+		{"lag(lag(a) OVER ()) OVER () FROM x [RANGE 1 TUPLES]",
+			"window functions cannot be nested", nil, nil},
+
+		{"f(a) OVER () FROM x [RANGE 1 TUPLES]",
+			"'f' is not a window function", nil, nil},
+
+		{"row_number(a) OVER () FROM x [RANGE 1 TUPLES]",
+			"window function 'row_number' cannot take 1 arguments", nil, nil},
+
 		// various grouping checks
 		{"a FROM x [RANGE 1 TUPLES] GROUP BY a", "",
 			rowValue{"x", "a"},
@@ -928,6 +959,47 @@ func TestRangeChecker(t *testing.T) {
 		{"a FROM x [RANGE 86400000 MILLISECONDS]", ""},
 		{"a FROM x [RANGE 86400000.01 MILLISECONDS]",
 			"RANGE value 8.640000001e+07 is too large for MILLISECONDS (must be at most 86400000)"},
+		// MINUTES
+		{"a FROM x [RANGE 1 MINUTES]", ""},
+		{"a FROM x [RANGE 1440 MINUTES]", ""},
+		{"a FROM x [RANGE 1440.01 MINUTES]",
+			"RANGE value 1440.01 is too large for MINUTES (must be at most 1440)"},
+		// SLIDE
+		{"a FROM x [RANGE 1 MINUTES SLIDE 10 SECONDS]", ""},
+		{"a FROM x [RANGE 10 SECONDS SLIDE 10 SECONDS]", ""},
+		{"a FROM x [RANGE 10 TUPLES SLIDE 5 TUPLES]", ""},
+		{"a FROM x [RANGE 10 SECONDS SLIDE 0 SECONDS]",
+			"number in SLIDE clause must be positive, not 0"},
+		{"a FROM x [RANGE 10 SECONDS SLIDE 1 MINUTES]",
+			"SLIDE interval 1 MINUTES must not be larger than RANGE interval 10 SECONDS"},
+		{"a FROM x [RANGE 10 TUPLES SLIDE 11 TUPLES]",
+			"SLIDE value 11 must not be larger than RANGE value 10"},
+		{"a FROM x [RANGE 10 SECONDS SLIDE 5 TUPLES]",
+			"SLIDE unit TUPLES cannot be used with RANGE unit SECONDS"},
+		{"a FROM x [RANGE 10 TUPLES SLIDE 5 SECONDS]",
+			"SLIDE unit SECONDS cannot be used with RANGE unit TUPLES"},
+		{"x:a FROM x [RANGE 10 SECONDS SLIDE 5 SECONDS], y [RANGE 10 SECONDS SLIDE 5 SECONDS]", ""},
+		{"x:a FROM x [RANGE 10 SECONDS SLIDE 5 SECONDS], y [RANGE 10 SECONDS]",
+			"all relations must have the same SLIDE specification"},
+		// WATERMARK
+		{"a FROM x [RANGE 10 TUPLES, WATERMARK DELAY 5 SECONDS]", ""},
+		{"a FROM x [RANGE 10 SECONDS SLIDE 5 SECONDS, WATERMARK DELAY 5 SECONDS, ALLOWED LATENESS 1 MINUTES]", ""},
+		{"a FROM x [RANGE 10 SECONDS, WATERMARK DELAY 5 SECONDS, LATE TUPLES TO y]", ""},
+		{"a FROM x [RANGE 10 SECONDS, WATERMARK DELAY 5 SECONDS, ALLOWED LATENESS 1 MINUTES]",
+			"ALLOWED LATENESS can only be used with a time-based SLIDE clause"},
+		{"a FROM x [RANGE 10 TUPLES SLIDE 5 TUPLES, WATERMARK DELAY 5 SECONDS, ALLOWED LATENESS 1 MINUTES]",
+			"ALLOWED LATENESS can only be used with a time-based SLIDE clause"},
+		{"a FROM x [RANGE 10 SECONDS SLIDE 5 SECONDS, WATERMARK DELAY 5 SECONDS, ALLOWED LATENESS 0 SECONDS]",
+			"number in ALLOWED LATENESS clause must be positive, not 0"},
+		{"x:a FROM x [RANGE 10 SECONDS, WATERMARK DELAY 5 SECONDS], y [RANGE 10 SECONDS]",
+			"all relations must have the same WATERMARK specification"},
+		// SESSION
+		{"a FROM x [SESSION GAP 30 SECONDS]",
+			"SESSION windows can only be used with RSTREAM"},
+		{"a FROM x [SESSION GAP 0 SECONDS]",
+			"number in SESSION GAP clause must be positive, not 0"},
+		{"x:a FROM x [SESSION GAP 30 SECONDS], y [RANGE 10 SECONDS]",
+			"SESSION windows cannot be used with more than one relation"},
 	}
 
 	for _, testCase := range testCases {
@@ -1173,3 +1245,70 @@ func TestVolatileAggregateChecker(t *testing.T) {
 		})
 	}
 }
+
+func TestJoinConditionSplitting(t *testing.T) {
+	reg := udf.CopyGlobalUDFRegistry(core.NewContext(nil))
+
+	testCases := []struct {
+		on            string
+		expectedError string
+		keys          [][2]FlatExpression
+		filter        FlatExpression
+	}{
+		{"x:a = y:b", "",
+			[][2]FlatExpression{{rowValue{"x", "a"}, rowValue{"y", "b"}}},
+			nil},
+
+		// the sides of an equality are swapped if required
+		{"y:b = x:a AND x:c = y:d + 1", "",
+			[][2]FlatExpression{
+				{rowValue{"x", "a"}, rowValue{"y", "b"}},
+				{rowValue{"x", "c"}, binaryOpAST{parser.Plus, rowValue{"y", "d"}, numericLiteral{1}}},
+			},
+			nil},
+
+		// conditions that cannot be evaluated on one relation each
+		// become the filter
+		{"x:a = y:b AND x:c < y:d", "",
+			[][2]FlatExpression{{rowValue{"x", "a"}, rowValue{"y", "b"}}},
+			binaryOpAST{parser.Less, rowValue{"x", "c"}, rowValue{"y", "d"}}},
+
+		{"x:a = x:b OR x:c = y:d", "",
+			nil,
+			binaryOpAST{parser.Or,
+				binaryOpAST{parser.Equal, rowValue{"x", "a"}, rowValue{"x", "b"}},
+				binaryOpAST{parser.Equal, rowValue{"x", "c"}, rowValue{"y", "d"}}}},
+
+		{"x:a = z:b", "cannot reference relation 'z'", nil, nil},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		Convey(fmt.Sprintf("Given a JOIN with the condition %s", testCase.on), t, func() {
+			p := parser.New()
+			stmt := "CREATE STREAM s AS SELECT ISTREAM x:a FROM x [RANGE 1 TUPLES] " +
+				"INNER JOIN y [RANGE 1 TUPLES] ON " + testCase.on
+			astUnchecked, _, err := p.ParseStmt(stmt)
+			So(err, ShouldBeNil)
+			So(astUnchecked, ShouldHaveSameTypeAs, parser.CreateStreamAsSelectStmt{})
+			ast := astUnchecked.(parser.CreateStreamAsSelectStmt).Select
+
+			Convey("When we analyze it", func() {
+				logPlan, err := Analyze(ast, reg)
+				if testCase.expectedError == "" {
+					Convey("Then the condition is split into keys and a filter", func() {
+						So(err, ShouldBeNil)
+						So(logPlan.JoinKeys, ShouldResemble, testCase.keys)
+						So(logPlan.JoinFilter, ShouldResemble, testCase.filter)
+					})
+				} else {
+					Convey("There is an error", func() {
+						So(err, ShouldNotBeNil)
+						So(err.Error(), ShouldContainSubstring, testCase.expectedError)
+					})
+				}
+			})
+		})
+	}
+}
@@ -0,0 +1,353 @@
+package execution
+
+import (
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"sort"
+)
+
+// windowFunc is a function that can be used with an OVER clause, such as
+//  SELECT ISTREAM x - lag(x) OVER (PARTITION BY device ORDER BY ts())
+//      FROM s [RANGE 10 TUPLES]
+// Unlike a UDF, a window function computes the values for all rows of a
+// partition at once. args[i][j] holds the value of the i-th argument for
+// the j-th row of the partition, where the rows are sorted as given in the
+// OVER clause. The function must return one value per row.
+type windowFunc struct {
+	minArity int
+	maxArity int
+	call     func(args []data.Array, n int) ([]data.Value, error)
+}
+
+var windowFuncs = map[string]windowFunc{
+	"row_number": {0, 0, rowNumber},
+	"lag": {1, 3, func(args []data.Array, n int) ([]data.Value, error) {
+		return shiftedValues(args, n, -1)
+	}},
+	"lead": {1, 3, func(args []data.Array, n int) ([]data.Value, error) {
+		return shiftedValues(args, n, 1)
+	}},
+	"first_value": {1, 1, firstValue},
+}
+
+// rowNumber returns the number of each row in its partition,
+// starting from 1.
+func rowNumber(args []data.Array, n int) ([]data.Value, error) {
+	res := make([]data.Value, n)
+	for j := range res {
+		res[j] = data.Int(j + 1)
+	}
+	return res, nil
+}
+
+// shiftedValues returns the value of the first argument in the row that
+// is `offset` rows before (dir < 0) or after (dir > 0) each row. The
+// offset is given as the second argument and defaults to 1. If there is
+// no such row, the third argument or NULL is returned.
+func shiftedValues(args []data.Array, n int, dir int) ([]data.Value, error) {
+	res := make([]data.Value, n)
+	for j := range res {
+		offset := int64(1)
+		if len(args) > 1 {
+			o, err := data.AsInt(args[1][j])
+			if err != nil || o < 0 {
+				return nil, fmt.Errorf("offset must be a non-negative integer: %v",
+					args[1][j])
+			}
+			offset = o
+		}
+		var def data.Value = data.Null{}
+		if len(args) > 2 {
+			def = args[2][j]
+		}
+		k := int64(j) + int64(dir)*offset
+		if k < 0 || k >= int64(n) {
+			res[j] = def
+		} else {
+			res[j] = args[0][k]
+		}
+	}
+	return res, nil
+}
+
+// firstValue returns the value of the argument in the first row
+// of the partition.
+func firstValue(args []data.Array, n int) ([]data.Value, error) {
+	res := make([]data.Value, n)
+	for j := range res {
+		res[j] = args[0][0]
+	}
+	return res, nil
+}
+
+// windowFuncAppToFlatExpr converts the application of a window function
+// to a FlatExpression.
+func windowFuncAppToFlatExpr(w parser.WindowFuncAppAST, reg udf.FunctionRegistry) (FlatExpression, error) {
+	name := string(w.Func.Function)
+	f, ok := windowFuncs[name]
+	if !ok {
+		return nil, fmt.Errorf("'%s' is not a window function", name)
+	}
+	if arity := len(w.Func.Expressions); arity < f.minArity || arity > f.maxArity {
+		return nil, fmt.Errorf("window function '%s' cannot take %d arguments",
+			name, arity)
+	}
+	if len(w.Func.Ordering) > 0 {
+		return nil, fmt.Errorf("you cannot use ORDER BY in the arguments "+
+			"of window function '%s'", name)
+	}
+	// compute child expressions, which must not contain other
+	// window functions
+	toFlat := func(e parser.Expression) (FlatExpression, error) {
+		expr, err := ParserExprToFlatExpr(e, reg)
+		if err != nil {
+			return nil, err
+		}
+		if len(windowFuncApps(expr)) > 0 {
+			return nil, fmt.Errorf("window functions cannot be nested")
+		}
+		return expr, nil
+	}
+	exprs := make([]FlatExpression, len(w.Func.Expressions))
+	for i, ast := range w.Func.Expressions {
+		expr, err := toFlat(ast)
+		if err != nil {
+			return nil, err
+		}
+		exprs[i] = expr
+	}
+	partition := make([]FlatExpression, len(w.Partition))
+	for i, ast := range w.Partition {
+		expr, err := toFlat(ast)
+		if err != nil {
+			return nil, err
+		}
+		partition[i] = expr
+	}
+	ordering := make([]windowSortExpression, len(w.Ordering))
+	for i, sortExpr := range w.Ordering {
+		expr, err := toFlat(sortExpr.Expr)
+		if err != nil {
+			return nil, err
+		}
+		ordering[i] = windowSortExpression{expr, sortExpr.Ascending != parser.No}
+	}
+	return windowFuncAppAST{w.Func.Function, exprs, partition, ordering}, nil
+}
+
+// windowFuncApps returns all applications of window functions
+// in the given expression.
+func windowFuncApps(expr FlatExpression) []windowFuncAppAST {
+	var apps []windowFuncAppAST
+	var walk func(e FlatExpression)
+	walkAll := func(exprs []FlatExpression) {
+		for _, e := range exprs {
+			walk(e)
+		}
+	}
+	walk = func(e FlatExpression) {
+		switch obj := e.(type) {
+		case windowFuncAppAST:
+			apps = append(apps, obj)
+		case binaryOpAST:
+			walk(obj.Left)
+			walk(obj.Right)
+		case betweenAST:
+			walk(obj.Expr)
+			walk(obj.Lower)
+			walk(obj.Upper)
+		case unaryOpAST:
+			walk(obj.Expr)
+		case typeCastAST:
+			walk(obj.Expr)
+		case funcAppAST:
+			walkAll(obj.Expressions)
+		case aggregateInputSorter:
+			walkAll(obj.Expressions)
+		case arrayAST:
+			walkAll(obj.Expressions)
+		case mapAST:
+			for _, p := range obj.Entries {
+				walk(p.Value)
+			}
+		case caseAST:
+			walk(obj.Reference)
+			for _, p := range obj.Checks {
+				walk(p.When)
+				walk(p.Then)
+			}
+			walk(obj.Default)
+		}
+	}
+	walk(expr)
+	return apps
+}
+
+// containsWindowFuncApp returns true if any of the given projections
+// uses a window function.
+func containsWindowFuncApp(projections []aliasedExpression) bool {
+	for _, proj := range projections {
+		if len(windowFuncApps(proj.expr)) > 0 {
+			return true
+		}
+		for _, aggrInput := range proj.aggrInputs {
+			if len(windowFuncApps(aggrInput)) > 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// windowFuncEvaluator computes the values of one window function
+// for all rows of a window.
+type windowFuncEvaluator struct {
+	key       string
+	f         windowFunc
+	args      []Evaluator
+	partition []Evaluator
+	ordering  []Evaluator
+	ascending []bool
+}
+
+// prepareWindowFuncs creates evaluators for all window functions used in
+// the given projections. Applications having the same representation are
+// only computed once.
+func prepareWindowFuncs(projections []aliasedExpression, reg udf.FunctionRegistry) ([]*windowFuncEvaluator, error) {
+	var evals []*windowFuncEvaluator
+	seen := map[string]bool{}
+	toEvals := func(exprs []FlatExpression) ([]Evaluator, error) {
+		evals := make([]Evaluator, len(exprs))
+		for i, expr := range exprs {
+			eval, err := ExpressionToEvaluator(expr, reg)
+			if err != nil {
+				return nil, err
+			}
+			evals[i] = eval
+		}
+		return evals, nil
+	}
+	for _, proj := range projections {
+		for _, app := range windowFuncApps(proj.expr) {
+			key := app.Key()
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+
+			args, err := toEvals(app.Expressions)
+			if err != nil {
+				return nil, err
+			}
+			partition, err := toEvals(app.Partition)
+			if err != nil {
+				return nil, err
+			}
+			orderExprs := make([]FlatExpression, len(app.Ordering))
+			ascending := make([]bool, len(app.Ordering))
+			for i, o := range app.Ordering {
+				orderExprs[i] = o.Value
+				ascending[i] = o.Ascending
+			}
+			ordering, err := toEvals(orderExprs)
+			if err != nil {
+				return nil, err
+			}
+			evals = append(evals, &windowFuncEvaluator{
+				key:       key,
+				f:         windowFuncs[string(app.Function)],
+				args:      args,
+				partition: partition,
+				ordering:  ordering,
+				ascending: ascending,
+			})
+		}
+	}
+	return evals, nil
+}
+
+// eval computes the values of the window function for all rows and
+// stores them in results[j][w.key] for the j-th row.
+func (w *windowFuncEvaluator) eval(rows []data.Map, results []data.Map) error {
+	evalAll := func(evals []Evaluator, row data.Map) (data.Array, error) {
+		values := make(data.Array, len(evals))
+		for i, e := range evals {
+			v, err := e.Eval(row)
+			if err != nil {
+				return nil, err
+			}
+			values[i] = v
+		}
+		return values, nil
+	}
+
+	// split the rows into partitions, keeping the order in which
+	// partitions first appear
+	type partition struct {
+		key     data.Array
+		indexes []int
+	}
+	var partitions []*partition
+	byHash := map[data.HashValue][]*partition{}
+	args := make([]data.Array, len(rows))
+	ordering := make([]sortArray, len(w.ordering))
+	for i := range ordering {
+		ordering[i] = sortArray{make(data.Array, len(rows)), w.ascending[i]}
+	}
+	for j, row := range rows {
+		key, err := evalAll(w.partition, row)
+		if err != nil {
+			return err
+		}
+		var part *partition
+		h := data.Hash(key)
+		for _, p := range byHash[h] {
+			if data.Equal(p.key, key) {
+				part = p
+				break
+			}
+		}
+		if part == nil {
+			part = &partition{key: key}
+			byHash[h] = append(byHash[h], part)
+			partitions = append(partitions, part)
+		}
+		part.indexes = append(part.indexes, j)
+
+		args[j], err = evalAll(w.args, row)
+		if err != nil {
+			return err
+		}
+		orderValues, err := evalAll(w.ordering, row)
+		if err != nil {
+			return err
+		}
+		for i, v := range orderValues {
+			ordering[i].values[j] = v
+		}
+	}
+
+	for _, part := range partitions {
+		// rows with the same values in the ORDER BY clause
+		// keep the order in which they arrived
+		sort.Stable(&indexSlice{part.indexes, ordering})
+		n := len(part.indexes)
+		partArgs := make([]data.Array, len(w.args))
+		for i := range partArgs {
+			partArgs[i] = make(data.Array, n)
+			for k, j := range part.indexes {
+				partArgs[i][k] = args[j][i]
+			}
+		}
+		values, err := w.f.call(partArgs, n)
+		if err != nil {
+			return err
+		}
+		for k, j := range part.indexes {
+			results[j][w.key] = values[k]
+		}
+	}
+	return nil
+}
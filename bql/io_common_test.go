@@ -1,6 +1,7 @@
 package bql
 
 import (
+	"errors"
 	"fmt"
 	"gopkg.in/sensorbee/sensorbee.v0/core"
 	"gopkg.in/sensorbee/sensorbee.v0/data"
@@ -248,7 +249,27 @@ func (s *tupleCollectorUpdatableSink) Update(ctx *core.Context, params data.Map)
 	return nil
 }
 
+// failingSink is a sink which fails to write all tuples.
+type failingSink struct {
+}
+
+func (s *failingSink) Write(ctx *core.Context, t *core.Tuple) error {
+	return core.TemporaryError(errors.New("failure"))
+}
+
+func (s *failingSink) Close(ctx *core.Context) error {
+	return nil
+}
+
+func createFailingSink(ctx *core.Context, ioParams *IOParams, params data.Map) (core.Sink, error) {
+	for key := range params {
+		return nil, fmt.Errorf("unknown sink parameter: %s", key)
+	}
+	return &failingSink{}, nil
+}
+
 func init() {
 	MustRegisterGlobalSinkCreator("collector", SinkCreatorFunc(createCollectorSink))
 	MustRegisterGlobalSinkCreator("collector_updatable", SinkCreatorFunc(createCollectorUpdatableSink))
+	MustRegisterGlobalSinkCreator("failing", SinkCreatorFunc(createFailingSink))
 }
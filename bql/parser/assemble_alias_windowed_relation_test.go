@@ -11,8 +11,8 @@ func TestAssembleAliasedStreamWindow(t *testing.T) {
 
 		Convey("When the stack contains two correct items", func() {
 			ps.PushComponent(0, 6, Raw{"PRE"})
-			ps.PushComponent(6, 7, StreamWindowAST{Stream{ActualStream, "a", nil},
-				IntervalAST{FloatLiteral{2}, Seconds}, 2, UnspecifiedSheddingOption})
+			ps.PushComponent(6, 7, StreamWindowAST{Stream{ActualStream, "a", nil, nil},
+				IntervalAST{FloatLiteral{2}, Seconds}, IntervalAST{}, IntervalAST{}, WatermarkAST{}, 2, UnspecifiedSheddingOption})
 			ps.PushComponent(7, 8, Identifier("out"))
 			ps.AssembleAliasedStreamWindow()
 
@@ -29,8 +29,8 @@ func TestAssembleAliasedStreamWindow(t *testing.T) {
 					Convey("And it contains the previous data", func() {
 						comp := top.comp.(AliasedStreamWindowAST)
 						So(comp.StreamWindowAST, ShouldResemble,
-							StreamWindowAST{Stream{ActualStream, "a", nil},
-								IntervalAST{FloatLiteral{2}, Seconds}, 2, UnspecifiedSheddingOption})
+							StreamWindowAST{Stream{ActualStream, "a", nil, nil},
+								IntervalAST{FloatLiteral{2}, Seconds}, IntervalAST{}, IntervalAST{}, WatermarkAST{}, 2, UnspecifiedSheddingOption})
 						So(comp.Alias, ShouldEqual, "out")
 					})
 				})
@@ -0,0 +1,114 @@
+package parser
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"testing"
+)
+
+func TestAssembleCreateOrReplaceStreamAsSelect(t *testing.T) {
+	Convey("Given a parseStack", t, func() {
+		ps := parseStack{}
+		Convey("When the stack contains the correct CREATE OR REPLACE STREAM items", func() {
+			sel := SelectStmt{EmitterAST: EmitterAST{EmitterType: Istream}}
+			ps.PushComponent(2, 4, StreamIdentifier("x"))
+			ps.AssembleSourceSinkSpecs(4, 4)
+			ps.PushComponent(4, 10, sel)
+			ps.AssembleCreateOrReplaceStreamAsSelect()
+
+			Convey("Then AssembleCreateOrReplaceStreamAsSelect transforms them into one item", func() {
+				So(ps.Len(), ShouldEqual, 1)
+
+				Convey("And that item is a CreateOrReplaceStreamAsSelectStmt", func() {
+					top := ps.Peek()
+					So(top, ShouldNotBeNil)
+					So(top.begin, ShouldEqual, 2)
+					So(top.end, ShouldEqual, 10)
+					So(top.comp, ShouldResemble, CreateOrReplaceStreamAsSelectStmt{"x", sel, SourceSinkSpecsAST{}})
+				})
+			})
+		})
+
+		Convey("When the stack contains a wrong item", func() {
+			ps.PushComponent(2, 4, StreamIdentifier("x"))
+			ps.AssembleSourceSinkSpecs(4, 4)
+			ps.PushComponent(4, 6, Istream) // must be SELECT in correct stmt
+
+			Convey("Then AssembleCreateOrReplaceStreamAsSelect panics", func() {
+				So(ps.AssembleCreateOrReplaceStreamAsSelect, ShouldPanic)
+			})
+		})
+	})
+
+	Convey("Given a parser", t, func() {
+		p := &bqlPeg{}
+
+		Convey("When doing a full SELECT", func() {
+			p.Buffer = "CREATE OR REPLACE STREAM x_2 AS SELECT ISTREAM a, b AS y FROM c [RANGE 3 TUPLES] WHERE a > 2"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, CreateOrReplaceStreamAsSelectStmt{})
+				cssComp := top.(CreateOrReplaceStreamAsSelectStmt)
+
+				So(cssComp.Name, ShouldEqual, "x_2")
+				comp := cssComp.Select
+				So(comp.EmitterType, ShouldEqual, Istream)
+				So(len(comp.Projections), ShouldEqual, 2)
+				So(comp.Projections[1], ShouldResemble, AliasAST{RowValue{"", "b"}, "y"})
+				So(len(comp.Relations), ShouldEqual, 1)
+				So(comp.Relations[0].Name, ShouldEqual, "c")
+				So(comp.Filter, ShouldNotBeNil)
+
+				Convey("And String() should return the original statement", func() {
+					So(cssComp.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When doing a SELECT with parameters", func() {
+			p.Buffer = `CREATE OR REPLACE STREAM x WITH on_error="dlq:d", max_retries=3 AS SELECT ISTREAM a FROM c [RANGE 3 TUPLES]`
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, CreateOrReplaceStreamAsSelectStmt{})
+				cssComp := top.(CreateOrReplaceStreamAsSelectStmt)
+
+				So(cssComp.Name, ShouldEqual, "x")
+				So(cssComp.Params, ShouldResemble, []SourceSinkParamAST{
+					{"on_error", data.String("dlq:d")},
+					{"max_retries", data.Int(3)},
+				})
+				So(cssComp.Select.Projections, ShouldResemble, []Expression{RowValue{"", "a"}})
+
+				Convey("And String() should return the original statement", func() {
+					So(cssComp.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When using a UNION ALL", func() {
+			p.Buffer = "CREATE OR REPLACE STREAM x AS SELECT ISTREAM a FROM c [RANGE 3 TUPLES] " +
+				"UNION ALL SELECT ISTREAM a FROM d [RANGE 3 TUPLES]"
+			p.Init()
+
+			Convey("Then parsing should fail", func() {
+				So(p.Parse(), ShouldNotBeNil)
+			})
+		})
+	})
+}
@@ -2,6 +2,7 @@ package parser
 
 import (
 	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
 	"testing"
 )
 
@@ -10,6 +11,7 @@ func TestAssembleCreateStreamAsSelect(t *testing.T) {
 		ps := parseStack{}
 		Convey("When the stack contains the correct CREATE STREAM items", func() {
 			ps.PushComponent(2, 4, StreamIdentifier("x"))
+			ps.AssembleSourceSinkSpecs(4, 4)
 			ps.PushComponent(4, 6, Istream)
 			ps.AssembleEmitterOptions(6, 6)
 			ps.AssembleEmitter()
@@ -18,18 +20,24 @@ func TestAssembleCreateStreamAsSelect(t *testing.T) {
 			ps.PushComponent(8, 9, Identifier("y"))
 			ps.AssembleAlias()
 			ps.AssembleProjections(6, 9)
-			ps.PushComponent(10, 11, Stream{ActualStream, "c", nil})
+			ps.PushComponent(10, 11, Stream{ActualStream, "c", nil, nil})
 			ps.PushComponent(11, 12, IntervalAST{FloatLiteral{3}, Tuples})
+			ps.EnsureSlideSpec(12, 12)
+			ps.AssembleRangeWindow()
+			ps.EnsureWatermarkSpec(12, 12)
 			ps.PushComponent(12, 13, NumericLiteral{2})
 			ps.EnsureCapacitySpec(12, 13)
 			ps.PushComponent(13, 14, DropOldest)
 			ps.EnsureSheddingSpec(13, 14)
 			ps.AssembleStreamWindow()
 			ps.EnsureAliasedStreamWindow()
-			ps.PushComponent(14, 15, Stream{ActualStream, "d", nil})
+			ps.PushComponent(14, 15, Stream{ActualStream, "d", nil, nil})
 			ps.PushComponent(16, 17, NumericLiteral{2})
 			ps.PushComponent(17, 18, Seconds)
 			ps.AssembleInterval()
+			ps.EnsureSlideSpec(18, 18)
+			ps.AssembleRangeWindow()
+			ps.EnsureWatermarkSpec(18, 18)
 			ps.EnsureCapacitySpec(18, 18)
 			ps.EnsureSheddingSpec(18, 18)
 			ps.AssembleStreamWindow()
@@ -44,6 +52,8 @@ func TestAssembleCreateStreamAsSelect(t *testing.T) {
 			ps.AssembleGrouping(21, 23)
 			ps.PushComponent(23, 24, RowValue{"", "h"})
 			ps.AssembleHaving(23, 24)
+			ps.AssembleOrdering(24, 24)
+			ps.AssembleLimit(24, 24)
 			ps.AssembleSelect()
 			ps.AssembleCreateStreamAsSelect()
 
@@ -155,5 +165,33 @@ func TestAssembleCreateStreamAsSelect(t *testing.T) {
 				})
 			})
 		})
+
+		Convey("When doing a SELECT with parameters", func() {
+			p.Buffer = `CREATE STREAM x WITH on_error="dlq:d", max_retries=3 AS SELECT ISTREAM a FROM c [RANGE 3 TUPLES]`
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, CreateStreamAsSelectStmt{})
+				cssComp := top.(CreateStreamAsSelectStmt)
+
+				So(cssComp.Name, ShouldEqual, "x")
+				So(cssComp.Params, ShouldResemble, []SourceSinkParamAST{
+					{"on_error", data.String("dlq:d")},
+					{"max_retries", data.Int(3)},
+				})
+				So(cssComp.Select.Projections, ShouldResemble, []Expression{RowValue{"", "a"}})
+
+				Convey("And String() should return the original statement", func() {
+					So(cssComp.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
 	})
 }
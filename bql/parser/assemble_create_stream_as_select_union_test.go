@@ -18,18 +18,24 @@ func TestAssembleCreateStreamAsSelectUnion(t *testing.T) {
 			ps.PushComponent(8, 9, Identifier("y"))
 			ps.AssembleAlias()
 			ps.AssembleProjections(6, 9)
-			ps.PushComponent(10, 11, Stream{ActualStream, "c", nil})
+			ps.PushComponent(10, 11, Stream{ActualStream, "c", nil, nil})
 			ps.PushComponent(11, 12, IntervalAST{FloatLiteral{3}, Tuples})
+			ps.EnsureSlideSpec(12, 12)
+			ps.AssembleRangeWindow()
+			ps.EnsureWatermarkSpec(12, 12)
 			ps.PushComponent(12, 13, NumericLiteral{2})
 			ps.EnsureCapacitySpec(12, 13)
 			ps.PushComponent(13, 14, DropOldest)
 			ps.EnsureSheddingSpec(13, 14)
 			ps.AssembleStreamWindow()
 			ps.EnsureAliasedStreamWindow()
-			ps.PushComponent(14, 15, Stream{ActualStream, "d", nil})
+			ps.PushComponent(14, 15, Stream{ActualStream, "d", nil, nil})
 			ps.PushComponent(16, 17, NumericLiteral{2})
 			ps.PushComponent(17, 18, Seconds)
 			ps.AssembleInterval()
+			ps.EnsureSlideSpec(18, 18)
+			ps.AssembleRangeWindow()
+			ps.EnsureWatermarkSpec(18, 18)
 			ps.EnsureCapacitySpec(18, 18)
 			ps.EnsureSheddingSpec(18, 18)
 			ps.AssembleStreamWindow()
@@ -44,6 +50,8 @@ func TestAssembleCreateStreamAsSelectUnion(t *testing.T) {
 			ps.AssembleGrouping(21, 23)
 			ps.PushComponent(23, 24, RowValue{"", "h"})
 			ps.AssembleHaving(23, 24)
+			ps.AssembleOrdering(24, 24)
+			ps.AssembleLimit(24, 24)
 			ps.AssembleSelect()
 			ps.AssembleSelectUnion(4, 24)
 			ps.AssembleCreateStreamAsSelectUnion()
@@ -0,0 +1,210 @@
+package parser
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestAssembleJoin(t *testing.T) {
+	Convey("Given a parseStack", t, func() {
+		ps := parseStack{}
+
+		Convey("When the stack contains all JOIN components", func() {
+			ps.PushComponent(0, 6, Raw{"PRE"})
+			ps.PushComponent(6, 10, InnerJoin)
+			ps.PushComponent(11, 13, AliasedStreamWindowAST{
+				StreamWindowAST{Stream{ActualStream, "b", nil, nil}, IntervalAST{FloatLiteral{2}, Seconds},
+					IntervalAST{}, IntervalAST{}, WatermarkAST{}, UnspecifiedCapacity, UnspecifiedSheddingOption}, "",
+			})
+			ps.PushComponent(17, 20, RowValue{"a", "k"})
+			ps.AssembleJoin()
+
+			Convey("Then AssembleJoin replaces them with two items", func() {
+				So(ps.Len(), ShouldEqual, 3)
+
+				Convey("And the top item is a JoinAST", func() {
+					top := ps.Peek()
+					So(top, ShouldNotBeNil)
+					So(top.begin, ShouldEqual, 17)
+					So(top.end, ShouldEqual, 20)
+					So(top.comp, ShouldResemble, JoinAST{InnerJoin, "", RowValue{"a", "k"}})
+				})
+
+				Convey("And the item below is the joined relation", func() {
+					ps.Pop()
+					top := ps.Peek()
+					So(top, ShouldNotBeNil)
+					So(top.begin, ShouldEqual, 11)
+					So(top.end, ShouldEqual, 13)
+					So(top.comp, ShouldHaveSameTypeAs, AliasedStreamWindowAST{})
+					So(top.comp.(AliasedStreamWindowAST).Name, ShouldEqual, "b")
+				})
+			})
+		})
+
+		Convey("When the stack contains the components of a lookup join", func() {
+			ps.PushComponent(0, 6, Raw{"PRE"})
+			ps.PushComponent(6, 10, LeftOuterJoin)
+			ps.PushComponent(11, 13, StreamIdentifier("t"))
+			ps.PushComponent(17, 20, RowValue{"t", "k"})
+			ps.AssembleJoin()
+
+			Convey("Then AssembleJoin replaces them with a new item", func() {
+				So(ps.Len(), ShouldEqual, 2)
+
+				Convey("And that item is a JoinAST with a table", func() {
+					top := ps.Peek()
+					So(top, ShouldNotBeNil)
+					So(top.begin, ShouldEqual, 11)
+					So(top.end, ShouldEqual, 20)
+					So(top.comp, ShouldResemble, JoinAST{LeftOuterJoin, "t", RowValue{"t", "k"}})
+				})
+			})
+		})
+
+		Convey("When the stack contains a wrong item", func() {
+			ps.PushComponent(0, 6, Raw{"PRE"})
+			ps.PushComponent(6, 10, InnerJoin)
+			ps.PushComponent(11, 13, Raw{"b"})
+			ps.PushComponent(17, 20, RowValue{"a", "k"})
+
+			Convey("Then AssembleJoin panics", func() {
+				So(ps.AssembleJoin, ShouldPanic)
+			})
+		})
+
+		Convey("When the stack is empty", func() {
+			Convey("Then AssembleJoin panics", func() {
+				So(ps.AssembleJoin, ShouldPanic)
+			})
+		})
+	})
+
+	Convey("Given a parser", t, func() {
+		p := &bqlPeg{}
+
+		Convey("When selecting with an INNER JOIN", func() {
+			p.Buffer = "SELECT ISTREAM a:x, b:y FROM a [RANGE 2 SECONDS] INNER JOIN b [RANGE 3 TUPLES] ON a:k = b:k"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, SelectStmt{})
+				comp := top.(SelectStmt)
+				So(len(comp.Relations), ShouldEqual, 2)
+				So(comp.Relations[0].Name, ShouldEqual, "a")
+				So(comp.Relations[1].Name, ShouldEqual, "b")
+				So(comp.Relations[1].Unit, ShouldEqual, Tuples)
+				So(comp.Join, ShouldResemble, JoinAST{InnerJoin, "",
+					BinaryOpAST{Equal, RowValue{"a", "k"}, RowValue{"b", "k"}}})
+
+				Convey("And String() should return the original statement", func() {
+					So(comp.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When selecting with a JOIN", func() {
+			p.Buffer = "SELECT ISTREAM a:x FROM a [RANGE 2 SECONDS] JOIN b [RANGE 3 TUPLES] ON a:k = b:k AND a:v < b:v"
+			p.Init()
+
+			Convey("Then the statement should be parsed as an INNER JOIN", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, SelectStmt{})
+				comp := top.(SelectStmt)
+				So(len(comp.Relations), ShouldEqual, 2)
+				So(comp.Join.Type, ShouldEqual, InnerJoin)
+				So(comp.Join.On, ShouldResemble, BinaryOpAST{And,
+					BinaryOpAST{Equal, RowValue{"a", "k"}, RowValue{"b", "k"}},
+					BinaryOpAST{Less, RowValue{"a", "v"}, RowValue{"b", "v"}}})
+			})
+		})
+
+		Convey("When selecting with a LEFT OUTER JOIN", func() {
+			p.Buffer = "SELECT RSTREAM a:x, b:y FROM a [RANGE 2 SECONDS] LEFT OUTER JOIN b [RANGE 3 TUPLES] AS c ON a:k = c:k"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, SelectStmt{})
+				comp := top.(SelectStmt)
+				So(len(comp.Relations), ShouldEqual, 2)
+				So(comp.Relations[1].Alias, ShouldEqual, "c")
+				So(comp.Join.Type, ShouldEqual, LeftOuterJoin)
+
+				Convey("And String() should return the original statement", func() {
+					So(comp.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When selecting with a LEFT JOIN", func() {
+			p.Buffer = "SELECT RSTREAM a:x FROM a [RANGE 2 SECONDS] LEFT JOIN b [RANGE 3 TUPLES] ON a:k = b:k"
+			p.Init()
+
+			Convey("Then the statement should be parsed as a LEFT OUTER JOIN", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, SelectStmt{})
+				So(top.(SelectStmt).Join.Type, ShouldEqual, LeftOuterJoin)
+			})
+		})
+
+		Convey("When selecting with a JOIN on a table", func() {
+			p.Buffer = "SELECT ISTREAM a:x, t:y FROM a [RANGE 1 TUPLES] INNER JOIN t ON a:k = t:id"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, SelectStmt{})
+				comp := top.(SelectStmt)
+				So(len(comp.Relations), ShouldEqual, 1)
+				So(comp.Relations[0].Name, ShouldEqual, "a")
+				So(comp.Join, ShouldResemble, JoinAST{InnerJoin, "t",
+					BinaryOpAST{Equal, RowValue{"a", "k"}, RowValue{"t", "id"}}})
+
+				Convey("And String() should return the original statement", func() {
+					So(comp.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When selecting with a JOIN without ON clause", func() {
+			p.Buffer = "SELECT RSTREAM a:x FROM a [RANGE 2 SECONDS] JOIN b [RANGE 3 TUPLES]"
+			p.Init()
+
+			Convey("Then parsing the statement should fail", func() {
+				So(p.Parse(), ShouldNotBeNil)
+			})
+		})
+	})
+}
@@ -0,0 +1,76 @@
+package parser
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestAssembleLambda(t *testing.T) {
+	Convey("Given a parseStack", t, func() {
+		ps := parseStack{}
+
+		Convey("When the parameters of a lambda expression are assembled", func() {
+			ps.PushComponent(0, 2, Raw{"PRE"})
+			ps.PushComponent(3, 4, Identifier("x"))
+			ps.PushComponent(5, 6, Identifier("y"))
+			ps.AssembleLambdaParams(2, 7)
+
+			Convey("Then references to the parameters become lambda variables", func() {
+				ps.AssembleRowValue(11, 12, "x")
+				ps.AssembleRowValue(13, 16, "y.a")
+				ps.AssembleRowValue(17, 21, "y[0]")
+				ps.AssembleRowValue(22, 24, "xy")
+				ps.AssembleRowValue(25, 28, "s:x")
+				So(ps.Len(), ShouldEqual, 7)
+				So(ps.Pop().comp, ShouldResemble, RowValue{"s", "x"})
+				So(ps.Pop().comp, ShouldResemble, RowValue{"", "xy"})
+				So(ps.Pop().comp, ShouldResemble, LambdaVariable{"y", "[0]"})
+				So(ps.Pop().comp, ShouldResemble, LambdaVariable{"y", ".a"})
+				So(ps.Pop().comp, ShouldResemble, LambdaVariable{"x", ""})
+			})
+
+			Convey("And the lambda expression is assembled", func() {
+				ps.AssembleRowValue(11, 12, "x")
+				ps.AssembleLambda()
+
+				Convey("Then AssembleLambda replaces them with a LambdaAST", func() {
+					So(ps.Len(), ShouldEqual, 2)
+					top := ps.Peek()
+					So(top, ShouldNotBeNil)
+					So(top.begin, ShouldEqual, 2)
+					So(top.end, ShouldEqual, 12)
+					So(top.comp, ShouldResemble, LambdaAST{[]string{"x", "y"},
+						LambdaVariable{"x", ""}})
+				})
+
+				Convey("Then references to the parameters are row values again", func() {
+					ps.AssembleRowValue(13, 14, "x")
+					So(ps.Peek().comp, ShouldResemble, RowValue{"", "x"})
+				})
+			})
+		})
+
+		Convey("When the stack contains only the body", func() {
+			ps.PushComponent(2, 3, RowValue{"", "a"})
+			f := func() {
+				ps.AssembleLambda()
+			}
+
+			Convey("Then AssembleLambda panics", func() {
+				So(f, ShouldPanic)
+			})
+		})
+
+		Convey("When the stack contains wrong items", func() {
+			ps.PushComponent(2, 3, RowValue{"", "a"})
+			ps.PushComponent(3, 4, RowValue{"", "b"})
+			f := func() {
+				ps.AssembleLambda()
+			}
+
+			Convey("Then AssembleLambda panics", func() {
+				So(f, ShouldPanic)
+			})
+		})
+	})
+}
@@ -0,0 +1,91 @@
+package parser
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestAssembleLimit(t *testing.T) {
+	Convey("Given a parseStack", t, func() {
+		ps := parseStack{}
+
+		Convey("When the stack contains a limit in the given range", func() {
+			ps.PushComponent(0, 6, Raw{"PRE"})
+			ps.PushComponent(6, 7, NumericLiteral{5})
+			ps.AssembleLimit(6, 7)
+
+			Convey("Then AssembleLimit replaces it with a LimitAST", func() {
+				So(ps.Len(), ShouldEqual, 2)
+				top := ps.Peek()
+				So(top, ShouldNotBeNil)
+				So(top.begin, ShouldEqual, 6)
+				So(top.end, ShouldEqual, 7)
+				So(top.comp, ShouldResemble, LimitAST{true, 5, 0})
+			})
+		})
+
+		Convey("When the stack contains a limit and an offset in the given range", func() {
+			ps.PushComponent(0, 6, Raw{"PRE"})
+			ps.PushComponent(6, 7, NumericLiteral{5})
+			ps.PushComponent(7, 8, NumericLiteral{2})
+			ps.AssembleLimit(6, 8)
+
+			Convey("Then AssembleLimit replaces them with a LimitAST", func() {
+				So(ps.Len(), ShouldEqual, 2)
+				top := ps.Peek()
+				So(top, ShouldNotBeNil)
+				So(top.begin, ShouldEqual, 6)
+				So(top.end, ShouldEqual, 8)
+				So(top.comp, ShouldResemble, LimitAST{true, 5, 2})
+			})
+		})
+
+		Convey("When the given range is empty", func() {
+			ps.PushComponent(0, 6, Raw{"PRE"})
+			ps.AssembleLimit(6, 6)
+
+			Convey("Then AssembleLimit pushes an empty LimitAST", func() {
+				So(ps.Len(), ShouldEqual, 2)
+				top := ps.Peek()
+				So(top, ShouldNotBeNil)
+				So(top.comp, ShouldResemble, LimitAST{})
+			})
+		})
+	})
+
+	Convey("Given a parser", t, func() {
+		p := &bqlPeg{}
+
+		for _, stmt := range []string{
+			"SELECT RSTREAM a FROM c [RANGE 1 TUPLES]",
+			"SELECT RSTREAM a FROM c [RANGE 1 TUPLES] LIMIT 5",
+			"SELECT RSTREAM a FROM c [RANGE 1 TUPLES] ORDER BY a DESC LIMIT 5 OFFSET 10",
+		} {
+			stmt := stmt
+			Convey("When parsing "+stmt, func() {
+				p.Buffer = stmt
+				p.Init()
+
+				Convey("Then String() should return the original statement", func() {
+					So(p.Parse(), ShouldBeNil)
+					p.Execute()
+					So(p.parseStack.Len(), ShouldEqual, 1)
+					s := p.parseStack.Peek().comp.(SelectStmt)
+					So(s.String(), ShouldEqual, stmt)
+				})
+			})
+		}
+
+		Convey("When selecting with a LIMIT and an OFFSET", func() {
+			p.Buffer = "SELECT RSTREAM a FROM c [RANGE 1 TUPLES] LIMIT 5 OFFSET 10"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				So(p.Parse(), ShouldBeNil)
+				p.Execute()
+				s := p.parseStack.Peek().comp.(SelectStmt)
+				So(s.LimitAST, ShouldResemble, LimitAST{true, 5, 10})
+			})
+		})
+	})
+}
@@ -0,0 +1,105 @@
+package parser
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestAssembleOrdering(t *testing.T) {
+	Convey("Given a parseStack", t, func() {
+		ps := parseStack{}
+
+		Convey("When the stack contains two items in the given range", func() {
+			ps.PushComponent(0, 6, Raw{"PRE"})
+			ps.PushComponent(6, 7, SortedExpressionAST{RowValue{"", "a"}, UnspecifiedKeyword})
+			ps.PushComponent(7, 8, SortedExpressionAST{RowValue{"", "b"}, No})
+			ps.AssembleOrdering(6, 8)
+
+			Convey("Then AssembleOrdering replaces them with a new item", func() {
+				So(ps.Len(), ShouldEqual, 2)
+
+				Convey("And that item is an OrderingAST", func() {
+					top := ps.Peek()
+					So(top, ShouldNotBeNil)
+					So(top.begin, ShouldEqual, 6)
+					So(top.end, ShouldEqual, 8)
+					So(top.comp, ShouldHaveSameTypeAs, OrderingAST{})
+
+					Convey("And it contains the previous data", func() {
+						comp := top.comp.(OrderingAST)
+						So(len(comp.Ordering), ShouldEqual, 2)
+						So(comp.Ordering[0], ShouldResemble, SortedExpressionAST{RowValue{"", "a"}, UnspecifiedKeyword})
+						So(comp.Ordering[1], ShouldResemble, SortedExpressionAST{RowValue{"", "b"}, No})
+					})
+				})
+			})
+		})
+
+		Convey("When the given range is empty", func() {
+			ps.PushComponent(0, 6, Raw{"PRE"})
+			ps.AssembleOrdering(6, 6)
+
+			Convey("Then AssembleOrdering pushes one item onto the stack", func() {
+				So(ps.Len(), ShouldEqual, 2)
+
+				Convey("And that item is an empty OrderingAST", func() {
+					top := ps.Peek()
+					So(top, ShouldNotBeNil)
+					So(top.begin, ShouldEqual, 6)
+					So(top.end, ShouldEqual, 6)
+					So(top.comp, ShouldResemble, OrderingAST{})
+				})
+			})
+		})
+	})
+
+	Convey("Given a parser", t, func() {
+		p := &bqlPeg{}
+
+		Convey("When selecting without an ORDER BY", func() {
+			p.Buffer = "SELECT ISTREAM a, b FROM c [RANGE 1 TUPLES]"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, SelectStmt{})
+				s := top.(SelectStmt)
+				So(s.Ordering, ShouldBeNil)
+
+				Convey("And String() should return the original statement", func() {
+					So(s.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When selecting with an ORDER BY", func() {
+			p.Buffer = "SELECT RSTREAM a, avg(b) AS t FROM c [RANGE 1 MINUTES] GROUP BY a ORDER BY t DESC, a"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, SelectStmt{})
+				s := top.(SelectStmt)
+				So(len(s.Ordering), ShouldEqual, 2)
+				So(s.Ordering[0], ShouldResemble, SortedExpressionAST{RowValue{"", "t"}, No})
+				So(s.Ordering[1], ShouldResemble, SortedExpressionAST{RowValue{"", "a"}, UnspecifiedKeyword})
+
+				Convey("And String() should return the original statement", func() {
+					So(s.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+	})
+}
@@ -15,18 +15,24 @@ func TestAssembleSelect(t *testing.T) {
 			ps.PushComponent(6, 7, RowValue{"", "a"})
 			ps.PushComponent(7, 8, RowValue{"", "b"})
 			ps.AssembleProjections(6, 8)
-			ps.PushComponent(10, 11, Stream{ActualStream, "c", nil})
+			ps.PushComponent(10, 11, Stream{ActualStream, "c", nil, nil})
 			ps.PushComponent(11, 12, IntervalAST{FloatLiteral{3}, Tuples})
+			ps.EnsureSlideSpec(12, 12)
+			ps.AssembleRangeWindow()
+			ps.EnsureWatermarkSpec(12, 12)
 			ps.PushComponent(12, 13, NumericLiteral{2})
 			ps.EnsureCapacitySpec(12, 13)
 			ps.PushComponent(13, 14, DropOldest)
 			ps.EnsureSheddingSpec(13, 14)
 			ps.AssembleStreamWindow()
 			ps.EnsureAliasedStreamWindow()
-			ps.PushComponent(14, 15, Stream{ActualStream, "d", nil})
+			ps.PushComponent(14, 15, Stream{ActualStream, "d", nil, nil})
 			ps.PushComponent(16, 17, NumericLiteral{2})
 			ps.PushComponent(17, 18, Seconds)
 			ps.AssembleInterval()
+			ps.EnsureSlideSpec(18, 18)
+			ps.AssembleRangeWindow()
+			ps.EnsureWatermarkSpec(18, 18)
 			ps.EnsureCapacitySpec(18, 18)
 			ps.EnsureSheddingSpec(18, 18)
 			ps.AssembleStreamWindow()
@@ -41,6 +47,8 @@ func TestAssembleSelect(t *testing.T) {
 			ps.AssembleGrouping(24, 28)
 			ps.PushComponent(28, 30, RowValue{"", "h"})
 			ps.AssembleHaving(28, 30)
+			ps.AssembleOrdering(30, 30)
+			ps.AssembleLimit(30, 30)
 			ps.AssembleSelect()
 
 			Convey("Then AssembleSelect transforms them into one item", func() {
@@ -98,18 +106,24 @@ func TestAssembleSelect(t *testing.T) {
 			ps.PushComponent(6, 7, RowValue{"", "a"})
 			ps.PushComponent(7, 8, RowValue{"", "b"})
 			ps.AssembleProjections(6, 8)
-			ps.PushComponent(10, 11, Stream{ActualStream, "c", nil})
+			ps.PushComponent(10, 11, Stream{ActualStream, "c", nil, nil})
 			ps.PushComponent(11, 12, IntervalAST{FloatLiteral{3}, Tuples})
+			ps.EnsureSlideSpec(12, 12)
+			ps.AssembleRangeWindow()
+			ps.EnsureWatermarkSpec(12, 12)
 			ps.PushComponent(12, 13, NumericLiteral{2})
 			ps.EnsureCapacitySpec(12, 13)
 			ps.PushComponent(13, 14, DropOldest)
 			ps.EnsureSheddingSpec(13, 14)
 			ps.AssembleStreamWindow()
 			ps.EnsureAliasedStreamWindow()
-			ps.PushComponent(14, 15, Stream{ActualStream, "d", nil})
+			ps.PushComponent(14, 15, Stream{ActualStream, "d", nil, nil})
 			ps.PushComponent(16, 17, NumericLiteral{2})
 			ps.PushComponent(17, 18, Seconds)
 			ps.AssembleInterval()
+			ps.EnsureSlideSpec(18, 18)
+			ps.AssembleRangeWindow()
+			ps.EnsureWatermarkSpec(18, 18)
 			ps.EnsureCapacitySpec(18, 18)
 			ps.EnsureSheddingSpec(18, 18)
 			ps.AssembleStreamWindow()
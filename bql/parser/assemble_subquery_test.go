@@ -0,0 +1,181 @@
+package parser
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestAssembleSubqueryStream(t *testing.T) {
+	Convey("Given a parseStack", t, func() {
+		ps := parseStack{}
+
+		Convey("When the stack contains a SelectStmt", func() {
+			sel := SelectStmt{EmitterAST: EmitterAST{EmitterType: Istream}}
+			ps.PushComponent(0, 6, Raw{"PRE"})
+			ps.PushComponent(7, 20, sel)
+			ps.AssembleSubqueryStream(6, 21)
+
+			Convey("Then AssembleSubqueryStream replaces it with a new item", func() {
+				So(ps.Len(), ShouldEqual, 2)
+
+				Convey("And that item is a Stream", func() {
+					top := ps.Peek()
+					So(top, ShouldNotBeNil)
+					So(top.begin, ShouldEqual, 6)
+					So(top.end, ShouldEqual, 21)
+					So(top.comp, ShouldResemble, Stream{SubqueryStream, "", nil, &sel})
+				})
+			})
+		})
+
+		Convey("When the stack contains a wrong item", func() {
+			ps.PushComponent(0, 6, Raw{"PRE"})
+
+			Convey("Then AssembleSubqueryStream panics", func() {
+				So(func() { ps.AssembleSubqueryStream(0, 6) }, ShouldPanic)
+			})
+		})
+	})
+
+	Convey("Given a parser", t, func() {
+		p := &bqlPeg{}
+
+		Convey("When parsing a SELECT statement with a subquery", func() {
+			p.Buffer = "SELECT ISTREAM s:x FROM (SELECT RSTREAM a AS x FROM b [RANGE 1 TUPLES]) [RANGE 2 TUPLES] AS s"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, SelectStmt{})
+				s := top.(SelectStmt)
+				So(len(s.Relations), ShouldEqual, 1)
+				rel := s.Relations[0]
+				So(rel.Type, ShouldEqual, SubqueryStream)
+				So(rel.Name, ShouldEqual, "s")
+				So(rel.Alias, ShouldEqual, "s")
+				So(rel.IntervalAST, ShouldResemble, IntervalAST{FloatLiteral{2}, Tuples})
+				So(rel.Subquery, ShouldNotBeNil)
+				So(rel.Subquery.EmitterType, ShouldEqual, Rstream)
+				So(len(rel.Subquery.Relations), ShouldEqual, 1)
+				So(rel.Subquery.Relations[0].Stream, ShouldResemble, NewStream("b"))
+
+				Convey("And String() should return the original statement", func() {
+					So(s.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When parsing a subquery without an alias", func() {
+			p.Buffer = "SELECT ISTREAM x FROM (SELECT RSTREAM a AS x FROM b [RANGE 1 TUPLES]) [RANGE 2 TUPLES]"
+			p.Init()
+
+			Convey("Then parsing should fail", func() {
+				So(p.Parse(), ShouldNotBeNil)
+			})
+		})
+
+		Convey("When parsing a SELECT statement with common table expressions", func() {
+			p.Buffer = "WITH x AS (SELECT RSTREAM a FROM s [RANGE 1 TUPLES]), " +
+				"y AS (SELECT RSTREAM a FROM x [RANGE 2 TUPLES]) " +
+				"SELECT ISTREAM y:a, s:a FROM y [RANGE 3 TUPLES], s [RANGE 4 TUPLES]"
+			p.Init()
+
+			Convey("Then the references should be replaced by subqueries", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, SelectStmt{})
+				s := top.(SelectStmt)
+				So(len(s.Relations), ShouldEqual, 2)
+
+				y := s.Relations[0]
+				So(y.Type, ShouldEqual, SubqueryStream)
+				So(y.Name, ShouldEqual, "y")
+				So(y.Alias, ShouldEqual, "")
+				So(y.IntervalAST, ShouldResemble, IntervalAST{FloatLiteral{3}, Tuples})
+
+				x := y.Subquery.Relations[0]
+				So(x.Type, ShouldEqual, SubqueryStream)
+				So(x.Name, ShouldEqual, "x")
+				So(x.IntervalAST, ShouldResemble, IntervalAST{FloatLiteral{2}, Tuples})
+				So(x.Subquery.Relations[0].Stream, ShouldResemble, NewStream("s"))
+
+				So(s.Relations[1].Stream, ShouldResemble, NewStream("s"))
+
+				Convey("And String() should return the statement with inlined subqueries", func() {
+					So(s.String(), ShouldEqual, "SELECT ISTREAM y:a, s:a FROM "+
+						"(SELECT RSTREAM a FROM (SELECT RSTREAM a FROM s [RANGE 1 TUPLES]) [RANGE 2 TUPLES]) [RANGE 3 TUPLES], "+
+						"s [RANGE 4 TUPLES]")
+				})
+			})
+		})
+
+		Convey("When parsing nested common table expressions having the same name", func() {
+			p.Buffer = "WITH s AS (SELECT RSTREAM a FROM s [RANGE 1 TUPLES]) " +
+				"SELECT ISTREAM a FROM (WITH s AS (SELECT RSTREAM a FROM s [RANGE 2 TUPLES]) " +
+				"SELECT RSTREAM a FROM s [RANGE 3 TUPLES]) [RANGE 4 TUPLES] AS t"
+			p.Init()
+
+			Convey("Then the inner definition should shadow the outer one", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				s := ps.Peek().comp.(SelectStmt)
+				t := s.Relations[0]
+				So(t.Type, ShouldEqual, SubqueryStream)
+				So(t.Name, ShouldEqual, "t")
+
+				inner := t.Subquery.Relations[0]
+				So(inner.Type, ShouldEqual, SubqueryStream)
+				So(inner.Name, ShouldEqual, "s")
+				So(inner.IntervalAST, ShouldResemble, IntervalAST{FloatLiteral{3}, Tuples})
+
+				Convey("And the inner definition should refer to the outer one", func() {
+					outer := inner.Subquery.Relations[0]
+					So(outer.Type, ShouldEqual, SubqueryStream)
+					So(outer.Name, ShouldEqual, "s")
+					So(outer.IntervalAST, ShouldResemble, IntervalAST{FloatLiteral{2}, Tuples})
+					So(outer.Subquery.Relations[0].IntervalAST, ShouldResemble, IntervalAST{FloatLiteral{1}, Tuples})
+					So(outer.Subquery.Relations[0].Stream, ShouldResemble, NewStream("s"))
+				})
+			})
+		})
+
+		Convey("When parsing a CREATE STREAM statement with a common table expression", func() {
+			p.Buffer = "CREATE STREAM out AS WITH x AS (SELECT RSTREAM a FROM s [RANGE 1 TUPLES]) " +
+				"SELECT ISTREAM a FROM x [RANGE 2 TUPLES] AS y"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, CreateStreamAsSelectStmt{})
+				s := top.(CreateStreamAsSelectStmt)
+				So(s.Name, ShouldEqual, "out")
+				rel := s.Select.Relations[0]
+				So(rel.Type, ShouldEqual, SubqueryStream)
+				So(rel.Name, ShouldEqual, "x")
+				So(rel.Alias, ShouldEqual, "y")
+				So(rel.Subquery.Relations[0].Stream, ShouldResemble, NewStream("s"))
+			})
+		})
+	})
+}
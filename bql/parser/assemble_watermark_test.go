@@ -0,0 +1,146 @@
+package parser
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestAssembleWatermark(t *testing.T) {
+	Convey("Given a parseStack", t, func() {
+		ps := parseStack{}
+
+		Convey("When the stack contains all WATERMARK components", func() {
+			ps.PushComponent(0, 6, Raw{"PRE"})
+			ps.PushComponent(6, 8, IntervalAST{FloatLiteral{5}, Seconds})
+			ps.PushComponent(8, 10, IntervalAST{FloatLiteral{1}, Minutes})
+			ps.EnsureAllowedLatenessSpec(8, 10)
+			ps.PushComponent(10, 12, StreamIdentifier("late"))
+			ps.EnsureLateTuplesSpec(10, 12)
+			ps.AssembleWatermark()
+
+			Convey("Then AssembleWatermark replaces them with a new item", func() {
+				So(ps.Len(), ShouldEqual, 2)
+
+				Convey("And that item is a WatermarkAST", func() {
+					top := ps.Peek()
+					So(top, ShouldNotBeNil)
+					So(top.begin, ShouldEqual, 6)
+					So(top.end, ShouldEqual, 12)
+					So(top.comp, ShouldResemble, WatermarkAST{
+						IntervalAST{FloatLiteral{5}, Seconds},
+						IntervalAST{FloatLiteral{1}, Minutes},
+						StreamIdentifier("late"),
+					})
+				})
+			})
+		})
+
+		Convey("When the stack contains only the DELAY component", func() {
+			ps.PushComponent(0, 6, Raw{"PRE"})
+			ps.PushComponent(6, 8, IntervalAST{FloatLiteral{5}, Seconds})
+			ps.EnsureAllowedLatenessSpec(8, 8)
+			ps.EnsureLateTuplesSpec(8, 8)
+			ps.AssembleWatermark()
+
+			Convey("Then AssembleWatermark replaces them with a new item", func() {
+				So(ps.Len(), ShouldEqual, 2)
+
+				Convey("And that item is a WatermarkAST", func() {
+					top := ps.Peek()
+					So(top, ShouldNotBeNil)
+					So(top.begin, ShouldEqual, 6)
+					So(top.end, ShouldEqual, 8)
+					So(top.comp, ShouldResemble, WatermarkAST{
+						IntervalAST{FloatLiteral{5}, Seconds},
+						IntervalAST{FloatLiteral{0}, UnspecifiedIntervalUnit},
+						StreamIdentifier(""),
+					})
+				})
+			})
+		})
+
+		Convey("When the stack contains a wrong item", func() {
+			ps.PushComponent(0, 6, Raw{"PRE"})
+
+			Convey("Then AssembleWatermark panics", func() {
+				So(ps.AssembleWatermark, ShouldPanic)
+			})
+		})
+
+		Convey("When the stack is empty", func() {
+			Convey("Then AssembleWatermark panics", func() {
+				So(ps.AssembleWatermark, ShouldPanic)
+			})
+		})
+	})
+
+	Convey("Given a parser", t, func() {
+		p := &bqlPeg{}
+
+		Convey("When selecting with a WATERMARK DELAY", func() {
+			p.Buffer = "CREATE STREAM x AS SELECT ISTREAM a FROM c [RANGE 10 SECONDS, WATERMARK DELAY 5 SECONDS, BUFFER SIZE 3]"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, CreateStreamAsSelectStmt{})
+				comp := top.(CreateStreamAsSelectStmt).Select
+				w := comp.Relations[0].Watermark
+				So(w.Delay, ShouldResemble, IntervalAST{FloatLiteral{5}, Seconds})
+				So(w.AllowedLateness.Unit, ShouldEqual, UnspecifiedIntervalUnit)
+				So(w.LateStream, ShouldEqual, "")
+				So(comp.Relations[0].Capacity, ShouldEqual, 3)
+
+				Convey("And String() should return the original statement", func() {
+					stmt := top.(CreateStreamAsSelectStmt)
+					So(stmt.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When selecting with a full WATERMARK specification", func() {
+			p.Buffer = "CREATE STREAM x AS SELECT RSTREAM a FROM c [RANGE 1 MINUTES SLIDE 10 SECONDS, " +
+				"WATERMARK DELAY 5 SECONDS, ALLOWED LATENESS 30 SECONDS, LATE TUPLES TO d, DROP OLDEST IF FULL] AS e"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, CreateStreamAsSelectStmt{})
+				comp := top.(CreateStreamAsSelectStmt).Select
+				w := comp.Relations[0].Watermark
+				So(w.Delay, ShouldResemble, IntervalAST{FloatLiteral{5}, Seconds})
+				So(w.AllowedLateness, ShouldResemble, IntervalAST{FloatLiteral{30}, Seconds})
+				So(w.LateStream, ShouldEqual, "d")
+				So(comp.Relations[0].Shedding, ShouldEqual, DropOldest)
+				So(comp.Relations[0].Alias, ShouldEqual, "e")
+
+				Convey("And String() should return the original statement", func() {
+					stmt := top.(CreateStreamAsSelectStmt)
+					So(stmt.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When selecting with a tuple-based WATERMARK DELAY", func() {
+			p.Buffer = "CREATE STREAM x AS SELECT ISTREAM a FROM c [RANGE 10 SECONDS, WATERMARK DELAY 5 TUPLES]"
+			p.Init()
+
+			Convey("Then parsing the statement should fail", func() {
+				err := p.Parse()
+				So(err, ShouldNotEqual, nil)
+			})
+		})
+	})
+}
@@ -12,12 +12,12 @@ func TestAssembleWindowedFrom(t *testing.T) {
 		Convey("When the stack contains only AliasedStreamWindows in the given range", func() {
 			ps.PushComponent(0, 6, Raw{"PRE"})
 			ps.PushComponent(6, 8, AliasedStreamWindowAST{
-				StreamWindowAST{Stream{ActualStream, "a", nil}, IntervalAST{FloatLiteral{3}, Tuples},
-					2, UnspecifiedSheddingOption}, "",
+				StreamWindowAST{Stream{ActualStream, "a", nil, nil}, IntervalAST{FloatLiteral{3}, Tuples},
+					IntervalAST{}, IntervalAST{}, WatermarkAST{}, 2, UnspecifiedSheddingOption}, "",
 			})
 			ps.PushComponent(8, 10, AliasedStreamWindowAST{
-				StreamWindowAST{Stream{ActualStream, "b", nil}, IntervalAST{FloatLiteral{2}, Seconds},
-					UnspecifiedCapacity, Wait}, "",
+				StreamWindowAST{Stream{ActualStream, "b", nil, nil}, IntervalAST{FloatLiteral{2}, Seconds},
+					IntervalAST{}, IntervalAST{}, WatermarkAST{}, UnspecifiedCapacity, Wait}, "",
 			})
 			ps.AssembleWindowedFrom(6, 10)
 
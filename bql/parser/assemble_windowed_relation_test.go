@@ -11,8 +11,11 @@ func TestAssembleStreamWindow(t *testing.T) {
 
 		Convey("When the stack contains two correct items", func() {
 			ps.PushComponent(0, 6, Raw{"PRE"})
-			ps.PushComponent(6, 8, Stream{ActualStream, "a", nil})
+			ps.PushComponent(6, 8, Stream{ActualStream, "a", nil, nil})
 			ps.PushComponent(8, 10, IntervalAST{FloatLiteral{2}, Seconds})
+			ps.EnsureSlideSpec(10, 10)
+			ps.AssembleRangeWindow()
+			ps.EnsureWatermarkSpec(10, 10)
 			ps.PushComponent(10, 12, NumericLiteral{2})
 			ps.EnsureCapacitySpec(10, 12)
 			ps.PushComponent(12, 14, DropOldest)
@@ -34,6 +37,7 @@ func TestAssembleStreamWindow(t *testing.T) {
 						So(comp.Name, ShouldEqual, "a")
 						So(comp.Value, ShouldEqual, 2)
 						So(comp.Unit, ShouldEqual, Seconds)
+						So(comp.Slide.Unit, ShouldEqual, UnspecifiedIntervalUnit)
 						So(comp.Capacity, ShouldEqual, 2)
 						So(comp.Shedding, ShouldEqual, DropOldest)
 					})
@@ -41,10 +45,82 @@ func TestAssembleStreamWindow(t *testing.T) {
 			})
 		})
 
+		Convey("When the stack contains a SLIDE specification", func() {
+			ps.PushComponent(0, 6, Raw{"PRE"})
+			ps.PushComponent(6, 8, Stream{ActualStream, "a", nil, nil})
+			ps.PushComponent(8, 10, IntervalAST{FloatLiteral{1}, Minutes})
+			ps.PushComponent(10, 12, IntervalAST{FloatLiteral{10}, Seconds})
+			ps.EnsureSlideSpec(10, 12)
+			ps.AssembleRangeWindow()
+			ps.EnsureWatermarkSpec(12, 12)
+			ps.EnsureCapacitySpec(12, 12)
+			ps.EnsureSheddingSpec(12, 12)
+			ps.AssembleStreamWindow()
+
+			Convey("Then AssembleStreamWindow transforms them into one item", func() {
+				So(ps.Len(), ShouldEqual, 2)
+
+				Convey("And that item is a StreamWindowAST", func() {
+					top := ps.Peek()
+					So(top, ShouldNotBeNil)
+					So(top.begin, ShouldEqual, 6)
+					So(top.end, ShouldEqual, 12)
+					So(top.comp, ShouldHaveSameTypeAs, StreamWindowAST{})
+
+					Convey("And it contains the previously pushed data", func() {
+						comp := top.comp.(StreamWindowAST)
+						So(comp.Name, ShouldEqual, "a")
+						So(comp.Value, ShouldEqual, 1)
+						So(comp.Unit, ShouldEqual, Minutes)
+						So(comp.Slide.Value, ShouldEqual, 10)
+						So(comp.Slide.Unit, ShouldEqual, Seconds)
+						So(comp.Capacity, ShouldEqual, UnspecifiedCapacity)
+						So(comp.Shedding, ShouldEqual, UnspecifiedSheddingOption)
+					})
+				})
+			})
+		})
+
+		Convey("When the stack contains a SESSION specification", func() {
+			ps.PushComponent(0, 6, Raw{"PRE"})
+			ps.PushComponent(6, 8, Stream{ActualStream, "a", nil, nil})
+			ps.PushComponent(8, 12, IntervalAST{FloatLiteral{30}, Seconds})
+			ps.AssembleSessionWindow()
+			ps.EnsureWatermarkSpec(12, 12)
+			ps.EnsureCapacitySpec(12, 12)
+			ps.EnsureSheddingSpec(12, 12)
+			ps.AssembleStreamWindow()
+
+			Convey("Then AssembleStreamWindow transforms them into one item", func() {
+				So(ps.Len(), ShouldEqual, 2)
+
+				Convey("And that item is a StreamWindowAST", func() {
+					top := ps.Peek()
+					So(top, ShouldNotBeNil)
+					So(top.begin, ShouldEqual, 6)
+					So(top.end, ShouldEqual, 12)
+					So(top.comp, ShouldHaveSameTypeAs, StreamWindowAST{})
+
+					Convey("And it contains the previously pushed data", func() {
+						comp := top.comp.(StreamWindowAST)
+						So(comp.Name, ShouldEqual, "a")
+						So(comp.IsSession(), ShouldBeTrue)
+						So(comp.Gap.Value, ShouldEqual, 30)
+						So(comp.Gap.Unit, ShouldEqual, Seconds)
+						So(comp.Unit, ShouldEqual, UnspecifiedIntervalUnit)
+						So(comp.Slide.Unit, ShouldEqual, UnspecifiedIntervalUnit)
+					})
+				})
+			})
+		})
+
 		Convey("When the stack contains two correct items (float)", func() {
 			ps.PushComponent(0, 6, Raw{"PRE"})
-			ps.PushComponent(6, 8, Stream{ActualStream, "a", nil})
+			ps.PushComponent(6, 8, Stream{ActualStream, "a", nil, nil})
 			ps.PushComponent(8, 10, IntervalAST{FloatLiteral{0.2}, Seconds})
+			ps.EnsureSlideSpec(10, 10)
+			ps.AssembleRangeWindow()
+			ps.EnsureWatermarkSpec(10, 10)
 			ps.PushComponent(10, 12, NumericLiteral{2})
 			ps.EnsureCapacitySpec(10, 12)
 			ps.PushComponent(12, 14, DropNewest)
@@ -75,7 +151,7 @@ func TestAssembleStreamWindow(t *testing.T) {
 
 		Convey("When the stack contains a wrong item", func() {
 			ps.PushComponent(0, 6, Raw{"PRE"})
-			ps.PushComponent(6, 8, Stream{ActualStream, "a", nil})
+			ps.PushComponent(6, 8, Stream{ActualStream, "a", nil, nil})
 
 			Convey("Then AssembleStreamWindow panics", func() {
 				So(ps.AssembleStreamWindow, ShouldPanic)
@@ -158,6 +234,140 @@ func TestAssembleStreamWindow(t *testing.T) {
 			})
 		})
 
+		Convey("When selecting with a FROM (MINUTES/int) and a SLIDE", func() {
+			p.Buffer = "CREATE STREAM x AS SELECT ISTREAM a, b FROM c [RANGE 1 MINUTES SLIDE 10 SECONDS, BUFFER SIZE 3]"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, CreateStreamAsSelectStmt{})
+				comp := top.(CreateStreamAsSelectStmt).Select
+				So(comp.Relations[0].Name, ShouldEqual, "c")
+				So(comp.Relations[0].Value, ShouldEqual, 1)
+				So(comp.Relations[0].Unit, ShouldEqual, Minutes)
+				So(comp.Relations[0].Slide.Value, ShouldEqual, 10)
+				So(comp.Relations[0].Slide.Unit, ShouldEqual, Seconds)
+				So(comp.Relations[0].Capacity, ShouldEqual, 3)
+				So(comp.Relations[0].Shedding, ShouldEqual, UnspecifiedSheddingOption)
+				So(comp.Relations[0].Alias, ShouldEqual, "")
+
+				Convey("And String() should return the original statement", func() {
+					stmt := top.(CreateStreamAsSelectStmt)
+					So(stmt.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When selecting with a FROM (TUPLES) and a SLIDE", func() {
+			p.Buffer = "CREATE STREAM x AS SELECT ISTREAM a, b FROM c [RANGE 10 TUPLES SLIDE 5 TUPLES] AS d"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, CreateStreamAsSelectStmt{})
+				comp := top.(CreateStreamAsSelectStmt).Select
+				So(comp.Relations[0].Name, ShouldEqual, "c")
+				So(comp.Relations[0].Value, ShouldEqual, 10)
+				So(comp.Relations[0].Unit, ShouldEqual, Tuples)
+				So(comp.Relations[0].Slide.Value, ShouldEqual, 5)
+				So(comp.Relations[0].Slide.Unit, ShouldEqual, Tuples)
+				So(comp.Relations[0].Alias, ShouldEqual, "d")
+
+				Convey("And String() should return the original statement", func() {
+					stmt := top.(CreateStreamAsSelectStmt)
+					So(stmt.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When selecting with a SESSION window", func() {
+			p.Buffer = "CREATE STREAM x AS SELECT RSTREAM id, count(*) FROM c [SESSION GAP 30 SECONDS, BUFFER SIZE 3] GROUP BY id"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, CreateStreamAsSelectStmt{})
+				comp := top.(CreateStreamAsSelectStmt).Select
+				So(comp.Relations[0].Name, ShouldEqual, "c")
+				So(comp.Relations[0].IsSession(), ShouldBeTrue)
+				So(comp.Relations[0].Gap.Value, ShouldEqual, 30)
+				So(comp.Relations[0].Gap.Unit, ShouldEqual, Seconds)
+				So(comp.Relations[0].Unit, ShouldEqual, UnspecifiedIntervalUnit)
+				So(comp.Relations[0].Slide.Unit, ShouldEqual, UnspecifiedIntervalUnit)
+				So(comp.Relations[0].Capacity, ShouldEqual, 3)
+				So(comp.Relations[0].Alias, ShouldEqual, "")
+
+				Convey("And String() should return the original statement", func() {
+					stmt := top.(CreateStreamAsSelectStmt)
+					So(stmt.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When selecting with a SESSION window and a WATERMARK", func() {
+			p.Buffer = "CREATE STREAM x AS SELECT RSTREAM id, count(*) FROM c [SESSION GAP 1.5 MINUTES, WATERMARK DELAY 5 SECONDS] AS d GROUP BY id"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, CreateStreamAsSelectStmt{})
+				comp := top.(CreateStreamAsSelectStmt).Select
+				So(comp.Relations[0].Gap.Value, ShouldEqual, 1.5)
+				So(comp.Relations[0].Gap.Unit, ShouldEqual, Minutes)
+				So(comp.Relations[0].Watermark.Delay.Value, ShouldEqual, 5)
+				So(comp.Relations[0].Alias, ShouldEqual, "d")
+
+				Convey("And String() should return the original statement", func() {
+					stmt := top.(CreateStreamAsSelectStmt)
+					So(stmt.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When selecting with a SESSION window with a tuple-based gap", func() {
+			p.Buffer = "CREATE STREAM x AS SELECT RSTREAM a FROM c [SESSION GAP 3 TUPLES]"
+			p.Init()
+
+			Convey("Then parsing the statement should fail", func() {
+				err := p.Parse()
+				So(err, ShouldNotEqual, nil)
+			})
+		})
+
+		Convey("When selecting with a SESSION window and a SLIDE", func() {
+			p.Buffer = "CREATE STREAM x AS SELECT RSTREAM a FROM c [SESSION GAP 3 SECONDS SLIDE 1 SECONDS]"
+			p.Init()
+
+			Convey("Then parsing the statement should fail", func() {
+				err := p.Parse()
+				So(err, ShouldNotEqual, nil)
+			})
+		})
+
 		Convey("When selecting with a FROM (MILLISECONDS/float)", func() {
 			p.Buffer = "CREATE STREAM x AS SELECT ISTREAM a, b FROM c [RANGE 0.2 MILLISECONDS]"
 			p.Init()
@@ -3,6 +3,7 @@ package parser
 import (
 	"fmt"
 	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"math"
 	"strconv"
 	"strings"
 )
@@ -28,6 +29,8 @@ type SelectStmt struct {
 	FilterAST
 	GroupingAST
 	HavingAST
+	OrderingAST
+	LimitAST
 }
 
 func (s SelectStmt) String() string {
@@ -37,6 +40,8 @@ func (s SelectStmt) String() string {
 	str = append(str, s.FilterAST.string())
 	str = append(str, s.GroupingAST.string())
 	str = append(str, s.HavingAST.string())
+	str = append(str, s.OrderingAST.string())
+	str = append(str, s.LimitAST.string())
 
 	st := []string{}
 	for _, s := range str {
@@ -47,6 +52,20 @@ func (s SelectStmt) String() string {
 	return strings.Join(st, " ")
 }
 
+// CommonTableExpressionAST is a SELECT statement named in a WITH
+// clause, as in
+//  WITH x AS (SELECT ...) SELECT ... FROM x [RANGE ...]
+// It only appears while parsing: every reference to the name in the
+// following statement is replaced by a SubqueryStream.
+type CommonTableExpressionAST struct {
+	Name   StreamIdentifier
+	Select SelectStmt
+}
+
+func (c CommonTableExpressionAST) String() string {
+	return string(c.Name) + " AS (" + c.Select.String() + ")"
+}
+
 type SelectUnionStmt struct {
 	Selects []SelectStmt
 }
@@ -62,10 +81,32 @@ func (s SelectUnionStmt) String() string {
 type CreateStreamAsSelectStmt struct {
 	Name   StreamIdentifier
 	Select SelectStmt
+	SourceSinkSpecsAST
 }
 
 func (s CreateStreamAsSelectStmt) String() string {
-	str := []string{"CREATE", "STREAM", string(s.Name), "AS", s.Select.String()}
+	str := []string{"CREATE", "STREAM", string(s.Name)}
+	specs := s.SourceSinkSpecsAST.string("WITH")
+	if specs != "" {
+		str = append(str, specs)
+	}
+	str = append(str, "AS", s.Select.String())
+	return strings.Join(str, " ")
+}
+
+type CreateOrReplaceStreamAsSelectStmt struct {
+	Name   StreamIdentifier
+	Select SelectStmt
+	SourceSinkSpecsAST
+}
+
+func (s CreateOrReplaceStreamAsSelectStmt) String() string {
+	str := []string{"CREATE", "OR", "REPLACE", "STREAM", string(s.Name)}
+	specs := s.SourceSinkSpecsAST.string("WITH")
+	if specs != "" {
+		str = append(str, specs)
+	}
+	str = append(str, "AS", s.Select.String())
 	return strings.Join(str, " ")
 }
 
@@ -405,6 +446,10 @@ func (a AliasAST) String() string {
 
 type WindowedFromAST struct {
 	Relations []AliasedStreamWindowAST
+	// Join holds the JOIN clause combining the two relations in
+	// Relations. If its Type is UnspecifiedJoinType, the relations
+	// are combined by a cross product.
+	Join JoinAST
 }
 
 func (a WindowedFromAST) string() string {
@@ -412,6 +457,16 @@ func (a WindowedFromAST) string() string {
 		return ""
 	}
 
+	if a.Join.Table != "" && len(a.Relations) == 1 {
+		return "FROM " + a.Relations[0].string() + " " + a.Join.Type.String() +
+			" " + a.Join.Table + " ON " + a.Join.On.String()
+	}
+
+	if a.Join.Type != UnspecifiedJoinType && len(a.Relations) == 2 {
+		return "FROM " + a.Relations[0].string() + " " + a.Join.Type.String() +
+			" " + a.Relations[1].string() + " ON " + a.Join.On.String()
+	}
+
 	str := []string{}
 	for _, r := range a.Relations {
 		str = append(str, r.string())
@@ -419,6 +474,16 @@ func (a WindowedFromAST) string() string {
 	return "FROM " + strings.Join(str, ", ")
 }
 
+// JoinAST holds the type and the condition of a JOIN clause.
+type JoinAST struct {
+	Type JoinType
+	// Table is the name of the shared state that is joined with the
+	// only relation in a lookup join. It is empty when two relations
+	// are joined.
+	Table string
+	On    Expression
+}
+
 type AliasedStreamWindowAST struct {
 	StreamWindowAST
 	Alias string
@@ -437,12 +502,35 @@ const UnspecifiedCapacity int64 = -1
 type StreamWindowAST struct {
 	Stream
 	IntervalAST
-	Capacity int64
-	Shedding SheddingOption
+	// Slide is the interval by which the window advances. When its
+	// Unit is UnspecifiedIntervalUnit, the window slides with every
+	// incoming tuple.
+	Slide IntervalAST
+	// Gap is the inactivity timeout of a session window. When its
+	// Unit is not UnspecifiedIntervalUnit, the window is a session
+	// window and neither the RANGE nor the SLIDE interval is specified.
+	Gap       IntervalAST
+	Watermark WatermarkAST
+	Capacity  int64
+	Shedding  SheddingOption
+}
+
+// IsSession returns true if the window is a session window.
+func (a StreamWindowAST) IsSession() bool {
+	return a.Gap.Unit != UnspecifiedIntervalUnit
 }
 
 func (a StreamWindowAST) string() string {
-	interval := a.IntervalAST.string()
+	interval := ""
+	if a.IsSession() {
+		interval = "SESSION GAP " + a.Gap.FloatLiteral.String() + " " + a.Gap.Unit.String()
+	} else {
+		interval = a.IntervalAST.string()
+		if a.Slide.Unit != UnspecifiedIntervalUnit {
+			interval += " SLIDE " + a.Slide.FloatLiteral.String() + " " + a.Slide.Unit.String()
+		}
+	}
+	interval += a.Watermark.string()
 	capacity := ""
 	if a.Capacity != UnspecifiedCapacity {
 		capacity = fmt.Sprintf(", BUFFER SIZE %d", a.Capacity)
@@ -463,11 +551,38 @@ func (a StreamWindowAST) string() string {
 			ps = append(ps, p.String())
 		}
 		return a.Stream.Name + "(" + strings.Join(ps, ", ") + ") " + suffix
+
+	case SubqueryStream:
+		return "(" + a.Stream.Subquery.String() + ") " + suffix
 	}
 
 	return "UnknownStreamType"
 }
 
+// WatermarkAST describes how out-of-order tuples of a stream are
+// handled. When the Unit of Delay is UnspecifiedIntervalUnit, tuples
+// are processed in the order in which they arrive.
+type WatermarkAST struct {
+	Delay           IntervalAST
+	AllowedLateness IntervalAST
+	LateStream      StreamIdentifier
+}
+
+func (a WatermarkAST) string() string {
+	if a.Delay.Unit == UnspecifiedIntervalUnit {
+		return ""
+	}
+	str := ", WATERMARK DELAY " + a.Delay.FloatLiteral.String() + " " + a.Delay.Unit.String()
+	if a.AllowedLateness.Unit != UnspecifiedIntervalUnit {
+		str += ", ALLOWED LATENESS " + a.AllowedLateness.FloatLiteral.String() +
+			" " + a.AllowedLateness.Unit.String()
+	}
+	if a.LateStream != "" {
+		str += ", LATE TUPLES TO " + string(a.LateStream)
+	}
+	return str
+}
+
 type IntervalAST struct {
 	FloatLiteral
 	Unit IntervalUnit
@@ -515,6 +630,43 @@ func (a HavingAST) string() string {
 	return "HAVING " + a.Having.String()
 }
 
+// OrderingAST holds the ORDER BY clause of a SELECT statement, which
+// sorts the rows computed for each window.
+type OrderingAST struct {
+	Ordering []SortedExpressionAST
+}
+
+func (a OrderingAST) string() string {
+	if len(a.Ordering) == 0 {
+		return ""
+	}
+	str := make([]string, len(a.Ordering))
+	for i, e := range a.Ordering {
+		str[i] = e.String()
+	}
+	return "ORDER BY " + strings.Join(str, ", ")
+}
+
+// LimitAST holds the LIMIT and OFFSET clauses of a SELECT statement,
+// which restrict the rows computed for each window. Limit and Offset
+// are only valid if HasLimit is true.
+type LimitAST struct {
+	HasLimit bool
+	Limit    int64
+	Offset   int64
+}
+
+func (a LimitAST) string() string {
+	if !a.HasLimit {
+		return ""
+	}
+	s := fmt.Sprintf("LIMIT %d", a.Limit)
+	if a.Offset > 0 {
+		s += fmt.Sprintf(" OFFSET %d", a.Offset)
+	}
+	return s
+}
+
 type SourceSinkSpecsAST struct {
 	Params []SourceSinkParamAST
 }
@@ -627,6 +779,16 @@ func (b BinaryOpAST) String() string {
 		}
 	}
 
+	// BETWEEN can only be combined with AND and OR without parentheses
+	if b.Op != And && b.Op != Or {
+		if _, ok := b.Left.(BetweenAST); ok {
+			encloseLeft = true
+		}
+		if _, ok := b.Right.(BetweenAST); ok {
+			encloseRight = true
+		}
+	}
+
 	if encloseLeft {
 		str[0] = "(" + str[0] + ")"
 	}
@@ -637,6 +799,54 @@ func (b BinaryOpAST) String() string {
 	return strings.Join(str, " ")
 }
 
+// BetweenAST represents `Expr BETWEEN Lower AND Upper` (or NOT BETWEEN,
+// depending on Op). It is kept as a separate node instead of being
+// rewritten as two comparisons so that Expr is only evaluated once.
+type BetweenAST struct {
+	Op    Operator
+	Expr  Expression
+	Lower Expression
+	Upper Expression
+}
+
+func (b BetweenAST) ReferencedRelations() map[string]bool {
+	rels := map[string]bool{}
+	for _, e := range []Expression{b.Expr, b.Lower, b.Upper} {
+		for rel := range e.ReferencedRelations() {
+			rels[rel] = true
+		}
+	}
+	return rels
+}
+
+func (b BetweenAST) RenameReferencedRelation(from, to string) Expression {
+	return BetweenAST{b.Op,
+		b.Expr.RenameReferencedRelation(from, to),
+		b.Lower.RenameReferencedRelation(from, to),
+		b.Upper.RenameReferencedRelation(from, to)}
+}
+
+func (b BetweenAST) Foldable() bool {
+	return b.Expr.Foldable() && b.Lower.Foldable() && b.Upper.Foldable()
+}
+
+func (b BetweenAST) String() string {
+	str := func(e Expression) string {
+		// Enclose operands that bind less tightly than BETWEEN
+		// such as "(a AND b) BETWEEN 1 AND 2"
+		switch o := e.(type) {
+		case BinaryOpAST:
+			if !o.Op.hasHigherPrecedenceThan(b.Op) {
+				return "(" + o.String() + ")"
+			}
+		case BetweenAST:
+			return "(" + o.String() + ")"
+		}
+		return e.String()
+	}
+	return str(b.Expr) + " " + b.Op.String() + " " + str(b.Lower) + " AND " + str(b.Upper)
+}
+
 type UnaryOpAST struct {
 	Op   Operator
 	Expr Expression
@@ -665,7 +875,8 @@ func (u UnaryOpAST) String() string {
 	}
 
 	// Enclose expression in parentheses for "NOT (a AND B)" like case
-	if _, ok := u.Expr.(BinaryOpAST); ok {
+	switch u.Expr.(type) {
+	case BinaryOpAST, BetweenAST:
 		expr = "(" + expr + ")"
 	}
 
@@ -767,6 +978,130 @@ func (f FuncAppAST) String() string {
 	return s + ")"
 }
 
+// WindowFuncAppAST is the application of a window function, such as
+// `lag(x) OVER (PARTITION BY id ORDER BY ts())`. The value of a window
+// function is computed from the rows of the window that are in the same
+// partition as the current row, sorted as given in the OVER clause.
+type WindowFuncAppAST struct {
+	Func      FuncAppAST
+	Partition []Expression
+	Ordering  []SortedExpressionAST
+}
+
+func (w WindowFuncAppAST) ReferencedRelations() map[string]bool {
+	rels := w.Func.ReferencedRelations()
+	for _, expr := range w.Partition {
+		for rel := range expr.ReferencedRelations() {
+			rels[rel] = true
+		}
+	}
+	for _, expr := range w.Ordering {
+		for rel := range expr.ReferencedRelations() {
+			rels[rel] = true
+		}
+	}
+	return rels
+}
+
+func (w WindowFuncAppAST) RenameReferencedRelation(from, to string) Expression {
+	var newPartition []Expression
+	if len(w.Partition) > 0 {
+		newPartition = make([]Expression, len(w.Partition))
+		for i, expr := range w.Partition {
+			newPartition[i] = expr.RenameReferencedRelation(from, to)
+		}
+	}
+	var newOrderExprs []SortedExpressionAST
+	if len(w.Ordering) > 0 {
+		newOrderExprs = make([]SortedExpressionAST, len(w.Ordering))
+		for i, expr := range w.Ordering {
+			newOrderExprs[i] = expr.RenameReferencedRelation(from, to).(SortedExpressionAST)
+		}
+	}
+	return WindowFuncAppAST{
+		w.Func.RenameReferencedRelation(from, to).(FuncAppAST),
+		newPartition,
+		newOrderExprs,
+	}
+}
+
+func (w WindowFuncAppAST) Foldable() bool {
+	// the value depends on other rows
+	return false
+}
+
+func (w WindowFuncAppAST) String() string {
+	over := []string{}
+	if len(w.Partition) > 0 {
+		partition := make([]string, len(w.Partition))
+		for i, expr := range w.Partition {
+			partition[i] = expr.String()
+		}
+		over = append(over, "PARTITION BY "+strings.Join(partition, ", "))
+	}
+	if len(w.Ordering) > 0 {
+		orderStrings := make([]string, len(w.Ordering))
+		for i, expr := range w.Ordering {
+			orderStrings[i] = expr.String()
+		}
+		over = append(over, "ORDER BY "+strings.Join(orderStrings, ", "))
+	}
+	return w.Func.String() + " OVER (" + strings.Join(over, " ") + ")"
+}
+
+// LambdaAST is an anonymous function such as `x -> x * 2` or
+// `(acc, x) -> acc + x`. It can only be used as an argument of a
+// higher-order function. References to the parameters in Body are
+// represented by LambdaVariable.
+type LambdaAST struct {
+	Params []string
+	Body   Expression
+}
+
+func (l LambdaAST) ReferencedRelations() map[string]bool {
+	return l.Body.ReferencedRelations()
+}
+
+func (l LambdaAST) RenameReferencedRelation(from, to string) Expression {
+	return LambdaAST{l.Params, l.Body.RenameReferencedRelation(from, to)}
+}
+
+func (l LambdaAST) Foldable() bool {
+	return l.Body.Foldable()
+}
+
+func (l LambdaAST) String() string {
+	if len(l.Params) == 1 {
+		return l.Params[0] + " -> " + l.Body.String()
+	}
+	return "(" + strings.Join(l.Params, ", ") + ") -> " + l.Body.String()
+}
+
+// LambdaVariable is a reference to a parameter of a lambda expression,
+// such as `x` or `x.a[0]` in `x -> x.a[0] + 1`. Path is the part of the
+// reference following the parameter name.
+type LambdaVariable struct {
+	Name string
+	Path string
+}
+
+func (v LambdaVariable) ReferencedRelations() map[string]bool {
+	return nil
+}
+
+func (v LambdaVariable) RenameReferencedRelation(from, to string) Expression {
+	return v
+}
+
+func (v LambdaVariable) Foldable() bool {
+	// the value is bound when the lambda expression is applied
+	return true
+}
+
+func (v LambdaVariable) String() string {
+	return v.Name + v.Path
+}
+
 type SortedExpressionAST struct {
 	Expr      Expression
 	Ascending BinaryKeyword
@@ -905,16 +1240,21 @@ func (k KeyValuePairAST) string() string {
 
 // It seems not possible in Go to have a variable that says "this is
 // either struct A or struct B or struct C", so we build one struct
-// that serves both for "real" streams (as in `FROM x`) and stream-
-// generating functions (as in `FROM series(1, 5)`).
+// that serves for "real" streams (as in `FROM x`), stream-generating
+// functions (as in `FROM series(1, 5)`) and subqueries (as in
+// `FROM (SELECT ...)`).
 type Stream struct {
 	Type   StreamType
 	Name   string
 	Params []Expression
+	// Subquery is the SELECT statement computing a SubqueryStream.
+	// Its Name is the alias of the subquery or the name of the
+	// common table expression it was defined by.
+	Subquery *SelectStmt
 }
 
 func NewStream(s string) Stream {
-	return Stream{ActualStream, s, nil}
+	return Stream{ActualStream, s, nil, nil}
 }
 
 type Wildcard struct {
@@ -1212,6 +1552,56 @@ func NewFloatLiteral(s string) FloatLiteral {
 	return FloatLiteral{val}
 }
 
+// IntervalLiteral is a time interval such as `INTERVAL 5 MINUTES`. It can
+// be added to or subtracted from a timestamp:
+//
+//  ts() - INTERVAL 1.5 HOURS
+//
+// In other places, it is evaluated to the number of microseconds in the
+// interval.
+type IntervalLiteral struct {
+	Value float64
+	Unit  IntervalUnit
+}
+
+func (l IntervalLiteral) ReferencedRelations() map[string]bool {
+	return nil
+}
+
+func (l IntervalLiteral) RenameReferencedRelation(from, to string) Expression {
+	return l
+}
+
+func (l IntervalLiteral) Foldable() bool {
+	return true
+}
+
+func (l IntervalLiteral) String() string {
+	return fmt.Sprintf("INTERVAL %v %s", l.Value, l.Unit)
+}
+
+// Microseconds returns the length of the interval in microseconds.
+func (l IntervalLiteral) Microseconds() int64 {
+	var us float64
+	switch l.Unit {
+	case Microseconds:
+		us = 1
+	case Milliseconds:
+		us = 1e3
+	case Seconds:
+		us = 1e6
+	case Minutes:
+		us = 60 * 1e6
+	case Hours:
+		us = 60 * 60 * 1e6
+	case Days:
+		us = 24 * 60 * 60 * 1e6
+	case Weeks:
+		us = 7 * 24 * 60 * 60 * 1e6
+	}
+	return int64(math.Floor(l.Value*us + 0.5))
+}
+
 type NullLiteral struct {
 }
 
@@ -1370,6 +1760,7 @@ const (
 	UnknownStreamType StreamType = iota
 	ActualStream
 	UDSFStream
+	SubqueryStream
 )
 
 func (st StreamType) String() string {
@@ -1379,6 +1770,8 @@ func (st StreamType) String() string {
 		s = "ActualStream"
 	case UDSFStream:
 		s = "UDSFStream"
+	case SubqueryStream:
+		s = "SubqueryStream"
 	}
 	return s
 }
@@ -1390,8 +1783,38 @@ const (
 	Tuples
 	Seconds
 	Milliseconds
+	Minutes
+	// The following units can only be used in IntervalLiteral.
+	Microseconds
+	Hours
+	Days
+	Weeks
 )
 
+// NewIntervalUnit returns the IntervalUnit having the given name,
+// which is case-insensitive and can be singular or plural.
+func NewIntervalUnit(s string) IntervalUnit {
+	switch strings.TrimSuffix(strings.ToUpper(s), "S") {
+	case "TUPLE":
+		return Tuples
+	case "SECOND":
+		return Seconds
+	case "MILLISECOND":
+		return Milliseconds
+	case "MINUTE":
+		return Minutes
+	case "MICROSECOND":
+		return Microseconds
+	case "HOUR":
+		return Hours
+	case "DAY":
+		return Days
+	case "WEEK":
+		return Weeks
+	}
+	return UnspecifiedIntervalUnit
+}
+
 func (i IntervalUnit) String() string {
 	s := "UNSPECIFIED"
 	switch i {
@@ -1401,6 +1824,16 @@ func (i IntervalUnit) String() string {
 		s = "SECONDS"
 	case Milliseconds:
 		s = "MILLISECONDS"
+	case Minutes:
+		s = "MINUTES"
+	case Microseconds:
+		s = "MICROSECONDS"
+	case Hours:
+		s = "HOURS"
+	case Days:
+		s = "DAYS"
+	case Weeks:
+		s = "WEEKS"
 	}
 	return s
 }
@@ -1464,6 +1897,25 @@ func (k BinaryKeyword) string(yes, no string) string {
 	return ""
 }
 
+type JoinType int
+
+const (
+	UnspecifiedJoinType JoinType = iota
+	InnerJoin
+	LeftOuterJoin
+)
+
+func (t JoinType) String() string {
+	s := "UnspecifiedJoinType"
+	switch t {
+	case InnerJoin:
+		s = "INNER JOIN"
+	case LeftOuterJoin:
+		s = "LEFT OUTER JOIN"
+	}
+	return s
+}
+
 type SheddingOption int
 
 const (
@@ -1538,6 +1990,17 @@ const (
 	Greater
 	GreaterOrEqual
 	NotEqual
+	In
+	NotIn
+	Like
+	NotLike
+	ILike
+	NotILike
+	RegexpMatch
+	NotRegexpMatch
+	// Between and NotBetween are only used by BetweenAST.
+	Between
+	NotBetween
 	Concat
 	Is
 	IsNot
@@ -1599,6 +2062,26 @@ func (o Operator) String() string {
 		s = ">="
 	case NotEqual:
 		s = "!="
+	case In:
+		s = "IN"
+	case NotIn:
+		s = "NOT IN"
+	case Like:
+		s = "LIKE"
+	case NotLike:
+		s = "NOT LIKE"
+	case ILike:
+		s = "ILIKE"
+	case NotILike:
+		s = "NOT ILIKE"
+	case RegexpMatch:
+		s = "~"
+	case NotRegexpMatch:
+		s = "!~"
+	case Between:
+		s = "BETWEEN"
+	case NotBetween:
+		s = "NOT BETWEEN"
 	case Concat:
 		s = "||"
 	case Is:
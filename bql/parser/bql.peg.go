@@ -23,8 +23,11 @@ const (
 	ruleStateStmt
 	ruleStreamStmt
 	ruleSelectStmt
+	ruleWithSelectStmt
+	ruleCommonTableExpression
 	ruleSelectUnionStmt
 	ruleCreateStreamAsSelectStmt
+	ruleCreateOrReplaceStreamAsSelectStmt
 	ruleCreateStreamAsSelectUnionStmt
 	ruleCreateSourceStmt
 	ruleCreateSinkStmt
@@ -62,15 +65,28 @@ const (
 	ruleTimeInterval
 	ruleTuplesInterval
 	ruleRelations
+	ruleJoinedRelation
+	ruleJoinedTable
+	ruleJoinType
 	ruleFilter
 	ruleGrouping
 	ruleGroupList
 	ruleHaving
+	ruleOrdering
+	ruleLimit
 	ruleRelationLike
 	ruleAliasedStreamWindow
 	ruleStreamWindow
+	ruleRangeWindowSpec
+	ruleSessionWindowSpec
 	ruleStreamLike
+	ruleSubqueryStream
 	ruleUDSFFuncApp
+	ruleSlideSpecOpt
+	ruleWatermarkSpecOpt
+	ruleWatermarkSpec
+	ruleAllowedLatenessSpecOpt
+	ruleLateTuplesSpecOpt
 	ruleCapacitySpecOpt
 	ruleSheddingSpecOpt
 	ruleSheddingOption
@@ -91,6 +107,7 @@ const (
 	ruleandExpr
 	rulenotExpr
 	rulecomparisonExpr
+	ruleInList
 	ruleotherOpExpr
 	ruleisExpr
 	ruletermExpr
@@ -99,10 +116,18 @@ const (
 	rulecastExpr
 	rulebaseExpr
 	ruleFuncTypeCast
+	ruleExtract
+	ruleExtractField
 	ruleFuncApp
+	ruleWindowFuncApp
+	ruleWindowPartition
+	ruleWindowOrder
 	ruleFuncAppWithOrderBy
 	ruleFuncAppWithoutOrderBy
 	ruleFuncParams
+	ruleFuncParam
+	ruleLambda
+	ruleLambdaParams
 	ruleParamsOrder
 	ruleSortedExpression
 	ruleOrderDirectionOpt
@@ -115,6 +140,9 @@ const (
 	ruleWhenThenPair
 	ruleLiteral
 	ruleComparisonOp
+	rulePatternOp
+	ruleInOp
+	ruleBetweenOp
 	ruleOtherOp
 	ruleIsOp
 	rulePlusMinusOp
@@ -129,6 +157,9 @@ const (
 	ruleFunction
 	ruleNullLiteral
 	ruleMissing
+	ruleIntervalLiteral
+	ruleIntervalValue
+	ruleIntervalLiteralUnit
 	ruleBooleanLiteral
 	ruleTRUE
 	ruleFALSE
@@ -138,8 +169,11 @@ const (
 	ruleDSTREAM
 	ruleRSTREAM
 	ruleTUPLES
+	ruleMINUTES
 	ruleSECONDS
 	ruleMILLISECONDS
+	ruleInnerJoin
+	ruleLeftOuterJoin
 	ruleWait
 	ruleDropOldest
 	ruleDropNewest
@@ -168,6 +202,16 @@ const (
 	ruleGreater
 	ruleGreaterOrEqual
 	ruleNotEqual
+	ruleInOperator
+	ruleNotIn
+	ruleLike
+	ruleNotLike
+	ruleILike
+	ruleNotILike
+	ruleRegexpMatch
+	ruleNotRegexpMatch
+	ruleBetween
+	ruleNotBetween
 	ruleConcat
 	ruleIs
 	ruleIsNot
@@ -335,6 +379,43 @@ const (
 	ruleAction131
 	ruleAction132
 	ruleAction133
+	ruleAction134
+	ruleAction135
+	ruleAction136
+	ruleAction137
+	ruleAction138
+	ruleAction139
+	ruleAction140
+	ruleAction141
+	ruleAction142
+	ruleAction143
+	ruleAction144
+	ruleAction145
+	ruleAction146
+	ruleAction147
+	ruleAction148
+	ruleAction149
+	ruleAction150
+	ruleAction151
+	ruleAction152
+	ruleAction153
+	ruleAction154
+	ruleAction155
+	ruleAction156
+	ruleAction157
+	ruleAction158
+	ruleAction159
+	ruleAction160
+	ruleAction161
+	ruleAction162
+	ruleAction163
+	ruleAction164
+	ruleAction165
+	ruleAction166
+	ruleAction167
+	ruleAction168
+	ruleAction169
+	ruleAction170
 
 	rulePre
 	ruleIn
@@ -352,8 +433,11 @@ var rul3s = [...]string{
 	"StateStmt",
 	"StreamStmt",
 	"SelectStmt",
+	"WithSelectStmt",
+	"CommonTableExpression",
 	"SelectUnionStmt",
 	"CreateStreamAsSelectStmt",
+	"CreateOrReplaceStreamAsSelectStmt",
 	"CreateStreamAsSelectUnionStmt",
 	"CreateSourceStmt",
 	"CreateSinkStmt",
@@ -391,15 +475,28 @@ var rul3s = [...]string{
 	"TimeInterval",
 	"TuplesInterval",
 	"Relations",
+	"JoinedRelation",
+	"JoinedTable",
+	"JoinType",
 	"Filter",
 	"Grouping",
 	"GroupList",
 	"Having",
+	"Ordering",
+	"Limit",
 	"RelationLike",
 	"AliasedStreamWindow",
 	"StreamWindow",
+	"RangeWindowSpec",
+	"SessionWindowSpec",
 	"StreamLike",
+	"SubqueryStream",
 	"UDSFFuncApp",
+	"SlideSpecOpt",
+	"WatermarkSpecOpt",
+	"WatermarkSpec",
+	"AllowedLatenessSpecOpt",
+	"LateTuplesSpecOpt",
 	"CapacitySpecOpt",
 	"SheddingSpecOpt",
 	"SheddingOption",
@@ -420,6 +517,7 @@ var rul3s = [...]string{
 	"andExpr",
 	"notExpr",
 	"comparisonExpr",
+	"InList",
 	"otherOpExpr",
 	"isExpr",
 	"termExpr",
@@ -428,10 +526,18 @@ var rul3s = [...]string{
 	"castExpr",
 	"baseExpr",
 	"FuncTypeCast",
+	"Extract",
+	"ExtractField",
 	"FuncApp",
+	"WindowFuncApp",
+	"WindowPartition",
+	"WindowOrder",
 	"FuncAppWithOrderBy",
 	"FuncAppWithoutOrderBy",
 	"FuncParams",
+	"FuncParam",
+	"Lambda",
+	"LambdaParams",
 	"ParamsOrder",
 	"SortedExpression",
 	"OrderDirectionOpt",
@@ -444,6 +550,9 @@ var rul3s = [...]string{
 	"WhenThenPair",
 	"Literal",
 	"ComparisonOp",
+	"PatternOp",
+	"InOp",
+	"BetweenOp",
 	"OtherOp",
 	"IsOp",
 	"PlusMinusOp",
@@ -458,6 +567,9 @@ var rul3s = [...]string{
 	"Function",
 	"NullLiteral",
 	"Missing",
+	"IntervalLiteral",
+	"IntervalValue",
+	"IntervalLiteralUnit",
 	"BooleanLiteral",
 	"TRUE",
 	"FALSE",
@@ -467,8 +579,11 @@ var rul3s = [...]string{
 	"DSTREAM",
 	"RSTREAM",
 	"TUPLES",
+	"MINUTES",
 	"SECONDS",
 	"MILLISECONDS",
+	"InnerJoin",
+	"LeftOuterJoin",
 	"Wait",
 	"DropOldest",
 	"DropNewest",
@@ -497,6 +612,16 @@ var rul3s = [...]string{
 	"Greater",
 	"GreaterOrEqual",
 	"NotEqual",
+	"InOperator",
+	"NotIn",
+	"Like",
+	"NotLike",
+	"ILike",
+	"NotILike",
+	"RegexpMatch",
+	"NotRegexpMatch",
+	"Between",
+	"NotBetween",
 	"Concat",
 	"Is",
 	"IsNot",
@@ -664,6 +789,43 @@ var rul3s = [...]string{
 	"Action131",
 	"Action132",
 	"Action133",
+	"Action134",
+	"Action135",
+	"Action136",
+	"Action137",
+	"Action138",
+	"Action139",
+	"Action140",
+	"Action141",
+	"Action142",
+	"Action143",
+	"Action144",
+	"Action145",
+	"Action146",
+	"Action147",
+	"Action148",
+	"Action149",
+	"Action150",
+	"Action151",
+	"Action152",
+	"Action153",
+	"Action154",
+	"Action155",
+	"Action156",
+	"Action157",
+	"Action158",
+	"Action159",
+	"Action160",
+	"Action161",
+	"Action162",
+	"Action163",
+	"Action164",
+	"Action165",
+	"Action166",
+	"Action167",
+	"Action168",
+	"Action169",
+	"Action170",
 
 	"Pre_",
 	"_In_",
@@ -984,7 +1146,7 @@ type bqlPegBackend struct {
 
 	Buffer string
 	buffer []rune
-	rules  [322]func() bool
+	rules  [403]func() bool
 	Parse  func(rule ...int) error
 	Reset  func()
 	Pretty bool
@@ -1082,549 +1244,704 @@ func (p *bqlPegBackend) Execute() {
 
 		case ruleAction3:
 
-			p.AssembleSelectUnion(begin, end)
+			p.AssembleWithSelect(begin, end)
 
 		case ruleAction4:
 
-			p.AssembleCreateStreamAsSelect()
+			p.AssembleCommonTableExpression()
 
 		case ruleAction5:
 
-			p.AssembleCreateStreamAsSelectUnion()
+			p.AssembleSelectUnion(begin, end)
 
 		case ruleAction6:
 
-			p.AssembleCreateSource()
+			p.AssembleCreateStreamAsSelect()
 
 		case ruleAction7:
 
-			p.AssembleCreateSink()
+			p.AssembleCreateOrReplaceStreamAsSelect()
 
 		case ruleAction8:
 
-			p.AssembleCreateState()
+			p.AssembleCreateStreamAsSelectUnion()
 
 		case ruleAction9:
 
-			p.AssembleUpdateState()
+			p.AssembleCreateSource()
 
 		case ruleAction10:
 
-			p.AssembleUpdateSource()
+			p.AssembleCreateSink()
 
 		case ruleAction11:
 
-			p.AssembleUpdateSink()
+			p.AssembleCreateState()
 
 		case ruleAction12:
 
-			p.AssembleInsertIntoFrom()
+			p.AssembleUpdateState()
 
 		case ruleAction13:
 
-			p.AssemblePauseSource()
+			p.AssembleUpdateSource()
 
 		case ruleAction14:
 
-			p.AssembleResumeSource()
+			p.AssembleUpdateSink()
 
 		case ruleAction15:
 
-			p.AssembleRewindSource()
+			p.AssembleInsertIntoFrom()
 
 		case ruleAction16:
 
-			p.AssembleDropSource()
+			p.AssemblePauseSource()
 
 		case ruleAction17:
 
-			p.AssembleDropStream()
+			p.AssembleResumeSource()
 
 		case ruleAction18:
 
-			p.AssembleDropSink()
+			p.AssembleRewindSource()
 
 		case ruleAction19:
 
-			p.AssembleDropState()
+			p.AssembleDropSource()
 
 		case ruleAction20:
 
-			p.AssembleLoadState()
+			p.AssembleDropStream()
 
 		case ruleAction21:
 
-			p.AssembleLoadStateOrCreate()
+			p.AssembleDropSink()
 
 		case ruleAction22:
 
-			p.AssembleSaveState()
+			p.AssembleDropState()
 
 		case ruleAction23:
 
-			p.AssembleEval(begin, end)
+			p.AssembleLoadState()
 
 		case ruleAction24:
 
-			p.AssembleEmitter()
+			p.AssembleLoadStateOrCreate()
 
 		case ruleAction25:
 
-			p.AssembleEmitterOptions(begin, end)
+			p.AssembleSaveState()
 
 		case ruleAction26:
 
-			p.AssembleEmitterLimit()
+			p.AssembleEval(begin, end)
 
 		case ruleAction27:
 
-			p.AssembleEmitterSampling(CountBasedSampling, 1)
+			p.AssembleEmitter()
 
 		case ruleAction28:
 
-			p.AssembleEmitterSampling(RandomizedSampling, 1)
+			p.AssembleEmitterOptions(begin, end)
 
 		case ruleAction29:
 
-			p.AssembleEmitterSampling(TimeBasedSampling, 1)
+			p.AssembleEmitterLimit()
 
 		case ruleAction30:
 
-			p.AssembleEmitterSampling(TimeBasedSampling, 0.001)
+			p.AssembleEmitterSampling(CountBasedSampling, 1)
 
 		case ruleAction31:
 
-			p.AssembleProjections(begin, end)
+			p.AssembleEmitterSampling(RandomizedSampling, 1)
 
 		case ruleAction32:
 
-			p.AssembleAlias()
+			p.AssembleEmitterSampling(TimeBasedSampling, 1)
 
 		case ruleAction33:
 
-			// This is *always* executed, even if there is no
-			// FROM clause present in the statement.
-			p.AssembleWindowedFrom(begin, end)
+			p.AssembleEmitterSampling(TimeBasedSampling, 0.001)
 
 		case ruleAction34:
 
-			p.AssembleInterval()
+			p.AssembleProjections(begin, end)
 
 		case ruleAction35:
 
-			p.AssembleInterval()
+			p.AssembleAlias()
 
 		case ruleAction36:
 
 			// This is *always* executed, even if there is no
-			// WHERE clause present in the statement.
-			p.AssembleFilter(begin, end)
+			// FROM clause present in the statement.
+			p.AssembleWindowedFrom(begin, end)
 
 		case ruleAction37:
 
-			// This is *always* executed, even if there is no
-			// GROUP BY clause present in the statement.
-			p.AssembleGrouping(begin, end)
+			p.AssembleInterval()
 
 		case ruleAction38:
 
-			// This is *always* executed, even if there is no
-			// HAVING clause present in the statement.
-			p.AssembleHaving(begin, end)
+			p.AssembleInterval()
 
 		case ruleAction39:
 
-			p.EnsureAliasedStreamWindow()
+			p.AssembleJoin()
 
 		case ruleAction40:
 
-			p.AssembleAliasedStreamWindow()
+			// This is *always* executed, even if there is no
+			// WHERE clause present in the statement.
+			p.AssembleFilter(begin, end)
 
 		case ruleAction41:
 
-			p.AssembleStreamWindow()
+			// This is *always* executed, even if there is no
+			// GROUP BY clause present in the statement.
+			p.AssembleGrouping(begin, end)
 
 		case ruleAction42:
 
-			p.AssembleUDSFFuncApp()
+			// This is *always* executed, even if there is no
+			// HAVING clause present in the statement.
+			p.AssembleHaving(begin, end)
 
 		case ruleAction43:
 
-			p.EnsureCapacitySpec(begin, end)
+			// This is *always* executed, even if there is no
+			// ORDER BY clause present in the statement.
+			p.AssembleOrdering(begin, end)
 
 		case ruleAction44:
 
-			p.EnsureSheddingSpec(begin, end)
+			// This is *always* executed, even if there is no
+			// LIMIT clause present in the statement.
+			p.AssembleLimit(begin, end)
 
 		case ruleAction45:
 
-			p.AssembleSourceSinkSpecs(begin, end)
+			p.EnsureAliasedStreamWindow()
 
 		case ruleAction46:
 
-			p.AssembleSourceSinkSpecs(begin, end)
+			p.AssembleAliasedStreamWindow()
 
 		case ruleAction47:
 
-			p.AssembleSourceSinkSpecs(begin, end)
+			p.AssembleStreamWindow()
 
 		case ruleAction48:
 
-			p.EnsureIdentifier(begin, end)
+			p.AssembleRangeWindow()
 
 		case ruleAction49:
 
-			p.AssembleSourceSinkParam()
+			p.AssembleSessionWindow()
 
 		case ruleAction50:
 
-			p.AssembleExpressions(begin, end)
-			p.AssembleArray()
+			p.AssembleSubqueryStream(begin, end)
 
 		case ruleAction51:
 
-			p.AssembleMap(begin, end)
+			p.AssembleUDSFFuncApp()
 
 		case ruleAction52:
 
-			p.AssembleKeyValuePair()
+			p.EnsureSlideSpec(begin, end)
 
 		case ruleAction53:
 
-			p.EnsureKeywordPresent(begin, end)
+			p.EnsureWatermarkSpec(begin, end)
 
 		case ruleAction54:
 
-			p.AssembleBinaryOperation(begin, end)
+			p.AssembleWatermark()
 
 		case ruleAction55:
 
-			p.AssembleBinaryOperation(begin, end)
+			p.EnsureAllowedLatenessSpec(begin, end)
 
 		case ruleAction56:
 
-			p.AssembleUnaryPrefixOperation(begin, end)
+			p.EnsureLateTuplesSpec(begin, end)
 
 		case ruleAction57:
 
-			p.AssembleBinaryOperation(begin, end)
+			p.EnsureCapacitySpec(begin, end)
 
 		case ruleAction58:
 
-			p.AssembleBinaryOperation(begin, end)
+			p.EnsureSheddingSpec(begin, end)
 
 		case ruleAction59:
 
-			p.AssembleBinaryOperation(begin, end)
+			p.AssembleSourceSinkSpecs(begin, end)
 
 		case ruleAction60:
 
-			p.AssembleBinaryOperation(begin, end)
+			p.AssembleSourceSinkSpecs(begin, end)
 
 		case ruleAction61:
 
-			p.AssembleBinaryOperation(begin, end)
+			p.AssembleSourceSinkSpecs(begin, end)
 
 		case ruleAction62:
 
-			p.AssembleUnaryPrefixOperation(begin, end)
+			p.EnsureIdentifier(begin, end)
 
 		case ruleAction63:
 
-			p.AssembleTypeCast(begin, end)
+			p.AssembleSourceSinkParam()
 
 		case ruleAction64:
 
-			p.AssembleTypeCast(begin, end)
+			p.AssembleExpressions(begin, end)
+			p.AssembleArray()
 
 		case ruleAction65:
 
-			p.AssembleFuncApp()
+			p.AssembleMap(begin, end)
 
 		case ruleAction66:
 
-			p.AssembleExpressions(begin, end)
-			p.AssembleFuncApp()
+			p.AssembleKeyValuePair()
 
 		case ruleAction67:
 
-			p.AssembleExpressions(begin, end)
+			p.EnsureKeywordPresent(begin, end)
 
 		case ruleAction68:
 
-			p.AssembleExpressions(begin, end)
+			p.AssembleBinaryOperation(begin, end)
 
 		case ruleAction69:
 
-			p.AssembleSortedExpression()
+			p.AssembleBinaryOperation(begin, end)
 
 		case ruleAction70:
 
-			p.EnsureKeywordPresent(begin, end)
+			p.AssembleUnaryPrefixOperation(begin, end)
 
 		case ruleAction71:
 
-			p.AssembleExpressions(begin, end)
-			p.AssembleArray()
+			p.AssembleComparison(begin, end)
 
 		case ruleAction72:
 
-			p.AssembleMap(begin, end)
+			p.AssembleExpressions(begin, end)
+			p.AssembleArray()
 
 		case ruleAction73:
 
-			p.AssembleKeyValuePair()
+			p.AssembleBinaryOperation(begin, end)
 
 		case ruleAction74:
 
-			p.AssembleConditionCase(begin, end)
+			p.AssembleBinaryOperation(begin, end)
 
 		case ruleAction75:
 
-			p.AssembleExpressionCase(begin, end)
+			p.AssembleBinaryOperation(begin, end)
 
 		case ruleAction76:
 
-			p.AssembleWhenThenPair()
+			p.AssembleBinaryOperation(begin, end)
 
 		case ruleAction77:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, NewStream(substr))
+			p.AssembleUnaryPrefixOperation(begin, end)
 
 		case ruleAction78:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, NewRowMeta(substr, TimestampMeta))
+			p.AssembleTypeCast(begin, end)
 
 		case ruleAction79:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, NewRowValue(substr))
+			p.AssembleTypeCast(begin, end)
 
 		case ruleAction80:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, NewNumericLiteral(substr))
+			p.AssembleExtract()
 
 		case ruleAction81:
 
 			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, NewNumericLiteral(substr))
+			p.PushComponent(begin, end, Identifier(substr))
 
 		case ruleAction82:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, NewFloatLiteral(substr))
+			p.AssembleWindowFuncApp()
 
 		case ruleAction83:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, FuncName(substr))
+			p.AssembleExpressions(begin, end)
 
 		case ruleAction84:
 
-			p.PushComponent(begin, end, NewNullLiteral())
+			p.AssembleExpressions(begin, end)
 
 		case ruleAction85:
 
-			p.PushComponent(begin, end, NewMissing())
+			p.AssembleFuncApp()
 
 		case ruleAction86:
 
-			p.PushComponent(begin, end, NewBoolLiteral(true))
+			p.AssembleExpressions(begin, end)
+			p.AssembleFuncApp()
 
 		case ruleAction87:
 
-			p.PushComponent(begin, end, NewBoolLiteral(false))
+			p.AssembleExpressions(begin, end)
 
 		case ruleAction88:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, NewWildcard(substr))
+			p.AssembleLambda()
 
 		case ruleAction89:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, NewStringLiteral(substr))
+			p.AssembleLambdaParams(begin, end)
 
 		case ruleAction90:
 
-			p.PushComponent(begin, end, Istream)
+			p.AssembleExpressions(begin, end)
 
 		case ruleAction91:
 
-			p.PushComponent(begin, end, Dstream)
+			p.AssembleSortedExpression()
 
 		case ruleAction92:
 
-			p.PushComponent(begin, end, Rstream)
+			p.EnsureKeywordPresent(begin, end)
 
 		case ruleAction93:
 
-			p.PushComponent(begin, end, Tuples)
+			p.AssembleExpressions(begin, end)
+			p.AssembleArray()
 
 		case ruleAction94:
 
-			p.PushComponent(begin, end, Seconds)
+			p.AssembleMap(begin, end)
 
 		case ruleAction95:
 
-			p.PushComponent(begin, end, Milliseconds)
+			p.AssembleKeyValuePair()
 
 		case ruleAction96:
 
-			p.PushComponent(begin, end, Wait)
+			p.AssembleConditionCase(begin, end)
 
 		case ruleAction97:
 
-			p.PushComponent(begin, end, DropOldest)
+			p.AssembleExpressionCase(begin, end)
 
 		case ruleAction98:
 
-			p.PushComponent(begin, end, DropNewest)
+			p.AssembleWhenThenPair()
 
 		case ruleAction99:
 
 			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, StreamIdentifier(substr))
+			p.PushComponent(begin, end, NewStream(substr))
 
 		case ruleAction100:
 
 			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, SourceSinkType(substr))
+			p.PushComponent(begin, end, NewRowMeta(substr, TimestampMeta))
 
 		case ruleAction101:
 
 			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, SourceSinkParamKey(substr))
+			p.AssembleRowValue(begin, end, substr)
 
 		case ruleAction102:
 
-			p.PushComponent(begin, end, Yes)
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, NewNumericLiteral(substr))
 
 		case ruleAction103:
 
-			p.PushComponent(begin, end, No)
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, NewNumericLiteral(substr))
 
 		case ruleAction104:
 
-			p.PushComponent(begin, end, Yes)
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, NewFloatLiteral(substr))
 
 		case ruleAction105:
 
-			p.PushComponent(begin, end, No)
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, FuncName(substr))
 
 		case ruleAction106:
 
-			p.PushComponent(begin, end, Bool)
+			p.PushComponent(begin, end, NewNullLiteral())
 
 		case ruleAction107:
 
-			p.PushComponent(begin, end, Int)
+			p.PushComponent(begin, end, NewMissing())
 
 		case ruleAction108:
 
-			p.PushComponent(begin, end, Float)
+			p.AssembleIntervalLiteral()
 
 		case ruleAction109:
 
-			p.PushComponent(begin, end, String)
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, NewIntervalUnit(substr))
 
 		case ruleAction110:
 
-			p.PushComponent(begin, end, Blob)
+			p.PushComponent(begin, end, NewBoolLiteral(true))
 
 		case ruleAction111:
 
-			p.PushComponent(begin, end, Timestamp)
+			p.PushComponent(begin, end, NewBoolLiteral(false))
 
 		case ruleAction112:
 
-			p.PushComponent(begin, end, Array)
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, NewWildcard(substr))
 
 		case ruleAction113:
 
-			p.PushComponent(begin, end, Map)
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, NewStringLiteral(substr))
 
 		case ruleAction114:
 
-			p.PushComponent(begin, end, Or)
+			p.PushComponent(begin, end, Istream)
 
 		case ruleAction115:
 
-			p.PushComponent(begin, end, And)
+			p.PushComponent(begin, end, Dstream)
 
 		case ruleAction116:
 
-			p.PushComponent(begin, end, Not)
+			p.PushComponent(begin, end, Rstream)
 
 		case ruleAction117:
 
-			p.PushComponent(begin, end, Equal)
+			p.PushComponent(begin, end, Tuples)
 
 		case ruleAction118:
 
-			p.PushComponent(begin, end, Less)
+			p.PushComponent(begin, end, Minutes)
 
 		case ruleAction119:
 
-			p.PushComponent(begin, end, LessOrEqual)
+			p.PushComponent(begin, end, Seconds)
 
 		case ruleAction120:
 
-			p.PushComponent(begin, end, Greater)
+			p.PushComponent(begin, end, Milliseconds)
 
 		case ruleAction121:
 
-			p.PushComponent(begin, end, GreaterOrEqual)
+			p.PushComponent(begin, end, InnerJoin)
 
 		case ruleAction122:
 
-			p.PushComponent(begin, end, NotEqual)
+			p.PushComponent(begin, end, LeftOuterJoin)
 
 		case ruleAction123:
 
-			p.PushComponent(begin, end, Concat)
+			p.PushComponent(begin, end, Wait)
 
 		case ruleAction124:
 
-			p.PushComponent(begin, end, Is)
+			p.PushComponent(begin, end, DropOldest)
 
 		case ruleAction125:
 
-			p.PushComponent(begin, end, IsNot)
+			p.PushComponent(begin, end, DropNewest)
 
 		case ruleAction126:
 
-			p.PushComponent(begin, end, Plus)
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, StreamIdentifier(substr))
 
 		case ruleAction127:
 
-			p.PushComponent(begin, end, Minus)
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, SourceSinkType(substr))
 
 		case ruleAction128:
 
-			p.PushComponent(begin, end, Multiply)
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, SourceSinkParamKey(substr))
 
 		case ruleAction129:
 
-			p.PushComponent(begin, end, Divide)
+			p.PushComponent(begin, end, Yes)
 
 		case ruleAction130:
 
-			p.PushComponent(begin, end, Modulo)
+			p.PushComponent(begin, end, No)
 
 		case ruleAction131:
 
-			p.PushComponent(begin, end, UnaryMinus)
+			p.PushComponent(begin, end, Yes)
 
 		case ruleAction132:
 
+			p.PushComponent(begin, end, No)
+
+		case ruleAction133:
+
+			p.PushComponent(begin, end, Bool)
+
+		case ruleAction134:
+
+			p.PushComponent(begin, end, Int)
+
+		case ruleAction135:
+
+			p.PushComponent(begin, end, Float)
+
+		case ruleAction136:
+
+			p.PushComponent(begin, end, String)
+
+		case ruleAction137:
+
+			p.PushComponent(begin, end, Blob)
+
+		case ruleAction138:
+
+			p.PushComponent(begin, end, Timestamp)
+
+		case ruleAction139:
+
+			p.PushComponent(begin, end, Array)
+
+		case ruleAction140:
+
+			p.PushComponent(begin, end, Map)
+
+		case ruleAction141:
+
+			p.PushComponent(begin, end, Or)
+
+		case ruleAction142:
+
+			p.PushComponent(begin, end, And)
+
+		case ruleAction143:
+
+			p.PushComponent(begin, end, Not)
+
+		case ruleAction144:
+
+			p.PushComponent(begin, end, Equal)
+
+		case ruleAction145:
+
+			p.PushComponent(begin, end, Less)
+
+		case ruleAction146:
+
+			p.PushComponent(begin, end, LessOrEqual)
+
+		case ruleAction147:
+
+			p.PushComponent(begin, end, Greater)
+
+		case ruleAction148:
+
+			p.PushComponent(begin, end, GreaterOrEqual)
+
+		case ruleAction149:
+
+			p.PushComponent(begin, end, NotEqual)
+
+		case ruleAction150:
+
+			p.PushComponent(begin, end, In)
+
+		case ruleAction151:
+
+			p.PushComponent(begin, end, NotIn)
+
+		case ruleAction152:
+
+			p.PushComponent(begin, end, Like)
+
+		case ruleAction153:
+
+			p.PushComponent(begin, end, NotLike)
+
+		case ruleAction154:
+
+			p.PushComponent(begin, end, ILike)
+
+		case ruleAction155:
+
+			p.PushComponent(begin, end, NotILike)
+
+		case ruleAction156:
+
+			p.PushComponent(begin, end, RegexpMatch)
+
+		case ruleAction157:
+
+			p.PushComponent(begin, end, NotRegexpMatch)
+
+		case ruleAction158:
+
+			p.PushComponent(begin, end, Between)
+
+		case ruleAction159:
+
+			p.PushComponent(begin, end, NotBetween)
+
+		case ruleAction160:
+
+			p.PushComponent(begin, end, Concat)
+
+		case ruleAction161:
+
+			p.PushComponent(begin, end, Is)
+
+		case ruleAction162:
+
+			p.PushComponent(begin, end, IsNot)
+
+		case ruleAction163:
+
+			p.PushComponent(begin, end, Plus)
+
+		case ruleAction164:
+
+			p.PushComponent(begin, end, Minus)
+
+		case ruleAction165:
+
+			p.PushComponent(begin, end, Multiply)
+
+		case ruleAction166:
+
+			p.PushComponent(begin, end, Divide)
+
+		case ruleAction167:
+
+			p.PushComponent(begin, end, Modulo)
+
+		case ruleAction168:
+
+			p.PushComponent(begin, end, UnaryMinus)
+
+		case ruleAction169:
+
 			substr := string([]rune(buffer)[begin:end])
 			p.PushComponent(begin, end, Identifier(substr))
 
-		case ruleAction133:
+		case ruleAction170:
 
 			substr := string([]rune(buffer)[begin:end])
 			p.PushComponent(begin, end, Identifier(substr))
@@ -1813,7 +2130,7 @@ func (p *bqlPegBackend) Init() {
 			position, tokenIndex, depth = position10, tokenIndex10, depth10
 			return false
 		},
-		/* 3 Statement <- <(SelectUnionStmt / SelectStmt / SourceStmt / SinkStmt / StateStmt / StreamStmt / EvalStmt)> */
+		/* 3 Statement <- <(SelectUnionStmt / WithSelectStmt / SelectStmt / SourceStmt / SinkStmt / StateStmt / StreamStmt / EvalStmt)> */
 		func() bool {
 			position13, tokenIndex13, depth13 := position, tokenIndex, depth
 			{
@@ -1827,35 +2144,41 @@ func (p *bqlPegBackend) Init() {
 					goto l15
 				l16:
 					position, tokenIndex, depth = position15, tokenIndex15, depth15
-					if !_rules[ruleSelectStmt]() {
+					if !_rules[ruleWithSelectStmt]() {
 						goto l17
 					}
 					goto l15
 				l17:
 					position, tokenIndex, depth = position15, tokenIndex15, depth15
-					if !_rules[ruleSourceStmt]() {
+					if !_rules[ruleSelectStmt]() {
 						goto l18
 					}
 					goto l15
 				l18:
 					position, tokenIndex, depth = position15, tokenIndex15, depth15
-					if !_rules[ruleSinkStmt]() {
+					if !_rules[ruleSourceStmt]() {
 						goto l19
 					}
 					goto l15
 				l19:
 					position, tokenIndex, depth = position15, tokenIndex15, depth15
-					if !_rules[ruleStateStmt]() {
+					if !_rules[ruleSinkStmt]() {
 						goto l20
 					}
 					goto l15
 				l20:
 					position, tokenIndex, depth = position15, tokenIndex15, depth15
-					if !_rules[ruleStreamStmt]() {
+					if !_rules[ruleStateStmt]() {
 						goto l21
 					}
 					goto l15
 				l21:
+					position, tokenIndex, depth = position15, tokenIndex15, depth15
+					if !_rules[ruleStreamStmt]() {
+						goto l22
+					}
+					goto l15
+				l22:
 					position, tokenIndex, depth = position15, tokenIndex15, depth15
 					if !_rules[ruleEvalStmt]() {
 						goto l13
@@ -1872,15733 +2195,21690 @@ func (p *bqlPegBackend) Init() {
 		},
 		/* 4 SourceStmt <- <(CreateSourceStmt / UpdateSourceStmt / DropSourceStmt / PauseSourceStmt / ResumeSourceStmt / RewindSourceStmt)> */
 		func() bool {
-			position22, tokenIndex22, depth22 := position, tokenIndex, depth
+			position23, tokenIndex23, depth23 := position, tokenIndex, depth
 			{
-				position23 := position
+				position24 := position
 				depth++
 				{
-					position24, tokenIndex24, depth24 := position, tokenIndex, depth
+					position25, tokenIndex25, depth25 := position, tokenIndex, depth
 					if !_rules[ruleCreateSourceStmt]() {
-						goto l25
-					}
-					goto l24
-				l25:
-					position, tokenIndex, depth = position24, tokenIndex24, depth24
-					if !_rules[ruleUpdateSourceStmt]() {
 						goto l26
 					}
-					goto l24
+					goto l25
 				l26:
-					position, tokenIndex, depth = position24, tokenIndex24, depth24
-					if !_rules[ruleDropSourceStmt]() {
+					position, tokenIndex, depth = position25, tokenIndex25, depth25
+					if !_rules[ruleUpdateSourceStmt]() {
 						goto l27
 					}
-					goto l24
+					goto l25
 				l27:
-					position, tokenIndex, depth = position24, tokenIndex24, depth24
-					if !_rules[rulePauseSourceStmt]() {
+					position, tokenIndex, depth = position25, tokenIndex25, depth25
+					if !_rules[ruleDropSourceStmt]() {
 						goto l28
 					}
-					goto l24
+					goto l25
 				l28:
-					position, tokenIndex, depth = position24, tokenIndex24, depth24
-					if !_rules[ruleResumeSourceStmt]() {
+					position, tokenIndex, depth = position25, tokenIndex25, depth25
+					if !_rules[rulePauseSourceStmt]() {
 						goto l29
 					}
-					goto l24
+					goto l25
 				l29:
-					position, tokenIndex, depth = position24, tokenIndex24, depth24
+					position, tokenIndex, depth = position25, tokenIndex25, depth25
+					if !_rules[ruleResumeSourceStmt]() {
+						goto l30
+					}
+					goto l25
+				l30:
+					position, tokenIndex, depth = position25, tokenIndex25, depth25
 					if !_rules[ruleRewindSourceStmt]() {
-						goto l22
+						goto l23
 					}
 				}
-			l24:
+			l25:
 				depth--
-				add(ruleSourceStmt, position23)
+				add(ruleSourceStmt, position24)
 			}
 			return true
-		l22:
-			position, tokenIndex, depth = position22, tokenIndex22, depth22
+		l23:
+			position, tokenIndex, depth = position23, tokenIndex23, depth23
 			return false
 		},
 		/* 5 SinkStmt <- <(CreateSinkStmt / UpdateSinkStmt / DropSinkStmt)> */
 		func() bool {
-			position30, tokenIndex30, depth30 := position, tokenIndex, depth
+			position31, tokenIndex31, depth31 := position, tokenIndex, depth
 			{
-				position31 := position
+				position32 := position
 				depth++
 				{
-					position32, tokenIndex32, depth32 := position, tokenIndex, depth
+					position33, tokenIndex33, depth33 := position, tokenIndex, depth
 					if !_rules[ruleCreateSinkStmt]() {
-						goto l33
-					}
-					goto l32
-				l33:
-					position, tokenIndex, depth = position32, tokenIndex32, depth32
-					if !_rules[ruleUpdateSinkStmt]() {
 						goto l34
 					}
-					goto l32
+					goto l33
 				l34:
-					position, tokenIndex, depth = position32, tokenIndex32, depth32
+					position, tokenIndex, depth = position33, tokenIndex33, depth33
+					if !_rules[ruleUpdateSinkStmt]() {
+						goto l35
+					}
+					goto l33
+				l35:
+					position, tokenIndex, depth = position33, tokenIndex33, depth33
 					if !_rules[ruleDropSinkStmt]() {
-						goto l30
+						goto l31
 					}
 				}
-			l32:
+			l33:
 				depth--
-				add(ruleSinkStmt, position31)
+				add(ruleSinkStmt, position32)
 			}
 			return true
-		l30:
-			position, tokenIndex, depth = position30, tokenIndex30, depth30
+		l31:
+			position, tokenIndex, depth = position31, tokenIndex31, depth31
 			return false
 		},
 		/* 6 StateStmt <- <(CreateStateStmt / UpdateStateStmt / DropStateStmt / LoadStateOrCreateStmt / LoadStateStmt / SaveStateStmt)> */
 		func() bool {
-			position35, tokenIndex35, depth35 := position, tokenIndex, depth
+			position36, tokenIndex36, depth36 := position, tokenIndex, depth
 			{
-				position36 := position
+				position37 := position
 				depth++
 				{
-					position37, tokenIndex37, depth37 := position, tokenIndex, depth
+					position38, tokenIndex38, depth38 := position, tokenIndex, depth
 					if !_rules[ruleCreateStateStmt]() {
-						goto l38
-					}
-					goto l37
-				l38:
-					position, tokenIndex, depth = position37, tokenIndex37, depth37
-					if !_rules[ruleUpdateStateStmt]() {
 						goto l39
 					}
-					goto l37
+					goto l38
 				l39:
-					position, tokenIndex, depth = position37, tokenIndex37, depth37
-					if !_rules[ruleDropStateStmt]() {
+					position, tokenIndex, depth = position38, tokenIndex38, depth38
+					if !_rules[ruleUpdateStateStmt]() {
 						goto l40
 					}
-					goto l37
+					goto l38
 				l40:
-					position, tokenIndex, depth = position37, tokenIndex37, depth37
-					if !_rules[ruleLoadStateOrCreateStmt]() {
+					position, tokenIndex, depth = position38, tokenIndex38, depth38
+					if !_rules[ruleDropStateStmt]() {
 						goto l41
 					}
-					goto l37
+					goto l38
 				l41:
-					position, tokenIndex, depth = position37, tokenIndex37, depth37
-					if !_rules[ruleLoadStateStmt]() {
+					position, tokenIndex, depth = position38, tokenIndex38, depth38
+					if !_rules[ruleLoadStateOrCreateStmt]() {
 						goto l42
 					}
-					goto l37
+					goto l38
 				l42:
-					position, tokenIndex, depth = position37, tokenIndex37, depth37
+					position, tokenIndex, depth = position38, tokenIndex38, depth38
+					if !_rules[ruleLoadStateStmt]() {
+						goto l43
+					}
+					goto l38
+				l43:
+					position, tokenIndex, depth = position38, tokenIndex38, depth38
 					if !_rules[ruleSaveStateStmt]() {
-						goto l35
+						goto l36
 					}
 				}
-			l37:
+			l38:
 				depth--
-				add(ruleStateStmt, position36)
+				add(ruleStateStmt, position37)
 			}
 			return true
-		l35:
-			position, tokenIndex, depth = position35, tokenIndex35, depth35
+		l36:
+			position, tokenIndex, depth = position36, tokenIndex36, depth36
 			return false
 		},
-		/* 7 StreamStmt <- <(CreateStreamAsSelectUnionStmt / CreateStreamAsSelectStmt / DropStreamStmt / InsertIntoFromStmt)> */
+		/* 7 StreamStmt <- <(CreateStreamAsSelectUnionStmt / CreateStreamAsSelectStmt / CreateOrReplaceStreamAsSelectStmt / DropStreamStmt / InsertIntoFromStmt)> */
 		func() bool {
-			position43, tokenIndex43, depth43 := position, tokenIndex, depth
+			position44, tokenIndex44, depth44 := position, tokenIndex, depth
 			{
-				position44 := position
+				position45 := position
 				depth++
 				{
-					position45, tokenIndex45, depth45 := position, tokenIndex, depth
+					position46, tokenIndex46, depth46 := position, tokenIndex, depth
 					if !_rules[ruleCreateStreamAsSelectUnionStmt]() {
-						goto l46
-					}
-					goto l45
-				l46:
-					position, tokenIndex, depth = position45, tokenIndex45, depth45
-					if !_rules[ruleCreateStreamAsSelectStmt]() {
 						goto l47
 					}
-					goto l45
+					goto l46
 				l47:
-					position, tokenIndex, depth = position45, tokenIndex45, depth45
-					if !_rules[ruleDropStreamStmt]() {
+					position, tokenIndex, depth = position46, tokenIndex46, depth46
+					if !_rules[ruleCreateStreamAsSelectStmt]() {
 						goto l48
 					}
-					goto l45
+					goto l46
 				l48:
-					position, tokenIndex, depth = position45, tokenIndex45, depth45
+					position, tokenIndex, depth = position46, tokenIndex46, depth46
+					if !_rules[ruleCreateOrReplaceStreamAsSelectStmt]() {
+						goto l49
+					}
+					goto l46
+				l49:
+					position, tokenIndex, depth = position46, tokenIndex46, depth46
+					if !_rules[ruleDropStreamStmt]() {
+						goto l50
+					}
+					goto l46
+				l50:
+					position, tokenIndex, depth = position46, tokenIndex46, depth46
 					if !_rules[ruleInsertIntoFromStmt]() {
-						goto l43
+						goto l44
 					}
 				}
-			l45:
+			l46:
 				depth--
-				add(ruleStreamStmt, position44)
+				add(ruleStreamStmt, position45)
 			}
 			return true
-		l43:
-			position, tokenIndex, depth = position43, tokenIndex43, depth43
+		l44:
+			position, tokenIndex, depth = position44, tokenIndex44, depth44
 			return false
 		},
-		/* 8 SelectStmt <- <(('s' / 'S') ('e' / 'E') ('l' / 'L') ('e' / 'E') ('c' / 'C') ('t' / 'T') Emitter Projections WindowedFrom Filter Grouping Having Action2)> */
+		/* 8 SelectStmt <- <(('s' / 'S') ('e' / 'E') ('l' / 'L') ('e' / 'E') ('c' / 'C') ('t' / 'T') Emitter Projections WindowedFrom Filter Grouping Having Ordering Limit Action2)> */
 		func() bool {
-			position49, tokenIndex49, depth49 := position, tokenIndex, depth
+			position51, tokenIndex51, depth51 := position, tokenIndex, depth
 			{
-				position50 := position
+				position52 := position
 				depth++
-				{
-					position51, tokenIndex51, depth51 := position, tokenIndex, depth
-					if buffer[position] != rune('s') {
-						goto l52
-					}
-					position++
-					goto l51
-				l52:
-					position, tokenIndex, depth = position51, tokenIndex51, depth51
-					if buffer[position] != rune('S') {
-						goto l49
-					}
-					position++
-				}
-			l51:
 				{
 					position53, tokenIndex53, depth53 := position, tokenIndex, depth
-					if buffer[position] != rune('e') {
+					if buffer[position] != rune('s') {
 						goto l54
 					}
 					position++
 					goto l53
 				l54:
 					position, tokenIndex, depth = position53, tokenIndex53, depth53
-					if buffer[position] != rune('E') {
-						goto l49
+					if buffer[position] != rune('S') {
+						goto l51
 					}
 					position++
 				}
 			l53:
 				{
 					position55, tokenIndex55, depth55 := position, tokenIndex, depth
-					if buffer[position] != rune('l') {
+					if buffer[position] != rune('e') {
 						goto l56
 					}
 					position++
 					goto l55
 				l56:
 					position, tokenIndex, depth = position55, tokenIndex55, depth55
-					if buffer[position] != rune('L') {
-						goto l49
+					if buffer[position] != rune('E') {
+						goto l51
 					}
 					position++
 				}
 			l55:
 				{
 					position57, tokenIndex57, depth57 := position, tokenIndex, depth
-					if buffer[position] != rune('e') {
+					if buffer[position] != rune('l') {
 						goto l58
 					}
 					position++
 					goto l57
 				l58:
 					position, tokenIndex, depth = position57, tokenIndex57, depth57
-					if buffer[position] != rune('E') {
-						goto l49
+					if buffer[position] != rune('L') {
+						goto l51
 					}
 					position++
 				}
 			l57:
 				{
 					position59, tokenIndex59, depth59 := position, tokenIndex, depth
-					if buffer[position] != rune('c') {
+					if buffer[position] != rune('e') {
 						goto l60
 					}
 					position++
 					goto l59
 				l60:
 					position, tokenIndex, depth = position59, tokenIndex59, depth59
-					if buffer[position] != rune('C') {
-						goto l49
+					if buffer[position] != rune('E') {
+						goto l51
 					}
 					position++
 				}
 			l59:
 				{
 					position61, tokenIndex61, depth61 := position, tokenIndex, depth
-					if buffer[position] != rune('t') {
+					if buffer[position] != rune('c') {
 						goto l62
 					}
 					position++
 					goto l61
 				l62:
 					position, tokenIndex, depth = position61, tokenIndex61, depth61
-					if buffer[position] != rune('T') {
-						goto l49
+					if buffer[position] != rune('C') {
+						goto l51
 					}
 					position++
 				}
 			l61:
+				{
+					position63, tokenIndex63, depth63 := position, tokenIndex, depth
+					if buffer[position] != rune('t') {
+						goto l64
+					}
+					position++
+					goto l63
+				l64:
+					position, tokenIndex, depth = position63, tokenIndex63, depth63
+					if buffer[position] != rune('T') {
+						goto l51
+					}
+					position++
+				}
+			l63:
 				if !_rules[ruleEmitter]() {
-					goto l49
+					goto l51
 				}
 				if !_rules[ruleProjections]() {
-					goto l49
+					goto l51
 				}
 				if !_rules[ruleWindowedFrom]() {
-					goto l49
+					goto l51
 				}
 				if !_rules[ruleFilter]() {
-					goto l49
+					goto l51
 				}
 				if !_rules[ruleGrouping]() {
-					goto l49
+					goto l51
 				}
 				if !_rules[ruleHaving]() {
-					goto l49
+					goto l51
+				}
+				if !_rules[ruleOrdering]() {
+					goto l51
+				}
+				if !_rules[ruleLimit]() {
+					goto l51
 				}
 				if !_rules[ruleAction2]() {
-					goto l49
+					goto l51
 				}
 				depth--
-				add(ruleSelectStmt, position50)
+				add(ruleSelectStmt, position52)
 			}
 			return true
-		l49:
-			position, tokenIndex, depth = position49, tokenIndex49, depth49
+		l51:
+			position, tokenIndex, depth = position51, tokenIndex51, depth51
 			return false
 		},
-		/* 9 SelectUnionStmt <- <(<(SelectStmt (sp (('u' / 'U') ('n' / 'N') ('i' / 'I') ('o' / 'O') ('n' / 'N')) sp (('a' / 'A') ('l' / 'L') ('l' / 'L')) sp SelectStmt)+)> Action3)> */
+		/* 9 WithSelectStmt <- <(<(('w' / 'W') ('i' / 'I') ('t' / 'T') ('h' / 'H') sp CommonTableExpression (spOpt ',' spOpt CommonTableExpression)* sp SelectStmt)> Action3)> */
 		func() bool {
-			position63, tokenIndex63, depth63 := position, tokenIndex, depth
+			position65, tokenIndex65, depth65 := position, tokenIndex, depth
 			{
-				position64 := position
+				position66 := position
 				depth++
 				{
-					position65 := position
+					position67 := position
 					depth++
-					if !_rules[ruleSelectStmt]() {
-						goto l63
-					}
-					if !_rules[rulesp]() {
-						goto l63
-					}
 					{
 						position68, tokenIndex68, depth68 := position, tokenIndex, depth
-						if buffer[position] != rune('u') {
+						if buffer[position] != rune('w') {
 							goto l69
 						}
 						position++
 						goto l68
 					l69:
 						position, tokenIndex, depth = position68, tokenIndex68, depth68
-						if buffer[position] != rune('U') {
-							goto l63
+						if buffer[position] != rune('W') {
+							goto l65
 						}
 						position++
 					}
 				l68:
 					{
 						position70, tokenIndex70, depth70 := position, tokenIndex, depth
-						if buffer[position] != rune('n') {
+						if buffer[position] != rune('i') {
 							goto l71
 						}
 						position++
 						goto l70
 					l71:
 						position, tokenIndex, depth = position70, tokenIndex70, depth70
-						if buffer[position] != rune('N') {
-							goto l63
+						if buffer[position] != rune('I') {
+							goto l65
 						}
 						position++
 					}
 				l70:
 					{
 						position72, tokenIndex72, depth72 := position, tokenIndex, depth
-						if buffer[position] != rune('i') {
+						if buffer[position] != rune('t') {
 							goto l73
 						}
 						position++
 						goto l72
 					l73:
 						position, tokenIndex, depth = position72, tokenIndex72, depth72
-						if buffer[position] != rune('I') {
-							goto l63
+						if buffer[position] != rune('T') {
+							goto l65
 						}
 						position++
 					}
 				l72:
 					{
 						position74, tokenIndex74, depth74 := position, tokenIndex, depth
-						if buffer[position] != rune('o') {
+						if buffer[position] != rune('h') {
 							goto l75
 						}
 						position++
 						goto l74
 					l75:
 						position, tokenIndex, depth = position74, tokenIndex74, depth74
-						if buffer[position] != rune('O') {
-							goto l63
+						if buffer[position] != rune('H') {
+							goto l65
 						}
 						position++
 					}
 				l74:
+					if !_rules[rulesp]() {
+						goto l65
+					}
+					if !_rules[ruleCommonTableExpression]() {
+						goto l65
+					}
+				l76:
 					{
-						position76, tokenIndex76, depth76 := position, tokenIndex, depth
-						if buffer[position] != rune('n') {
+						position77, tokenIndex77, depth77 := position, tokenIndex, depth
+						if !_rules[rulespOpt]() {
 							goto l77
 						}
-						position++
-						goto l76
+						if buffer[position] != rune(',') {
+							goto l77
+						}
+						position++
+						if !_rules[rulespOpt]() {
+							goto l77
+						}
+						if !_rules[ruleCommonTableExpression]() {
+							goto l77
+						}
+						goto l76
 					l77:
-						position, tokenIndex, depth = position76, tokenIndex76, depth76
+						position, tokenIndex, depth = position77, tokenIndex77, depth77
+					}
+					if !_rules[rulesp]() {
+						goto l65
+					}
+					if !_rules[ruleSelectStmt]() {
+						goto l65
+					}
+					depth--
+					add(rulePegText, position67)
+				}
+				if !_rules[ruleAction3]() {
+					goto l65
+				}
+				depth--
+				add(ruleWithSelectStmt, position66)
+			}
+			return true
+		l65:
+			position, tokenIndex, depth = position65, tokenIndex65, depth65
+			return false
+		},
+		/* 10 CommonTableExpression <- <(StreamIdentifier sp (('a' / 'A') ('s' / 'S')) spOpt '(' spOpt (WithSelectStmt / SelectStmt) spOpt ')' Action4)> */
+		func() bool {
+			position78, tokenIndex78, depth78 := position, tokenIndex, depth
+			{
+				position79 := position
+				depth++
+				if !_rules[ruleStreamIdentifier]() {
+					goto l78
+				}
+				if !_rules[rulesp]() {
+					goto l78
+				}
+				{
+					position80, tokenIndex80, depth80 := position, tokenIndex, depth
+					if buffer[position] != rune('a') {
+						goto l81
+					}
+					position++
+					goto l80
+				l81:
+					position, tokenIndex, depth = position80, tokenIndex80, depth80
+					if buffer[position] != rune('A') {
+						goto l78
+					}
+					position++
+				}
+			l80:
+				{
+					position82, tokenIndex82, depth82 := position, tokenIndex, depth
+					if buffer[position] != rune('s') {
+						goto l83
+					}
+					position++
+					goto l82
+				l83:
+					position, tokenIndex, depth = position82, tokenIndex82, depth82
+					if buffer[position] != rune('S') {
+						goto l78
+					}
+					position++
+				}
+			l82:
+				if !_rules[rulespOpt]() {
+					goto l78
+				}
+				if buffer[position] != rune('(') {
+					goto l78
+				}
+				position++
+				if !_rules[rulespOpt]() {
+					goto l78
+				}
+				{
+					position84, tokenIndex84, depth84 := position, tokenIndex, depth
+					if !_rules[ruleWithSelectStmt]() {
+						goto l85
+					}
+					goto l84
+				l85:
+					position, tokenIndex, depth = position84, tokenIndex84, depth84
+					if !_rules[ruleSelectStmt]() {
+						goto l78
+					}
+				}
+			l84:
+				if !_rules[rulespOpt]() {
+					goto l78
+				}
+				if buffer[position] != rune(')') {
+					goto l78
+				}
+				position++
+				if !_rules[ruleAction4]() {
+					goto l78
+				}
+				depth--
+				add(ruleCommonTableExpression, position79)
+			}
+			return true
+		l78:
+			position, tokenIndex, depth = position78, tokenIndex78, depth78
+			return false
+		},
+		/* 11 SelectUnionStmt <- <(<(SelectStmt (sp (('u' / 'U') ('n' / 'N') ('i' / 'I') ('o' / 'O') ('n' / 'N')) sp (('a' / 'A') ('l' / 'L') ('l' / 'L')) sp SelectStmt)+)> Action5)> */
+		func() bool {
+			position86, tokenIndex86, depth86 := position, tokenIndex, depth
+			{
+				position87 := position
+				depth++
+				{
+					position88 := position
+					depth++
+					if !_rules[ruleSelectStmt]() {
+						goto l86
+					}
+					if !_rules[rulesp]() {
+						goto l86
+					}
+					{
+						position91, tokenIndex91, depth91 := position, tokenIndex, depth
+						if buffer[position] != rune('u') {
+							goto l92
+						}
+						position++
+						goto l91
+					l92:
+						position, tokenIndex, depth = position91, tokenIndex91, depth91
+						if buffer[position] != rune('U') {
+							goto l86
+						}
+						position++
+					}
+				l91:
+					{
+						position93, tokenIndex93, depth93 := position, tokenIndex, depth
+						if buffer[position] != rune('n') {
+							goto l94
+						}
+						position++
+						goto l93
+					l94:
+						position, tokenIndex, depth = position93, tokenIndex93, depth93
 						if buffer[position] != rune('N') {
-							goto l63
+							goto l86
 						}
 						position++
 					}
-				l76:
+				l93:
+					{
+						position95, tokenIndex95, depth95 := position, tokenIndex, depth
+						if buffer[position] != rune('i') {
+							goto l96
+						}
+						position++
+						goto l95
+					l96:
+						position, tokenIndex, depth = position95, tokenIndex95, depth95
+						if buffer[position] != rune('I') {
+							goto l86
+						}
+						position++
+					}
+				l95:
+					{
+						position97, tokenIndex97, depth97 := position, tokenIndex, depth
+						if buffer[position] != rune('o') {
+							goto l98
+						}
+						position++
+						goto l97
+					l98:
+						position, tokenIndex, depth = position97, tokenIndex97, depth97
+						if buffer[position] != rune('O') {
+							goto l86
+						}
+						position++
+					}
+				l97:
+					{
+						position99, tokenIndex99, depth99 := position, tokenIndex, depth
+						if buffer[position] != rune('n') {
+							goto l100
+						}
+						position++
+						goto l99
+					l100:
+						position, tokenIndex, depth = position99, tokenIndex99, depth99
+						if buffer[position] != rune('N') {
+							goto l86
+						}
+						position++
+					}
+				l99:
 					if !_rules[rulesp]() {
-						goto l63
+						goto l86
 					}
 					{
-						position78, tokenIndex78, depth78 := position, tokenIndex, depth
+						position101, tokenIndex101, depth101 := position, tokenIndex, depth
 						if buffer[position] != rune('a') {
-							goto l79
+							goto l102
 						}
 						position++
-						goto l78
-					l79:
-						position, tokenIndex, depth = position78, tokenIndex78, depth78
+						goto l101
+					l102:
+						position, tokenIndex, depth = position101, tokenIndex101, depth101
 						if buffer[position] != rune('A') {
-							goto l63
+							goto l86
 						}
 						position++
 					}
-				l78:
+				l101:
 					{
-						position80, tokenIndex80, depth80 := position, tokenIndex, depth
+						position103, tokenIndex103, depth103 := position, tokenIndex, depth
 						if buffer[position] != rune('l') {
-							goto l81
+							goto l104
 						}
 						position++
-						goto l80
-					l81:
-						position, tokenIndex, depth = position80, tokenIndex80, depth80
+						goto l103
+					l104:
+						position, tokenIndex, depth = position103, tokenIndex103, depth103
 						if buffer[position] != rune('L') {
-							goto l63
+							goto l86
 						}
 						position++
 					}
-				l80:
+				l103:
 					{
-						position82, tokenIndex82, depth82 := position, tokenIndex, depth
+						position105, tokenIndex105, depth105 := position, tokenIndex, depth
 						if buffer[position] != rune('l') {
-							goto l83
+							goto l106
 						}
 						position++
-						goto l82
-					l83:
-						position, tokenIndex, depth = position82, tokenIndex82, depth82
+						goto l105
+					l106:
+						position, tokenIndex, depth = position105, tokenIndex105, depth105
 						if buffer[position] != rune('L') {
-							goto l63
+							goto l86
 						}
 						position++
 					}
-				l82:
+				l105:
 					if !_rules[rulesp]() {
-						goto l63
+						goto l86
 					}
 					if !_rules[ruleSelectStmt]() {
-						goto l63
+						goto l86
 					}
-				l66:
+				l89:
 					{
-						position67, tokenIndex67, depth67 := position, tokenIndex, depth
+						position90, tokenIndex90, depth90 := position, tokenIndex, depth
 						if !_rules[rulesp]() {
-							goto l67
+							goto l90
 						}
 						{
-							position84, tokenIndex84, depth84 := position, tokenIndex, depth
+							position107, tokenIndex107, depth107 := position, tokenIndex, depth
 							if buffer[position] != rune('u') {
-								goto l85
+								goto l108
 							}
 							position++
-							goto l84
-						l85:
-							position, tokenIndex, depth = position84, tokenIndex84, depth84
+							goto l107
+						l108:
+							position, tokenIndex, depth = position107, tokenIndex107, depth107
 							if buffer[position] != rune('U') {
-								goto l67
+								goto l90
 							}
 							position++
 						}
-					l84:
+					l107:
 						{
-							position86, tokenIndex86, depth86 := position, tokenIndex, depth
+							position109, tokenIndex109, depth109 := position, tokenIndex, depth
 							if buffer[position] != rune('n') {
-								goto l87
+								goto l110
 							}
 							position++
-							goto l86
-						l87:
-							position, tokenIndex, depth = position86, tokenIndex86, depth86
+							goto l109
+						l110:
+							position, tokenIndex, depth = position109, tokenIndex109, depth109
 							if buffer[position] != rune('N') {
-								goto l67
+								goto l90
 							}
 							position++
 						}
-					l86:
+					l109:
 						{
-							position88, tokenIndex88, depth88 := position, tokenIndex, depth
+							position111, tokenIndex111, depth111 := position, tokenIndex, depth
 							if buffer[position] != rune('i') {
-								goto l89
+								goto l112
 							}
 							position++
-							goto l88
-						l89:
-							position, tokenIndex, depth = position88, tokenIndex88, depth88
+							goto l111
+						l112:
+							position, tokenIndex, depth = position111, tokenIndex111, depth111
 							if buffer[position] != rune('I') {
-								goto l67
+								goto l90
 							}
 							position++
 						}
-					l88:
+					l111:
 						{
-							position90, tokenIndex90, depth90 := position, tokenIndex, depth
+							position113, tokenIndex113, depth113 := position, tokenIndex, depth
 							if buffer[position] != rune('o') {
-								goto l91
+								goto l114
 							}
 							position++
-							goto l90
-						l91:
-							position, tokenIndex, depth = position90, tokenIndex90, depth90
+							goto l113
+						l114:
+							position, tokenIndex, depth = position113, tokenIndex113, depth113
 							if buffer[position] != rune('O') {
-								goto l67
+								goto l90
 							}
 							position++
 						}
-					l90:
+					l113:
 						{
-							position92, tokenIndex92, depth92 := position, tokenIndex, depth
+							position115, tokenIndex115, depth115 := position, tokenIndex, depth
 							if buffer[position] != rune('n') {
-								goto l93
+								goto l116
 							}
 							position++
-							goto l92
-						l93:
-							position, tokenIndex, depth = position92, tokenIndex92, depth92
+							goto l115
+						l116:
+							position, tokenIndex, depth = position115, tokenIndex115, depth115
 							if buffer[position] != rune('N') {
-								goto l67
+								goto l90
 							}
 							position++
 						}
-					l92:
+					l115:
 						if !_rules[rulesp]() {
-							goto l67
+							goto l90
 						}
 						{
-							position94, tokenIndex94, depth94 := position, tokenIndex, depth
+							position117, tokenIndex117, depth117 := position, tokenIndex, depth
 							if buffer[position] != rune('a') {
-								goto l95
+								goto l118
 							}
 							position++
-							goto l94
-						l95:
-							position, tokenIndex, depth = position94, tokenIndex94, depth94
+							goto l117
+						l118:
+							position, tokenIndex, depth = position117, tokenIndex117, depth117
 							if buffer[position] != rune('A') {
-								goto l67
+								goto l90
 							}
 							position++
 						}
-					l94:
+					l117:
 						{
-							position96, tokenIndex96, depth96 := position, tokenIndex, depth
+							position119, tokenIndex119, depth119 := position, tokenIndex, depth
 							if buffer[position] != rune('l') {
-								goto l97
+								goto l120
 							}
 							position++
-							goto l96
-						l97:
-							position, tokenIndex, depth = position96, tokenIndex96, depth96
+							goto l119
+						l120:
+							position, tokenIndex, depth = position119, tokenIndex119, depth119
 							if buffer[position] != rune('L') {
-								goto l67
+								goto l90
 							}
 							position++
 						}
-					l96:
+					l119:
 						{
-							position98, tokenIndex98, depth98 := position, tokenIndex, depth
+							position121, tokenIndex121, depth121 := position, tokenIndex, depth
 							if buffer[position] != rune('l') {
-								goto l99
+								goto l122
 							}
 							position++
-							goto l98
-						l99:
-							position, tokenIndex, depth = position98, tokenIndex98, depth98
+							goto l121
+						l122:
+							position, tokenIndex, depth = position121, tokenIndex121, depth121
 							if buffer[position] != rune('L') {
-								goto l67
+								goto l90
 							}
 							position++
 						}
-					l98:
+					l121:
 						if !_rules[rulesp]() {
-							goto l67
+							goto l90
 						}
 						if !_rules[ruleSelectStmt]() {
-							goto l67
+							goto l90
 						}
-						goto l66
-					l67:
-						position, tokenIndex, depth = position67, tokenIndex67, depth67
+						goto l89
+					l90:
+						position, tokenIndex, depth = position90, tokenIndex90, depth90
 					}
 					depth--
-					add(rulePegText, position65)
+					add(rulePegText, position88)
 				}
-				if !_rules[ruleAction3]() {
-					goto l63
+				if !_rules[ruleAction5]() {
+					goto l86
 				}
 				depth--
-				add(ruleSelectUnionStmt, position64)
+				add(ruleSelectUnionStmt, position87)
 			}
 			return true
-		l63:
-			position, tokenIndex, depth = position63, tokenIndex63, depth63
+		l86:
+			position, tokenIndex, depth = position86, tokenIndex86, depth86
 			return false
 		},
-		/* 10 CreateStreamAsSelectStmt <- <(('c' / 'C') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M')) sp StreamIdentifier sp (('a' / 'A') ('s' / 'S')) sp SelectStmt Action4)> */
+		/* 12 CreateStreamAsSelectStmt <- <(('c' / 'C') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M')) sp StreamIdentifier SourceSinkSpecs sp (('a' / 'A') ('s' / 'S')) sp (WithSelectStmt / SelectStmt) Action6)> */
 		func() bool {
-			position100, tokenIndex100, depth100 := position, tokenIndex, depth
+			position123, tokenIndex123, depth123 := position, tokenIndex, depth
 			{
-				position101 := position
+				position124 := position
 				depth++
 				{
-					position102, tokenIndex102, depth102 := position, tokenIndex, depth
+					position125, tokenIndex125, depth125 := position, tokenIndex, depth
 					if buffer[position] != rune('c') {
-						goto l103
+						goto l126
 					}
 					position++
-					goto l102
-				l103:
-					position, tokenIndex, depth = position102, tokenIndex102, depth102
+					goto l125
+				l126:
+					position, tokenIndex, depth = position125, tokenIndex125, depth125
 					if buffer[position] != rune('C') {
-						goto l100
+						goto l123
 					}
 					position++
 				}
-			l102:
+			l125:
 				{
-					position104, tokenIndex104, depth104 := position, tokenIndex, depth
+					position127, tokenIndex127, depth127 := position, tokenIndex, depth
 					if buffer[position] != rune('r') {
-						goto l105
+						goto l128
 					}
 					position++
-					goto l104
-				l105:
-					position, tokenIndex, depth = position104, tokenIndex104, depth104
+					goto l127
+				l128:
+					position, tokenIndex, depth = position127, tokenIndex127, depth127
 					if buffer[position] != rune('R') {
-						goto l100
+						goto l123
 					}
 					position++
 				}
-			l104:
+			l127:
 				{
-					position106, tokenIndex106, depth106 := position, tokenIndex, depth
+					position129, tokenIndex129, depth129 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l107
+						goto l130
 					}
 					position++
-					goto l106
-				l107:
-					position, tokenIndex, depth = position106, tokenIndex106, depth106
+					goto l129
+				l130:
+					position, tokenIndex, depth = position129, tokenIndex129, depth129
 					if buffer[position] != rune('E') {
-						goto l100
+						goto l123
 					}
 					position++
 				}
-			l106:
+			l129:
 				{
-					position108, tokenIndex108, depth108 := position, tokenIndex, depth
+					position131, tokenIndex131, depth131 := position, tokenIndex, depth
 					if buffer[position] != rune('a') {
-						goto l109
+						goto l132
 					}
 					position++
-					goto l108
-				l109:
-					position, tokenIndex, depth = position108, tokenIndex108, depth108
+					goto l131
+				l132:
+					position, tokenIndex, depth = position131, tokenIndex131, depth131
 					if buffer[position] != rune('A') {
-						goto l100
+						goto l123
 					}
 					position++
 				}
-			l108:
+			l131:
 				{
-					position110, tokenIndex110, depth110 := position, tokenIndex, depth
+					position133, tokenIndex133, depth133 := position, tokenIndex, depth
 					if buffer[position] != rune('t') {
-						goto l111
+						goto l134
 					}
 					position++
-					goto l110
-				l111:
-					position, tokenIndex, depth = position110, tokenIndex110, depth110
+					goto l133
+				l134:
+					position, tokenIndex, depth = position133, tokenIndex133, depth133
 					if buffer[position] != rune('T') {
-						goto l100
+						goto l123
 					}
 					position++
 				}
-			l110:
+			l133:
 				{
-					position112, tokenIndex112, depth112 := position, tokenIndex, depth
+					position135, tokenIndex135, depth135 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l113
+						goto l136
 					}
 					position++
-					goto l112
-				l113:
-					position, tokenIndex, depth = position112, tokenIndex112, depth112
+					goto l135
+				l136:
+					position, tokenIndex, depth = position135, tokenIndex135, depth135
 					if buffer[position] != rune('E') {
-						goto l100
+						goto l123
 					}
 					position++
 				}
-			l112:
+			l135:
 				if !_rules[rulesp]() {
-					goto l100
+					goto l123
 				}
 				{
-					position114, tokenIndex114, depth114 := position, tokenIndex, depth
+					position137, tokenIndex137, depth137 := position, tokenIndex, depth
 					if buffer[position] != rune('s') {
-						goto l115
+						goto l138
 					}
 					position++
-					goto l114
-				l115:
-					position, tokenIndex, depth = position114, tokenIndex114, depth114
+					goto l137
+				l138:
+					position, tokenIndex, depth = position137, tokenIndex137, depth137
 					if buffer[position] != rune('S') {
-						goto l100
+						goto l123
 					}
 					position++
 				}
-			l114:
+			l137:
 				{
-					position116, tokenIndex116, depth116 := position, tokenIndex, depth
+					position139, tokenIndex139, depth139 := position, tokenIndex, depth
 					if buffer[position] != rune('t') {
-						goto l117
+						goto l140
 					}
 					position++
-					goto l116
-				l117:
-					position, tokenIndex, depth = position116, tokenIndex116, depth116
+					goto l139
+				l140:
+					position, tokenIndex, depth = position139, tokenIndex139, depth139
 					if buffer[position] != rune('T') {
-						goto l100
+						goto l123
 					}
 					position++
 				}
-			l116:
+			l139:
 				{
-					position118, tokenIndex118, depth118 := position, tokenIndex, depth
+					position141, tokenIndex141, depth141 := position, tokenIndex, depth
 					if buffer[position] != rune('r') {
-						goto l119
+						goto l142
 					}
 					position++
-					goto l118
-				l119:
-					position, tokenIndex, depth = position118, tokenIndex118, depth118
+					goto l141
+				l142:
+					position, tokenIndex, depth = position141, tokenIndex141, depth141
 					if buffer[position] != rune('R') {
-						goto l100
+						goto l123
 					}
 					position++
 				}
-			l118:
+			l141:
 				{
-					position120, tokenIndex120, depth120 := position, tokenIndex, depth
+					position143, tokenIndex143, depth143 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l121
+						goto l144
 					}
 					position++
-					goto l120
-				l121:
-					position, tokenIndex, depth = position120, tokenIndex120, depth120
+					goto l143
+				l144:
+					position, tokenIndex, depth = position143, tokenIndex143, depth143
 					if buffer[position] != rune('E') {
-						goto l100
+						goto l123
 					}
 					position++
 				}
-			l120:
+			l143:
 				{
-					position122, tokenIndex122, depth122 := position, tokenIndex, depth
+					position145, tokenIndex145, depth145 := position, tokenIndex, depth
 					if buffer[position] != rune('a') {
-						goto l123
+						goto l146
 					}
 					position++
-					goto l122
-				l123:
-					position, tokenIndex, depth = position122, tokenIndex122, depth122
+					goto l145
+				l146:
+					position, tokenIndex, depth = position145, tokenIndex145, depth145
 					if buffer[position] != rune('A') {
-						goto l100
+						goto l123
 					}
 					position++
 				}
-			l122:
+			l145:
 				{
-					position124, tokenIndex124, depth124 := position, tokenIndex, depth
+					position147, tokenIndex147, depth147 := position, tokenIndex, depth
 					if buffer[position] != rune('m') {
-						goto l125
+						goto l148
 					}
 					position++
-					goto l124
-				l125:
-					position, tokenIndex, depth = position124, tokenIndex124, depth124
+					goto l147
+				l148:
+					position, tokenIndex, depth = position147, tokenIndex147, depth147
 					if buffer[position] != rune('M') {
-						goto l100
+						goto l123
 					}
 					position++
 				}
-			l124:
+			l147:
 				if !_rules[rulesp]() {
-					goto l100
+					goto l123
 				}
 				if !_rules[ruleStreamIdentifier]() {
-					goto l100
+					goto l123
+				}
+				if !_rules[ruleSourceSinkSpecs]() {
+					goto l123
 				}
 				if !_rules[rulesp]() {
-					goto l100
+					goto l123
 				}
 				{
-					position126, tokenIndex126, depth126 := position, tokenIndex, depth
+					position149, tokenIndex149, depth149 := position, tokenIndex, depth
 					if buffer[position] != rune('a') {
-						goto l127
+						goto l150
 					}
 					position++
-					goto l126
-				l127:
-					position, tokenIndex, depth = position126, tokenIndex126, depth126
+					goto l149
+				l150:
+					position, tokenIndex, depth = position149, tokenIndex149, depth149
 					if buffer[position] != rune('A') {
-						goto l100
+						goto l123
 					}
 					position++
 				}
-			l126:
+			l149:
 				{
-					position128, tokenIndex128, depth128 := position, tokenIndex, depth
+					position151, tokenIndex151, depth151 := position, tokenIndex, depth
 					if buffer[position] != rune('s') {
-						goto l129
+						goto l152
 					}
 					position++
-					goto l128
-				l129:
-					position, tokenIndex, depth = position128, tokenIndex128, depth128
+					goto l151
+				l152:
+					position, tokenIndex, depth = position151, tokenIndex151, depth151
 					if buffer[position] != rune('S') {
-						goto l100
+						goto l123
 					}
 					position++
 				}
-			l128:
+			l151:
 				if !_rules[rulesp]() {
-					goto l100
+					goto l123
 				}
-				if !_rules[ruleSelectStmt]() {
-					goto l100
+				{
+					position153, tokenIndex153, depth153 := position, tokenIndex, depth
+					if !_rules[ruleWithSelectStmt]() {
+						goto l154
+					}
+					goto l153
+				l154:
+					position, tokenIndex, depth = position153, tokenIndex153, depth153
+					if !_rules[ruleSelectStmt]() {
+						goto l123
+					}
 				}
-				if !_rules[ruleAction4]() {
-					goto l100
+			l153:
+				if !_rules[ruleAction6]() {
+					goto l123
 				}
 				depth--
-				add(ruleCreateStreamAsSelectStmt, position101)
+				add(ruleCreateStreamAsSelectStmt, position124)
 			}
 			return true
-		l100:
-			position, tokenIndex, depth = position100, tokenIndex100, depth100
+		l123:
+			position, tokenIndex, depth = position123, tokenIndex123, depth123
 			return false
 		},
-		/* 11 CreateStreamAsSelectUnionStmt <- <(('c' / 'C') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M')) sp StreamIdentifier sp (('a' / 'A') ('s' / 'S')) sp SelectUnionStmt Action5)> */
+		/* 13 CreateOrReplaceStreamAsSelectStmt <- <(('c' / 'C') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('o' / 'O') ('r' / 'R')) sp (('r' / 'R') ('e' / 'E') ('p' / 'P') ('l' / 'L') ('a' / 'A') ('c' / 'C') ('e' / 'E')) sp (('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M')) sp StreamIdentifier SourceSinkSpecs sp (('a' / 'A') ('s' / 'S')) sp (WithSelectStmt / SelectStmt) Action7)> */
 		func() bool {
-			position130, tokenIndex130, depth130 := position, tokenIndex, depth
+			position155, tokenIndex155, depth155 := position, tokenIndex, depth
 			{
-				position131 := position
+				position156 := position
 				depth++
 				{
-					position132, tokenIndex132, depth132 := position, tokenIndex, depth
+					position157, tokenIndex157, depth157 := position, tokenIndex, depth
 					if buffer[position] != rune('c') {
-						goto l133
+						goto l158
 					}
 					position++
-					goto l132
-				l133:
-					position, tokenIndex, depth = position132, tokenIndex132, depth132
+					goto l157
+				l158:
+					position, tokenIndex, depth = position157, tokenIndex157, depth157
 					if buffer[position] != rune('C') {
-						goto l130
+						goto l155
 					}
 					position++
 				}
-			l132:
+			l157:
 				{
-					position134, tokenIndex134, depth134 := position, tokenIndex, depth
+					position159, tokenIndex159, depth159 := position, tokenIndex, depth
 					if buffer[position] != rune('r') {
-						goto l135
+						goto l160
 					}
 					position++
-					goto l134
-				l135:
-					position, tokenIndex, depth = position134, tokenIndex134, depth134
+					goto l159
+				l160:
+					position, tokenIndex, depth = position159, tokenIndex159, depth159
 					if buffer[position] != rune('R') {
-						goto l130
+						goto l155
 					}
 					position++
 				}
-			l134:
+			l159:
 				{
-					position136, tokenIndex136, depth136 := position, tokenIndex, depth
+					position161, tokenIndex161, depth161 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l137
+						goto l162
 					}
 					position++
-					goto l136
-				l137:
-					position, tokenIndex, depth = position136, tokenIndex136, depth136
+					goto l161
+				l162:
+					position, tokenIndex, depth = position161, tokenIndex161, depth161
 					if buffer[position] != rune('E') {
-						goto l130
+						goto l155
 					}
 					position++
 				}
-			l136:
+			l161:
 				{
-					position138, tokenIndex138, depth138 := position, tokenIndex, depth
+					position163, tokenIndex163, depth163 := position, tokenIndex, depth
 					if buffer[position] != rune('a') {
-						goto l139
+						goto l164
 					}
 					position++
-					goto l138
-				l139:
-					position, tokenIndex, depth = position138, tokenIndex138, depth138
+					goto l163
+				l164:
+					position, tokenIndex, depth = position163, tokenIndex163, depth163
 					if buffer[position] != rune('A') {
-						goto l130
+						goto l155
 					}
 					position++
 				}
-			l138:
+			l163:
 				{
-					position140, tokenIndex140, depth140 := position, tokenIndex, depth
+					position165, tokenIndex165, depth165 := position, tokenIndex, depth
 					if buffer[position] != rune('t') {
-						goto l141
+						goto l166
 					}
 					position++
-					goto l140
-				l141:
-					position, tokenIndex, depth = position140, tokenIndex140, depth140
+					goto l165
+				l166:
+					position, tokenIndex, depth = position165, tokenIndex165, depth165
 					if buffer[position] != rune('T') {
-						goto l130
+						goto l155
 					}
 					position++
 				}
-			l140:
+			l165:
 				{
-					position142, tokenIndex142, depth142 := position, tokenIndex, depth
+					position167, tokenIndex167, depth167 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l143
+						goto l168
 					}
 					position++
-					goto l142
-				l143:
-					position, tokenIndex, depth = position142, tokenIndex142, depth142
+					goto l167
+				l168:
+					position, tokenIndex, depth = position167, tokenIndex167, depth167
 					if buffer[position] != rune('E') {
-						goto l130
+						goto l155
 					}
 					position++
 				}
-			l142:
+			l167:
 				if !_rules[rulesp]() {
-					goto l130
+					goto l155
 				}
 				{
-					position144, tokenIndex144, depth144 := position, tokenIndex, depth
-					if buffer[position] != rune('s') {
-						goto l145
+					position169, tokenIndex169, depth169 := position, tokenIndex, depth
+					if buffer[position] != rune('o') {
+						goto l170
 					}
 					position++
-					goto l144
-				l145:
-					position, tokenIndex, depth = position144, tokenIndex144, depth144
-					if buffer[position] != rune('S') {
-						goto l130
+					goto l169
+				l170:
+					position, tokenIndex, depth = position169, tokenIndex169, depth169
+					if buffer[position] != rune('O') {
+						goto l155
 					}
 					position++
 				}
-			l144:
+			l169:
 				{
-					position146, tokenIndex146, depth146 := position, tokenIndex, depth
-					if buffer[position] != rune('t') {
-						goto l147
+					position171, tokenIndex171, depth171 := position, tokenIndex, depth
+					if buffer[position] != rune('r') {
+						goto l172
 					}
 					position++
-					goto l146
-				l147:
-					position, tokenIndex, depth = position146, tokenIndex146, depth146
-					if buffer[position] != rune('T') {
-						goto l130
+					goto l171
+				l172:
+					position, tokenIndex, depth = position171, tokenIndex171, depth171
+					if buffer[position] != rune('R') {
+						goto l155
 					}
 					position++
 				}
-			l146:
+			l171:
+				if !_rules[rulesp]() {
+					goto l155
+				}
 				{
-					position148, tokenIndex148, depth148 := position, tokenIndex, depth
+					position173, tokenIndex173, depth173 := position, tokenIndex, depth
 					if buffer[position] != rune('r') {
-						goto l149
+						goto l174
 					}
 					position++
-					goto l148
-				l149:
-					position, tokenIndex, depth = position148, tokenIndex148, depth148
+					goto l173
+				l174:
+					position, tokenIndex, depth = position173, tokenIndex173, depth173
 					if buffer[position] != rune('R') {
-						goto l130
+						goto l155
 					}
 					position++
 				}
-			l148:
+			l173:
 				{
-					position150, tokenIndex150, depth150 := position, tokenIndex, depth
+					position175, tokenIndex175, depth175 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l151
+						goto l176
 					}
 					position++
-					goto l150
-				l151:
-					position, tokenIndex, depth = position150, tokenIndex150, depth150
+					goto l175
+				l176:
+					position, tokenIndex, depth = position175, tokenIndex175, depth175
 					if buffer[position] != rune('E') {
-						goto l130
+						goto l155
 					}
 					position++
 				}
-			l150:
+			l175:
 				{
-					position152, tokenIndex152, depth152 := position, tokenIndex, depth
-					if buffer[position] != rune('a') {
-						goto l153
+					position177, tokenIndex177, depth177 := position, tokenIndex, depth
+					if buffer[position] != rune('p') {
+						goto l178
 					}
 					position++
-					goto l152
-				l153:
-					position, tokenIndex, depth = position152, tokenIndex152, depth152
-					if buffer[position] != rune('A') {
-						goto l130
+					goto l177
+				l178:
+					position, tokenIndex, depth = position177, tokenIndex177, depth177
+					if buffer[position] != rune('P') {
+						goto l155
 					}
 					position++
 				}
-			l152:
+			l177:
 				{
-					position154, tokenIndex154, depth154 := position, tokenIndex, depth
-					if buffer[position] != rune('m') {
-						goto l155
+					position179, tokenIndex179, depth179 := position, tokenIndex, depth
+					if buffer[position] != rune('l') {
+						goto l180
 					}
 					position++
-					goto l154
-				l155:
-					position, tokenIndex, depth = position154, tokenIndex154, depth154
-					if buffer[position] != rune('M') {
-						goto l130
+					goto l179
+				l180:
+					position, tokenIndex, depth = position179, tokenIndex179, depth179
+					if buffer[position] != rune('L') {
+						goto l155
 					}
 					position++
 				}
-			l154:
-				if !_rules[rulesp]() {
-					goto l130
-				}
-				if !_rules[ruleStreamIdentifier]() {
-					goto l130
-				}
-				if !_rules[rulesp]() {
-					goto l130
-				}
+			l179:
 				{
-					position156, tokenIndex156, depth156 := position, tokenIndex, depth
+					position181, tokenIndex181, depth181 := position, tokenIndex, depth
 					if buffer[position] != rune('a') {
-						goto l157
+						goto l182
 					}
 					position++
-					goto l156
-				l157:
-					position, tokenIndex, depth = position156, tokenIndex156, depth156
+					goto l181
+				l182:
+					position, tokenIndex, depth = position181, tokenIndex181, depth181
 					if buffer[position] != rune('A') {
-						goto l130
+						goto l155
 					}
 					position++
 				}
-			l156:
+			l181:
 				{
-					position158, tokenIndex158, depth158 := position, tokenIndex, depth
-					if buffer[position] != rune('s') {
-						goto l159
+					position183, tokenIndex183, depth183 := position, tokenIndex, depth
+					if buffer[position] != rune('c') {
+						goto l184
 					}
 					position++
-					goto l158
-				l159:
-					position, tokenIndex, depth = position158, tokenIndex158, depth158
-					if buffer[position] != rune('S') {
-						goto l130
+					goto l183
+				l184:
+					position, tokenIndex, depth = position183, tokenIndex183, depth183
+					if buffer[position] != rune('C') {
+						goto l155
+					}
+					position++
+				}
+			l183:
+				{
+					position185, tokenIndex185, depth185 := position, tokenIndex, depth
+					if buffer[position] != rune('e') {
+						goto l186
+					}
+					position++
+					goto l185
+				l186:
+					position, tokenIndex, depth = position185, tokenIndex185, depth185
+					if buffer[position] != rune('E') {
+						goto l155
 					}
 					position++
 				}
-			l158:
+			l185:
 				if !_rules[rulesp]() {
-					goto l130
+					goto l155
 				}
-				if !_rules[ruleSelectUnionStmt]() {
-					goto l130
+				{
+					position187, tokenIndex187, depth187 := position, tokenIndex, depth
+					if buffer[position] != rune('s') {
+						goto l188
+					}
+					position++
+					goto l187
+				l188:
+					position, tokenIndex, depth = position187, tokenIndex187, depth187
+					if buffer[position] != rune('S') {
+						goto l155
+					}
+					position++
 				}
-				if !_rules[ruleAction5]() {
-					goto l130
+			l187:
+				{
+					position189, tokenIndex189, depth189 := position, tokenIndex, depth
+					if buffer[position] != rune('t') {
+						goto l190
+					}
+					position++
+					goto l189
+				l190:
+					position, tokenIndex, depth = position189, tokenIndex189, depth189
+					if buffer[position] != rune('T') {
+						goto l155
+					}
+					position++
+				}
+			l189:
+				{
+					position191, tokenIndex191, depth191 := position, tokenIndex, depth
+					if buffer[position] != rune('r') {
+						goto l192
+					}
+					position++
+					goto l191
+				l192:
+					position, tokenIndex, depth = position191, tokenIndex191, depth191
+					if buffer[position] != rune('R') {
+						goto l155
+					}
+					position++
+				}
+			l191:
+				{
+					position193, tokenIndex193, depth193 := position, tokenIndex, depth
+					if buffer[position] != rune('e') {
+						goto l194
+					}
+					position++
+					goto l193
+				l194:
+					position, tokenIndex, depth = position193, tokenIndex193, depth193
+					if buffer[position] != rune('E') {
+						goto l155
+					}
+					position++
+				}
+			l193:
+				{
+					position195, tokenIndex195, depth195 := position, tokenIndex, depth
+					if buffer[position] != rune('a') {
+						goto l196
+					}
+					position++
+					goto l195
+				l196:
+					position, tokenIndex, depth = position195, tokenIndex195, depth195
+					if buffer[position] != rune('A') {
+						goto l155
+					}
+					position++
+				}
+			l195:
+				{
+					position197, tokenIndex197, depth197 := position, tokenIndex, depth
+					if buffer[position] != rune('m') {
+						goto l198
+					}
+					position++
+					goto l197
+				l198:
+					position, tokenIndex, depth = position197, tokenIndex197, depth197
+					if buffer[position] != rune('M') {
+						goto l155
+					}
+					position++
+				}
+			l197:
+				if !_rules[rulesp]() {
+					goto l155
+				}
+				if !_rules[ruleStreamIdentifier]() {
+					goto l155
+				}
+				if !_rules[ruleSourceSinkSpecs]() {
+					goto l155
+				}
+				if !_rules[rulesp]() {
+					goto l155
+				}
+				{
+					position199, tokenIndex199, depth199 := position, tokenIndex, depth
+					if buffer[position] != rune('a') {
+						goto l200
+					}
+					position++
+					goto l199
+				l200:
+					position, tokenIndex, depth = position199, tokenIndex199, depth199
+					if buffer[position] != rune('A') {
+						goto l155
+					}
+					position++
+				}
+			l199:
+				{
+					position201, tokenIndex201, depth201 := position, tokenIndex, depth
+					if buffer[position] != rune('s') {
+						goto l202
+					}
+					position++
+					goto l201
+				l202:
+					position, tokenIndex, depth = position201, tokenIndex201, depth201
+					if buffer[position] != rune('S') {
+						goto l155
+					}
+					position++
+				}
+			l201:
+				if !_rules[rulesp]() {
+					goto l155
+				}
+				{
+					position203, tokenIndex203, depth203 := position, tokenIndex, depth
+					if !_rules[ruleWithSelectStmt]() {
+						goto l204
+					}
+					goto l203
+				l204:
+					position, tokenIndex, depth = position203, tokenIndex203, depth203
+					if !_rules[ruleSelectStmt]() {
+						goto l155
+					}
+				}
+			l203:
+				if !_rules[ruleAction7]() {
+					goto l155
 				}
 				depth--
-				add(ruleCreateStreamAsSelectUnionStmt, position131)
+				add(ruleCreateOrReplaceStreamAsSelectStmt, position156)
 			}
 			return true
-		l130:
-			position, tokenIndex, depth = position130, tokenIndex130, depth130
+		l155:
+			position, tokenIndex, depth = position155, tokenIndex155, depth155
 			return false
 		},
-		/* 12 CreateSourceStmt <- <(('c' / 'C') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('t' / 'T') ('e' / 'E') PausedOpt sp (('s' / 'S') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('c' / 'C') ('e' / 'E')) sp StreamIdentifier sp (('t' / 'T') ('y' / 'Y') ('p' / 'P') ('e' / 'E')) sp SourceSinkType SourceSinkSpecs Action6)> */
+		/* 14 CreateStreamAsSelectUnionStmt <- <(('c' / 'C') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M')) sp StreamIdentifier sp (('a' / 'A') ('s' / 'S')) sp SelectUnionStmt Action8)> */
 		func() bool {
-			position160, tokenIndex160, depth160 := position, tokenIndex, depth
+			position205, tokenIndex205, depth205 := position, tokenIndex, depth
 			{
-				position161 := position
+				position206 := position
 				depth++
 				{
-					position162, tokenIndex162, depth162 := position, tokenIndex, depth
+					position207, tokenIndex207, depth207 := position, tokenIndex, depth
 					if buffer[position] != rune('c') {
-						goto l163
+						goto l208
 					}
 					position++
-					goto l162
-				l163:
-					position, tokenIndex, depth = position162, tokenIndex162, depth162
+					goto l207
+				l208:
+					position, tokenIndex, depth = position207, tokenIndex207, depth207
 					if buffer[position] != rune('C') {
-						goto l160
+						goto l205
 					}
 					position++
 				}
-			l162:
+			l207:
 				{
-					position164, tokenIndex164, depth164 := position, tokenIndex, depth
+					position209, tokenIndex209, depth209 := position, tokenIndex, depth
 					if buffer[position] != rune('r') {
-						goto l165
+						goto l210
 					}
 					position++
-					goto l164
-				l165:
-					position, tokenIndex, depth = position164, tokenIndex164, depth164
+					goto l209
+				l210:
+					position, tokenIndex, depth = position209, tokenIndex209, depth209
 					if buffer[position] != rune('R') {
-						goto l160
+						goto l205
 					}
 					position++
 				}
-			l164:
+			l209:
 				{
-					position166, tokenIndex166, depth166 := position, tokenIndex, depth
+					position211, tokenIndex211, depth211 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l167
+						goto l212
 					}
 					position++
-					goto l166
-				l167:
-					position, tokenIndex, depth = position166, tokenIndex166, depth166
+					goto l211
+				l212:
+					position, tokenIndex, depth = position211, tokenIndex211, depth211
 					if buffer[position] != rune('E') {
-						goto l160
+						goto l205
 					}
 					position++
 				}
-			l166:
+			l211:
 				{
-					position168, tokenIndex168, depth168 := position, tokenIndex, depth
+					position213, tokenIndex213, depth213 := position, tokenIndex, depth
 					if buffer[position] != rune('a') {
-						goto l169
+						goto l214
 					}
 					position++
-					goto l168
-				l169:
-					position, tokenIndex, depth = position168, tokenIndex168, depth168
+					goto l213
+				l214:
+					position, tokenIndex, depth = position213, tokenIndex213, depth213
 					if buffer[position] != rune('A') {
-						goto l160
+						goto l205
 					}
 					position++
 				}
-			l168:
+			l213:
 				{
-					position170, tokenIndex170, depth170 := position, tokenIndex, depth
+					position215, tokenIndex215, depth215 := position, tokenIndex, depth
 					if buffer[position] != rune('t') {
-						goto l171
+						goto l216
 					}
 					position++
-					goto l170
-				l171:
-					position, tokenIndex, depth = position170, tokenIndex170, depth170
+					goto l215
+				l216:
+					position, tokenIndex, depth = position215, tokenIndex215, depth215
 					if buffer[position] != rune('T') {
-						goto l160
+						goto l205
 					}
 					position++
 				}
-			l170:
+			l215:
 				{
-					position172, tokenIndex172, depth172 := position, tokenIndex, depth
+					position217, tokenIndex217, depth217 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l173
+						goto l218
 					}
 					position++
-					goto l172
-				l173:
-					position, tokenIndex, depth = position172, tokenIndex172, depth172
+					goto l217
+				l218:
+					position, tokenIndex, depth = position217, tokenIndex217, depth217
 					if buffer[position] != rune('E') {
-						goto l160
+						goto l205
 					}
 					position++
 				}
-			l172:
-				if !_rules[rulePausedOpt]() {
-					goto l160
-				}
+			l217:
 				if !_rules[rulesp]() {
-					goto l160
+					goto l205
 				}
 				{
-					position174, tokenIndex174, depth174 := position, tokenIndex, depth
+					position219, tokenIndex219, depth219 := position, tokenIndex, depth
 					if buffer[position] != rune('s') {
-						goto l175
+						goto l220
 					}
 					position++
-					goto l174
-				l175:
-					position, tokenIndex, depth = position174, tokenIndex174, depth174
+					goto l219
+				l220:
+					position, tokenIndex, depth = position219, tokenIndex219, depth219
 					if buffer[position] != rune('S') {
-						goto l160
+						goto l205
 					}
 					position++
 				}
-			l174:
+			l219:
 				{
-					position176, tokenIndex176, depth176 := position, tokenIndex, depth
-					if buffer[position] != rune('o') {
-						goto l177
+					position221, tokenIndex221, depth221 := position, tokenIndex, depth
+					if buffer[position] != rune('t') {
+						goto l222
 					}
 					position++
-					goto l176
-				l177:
-					position, tokenIndex, depth = position176, tokenIndex176, depth176
-					if buffer[position] != rune('O') {
-						goto l160
+					goto l221
+				l222:
+					position, tokenIndex, depth = position221, tokenIndex221, depth221
+					if buffer[position] != rune('T') {
+						goto l205
 					}
 					position++
 				}
-			l176:
+			l221:
 				{
-					position178, tokenIndex178, depth178 := position, tokenIndex, depth
-					if buffer[position] != rune('u') {
-						goto l179
+					position223, tokenIndex223, depth223 := position, tokenIndex, depth
+					if buffer[position] != rune('r') {
+						goto l224
 					}
 					position++
-					goto l178
-				l179:
-					position, tokenIndex, depth = position178, tokenIndex178, depth178
-					if buffer[position] != rune('U') {
-						goto l160
+					goto l223
+				l224:
+					position, tokenIndex, depth = position223, tokenIndex223, depth223
+					if buffer[position] != rune('R') {
+						goto l205
 					}
 					position++
 				}
-			l178:
+			l223:
 				{
-					position180, tokenIndex180, depth180 := position, tokenIndex, depth
-					if buffer[position] != rune('r') {
-						goto l181
+					position225, tokenIndex225, depth225 := position, tokenIndex, depth
+					if buffer[position] != rune('e') {
+						goto l226
 					}
 					position++
-					goto l180
-				l181:
-					position, tokenIndex, depth = position180, tokenIndex180, depth180
-					if buffer[position] != rune('R') {
-						goto l160
+					goto l225
+				l226:
+					position, tokenIndex, depth = position225, tokenIndex225, depth225
+					if buffer[position] != rune('E') {
+						goto l205
 					}
 					position++
 				}
-			l180:
+			l225:
 				{
-					position182, tokenIndex182, depth182 := position, tokenIndex, depth
-					if buffer[position] != rune('c') {
-						goto l183
+					position227, tokenIndex227, depth227 := position, tokenIndex, depth
+					if buffer[position] != rune('a') {
+						goto l228
 					}
 					position++
-					goto l182
-				l183:
-					position, tokenIndex, depth = position182, tokenIndex182, depth182
-					if buffer[position] != rune('C') {
-						goto l160
+					goto l227
+				l228:
+					position, tokenIndex, depth = position227, tokenIndex227, depth227
+					if buffer[position] != rune('A') {
+						goto l205
 					}
 					position++
 				}
-			l182:
+			l227:
 				{
-					position184, tokenIndex184, depth184 := position, tokenIndex, depth
-					if buffer[position] != rune('e') {
-						goto l185
+					position229, tokenIndex229, depth229 := position, tokenIndex, depth
+					if buffer[position] != rune('m') {
+						goto l230
 					}
 					position++
-					goto l184
-				l185:
-					position, tokenIndex, depth = position184, tokenIndex184, depth184
-					if buffer[position] != rune('E') {
-						goto l160
+					goto l229
+				l230:
+					position, tokenIndex, depth = position229, tokenIndex229, depth229
+					if buffer[position] != rune('M') {
+						goto l205
 					}
 					position++
 				}
-			l184:
+			l229:
 				if !_rules[rulesp]() {
-					goto l160
+					goto l205
 				}
 				if !_rules[ruleStreamIdentifier]() {
-					goto l160
+					goto l205
 				}
 				if !_rules[rulesp]() {
-					goto l160
-				}
-				{
-					position186, tokenIndex186, depth186 := position, tokenIndex, depth
-					if buffer[position] != rune('t') {
-						goto l187
-					}
-					position++
-					goto l186
-				l187:
-					position, tokenIndex, depth = position186, tokenIndex186, depth186
-					if buffer[position] != rune('T') {
-						goto l160
-					}
-					position++
-				}
-			l186:
-				{
-					position188, tokenIndex188, depth188 := position, tokenIndex, depth
-					if buffer[position] != rune('y') {
-						goto l189
-					}
-					position++
-					goto l188
-				l189:
-					position, tokenIndex, depth = position188, tokenIndex188, depth188
-					if buffer[position] != rune('Y') {
-						goto l160
-					}
-					position++
+					goto l205
 				}
-			l188:
 				{
-					position190, tokenIndex190, depth190 := position, tokenIndex, depth
-					if buffer[position] != rune('p') {
-						goto l191
+					position231, tokenIndex231, depth231 := position, tokenIndex, depth
+					if buffer[position] != rune('a') {
+						goto l232
 					}
 					position++
-					goto l190
-				l191:
-					position, tokenIndex, depth = position190, tokenIndex190, depth190
-					if buffer[position] != rune('P') {
-						goto l160
+					goto l231
+				l232:
+					position, tokenIndex, depth = position231, tokenIndex231, depth231
+					if buffer[position] != rune('A') {
+						goto l205
 					}
 					position++
 				}
-			l190:
+			l231:
 				{
-					position192, tokenIndex192, depth192 := position, tokenIndex, depth
-					if buffer[position] != rune('e') {
-						goto l193
+					position233, tokenIndex233, depth233 := position, tokenIndex, depth
+					if buffer[position] != rune('s') {
+						goto l234
 					}
 					position++
-					goto l192
-				l193:
-					position, tokenIndex, depth = position192, tokenIndex192, depth192
-					if buffer[position] != rune('E') {
-						goto l160
+					goto l233
+				l234:
+					position, tokenIndex, depth = position233, tokenIndex233, depth233
+					if buffer[position] != rune('S') {
+						goto l205
 					}
 					position++
 				}
-			l192:
+			l233:
 				if !_rules[rulesp]() {
-					goto l160
+					goto l205
 				}
-				if !_rules[ruleSourceSinkType]() {
-					goto l160
-				}
-				if !_rules[ruleSourceSinkSpecs]() {
-					goto l160
+				if !_rules[ruleSelectUnionStmt]() {
+					goto l205
 				}
-				if !_rules[ruleAction6]() {
-					goto l160
+				if !_rules[ruleAction8]() {
+					goto l205
 				}
 				depth--
-				add(ruleCreateSourceStmt, position161)
+				add(ruleCreateStreamAsSelectUnionStmt, position206)
 			}
 			return true
-		l160:
-			position, tokenIndex, depth = position160, tokenIndex160, depth160
+		l205:
+			position, tokenIndex, depth = position205, tokenIndex205, depth205
 			return false
 		},
-		/* 13 CreateSinkStmt <- <(('c' / 'C') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('i' / 'I') ('n' / 'N') ('k' / 'K')) sp StreamIdentifier sp (('t' / 'T') ('y' / 'Y') ('p' / 'P') ('e' / 'E')) sp SourceSinkType SourceSinkSpecs Action7)> */
+		/* 15 CreateSourceStmt <- <(('c' / 'C') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('t' / 'T') ('e' / 'E') PausedOpt sp (('s' / 'S') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('c' / 'C') ('e' / 'E')) sp StreamIdentifier sp (('t' / 'T') ('y' / 'Y') ('p' / 'P') ('e' / 'E')) sp SourceSinkType SourceSinkSpecs Action9)> */
 		func() bool {
-			position194, tokenIndex194, depth194 := position, tokenIndex, depth
+			position235, tokenIndex235, depth235 := position, tokenIndex, depth
 			{
-				position195 := position
+				position236 := position
 				depth++
 				{
-					position196, tokenIndex196, depth196 := position, tokenIndex, depth
+					position237, tokenIndex237, depth237 := position, tokenIndex, depth
 					if buffer[position] != rune('c') {
-						goto l197
+						goto l238
 					}
 					position++
-					goto l196
-				l197:
-					position, tokenIndex, depth = position196, tokenIndex196, depth196
+					goto l237
+				l238:
+					position, tokenIndex, depth = position237, tokenIndex237, depth237
 					if buffer[position] != rune('C') {
-						goto l194
+						goto l235
 					}
 					position++
 				}
-			l196:
+			l237:
 				{
-					position198, tokenIndex198, depth198 := position, tokenIndex, depth
+					position239, tokenIndex239, depth239 := position, tokenIndex, depth
 					if buffer[position] != rune('r') {
-						goto l199
+						goto l240
 					}
 					position++
-					goto l198
-				l199:
-					position, tokenIndex, depth = position198, tokenIndex198, depth198
+					goto l239
+				l240:
+					position, tokenIndex, depth = position239, tokenIndex239, depth239
 					if buffer[position] != rune('R') {
-						goto l194
+						goto l235
 					}
 					position++
 				}
-			l198:
+			l239:
 				{
-					position200, tokenIndex200, depth200 := position, tokenIndex, depth
+					position241, tokenIndex241, depth241 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l201
+						goto l242
 					}
 					position++
-					goto l200
-				l201:
-					position, tokenIndex, depth = position200, tokenIndex200, depth200
+					goto l241
+				l242:
+					position, tokenIndex, depth = position241, tokenIndex241, depth241
 					if buffer[position] != rune('E') {
-						goto l194
+						goto l235
 					}
 					position++
 				}
-			l200:
+			l241:
 				{
-					position202, tokenIndex202, depth202 := position, tokenIndex, depth
+					position243, tokenIndex243, depth243 := position, tokenIndex, depth
 					if buffer[position] != rune('a') {
-						goto l203
+						goto l244
 					}
 					position++
-					goto l202
-				l203:
-					position, tokenIndex, depth = position202, tokenIndex202, depth202
+					goto l243
+				l244:
+					position, tokenIndex, depth = position243, tokenIndex243, depth243
 					if buffer[position] != rune('A') {
-						goto l194
+						goto l235
 					}
 					position++
 				}
-			l202:
+			l243:
 				{
-					position204, tokenIndex204, depth204 := position, tokenIndex, depth
+					position245, tokenIndex245, depth245 := position, tokenIndex, depth
 					if buffer[position] != rune('t') {
-						goto l205
+						goto l246
 					}
 					position++
-					goto l204
-				l205:
-					position, tokenIndex, depth = position204, tokenIndex204, depth204
+					goto l245
+				l246:
+					position, tokenIndex, depth = position245, tokenIndex245, depth245
 					if buffer[position] != rune('T') {
-						goto l194
+						goto l235
 					}
 					position++
 				}
-			l204:
+			l245:
 				{
-					position206, tokenIndex206, depth206 := position, tokenIndex, depth
+					position247, tokenIndex247, depth247 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l207
+						goto l248
 					}
 					position++
-					goto l206
-				l207:
-					position, tokenIndex, depth = position206, tokenIndex206, depth206
+					goto l247
+				l248:
+					position, tokenIndex, depth = position247, tokenIndex247, depth247
 					if buffer[position] != rune('E') {
-						goto l194
+						goto l235
 					}
 					position++
 				}
-			l206:
+			l247:
+				if !_rules[rulePausedOpt]() {
+					goto l235
+				}
 				if !_rules[rulesp]() {
-					goto l194
+					goto l235
 				}
 				{
-					position208, tokenIndex208, depth208 := position, tokenIndex, depth
+					position249, tokenIndex249, depth249 := position, tokenIndex, depth
 					if buffer[position] != rune('s') {
-						goto l209
+						goto l250
 					}
 					position++
-					goto l208
-				l209:
-					position, tokenIndex, depth = position208, tokenIndex208, depth208
+					goto l249
+				l250:
+					position, tokenIndex, depth = position249, tokenIndex249, depth249
 					if buffer[position] != rune('S') {
-						goto l194
+						goto l235
 					}
 					position++
 				}
-			l208:
+			l249:
 				{
-					position210, tokenIndex210, depth210 := position, tokenIndex, depth
-					if buffer[position] != rune('i') {
-						goto l211
+					position251, tokenIndex251, depth251 := position, tokenIndex, depth
+					if buffer[position] != rune('o') {
+						goto l252
 					}
 					position++
-					goto l210
-				l211:
-					position, tokenIndex, depth = position210, tokenIndex210, depth210
-					if buffer[position] != rune('I') {
-						goto l194
+					goto l251
+				l252:
+					position, tokenIndex, depth = position251, tokenIndex251, depth251
+					if buffer[position] != rune('O') {
+						goto l235
 					}
 					position++
 				}
-			l210:
+			l251:
 				{
-					position212, tokenIndex212, depth212 := position, tokenIndex, depth
-					if buffer[position] != rune('n') {
-						goto l213
+					position253, tokenIndex253, depth253 := position, tokenIndex, depth
+					if buffer[position] != rune('u') {
+						goto l254
 					}
 					position++
-					goto l212
-				l213:
-					position, tokenIndex, depth = position212, tokenIndex212, depth212
-					if buffer[position] != rune('N') {
-						goto l194
+					goto l253
+				l254:
+					position, tokenIndex, depth = position253, tokenIndex253, depth253
+					if buffer[position] != rune('U') {
+						goto l235
 					}
 					position++
 				}
-			l212:
+			l253:
 				{
-					position214, tokenIndex214, depth214 := position, tokenIndex, depth
-					if buffer[position] != rune('k') {
-						goto l215
+					position255, tokenIndex255, depth255 := position, tokenIndex, depth
+					if buffer[position] != rune('r') {
+						goto l256
 					}
 					position++
-					goto l214
-				l215:
-					position, tokenIndex, depth = position214, tokenIndex214, depth214
-					if buffer[position] != rune('K') {
-						goto l194
+					goto l255
+				l256:
+					position, tokenIndex, depth = position255, tokenIndex255, depth255
+					if buffer[position] != rune('R') {
+						goto l235
+					}
+					position++
+				}
+			l255:
+				{
+					position257, tokenIndex257, depth257 := position, tokenIndex, depth
+					if buffer[position] != rune('c') {
+						goto l258
+					}
+					position++
+					goto l257
+				l258:
+					position, tokenIndex, depth = position257, tokenIndex257, depth257
+					if buffer[position] != rune('C') {
+						goto l235
+					}
+					position++
+				}
+			l257:
+				{
+					position259, tokenIndex259, depth259 := position, tokenIndex, depth
+					if buffer[position] != rune('e') {
+						goto l260
+					}
+					position++
+					goto l259
+				l260:
+					position, tokenIndex, depth = position259, tokenIndex259, depth259
+					if buffer[position] != rune('E') {
+						goto l235
 					}
 					position++
 				}
-			l214:
+			l259:
 				if !_rules[rulesp]() {
-					goto l194
+					goto l235
 				}
 				if !_rules[ruleStreamIdentifier]() {
-					goto l194
+					goto l235
 				}
 				if !_rules[rulesp]() {
-					goto l194
+					goto l235
 				}
 				{
-					position216, tokenIndex216, depth216 := position, tokenIndex, depth
+					position261, tokenIndex261, depth261 := position, tokenIndex, depth
 					if buffer[position] != rune('t') {
-						goto l217
+						goto l262
 					}
 					position++
-					goto l216
-				l217:
-					position, tokenIndex, depth = position216, tokenIndex216, depth216
+					goto l261
+				l262:
+					position, tokenIndex, depth = position261, tokenIndex261, depth261
 					if buffer[position] != rune('T') {
-						goto l194
+						goto l235
 					}
 					position++
 				}
-			l216:
+			l261:
 				{
-					position218, tokenIndex218, depth218 := position, tokenIndex, depth
+					position263, tokenIndex263, depth263 := position, tokenIndex, depth
 					if buffer[position] != rune('y') {
-						goto l219
+						goto l264
 					}
 					position++
-					goto l218
-				l219:
-					position, tokenIndex, depth = position218, tokenIndex218, depth218
+					goto l263
+				l264:
+					position, tokenIndex, depth = position263, tokenIndex263, depth263
 					if buffer[position] != rune('Y') {
-						goto l194
+						goto l235
 					}
 					position++
 				}
-			l218:
+			l263:
 				{
-					position220, tokenIndex220, depth220 := position, tokenIndex, depth
+					position265, tokenIndex265, depth265 := position, tokenIndex, depth
 					if buffer[position] != rune('p') {
-						goto l221
+						goto l266
 					}
 					position++
-					goto l220
-				l221:
-					position, tokenIndex, depth = position220, tokenIndex220, depth220
+					goto l265
+				l266:
+					position, tokenIndex, depth = position265, tokenIndex265, depth265
 					if buffer[position] != rune('P') {
-						goto l194
+						goto l235
 					}
 					position++
 				}
-			l220:
+			l265:
 				{
-					position222, tokenIndex222, depth222 := position, tokenIndex, depth
+					position267, tokenIndex267, depth267 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l223
+						goto l268
 					}
 					position++
-					goto l222
-				l223:
-					position, tokenIndex, depth = position222, tokenIndex222, depth222
+					goto l267
+				l268:
+					position, tokenIndex, depth = position267, tokenIndex267, depth267
 					if buffer[position] != rune('E') {
-						goto l194
+						goto l235
 					}
 					position++
 				}
-			l222:
+			l267:
 				if !_rules[rulesp]() {
-					goto l194
+					goto l235
 				}
 				if !_rules[ruleSourceSinkType]() {
-					goto l194
+					goto l235
 				}
 				if !_rules[ruleSourceSinkSpecs]() {
-					goto l194
+					goto l235
 				}
-				if !_rules[ruleAction7]() {
-					goto l194
+				if !_rules[ruleAction9]() {
+					goto l235
 				}
 				depth--
-				add(ruleCreateSinkStmt, position195)
+				add(ruleCreateSourceStmt, position236)
 			}
 			return true
-		l194:
-			position, tokenIndex, depth = position194, tokenIndex194, depth194
+		l235:
+			position, tokenIndex, depth = position235, tokenIndex235, depth235
 			return false
 		},
-		/* 14 CreateStateStmt <- <(('c' / 'C') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('t' / 'T') ('a' / 'A') ('t' / 'T') ('e' / 'E')) sp StreamIdentifier sp (('t' / 'T') ('y' / 'Y') ('p' / 'P') ('e' / 'E')) sp SourceSinkType SourceSinkSpecs Action8)> */
+		/* 16 CreateSinkStmt <- <(('c' / 'C') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('i' / 'I') ('n' / 'N') ('k' / 'K')) sp StreamIdentifier sp (('t' / 'T') ('y' / 'Y') ('p' / 'P') ('e' / 'E')) sp SourceSinkType SourceSinkSpecs Action10)> */
 		func() bool {
-			position224, tokenIndex224, depth224 := position, tokenIndex, depth
+			position269, tokenIndex269, depth269 := position, tokenIndex, depth
 			{
-				position225 := position
+				position270 := position
 				depth++
 				{
-					position226, tokenIndex226, depth226 := position, tokenIndex, depth
+					position271, tokenIndex271, depth271 := position, tokenIndex, depth
 					if buffer[position] != rune('c') {
-						goto l227
+						goto l272
 					}
 					position++
-					goto l226
-				l227:
-					position, tokenIndex, depth = position226, tokenIndex226, depth226
+					goto l271
+				l272:
+					position, tokenIndex, depth = position271, tokenIndex271, depth271
 					if buffer[position] != rune('C') {
-						goto l224
+						goto l269
 					}
 					position++
 				}
-			l226:
+			l271:
 				{
-					position228, tokenIndex228, depth228 := position, tokenIndex, depth
+					position273, tokenIndex273, depth273 := position, tokenIndex, depth
 					if buffer[position] != rune('r') {
-						goto l229
+						goto l274
 					}
 					position++
-					goto l228
-				l229:
-					position, tokenIndex, depth = position228, tokenIndex228, depth228
+					goto l273
+				l274:
+					position, tokenIndex, depth = position273, tokenIndex273, depth273
 					if buffer[position] != rune('R') {
-						goto l224
+						goto l269
 					}
 					position++
 				}
-			l228:
+			l273:
 				{
-					position230, tokenIndex230, depth230 := position, tokenIndex, depth
+					position275, tokenIndex275, depth275 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l231
+						goto l276
 					}
 					position++
-					goto l230
-				l231:
-					position, tokenIndex, depth = position230, tokenIndex230, depth230
+					goto l275
+				l276:
+					position, tokenIndex, depth = position275, tokenIndex275, depth275
 					if buffer[position] != rune('E') {
-						goto l224
+						goto l269
 					}
 					position++
 				}
-			l230:
+			l275:
 				{
-					position232, tokenIndex232, depth232 := position, tokenIndex, depth
+					position277, tokenIndex277, depth277 := position, tokenIndex, depth
 					if buffer[position] != rune('a') {
-						goto l233
+						goto l278
 					}
 					position++
-					goto l232
-				l233:
-					position, tokenIndex, depth = position232, tokenIndex232, depth232
+					goto l277
+				l278:
+					position, tokenIndex, depth = position277, tokenIndex277, depth277
 					if buffer[position] != rune('A') {
-						goto l224
+						goto l269
 					}
 					position++
 				}
-			l232:
+			l277:
 				{
-					position234, tokenIndex234, depth234 := position, tokenIndex, depth
+					position279, tokenIndex279, depth279 := position, tokenIndex, depth
 					if buffer[position] != rune('t') {
-						goto l235
+						goto l280
 					}
 					position++
-					goto l234
-				l235:
-					position, tokenIndex, depth = position234, tokenIndex234, depth234
+					goto l279
+				l280:
+					position, tokenIndex, depth = position279, tokenIndex279, depth279
 					if buffer[position] != rune('T') {
-						goto l224
+						goto l269
 					}
 					position++
 				}
-			l234:
+			l279:
 				{
-					position236, tokenIndex236, depth236 := position, tokenIndex, depth
+					position281, tokenIndex281, depth281 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l237
+						goto l282
 					}
 					position++
-					goto l236
-				l237:
-					position, tokenIndex, depth = position236, tokenIndex236, depth236
+					goto l281
+				l282:
+					position, tokenIndex, depth = position281, tokenIndex281, depth281
 					if buffer[position] != rune('E') {
-						goto l224
+						goto l269
 					}
 					position++
 				}
-			l236:
+			l281:
 				if !_rules[rulesp]() {
-					goto l224
+					goto l269
 				}
 				{
-					position238, tokenIndex238, depth238 := position, tokenIndex, depth
+					position283, tokenIndex283, depth283 := position, tokenIndex, depth
 					if buffer[position] != rune('s') {
-						goto l239
+						goto l284
 					}
 					position++
-					goto l238
-				l239:
-					position, tokenIndex, depth = position238, tokenIndex238, depth238
+					goto l283
+				l284:
+					position, tokenIndex, depth = position283, tokenIndex283, depth283
 					if buffer[position] != rune('S') {
-						goto l224
+						goto l269
 					}
 					position++
 				}
-			l238:
+			l283:
 				{
-					position240, tokenIndex240, depth240 := position, tokenIndex, depth
-					if buffer[position] != rune('t') {
-						goto l241
+					position285, tokenIndex285, depth285 := position, tokenIndex, depth
+					if buffer[position] != rune('i') {
+						goto l286
 					}
 					position++
-					goto l240
-				l241:
-					position, tokenIndex, depth = position240, tokenIndex240, depth240
+					goto l285
+				l286:
+					position, tokenIndex, depth = position285, tokenIndex285, depth285
+					if buffer[position] != rune('I') {
+						goto l269
+					}
+					position++
+				}
+			l285:
+				{
+					position287, tokenIndex287, depth287 := position, tokenIndex, depth
+					if buffer[position] != rune('n') {
+						goto l288
+					}
+					position++
+					goto l287
+				l288:
+					position, tokenIndex, depth = position287, tokenIndex287, depth287
+					if buffer[position] != rune('N') {
+						goto l269
+					}
+					position++
+				}
+			l287:
+				{
+					position289, tokenIndex289, depth289 := position, tokenIndex, depth
+					if buffer[position] != rune('k') {
+						goto l290
+					}
+					position++
+					goto l289
+				l290:
+					position, tokenIndex, depth = position289, tokenIndex289, depth289
+					if buffer[position] != rune('K') {
+						goto l269
+					}
+					position++
+				}
+			l289:
+				if !_rules[rulesp]() {
+					goto l269
+				}
+				if !_rules[ruleStreamIdentifier]() {
+					goto l269
+				}
+				if !_rules[rulesp]() {
+					goto l269
+				}
+				{
+					position291, tokenIndex291, depth291 := position, tokenIndex, depth
+					if buffer[position] != rune('t') {
+						goto l292
+					}
+					position++
+					goto l291
+				l292:
+					position, tokenIndex, depth = position291, tokenIndex291, depth291
 					if buffer[position] != rune('T') {
-						goto l224
+						goto l269
+					}
+					position++
+				}
+			l291:
+				{
+					position293, tokenIndex293, depth293 := position, tokenIndex, depth
+					if buffer[position] != rune('y') {
+						goto l294
+					}
+					position++
+					goto l293
+				l294:
+					position, tokenIndex, depth = position293, tokenIndex293, depth293
+					if buffer[position] != rune('Y') {
+						goto l269
+					}
+					position++
+				}
+			l293:
+				{
+					position295, tokenIndex295, depth295 := position, tokenIndex, depth
+					if buffer[position] != rune('p') {
+						goto l296
+					}
+					position++
+					goto l295
+				l296:
+					position, tokenIndex, depth = position295, tokenIndex295, depth295
+					if buffer[position] != rune('P') {
+						goto l269
+					}
+					position++
+				}
+			l295:
+				{
+					position297, tokenIndex297, depth297 := position, tokenIndex, depth
+					if buffer[position] != rune('e') {
+						goto l298
+					}
+					position++
+					goto l297
+				l298:
+					position, tokenIndex, depth = position297, tokenIndex297, depth297
+					if buffer[position] != rune('E') {
+						goto l269
+					}
+					position++
+				}
+			l297:
+				if !_rules[rulesp]() {
+					goto l269
+				}
+				if !_rules[ruleSourceSinkType]() {
+					goto l269
+				}
+				if !_rules[ruleSourceSinkSpecs]() {
+					goto l269
+				}
+				if !_rules[ruleAction10]() {
+					goto l269
+				}
+				depth--
+				add(ruleCreateSinkStmt, position270)
+			}
+			return true
+		l269:
+			position, tokenIndex, depth = position269, tokenIndex269, depth269
+			return false
+		},
+		/* 17 CreateStateStmt <- <(('c' / 'C') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('t' / 'T') ('a' / 'A') ('t' / 'T') ('e' / 'E')) sp StreamIdentifier sp (('t' / 'T') ('y' / 'Y') ('p' / 'P') ('e' / 'E')) sp SourceSinkType SourceSinkSpecs Action11)> */
+		func() bool {
+			position299, tokenIndex299, depth299 := position, tokenIndex, depth
+			{
+				position300 := position
+				depth++
+				{
+					position301, tokenIndex301, depth301 := position, tokenIndex, depth
+					if buffer[position] != rune('c') {
+						goto l302
+					}
+					position++
+					goto l301
+				l302:
+					position, tokenIndex, depth = position301, tokenIndex301, depth301
+					if buffer[position] != rune('C') {
+						goto l299
+					}
+					position++
+				}
+			l301:
+				{
+					position303, tokenIndex303, depth303 := position, tokenIndex, depth
+					if buffer[position] != rune('r') {
+						goto l304
+					}
+					position++
+					goto l303
+				l304:
+					position, tokenIndex, depth = position303, tokenIndex303, depth303
+					if buffer[position] != rune('R') {
+						goto l299
 					}
 					position++
 				}
-			l240:
+			l303:
 				{
-					position242, tokenIndex242, depth242 := position, tokenIndex, depth
+					position305, tokenIndex305, depth305 := position, tokenIndex, depth
+					if buffer[position] != rune('e') {
+						goto l306
+					}
+					position++
+					goto l305
+				l306:
+					position, tokenIndex, depth = position305, tokenIndex305, depth305
+					if buffer[position] != rune('E') {
+						goto l299
+					}
+					position++
+				}
+			l305:
+				{
+					position307, tokenIndex307, depth307 := position, tokenIndex, depth
 					if buffer[position] != rune('a') {
-						goto l243
+						goto l308
 					}
 					position++
-					goto l242
-				l243:
-					position, tokenIndex, depth = position242, tokenIndex242, depth242
+					goto l307
+				l308:
+					position, tokenIndex, depth = position307, tokenIndex307, depth307
 					if buffer[position] != rune('A') {
-						goto l224
+						goto l299
 					}
 					position++
 				}
-			l242:
+			l307:
 				{
-					position244, tokenIndex244, depth244 := position, tokenIndex, depth
+					position309, tokenIndex309, depth309 := position, tokenIndex, depth
 					if buffer[position] != rune('t') {
-						goto l245
+						goto l310
 					}
 					position++
-					goto l244
-				l245:
-					position, tokenIndex, depth = position244, tokenIndex244, depth244
+					goto l309
+				l310:
+					position, tokenIndex, depth = position309, tokenIndex309, depth309
 					if buffer[position] != rune('T') {
-						goto l224
+						goto l299
 					}
 					position++
 				}
-			l244:
+			l309:
 				{
-					position246, tokenIndex246, depth246 := position, tokenIndex, depth
+					position311, tokenIndex311, depth311 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l247
+						goto l312
 					}
 					position++
-					goto l246
-				l247:
-					position, tokenIndex, depth = position246, tokenIndex246, depth246
+					goto l311
+				l312:
+					position, tokenIndex, depth = position311, tokenIndex311, depth311
 					if buffer[position] != rune('E') {
-						goto l224
+						goto l299
+					}
+					position++
+				}
+			l311:
+				if !_rules[rulesp]() {
+					goto l299
+				}
+				{
+					position313, tokenIndex313, depth313 := position, tokenIndex, depth
+					if buffer[position] != rune('s') {
+						goto l314
+					}
+					position++
+					goto l313
+				l314:
+					position, tokenIndex, depth = position313, tokenIndex313, depth313
+					if buffer[position] != rune('S') {
+						goto l299
+					}
+					position++
+				}
+			l313:
+				{
+					position315, tokenIndex315, depth315 := position, tokenIndex, depth
+					if buffer[position] != rune('t') {
+						goto l316
+					}
+					position++
+					goto l315
+				l316:
+					position, tokenIndex, depth = position315, tokenIndex315, depth315
+					if buffer[position] != rune('T') {
+						goto l299
+					}
+					position++
+				}
+			l315:
+				{
+					position317, tokenIndex317, depth317 := position, tokenIndex, depth
+					if buffer[position] != rune('a') {
+						goto l318
+					}
+					position++
+					goto l317
+				l318:
+					position, tokenIndex, depth = position317, tokenIndex317, depth317
+					if buffer[position] != rune('A') {
+						goto l299
+					}
+					position++
+				}
+			l317:
+				{
+					position319, tokenIndex319, depth319 := position, tokenIndex, depth
+					if buffer[position] != rune('t') {
+						goto l320
+					}
+					position++
+					goto l319
+				l320:
+					position, tokenIndex, depth = position319, tokenIndex319, depth319
+					if buffer[position] != rune('T') {
+						goto l299
+					}
+					position++
+				}
+			l319:
+				{
+					position321, tokenIndex321, depth321 := position, tokenIndex, depth
+					if buffer[position] != rune('e') {
+						goto l322
+					}
+					position++
+					goto l321
+				l322:
+					position, tokenIndex, depth = position321, tokenIndex321, depth321
+					if buffer[position] != rune('E') {
+						goto l299
 					}
 					position++
 				}
-			l246:
+			l321:
 				if !_rules[rulesp]() {
-					goto l224
+					goto l299
 				}
 				if !_rules[ruleStreamIdentifier]() {
-					goto l224
+					goto l299
 				}
 				if !_rules[rulesp]() {
-					goto l224
+					goto l299
 				}
 				{
-					position248, tokenIndex248, depth248 := position, tokenIndex, depth
+					position323, tokenIndex323, depth323 := position, tokenIndex, depth
 					if buffer[position] != rune('t') {
-						goto l249
+						goto l324
 					}
 					position++
-					goto l248
-				l249:
-					position, tokenIndex, depth = position248, tokenIndex248, depth248
+					goto l323
+				l324:
+					position, tokenIndex, depth = position323, tokenIndex323, depth323
 					if buffer[position] != rune('T') {
-						goto l224
+						goto l299
 					}
 					position++
 				}
-			l248:
+			l323:
 				{
-					position250, tokenIndex250, depth250 := position, tokenIndex, depth
+					position325, tokenIndex325, depth325 := position, tokenIndex, depth
 					if buffer[position] != rune('y') {
-						goto l251
+						goto l326
 					}
 					position++
-					goto l250
-				l251:
-					position, tokenIndex, depth = position250, tokenIndex250, depth250
+					goto l325
+				l326:
+					position, tokenIndex, depth = position325, tokenIndex325, depth325
 					if buffer[position] != rune('Y') {
-						goto l224
+						goto l299
 					}
 					position++
 				}
-			l250:
+			l325:
 				{
-					position252, tokenIndex252, depth252 := position, tokenIndex, depth
+					position327, tokenIndex327, depth327 := position, tokenIndex, depth
 					if buffer[position] != rune('p') {
-						goto l253
+						goto l328
 					}
 					position++
-					goto l252
-				l253:
-					position, tokenIndex, depth = position252, tokenIndex252, depth252
+					goto l327
+				l328:
+					position, tokenIndex, depth = position327, tokenIndex327, depth327
 					if buffer[position] != rune('P') {
-						goto l224
+						goto l299
 					}
 					position++
 				}
-			l252:
+			l327:
 				{
-					position254, tokenIndex254, depth254 := position, tokenIndex, depth
+					position329, tokenIndex329, depth329 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l255
+						goto l330
 					}
 					position++
-					goto l254
-				l255:
-					position, tokenIndex, depth = position254, tokenIndex254, depth254
+					goto l329
+				l330:
+					position, tokenIndex, depth = position329, tokenIndex329, depth329
 					if buffer[position] != rune('E') {
-						goto l224
+						goto l299
 					}
 					position++
 				}
-			l254:
+			l329:
 				if !_rules[rulesp]() {
-					goto l224
+					goto l299
 				}
 				if !_rules[ruleSourceSinkType]() {
-					goto l224
+					goto l299
 				}
 				if !_rules[ruleSourceSinkSpecs]() {
-					goto l224
+					goto l299
 				}
-				if !_rules[ruleAction8]() {
-					goto l224
+				if !_rules[ruleAction11]() {
+					goto l299
 				}
 				depth--
-				add(ruleCreateStateStmt, position225)
+				add(ruleCreateStateStmt, position300)
 			}
 			return true
-		l224:
-			position, tokenIndex, depth = position224, tokenIndex224, depth224
+		l299:
+			position, tokenIndex, depth = position299, tokenIndex299, depth299
 			return false
 		},
-		/* 15 UpdateStateStmt <- <(('u' / 'U') ('p' / 'P') ('d' / 'D') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('t' / 'T') ('a' / 'A') ('t' / 'T') ('e' / 'E')) sp StreamIdentifier UpdateSourceSinkSpecs Action9)> */
+		/* 18 UpdateStateStmt <- <(('u' / 'U') ('p' / 'P') ('d' / 'D') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('t' / 'T') ('a' / 'A') ('t' / 'T') ('e' / 'E')) sp StreamIdentifier UpdateSourceSinkSpecs Action12)> */
 		func() bool {
-			position256, tokenIndex256, depth256 := position, tokenIndex, depth
+			position331, tokenIndex331, depth331 := position, tokenIndex, depth
 			{
-				position257 := position
+				position332 := position
 				depth++
 				{
-					position258, tokenIndex258, depth258 := position, tokenIndex, depth
+					position333, tokenIndex333, depth333 := position, tokenIndex, depth
 					if buffer[position] != rune('u') {
-						goto l259
+						goto l334
 					}
 					position++
-					goto l258
-				l259:
-					position, tokenIndex, depth = position258, tokenIndex258, depth258
+					goto l333
+				l334:
+					position, tokenIndex, depth = position333, tokenIndex333, depth333
 					if buffer[position] != rune('U') {
-						goto l256
+						goto l331
 					}
 					position++
 				}
-			l258:
+			l333:
 				{
-					position260, tokenIndex260, depth260 := position, tokenIndex, depth
+					position335, tokenIndex335, depth335 := position, tokenIndex, depth
 					if buffer[position] != rune('p') {
-						goto l261
+						goto l336
 					}
 					position++
-					goto l260
-				l261:
-					position, tokenIndex, depth = position260, tokenIndex260, depth260
+					goto l335
+				l336:
+					position, tokenIndex, depth = position335, tokenIndex335, depth335
 					if buffer[position] != rune('P') {
-						goto l256
+						goto l331
 					}
 					position++
 				}
-			l260:
+			l335:
 				{
-					position262, tokenIndex262, depth262 := position, tokenIndex, depth
+					position337, tokenIndex337, depth337 := position, tokenIndex, depth
 					if buffer[position] != rune('d') {
-						goto l263
+						goto l338
 					}
 					position++
-					goto l262
-				l263:
-					position, tokenIndex, depth = position262, tokenIndex262, depth262
+					goto l337
+				l338:
+					position, tokenIndex, depth = position337, tokenIndex337, depth337
 					if buffer[position] != rune('D') {
-						goto l256
+						goto l331
 					}
 					position++
 				}
-			l262:
+			l337:
 				{
-					position264, tokenIndex264, depth264 := position, tokenIndex, depth
+					position339, tokenIndex339, depth339 := position, tokenIndex, depth
 					if buffer[position] != rune('a') {
-						goto l265
+						goto l340
 					}
 					position++
-					goto l264
-				l265:
-					position, tokenIndex, depth = position264, tokenIndex264, depth264
+					goto l339
+				l340:
+					position, tokenIndex, depth = position339, tokenIndex339, depth339
 					if buffer[position] != rune('A') {
-						goto l256
+						goto l331
 					}
 					position++
 				}
-			l264:
+			l339:
 				{
-					position266, tokenIndex266, depth266 := position, tokenIndex, depth
+					position341, tokenIndex341, depth341 := position, tokenIndex, depth
 					if buffer[position] != rune('t') {
-						goto l267
+						goto l342
 					}
 					position++
-					goto l266
-				l267:
-					position, tokenIndex, depth = position266, tokenIndex266, depth266
+					goto l341
+				l342:
+					position, tokenIndex, depth = position341, tokenIndex341, depth341
 					if buffer[position] != rune('T') {
-						goto l256
+						goto l331
 					}
 					position++
 				}
-			l266:
+			l341:
 				{
-					position268, tokenIndex268, depth268 := position, tokenIndex, depth
+					position343, tokenIndex343, depth343 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l269
+						goto l344
 					}
 					position++
-					goto l268
-				l269:
-					position, tokenIndex, depth = position268, tokenIndex268, depth268
+					goto l343
+				l344:
+					position, tokenIndex, depth = position343, tokenIndex343, depth343
 					if buffer[position] != rune('E') {
-						goto l256
+						goto l331
 					}
 					position++
 				}
-			l268:
+			l343:
 				if !_rules[rulesp]() {
-					goto l256
+					goto l331
 				}
 				{
-					position270, tokenIndex270, depth270 := position, tokenIndex, depth
+					position345, tokenIndex345, depth345 := position, tokenIndex, depth
 					if buffer[position] != rune('s') {
-						goto l271
+						goto l346
 					}
 					position++
-					goto l270
-				l271:
-					position, tokenIndex, depth = position270, tokenIndex270, depth270
+					goto l345
+				l346:
+					position, tokenIndex, depth = position345, tokenIndex345, depth345
 					if buffer[position] != rune('S') {
-						goto l256
+						goto l331
 					}
 					position++
 				}
-			l270:
+			l345:
 				{
-					position272, tokenIndex272, depth272 := position, tokenIndex, depth
+					position347, tokenIndex347, depth347 := position, tokenIndex, depth
 					if buffer[position] != rune('t') {
-						goto l273
+						goto l348
 					}
 					position++
-					goto l272
-				l273:
-					position, tokenIndex, depth = position272, tokenIndex272, depth272
+					goto l347
+				l348:
+					position, tokenIndex, depth = position347, tokenIndex347, depth347
 					if buffer[position] != rune('T') {
-						goto l256
+						goto l331
 					}
 					position++
 				}
-			l272:
+			l347:
 				{
-					position274, tokenIndex274, depth274 := position, tokenIndex, depth
+					position349, tokenIndex349, depth349 := position, tokenIndex, depth
 					if buffer[position] != rune('a') {
-						goto l275
+						goto l350
 					}
 					position++
-					goto l274
-				l275:
-					position, tokenIndex, depth = position274, tokenIndex274, depth274
+					goto l349
+				l350:
+					position, tokenIndex, depth = position349, tokenIndex349, depth349
 					if buffer[position] != rune('A') {
-						goto l256
+						goto l331
 					}
 					position++
 				}
-			l274:
+			l349:
 				{
-					position276, tokenIndex276, depth276 := position, tokenIndex, depth
+					position351, tokenIndex351, depth351 := position, tokenIndex, depth
 					if buffer[position] != rune('t') {
-						goto l277
+						goto l352
 					}
 					position++
-					goto l276
-				l277:
-					position, tokenIndex, depth = position276, tokenIndex276, depth276
+					goto l351
+				l352:
+					position, tokenIndex, depth = position351, tokenIndex351, depth351
 					if buffer[position] != rune('T') {
-						goto l256
+						goto l331
 					}
 					position++
 				}
-			l276:
+			l351:
 				{
-					position278, tokenIndex278, depth278 := position, tokenIndex, depth
+					position353, tokenIndex353, depth353 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l279
+						goto l354
 					}
 					position++
-					goto l278
-				l279:
-					position, tokenIndex, depth = position278, tokenIndex278, depth278
+					goto l353
+				l354:
+					position, tokenIndex, depth = position353, tokenIndex353, depth353
 					if buffer[position] != rune('E') {
-						goto l256
+						goto l331
 					}
 					position++
 				}
-			l278:
+			l353:
 				if !_rules[rulesp]() {
-					goto l256
+					goto l331
 				}
 				if !_rules[ruleStreamIdentifier]() {
-					goto l256
+					goto l331
 				}
 				if !_rules[ruleUpdateSourceSinkSpecs]() {
-					goto l256
+					goto l331
 				}
-				if !_rules[ruleAction9]() {
-					goto l256
+				if !_rules[ruleAction12]() {
+					goto l331
 				}
 				depth--
-				add(ruleUpdateStateStmt, position257)
+				add(ruleUpdateStateStmt, position332)
 			}
 			return true
-		l256:
-			position, tokenIndex, depth = position256, tokenIndex256, depth256
+		l331:
+			position, tokenIndex, depth = position331, tokenIndex331, depth331
 			return false
 		},
-		/* 16 UpdateSourceStmt <- <(('u' / 'U') ('p' / 'P') ('d' / 'D') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('c' / 'C') ('e' / 'E')) sp StreamIdentifier UpdateSourceSinkSpecs Action10)> */
+		/* 19 UpdateSourceStmt <- <(('u' / 'U') ('p' / 'P') ('d' / 'D') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('c' / 'C') ('e' / 'E')) sp StreamIdentifier UpdateSourceSinkSpecs Action13)> */
 		func() bool {
-			position280, tokenIndex280, depth280 := position, tokenIndex, depth
+			position355, tokenIndex355, depth355 := position, tokenIndex, depth
 			{
-				position281 := position
+				position356 := position
 				depth++
 				{
-					position282, tokenIndex282, depth282 := position, tokenIndex, depth
+					position357, tokenIndex357, depth357 := position, tokenIndex, depth
 					if buffer[position] != rune('u') {
-						goto l283
+						goto l358
 					}
 					position++
-					goto l282
-				l283:
-					position, tokenIndex, depth = position282, tokenIndex282, depth282
+					goto l357
+				l358:
+					position, tokenIndex, depth = position357, tokenIndex357, depth357
 					if buffer[position] != rune('U') {
-						goto l280
+						goto l355
 					}
 					position++
 				}
-			l282:
+			l357:
 				{
-					position284, tokenIndex284, depth284 := position, tokenIndex, depth
+					position359, tokenIndex359, depth359 := position, tokenIndex, depth
 					if buffer[position] != rune('p') {
-						goto l285
+						goto l360
 					}
 					position++
-					goto l284
-				l285:
-					position, tokenIndex, depth = position284, tokenIndex284, depth284
+					goto l359
+				l360:
+					position, tokenIndex, depth = position359, tokenIndex359, depth359
 					if buffer[position] != rune('P') {
-						goto l280
+						goto l355
 					}
 					position++
 				}
-			l284:
+			l359:
 				{
-					position286, tokenIndex286, depth286 := position, tokenIndex, depth
+					position361, tokenIndex361, depth361 := position, tokenIndex, depth
 					if buffer[position] != rune('d') {
-						goto l287
+						goto l362
 					}
 					position++
-					goto l286
-				l287:
-					position, tokenIndex, depth = position286, tokenIndex286, depth286
+					goto l361
+				l362:
+					position, tokenIndex, depth = position361, tokenIndex361, depth361
 					if buffer[position] != rune('D') {
-						goto l280
+						goto l355
 					}
 					position++
 				}
-			l286:
+			l361:
 				{
-					position288, tokenIndex288, depth288 := position, tokenIndex, depth
+					position363, tokenIndex363, depth363 := position, tokenIndex, depth
 					if buffer[position] != rune('a') {
-						goto l289
+						goto l364
 					}
 					position++
-					goto l288
-				l289:
-					position, tokenIndex, depth = position288, tokenIndex288, depth288
+					goto l363
+				l364:
+					position, tokenIndex, depth = position363, tokenIndex363, depth363
 					if buffer[position] != rune('A') {
-						goto l280
+						goto l355
 					}
 					position++
 				}
-			l288:
+			l363:
 				{
-					position290, tokenIndex290, depth290 := position, tokenIndex, depth
+					position365, tokenIndex365, depth365 := position, tokenIndex, depth
 					if buffer[position] != rune('t') {
-						goto l291
+						goto l366
 					}
 					position++
-					goto l290
-				l291:
-					position, tokenIndex, depth = position290, tokenIndex290, depth290
+					goto l365
+				l366:
+					position, tokenIndex, depth = position365, tokenIndex365, depth365
 					if buffer[position] != rune('T') {
-						goto l280
+						goto l355
 					}
 					position++
 				}
-			l290:
+			l365:
 				{
-					position292, tokenIndex292, depth292 := position, tokenIndex, depth
+					position367, tokenIndex367, depth367 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l293
+						goto l368
 					}
 					position++
-					goto l292
-				l293:
-					position, tokenIndex, depth = position292, tokenIndex292, depth292
+					goto l367
+				l368:
+					position, tokenIndex, depth = position367, tokenIndex367, depth367
 					if buffer[position] != rune('E') {
-						goto l280
+						goto l355
 					}
 					position++
 				}
-			l292:
+			l367:
 				if !_rules[rulesp]() {
-					goto l280
+					goto l355
 				}
 				{
-					position294, tokenIndex294, depth294 := position, tokenIndex, depth
+					position369, tokenIndex369, depth369 := position, tokenIndex, depth
 					if buffer[position] != rune('s') {
-						goto l295
+						goto l370
 					}
 					position++
-					goto l294
-				l295:
-					position, tokenIndex, depth = position294, tokenIndex294, depth294
+					goto l369
+				l370:
+					position, tokenIndex, depth = position369, tokenIndex369, depth369
 					if buffer[position] != rune('S') {
-						goto l280
+						goto l355
 					}
 					position++
 				}
-			l294:
+			l369:
 				{
-					position296, tokenIndex296, depth296 := position, tokenIndex, depth
+					position371, tokenIndex371, depth371 := position, tokenIndex, depth
 					if buffer[position] != rune('o') {
-						goto l297
+						goto l372
 					}
 					position++
-					goto l296
-				l297:
-					position, tokenIndex, depth = position296, tokenIndex296, depth296
+					goto l371
+				l372:
+					position, tokenIndex, depth = position371, tokenIndex371, depth371
 					if buffer[position] != rune('O') {
-						goto l280
+						goto l355
 					}
 					position++
 				}
-			l296:
+			l371:
 				{
-					position298, tokenIndex298, depth298 := position, tokenIndex, depth
+					position373, tokenIndex373, depth373 := position, tokenIndex, depth
 					if buffer[position] != rune('u') {
-						goto l299
+						goto l374
 					}
 					position++
-					goto l298
-				l299:
-					position, tokenIndex, depth = position298, tokenIndex298, depth298
+					goto l373
+				l374:
+					position, tokenIndex, depth = position373, tokenIndex373, depth373
 					if buffer[position] != rune('U') {
-						goto l280
+						goto l355
 					}
 					position++
 				}
-			l298:
+			l373:
 				{
-					position300, tokenIndex300, depth300 := position, tokenIndex, depth
+					position375, tokenIndex375, depth375 := position, tokenIndex, depth
 					if buffer[position] != rune('r') {
-						goto l301
+						goto l376
 					}
 					position++
-					goto l300
-				l301:
-					position, tokenIndex, depth = position300, tokenIndex300, depth300
+					goto l375
+				l376:
+					position, tokenIndex, depth = position375, tokenIndex375, depth375
 					if buffer[position] != rune('R') {
-						goto l280
+						goto l355
 					}
 					position++
 				}
-			l300:
+			l375:
 				{
-					position302, tokenIndex302, depth302 := position, tokenIndex, depth
+					position377, tokenIndex377, depth377 := position, tokenIndex, depth
 					if buffer[position] != rune('c') {
-						goto l303
+						goto l378
 					}
 					position++
-					goto l302
-				l303:
-					position, tokenIndex, depth = position302, tokenIndex302, depth302
+					goto l377
+				l378:
+					position, tokenIndex, depth = position377, tokenIndex377, depth377
 					if buffer[position] != rune('C') {
-						goto l280
+						goto l355
 					}
 					position++
 				}
-			l302:
+			l377:
 				{
-					position304, tokenIndex304, depth304 := position, tokenIndex, depth
+					position379, tokenIndex379, depth379 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l305
+						goto l380
 					}
 					position++
-					goto l304
-				l305:
-					position, tokenIndex, depth = position304, tokenIndex304, depth304
+					goto l379
+				l380:
+					position, tokenIndex, depth = position379, tokenIndex379, depth379
 					if buffer[position] != rune('E') {
-						goto l280
+						goto l355
 					}
 					position++
 				}
-			l304:
+			l379:
 				if !_rules[rulesp]() {
-					goto l280
+					goto l355
 				}
 				if !_rules[ruleStreamIdentifier]() {
-					goto l280
+					goto l355
 				}
 				if !_rules[ruleUpdateSourceSinkSpecs]() {
-					goto l280
+					goto l355
 				}
-				if !_rules[ruleAction10]() {
-					goto l280
+				if !_rules[ruleAction13]() {
+					goto l355
 				}
 				depth--
-				add(ruleUpdateSourceStmt, position281)
+				add(ruleUpdateSourceStmt, position356)
 			}
 			return true
-		l280:
-			position, tokenIndex, depth = position280, tokenIndex280, depth280
+		l355:
+			position, tokenIndex, depth = position355, tokenIndex355, depth355
 			return false
 		},
-		/* 17 UpdateSinkStmt <- <(('u' / 'U') ('p' / 'P') ('d' / 'D') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('i' / 'I') ('n' / 'N') ('k' / 'K')) sp StreamIdentifier UpdateSourceSinkSpecs Action11)> */
+		/* 20 UpdateSinkStmt <- <(('u' / 'U') ('p' / 'P') ('d' / 'D') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('i' / 'I') ('n' / 'N') ('k' / 'K')) sp StreamIdentifier UpdateSourceSinkSpecs Action14)> */
 		func() bool {
-			position306, tokenIndex306, depth306 := position, tokenIndex, depth
+			position381, tokenIndex381, depth381 := position, tokenIndex, depth
 			{
-				position307 := position
+				position382 := position
 				depth++
 				{
-					position308, tokenIndex308, depth308 := position, tokenIndex, depth
+					position383, tokenIndex383, depth383 := position, tokenIndex, depth
 					if buffer[position] != rune('u') {
-						goto l309
+						goto l384
 					}
 					position++
-					goto l308
-				l309:
-					position, tokenIndex, depth = position308, tokenIndex308, depth308
+					goto l383
+				l384:
+					position, tokenIndex, depth = position383, tokenIndex383, depth383
 					if buffer[position] != rune('U') {
-						goto l306
+						goto l381
 					}
 					position++
 				}
-			l308:
+			l383:
 				{
-					position310, tokenIndex310, depth310 := position, tokenIndex, depth
+					position385, tokenIndex385, depth385 := position, tokenIndex, depth
 					if buffer[position] != rune('p') {
-						goto l311
+						goto l386
 					}
 					position++
-					goto l310
-				l311:
-					position, tokenIndex, depth = position310, tokenIndex310, depth310
+					goto l385
+				l386:
+					position, tokenIndex, depth = position385, tokenIndex385, depth385
 					if buffer[position] != rune('P') {
-						goto l306
+						goto l381
 					}
 					position++
 				}
-			l310:
+			l385:
 				{
-					position312, tokenIndex312, depth312 := position, tokenIndex, depth
+					position387, tokenIndex387, depth387 := position, tokenIndex, depth
 					if buffer[position] != rune('d') {
-						goto l313
+						goto l388
 					}
 					position++
-					goto l312
-				l313:
-					position, tokenIndex, depth = position312, tokenIndex312, depth312
+					goto l387
+				l388:
+					position, tokenIndex, depth = position387, tokenIndex387, depth387
 					if buffer[position] != rune('D') {
-						goto l306
+						goto l381
 					}
 					position++
 				}
-			l312:
+			l387:
 				{
-					position314, tokenIndex314, depth314 := position, tokenIndex, depth
+					position389, tokenIndex389, depth389 := position, tokenIndex, depth
 					if buffer[position] != rune('a') {
-						goto l315
+						goto l390
 					}
 					position++
-					goto l314
-				l315:
-					position, tokenIndex, depth = position314, tokenIndex314, depth314
+					goto l389
+				l390:
+					position, tokenIndex, depth = position389, tokenIndex389, depth389
 					if buffer[position] != rune('A') {
-						goto l306
+						goto l381
 					}
 					position++
 				}
-			l314:
+			l389:
 				{
-					position316, tokenIndex316, depth316 := position, tokenIndex, depth
+					position391, tokenIndex391, depth391 := position, tokenIndex, depth
 					if buffer[position] != rune('t') {
-						goto l317
+						goto l392
 					}
 					position++
-					goto l316
-				l317:
-					position, tokenIndex, depth = position316, tokenIndex316, depth316
+					goto l391
+				l392:
+					position, tokenIndex, depth = position391, tokenIndex391, depth391
 					if buffer[position] != rune('T') {
-						goto l306
+						goto l381
 					}
 					position++
 				}
-			l316:
+			l391:
 				{
-					position318, tokenIndex318, depth318 := position, tokenIndex, depth
+					position393, tokenIndex393, depth393 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l319
+						goto l394
 					}
 					position++
-					goto l318
-				l319:
-					position, tokenIndex, depth = position318, tokenIndex318, depth318
+					goto l393
+				l394:
+					position, tokenIndex, depth = position393, tokenIndex393, depth393
 					if buffer[position] != rune('E') {
-						goto l306
+						goto l381
 					}
 					position++
 				}
-			l318:
+			l393:
 				if !_rules[rulesp]() {
-					goto l306
+					goto l381
 				}
 				{
-					position320, tokenIndex320, depth320 := position, tokenIndex, depth
+					position395, tokenIndex395, depth395 := position, tokenIndex, depth
 					if buffer[position] != rune('s') {
-						goto l321
+						goto l396
 					}
 					position++
-					goto l320
-				l321:
-					position, tokenIndex, depth = position320, tokenIndex320, depth320
+					goto l395
+				l396:
+					position, tokenIndex, depth = position395, tokenIndex395, depth395
 					if buffer[position] != rune('S') {
-						goto l306
+						goto l381
 					}
 					position++
 				}
-			l320:
+			l395:
 				{
-					position322, tokenIndex322, depth322 := position, tokenIndex, depth
+					position397, tokenIndex397, depth397 := position, tokenIndex, depth
 					if buffer[position] != rune('i') {
-						goto l323
+						goto l398
 					}
 					position++
-					goto l322
-				l323:
-					position, tokenIndex, depth = position322, tokenIndex322, depth322
+					goto l397
+				l398:
+					position, tokenIndex, depth = position397, tokenIndex397, depth397
 					if buffer[position] != rune('I') {
-						goto l306
+						goto l381
 					}
 					position++
 				}
-			l322:
+			l397:
 				{
-					position324, tokenIndex324, depth324 := position, tokenIndex, depth
+					position399, tokenIndex399, depth399 := position, tokenIndex, depth
 					if buffer[position] != rune('n') {
-						goto l325
+						goto l400
 					}
 					position++
-					goto l324
-				l325:
-					position, tokenIndex, depth = position324, tokenIndex324, depth324
+					goto l399
+				l400:
+					position, tokenIndex, depth = position399, tokenIndex399, depth399
 					if buffer[position] != rune('N') {
-						goto l306
+						goto l381
 					}
 					position++
 				}
-			l324:
+			l399:
 				{
-					position326, tokenIndex326, depth326 := position, tokenIndex, depth
+					position401, tokenIndex401, depth401 := position, tokenIndex, depth
 					if buffer[position] != rune('k') {
-						goto l327
+						goto l402
 					}
 					position++
-					goto l326
-				l327:
-					position, tokenIndex, depth = position326, tokenIndex326, depth326
+					goto l401
+				l402:
+					position, tokenIndex, depth = position401, tokenIndex401, depth401
 					if buffer[position] != rune('K') {
-						goto l306
+						goto l381
 					}
 					position++
 				}
-			l326:
+			l401:
 				if !_rules[rulesp]() {
-					goto l306
+					goto l381
 				}
 				if !_rules[ruleStreamIdentifier]() {
-					goto l306
+					goto l381
 				}
 				if !_rules[ruleUpdateSourceSinkSpecs]() {
-					goto l306
+					goto l381
 				}
-				if !_rules[ruleAction11]() {
-					goto l306
+				if !_rules[ruleAction14]() {
+					goto l381
 				}
 				depth--
-				add(ruleUpdateSinkStmt, position307)
+				add(ruleUpdateSinkStmt, position382)
 			}
 			return true
-		l306:
-			position, tokenIndex, depth = position306, tokenIndex306, depth306
+		l381:
+			position, tokenIndex, depth = position381, tokenIndex381, depth381
 			return false
 		},
-		/* 18 InsertIntoFromStmt <- <(('i' / 'I') ('n' / 'N') ('s' / 'S') ('e' / 'E') ('r' / 'R') ('t' / 'T') sp (('i' / 'I') ('n' / 'N') ('t' / 'T') ('o' / 'O')) sp StreamIdentifier sp (('f' / 'F') ('r' / 'R') ('o' / 'O') ('m' / 'M')) sp StreamIdentifier Action12)> */
+		/* 21 InsertIntoFromStmt <- <(('i' / 'I') ('n' / 'N') ('s' / 'S') ('e' / 'E') ('r' / 'R') ('t' / 'T') sp (('i' / 'I') ('n' / 'N') ('t' / 'T') ('o' / 'O')) sp StreamIdentifier sp (('f' / 'F') ('r' / 'R') ('o' / 'O') ('m' / 'M')) sp StreamIdentifier Action15)> */
 		func() bool {
-			position328, tokenIndex328, depth328 := position, tokenIndex, depth
+			position403, tokenIndex403, depth403 := position, tokenIndex, depth
 			{
-				position329 := position
+				position404 := position
 				depth++
 				{
-					position330, tokenIndex330, depth330 := position, tokenIndex, depth
+					position405, tokenIndex405, depth405 := position, tokenIndex, depth
 					if buffer[position] != rune('i') {
-						goto l331
+						goto l406
 					}
 					position++
-					goto l330
-				l331:
-					position, tokenIndex, depth = position330, tokenIndex330, depth330
+					goto l405
+				l406:
+					position, tokenIndex, depth = position405, tokenIndex405, depth405
 					if buffer[position] != rune('I') {
-						goto l328
+						goto l403
 					}
 					position++
 				}
-			l330:
+			l405:
 				{
-					position332, tokenIndex332, depth332 := position, tokenIndex, depth
+					position407, tokenIndex407, depth407 := position, tokenIndex, depth
 					if buffer[position] != rune('n') {
-						goto l333
+						goto l408
 					}
 					position++
-					goto l332
-				l333:
-					position, tokenIndex, depth = position332, tokenIndex332, depth332
+					goto l407
+				l408:
+					position, tokenIndex, depth = position407, tokenIndex407, depth407
 					if buffer[position] != rune('N') {
-						goto l328
+						goto l403
 					}
 					position++
 				}
-			l332:
+			l407:
 				{
-					position334, tokenIndex334, depth334 := position, tokenIndex, depth
+					position409, tokenIndex409, depth409 := position, tokenIndex, depth
 					if buffer[position] != rune('s') {
-						goto l335
+						goto l410
 					}
 					position++
-					goto l334
-				l335:
-					position, tokenIndex, depth = position334, tokenIndex334, depth334
+					goto l409
+				l410:
+					position, tokenIndex, depth = position409, tokenIndex409, depth409
 					if buffer[position] != rune('S') {
-						goto l328
+						goto l403
 					}
 					position++
 				}
-			l334:
+			l409:
 				{
-					position336, tokenIndex336, depth336 := position, tokenIndex, depth
+					position411, tokenIndex411, depth411 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l337
+						goto l412
 					}
 					position++
-					goto l336
-				l337:
-					position, tokenIndex, depth = position336, tokenIndex336, depth336
+					goto l411
+				l412:
+					position, tokenIndex, depth = position411, tokenIndex411, depth411
 					if buffer[position] != rune('E') {
-						goto l328
+						goto l403
 					}
 					position++
 				}
-			l336:
+			l411:
 				{
-					position338, tokenIndex338, depth338 := position, tokenIndex, depth
+					position413, tokenIndex413, depth413 := position, tokenIndex, depth
 					if buffer[position] != rune('r') {
-						goto l339
+						goto l414
 					}
 					position++
-					goto l338
-				l339:
-					position, tokenIndex, depth = position338, tokenIndex338, depth338
+					goto l413
+				l414:
+					position, tokenIndex, depth = position413, tokenIndex413, depth413
 					if buffer[position] != rune('R') {
-						goto l328
+						goto l403
 					}
 					position++
 				}
-			l338:
+			l413:
 				{
-					position340, tokenIndex340, depth340 := position, tokenIndex, depth
+					position415, tokenIndex415, depth415 := position, tokenIndex, depth
 					if buffer[position] != rune('t') {
-						goto l341
+						goto l416
 					}
 					position++
-					goto l340
-				l341:
-					position, tokenIndex, depth = position340, tokenIndex340, depth340
+					goto l415
+				l416:
+					position, tokenIndex, depth = position415, tokenIndex415, depth415
 					if buffer[position] != rune('T') {
-						goto l328
+						goto l403
 					}
 					position++
 				}
-			l340:
+			l415:
 				if !_rules[rulesp]() {
-					goto l328
+					goto l403
 				}
 				{
-					position342, tokenIndex342, depth342 := position, tokenIndex, depth
+					position417, tokenIndex417, depth417 := position, tokenIndex, depth
 					if buffer[position] != rune('i') {
-						goto l343
+						goto l418
 					}
 					position++
-					goto l342
-				l343:
-					position, tokenIndex, depth = position342, tokenIndex342, depth342
+					goto l417
+				l418:
+					position, tokenIndex, depth = position417, tokenIndex417, depth417
 					if buffer[position] != rune('I') {
-						goto l328
+						goto l403
 					}
 					position++
 				}
-			l342:
+			l417:
 				{
-					position344, tokenIndex344, depth344 := position, tokenIndex, depth
+					position419, tokenIndex419, depth419 := position, tokenIndex, depth
 					if buffer[position] != rune('n') {
-						goto l345
+						goto l420
 					}
 					position++
-					goto l344
-				l345:
-					position, tokenIndex, depth = position344, tokenIndex344, depth344
+					goto l419
+				l420:
+					position, tokenIndex, depth = position419, tokenIndex419, depth419
 					if buffer[position] != rune('N') {
-						goto l328
+						goto l403
 					}
 					position++
 				}
-			l344:
+			l419:
 				{
-					position346, tokenIndex346, depth346 := position, tokenIndex, depth
+					position421, tokenIndex421, depth421 := position, tokenIndex, depth
 					if buffer[position] != rune('t') {
-						goto l347
+						goto l422
 					}
 					position++
-					goto l346
-				l347:
-					position, tokenIndex, depth = position346, tokenIndex346, depth346
+					goto l421
+				l422:
+					position, tokenIndex, depth = position421, tokenIndex421, depth421
 					if buffer[position] != rune('T') {
-						goto l328
+						goto l403
 					}
 					position++
 				}
-			l346:
+			l421:
 				{
-					position348, tokenIndex348, depth348 := position, tokenIndex, depth
+					position423, tokenIndex423, depth423 := position, tokenIndex, depth
 					if buffer[position] != rune('o') {
-						goto l349
+						goto l424
 					}
 					position++
-					goto l348
-				l349:
-					position, tokenIndex, depth = position348, tokenIndex348, depth348
+					goto l423
+				l424:
+					position, tokenIndex, depth = position423, tokenIndex423, depth423
 					if buffer[position] != rune('O') {
-						goto l328
+						goto l403
 					}
 					position++
 				}
-			l348:
+			l423:
 				if !_rules[rulesp]() {
-					goto l328
+					goto l403
 				}
 				if !_rules[ruleStreamIdentifier]() {
-					goto l328
+					goto l403
 				}
 				if !_rules[rulesp]() {
-					goto l328
+					goto l403
 				}
 				{
-					position350, tokenIndex350, depth350 := position, tokenIndex, depth
+					position425, tokenIndex425, depth425 := position, tokenIndex, depth
 					if buffer[position] != rune('f') {
-						goto l351
+						goto l426
 					}
 					position++
-					goto l350
-				l351:
-					position, tokenIndex, depth = position350, tokenIndex350, depth350
+					goto l425
+				l426:
+					position, tokenIndex, depth = position425, tokenIndex425, depth425
 					if buffer[position] != rune('F') {
-						goto l328
+						goto l403
 					}
 					position++
 				}
-			l350:
+			l425:
 				{
-					position352, tokenIndex352, depth352 := position, tokenIndex, depth
+					position427, tokenIndex427, depth427 := position, tokenIndex, depth
 					if buffer[position] != rune('r') {
-						goto l353
+						goto l428
 					}
 					position++
-					goto l352
-				l353:
-					position, tokenIndex, depth = position352, tokenIndex352, depth352
+					goto l427
+				l428:
+					position, tokenIndex, depth = position427, tokenIndex427, depth427
 					if buffer[position] != rune('R') {
-						goto l328
+						goto l403
 					}
 					position++
 				}
-			l352:
+			l427:
 				{
-					position354, tokenIndex354, depth354 := position, tokenIndex, depth
+					position429, tokenIndex429, depth429 := position, tokenIndex, depth
 					if buffer[position] != rune('o') {
-						goto l355
+						goto l430
 					}
 					position++
-					goto l354
-				l355:
-					position, tokenIndex, depth = position354, tokenIndex354, depth354
+					goto l429
+				l430:
+					position, tokenIndex, depth = position429, tokenIndex429, depth429
 					if buffer[position] != rune('O') {
-						goto l328
+						goto l403
 					}
 					position++
 				}
-			l354:
+			l429:
 				{
-					position356, tokenIndex356, depth356 := position, tokenIndex, depth
+					position431, tokenIndex431, depth431 := position, tokenIndex, depth
 					if buffer[position] != rune('m') {
-						goto l357
+						goto l432
 					}
 					position++
-					goto l356
-				l357:
-					position, tokenIndex, depth = position356, tokenIndex356, depth356
+					goto l431
+				l432:
+					position, tokenIndex, depth = position431, tokenIndex431, depth431
 					if buffer[position] != rune('M') {
-						goto l328
+						goto l403
 					}
 					position++
 				}
-			l356:
+			l431:
 				if !_rules[rulesp]() {
-					goto l328
+					goto l403
 				}
 				if !_rules[ruleStreamIdentifier]() {
-					goto l328
+					goto l403
 				}
-				if !_rules[ruleAction12]() {
-					goto l328
+				if !_rules[ruleAction15]() {
+					goto l403
 				}
 				depth--
-				add(ruleInsertIntoFromStmt, position329)
+				add(ruleInsertIntoFromStmt, position404)
 			}
 			return true
-		l328:
-			position, tokenIndex, depth = position328, tokenIndex328, depth328
+		l403:
+			position, tokenIndex, depth = position403, tokenIndex403, depth403
 			return false
 		},
-		/* 19 PauseSourceStmt <- <(('p' / 'P') ('a' / 'A') ('u' / 'U') ('s' / 'S') ('e' / 'E') sp (('s' / 'S') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('c' / 'C') ('e' / 'E')) sp StreamIdentifier Action13)> */
+		/* 22 PauseSourceStmt <- <(('p' / 'P') ('a' / 'A') ('u' / 'U') ('s' / 'S') ('e' / 'E') sp (('s' / 'S') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('c' / 'C') ('e' / 'E')) sp StreamIdentifier Action16)> */
 		func() bool {
-			position358, tokenIndex358, depth358 := position, tokenIndex, depth
+			position433, tokenIndex433, depth433 := position, tokenIndex, depth
 			{
-				position359 := position
+				position434 := position
 				depth++
 				{
-					position360, tokenIndex360, depth360 := position, tokenIndex, depth
+					position435, tokenIndex435, depth435 := position, tokenIndex, depth
 					if buffer[position] != rune('p') {
-						goto l361
+						goto l436
 					}
 					position++
-					goto l360
-				l361:
-					position, tokenIndex, depth = position360, tokenIndex360, depth360
+					goto l435
+				l436:
+					position, tokenIndex, depth = position435, tokenIndex435, depth435
 					if buffer[position] != rune('P') {
-						goto l358
+						goto l433
 					}
 					position++
 				}
-			l360:
+			l435:
 				{
-					position362, tokenIndex362, depth362 := position, tokenIndex, depth
+					position437, tokenIndex437, depth437 := position, tokenIndex, depth
 					if buffer[position] != rune('a') {
-						goto l363
+						goto l438
 					}
 					position++
-					goto l362
-				l363:
-					position, tokenIndex, depth = position362, tokenIndex362, depth362
+					goto l437
+				l438:
+					position, tokenIndex, depth = position437, tokenIndex437, depth437
 					if buffer[position] != rune('A') {
-						goto l358
+						goto l433
 					}
 					position++
 				}
-			l362:
+			l437:
 				{
-					position364, tokenIndex364, depth364 := position, tokenIndex, depth
+					position439, tokenIndex439, depth439 := position, tokenIndex, depth
 					if buffer[position] != rune('u') {
-						goto l365
+						goto l440
 					}
 					position++
-					goto l364
-				l365:
-					position, tokenIndex, depth = position364, tokenIndex364, depth364
+					goto l439
+				l440:
+					position, tokenIndex, depth = position439, tokenIndex439, depth439
 					if buffer[position] != rune('U') {
-						goto l358
+						goto l433
 					}
 					position++
 				}
-			l364:
+			l439:
 				{
-					position366, tokenIndex366, depth366 := position, tokenIndex, depth
+					position441, tokenIndex441, depth441 := position, tokenIndex, depth
 					if buffer[position] != rune('s') {
-						goto l367
+						goto l442
 					}
 					position++
-					goto l366
-				l367:
-					position, tokenIndex, depth = position366, tokenIndex366, depth366
+					goto l441
+				l442:
+					position, tokenIndex, depth = position441, tokenIndex441, depth441
 					if buffer[position] != rune('S') {
-						goto l358
+						goto l433
 					}
 					position++
 				}
-			l366:
+			l441:
 				{
-					position368, tokenIndex368, depth368 := position, tokenIndex, depth
+					position443, tokenIndex443, depth443 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l369
+						goto l444
 					}
 					position++
-					goto l368
-				l369:
-					position, tokenIndex, depth = position368, tokenIndex368, depth368
+					goto l443
+				l444:
+					position, tokenIndex, depth = position443, tokenIndex443, depth443
 					if buffer[position] != rune('E') {
-						goto l358
+						goto l433
 					}
 					position++
 				}
-			l368:
+			l443:
 				if !_rules[rulesp]() {
-					goto l358
+					goto l433
 				}
 				{
-					position370, tokenIndex370, depth370 := position, tokenIndex, depth
+					position445, tokenIndex445, depth445 := position, tokenIndex, depth
 					if buffer[position] != rune('s') {
-						goto l371
+						goto l446
 					}
 					position++
-					goto l370
-				l371:
-					position, tokenIndex, depth = position370, tokenIndex370, depth370
+					goto l445
+				l446:
+					position, tokenIndex, depth = position445, tokenIndex445, depth445
 					if buffer[position] != rune('S') {
-						goto l358
+						goto l433
 					}
 					position++
 				}
-			l370:
+			l445:
 				{
-					position372, tokenIndex372, depth372 := position, tokenIndex, depth
+					position447, tokenIndex447, depth447 := position, tokenIndex, depth
 					if buffer[position] != rune('o') {
-						goto l373
+						goto l448
 					}
 					position++
-					goto l372
-				l373:
-					position, tokenIndex, depth = position372, tokenIndex372, depth372
+					goto l447
+				l448:
+					position, tokenIndex, depth = position447, tokenIndex447, depth447
 					if buffer[position] != rune('O') {
-						goto l358
+						goto l433
 					}
 					position++
 				}
-			l372:
+			l447:
 				{
-					position374, tokenIndex374, depth374 := position, tokenIndex, depth
+					position449, tokenIndex449, depth449 := position, tokenIndex, depth
 					if buffer[position] != rune('u') {
-						goto l375
+						goto l450
 					}
 					position++
-					goto l374
-				l375:
-					position, tokenIndex, depth = position374, tokenIndex374, depth374
+					goto l449
+				l450:
+					position, tokenIndex, depth = position449, tokenIndex449, depth449
 					if buffer[position] != rune('U') {
-						goto l358
+						goto l433
 					}
 					position++
 				}
-			l374:
+			l449:
 				{
-					position376, tokenIndex376, depth376 := position, tokenIndex, depth
+					position451, tokenIndex451, depth451 := position, tokenIndex, depth
 					if buffer[position] != rune('r') {
-						goto l377
+						goto l452
 					}
 					position++
-					goto l376
-				l377:
-					position, tokenIndex, depth = position376, tokenIndex376, depth376
+					goto l451
+				l452:
+					position, tokenIndex, depth = position451, tokenIndex451, depth451
 					if buffer[position] != rune('R') {
-						goto l358
+						goto l433
 					}
 					position++
 				}
-			l376:
+			l451:
 				{
-					position378, tokenIndex378, depth378 := position, tokenIndex, depth
+					position453, tokenIndex453, depth453 := position, tokenIndex, depth
 					if buffer[position] != rune('c') {
-						goto l379
+						goto l454
 					}
 					position++
-					goto l378
-				l379:
-					position, tokenIndex, depth = position378, tokenIndex378, depth378
+					goto l453
+				l454:
+					position, tokenIndex, depth = position453, tokenIndex453, depth453
 					if buffer[position] != rune('C') {
-						goto l358
+						goto l433
 					}
 					position++
 				}
-			l378:
+			l453:
 				{
-					position380, tokenIndex380, depth380 := position, tokenIndex, depth
+					position455, tokenIndex455, depth455 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l381
+						goto l456
 					}
 					position++
-					goto l380
-				l381:
-					position, tokenIndex, depth = position380, tokenIndex380, depth380
+					goto l455
+				l456:
+					position, tokenIndex, depth = position455, tokenIndex455, depth455
 					if buffer[position] != rune('E') {
-						goto l358
+						goto l433
 					}
 					position++
 				}
-			l380:
+			l455:
 				if !_rules[rulesp]() {
-					goto l358
+					goto l433
 				}
 				if !_rules[ruleStreamIdentifier]() {
-					goto l358
+					goto l433
 				}
-				if !_rules[ruleAction13]() {
-					goto l358
+				if !_rules[ruleAction16]() {
+					goto l433
 				}
 				depth--
-				add(rulePauseSourceStmt, position359)
+				add(rulePauseSourceStmt, position434)
 			}
 			return true
-		l358:
-			position, tokenIndex, depth = position358, tokenIndex358, depth358
+		l433:
+			position, tokenIndex, depth = position433, tokenIndex433, depth433
 			return false
 		},
-		/* 20 ResumeSourceStmt <- <(('r' / 'R') ('e' / 'E') ('s' / 'S') ('u' / 'U') ('m' / 'M') ('e' / 'E') sp (('s' / 'S') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('c' / 'C') ('e' / 'E')) sp StreamIdentifier Action14)> */
+		/* 23 ResumeSourceStmt <- <(('r' / 'R') ('e' / 'E') ('s' / 'S') ('u' / 'U') ('m' / 'M') ('e' / 'E') sp (('s' / 'S') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('c' / 'C') ('e' / 'E')) sp StreamIdentifier Action17)> */
 		func() bool {
-			position382, tokenIndex382, depth382 := position, tokenIndex, depth
+			position457, tokenIndex457, depth457 := position, tokenIndex, depth
 			{
-				position383 := position
+				position458 := position
 				depth++
 				{
-					position384, tokenIndex384, depth384 := position, tokenIndex, depth
+					position459, tokenIndex459, depth459 := position, tokenIndex, depth
 					if buffer[position] != rune('r') {
-						goto l385
+						goto l460
 					}
 					position++
-					goto l384
-				l385:
-					position, tokenIndex, depth = position384, tokenIndex384, depth384
+					goto l459
+				l460:
+					position, tokenIndex, depth = position459, tokenIndex459, depth459
 					if buffer[position] != rune('R') {
-						goto l382
+						goto l457
 					}
 					position++
 				}
-			l384:
+			l459:
 				{
-					position386, tokenIndex386, depth386 := position, tokenIndex, depth
+					position461, tokenIndex461, depth461 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l387
+						goto l462
 					}
 					position++
-					goto l386
-				l387:
-					position, tokenIndex, depth = position386, tokenIndex386, depth386
+					goto l461
+				l462:
+					position, tokenIndex, depth = position461, tokenIndex461, depth461
 					if buffer[position] != rune('E') {
-						goto l382
+						goto l457
 					}
 					position++
 				}
-			l386:
+			l461:
 				{
-					position388, tokenIndex388, depth388 := position, tokenIndex, depth
+					position463, tokenIndex463, depth463 := position, tokenIndex, depth
 					if buffer[position] != rune('s') {
-						goto l389
+						goto l464
 					}
 					position++
-					goto l388
-				l389:
-					position, tokenIndex, depth = position388, tokenIndex388, depth388
+					goto l463
+				l464:
+					position, tokenIndex, depth = position463, tokenIndex463, depth463
 					if buffer[position] != rune('S') {
-						goto l382
+						goto l457
 					}
 					position++
 				}
-			l388:
+			l463:
 				{
-					position390, tokenIndex390, depth390 := position, tokenIndex, depth
+					position465, tokenIndex465, depth465 := position, tokenIndex, depth
 					if buffer[position] != rune('u') {
-						goto l391
+						goto l466
 					}
 					position++
-					goto l390
-				l391:
-					position, tokenIndex, depth = position390, tokenIndex390, depth390
+					goto l465
+				l466:
+					position, tokenIndex, depth = position465, tokenIndex465, depth465
 					if buffer[position] != rune('U') {
-						goto l382
+						goto l457
 					}
 					position++
 				}
-			l390:
+			l465:
 				{
-					position392, tokenIndex392, depth392 := position, tokenIndex, depth
+					position467, tokenIndex467, depth467 := position, tokenIndex, depth
 					if buffer[position] != rune('m') {
-						goto l393
+						goto l468
 					}
 					position++
-					goto l392
-				l393:
-					position, tokenIndex, depth = position392, tokenIndex392, depth392
+					goto l467
+				l468:
+					position, tokenIndex, depth = position467, tokenIndex467, depth467
 					if buffer[position] != rune('M') {
-						goto l382
+						goto l457
 					}
 					position++
 				}
-			l392:
+			l467:
 				{
-					position394, tokenIndex394, depth394 := position, tokenIndex, depth
+					position469, tokenIndex469, depth469 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l395
+						goto l470
 					}
 					position++
-					goto l394
-				l395:
-					position, tokenIndex, depth = position394, tokenIndex394, depth394
+					goto l469
+				l470:
+					position, tokenIndex, depth = position469, tokenIndex469, depth469
 					if buffer[position] != rune('E') {
-						goto l382
+						goto l457
 					}
 					position++
 				}
-			l394:
+			l469:
 				if !_rules[rulesp]() {
-					goto l382
+					goto l457
 				}
 				{
-					position396, tokenIndex396, depth396 := position, tokenIndex, depth
+					position471, tokenIndex471, depth471 := position, tokenIndex, depth
 					if buffer[position] != rune('s') {
-						goto l397
+						goto l472
 					}
 					position++
-					goto l396
-				l397:
-					position, tokenIndex, depth = position396, tokenIndex396, depth396
+					goto l471
+				l472:
+					position, tokenIndex, depth = position471, tokenIndex471, depth471
 					if buffer[position] != rune('S') {
-						goto l382
+						goto l457
 					}
 					position++
 				}
-			l396:
+			l471:
 				{
-					position398, tokenIndex398, depth398 := position, tokenIndex, depth
+					position473, tokenIndex473, depth473 := position, tokenIndex, depth
 					if buffer[position] != rune('o') {
-						goto l399
+						goto l474
 					}
 					position++
-					goto l398
-				l399:
-					position, tokenIndex, depth = position398, tokenIndex398, depth398
+					goto l473
+				l474:
+					position, tokenIndex, depth = position473, tokenIndex473, depth473
 					if buffer[position] != rune('O') {
-						goto l382
+						goto l457
 					}
 					position++
 				}
-			l398:
+			l473:
 				{
-					position400, tokenIndex400, depth400 := position, tokenIndex, depth
+					position475, tokenIndex475, depth475 := position, tokenIndex, depth
 					if buffer[position] != rune('u') {
-						goto l401
+						goto l476
 					}
 					position++
-					goto l400
-				l401:
-					position, tokenIndex, depth = position400, tokenIndex400, depth400
+					goto l475
+				l476:
+					position, tokenIndex, depth = position475, tokenIndex475, depth475
 					if buffer[position] != rune('U') {
-						goto l382
+						goto l457
 					}
 					position++
 				}
-			l400:
+			l475:
 				{
-					position402, tokenIndex402, depth402 := position, tokenIndex, depth
+					position477, tokenIndex477, depth477 := position, tokenIndex, depth
 					if buffer[position] != rune('r') {
-						goto l403
+						goto l478
 					}
 					position++
-					goto l402
-				l403:
-					position, tokenIndex, depth = position402, tokenIndex402, depth402
+					goto l477
+				l478:
+					position, tokenIndex, depth = position477, tokenIndex477, depth477
 					if buffer[position] != rune('R') {
-						goto l382
+						goto l457
 					}
 					position++
 				}
-			l402:
+			l477:
 				{
-					position404, tokenIndex404, depth404 := position, tokenIndex, depth
+					position479, tokenIndex479, depth479 := position, tokenIndex, depth
 					if buffer[position] != rune('c') {
-						goto l405
+						goto l480
 					}
 					position++
-					goto l404
-				l405:
-					position, tokenIndex, depth = position404, tokenIndex404, depth404
+					goto l479
+				l480:
+					position, tokenIndex, depth = position479, tokenIndex479, depth479
 					if buffer[position] != rune('C') {
-						goto l382
+						goto l457
 					}
 					position++
 				}
-			l404:
+			l479:
 				{
-					position406, tokenIndex406, depth406 := position, tokenIndex, depth
+					position481, tokenIndex481, depth481 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l407
+						goto l482
 					}
 					position++
-					goto l406
-				l407:
-					position, tokenIndex, depth = position406, tokenIndex406, depth406
+					goto l481
+				l482:
+					position, tokenIndex, depth = position481, tokenIndex481, depth481
 					if buffer[position] != rune('E') {
-						goto l382
+						goto l457
 					}
 					position++
 				}
-			l406:
+			l481:
 				if !_rules[rulesp]() {
-					goto l382
+					goto l457
 				}
 				if !_rules[ruleStreamIdentifier]() {
-					goto l382
+					goto l457
 				}
-				if !_rules[ruleAction14]() {
-					goto l382
+				if !_rules[ruleAction17]() {
+					goto l457
 				}
 				depth--
-				add(ruleResumeSourceStmt, position383)
+				add(ruleResumeSourceStmt, position458)
 			}
 			return true
-		l382:
-			position, tokenIndex, depth = position382, tokenIndex382, depth382
+		l457:
+			position, tokenIndex, depth = position457, tokenIndex457, depth457
 			return false
 		},
-		/* 21 RewindSourceStmt <- <(('r' / 'R') ('e' / 'E') ('w' / 'W') ('i' / 'I') ('n' / 'N') ('d' / 'D') sp (('s' / 'S') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('c' / 'C') ('e' / 'E')) sp StreamIdentifier Action15)> */
+		/* 24 RewindSourceStmt <- <(('r' / 'R') ('e' / 'E') ('w' / 'W') ('i' / 'I') ('n' / 'N') ('d' / 'D') sp (('s' / 'S') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('c' / 'C') ('e' / 'E')) sp StreamIdentifier Action18)> */
 		func() bool {
-			position408, tokenIndex408, depth408 := position, tokenIndex, depth
+			position483, tokenIndex483, depth483 := position, tokenIndex, depth
 			{
-				position409 := position
+				position484 := position
 				depth++
 				{
-					position410, tokenIndex410, depth410 := position, tokenIndex, depth
+					position485, tokenIndex485, depth485 := position, tokenIndex, depth
 					if buffer[position] != rune('r') {
-						goto l411
+						goto l486
 					}
 					position++
-					goto l410
-				l411:
-					position, tokenIndex, depth = position410, tokenIndex410, depth410
+					goto l485
+				l486:
+					position, tokenIndex, depth = position485, tokenIndex485, depth485
 					if buffer[position] != rune('R') {
-						goto l408
+						goto l483
 					}
 					position++
 				}
-			l410:
+			l485:
 				{
-					position412, tokenIndex412, depth412 := position, tokenIndex, depth
+					position487, tokenIndex487, depth487 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l413
+						goto l488
 					}
 					position++
-					goto l412
-				l413:
-					position, tokenIndex, depth = position412, tokenIndex412, depth412
+					goto l487
+				l488:
+					position, tokenIndex, depth = position487, tokenIndex487, depth487
 					if buffer[position] != rune('E') {
-						goto l408
+						goto l483
 					}
 					position++
 				}
-			l412:
+			l487:
 				{
-					position414, tokenIndex414, depth414 := position, tokenIndex, depth
+					position489, tokenIndex489, depth489 := position, tokenIndex, depth
 					if buffer[position] != rune('w') {
-						goto l415
+						goto l490
 					}
 					position++
-					goto l414
-				l415:
-					position, tokenIndex, depth = position414, tokenIndex414, depth414
+					goto l489
+				l490:
+					position, tokenIndex, depth = position489, tokenIndex489, depth489
 					if buffer[position] != rune('W') {
-						goto l408
+						goto l483
 					}
 					position++
 				}
-			l414:
+			l489:
 				{
-					position416, tokenIndex416, depth416 := position, tokenIndex, depth
+					position491, tokenIndex491, depth491 := position, tokenIndex, depth
 					if buffer[position] != rune('i') {
-						goto l417
+						goto l492
 					}
 					position++
-					goto l416
-				l417:
-					position, tokenIndex, depth = position416, tokenIndex416, depth416
+					goto l491
+				l492:
+					position, tokenIndex, depth = position491, tokenIndex491, depth491
 					if buffer[position] != rune('I') {
-						goto l408
+						goto l483
 					}
 					position++
 				}
-			l416:
+			l491:
 				{
-					position418, tokenIndex418, depth418 := position, tokenIndex, depth
+					position493, tokenIndex493, depth493 := position, tokenIndex, depth
 					if buffer[position] != rune('n') {
-						goto l419
+						goto l494
 					}
 					position++
-					goto l418
-				l419:
-					position, tokenIndex, depth = position418, tokenIndex418, depth418
+					goto l493
+				l494:
+					position, tokenIndex, depth = position493, tokenIndex493, depth493
 					if buffer[position] != rune('N') {
-						goto l408
+						goto l483
 					}
 					position++
 				}
-			l418:
+			l493:
 				{
-					position420, tokenIndex420, depth420 := position, tokenIndex, depth
+					position495, tokenIndex495, depth495 := position, tokenIndex, depth
 					if buffer[position] != rune('d') {
-						goto l421
+						goto l496
 					}
 					position++
-					goto l420
-				l421:
-					position, tokenIndex, depth = position420, tokenIndex420, depth420
+					goto l495
+				l496:
+					position, tokenIndex, depth = position495, tokenIndex495, depth495
 					if buffer[position] != rune('D') {
-						goto l408
+						goto l483
 					}
 					position++
 				}
-			l420:
+			l495:
 				if !_rules[rulesp]() {
-					goto l408
+					goto l483
 				}
 				{
-					position422, tokenIndex422, depth422 := position, tokenIndex, depth
+					position497, tokenIndex497, depth497 := position, tokenIndex, depth
 					if buffer[position] != rune('s') {
-						goto l423
+						goto l498
 					}
 					position++
-					goto l422
-				l423:
-					position, tokenIndex, depth = position422, tokenIndex422, depth422
+					goto l497
+				l498:
+					position, tokenIndex, depth = position497, tokenIndex497, depth497
 					if buffer[position] != rune('S') {
-						goto l408
+						goto l483
 					}
 					position++
 				}
-			l422:
+			l497:
 				{
-					position424, tokenIndex424, depth424 := position, tokenIndex, depth
+					position499, tokenIndex499, depth499 := position, tokenIndex, depth
 					if buffer[position] != rune('o') {
-						goto l425
+						goto l500
 					}
 					position++
-					goto l424
-				l425:
-					position, tokenIndex, depth = position424, tokenIndex424, depth424
+					goto l499
+				l500:
+					position, tokenIndex, depth = position499, tokenIndex499, depth499
 					if buffer[position] != rune('O') {
-						goto l408
+						goto l483
 					}
 					position++
 				}
-			l424:
+			l499:
 				{
-					position426, tokenIndex426, depth426 := position, tokenIndex, depth
+					position501, tokenIndex501, depth501 := position, tokenIndex, depth
 					if buffer[position] != rune('u') {
-						goto l427
+						goto l502
 					}
 					position++
-					goto l426
-				l427:
-					position, tokenIndex, depth = position426, tokenIndex426, depth426
+					goto l501
+				l502:
+					position, tokenIndex, depth = position501, tokenIndex501, depth501
 					if buffer[position] != rune('U') {
-						goto l408
+						goto l483
 					}
 					position++
 				}
-			l426:
+			l501:
 				{
-					position428, tokenIndex428, depth428 := position, tokenIndex, depth
+					position503, tokenIndex503, depth503 := position, tokenIndex, depth
 					if buffer[position] != rune('r') {
-						goto l429
+						goto l504
 					}
 					position++
-					goto l428
-				l429:
-					position, tokenIndex, depth = position428, tokenIndex428, depth428
+					goto l503
+				l504:
+					position, tokenIndex, depth = position503, tokenIndex503, depth503
 					if buffer[position] != rune('R') {
-						goto l408
+						goto l483
 					}
 					position++
 				}
-			l428:
+			l503:
 				{
-					position430, tokenIndex430, depth430 := position, tokenIndex, depth
+					position505, tokenIndex505, depth505 := position, tokenIndex, depth
 					if buffer[position] != rune('c') {
-						goto l431
+						goto l506
 					}
 					position++
-					goto l430
-				l431:
-					position, tokenIndex, depth = position430, tokenIndex430, depth430
+					goto l505
+				l506:
+					position, tokenIndex, depth = position505, tokenIndex505, depth505
 					if buffer[position] != rune('C') {
-						goto l408
+						goto l483
 					}
 					position++
 				}
-			l430:
+			l505:
 				{
-					position432, tokenIndex432, depth432 := position, tokenIndex, depth
+					position507, tokenIndex507, depth507 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l433
+						goto l508
 					}
 					position++
-					goto l432
-				l433:
-					position, tokenIndex, depth = position432, tokenIndex432, depth432
+					goto l507
+				l508:
+					position, tokenIndex, depth = position507, tokenIndex507, depth507
 					if buffer[position] != rune('E') {
-						goto l408
+						goto l483
 					}
 					position++
 				}
-			l432:
+			l507:
 				if !_rules[rulesp]() {
-					goto l408
+					goto l483
 				}
 				if !_rules[ruleStreamIdentifier]() {
-					goto l408
+					goto l483
 				}
-				if !_rules[ruleAction15]() {
-					goto l408
+				if !_rules[ruleAction18]() {
+					goto l483
 				}
 				depth--
-				add(ruleRewindSourceStmt, position409)
+				add(ruleRewindSourceStmt, position484)
 			}
 			return true
-		l408:
-			position, tokenIndex, depth = position408, tokenIndex408, depth408
+		l483:
+			position, tokenIndex, depth = position483, tokenIndex483, depth483
 			return false
 		},
-		/* 22 DropSourceStmt <- <(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('s' / 'S') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('c' / 'C') ('e' / 'E')) sp StreamIdentifier Action16)> */
+		/* 25 DropSourceStmt <- <(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('s' / 'S') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('c' / 'C') ('e' / 'E')) sp StreamIdentifier Action19)> */
 		func() bool {
-			position434, tokenIndex434, depth434 := position, tokenIndex, depth
+			position509, tokenIndex509, depth509 := position, tokenIndex, depth
 			{
-				position435 := position
+				position510 := position
 				depth++
 				{
-					position436, tokenIndex436, depth436 := position, tokenIndex, depth
+					position511, tokenIndex511, depth511 := position, tokenIndex, depth
 					if buffer[position] != rune('d') {
-						goto l437
+						goto l512
 					}
 					position++
-					goto l436
-				l437:
-					position, tokenIndex, depth = position436, tokenIndex436, depth436
+					goto l511
+				l512:
+					position, tokenIndex, depth = position511, tokenIndex511, depth511
 					if buffer[position] != rune('D') {
-						goto l434
+						goto l509
 					}
 					position++
 				}
-			l436:
+			l511:
 				{
-					position438, tokenIndex438, depth438 := position, tokenIndex, depth
+					position513, tokenIndex513, depth513 := position, tokenIndex, depth
 					if buffer[position] != rune('r') {
-						goto l439
+						goto l514
 					}
 					position++
-					goto l438
-				l439:
-					position, tokenIndex, depth = position438, tokenIndex438, depth438
+					goto l513
+				l514:
+					position, tokenIndex, depth = position513, tokenIndex513, depth513
 					if buffer[position] != rune('R') {
-						goto l434
+						goto l509
 					}
 					position++
 				}
-			l438:
+			l513:
 				{
-					position440, tokenIndex440, depth440 := position, tokenIndex, depth
+					position515, tokenIndex515, depth515 := position, tokenIndex, depth
 					if buffer[position] != rune('o') {
-						goto l441
+						goto l516
 					}
 					position++
-					goto l440
-				l441:
-					position, tokenIndex, depth = position440, tokenIndex440, depth440
+					goto l515
+				l516:
+					position, tokenIndex, depth = position515, tokenIndex515, depth515
 					if buffer[position] != rune('O') {
-						goto l434
+						goto l509
 					}
 					position++
 				}
-			l440:
+			l515:
 				{
-					position442, tokenIndex442, depth442 := position, tokenIndex, depth
+					position517, tokenIndex517, depth517 := position, tokenIndex, depth
 					if buffer[position] != rune('p') {
-						goto l443
+						goto l518
 					}
 					position++
-					goto l442
-				l443:
-					position, tokenIndex, depth = position442, tokenIndex442, depth442
+					goto l517
+				l518:
+					position, tokenIndex, depth = position517, tokenIndex517, depth517
 					if buffer[position] != rune('P') {
-						goto l434
+						goto l509
 					}
 					position++
 				}
-			l442:
+			l517:
 				if !_rules[rulesp]() {
-					goto l434
+					goto l509
 				}
 				{
-					position444, tokenIndex444, depth444 := position, tokenIndex, depth
+					position519, tokenIndex519, depth519 := position, tokenIndex, depth
 					if buffer[position] != rune('s') {
-						goto l445
+						goto l520
 					}
 					position++
-					goto l444
-				l445:
-					position, tokenIndex, depth = position444, tokenIndex444, depth444
+					goto l519
+				l520:
+					position, tokenIndex, depth = position519, tokenIndex519, depth519
 					if buffer[position] != rune('S') {
-						goto l434
+						goto l509
 					}
 					position++
 				}
-			l444:
+			l519:
 				{
-					position446, tokenIndex446, depth446 := position, tokenIndex, depth
+					position521, tokenIndex521, depth521 := position, tokenIndex, depth
 					if buffer[position] != rune('o') {
-						goto l447
+						goto l522
 					}
 					position++
-					goto l446
-				l447:
-					position, tokenIndex, depth = position446, tokenIndex446, depth446
+					goto l521
+				l522:
+					position, tokenIndex, depth = position521, tokenIndex521, depth521
 					if buffer[position] != rune('O') {
-						goto l434
+						goto l509
 					}
 					position++
 				}
-			l446:
+			l521:
 				{
-					position448, tokenIndex448, depth448 := position, tokenIndex, depth
+					position523, tokenIndex523, depth523 := position, tokenIndex, depth
 					if buffer[position] != rune('u') {
-						goto l449
+						goto l524
 					}
 					position++
-					goto l448
-				l449:
-					position, tokenIndex, depth = position448, tokenIndex448, depth448
+					goto l523
+				l524:
+					position, tokenIndex, depth = position523, tokenIndex523, depth523
 					if buffer[position] != rune('U') {
-						goto l434
+						goto l509
 					}
 					position++
 				}
-			l448:
+			l523:
 				{
-					position450, tokenIndex450, depth450 := position, tokenIndex, depth
+					position525, tokenIndex525, depth525 := position, tokenIndex, depth
 					if buffer[position] != rune('r') {
-						goto l451
+						goto l526
 					}
 					position++
-					goto l450
-				l451:
-					position, tokenIndex, depth = position450, tokenIndex450, depth450
+					goto l525
+				l526:
+					position, tokenIndex, depth = position525, tokenIndex525, depth525
 					if buffer[position] != rune('R') {
-						goto l434
+						goto l509
 					}
 					position++
 				}
-			l450:
+			l525:
 				{
-					position452, tokenIndex452, depth452 := position, tokenIndex, depth
+					position527, tokenIndex527, depth527 := position, tokenIndex, depth
 					if buffer[position] != rune('c') {
-						goto l453
+						goto l528
 					}
 					position++
-					goto l452
-				l453:
-					position, tokenIndex, depth = position452, tokenIndex452, depth452
+					goto l527
+				l528:
+					position, tokenIndex, depth = position527, tokenIndex527, depth527
 					if buffer[position] != rune('C') {
-						goto l434
+						goto l509
 					}
 					position++
 				}
-			l452:
+			l527:
 				{
-					position454, tokenIndex454, depth454 := position, tokenIndex, depth
+					position529, tokenIndex529, depth529 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l455
+						goto l530
 					}
 					position++
-					goto l454
-				l455:
-					position, tokenIndex, depth = position454, tokenIndex454, depth454
+					goto l529
+				l530:
+					position, tokenIndex, depth = position529, tokenIndex529, depth529
 					if buffer[position] != rune('E') {
-						goto l434
+						goto l509
 					}
 					position++
 				}
-			l454:
+			l529:
 				if !_rules[rulesp]() {
-					goto l434
+					goto l509
 				}
 				if !_rules[ruleStreamIdentifier]() {
-					goto l434
+					goto l509
 				}
-				if !_rules[ruleAction16]() {
-					goto l434
+				if !_rules[ruleAction19]() {
+					goto l509
 				}
 				depth--
-				add(ruleDropSourceStmt, position435)
+				add(ruleDropSourceStmt, position510)
 			}
 			return true
-		l434:
-			position, tokenIndex, depth = position434, tokenIndex434, depth434
+		l509:
+			position, tokenIndex, depth = position509, tokenIndex509, depth509
 			return false
 		},
-		/* 23 DropStreamStmt <- <(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M')) sp StreamIdentifier Action17)> */
+		/* 26 DropStreamStmt <- <(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M')) sp StreamIdentifier Action20)> */
 		func() bool {
-			position456, tokenIndex456, depth456 := position, tokenIndex, depth
+			position531, tokenIndex531, depth531 := position, tokenIndex, depth
 			{
-				position457 := position
+				position532 := position
 				depth++
 				{
-					position458, tokenIndex458, depth458 := position, tokenIndex, depth
+					position533, tokenIndex533, depth533 := position, tokenIndex, depth
 					if buffer[position] != rune('d') {
-						goto l459
+						goto l534
 					}
 					position++
-					goto l458
-				l459:
-					position, tokenIndex, depth = position458, tokenIndex458, depth458
+					goto l533
+				l534:
+					position, tokenIndex, depth = position533, tokenIndex533, depth533
 					if buffer[position] != rune('D') {
-						goto l456
+						goto l531
 					}
 					position++
 				}
-			l458:
+			l533:
 				{
-					position460, tokenIndex460, depth460 := position, tokenIndex, depth
+					position535, tokenIndex535, depth535 := position, tokenIndex, depth
 					if buffer[position] != rune('r') {
-						goto l461
+						goto l536
 					}
 					position++
-					goto l460
-				l461:
-					position, tokenIndex, depth = position460, tokenIndex460, depth460
+					goto l535
+				l536:
+					position, tokenIndex, depth = position535, tokenIndex535, depth535
 					if buffer[position] != rune('R') {
-						goto l456
+						goto l531
 					}
 					position++
 				}
-			l460:
+			l535:
 				{
-					position462, tokenIndex462, depth462 := position, tokenIndex, depth
+					position537, tokenIndex537, depth537 := position, tokenIndex, depth
 					if buffer[position] != rune('o') {
-						goto l463
+						goto l538
 					}
 					position++
-					goto l462
-				l463:
-					position, tokenIndex, depth = position462, tokenIndex462, depth462
+					goto l537
+				l538:
+					position, tokenIndex, depth = position537, tokenIndex537, depth537
 					if buffer[position] != rune('O') {
-						goto l456
+						goto l531
 					}
 					position++
 				}
-			l462:
+			l537:
 				{
-					position464, tokenIndex464, depth464 := position, tokenIndex, depth
+					position539, tokenIndex539, depth539 := position, tokenIndex, depth
 					if buffer[position] != rune('p') {
-						goto l465
+						goto l540
 					}
 					position++
-					goto l464
-				l465:
-					position, tokenIndex, depth = position464, tokenIndex464, depth464
+					goto l539
+				l540:
+					position, tokenIndex, depth = position539, tokenIndex539, depth539
 					if buffer[position] != rune('P') {
-						goto l456
+						goto l531
 					}
 					position++
 				}
-			l464:
+			l539:
 				if !_rules[rulesp]() {
-					goto l456
+					goto l531
 				}
 				{
-					position466, tokenIndex466, depth466 := position, tokenIndex, depth
+					position541, tokenIndex541, depth541 := position, tokenIndex, depth
 					if buffer[position] != rune('s') {
-						goto l467
+						goto l542
 					}
 					position++
-					goto l466
-				l467:
-					position, tokenIndex, depth = position466, tokenIndex466, depth466
+					goto l541
+				l542:
+					position, tokenIndex, depth = position541, tokenIndex541, depth541
 					if buffer[position] != rune('S') {
-						goto l456
+						goto l531
 					}
 					position++
 				}
-			l466:
+			l541:
 				{
-					position468, tokenIndex468, depth468 := position, tokenIndex, depth
+					position543, tokenIndex543, depth543 := position, tokenIndex, depth
 					if buffer[position] != rune('t') {
-						goto l469
+						goto l544
 					}
 					position++
-					goto l468
-				l469:
-					position, tokenIndex, depth = position468, tokenIndex468, depth468
+					goto l543
+				l544:
+					position, tokenIndex, depth = position543, tokenIndex543, depth543
 					if buffer[position] != rune('T') {
-						goto l456
+						goto l531
 					}
 					position++
 				}
-			l468:
+			l543:
 				{
-					position470, tokenIndex470, depth470 := position, tokenIndex, depth
+					position545, tokenIndex545, depth545 := position, tokenIndex, depth
 					if buffer[position] != rune('r') {
-						goto l471
+						goto l546
 					}
 					position++
-					goto l470
-				l471:
-					position, tokenIndex, depth = position470, tokenIndex470, depth470
+					goto l545
+				l546:
+					position, tokenIndex, depth = position545, tokenIndex545, depth545
 					if buffer[position] != rune('R') {
-						goto l456
+						goto l531
 					}
 					position++
 				}
-			l470:
+			l545:
 				{
-					position472, tokenIndex472, depth472 := position, tokenIndex, depth
+					position547, tokenIndex547, depth547 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l473
+						goto l548
 					}
 					position++
-					goto l472
-				l473:
-					position, tokenIndex, depth = position472, tokenIndex472, depth472
+					goto l547
+				l548:
+					position, tokenIndex, depth = position547, tokenIndex547, depth547
 					if buffer[position] != rune('E') {
-						goto l456
+						goto l531
 					}
 					position++
 				}
-			l472:
+			l547:
 				{
-					position474, tokenIndex474, depth474 := position, tokenIndex, depth
+					position549, tokenIndex549, depth549 := position, tokenIndex, depth
 					if buffer[position] != rune('a') {
-						goto l475
+						goto l550
 					}
 					position++
-					goto l474
-				l475:
-					position, tokenIndex, depth = position474, tokenIndex474, depth474
+					goto l549
+				l550:
+					position, tokenIndex, depth = position549, tokenIndex549, depth549
 					if buffer[position] != rune('A') {
-						goto l456
+						goto l531
 					}
 					position++
 				}
-			l474:
+			l549:
 				{
-					position476, tokenIndex476, depth476 := position, tokenIndex, depth
+					position551, tokenIndex551, depth551 := position, tokenIndex, depth
 					if buffer[position] != rune('m') {
-						goto l477
+						goto l552
 					}
 					position++
-					goto l476
-				l477:
-					position, tokenIndex, depth = position476, tokenIndex476, depth476
+					goto l551
+				l552:
+					position, tokenIndex, depth = position551, tokenIndex551, depth551
 					if buffer[position] != rune('M') {
-						goto l456
+						goto l531
 					}
 					position++
 				}
-			l476:
+			l551:
 				if !_rules[rulesp]() {
-					goto l456
+					goto l531
 				}
 				if !_rules[ruleStreamIdentifier]() {
-					goto l456
+					goto l531
 				}
-				if !_rules[ruleAction17]() {
-					goto l456
+				if !_rules[ruleAction20]() {
+					goto l531
 				}
 				depth--
-				add(ruleDropStreamStmt, position457)
+				add(ruleDropStreamStmt, position532)
 			}
 			return true
-		l456:
-			position, tokenIndex, depth = position456, tokenIndex456, depth456
+		l531:
+			position, tokenIndex, depth = position531, tokenIndex531, depth531
 			return false
 		},
-		/* 24 DropSinkStmt <- <(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('s' / 'S') ('i' / 'I') ('n' / 'N') ('k' / 'K')) sp StreamIdentifier Action18)> */
+		/* 27 DropSinkStmt <- <(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('s' / 'S') ('i' / 'I') ('n' / 'N') ('k' / 'K')) sp StreamIdentifier Action21)> */
 		func() bool {
-			position478, tokenIndex478, depth478 := position, tokenIndex, depth
+			position553, tokenIndex553, depth553 := position, tokenIndex, depth
 			{
-				position479 := position
+				position554 := position
 				depth++
 				{
-					position480, tokenIndex480, depth480 := position, tokenIndex, depth
+					position555, tokenIndex555, depth555 := position, tokenIndex, depth
 					if buffer[position] != rune('d') {
-						goto l481
+						goto l556
 					}
 					position++
-					goto l480
-				l481:
-					position, tokenIndex, depth = position480, tokenIndex480, depth480
+					goto l555
+				l556:
+					position, tokenIndex, depth = position555, tokenIndex555, depth555
 					if buffer[position] != rune('D') {
-						goto l478
+						goto l553
 					}
 					position++
 				}
-			l480:
+			l555:
 				{
-					position482, tokenIndex482, depth482 := position, tokenIndex, depth
+					position557, tokenIndex557, depth557 := position, tokenIndex, depth
 					if buffer[position] != rune('r') {
-						goto l483
+						goto l558
 					}
 					position++
-					goto l482
-				l483:
-					position, tokenIndex, depth = position482, tokenIndex482, depth482
+					goto l557
+				l558:
+					position, tokenIndex, depth = position557, tokenIndex557, depth557
 					if buffer[position] != rune('R') {
-						goto l478
+						goto l553
 					}
 					position++
 				}
-			l482:
+			l557:
 				{
-					position484, tokenIndex484, depth484 := position, tokenIndex, depth
+					position559, tokenIndex559, depth559 := position, tokenIndex, depth
 					if buffer[position] != rune('o') {
-						goto l485
+						goto l560
 					}
 					position++
-					goto l484
-				l485:
-					position, tokenIndex, depth = position484, tokenIndex484, depth484
+					goto l559
+				l560:
+					position, tokenIndex, depth = position559, tokenIndex559, depth559
 					if buffer[position] != rune('O') {
-						goto l478
+						goto l553
 					}
 					position++
 				}
-			l484:
+			l559:
 				{
-					position486, tokenIndex486, depth486 := position, tokenIndex, depth
+					position561, tokenIndex561, depth561 := position, tokenIndex, depth
 					if buffer[position] != rune('p') {
-						goto l487
+						goto l562
 					}
 					position++
-					goto l486
-				l487:
-					position, tokenIndex, depth = position486, tokenIndex486, depth486
+					goto l561
+				l562:
+					position, tokenIndex, depth = position561, tokenIndex561, depth561
 					if buffer[position] != rune('P') {
-						goto l478
+						goto l553
 					}
 					position++
 				}
-			l486:
+			l561:
 				if !_rules[rulesp]() {
-					goto l478
+					goto l553
 				}
 				{
-					position488, tokenIndex488, depth488 := position, tokenIndex, depth
+					position563, tokenIndex563, depth563 := position, tokenIndex, depth
 					if buffer[position] != rune('s') {
-						goto l489
+						goto l564
 					}
 					position++
-					goto l488
-				l489:
-					position, tokenIndex, depth = position488, tokenIndex488, depth488
+					goto l563
+				l564:
+					position, tokenIndex, depth = position563, tokenIndex563, depth563
 					if buffer[position] != rune('S') {
-						goto l478
+						goto l553
 					}
 					position++
 				}
-			l488:
+			l563:
 				{
-					position490, tokenIndex490, depth490 := position, tokenIndex, depth
+					position565, tokenIndex565, depth565 := position, tokenIndex, depth
 					if buffer[position] != rune('i') {
-						goto l491
+						goto l566
 					}
 					position++
-					goto l490
-				l491:
-					position, tokenIndex, depth = position490, tokenIndex490, depth490
+					goto l565
+				l566:
+					position, tokenIndex, depth = position565, tokenIndex565, depth565
 					if buffer[position] != rune('I') {
-						goto l478
+						goto l553
 					}
 					position++
 				}
-			l490:
+			l565:
 				{
-					position492, tokenIndex492, depth492 := position, tokenIndex, depth
+					position567, tokenIndex567, depth567 := position, tokenIndex, depth
 					if buffer[position] != rune('n') {
-						goto l493
+						goto l568
 					}
 					position++
-					goto l492
-				l493:
-					position, tokenIndex, depth = position492, tokenIndex492, depth492
+					goto l567
+				l568:
+					position, tokenIndex, depth = position567, tokenIndex567, depth567
 					if buffer[position] != rune('N') {
-						goto l478
+						goto l553
 					}
 					position++
 				}
-			l492:
+			l567:
 				{
-					position494, tokenIndex494, depth494 := position, tokenIndex, depth
+					position569, tokenIndex569, depth569 := position, tokenIndex, depth
 					if buffer[position] != rune('k') {
-						goto l495
+						goto l570
 					}
 					position++
-					goto l494
-				l495:
-					position, tokenIndex, depth = position494, tokenIndex494, depth494
+					goto l569
+				l570:
+					position, tokenIndex, depth = position569, tokenIndex569, depth569
 					if buffer[position] != rune('K') {
-						goto l478
+						goto l553
 					}
 					position++
 				}
-			l494:
+			l569:
 				if !_rules[rulesp]() {
-					goto l478
+					goto l553
 				}
 				if !_rules[ruleStreamIdentifier]() {
-					goto l478
+					goto l553
 				}
-				if !_rules[ruleAction18]() {
-					goto l478
+				if !_rules[ruleAction21]() {
+					goto l553
 				}
 				depth--
-				add(ruleDropSinkStmt, position479)
+				add(ruleDropSinkStmt, position554)
 			}
 			return true
-		l478:
-			position, tokenIndex, depth = position478, tokenIndex478, depth478
+		l553:
+			position, tokenIndex, depth = position553, tokenIndex553, depth553
 			return false
 		},
-		/* 25 DropStateStmt <- <(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('s' / 'S') ('t' / 'T') ('a' / 'A') ('t' / 'T') ('e' / 'E')) sp StreamIdentifier Action19)> */
+		/* 28 DropStateStmt <- <(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('s' / 'S') ('t' / 'T') ('a' / 'A') ('t' / 'T') ('e' / 'E')) sp StreamIdentifier Action22)> */
 		func() bool {
-			position496, tokenIndex496, depth496 := position, tokenIndex, depth
+			position571, tokenIndex571, depth571 := position, tokenIndex, depth
 			{
-				position497 := position
+				position572 := position
 				depth++
 				{
-					position498, tokenIndex498, depth498 := position, tokenIndex, depth
+					position573, tokenIndex573, depth573 := position, tokenIndex, depth
 					if buffer[position] != rune('d') {
-						goto l499
+						goto l574
 					}
 					position++
-					goto l498
-				l499:
-					position, tokenIndex, depth = position498, tokenIndex498, depth498
+					goto l573
+				l574:
+					position, tokenIndex, depth = position573, tokenIndex573, depth573
 					if buffer[position] != rune('D') {
-						goto l496
+						goto l571
 					}
 					position++
 				}
-			l498:
+			l573:
 				{
-					position500, tokenIndex500, depth500 := position, tokenIndex, depth
+					position575, tokenIndex575, depth575 := position, tokenIndex, depth
 					if buffer[position] != rune('r') {
-						goto l501
+						goto l576
 					}
 					position++
-					goto l500
-				l501:
-					position, tokenIndex, depth = position500, tokenIndex500, depth500
+					goto l575
+				l576:
+					position, tokenIndex, depth = position575, tokenIndex575, depth575
 					if buffer[position] != rune('R') {
-						goto l496
+						goto l571
 					}
 					position++
 				}
-			l500:
+			l575:
 				{
-					position502, tokenIndex502, depth502 := position, tokenIndex, depth
+					position577, tokenIndex577, depth577 := position, tokenIndex, depth
 					if buffer[position] != rune('o') {
-						goto l503
+						goto l578
 					}
 					position++
-					goto l502
-				l503:
-					position, tokenIndex, depth = position502, tokenIndex502, depth502
+					goto l577
+				l578:
+					position, tokenIndex, depth = position577, tokenIndex577, depth577
 					if buffer[position] != rune('O') {
-						goto l496
+						goto l571
 					}
 					position++
 				}
-			l502:
+			l577:
 				{
-					position504, tokenIndex504, depth504 := position, tokenIndex, depth
+					position579, tokenIndex579, depth579 := position, tokenIndex, depth
 					if buffer[position] != rune('p') {
-						goto l505
+						goto l580
 					}
 					position++
-					goto l504
-				l505:
-					position, tokenIndex, depth = position504, tokenIndex504, depth504
+					goto l579
+				l580:
+					position, tokenIndex, depth = position579, tokenIndex579, depth579
 					if buffer[position] != rune('P') {
-						goto l496
+						goto l571
 					}
 					position++
 				}
-			l504:
+			l579:
 				if !_rules[rulesp]() {
-					goto l496
+					goto l571
 				}
 				{
-					position506, tokenIndex506, depth506 := position, tokenIndex, depth
+					position581, tokenIndex581, depth581 := position, tokenIndex, depth
 					if buffer[position] != rune('s') {
-						goto l507
+						goto l582
 					}
 					position++
-					goto l506
-				l507:
-					position, tokenIndex, depth = position506, tokenIndex506, depth506
+					goto l581
+				l582:
+					position, tokenIndex, depth = position581, tokenIndex581, depth581
 					if buffer[position] != rune('S') {
-						goto l496
+						goto l571
 					}
 					position++
 				}
-			l506:
+			l581:
 				{
-					position508, tokenIndex508, depth508 := position, tokenIndex, depth
+					position583, tokenIndex583, depth583 := position, tokenIndex, depth
 					if buffer[position] != rune('t') {
-						goto l509
+						goto l584
 					}
 					position++
-					goto l508
-				l509:
-					position, tokenIndex, depth = position508, tokenIndex508, depth508
+					goto l583
+				l584:
+					position, tokenIndex, depth = position583, tokenIndex583, depth583
 					if buffer[position] != rune('T') {
-						goto l496
+						goto l571
 					}
 					position++
 				}
-			l508:
+			l583:
 				{
-					position510, tokenIndex510, depth510 := position, tokenIndex, depth
+					position585, tokenIndex585, depth585 := position, tokenIndex, depth
 					if buffer[position] != rune('a') {
-						goto l511
+						goto l586
 					}
 					position++
-					goto l510
-				l511:
-					position, tokenIndex, depth = position510, tokenIndex510, depth510
+					goto l585
+				l586:
+					position, tokenIndex, depth = position585, tokenIndex585, depth585
 					if buffer[position] != rune('A') {
-						goto l496
+						goto l571
 					}
 					position++
 				}
-			l510:
+			l585:
 				{
-					position512, tokenIndex512, depth512 := position, tokenIndex, depth
+					position587, tokenIndex587, depth587 := position, tokenIndex, depth
 					if buffer[position] != rune('t') {
-						goto l513
+						goto l588
 					}
 					position++
-					goto l512
-				l513:
-					position, tokenIndex, depth = position512, tokenIndex512, depth512
+					goto l587
+				l588:
+					position, tokenIndex, depth = position587, tokenIndex587, depth587
 					if buffer[position] != rune('T') {
-						goto l496
+						goto l571
 					}
 					position++
 				}
-			l512:
+			l587:
 				{
-					position514, tokenIndex514, depth514 := position, tokenIndex, depth
+					position589, tokenIndex589, depth589 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l515
+						goto l590
 					}
 					position++
-					goto l514
-				l515:
-					position, tokenIndex, depth = position514, tokenIndex514, depth514
+					goto l589
+				l590:
+					position, tokenIndex, depth = position589, tokenIndex589, depth589
 					if buffer[position] != rune('E') {
-						goto l496
+						goto l571
 					}
 					position++
 				}
-			l514:
+			l589:
 				if !_rules[rulesp]() {
-					goto l496
+					goto l571
 				}
 				if !_rules[ruleStreamIdentifier]() {
-					goto l496
+					goto l571
 				}
-				if !_rules[ruleAction19]() {
-					goto l496
+				if !_rules[ruleAction22]() {
+					goto l571
 				}
 				depth--
-				add(ruleDropStateStmt, position497)
+				add(ruleDropStateStmt, position572)
 			}
 			return true
-		l496:
-			position, tokenIndex, depth = position496, tokenIndex496, depth496
+		l571:
+			position, tokenIndex, depth = position571, tokenIndex571, depth571
 			return false
 		},
-		/* 26 LoadStateStmt <- <(('l' / 'L') ('o' / 'O') ('a' / 'A') ('d' / 'D') sp (('s' / 'S') ('t' / 'T') ('a' / 'A') ('t' / 'T') ('e' / 'E')) sp StreamIdentifier sp (('t' / 'T') ('y' / 'Y') ('p' / 'P') ('e' / 'E')) sp SourceSinkType StateTagOpt SetOptSpecs Action20)> */
+		/* 29 LoadStateStmt <- <(('l' / 'L') ('o' / 'O') ('a' / 'A') ('d' / 'D') sp (('s' / 'S') ('t' / 'T') ('a' / 'A') ('t' / 'T') ('e' / 'E')) sp StreamIdentifier sp (('t' / 'T') ('y' / 'Y') ('p' / 'P') ('e' / 'E')) sp SourceSinkType StateTagOpt SetOptSpecs Action23)> */
 		func() bool {
-			position516, tokenIndex516, depth516 := position, tokenIndex, depth
+			position591, tokenIndex591, depth591 := position, tokenIndex, depth
 			{
-				position517 := position
+				position592 := position
 				depth++
 				{
-					position518, tokenIndex518, depth518 := position, tokenIndex, depth
+					position593, tokenIndex593, depth593 := position, tokenIndex, depth
 					if buffer[position] != rune('l') {
-						goto l519
+						goto l594
 					}
 					position++
-					goto l518
-				l519:
-					position, tokenIndex, depth = position518, tokenIndex518, depth518
+					goto l593
+				l594:
+					position, tokenIndex, depth = position593, tokenIndex593, depth593
 					if buffer[position] != rune('L') {
-						goto l516
+						goto l591
 					}
 					position++
 				}
-			l518:
+			l593:
 				{
-					position520, tokenIndex520, depth520 := position, tokenIndex, depth
+					position595, tokenIndex595, depth595 := position, tokenIndex, depth
 					if buffer[position] != rune('o') {
-						goto l521
+						goto l596
 					}
 					position++
-					goto l520
-				l521:
-					position, tokenIndex, depth = position520, tokenIndex520, depth520
+					goto l595
+				l596:
+					position, tokenIndex, depth = position595, tokenIndex595, depth595
 					if buffer[position] != rune('O') {
-						goto l516
+						goto l591
 					}
 					position++
 				}
-			l520:
+			l595:
 				{
-					position522, tokenIndex522, depth522 := position, tokenIndex, depth
+					position597, tokenIndex597, depth597 := position, tokenIndex, depth
 					if buffer[position] != rune('a') {
-						goto l523
+						goto l598
 					}
 					position++
-					goto l522
-				l523:
-					position, tokenIndex, depth = position522, tokenIndex522, depth522
+					goto l597
+				l598:
+					position, tokenIndex, depth = position597, tokenIndex597, depth597
 					if buffer[position] != rune('A') {
-						goto l516
+						goto l591
 					}
 					position++
 				}
-			l522:
+			l597:
 				{
-					position524, tokenIndex524, depth524 := position, tokenIndex, depth
+					position599, tokenIndex599, depth599 := position, tokenIndex, depth
 					if buffer[position] != rune('d') {
-						goto l525
+						goto l600
 					}
 					position++
-					goto l524
-				l525:
-					position, tokenIndex, depth = position524, tokenIndex524, depth524
+					goto l599
+				l600:
+					position, tokenIndex, depth = position599, tokenIndex599, depth599
 					if buffer[position] != rune('D') {
-						goto l516
+						goto l591
 					}
 					position++
 				}
-			l524:
+			l599:
 				if !_rules[rulesp]() {
-					goto l516
+					goto l591
 				}
 				{
-					position526, tokenIndex526, depth526 := position, tokenIndex, depth
+					position601, tokenIndex601, depth601 := position, tokenIndex, depth
 					if buffer[position] != rune('s') {
-						goto l527
+						goto l602
 					}
 					position++
-					goto l526
-				l527:
-					position, tokenIndex, depth = position526, tokenIndex526, depth526
+					goto l601
+				l602:
+					position, tokenIndex, depth = position601, tokenIndex601, depth601
 					if buffer[position] != rune('S') {
-						goto l516
+						goto l591
 					}
 					position++
 				}
-			l526:
+			l601:
 				{
-					position528, tokenIndex528, depth528 := position, tokenIndex, depth
+					position603, tokenIndex603, depth603 := position, tokenIndex, depth
 					if buffer[position] != rune('t') {
-						goto l529
+						goto l604
 					}
 					position++
-					goto l528
-				l529:
-					position, tokenIndex, depth = position528, tokenIndex528, depth528
+					goto l603
+				l604:
+					position, tokenIndex, depth = position603, tokenIndex603, depth603
 					if buffer[position] != rune('T') {
-						goto l516
+						goto l591
 					}
 					position++
 				}
-			l528:
+			l603:
 				{
-					position530, tokenIndex530, depth530 := position, tokenIndex, depth
+					position605, tokenIndex605, depth605 := position, tokenIndex, depth
 					if buffer[position] != rune('a') {
-						goto l531
+						goto l606
 					}
 					position++
-					goto l530
-				l531:
-					position, tokenIndex, depth = position530, tokenIndex530, depth530
+					goto l605
+				l606:
+					position, tokenIndex, depth = position605, tokenIndex605, depth605
 					if buffer[position] != rune('A') {
-						goto l516
+						goto l591
 					}
 					position++
 				}
-			l530:
+			l605:
 				{
-					position532, tokenIndex532, depth532 := position, tokenIndex, depth
+					position607, tokenIndex607, depth607 := position, tokenIndex, depth
 					if buffer[position] != rune('t') {
-						goto l533
+						goto l608
 					}
 					position++
-					goto l532
-				l533:
-					position, tokenIndex, depth = position532, tokenIndex532, depth532
+					goto l607
+				l608:
+					position, tokenIndex, depth = position607, tokenIndex607, depth607
 					if buffer[position] != rune('T') {
-						goto l516
+						goto l591
 					}
 					position++
 				}
-			l532:
+			l607:
 				{
-					position534, tokenIndex534, depth534 := position, tokenIndex, depth
+					position609, tokenIndex609, depth609 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l535
+						goto l610
 					}
 					position++
-					goto l534
-				l535:
-					position, tokenIndex, depth = position534, tokenIndex534, depth534
+					goto l609
+				l610:
+					position, tokenIndex, depth = position609, tokenIndex609, depth609
 					if buffer[position] != rune('E') {
-						goto l516
+						goto l591
 					}
 					position++
 				}
-			l534:
+			l609:
 				if !_rules[rulesp]() {
-					goto l516
+					goto l591
 				}
 				if !_rules[ruleStreamIdentifier]() {
-					goto l516
+					goto l591
 				}
 				if !_rules[rulesp]() {
-					goto l516
+					goto l591
 				}
 				{
-					position536, tokenIndex536, depth536 := position, tokenIndex, depth
+					position611, tokenIndex611, depth611 := position, tokenIndex, depth
 					if buffer[position] != rune('t') {
-						goto l537
+						goto l612
 					}
 					position++
-					goto l536
-				l537:
-					position, tokenIndex, depth = position536, tokenIndex536, depth536
+					goto l611
+				l612:
+					position, tokenIndex, depth = position611, tokenIndex611, depth611
 					if buffer[position] != rune('T') {
-						goto l516
+						goto l591
 					}
 					position++
 				}
-			l536:
+			l611:
 				{
-					position538, tokenIndex538, depth538 := position, tokenIndex, depth
+					position613, tokenIndex613, depth613 := position, tokenIndex, depth
 					if buffer[position] != rune('y') {
-						goto l539
+						goto l614
 					}
 					position++
-					goto l538
-				l539:
-					position, tokenIndex, depth = position538, tokenIndex538, depth538
+					goto l613
+				l614:
+					position, tokenIndex, depth = position613, tokenIndex613, depth613
 					if buffer[position] != rune('Y') {
-						goto l516
+						goto l591
 					}
 					position++
 				}
-			l538:
+			l613:
 				{
-					position540, tokenIndex540, depth540 := position, tokenIndex, depth
+					position615, tokenIndex615, depth615 := position, tokenIndex, depth
 					if buffer[position] != rune('p') {
-						goto l541
+						goto l616
 					}
 					position++
-					goto l540
-				l541:
-					position, tokenIndex, depth = position540, tokenIndex540, depth540
+					goto l615
+				l616:
+					position, tokenIndex, depth = position615, tokenIndex615, depth615
 					if buffer[position] != rune('P') {
-						goto l516
+						goto l591
 					}
 					position++
 				}
-			l540:
+			l615:
 				{
-					position542, tokenIndex542, depth542 := position, tokenIndex, depth
+					position617, tokenIndex617, depth617 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l543
+						goto l618
 					}
 					position++
-					goto l542
-				l543:
-					position, tokenIndex, depth = position542, tokenIndex542, depth542
+					goto l617
+				l618:
+					position, tokenIndex, depth = position617, tokenIndex617, depth617
 					if buffer[position] != rune('E') {
-						goto l516
+						goto l591
 					}
 					position++
 				}
-			l542:
+			l617:
 				if !_rules[rulesp]() {
-					goto l516
+					goto l591
 				}
 				if !_rules[ruleSourceSinkType]() {
-					goto l516
+					goto l591
 				}
 				if !_rules[ruleStateTagOpt]() {
-					goto l516
+					goto l591
 				}
 				if !_rules[ruleSetOptSpecs]() {
-					goto l516
+					goto l591
 				}
-				if !_rules[ruleAction20]() {
-					goto l516
+				if !_rules[ruleAction23]() {
+					goto l591
 				}
 				depth--
-				add(ruleLoadStateStmt, position517)
+				add(ruleLoadStateStmt, position592)
 			}
 			return true
-		l516:
-			position, tokenIndex, depth = position516, tokenIndex516, depth516
+		l591:
+			position, tokenIndex, depth = position591, tokenIndex591, depth591
 			return false
 		},
-		/* 27 LoadStateOrCreateStmt <- <(LoadStateStmt sp (('o' / 'O') ('r' / 'R')) sp (('c' / 'C') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('t' / 'T') ('e' / 'E')) sp (('i' / 'I') ('f' / 'F')) sp (('n' / 'N') ('o' / 'O') ('t' / 'T')) sp ((('s' / 'S') ('a' / 'A') ('v' / 'V') ('e' / 'E') ('d' / 'D')) / (('e' / 'E') ('x' / 'X') ('i' / 'I') ('s' / 'S') ('t' / 'T') ('s' / 'S'))) SourceSinkSpecs Action21)> */
+		/* 30 LoadStateOrCreateStmt <- <(LoadStateStmt sp (('o' / 'O') ('r' / 'R')) sp (('c' / 'C') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('t' / 'T') ('e' / 'E')) sp (('i' / 'I') ('f' / 'F')) sp (('n' / 'N') ('o' / 'O') ('t' / 'T')) sp ((('s' / 'S') ('a' / 'A') ('v' / 'V') ('e' / 'E') ('d' / 'D')) / (('e' / 'E') ('x' / 'X') ('i' / 'I') ('s' / 'S') ('t' / 'T') ('s' / 'S'))) SourceSinkSpecs Action24)> */
 		func() bool {
-			position544, tokenIndex544, depth544 := position, tokenIndex, depth
+			position619, tokenIndex619, depth619 := position, tokenIndex, depth
 			{
-				position545 := position
+				position620 := position
 				depth++
 				if !_rules[ruleLoadStateStmt]() {
-					goto l544
+					goto l619
 				}
 				if !_rules[rulesp]() {
-					goto l544
+					goto l619
 				}
 				{
-					position546, tokenIndex546, depth546 := position, tokenIndex, depth
+					position621, tokenIndex621, depth621 := position, tokenIndex, depth
 					if buffer[position] != rune('o') {
-						goto l547
+						goto l622
 					}
 					position++
-					goto l546
-				l547:
-					position, tokenIndex, depth = position546, tokenIndex546, depth546
+					goto l621
+				l622:
+					position, tokenIndex, depth = position621, tokenIndex621, depth621
 					if buffer[position] != rune('O') {
-						goto l544
+						goto l619
 					}
 					position++
 				}
-			l546:
+			l621:
 				{
-					position548, tokenIndex548, depth548 := position, tokenIndex, depth
+					position623, tokenIndex623, depth623 := position, tokenIndex, depth
 					if buffer[position] != rune('r') {
-						goto l549
+						goto l624
 					}
 					position++
-					goto l548
-				l549:
-					position, tokenIndex, depth = position548, tokenIndex548, depth548
+					goto l623
+				l624:
+					position, tokenIndex, depth = position623, tokenIndex623, depth623
 					if buffer[position] != rune('R') {
-						goto l544
+						goto l619
 					}
 					position++
 				}
-			l548:
+			l623:
 				if !_rules[rulesp]() {
-					goto l544
+					goto l619
 				}
 				{
-					position550, tokenIndex550, depth550 := position, tokenIndex, depth
+					position625, tokenIndex625, depth625 := position, tokenIndex, depth
 					if buffer[position] != rune('c') {
-						goto l551
+						goto l626
 					}
 					position++
-					goto l550
-				l551:
-					position, tokenIndex, depth = position550, tokenIndex550, depth550
+					goto l625
+				l626:
+					position, tokenIndex, depth = position625, tokenIndex625, depth625
 					if buffer[position] != rune('C') {
-						goto l544
+						goto l619
 					}
 					position++
 				}
-			l550:
+			l625:
 				{
-					position552, tokenIndex552, depth552 := position, tokenIndex, depth
+					position627, tokenIndex627, depth627 := position, tokenIndex, depth
 					if buffer[position] != rune('r') {
-						goto l553
+						goto l628
 					}
 					position++
-					goto l552
-				l553:
-					position, tokenIndex, depth = position552, tokenIndex552, depth552
+					goto l627
+				l628:
+					position, tokenIndex, depth = position627, tokenIndex627, depth627
 					if buffer[position] != rune('R') {
-						goto l544
+						goto l619
 					}
 					position++
 				}
-			l552:
+			l627:
 				{
-					position554, tokenIndex554, depth554 := position, tokenIndex, depth
+					position629, tokenIndex629, depth629 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l555
+						goto l630
 					}
 					position++
-					goto l554
-				l555:
-					position, tokenIndex, depth = position554, tokenIndex554, depth554
+					goto l629
+				l630:
+					position, tokenIndex, depth = position629, tokenIndex629, depth629
 					if buffer[position] != rune('E') {
-						goto l544
+						goto l619
 					}
 					position++
 				}
-			l554:
+			l629:
 				{
-					position556, tokenIndex556, depth556 := position, tokenIndex, depth
+					position631, tokenIndex631, depth631 := position, tokenIndex, depth
 					if buffer[position] != rune('a') {
-						goto l557
+						goto l632
 					}
 					position++
-					goto l556
-				l557:
-					position, tokenIndex, depth = position556, tokenIndex556, depth556
+					goto l631
+				l632:
+					position, tokenIndex, depth = position631, tokenIndex631, depth631
 					if buffer[position] != rune('A') {
-						goto l544
+						goto l619
 					}
 					position++
 				}
-			l556:
+			l631:
 				{
-					position558, tokenIndex558, depth558 := position, tokenIndex, depth
+					position633, tokenIndex633, depth633 := position, tokenIndex, depth
 					if buffer[position] != rune('t') {
-						goto l559
+						goto l634
 					}
 					position++
-					goto l558
-				l559:
-					position, tokenIndex, depth = position558, tokenIndex558, depth558
+					goto l633
+				l634:
+					position, tokenIndex, depth = position633, tokenIndex633, depth633
 					if buffer[position] != rune('T') {
-						goto l544
+						goto l619
 					}
 					position++
 				}
-			l558:
+			l633:
 				{
-					position560, tokenIndex560, depth560 := position, tokenIndex, depth
+					position635, tokenIndex635, depth635 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l561
+						goto l636
 					}
 					position++
-					goto l560
-				l561:
-					position, tokenIndex, depth = position560, tokenIndex560, depth560
+					goto l635
+				l636:
+					position, tokenIndex, depth = position635, tokenIndex635, depth635
 					if buffer[position] != rune('E') {
-						goto l544
+						goto l619
 					}
 					position++
 				}
-			l560:
+			l635:
 				if !_rules[rulesp]() {
-					goto l544
+					goto l619
 				}
 				{
-					position562, tokenIndex562, depth562 := position, tokenIndex, depth
+					position637, tokenIndex637, depth637 := position, tokenIndex, depth
 					if buffer[position] != rune('i') {
-						goto l563
+						goto l638
 					}
 					position++
-					goto l562
-				l563:
-					position, tokenIndex, depth = position562, tokenIndex562, depth562
+					goto l637
+				l638:
+					position, tokenIndex, depth = position637, tokenIndex637, depth637
 					if buffer[position] != rune('I') {
-						goto l544
+						goto l619
 					}
 					position++
 				}
-			l562:
+			l637:
 				{
-					position564, tokenIndex564, depth564 := position, tokenIndex, depth
+					position639, tokenIndex639, depth639 := position, tokenIndex, depth
 					if buffer[position] != rune('f') {
-						goto l565
+						goto l640
 					}
 					position++
-					goto l564
-				l565:
-					position, tokenIndex, depth = position564, tokenIndex564, depth564
+					goto l639
+				l640:
+					position, tokenIndex, depth = position639, tokenIndex639, depth639
 					if buffer[position] != rune('F') {
-						goto l544
+						goto l619
 					}
 					position++
 				}
-			l564:
+			l639:
 				if !_rules[rulesp]() {
-					goto l544
+					goto l619
 				}
 				{
-					position566, tokenIndex566, depth566 := position, tokenIndex, depth
+					position641, tokenIndex641, depth641 := position, tokenIndex, depth
 					if buffer[position] != rune('n') {
-						goto l567
+						goto l642
 					}
 					position++
-					goto l566
-				l567:
-					position, tokenIndex, depth = position566, tokenIndex566, depth566
+					goto l641
+				l642:
+					position, tokenIndex, depth = position641, tokenIndex641, depth641
 					if buffer[position] != rune('N') {
-						goto l544
+						goto l619
 					}
 					position++
 				}
-			l566:
+			l641:
 				{
-					position568, tokenIndex568, depth568 := position, tokenIndex, depth
+					position643, tokenIndex643, depth643 := position, tokenIndex, depth
 					if buffer[position] != rune('o') {
-						goto l569
+						goto l644
 					}
 					position++
-					goto l568
-				l569:
-					position, tokenIndex, depth = position568, tokenIndex568, depth568
+					goto l643
+				l644:
+					position, tokenIndex, depth = position643, tokenIndex643, depth643
 					if buffer[position] != rune('O') {
-						goto l544
+						goto l619
 					}
 					position++
 				}
-			l568:
+			l643:
 				{
-					position570, tokenIndex570, depth570 := position, tokenIndex, depth
+					position645, tokenIndex645, depth645 := position, tokenIndex, depth
 					if buffer[position] != rune('t') {
-						goto l571
+						goto l646
 					}
 					position++
-					goto l570
-				l571:
-					position, tokenIndex, depth = position570, tokenIndex570, depth570
+					goto l645
+				l646:
+					position, tokenIndex, depth = position645, tokenIndex645, depth645
 					if buffer[position] != rune('T') {
-						goto l544
+						goto l619
 					}
 					position++
 				}
-			l570:
+			l645:
 				if !_rules[rulesp]() {
-					goto l544
+					goto l619
 				}
 				{
-					position572, tokenIndex572, depth572 := position, tokenIndex, depth
+					position647, tokenIndex647, depth647 := position, tokenIndex, depth
 					{
-						position574, tokenIndex574, depth574 := position, tokenIndex, depth
+						position649, tokenIndex649, depth649 := position, tokenIndex, depth
 						if buffer[position] != rune('s') {
-							goto l575
+							goto l650
 						}
 						position++
-						goto l574
-					l575:
-						position, tokenIndex, depth = position574, tokenIndex574, depth574
+						goto l649
+					l650:
+						position, tokenIndex, depth = position649, tokenIndex649, depth649
 						if buffer[position] != rune('S') {
-							goto l573
+							goto l648
 						}
 						position++
 					}
-				l574:
+				l649:
 					{
-						position576, tokenIndex576, depth576 := position, tokenIndex, depth
+						position651, tokenIndex651, depth651 := position, tokenIndex, depth
 						if buffer[position] != rune('a') {
-							goto l577
+							goto l652
 						}
 						position++
-						goto l576
-					l577:
-						position, tokenIndex, depth = position576, tokenIndex576, depth576
+						goto l651
+					l652:
+						position, tokenIndex, depth = position651, tokenIndex651, depth651
 						if buffer[position] != rune('A') {
-							goto l573
+							goto l648
 						}
 						position++
 					}
-				l576:
+				l651:
 					{
-						position578, tokenIndex578, depth578 := position, tokenIndex, depth
+						position653, tokenIndex653, depth653 := position, tokenIndex, depth
 						if buffer[position] != rune('v') {
-							goto l579
+							goto l654
 						}
 						position++
-						goto l578
-					l579:
-						position, tokenIndex, depth = position578, tokenIndex578, depth578
+						goto l653
+					l654:
+						position, tokenIndex, depth = position653, tokenIndex653, depth653
 						if buffer[position] != rune('V') {
-							goto l573
+							goto l648
 						}
 						position++
 					}
-				l578:
+				l653:
 					{
-						position580, tokenIndex580, depth580 := position, tokenIndex, depth
+						position655, tokenIndex655, depth655 := position, tokenIndex, depth
 						if buffer[position] != rune('e') {
-							goto l581
+							goto l656
 						}
 						position++
-						goto l580
-					l581:
-						position, tokenIndex, depth = position580, tokenIndex580, depth580
+						goto l655
+					l656:
+						position, tokenIndex, depth = position655, tokenIndex655, depth655
 						if buffer[position] != rune('E') {
-							goto l573
+							goto l648
 						}
 						position++
 					}
-				l580:
+				l655:
 					{
-						position582, tokenIndex582, depth582 := position, tokenIndex, depth
+						position657, tokenIndex657, depth657 := position, tokenIndex, depth
 						if buffer[position] != rune('d') {
-							goto l583
+							goto l658
 						}
 						position++
-						goto l582
-					l583:
-						position, tokenIndex, depth = position582, tokenIndex582, depth582
+						goto l657
+					l658:
+						position, tokenIndex, depth = position657, tokenIndex657, depth657
 						if buffer[position] != rune('D') {
-							goto l573
+							goto l648
 						}
 						position++
 					}
-				l582:
-					goto l572
-				l573:
-					position, tokenIndex, depth = position572, tokenIndex572, depth572
+				l657:
+					goto l647
+				l648:
+					position, tokenIndex, depth = position647, tokenIndex647, depth647
 					{
-						position584, tokenIndex584, depth584 := position, tokenIndex, depth
+						position659, tokenIndex659, depth659 := position, tokenIndex, depth
 						if buffer[position] != rune('e') {
-							goto l585
+							goto l660
 						}
 						position++
-						goto l584
-					l585:
-						position, tokenIndex, depth = position584, tokenIndex584, depth584
+						goto l659
+					l660:
+						position, tokenIndex, depth = position659, tokenIndex659, depth659
 						if buffer[position] != rune('E') {
-							goto l544
+							goto l619
 						}
 						position++
 					}
-				l584:
+				l659:
 					{
-						position586, tokenIndex586, depth586 := position, tokenIndex, depth
+						position661, tokenIndex661, depth661 := position, tokenIndex, depth
 						if buffer[position] != rune('x') {
-							goto l587
+							goto l662
 						}
 						position++
-						goto l586
-					l587:
-						position, tokenIndex, depth = position586, tokenIndex586, depth586
+						goto l661
+					l662:
+						position, tokenIndex, depth = position661, tokenIndex661, depth661
 						if buffer[position] != rune('X') {
-							goto l544
+							goto l619
 						}
 						position++
 					}
-				l586:
+				l661:
 					{
-						position588, tokenIndex588, depth588 := position, tokenIndex, depth
+						position663, tokenIndex663, depth663 := position, tokenIndex, depth
 						if buffer[position] != rune('i') {
-							goto l589
+							goto l664
 						}
 						position++
-						goto l588
-					l589:
-						position, tokenIndex, depth = position588, tokenIndex588, depth588
+						goto l663
+					l664:
+						position, tokenIndex, depth = position663, tokenIndex663, depth663
 						if buffer[position] != rune('I') {
-							goto l544
+							goto l619
 						}
 						position++
 					}
-				l588:
+				l663:
 					{
-						position590, tokenIndex590, depth590 := position, tokenIndex, depth
+						position665, tokenIndex665, depth665 := position, tokenIndex, depth
 						if buffer[position] != rune('s') {
-							goto l591
+							goto l666
 						}
 						position++
-						goto l590
-					l591:
-						position, tokenIndex, depth = position590, tokenIndex590, depth590
+						goto l665
+					l666:
+						position, tokenIndex, depth = position665, tokenIndex665, depth665
 						if buffer[position] != rune('S') {
-							goto l544
+							goto l619
 						}
 						position++
 					}
-				l590:
+				l665:
 					{
-						position592, tokenIndex592, depth592 := position, tokenIndex, depth
+						position667, tokenIndex667, depth667 := position, tokenIndex, depth
 						if buffer[position] != rune('t') {
-							goto l593
+							goto l668
 						}
 						position++
-						goto l592
-					l593:
-						position, tokenIndex, depth = position592, tokenIndex592, depth592
+						goto l667
+					l668:
+						position, tokenIndex, depth = position667, tokenIndex667, depth667
 						if buffer[position] != rune('T') {
-							goto l544
+							goto l619
 						}
 						position++
 					}
-				l592:
+				l667:
 					{
-						position594, tokenIndex594, depth594 := position, tokenIndex, depth
+						position669, tokenIndex669, depth669 := position, tokenIndex, depth
 						if buffer[position] != rune('s') {
-							goto l595
+							goto l670
 						}
 						position++
-						goto l594
-					l595:
-						position, tokenIndex, depth = position594, tokenIndex594, depth594
+						goto l669
+					l670:
+						position, tokenIndex, depth = position669, tokenIndex669, depth669
 						if buffer[position] != rune('S') {
-							goto l544
+							goto l619
 						}
 						position++
 					}
-				l594:
+				l669:
 				}
-			l572:
+			l647:
 				if !_rules[ruleSourceSinkSpecs]() {
-					goto l544
+					goto l619
 				}
-				if !_rules[ruleAction21]() {
-					goto l544
+				if !_rules[ruleAction24]() {
+					goto l619
 				}
 				depth--
-				add(ruleLoadStateOrCreateStmt, position545)
+				add(ruleLoadStateOrCreateStmt, position620)
 			}
 			return true
-		l544:
-			position, tokenIndex, depth = position544, tokenIndex544, depth544
+		l619:
+			position, tokenIndex, depth = position619, tokenIndex619, depth619
 			return false
 		},
-		/* 28 SaveStateStmt <- <(('s' / 'S') ('a' / 'A') ('v' / 'V') ('e' / 'E') sp (('s' / 'S') ('t' / 'T') ('a' / 'A') ('t' / 'T') ('e' / 'E')) sp StreamIdentifier StateTagOpt Action22)> */
+		/* 31 SaveStateStmt <- <(('s' / 'S') ('a' / 'A') ('v' / 'V') ('e' / 'E') sp (('s' / 'S') ('t' / 'T') ('a' / 'A') ('t' / 'T') ('e' / 'E')) sp StreamIdentifier StateTagOpt Action25)> */
 		func() bool {
-			position596, tokenIndex596, depth596 := position, tokenIndex, depth
+			position671, tokenIndex671, depth671 := position, tokenIndex, depth
 			{
-				position597 := position
+				position672 := position
 				depth++
 				{
-					position598, tokenIndex598, depth598 := position, tokenIndex, depth
+					position673, tokenIndex673, depth673 := position, tokenIndex, depth
 					if buffer[position] != rune('s') {
-						goto l599
+						goto l674
 					}
 					position++
-					goto l598
-				l599:
-					position, tokenIndex, depth = position598, tokenIndex598, depth598
+					goto l673
+				l674:
+					position, tokenIndex, depth = position673, tokenIndex673, depth673
 					if buffer[position] != rune('S') {
-						goto l596
+						goto l671
 					}
 					position++
 				}
-			l598:
+			l673:
 				{
-					position600, tokenIndex600, depth600 := position, tokenIndex, depth
+					position675, tokenIndex675, depth675 := position, tokenIndex, depth
 					if buffer[position] != rune('a') {
-						goto l601
+						goto l676
 					}
 					position++
-					goto l600
-				l601:
-					position, tokenIndex, depth = position600, tokenIndex600, depth600
+					goto l675
+				l676:
+					position, tokenIndex, depth = position675, tokenIndex675, depth675
 					if buffer[position] != rune('A') {
-						goto l596
+						goto l671
 					}
 					position++
 				}
-			l600:
+			l675:
 				{
-					position602, tokenIndex602, depth602 := position, tokenIndex, depth
+					position677, tokenIndex677, depth677 := position, tokenIndex, depth
 					if buffer[position] != rune('v') {
-						goto l603
+						goto l678
 					}
 					position++
-					goto l602
-				l603:
-					position, tokenIndex, depth = position602, tokenIndex602, depth602
+					goto l677
+				l678:
+					position, tokenIndex, depth = position677, tokenIndex677, depth677
 					if buffer[position] != rune('V') {
-						goto l596
+						goto l671
 					}
 					position++
 				}
-			l602:
+			l677:
 				{
-					position604, tokenIndex604, depth604 := position, tokenIndex, depth
+					position679, tokenIndex679, depth679 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l605
+						goto l680
 					}
 					position++
-					goto l604
-				l605:
-					position, tokenIndex, depth = position604, tokenIndex604, depth604
+					goto l679
+				l680:
+					position, tokenIndex, depth = position679, tokenIndex679, depth679
 					if buffer[position] != rune('E') {
-						goto l596
+						goto l671
 					}
 					position++
 				}
-			l604:
+			l679:
 				if !_rules[rulesp]() {
-					goto l596
+					goto l671
 				}
 				{
-					position606, tokenIndex606, depth606 := position, tokenIndex, depth
+					position681, tokenIndex681, depth681 := position, tokenIndex, depth
 					if buffer[position] != rune('s') {
-						goto l607
+						goto l682
 					}
 					position++
-					goto l606
-				l607:
-					position, tokenIndex, depth = position606, tokenIndex606, depth606
+					goto l681
+				l682:
+					position, tokenIndex, depth = position681, tokenIndex681, depth681
 					if buffer[position] != rune('S') {
-						goto l596
+						goto l671
 					}
 					position++
 				}
-			l606:
+			l681:
 				{
-					position608, tokenIndex608, depth608 := position, tokenIndex, depth
+					position683, tokenIndex683, depth683 := position, tokenIndex, depth
 					if buffer[position] != rune('t') {
-						goto l609
+						goto l684
 					}
 					position++
-					goto l608
-				l609:
-					position, tokenIndex, depth = position608, tokenIndex608, depth608
+					goto l683
+				l684:
+					position, tokenIndex, depth = position683, tokenIndex683, depth683
 					if buffer[position] != rune('T') {
-						goto l596
+						goto l671
 					}
 					position++
 				}
-			l608:
+			l683:
 				{
-					position610, tokenIndex610, depth610 := position, tokenIndex, depth
+					position685, tokenIndex685, depth685 := position, tokenIndex, depth
 					if buffer[position] != rune('a') {
-						goto l611
+						goto l686
 					}
 					position++
-					goto l610
-				l611:
-					position, tokenIndex, depth = position610, tokenIndex610, depth610
+					goto l685
+				l686:
+					position, tokenIndex, depth = position685, tokenIndex685, depth685
 					if buffer[position] != rune('A') {
-						goto l596
+						goto l671
 					}
 					position++
 				}
-			l610:
+			l685:
 				{
-					position612, tokenIndex612, depth612 := position, tokenIndex, depth
+					position687, tokenIndex687, depth687 := position, tokenIndex, depth
 					if buffer[position] != rune('t') {
-						goto l613
+						goto l688
 					}
 					position++
-					goto l612
-				l613:
-					position, tokenIndex, depth = position612, tokenIndex612, depth612
+					goto l687
+				l688:
+					position, tokenIndex, depth = position687, tokenIndex687, depth687
 					if buffer[position] != rune('T') {
-						goto l596
+						goto l671
 					}
 					position++
 				}
-			l612:
+			l687:
 				{
-					position614, tokenIndex614, depth614 := position, tokenIndex, depth
+					position689, tokenIndex689, depth689 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l615
+						goto l690
 					}
 					position++
-					goto l614
-				l615:
-					position, tokenIndex, depth = position614, tokenIndex614, depth614
+					goto l689
+				l690:
+					position, tokenIndex, depth = position689, tokenIndex689, depth689
 					if buffer[position] != rune('E') {
-						goto l596
+						goto l671
 					}
 					position++
 				}
-			l614:
+			l689:
 				if !_rules[rulesp]() {
-					goto l596
+					goto l671
 				}
 				if !_rules[ruleStreamIdentifier]() {
-					goto l596
+					goto l671
 				}
 				if !_rules[ruleStateTagOpt]() {
-					goto l596
+					goto l671
 				}
-				if !_rules[ruleAction22]() {
-					goto l596
+				if !_rules[ruleAction25]() {
+					goto l671
 				}
 				depth--
-				add(ruleSaveStateStmt, position597)
+				add(ruleSaveStateStmt, position672)
 			}
 			return true
-		l596:
-			position, tokenIndex, depth = position596, tokenIndex596, depth596
+		l671:
+			position, tokenIndex, depth = position671, tokenIndex671, depth671
 			return false
 		},
-		/* 29 EvalStmt <- <(('e' / 'E') ('v' / 'V') ('a' / 'A') ('l' / 'L') sp Expression <(sp (('o' / 'O') ('n' / 'N')) sp MapExpr)?> Action23)> */
+		/* 32 EvalStmt <- <(('e' / 'E') ('v' / 'V') ('a' / 'A') ('l' / 'L') sp Expression <(sp (('o' / 'O') ('n' / 'N')) sp MapExpr)?> Action26)> */
 		func() bool {
-			position616, tokenIndex616, depth616 := position, tokenIndex, depth
+			position691, tokenIndex691, depth691 := position, tokenIndex, depth
 			{
-				position617 := position
+				position692 := position
 				depth++
 				{
-					position618, tokenIndex618, depth618 := position, tokenIndex, depth
+					position693, tokenIndex693, depth693 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l619
+						goto l694
 					}
 					position++
-					goto l618
-				l619:
-					position, tokenIndex, depth = position618, tokenIndex618, depth618
+					goto l693
+				l694:
+					position, tokenIndex, depth = position693, tokenIndex693, depth693
 					if buffer[position] != rune('E') {
-						goto l616
+						goto l691
 					}
 					position++
 				}
-			l618:
+			l693:
 				{
-					position620, tokenIndex620, depth620 := position, tokenIndex, depth
+					position695, tokenIndex695, depth695 := position, tokenIndex, depth
 					if buffer[position] != rune('v') {
-						goto l621
+						goto l696
 					}
 					position++
-					goto l620
-				l621:
-					position, tokenIndex, depth = position620, tokenIndex620, depth620
+					goto l695
+				l696:
+					position, tokenIndex, depth = position695, tokenIndex695, depth695
 					if buffer[position] != rune('V') {
-						goto l616
+						goto l691
 					}
 					position++
 				}
-			l620:
+			l695:
 				{
-					position622, tokenIndex622, depth622 := position, tokenIndex, depth
+					position697, tokenIndex697, depth697 := position, tokenIndex, depth
 					if buffer[position] != rune('a') {
-						goto l623
+						goto l698
 					}
 					position++
-					goto l622
-				l623:
-					position, tokenIndex, depth = position622, tokenIndex622, depth622
+					goto l697
+				l698:
+					position, tokenIndex, depth = position697, tokenIndex697, depth697
 					if buffer[position] != rune('A') {
-						goto l616
+						goto l691
 					}
 					position++
 				}
-			l622:
+			l697:
 				{
-					position624, tokenIndex624, depth624 := position, tokenIndex, depth
+					position699, tokenIndex699, depth699 := position, tokenIndex, depth
 					if buffer[position] != rune('l') {
-						goto l625
+						goto l700
 					}
 					position++
-					goto l624
-				l625:
-					position, tokenIndex, depth = position624, tokenIndex624, depth624
+					goto l699
+				l700:
+					position, tokenIndex, depth = position699, tokenIndex699, depth699
 					if buffer[position] != rune('L') {
-						goto l616
+						goto l691
 					}
 					position++
 				}
-			l624:
+			l699:
 				if !_rules[rulesp]() {
-					goto l616
+					goto l691
 				}
 				if !_rules[ruleExpression]() {
-					goto l616
+					goto l691
 				}
 				{
-					position626 := position
+					position701 := position
 					depth++
 					{
-						position627, tokenIndex627, depth627 := position, tokenIndex, depth
+						position702, tokenIndex702, depth702 := position, tokenIndex, depth
 						if !_rules[rulesp]() {
-							goto l627
+							goto l702
 						}
 						{
-							position629, tokenIndex629, depth629 := position, tokenIndex, depth
+							position704, tokenIndex704, depth704 := position, tokenIndex, depth
 							if buffer[position] != rune('o') {
-								goto l630
+								goto l705
 							}
 							position++
-							goto l629
-						l630:
-							position, tokenIndex, depth = position629, tokenIndex629, depth629
+							goto l704
+						l705:
+							position, tokenIndex, depth = position704, tokenIndex704, depth704
 							if buffer[position] != rune('O') {
-								goto l627
+								goto l702
 							}
 							position++
 						}
-					l629:
+					l704:
 						{
-							position631, tokenIndex631, depth631 := position, tokenIndex, depth
+							position706, tokenIndex706, depth706 := position, tokenIndex, depth
 							if buffer[position] != rune('n') {
-								goto l632
+								goto l707
 							}
 							position++
-							goto l631
-						l632:
-							position, tokenIndex, depth = position631, tokenIndex631, depth631
+							goto l706
+						l707:
+							position, tokenIndex, depth = position706, tokenIndex706, depth706
 							if buffer[position] != rune('N') {
-								goto l627
+								goto l702
 							}
 							position++
 						}
-					l631:
+					l706:
 						if !_rules[rulesp]() {
-							goto l627
+							goto l702
 						}
 						if !_rules[ruleMapExpr]() {
-							goto l627
+							goto l702
 						}
-						goto l628
-					l627:
-						position, tokenIndex, depth = position627, tokenIndex627, depth627
+						goto l703
+					l702:
+						position, tokenIndex, depth = position702, tokenIndex702, depth702
 					}
-				l628:
+				l703:
 					depth--
-					add(rulePegText, position626)
+					add(rulePegText, position701)
 				}
-				if !_rules[ruleAction23]() {
-					goto l616
+				if !_rules[ruleAction26]() {
+					goto l691
 				}
 				depth--
-				add(ruleEvalStmt, position617)
+				add(ruleEvalStmt, position692)
 			}
 			return true
-		l616:
-			position, tokenIndex, depth = position616, tokenIndex616, depth616
+		l691:
+			position, tokenIndex, depth = position691, tokenIndex691, depth691
 			return false
 		},
-		/* 30 Emitter <- <(sp (ISTREAM / DSTREAM / RSTREAM) EmitterOptions Action24)> */
+		/* 33 Emitter <- <(sp (ISTREAM / DSTREAM / RSTREAM) EmitterOptions Action27)> */
 		func() bool {
-			position633, tokenIndex633, depth633 := position, tokenIndex, depth
+			position708, tokenIndex708, depth708 := position, tokenIndex, depth
 			{
-				position634 := position
+				position709 := position
 				depth++
 				if !_rules[rulesp]() {
-					goto l633
+					goto l708
 				}
 				{
-					position635, tokenIndex635, depth635 := position, tokenIndex, depth
+					position710, tokenIndex710, depth710 := position, tokenIndex, depth
 					if !_rules[ruleISTREAM]() {
-						goto l636
+						goto l711
 					}
-					goto l635
-				l636:
-					position, tokenIndex, depth = position635, tokenIndex635, depth635
+					goto l710
+				l711:
+					position, tokenIndex, depth = position710, tokenIndex710, depth710
 					if !_rules[ruleDSTREAM]() {
-						goto l637
+						goto l712
 					}
-					goto l635
-				l637:
-					position, tokenIndex, depth = position635, tokenIndex635, depth635
+					goto l710
+				l712:
+					position, tokenIndex, depth = position710, tokenIndex710, depth710
 					if !_rules[ruleRSTREAM]() {
-						goto l633
+						goto l708
 					}
 				}
-			l635:
+			l710:
 				if !_rules[ruleEmitterOptions]() {
-					goto l633
+					goto l708
 				}
-				if !_rules[ruleAction24]() {
-					goto l633
+				if !_rules[ruleAction27]() {
+					goto l708
 				}
 				depth--
-				add(ruleEmitter, position634)
+				add(ruleEmitter, position709)
 			}
 			return true
-		l633:
-			position, tokenIndex, depth = position633, tokenIndex633, depth633
+		l708:
+			position, tokenIndex, depth = position708, tokenIndex708, depth708
 			return false
 		},
-		/* 31 EmitterOptions <- <(<(spOpt '[' spOpt EmitterOptionCombinations spOpt ']')?> Action25)> */
+		/* 34 EmitterOptions <- <(<(spOpt '[' spOpt EmitterOptionCombinations spOpt ']')?> Action28)> */
 		func() bool {
-			position638, tokenIndex638, depth638 := position, tokenIndex, depth
+			position713, tokenIndex713, depth713 := position, tokenIndex, depth
 			{
-				position639 := position
+				position714 := position
 				depth++
 				{
-					position640 := position
+					position715 := position
 					depth++
 					{
-						position641, tokenIndex641, depth641 := position, tokenIndex, depth
+						position716, tokenIndex716, depth716 := position, tokenIndex, depth
 						if !_rules[rulespOpt]() {
-							goto l641
+							goto l716
 						}
 						if buffer[position] != rune('[') {
-							goto l641
+							goto l716
 						}
 						position++
 						if !_rules[rulespOpt]() {
-							goto l641
+							goto l716
 						}
 						if !_rules[ruleEmitterOptionCombinations]() {
-							goto l641
+							goto l716
 						}
 						if !_rules[rulespOpt]() {
-							goto l641
+							goto l716
 						}
 						if buffer[position] != rune(']') {
-							goto l641
+							goto l716
 						}
 						position++
-						goto l642
-					l641:
-						position, tokenIndex, depth = position641, tokenIndex641, depth641
+						goto l717
+					l716:
+						position, tokenIndex, depth = position716, tokenIndex716, depth716
 					}
-				l642:
+				l717:
 					depth--
-					add(rulePegText, position640)
+					add(rulePegText, position715)
 				}
-				if !_rules[ruleAction25]() {
-					goto l638
+				if !_rules[ruleAction28]() {
+					goto l713
 				}
 				depth--
-				add(ruleEmitterOptions, position639)
+				add(ruleEmitterOptions, position714)
 			}
 			return true
-		l638:
-			position, tokenIndex, depth = position638, tokenIndex638, depth638
+		l713:
+			position, tokenIndex, depth = position713, tokenIndex713, depth713
 			return false
 		},
-		/* 32 EmitterOptionCombinations <- <(EmitterLimit / (EmitterSample sp EmitterLimit) / EmitterSample)> */
+		/* 35 EmitterOptionCombinations <- <(EmitterLimit / (EmitterSample sp EmitterLimit) / EmitterSample)> */
 		func() bool {
-			position643, tokenIndex643, depth643 := position, tokenIndex, depth
+			position718, tokenIndex718, depth718 := position, tokenIndex, depth
 			{
-				position644 := position
+				position719 := position
 				depth++
 				{
-					position645, tokenIndex645, depth645 := position, tokenIndex, depth
+					position720, tokenIndex720, depth720 := position, tokenIndex, depth
 					if !_rules[ruleEmitterLimit]() {
-						goto l646
+						goto l721
 					}
-					goto l645
-				l646:
-					position, tokenIndex, depth = position645, tokenIndex645, depth645
+					goto l720
+				l721:
+					position, tokenIndex, depth = position720, tokenIndex720, depth720
 					if !_rules[ruleEmitterSample]() {
-						goto l647
+						goto l722
 					}
 					if !_rules[rulesp]() {
-						goto l647
+						goto l722
 					}
 					if !_rules[ruleEmitterLimit]() {
-						goto l647
+						goto l722
 					}
-					goto l645
-				l647:
-					position, tokenIndex, depth = position645, tokenIndex645, depth645
+					goto l720
+				l722:
+					position, tokenIndex, depth = position720, tokenIndex720, depth720
 					if !_rules[ruleEmitterSample]() {
-						goto l643
+						goto l718
 					}
 				}
-			l645:
+			l720:
 				depth--
-				add(ruleEmitterOptionCombinations, position644)
+				add(ruleEmitterOptionCombinations, position719)
 			}
 			return true
-		l643:
-			position, tokenIndex, depth = position643, tokenIndex643, depth643
+		l718:
+			position, tokenIndex, depth = position718, tokenIndex718, depth718
 			return false
 		},
-		/* 33 EmitterLimit <- <(('l' / 'L') ('i' / 'I') ('m' / 'M') ('i' / 'I') ('t' / 'T') sp NumericLiteral Action26)> */
+		/* 36 EmitterLimit <- <(('l' / 'L') ('i' / 'I') ('m' / 'M') ('i' / 'I') ('t' / 'T') sp NumericLiteral Action29)> */
 		func() bool {
-			position648, tokenIndex648, depth648 := position, tokenIndex, depth
+			position723, tokenIndex723, depth723 := position, tokenIndex, depth
 			{
-				position649 := position
+				position724 := position
 				depth++
 				{
-					position650, tokenIndex650, depth650 := position, tokenIndex, depth
+					position725, tokenIndex725, depth725 := position, tokenIndex, depth
 					if buffer[position] != rune('l') {
-						goto l651
+						goto l726
 					}
 					position++
-					goto l650
-				l651:
-					position, tokenIndex, depth = position650, tokenIndex650, depth650
+					goto l725
+				l726:
+					position, tokenIndex, depth = position725, tokenIndex725, depth725
 					if buffer[position] != rune('L') {
-						goto l648
+						goto l723
 					}
 					position++
 				}
-			l650:
+			l725:
 				{
-					position652, tokenIndex652, depth652 := position, tokenIndex, depth
+					position727, tokenIndex727, depth727 := position, tokenIndex, depth
 					if buffer[position] != rune('i') {
-						goto l653
+						goto l728
 					}
 					position++
-					goto l652
-				l653:
-					position, tokenIndex, depth = position652, tokenIndex652, depth652
+					goto l727
+				l728:
+					position, tokenIndex, depth = position727, tokenIndex727, depth727
 					if buffer[position] != rune('I') {
-						goto l648
+						goto l723
 					}
 					position++
 				}
-			l652:
+			l727:
 				{
-					position654, tokenIndex654, depth654 := position, tokenIndex, depth
+					position729, tokenIndex729, depth729 := position, tokenIndex, depth
 					if buffer[position] != rune('m') {
-						goto l655
+						goto l730
 					}
 					position++
-					goto l654
-				l655:
-					position, tokenIndex, depth = position654, tokenIndex654, depth654
+					goto l729
+				l730:
+					position, tokenIndex, depth = position729, tokenIndex729, depth729
 					if buffer[position] != rune('M') {
-						goto l648
+						goto l723
 					}
 					position++
 				}
-			l654:
+			l729:
 				{
-					position656, tokenIndex656, depth656 := position, tokenIndex, depth
+					position731, tokenIndex731, depth731 := position, tokenIndex, depth
 					if buffer[position] != rune('i') {
-						goto l657
+						goto l732
 					}
 					position++
-					goto l656
-				l657:
-					position, tokenIndex, depth = position656, tokenIndex656, depth656
+					goto l731
+				l732:
+					position, tokenIndex, depth = position731, tokenIndex731, depth731
 					if buffer[position] != rune('I') {
-						goto l648
+						goto l723
 					}
 					position++
 				}
-			l656:
+			l731:
 				{
-					position658, tokenIndex658, depth658 := position, tokenIndex, depth
+					position733, tokenIndex733, depth733 := position, tokenIndex, depth
 					if buffer[position] != rune('t') {
-						goto l659
+						goto l734
 					}
 					position++
-					goto l658
-				l659:
-					position, tokenIndex, depth = position658, tokenIndex658, depth658
+					goto l733
+				l734:
+					position, tokenIndex, depth = position733, tokenIndex733, depth733
 					if buffer[position] != rune('T') {
-						goto l648
+						goto l723
 					}
 					position++
 				}
-			l658:
+			l733:
 				if !_rules[rulesp]() {
-					goto l648
+					goto l723
 				}
 				if !_rules[ruleNumericLiteral]() {
-					goto l648
+					goto l723
 				}
-				if !_rules[ruleAction26]() {
-					goto l648
+				if !_rules[ruleAction29]() {
+					goto l723
 				}
 				depth--
-				add(ruleEmitterLimit, position649)
+				add(ruleEmitterLimit, position724)
 			}
 			return true
-		l648:
-			position, tokenIndex, depth = position648, tokenIndex648, depth648
+		l723:
+			position, tokenIndex, depth = position723, tokenIndex723, depth723
 			return false
 		},
-		/* 34 EmitterSample <- <(CountBasedSampling / RandomizedSampling / TimeBasedSampling)> */
+		/* 37 EmitterSample <- <(CountBasedSampling / RandomizedSampling / TimeBasedSampling)> */
 		func() bool {
-			position660, tokenIndex660, depth660 := position, tokenIndex, depth
+			position735, tokenIndex735, depth735 := position, tokenIndex, depth
 			{
-				position661 := position
+				position736 := position
 				depth++
 				{
-					position662, tokenIndex662, depth662 := position, tokenIndex, depth
+					position737, tokenIndex737, depth737 := position, tokenIndex, depth
 					if !_rules[ruleCountBasedSampling]() {
-						goto l663
+						goto l738
 					}
-					goto l662
-				l663:
-					position, tokenIndex, depth = position662, tokenIndex662, depth662
+					goto l737
+				l738:
+					position, tokenIndex, depth = position737, tokenIndex737, depth737
 					if !_rules[ruleRandomizedSampling]() {
-						goto l664
+						goto l739
 					}
-					goto l662
-				l664:
-					position, tokenIndex, depth = position662, tokenIndex662, depth662
+					goto l737
+				l739:
+					position, tokenIndex, depth = position737, tokenIndex737, depth737
 					if !_rules[ruleTimeBasedSampling]() {
-						goto l660
+						goto l735
 					}
 				}
-			l662:
+			l737:
 				depth--
-				add(ruleEmitterSample, position661)
+				add(ruleEmitterSample, position736)
 			}
 			return true
-		l660:
-			position, tokenIndex, depth = position660, tokenIndex660, depth660
+		l735:
+			position, tokenIndex, depth = position735, tokenIndex735, depth735
 			return false
 		},
-		/* 35 CountBasedSampling <- <(('e' / 'E') ('v' / 'V') ('e' / 'E') ('r' / 'R') ('y' / 'Y') sp NumericLiteral spOpt '-'? spOpt ((('s' / 'S') ('t' / 'T')) / (('n' / 'N') ('d' / 'D')) / (('r' / 'R') ('d' / 'D')) / (('t' / 'T') ('h' / 'H'))) sp (('t' / 'T') ('u' / 'U') ('p' / 'P') ('l' / 'L') ('e' / 'E')) Action27)> */
+		/* 38 CountBasedSampling <- <(('e' / 'E') ('v' / 'V') ('e' / 'E') ('r' / 'R') ('y' / 'Y') sp NumericLiteral spOpt '-'? spOpt ((('s' / 'S') ('t' / 'T')) / (('n' / 'N') ('d' / 'D')) / (('r' / 'R') ('d' / 'D')) / (('t' / 'T') ('h' / 'H'))) sp (('t' / 'T') ('u' / 'U') ('p' / 'P') ('l' / 'L') ('e' / 'E')) Action30)> */
 		func() bool {
-			position665, tokenIndex665, depth665 := position, tokenIndex, depth
+			position740, tokenIndex740, depth740 := position, tokenIndex, depth
 			{
-				position666 := position
+				position741 := position
 				depth++
 				{
-					position667, tokenIndex667, depth667 := position, tokenIndex, depth
+					position742, tokenIndex742, depth742 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l668
+						goto l743
 					}
 					position++
-					goto l667
-				l668:
-					position, tokenIndex, depth = position667, tokenIndex667, depth667
+					goto l742
+				l743:
+					position, tokenIndex, depth = position742, tokenIndex742, depth742
 					if buffer[position] != rune('E') {
-						goto l665
+						goto l740
 					}
 					position++
 				}
-			l667:
+			l742:
 				{
-					position669, tokenIndex669, depth669 := position, tokenIndex, depth
+					position744, tokenIndex744, depth744 := position, tokenIndex, depth
 					if buffer[position] != rune('v') {
-						goto l670
+						goto l745
 					}
 					position++
-					goto l669
-				l670:
-					position, tokenIndex, depth = position669, tokenIndex669, depth669
+					goto l744
+				l745:
+					position, tokenIndex, depth = position744, tokenIndex744, depth744
 					if buffer[position] != rune('V') {
-						goto l665
+						goto l740
 					}
 					position++
 				}
-			l669:
+			l744:
 				{
-					position671, tokenIndex671, depth671 := position, tokenIndex, depth
+					position746, tokenIndex746, depth746 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l672
+						goto l747
 					}
 					position++
-					goto l671
-				l672:
-					position, tokenIndex, depth = position671, tokenIndex671, depth671
+					goto l746
+				l747:
+					position, tokenIndex, depth = position746, tokenIndex746, depth746
 					if buffer[position] != rune('E') {
-						goto l665
+						goto l740
 					}
 					position++
 				}
-			l671:
+			l746:
 				{
-					position673, tokenIndex673, depth673 := position, tokenIndex, depth
+					position748, tokenIndex748, depth748 := position, tokenIndex, depth
 					if buffer[position] != rune('r') {
-						goto l674
+						goto l749
 					}
 					position++
-					goto l673
-				l674:
-					position, tokenIndex, depth = position673, tokenIndex673, depth673
+					goto l748
+				l749:
+					position, tokenIndex, depth = position748, tokenIndex748, depth748
 					if buffer[position] != rune('R') {
-						goto l665
+						goto l740
 					}
 					position++
 				}
-			l673:
+			l748:
 				{
-					position675, tokenIndex675, depth675 := position, tokenIndex, depth
+					position750, tokenIndex750, depth750 := position, tokenIndex, depth
 					if buffer[position] != rune('y') {
-						goto l676
+						goto l751
 					}
 					position++
-					goto l675
-				l676:
-					position, tokenIndex, depth = position675, tokenIndex675, depth675
+					goto l750
+				l751:
+					position, tokenIndex, depth = position750, tokenIndex750, depth750
 					if buffer[position] != rune('Y') {
-						goto l665
+						goto l740
 					}
 					position++
 				}
-			l675:
+			l750:
 				if !_rules[rulesp]() {
-					goto l665
+					goto l740
 				}
 				if !_rules[ruleNumericLiteral]() {
-					goto l665
+					goto l740
 				}
 				if !_rules[rulespOpt]() {
-					goto l665
+					goto l740
 				}
 				{
-					position677, tokenIndex677, depth677 := position, tokenIndex, depth
+					position752, tokenIndex752, depth752 := position, tokenIndex, depth
 					if buffer[position] != rune('-') {
-						goto l677
+						goto l752
 					}
 					position++
-					goto l678
-				l677:
-					position, tokenIndex, depth = position677, tokenIndex677, depth677
+					goto l753
+				l752:
+					position, tokenIndex, depth = position752, tokenIndex752, depth752
 				}
-			l678:
+			l753:
 				if !_rules[rulespOpt]() {
-					goto l665
+					goto l740
 				}
 				{
-					position679, tokenIndex679, depth679 := position, tokenIndex, depth
+					position754, tokenIndex754, depth754 := position, tokenIndex, depth
 					{
-						position681, tokenIndex681, depth681 := position, tokenIndex, depth
+						position756, tokenIndex756, depth756 := position, tokenIndex, depth
 						if buffer[position] != rune('s') {
-							goto l682
+							goto l757
 						}
 						position++
-						goto l681
-					l682:
-						position, tokenIndex, depth = position681, tokenIndex681, depth681
+						goto l756
+					l757:
+						position, tokenIndex, depth = position756, tokenIndex756, depth756
 						if buffer[position] != rune('S') {
-							goto l680
+							goto l755
 						}
 						position++
 					}
-				l681:
+				l756:
 					{
-						position683, tokenIndex683, depth683 := position, tokenIndex, depth
+						position758, tokenIndex758, depth758 := position, tokenIndex, depth
 						if buffer[position] != rune('t') {
-							goto l684
+							goto l759
 						}
 						position++
-						goto l683
-					l684:
-						position, tokenIndex, depth = position683, tokenIndex683, depth683
+						goto l758
+					l759:
+						position, tokenIndex, depth = position758, tokenIndex758, depth758
 						if buffer[position] != rune('T') {
-							goto l680
+							goto l755
 						}
 						position++
 					}
-				l683:
-					goto l679
-				l680:
-					position, tokenIndex, depth = position679, tokenIndex679, depth679
+				l758:
+					goto l754
+				l755:
+					position, tokenIndex, depth = position754, tokenIndex754, depth754
 					{
-						position686, tokenIndex686, depth686 := position, tokenIndex, depth
+						position761, tokenIndex761, depth761 := position, tokenIndex, depth
 						if buffer[position] != rune('n') {
-							goto l687
+							goto l762
 						}
 						position++
-						goto l686
-					l687:
-						position, tokenIndex, depth = position686, tokenIndex686, depth686
+						goto l761
+					l762:
+						position, tokenIndex, depth = position761, tokenIndex761, depth761
 						if buffer[position] != rune('N') {
-							goto l685
+							goto l760
 						}
 						position++
 					}
-				l686:
+				l761:
 					{
-						position688, tokenIndex688, depth688 := position, tokenIndex, depth
+						position763, tokenIndex763, depth763 := position, tokenIndex, depth
 						if buffer[position] != rune('d') {
-							goto l689
+							goto l764
 						}
 						position++
-						goto l688
-					l689:
-						position, tokenIndex, depth = position688, tokenIndex688, depth688
+						goto l763
+					l764:
+						position, tokenIndex, depth = position763, tokenIndex763, depth763
 						if buffer[position] != rune('D') {
-							goto l685
+							goto l760
 						}
 						position++
 					}
-				l688:
-					goto l679
-				l685:
-					position, tokenIndex, depth = position679, tokenIndex679, depth679
+				l763:
+					goto l754
+				l760:
+					position, tokenIndex, depth = position754, tokenIndex754, depth754
 					{
-						position691, tokenIndex691, depth691 := position, tokenIndex, depth
+						position766, tokenIndex766, depth766 := position, tokenIndex, depth
 						if buffer[position] != rune('r') {
-							goto l692
+							goto l767
 						}
 						position++
-						goto l691
-					l692:
-						position, tokenIndex, depth = position691, tokenIndex691, depth691
+						goto l766
+					l767:
+						position, tokenIndex, depth = position766, tokenIndex766, depth766
 						if buffer[position] != rune('R') {
-							goto l690
+							goto l765
 						}
 						position++
 					}
-				l691:
+				l766:
 					{
-						position693, tokenIndex693, depth693 := position, tokenIndex, depth
+						position768, tokenIndex768, depth768 := position, tokenIndex, depth
 						if buffer[position] != rune('d') {
-							goto l694
+							goto l769
 						}
 						position++
-						goto l693
-					l694:
-						position, tokenIndex, depth = position693, tokenIndex693, depth693
+						goto l768
+					l769:
+						position, tokenIndex, depth = position768, tokenIndex768, depth768
 						if buffer[position] != rune('D') {
-							goto l690
+							goto l765
 						}
 						position++
 					}
-				l693:
-					goto l679
-				l690:
-					position, tokenIndex, depth = position679, tokenIndex679, depth679
+				l768:
+					goto l754
+				l765:
+					position, tokenIndex, depth = position754, tokenIndex754, depth754
 					{
-						position695, tokenIndex695, depth695 := position, tokenIndex, depth
+						position770, tokenIndex770, depth770 := position, tokenIndex, depth
 						if buffer[position] != rune('t') {
-							goto l696
+							goto l771
 						}
 						position++
-						goto l695
-					l696:
-						position, tokenIndex, depth = position695, tokenIndex695, depth695
+						goto l770
+					l771:
+						position, tokenIndex, depth = position770, tokenIndex770, depth770
 						if buffer[position] != rune('T') {
-							goto l665
+							goto l740
 						}
 						position++
 					}
-				l695:
+				l770:
 					{
-						position697, tokenIndex697, depth697 := position, tokenIndex, depth
+						position772, tokenIndex772, depth772 := position, tokenIndex, depth
 						if buffer[position] != rune('h') {
-							goto l698
+							goto l773
 						}
 						position++
-						goto l697
-					l698:
-						position, tokenIndex, depth = position697, tokenIndex697, depth697
+						goto l772
+					l773:
+						position, tokenIndex, depth = position772, tokenIndex772, depth772
 						if buffer[position] != rune('H') {
-							goto l665
+							goto l740
 						}
 						position++
 					}
-				l697:
+				l772:
 				}
-			l679:
+			l754:
 				if !_rules[rulesp]() {
-					goto l665
+					goto l740
 				}
 				{
-					position699, tokenIndex699, depth699 := position, tokenIndex, depth
+					position774, tokenIndex774, depth774 := position, tokenIndex, depth
 					if buffer[position] != rune('t') {
-						goto l700
+						goto l775
 					}
 					position++
-					goto l699
-				l700:
-					position, tokenIndex, depth = position699, tokenIndex699, depth699
+					goto l774
+				l775:
+					position, tokenIndex, depth = position774, tokenIndex774, depth774
 					if buffer[position] != rune('T') {
-						goto l665
+						goto l740
 					}
 					position++
 				}
-			l699:
+			l774:
 				{
-					position701, tokenIndex701, depth701 := position, tokenIndex, depth
+					position776, tokenIndex776, depth776 := position, tokenIndex, depth
 					if buffer[position] != rune('u') {
-						goto l702
+						goto l777
 					}
 					position++
-					goto l701
-				l702:
-					position, tokenIndex, depth = position701, tokenIndex701, depth701
+					goto l776
+				l777:
+					position, tokenIndex, depth = position776, tokenIndex776, depth776
 					if buffer[position] != rune('U') {
-						goto l665
+						goto l740
 					}
 					position++
 				}
-			l701:
+			l776:
 				{
-					position703, tokenIndex703, depth703 := position, tokenIndex, depth
+					position778, tokenIndex778, depth778 := position, tokenIndex, depth
 					if buffer[position] != rune('p') {
-						goto l704
+						goto l779
 					}
 					position++
-					goto l703
-				l704:
-					position, tokenIndex, depth = position703, tokenIndex703, depth703
+					goto l778
+				l779:
+					position, tokenIndex, depth = position778, tokenIndex778, depth778
 					if buffer[position] != rune('P') {
-						goto l665
+						goto l740
 					}
 					position++
 				}
-			l703:
+			l778:
 				{
-					position705, tokenIndex705, depth705 := position, tokenIndex, depth
+					position780, tokenIndex780, depth780 := position, tokenIndex, depth
 					if buffer[position] != rune('l') {
-						goto l706
+						goto l781
 					}
 					position++
-					goto l705
-				l706:
-					position, tokenIndex, depth = position705, tokenIndex705, depth705
+					goto l780
+				l781:
+					position, tokenIndex, depth = position780, tokenIndex780, depth780
 					if buffer[position] != rune('L') {
-						goto l665
+						goto l740
 					}
 					position++
 				}
-			l705:
+			l780:
 				{
-					position707, tokenIndex707, depth707 := position, tokenIndex, depth
+					position782, tokenIndex782, depth782 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l708
+						goto l783
 					}
 					position++
-					goto l707
-				l708:
-					position, tokenIndex, depth = position707, tokenIndex707, depth707
+					goto l782
+				l783:
+					position, tokenIndex, depth = position782, tokenIndex782, depth782
 					if buffer[position] != rune('E') {
-						goto l665
+						goto l740
 					}
 					position++
 				}
-			l707:
-				if !_rules[ruleAction27]() {
-					goto l665
+			l782:
+				if !_rules[ruleAction30]() {
+					goto l740
 				}
 				depth--
-				add(ruleCountBasedSampling, position666)
+				add(ruleCountBasedSampling, position741)
 			}
 			return true
-		l665:
-			position, tokenIndex, depth = position665, tokenIndex665, depth665
+		l740:
+			position, tokenIndex, depth = position740, tokenIndex740, depth740
 			return false
 		},
-		/* 36 RandomizedSampling <- <(('s' / 'S') ('a' / 'A') ('m' / 'M') ('p' / 'P') ('l' / 'L') ('e' / 'E') sp (FloatLiteral / NumericLiteral) spOpt '%' Action28)> */
+		/* 39 RandomizedSampling <- <(('s' / 'S') ('a' / 'A') ('m' / 'M') ('p' / 'P') ('l' / 'L') ('e' / 'E') sp (FloatLiteral / NumericLiteral) spOpt '%' Action31)> */
 		func() bool {
-			position709, tokenIndex709, depth709 := position, tokenIndex, depth
+			position784, tokenIndex784, depth784 := position, tokenIndex, depth
 			{
-				position710 := position
+				position785 := position
 				depth++
 				{
-					position711, tokenIndex711, depth711 := position, tokenIndex, depth
+					position786, tokenIndex786, depth786 := position, tokenIndex, depth
 					if buffer[position] != rune('s') {
-						goto l712
+						goto l787
 					}
 					position++
-					goto l711
-				l712:
-					position, tokenIndex, depth = position711, tokenIndex711, depth711
+					goto l786
+				l787:
+					position, tokenIndex, depth = position786, tokenIndex786, depth786
 					if buffer[position] != rune('S') {
-						goto l709
+						goto l784
 					}
 					position++
 				}
-			l711:
+			l786:
 				{
-					position713, tokenIndex713, depth713 := position, tokenIndex, depth
+					position788, tokenIndex788, depth788 := position, tokenIndex, depth
 					if buffer[position] != rune('a') {
-						goto l714
+						goto l789
 					}
 					position++
-					goto l713
-				l714:
-					position, tokenIndex, depth = position713, tokenIndex713, depth713
+					goto l788
+				l789:
+					position, tokenIndex, depth = position788, tokenIndex788, depth788
 					if buffer[position] != rune('A') {
-						goto l709
+						goto l784
 					}
 					position++
 				}
-			l713:
+			l788:
 				{
-					position715, tokenIndex715, depth715 := position, tokenIndex, depth
+					position790, tokenIndex790, depth790 := position, tokenIndex, depth
 					if buffer[position] != rune('m') {
-						goto l716
+						goto l791
 					}
 					position++
-					goto l715
-				l716:
-					position, tokenIndex, depth = position715, tokenIndex715, depth715
+					goto l790
+				l791:
+					position, tokenIndex, depth = position790, tokenIndex790, depth790
 					if buffer[position] != rune('M') {
-						goto l709
+						goto l784
 					}
 					position++
 				}
-			l715:
+			l790:
 				{
-					position717, tokenIndex717, depth717 := position, tokenIndex, depth
+					position792, tokenIndex792, depth792 := position, tokenIndex, depth
 					if buffer[position] != rune('p') {
-						goto l718
+						goto l793
 					}
 					position++
-					goto l717
-				l718:
-					position, tokenIndex, depth = position717, tokenIndex717, depth717
+					goto l792
+				l793:
+					position, tokenIndex, depth = position792, tokenIndex792, depth792
 					if buffer[position] != rune('P') {
-						goto l709
+						goto l784
 					}
 					position++
 				}
-			l717:
+			l792:
 				{
-					position719, tokenIndex719, depth719 := position, tokenIndex, depth
+					position794, tokenIndex794, depth794 := position, tokenIndex, depth
 					if buffer[position] != rune('l') {
-						goto l720
+						goto l795
 					}
 					position++
-					goto l719
-				l720:
-					position, tokenIndex, depth = position719, tokenIndex719, depth719
+					goto l794
+				l795:
+					position, tokenIndex, depth = position794, tokenIndex794, depth794
 					if buffer[position] != rune('L') {
-						goto l709
+						goto l784
 					}
 					position++
 				}
-			l719:
+			l794:
 				{
-					position721, tokenIndex721, depth721 := position, tokenIndex, depth
+					position796, tokenIndex796, depth796 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l722
+						goto l797
 					}
 					position++
-					goto l721
-				l722:
-					position, tokenIndex, depth = position721, tokenIndex721, depth721
+					goto l796
+				l797:
+					position, tokenIndex, depth = position796, tokenIndex796, depth796
 					if buffer[position] != rune('E') {
-						goto l709
+						goto l784
 					}
 					position++
 				}
-			l721:
+			l796:
 				if !_rules[rulesp]() {
-					goto l709
+					goto l784
 				}
 				{
-					position723, tokenIndex723, depth723 := position, tokenIndex, depth
+					position798, tokenIndex798, depth798 := position, tokenIndex, depth
 					if !_rules[ruleFloatLiteral]() {
-						goto l724
+						goto l799
 					}
-					goto l723
-				l724:
-					position, tokenIndex, depth = position723, tokenIndex723, depth723
+					goto l798
+				l799:
+					position, tokenIndex, depth = position798, tokenIndex798, depth798
 					if !_rules[ruleNumericLiteral]() {
-						goto l709
+						goto l784
 					}
 				}
-			l723:
+			l798:
 				if !_rules[rulespOpt]() {
-					goto l709
+					goto l784
 				}
 				if buffer[position] != rune('%') {
-					goto l709
+					goto l784
 				}
 				position++
-				if !_rules[ruleAction28]() {
-					goto l709
+				if !_rules[ruleAction31]() {
+					goto l784
 				}
 				depth--
-				add(ruleRandomizedSampling, position710)
+				add(ruleRandomizedSampling, position785)
 			}
 			return true
-		l709:
-			position, tokenIndex, depth = position709, tokenIndex709, depth709
+		l784:
+			position, tokenIndex, depth = position784, tokenIndex784, depth784
 			return false
 		},
-		/* 37 TimeBasedSampling <- <(TimeBasedSamplingSeconds / TimeBasedSamplingMilliseconds)> */
+		/* 40 TimeBasedSampling <- <(TimeBasedSamplingSeconds / TimeBasedSamplingMilliseconds)> */
 		func() bool {
-			position725, tokenIndex725, depth725 := position, tokenIndex, depth
+			position800, tokenIndex800, depth800 := position, tokenIndex, depth
 			{
-				position726 := position
+				position801 := position
 				depth++
 				{
-					position727, tokenIndex727, depth727 := position, tokenIndex, depth
+					position802, tokenIndex802, depth802 := position, tokenIndex, depth
 					if !_rules[ruleTimeBasedSamplingSeconds]() {
-						goto l728
+						goto l803
 					}
-					goto l727
-				l728:
-					position, tokenIndex, depth = position727, tokenIndex727, depth727
-					if !_rules[ruleTimeBasedSamplingMilliseconds]() {
-						goto l725
+					goto l802
+				l803:
+					position, tokenIndex, depth = position802, tokenIndex802, depth802
+					if !_rules[ruleTimeBasedSamplingMilliseconds]() {
+						goto l800
 					}
 				}
-			l727:
+			l802:
 				depth--
-				add(ruleTimeBasedSampling, position726)
+				add(ruleTimeBasedSampling, position801)
 			}
 			return true
-		l725:
-			position, tokenIndex, depth = position725, tokenIndex725, depth725
+		l800:
+			position, tokenIndex, depth = position800, tokenIndex800, depth800
 			return false
 		},
-		/* 38 TimeBasedSamplingSeconds <- <(('e' / 'E') ('v' / 'V') ('e' / 'E') ('r' / 'R') ('y' / 'Y') sp (FloatLiteral / NumericLiteral) sp (('s' / 'S') ('e' / 'E') ('c' / 'C') ('o' / 'O') ('n' / 'N') ('d' / 'D') ('s' / 'S')) Action29)> */
+		/* 41 TimeBasedSamplingSeconds <- <(('e' / 'E') ('v' / 'V') ('e' / 'E') ('r' / 'R') ('y' / 'Y') sp (FloatLiteral / NumericLiteral) sp (('s' / 'S') ('e' / 'E') ('c' / 'C') ('o' / 'O') ('n' / 'N') ('d' / 'D') ('s' / 'S')) Action32)> */
 		func() bool {
-			position729, tokenIndex729, depth729 := position, tokenIndex, depth
+			position804, tokenIndex804, depth804 := position, tokenIndex, depth
 			{
-				position730 := position
+				position805 := position
 				depth++
 				{
-					position731, tokenIndex731, depth731 := position, tokenIndex, depth
+					position806, tokenIndex806, depth806 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l732
+						goto l807
 					}
 					position++
-					goto l731
-				l732:
-					position, tokenIndex, depth = position731, tokenIndex731, depth731
+					goto l806
+				l807:
+					position, tokenIndex, depth = position806, tokenIndex806, depth806
 					if buffer[position] != rune('E') {
-						goto l729
+						goto l804
 					}
 					position++
 				}
-			l731:
+			l806:
 				{
-					position733, tokenIndex733, depth733 := position, tokenIndex, depth
+					position808, tokenIndex808, depth808 := position, tokenIndex, depth
 					if buffer[position] != rune('v') {
-						goto l734
+						goto l809
 					}
 					position++
-					goto l733
-				l734:
-					position, tokenIndex, depth = position733, tokenIndex733, depth733
+					goto l808
+				l809:
+					position, tokenIndex, depth = position808, tokenIndex808, depth808
 					if buffer[position] != rune('V') {
-						goto l729
+						goto l804
 					}
 					position++
 				}
-			l733:
+			l808:
 				{
-					position735, tokenIndex735, depth735 := position, tokenIndex, depth
+					position810, tokenIndex810, depth810 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l736
+						goto l811
 					}
 					position++
-					goto l735
-				l736:
-					position, tokenIndex, depth = position735, tokenIndex735, depth735
+					goto l810
+				l811:
+					position, tokenIndex, depth = position810, tokenIndex810, depth810
 					if buffer[position] != rune('E') {
-						goto l729
+						goto l804
 					}
 					position++
 				}
-			l735:
+			l810:
 				{
-					position737, tokenIndex737, depth737 := position, tokenIndex, depth
+					position812, tokenIndex812, depth812 := position, tokenIndex, depth
 					if buffer[position] != rune('r') {
-						goto l738
+						goto l813
 					}
 					position++
-					goto l737
-				l738:
-					position, tokenIndex, depth = position737, tokenIndex737, depth737
+					goto l812
+				l813:
+					position, tokenIndex, depth = position812, tokenIndex812, depth812
 					if buffer[position] != rune('R') {
-						goto l729
+						goto l804
 					}
 					position++
 				}
-			l737:
+			l812:
 				{
-					position739, tokenIndex739, depth739 := position, tokenIndex, depth
+					position814, tokenIndex814, depth814 := position, tokenIndex, depth
 					if buffer[position] != rune('y') {
-						goto l740
+						goto l815
 					}
 					position++
-					goto l739
-				l740:
-					position, tokenIndex, depth = position739, tokenIndex739, depth739
+					goto l814
+				l815:
+					position, tokenIndex, depth = position814, tokenIndex814, depth814
 					if buffer[position] != rune('Y') {
-						goto l729
+						goto l804
 					}
 					position++
 				}
-			l739:
+			l814:
 				if !_rules[rulesp]() {
-					goto l729
+					goto l804
 				}
 				{
-					position741, tokenIndex741, depth741 := position, tokenIndex, depth
+					position816, tokenIndex816, depth816 := position, tokenIndex, depth
 					if !_rules[ruleFloatLiteral]() {
-						goto l742
+						goto l817
 					}
-					goto l741
-				l742:
-					position, tokenIndex, depth = position741, tokenIndex741, depth741
+					goto l816
+				l817:
+					position, tokenIndex, depth = position816, tokenIndex816, depth816
 					if !_rules[ruleNumericLiteral]() {
-						goto l729
+						goto l804
 					}
 				}
-			l741:
+			l816:
 				if !_rules[rulesp]() {
-					goto l729
+					goto l804
 				}
 				{
-					position743, tokenIndex743, depth743 := position, tokenIndex, depth
+					position818, tokenIndex818, depth818 := position, tokenIndex, depth
 					if buffer[position] != rune('s') {
-						goto l744
+						goto l819
 					}
 					position++
-					goto l743
-				l744:
-					position, tokenIndex, depth = position743, tokenIndex743, depth743
+					goto l818
+				l819:
+					position, tokenIndex, depth = position818, tokenIndex818, depth818
 					if buffer[position] != rune('S') {
-						goto l729
+						goto l804
 					}
 					position++
 				}
-			l743:
+			l818:
 				{
-					position745, tokenIndex745, depth745 := position, tokenIndex, depth
+					position820, tokenIndex820, depth820 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l746
+						goto l821
 					}
 					position++
-					goto l745
-				l746:
-					position, tokenIndex, depth = position745, tokenIndex745, depth745
+					goto l820
+				l821:
+					position, tokenIndex, depth = position820, tokenIndex820, depth820
 					if buffer[position] != rune('E') {
-						goto l729
+						goto l804
 					}
 					position++
 				}
-			l745:
+			l820:
 				{
-					position747, tokenIndex747, depth747 := position, tokenIndex, depth
+					position822, tokenIndex822, depth822 := position, tokenIndex, depth
 					if buffer[position] != rune('c') {
-						goto l748
+						goto l823
 					}
 					position++
-					goto l747
-				l748:
-					position, tokenIndex, depth = position747, tokenIndex747, depth747
+					goto l822
+				l823:
+					position, tokenIndex, depth = position822, tokenIndex822, depth822
 					if buffer[position] != rune('C') {
-						goto l729
+						goto l804
 					}
 					position++
 				}
-			l747:
+			l822:
 				{
-					position749, tokenIndex749, depth749 := position, tokenIndex, depth
+					position824, tokenIndex824, depth824 := position, tokenIndex, depth
 					if buffer[position] != rune('o') {
-						goto l750
+						goto l825
 					}
 					position++
-					goto l749
-				l750:
-					position, tokenIndex, depth = position749, tokenIndex749, depth749
+					goto l824
+				l825:
+					position, tokenIndex, depth = position824, tokenIndex824, depth824
 					if buffer[position] != rune('O') {
-						goto l729
+						goto l804
 					}
 					position++
 				}
-			l749:
+			l824:
 				{
-					position751, tokenIndex751, depth751 := position, tokenIndex, depth
+					position826, tokenIndex826, depth826 := position, tokenIndex, depth
 					if buffer[position] != rune('n') {
-						goto l752
+						goto l827
 					}
 					position++
-					goto l751
-				l752:
-					position, tokenIndex, depth = position751, tokenIndex751, depth751
+					goto l826
+				l827:
+					position, tokenIndex, depth = position826, tokenIndex826, depth826
 					if buffer[position] != rune('N') {
-						goto l729
+						goto l804
 					}
 					position++
 				}
-			l751:
+			l826:
 				{
-					position753, tokenIndex753, depth753 := position, tokenIndex, depth
+					position828, tokenIndex828, depth828 := position, tokenIndex, depth
 					if buffer[position] != rune('d') {
-						goto l754
+						goto l829
 					}
 					position++
-					goto l753
-				l754:
-					position, tokenIndex, depth = position753, tokenIndex753, depth753
+					goto l828
+				l829:
+					position, tokenIndex, depth = position828, tokenIndex828, depth828
 					if buffer[position] != rune('D') {
-						goto l729
+						goto l804
 					}
 					position++
 				}
-			l753:
+			l828:
 				{
-					position755, tokenIndex755, depth755 := position, tokenIndex, depth
+					position830, tokenIndex830, depth830 := position, tokenIndex, depth
 					if buffer[position] != rune('s') {
-						goto l756
+						goto l831
 					}
 					position++
-					goto l755
-				l756:
-					position, tokenIndex, depth = position755, tokenIndex755, depth755
+					goto l830
+				l831:
+					position, tokenIndex, depth = position830, tokenIndex830, depth830
 					if buffer[position] != rune('S') {
-						goto l729
+						goto l804
 					}
 					position++
 				}
-			l755:
-				if !_rules[ruleAction29]() {
-					goto l729
+			l830:
+				if !_rules[ruleAction32]() {
+					goto l804
 				}
 				depth--
-				add(ruleTimeBasedSamplingSeconds, position730)
+				add(ruleTimeBasedSamplingSeconds, position805)
 			}
 			return true
-		l729:
-			position, tokenIndex, depth = position729, tokenIndex729, depth729
+		l804:
+			position, tokenIndex, depth = position804, tokenIndex804, depth804
 			return false
 		},
-		/* 39 TimeBasedSamplingMilliseconds <- <(('e' / 'E') ('v' / 'V') ('e' / 'E') ('r' / 'R') ('y' / 'Y') sp (FloatLiteral / NumericLiteral) sp (('m' / 'M') ('i' / 'I') ('l' / 'L') ('l' / 'L') ('i' / 'I') ('s' / 'S') ('e' / 'E') ('c' / 'C') ('o' / 'O') ('n' / 'N') ('d' / 'D') ('s' / 'S')) Action30)> */
+		/* 42 TimeBasedSamplingMilliseconds <- <(('e' / 'E') ('v' / 'V') ('e' / 'E') ('r' / 'R') ('y' / 'Y') sp (FloatLiteral / NumericLiteral) sp (('m' / 'M') ('i' / 'I') ('l' / 'L') ('l' / 'L') ('i' / 'I') ('s' / 'S') ('e' / 'E') ('c' / 'C') ('o' / 'O') ('n' / 'N') ('d' / 'D') ('s' / 'S')) Action33)> */
 		func() bool {
-			position757, tokenIndex757, depth757 := position, tokenIndex, depth
+			position832, tokenIndex832, depth832 := position, tokenIndex, depth
 			{
-				position758 := position
+				position833 := position
 				depth++
 				{
-					position759, tokenIndex759, depth759 := position, tokenIndex, depth
+					position834, tokenIndex834, depth834 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l760
+						goto l835
 					}
 					position++
-					goto l759
-				l760:
-					position, tokenIndex, depth = position759, tokenIndex759, depth759
+					goto l834
+				l835:
+					position, tokenIndex, depth = position834, tokenIndex834, depth834
 					if buffer[position] != rune('E') {
-						goto l757
+						goto l832
 					}
 					position++
 				}
-			l759:
+			l834:
 				{
-					position761, tokenIndex761, depth761 := position, tokenIndex, depth
+					position836, tokenIndex836, depth836 := position, tokenIndex, depth
 					if buffer[position] != rune('v') {
-						goto l762
+						goto l837
 					}
 					position++
-					goto l761
-				l762:
-					position, tokenIndex, depth = position761, tokenIndex761, depth761
+					goto l836
+				l837:
+					position, tokenIndex, depth = position836, tokenIndex836, depth836
 					if buffer[position] != rune('V') {
-						goto l757
+						goto l832
 					}
 					position++
 				}
-			l761:
+			l836:
 				{
-					position763, tokenIndex763, depth763 := position, tokenIndex, depth
+					position838, tokenIndex838, depth838 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l764
+						goto l839
 					}
 					position++
-					goto l763
-				l764:
-					position, tokenIndex, depth = position763, tokenIndex763, depth763
+					goto l838
+				l839:
+					position, tokenIndex, depth = position838, tokenIndex838, depth838
 					if buffer[position] != rune('E') {
-						goto l757
+						goto l832
 					}
 					position++
 				}
-			l763:
+			l838:
 				{
-					position765, tokenIndex765, depth765 := position, tokenIndex, depth
+					position840, tokenIndex840, depth840 := position, tokenIndex, depth
 					if buffer[position] != rune('r') {
-						goto l766
+						goto l841
 					}
 					position++
-					goto l765
-				l766:
-					position, tokenIndex, depth = position765, tokenIndex765, depth765
+					goto l840
+				l841:
+					position, tokenIndex, depth = position840, tokenIndex840, depth840
 					if buffer[position] != rune('R') {
-						goto l757
+						goto l832
 					}
 					position++
 				}
-			l765:
+			l840:
 				{
-					position767, tokenIndex767, depth767 := position, tokenIndex, depth
+					position842, tokenIndex842, depth842 := position, tokenIndex, depth
 					if buffer[position] != rune('y') {
-						goto l768
+						goto l843
 					}
 					position++
-					goto l767
-				l768:
-					position, tokenIndex, depth = position767, tokenIndex767, depth767
+					goto l842
+				l843:
+					position, tokenIndex, depth = position842, tokenIndex842, depth842
 					if buffer[position] != rune('Y') {
-						goto l757
+						goto l832
 					}
 					position++
 				}
-			l767:
+			l842:
 				if !_rules[rulesp]() {
-					goto l757
+					goto l832
 				}
 				{
-					position769, tokenIndex769, depth769 := position, tokenIndex, depth
+					position844, tokenIndex844, depth844 := position, tokenIndex, depth
 					if !_rules[ruleFloatLiteral]() {
-						goto l770
+						goto l845
 					}
-					goto l769
-				l770:
-					position, tokenIndex, depth = position769, tokenIndex769, depth769
+					goto l844
+				l845:
+					position, tokenIndex, depth = position844, tokenIndex844, depth844
 					if !_rules[ruleNumericLiteral]() {
-						goto l757
+						goto l832
 					}
 				}
-			l769:
+			l844:
 				if !_rules[rulesp]() {
-					goto l757
+					goto l832
 				}
 				{
-					position771, tokenIndex771, depth771 := position, tokenIndex, depth
+					position846, tokenIndex846, depth846 := position, tokenIndex, depth
 					if buffer[position] != rune('m') {
-						goto l772
+						goto l847
 					}
 					position++
-					goto l771
-				l772:
-					position, tokenIndex, depth = position771, tokenIndex771, depth771
+					goto l846
+				l847:
+					position, tokenIndex, depth = position846, tokenIndex846, depth846
 					if buffer[position] != rune('M') {
-						goto l757
+						goto l832
 					}
 					position++
 				}
-			l771:
+			l846:
 				{
-					position773, tokenIndex773, depth773 := position, tokenIndex, depth
+					position848, tokenIndex848, depth848 := position, tokenIndex, depth
 					if buffer[position] != rune('i') {
-						goto l774
+						goto l849
 					}
 					position++
-					goto l773
-				l774:
-					position, tokenIndex, depth = position773, tokenIndex773, depth773
+					goto l848
+				l849:
+					position, tokenIndex, depth = position848, tokenIndex848, depth848
 					if buffer[position] != rune('I') {
-						goto l757
+						goto l832
 					}
 					position++
 				}
-			l773:
+			l848:
 				{
-					position775, tokenIndex775, depth775 := position, tokenIndex, depth
+					position850, tokenIndex850, depth850 := position, tokenIndex, depth
 					if buffer[position] != rune('l') {
-						goto l776
+						goto l851
 					}
 					position++
-					goto l775
-				l776:
-					position, tokenIndex, depth = position775, tokenIndex775, depth775
+					goto l850
+				l851:
+					position, tokenIndex, depth = position850, tokenIndex850, depth850
 					if buffer[position] != rune('L') {
-						goto l757
+						goto l832
 					}
 					position++
 				}
-			l775:
+			l850:
 				{
-					position777, tokenIndex777, depth777 := position, tokenIndex, depth
+					position852, tokenIndex852, depth852 := position, tokenIndex, depth
 					if buffer[position] != rune('l') {
-						goto l778
+						goto l853
 					}
 					position++
-					goto l777
-				l778:
-					position, tokenIndex, depth = position777, tokenIndex777, depth777
+					goto l852
+				l853:
+					position, tokenIndex, depth = position852, tokenIndex852, depth852
 					if buffer[position] != rune('L') {
-						goto l757
+						goto l832
 					}
 					position++
 				}
-			l777:
+			l852:
 				{
-					position779, tokenIndex779, depth779 := position, tokenIndex, depth
+					position854, tokenIndex854, depth854 := position, tokenIndex, depth
 					if buffer[position] != rune('i') {
-						goto l780
+						goto l855
 					}
 					position++
-					goto l779
-				l780:
-					position, tokenIndex, depth = position779, tokenIndex779, depth779
+					goto l854
+				l855:
+					position, tokenIndex, depth = position854, tokenIndex854, depth854
 					if buffer[position] != rune('I') {
-						goto l757
+						goto l832
 					}
 					position++
 				}
-			l779:
+			l854:
 				{
-					position781, tokenIndex781, depth781 := position, tokenIndex, depth
+					position856, tokenIndex856, depth856 := position, tokenIndex, depth
 					if buffer[position] != rune('s') {
-						goto l782
+						goto l857
 					}
 					position++
-					goto l781
-				l782:
-					position, tokenIndex, depth = position781, tokenIndex781, depth781
+					goto l856
+				l857:
+					position, tokenIndex, depth = position856, tokenIndex856, depth856
 					if buffer[position] != rune('S') {
-						goto l757
+						goto l832
 					}
 					position++
 				}
-			l781:
+			l856:
 				{
-					position783, tokenIndex783, depth783 := position, tokenIndex, depth
+					position858, tokenIndex858, depth858 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l784
+						goto l859
 					}
 					position++
-					goto l783
-				l784:
-					position, tokenIndex, depth = position783, tokenIndex783, depth783
+					goto l858
+				l859:
+					position, tokenIndex, depth = position858, tokenIndex858, depth858
 					if buffer[position] != rune('E') {
-						goto l757
+						goto l832
 					}
 					position++
 				}
-			l783:
+			l858:
 				{
-					position785, tokenIndex785, depth785 := position, tokenIndex, depth
+					position860, tokenIndex860, depth860 := position, tokenIndex, depth
 					if buffer[position] != rune('c') {
-						goto l786
+						goto l861
 					}
 					position++
-					goto l785
-				l786:
-					position, tokenIndex, depth = position785, tokenIndex785, depth785
+					goto l860
+				l861:
+					position, tokenIndex, depth = position860, tokenIndex860, depth860
 					if buffer[position] != rune('C') {
-						goto l757
+						goto l832
 					}
 					position++
 				}
-			l785:
+			l860:
 				{
-					position787, tokenIndex787, depth787 := position, tokenIndex, depth
+					position862, tokenIndex862, depth862 := position, tokenIndex, depth
 					if buffer[position] != rune('o') {
-						goto l788
+						goto l863
 					}
 					position++
-					goto l787
-				l788:
-					position, tokenIndex, depth = position787, tokenIndex787, depth787
+					goto l862
+				l863:
+					position, tokenIndex, depth = position862, tokenIndex862, depth862
 					if buffer[position] != rune('O') {
-						goto l757
+						goto l832
 					}
 					position++
 				}
-			l787:
+			l862:
 				{
-					position789, tokenIndex789, depth789 := position, tokenIndex, depth
+					position864, tokenIndex864, depth864 := position, tokenIndex, depth
 					if buffer[position] != rune('n') {
-						goto l790
+						goto l865
 					}
 					position++
-					goto l789
-				l790:
-					position, tokenIndex, depth = position789, tokenIndex789, depth789
+					goto l864
+				l865:
+					position, tokenIndex, depth = position864, tokenIndex864, depth864
 					if buffer[position] != rune('N') {
-						goto l757
+						goto l832
 					}
 					position++
 				}
-			l789:
+			l864:
 				{
-					position791, tokenIndex791, depth791 := position, tokenIndex, depth
+					position866, tokenIndex866, depth866 := position, tokenIndex, depth
 					if buffer[position] != rune('d') {
-						goto l792
+						goto l867
 					}
 					position++
-					goto l791
-				l792:
-					position, tokenIndex, depth = position791, tokenIndex791, depth791
+					goto l866
+				l867:
+					position, tokenIndex, depth = position866, tokenIndex866, depth866
 					if buffer[position] != rune('D') {
-						goto l757
+						goto l832
 					}
 					position++
 				}
-			l791:
+			l866:
 				{
-					position793, tokenIndex793, depth793 := position, tokenIndex, depth
+					position868, tokenIndex868, depth868 := position, tokenIndex, depth
 					if buffer[position] != rune('s') {
-						goto l794
+						goto l869
 					}
 					position++
-					goto l793
-				l794:
-					position, tokenIndex, depth = position793, tokenIndex793, depth793
+					goto l868
+				l869:
+					position, tokenIndex, depth = position868, tokenIndex868, depth868
 					if buffer[position] != rune('S') {
-						goto l757
+						goto l832
 					}
 					position++
 				}
-			l793:
-				if !_rules[ruleAction30]() {
-					goto l757
+			l868:
+				if !_rules[ruleAction33]() {
+					goto l832
 				}
 				depth--
-				add(ruleTimeBasedSamplingMilliseconds, position758)
+				add(ruleTimeBasedSamplingMilliseconds, position833)
 			}
 			return true
-		l757:
-			position, tokenIndex, depth = position757, tokenIndex757, depth757
+		l832:
+			position, tokenIndex, depth = position832, tokenIndex832, depth832
 			return false
 		},
-		/* 40 Projections <- <(<(sp Projection (spOpt ',' spOpt Projection)*)> Action31)> */
+		/* 43 Projections <- <(<(sp Projection (spOpt ',' spOpt Projection)*)> Action34)> */
 		func() bool {
-			position795, tokenIndex795, depth795 := position, tokenIndex, depth
+			position870, tokenIndex870, depth870 := position, tokenIndex, depth
 			{
-				position796 := position
+				position871 := position
 				depth++
 				{
-					position797 := position
+					position872 := position
 					depth++
 					if !_rules[rulesp]() {
-						goto l795
+						goto l870
 					}
 					if !_rules[ruleProjection]() {
-						goto l795
+						goto l870
 					}
-				l798:
+				l873:
 					{
-						position799, tokenIndex799, depth799 := position, tokenIndex, depth
+						position874, tokenIndex874, depth874 := position, tokenIndex, depth
 						if !_rules[rulespOpt]() {
-							goto l799
+							goto l874
 						}
 						if buffer[position] != rune(',') {
-							goto l799
+							goto l874
 						}
 						position++
 						if !_rules[rulespOpt]() {
-							goto l799
+							goto l874
 						}
 						if !_rules[ruleProjection]() {
-							goto l799
+							goto l874
 						}
-						goto l798
-					l799:
-						position, tokenIndex, depth = position799, tokenIndex799, depth799
+						goto l873
+					l874:
+						position, tokenIndex, depth = position874, tokenIndex874, depth874
 					}
 					depth--
-					add(rulePegText, position797)
+					add(rulePegText, position872)
 				}
-				if !_rules[ruleAction31]() {
-					goto l795
+				if !_rules[ruleAction34]() {
+					goto l870
 				}
 				depth--
-				add(ruleProjections, position796)
+				add(ruleProjections, position871)
 			}
 			return true
-		l795:
-			position, tokenIndex, depth = position795, tokenIndex795, depth795
+		l870:
+			position, tokenIndex, depth = position870, tokenIndex870, depth870
 			return false
 		},
-		/* 41 Projection <- <(AliasExpression / ExpressionOrWildcard)> */
+		/* 44 Projection <- <(AliasExpression / ExpressionOrWildcard)> */
 		func() bool {
-			position800, tokenIndex800, depth800 := position, tokenIndex, depth
+			position875, tokenIndex875, depth875 := position, tokenIndex, depth
 			{
-				position801 := position
+				position876 := position
 				depth++
 				{
-					position802, tokenIndex802, depth802 := position, tokenIndex, depth
+					position877, tokenIndex877, depth877 := position, tokenIndex, depth
 					if !_rules[ruleAliasExpression]() {
-						goto l803
+						goto l878
 					}
-					goto l802
-				l803:
-					position, tokenIndex, depth = position802, tokenIndex802, depth802
+					goto l877
+				l878:
+					position, tokenIndex, depth = position877, tokenIndex877, depth877
 					if !_rules[ruleExpressionOrWildcard]() {
-						goto l800
+						goto l875
 					}
 				}
-			l802:
+			l877:
 				depth--
-				add(ruleProjection, position801)
+				add(ruleProjection, position876)
 			}
 			return true
-		l800:
-			position, tokenIndex, depth = position800, tokenIndex800, depth800
+		l875:
+			position, tokenIndex, depth = position875, tokenIndex875, depth875
 			return false
 		},
-		/* 42 AliasExpression <- <(ExpressionOrWildcard sp (('a' / 'A') ('s' / 'S')) sp TargetIdentifier Action32)> */
+		/* 45 AliasExpression <- <(ExpressionOrWildcard sp (('a' / 'A') ('s' / 'S')) sp TargetIdentifier Action35)> */
 		func() bool {
-			position804, tokenIndex804, depth804 := position, tokenIndex, depth
+			position879, tokenIndex879, depth879 := position, tokenIndex, depth
 			{
-				position805 := position
+				position880 := position
 				depth++
 				if !_rules[ruleExpressionOrWildcard]() {
-					goto l804
+					goto l879
 				}
 				if !_rules[rulesp]() {
-					goto l804
+					goto l879
 				}
 				{
-					position806, tokenIndex806, depth806 := position, tokenIndex, depth
+					position881, tokenIndex881, depth881 := position, tokenIndex, depth
 					if buffer[position] != rune('a') {
-						goto l807
+						goto l882
 					}
 					position++
-					goto l806
-				l807:
-					position, tokenIndex, depth = position806, tokenIndex806, depth806
+					goto l881
+				l882:
+					position, tokenIndex, depth = position881, tokenIndex881, depth881
 					if buffer[position] != rune('A') {
-						goto l804
+						goto l879
 					}
 					position++
 				}
-			l806:
+			l881:
 				{
-					position808, tokenIndex808, depth808 := position, tokenIndex, depth
+					position883, tokenIndex883, depth883 := position, tokenIndex, depth
 					if buffer[position] != rune('s') {
-						goto l809
+						goto l884
 					}
 					position++
-					goto l808
-				l809:
-					position, tokenIndex, depth = position808, tokenIndex808, depth808
+					goto l883
+				l884:
+					position, tokenIndex, depth = position883, tokenIndex883, depth883
 					if buffer[position] != rune('S') {
-						goto l804
+						goto l879
 					}
 					position++
 				}
-			l808:
+			l883:
 				if !_rules[rulesp]() {
-					goto l804
+					goto l879
 				}
 				if !_rules[ruleTargetIdentifier]() {
-					goto l804
+					goto l879
 				}
-				if !_rules[ruleAction32]() {
-					goto l804
+				if !_rules[ruleAction35]() {
+					goto l879
 				}
 				depth--
-				add(ruleAliasExpression, position805)
+				add(ruleAliasExpression, position880)
 			}
 			return true
-		l804:
-			position, tokenIndex, depth = position804, tokenIndex804, depth804
+		l879:
+			position, tokenIndex, depth = position879, tokenIndex879, depth879
 			return false
 		},
-		/* 43 WindowedFrom <- <(<(sp (('f' / 'F') ('r' / 'R') ('o' / 'O') ('m' / 'M')) sp Relations)?> Action33)> */
+		/* 46 WindowedFrom <- <(<(sp (('f' / 'F') ('r' / 'R') ('o' / 'O') ('m' / 'M')) sp Relations)?> Action36)> */
 		func() bool {
-			position810, tokenIndex810, depth810 := position, tokenIndex, depth
+			position885, tokenIndex885, depth885 := position, tokenIndex, depth
 			{
-				position811 := position
+				position886 := position
 				depth++
 				{
-					position812 := position
+					position887 := position
 					depth++
 					{
-						position813, tokenIndex813, depth813 := position, tokenIndex, depth
+						position888, tokenIndex888, depth888 := position, tokenIndex, depth
 						if !_rules[rulesp]() {
-							goto l813
+							goto l888
 						}
 						{
-							position815, tokenIndex815, depth815 := position, tokenIndex, depth
+							position890, tokenIndex890, depth890 := position, tokenIndex, depth
 							if buffer[position] != rune('f') {
-								goto l816
+								goto l891
 							}
 							position++
-							goto l815
-						l816:
-							position, tokenIndex, depth = position815, tokenIndex815, depth815
+							goto l890
+						l891:
+							position, tokenIndex, depth = position890, tokenIndex890, depth890
 							if buffer[position] != rune('F') {
-								goto l813
+								goto l888
 							}
 							position++
 						}
-					l815:
+					l890:
 						{
-							position817, tokenIndex817, depth817 := position, tokenIndex, depth
+							position892, tokenIndex892, depth892 := position, tokenIndex, depth
 							if buffer[position] != rune('r') {
-								goto l818
+								goto l893
 							}
 							position++
-							goto l817
-						l818:
-							position, tokenIndex, depth = position817, tokenIndex817, depth817
+							goto l892
+						l893:
+							position, tokenIndex, depth = position892, tokenIndex892, depth892
 							if buffer[position] != rune('R') {
-								goto l813
+								goto l888
 							}
 							position++
 						}
-					l817:
+					l892:
 						{
-							position819, tokenIndex819, depth819 := position, tokenIndex, depth
+							position894, tokenIndex894, depth894 := position, tokenIndex, depth
 							if buffer[position] != rune('o') {
-								goto l820
+								goto l895
 							}
 							position++
-							goto l819
-						l820:
-							position, tokenIndex, depth = position819, tokenIndex819, depth819
+							goto l894
+						l895:
+							position, tokenIndex, depth = position894, tokenIndex894, depth894
 							if buffer[position] != rune('O') {
-								goto l813
+								goto l888
 							}
 							position++
 						}
-					l819:
+					l894:
 						{
-							position821, tokenIndex821, depth821 := position, tokenIndex, depth
+							position896, tokenIndex896, depth896 := position, tokenIndex, depth
 							if buffer[position] != rune('m') {
-								goto l822
+								goto l897
 							}
 							position++
-							goto l821
-						l822:
-							position, tokenIndex, depth = position821, tokenIndex821, depth821
+							goto l896
+						l897:
+							position, tokenIndex, depth = position896, tokenIndex896, depth896
 							if buffer[position] != rune('M') {
-								goto l813
+								goto l888
 							}
 							position++
 						}
-					l821:
+					l896:
 						if !_rules[rulesp]() {
-							goto l813
+							goto l888
 						}
 						if !_rules[ruleRelations]() {
-							goto l813
+							goto l888
 						}
-						goto l814
-					l813:
-						position, tokenIndex, depth = position813, tokenIndex813, depth813
+						goto l889
+					l888:
+						position, tokenIndex, depth = position888, tokenIndex888, depth888
 					}
-				l814:
+				l889:
 					depth--
-					add(rulePegText, position812)
+					add(rulePegText, position887)
 				}
-				if !_rules[ruleAction33]() {
-					goto l810
+				if !_rules[ruleAction36]() {
+					goto l885
 				}
 				depth--
-				add(ruleWindowedFrom, position811)
+				add(ruleWindowedFrom, position886)
 			}
 			return true
-		l810:
-			position, tokenIndex, depth = position810, tokenIndex810, depth810
+		l885:
+			position, tokenIndex, depth = position885, tokenIndex885, depth885
 			return false
 		},
-		/* 44 Interval <- <(TimeInterval / TuplesInterval)> */
+		/* 47 Interval <- <(TimeInterval / TuplesInterval)> */
 		func() bool {
-			position823, tokenIndex823, depth823 := position, tokenIndex, depth
+			position898, tokenIndex898, depth898 := position, tokenIndex, depth
 			{
-				position824 := position
+				position899 := position
 				depth++
 				{
-					position825, tokenIndex825, depth825 := position, tokenIndex, depth
+					position900, tokenIndex900, depth900 := position, tokenIndex, depth
 					if !_rules[ruleTimeInterval]() {
-						goto l826
+						goto l901
 					}
-					goto l825
-				l826:
-					position, tokenIndex, depth = position825, tokenIndex825, depth825
+					goto l900
+				l901:
+					position, tokenIndex, depth = position900, tokenIndex900, depth900
 					if !_rules[ruleTuplesInterval]() {
-						goto l823
+						goto l898
 					}
 				}
-			l825:
+			l900:
 				depth--
-				add(ruleInterval, position824)
+				add(ruleInterval, position899)
 			}
 			return true
-		l823:
-			position, tokenIndex, depth = position823, tokenIndex823, depth823
+		l898:
+			position, tokenIndex, depth = position898, tokenIndex898, depth898
 			return false
 		},
-		/* 45 TimeInterval <- <((FloatLiteral / NumericLiteral) sp (SECONDS / MILLISECONDS) Action34)> */
+		/* 48 TimeInterval <- <((FloatLiteral / NumericLiteral) sp (MINUTES / SECONDS / MILLISECONDS) Action37)> */
 		func() bool {
-			position827, tokenIndex827, depth827 := position, tokenIndex, depth
+			position902, tokenIndex902, depth902 := position, tokenIndex, depth
 			{
-				position828 := position
+				position903 := position
 				depth++
 				{
-					position829, tokenIndex829, depth829 := position, tokenIndex, depth
+					position904, tokenIndex904, depth904 := position, tokenIndex, depth
 					if !_rules[ruleFloatLiteral]() {
-						goto l830
+						goto l905
 					}
-					goto l829
-				l830:
-					position, tokenIndex, depth = position829, tokenIndex829, depth829
+					goto l904
+				l905:
+					position, tokenIndex, depth = position904, tokenIndex904, depth904
 					if !_rules[ruleNumericLiteral]() {
-						goto l827
+						goto l902
 					}
 				}
-			l829:
+			l904:
 				if !_rules[rulesp]() {
-					goto l827
+					goto l902
 				}
 				{
-					position831, tokenIndex831, depth831 := position, tokenIndex, depth
+					position906, tokenIndex906, depth906 := position, tokenIndex, depth
+					if !_rules[ruleMINUTES]() {
+						goto l907
+					}
+					goto l906
+				l907:
+					position, tokenIndex, depth = position906, tokenIndex906, depth906
 					if !_rules[ruleSECONDS]() {
-						goto l832
+						goto l908
 					}
-					goto l831
-				l832:
-					position, tokenIndex, depth = position831, tokenIndex831, depth831
+					goto l906
+				l908:
+					position, tokenIndex, depth = position906, tokenIndex906, depth906
 					if !_rules[ruleMILLISECONDS]() {
-						goto l827
+						goto l902
 					}
 				}
-			l831:
-				if !_rules[ruleAction34]() {
-					goto l827
+			l906:
+				if !_rules[ruleAction37]() {
+					goto l902
 				}
 				depth--
-				add(ruleTimeInterval, position828)
+				add(ruleTimeInterval, position903)
 			}
 			return true
-		l827:
-			position, tokenIndex, depth = position827, tokenIndex827, depth827
+		l902:
+			position, tokenIndex, depth = position902, tokenIndex902, depth902
 			return false
 		},
-		/* 46 TuplesInterval <- <(NumericLiteral sp TUPLES Action35)> */
+		/* 49 TuplesInterval <- <(NumericLiteral sp TUPLES Action38)> */
 		func() bool {
-			position833, tokenIndex833, depth833 := position, tokenIndex, depth
+			position909, tokenIndex909, depth909 := position, tokenIndex, depth
 			{
-				position834 := position
+				position910 := position
 				depth++
 				if !_rules[ruleNumericLiteral]() {
-					goto l833
+					goto l909
 				}
 				if !_rules[rulesp]() {
-					goto l833
+					goto l909
 				}
 				if !_rules[ruleTUPLES]() {
-					goto l833
+					goto l909
 				}
-				if !_rules[ruleAction35]() {
-					goto l833
+				if !_rules[ruleAction38]() {
+					goto l909
 				}
 				depth--
-				add(ruleTuplesInterval, position834)
+				add(ruleTuplesInterval, position910)
 			}
 			return true
-		l833:
-			position, tokenIndex, depth = position833, tokenIndex833, depth833
+		l909:
+			position, tokenIndex, depth = position909, tokenIndex909, depth909
 			return false
 		},
-		/* 47 Relations <- <(RelationLike (spOpt ',' spOpt RelationLike)*)> */
+		/* 50 Relations <- <(RelationLike (JoinedRelation / (spOpt ',' spOpt RelationLike)*))> */
 		func() bool {
-			position835, tokenIndex835, depth835 := position, tokenIndex, depth
+			position911, tokenIndex911, depth911 := position, tokenIndex, depth
 			{
-				position836 := position
+				position912 := position
 				depth++
 				if !_rules[ruleRelationLike]() {
-					goto l835
+					goto l911
 				}
-			l837:
 				{
-					position838, tokenIndex838, depth838 := position, tokenIndex, depth
-					if !_rules[rulespOpt]() {
-						goto l838
+					position913, tokenIndex913, depth913 := position, tokenIndex, depth
+					if !_rules[ruleJoinedRelation]() {
+						goto l914
 					}
-					if buffer[position] != rune(',') {
-						goto l838
+					goto l913
+				l914:
+					position, tokenIndex, depth = position913, tokenIndex913, depth913
+				l915:
+					{
+						position916, tokenIndex916, depth916 := position, tokenIndex, depth
+						if !_rules[rulespOpt]() {
+							goto l916
+						}
+						if buffer[position] != rune(',') {
+							goto l916
+						}
+						position++
+						if !_rules[rulespOpt]() {
+							goto l916
+						}
+						if !_rules[ruleRelationLike]() {
+							goto l916
+						}
+						goto l915
+					l916:
+						position, tokenIndex, depth = position916, tokenIndex916, depth916
+					}
+				}
+			l913:
+				depth--
+				add(ruleRelations, position912)
+			}
+			return true
+		l911:
+			position, tokenIndex, depth = position911, tokenIndex911, depth911
+			return false
+		},
+		/* 51 JoinedRelation <- <(sp JoinType sp (RelationLike / JoinedTable) sp (('o' / 'O') ('n' / 'N')) sp Expression Action39)> */
+		func() bool {
+			position917, tokenIndex917, depth917 := position, tokenIndex, depth
+			{
+				position918 := position
+				depth++
+				if !_rules[rulesp]() {
+					goto l917
+				}
+				if !_rules[ruleJoinType]() {
+					goto l917
+				}
+				if !_rules[rulesp]() {
+					goto l917
+				}
+				{
+					position919, tokenIndex919, depth919 := position, tokenIndex, depth
+					if !_rules[ruleRelationLike]() {
+						goto l920
+					}
+					goto l919
+				l920:
+					position, tokenIndex, depth = position919, tokenIndex919, depth919
+					if !_rules[ruleJoinedTable]() {
+						goto l917
+					}
+				}
+			l919:
+				if !_rules[rulesp]() {
+					goto l917
+				}
+				{
+					position921, tokenIndex921, depth921 := position, tokenIndex, depth
+					if buffer[position] != rune('o') {
+						goto l922
 					}
 					position++
-					if !_rules[rulespOpt]() {
-						goto l838
+					goto l921
+				l922:
+					position, tokenIndex, depth = position921, tokenIndex921, depth921
+					if buffer[position] != rune('O') {
+						goto l917
 					}
-					if !_rules[ruleRelationLike]() {
-						goto l838
+					position++
+				}
+			l921:
+				{
+					position923, tokenIndex923, depth923 := position, tokenIndex, depth
+					if buffer[position] != rune('n') {
+						goto l924
 					}
-					goto l837
-				l838:
-					position, tokenIndex, depth = position838, tokenIndex838, depth838
+					position++
+					goto l923
+				l924:
+					position, tokenIndex, depth = position923, tokenIndex923, depth923
+					if buffer[position] != rune('N') {
+						goto l917
+					}
+					position++
+				}
+			l923:
+				if !_rules[rulesp]() {
+					goto l917
+				}
+				if !_rules[ruleExpression]() {
+					goto l917
+				}
+				if !_rules[ruleAction39]() {
+					goto l917
 				}
 				depth--
-				add(ruleRelations, position836)
+				add(ruleJoinedRelation, position918)
 			}
 			return true
-		l835:
-			position, tokenIndex, depth = position835, tokenIndex835, depth835
+		l917:
+			position, tokenIndex, depth = position917, tokenIndex917, depth917
 			return false
 		},
-		/* 48 Filter <- <(<(sp (('w' / 'W') ('h' / 'H') ('e' / 'E') ('r' / 'R') ('e' / 'E')) sp Expression)?> Action36)> */
+		/* 52 JoinedTable <- <StreamIdentifier> */
 		func() bool {
-			position839, tokenIndex839, depth839 := position, tokenIndex, depth
+			position925, tokenIndex925, depth925 := position, tokenIndex, depth
 			{
-				position840 := position
+				position926 := position
 				depth++
-				{
-					position841 := position
+				if !_rules[ruleStreamIdentifier]() {
+					goto l925
+				}
+				depth--
+				add(ruleJoinedTable, position926)
+			}
+			return true
+		l925:
+			position, tokenIndex, depth = position925, tokenIndex925, depth925
+			return false
+		},
+		/* 53 JoinType <- <(InnerJoin / LeftOuterJoin)> */
+		func() bool {
+			position927, tokenIndex927, depth927 := position, tokenIndex, depth
+			{
+				position928 := position
+				depth++
+				{
+					position929, tokenIndex929, depth929 := position, tokenIndex, depth
+					if !_rules[ruleInnerJoin]() {
+						goto l930
+					}
+					goto l929
+				l930:
+					position, tokenIndex, depth = position929, tokenIndex929, depth929
+					if !_rules[ruleLeftOuterJoin]() {
+						goto l927
+					}
+				}
+			l929:
+				depth--
+				add(ruleJoinType, position928)
+			}
+			return true
+		l927:
+			position, tokenIndex, depth = position927, tokenIndex927, depth927
+			return false
+		},
+		/* 54 Filter <- <(<(sp (('w' / 'W') ('h' / 'H') ('e' / 'E') ('r' / 'R') ('e' / 'E')) sp Expression)?> Action40)> */
+		func() bool {
+			position931, tokenIndex931, depth931 := position, tokenIndex, depth
+			{
+				position932 := position
+				depth++
+				{
+					position933 := position
 					depth++
 					{
-						position842, tokenIndex842, depth842 := position, tokenIndex, depth
+						position934, tokenIndex934, depth934 := position, tokenIndex, depth
 						if !_rules[rulesp]() {
-							goto l842
+							goto l934
 						}
 						{
-							position844, tokenIndex844, depth844 := position, tokenIndex, depth
+							position936, tokenIndex936, depth936 := position, tokenIndex, depth
 							if buffer[position] != rune('w') {
-								goto l845
+								goto l937
 							}
 							position++
-							goto l844
-						l845:
-							position, tokenIndex, depth = position844, tokenIndex844, depth844
+							goto l936
+						l937:
+							position, tokenIndex, depth = position936, tokenIndex936, depth936
 							if buffer[position] != rune('W') {
-								goto l842
+								goto l934
 							}
 							position++
 						}
-					l844:
+					l936:
 						{
-							position846, tokenIndex846, depth846 := position, tokenIndex, depth
+							position938, tokenIndex938, depth938 := position, tokenIndex, depth
 							if buffer[position] != rune('h') {
-								goto l847
+								goto l939
 							}
 							position++
-							goto l846
-						l847:
-							position, tokenIndex, depth = position846, tokenIndex846, depth846
+							goto l938
+						l939:
+							position, tokenIndex, depth = position938, tokenIndex938, depth938
 							if buffer[position] != rune('H') {
-								goto l842
+								goto l934
 							}
 							position++
 						}
-					l846:
+					l938:
 						{
-							position848, tokenIndex848, depth848 := position, tokenIndex, depth
+							position940, tokenIndex940, depth940 := position, tokenIndex, depth
 							if buffer[position] != rune('e') {
-								goto l849
+								goto l941
 							}
 							position++
-							goto l848
-						l849:
-							position, tokenIndex, depth = position848, tokenIndex848, depth848
+							goto l940
+						l941:
+							position, tokenIndex, depth = position940, tokenIndex940, depth940
 							if buffer[position] != rune('E') {
-								goto l842
+								goto l934
 							}
 							position++
 						}
-					l848:
+					l940:
 						{
-							position850, tokenIndex850, depth850 := position, tokenIndex, depth
+							position942, tokenIndex942, depth942 := position, tokenIndex, depth
 							if buffer[position] != rune('r') {
-								goto l851
+								goto l943
 							}
 							position++
-							goto l850
-						l851:
-							position, tokenIndex, depth = position850, tokenIndex850, depth850
+							goto l942
+						l943:
+							position, tokenIndex, depth = position942, tokenIndex942, depth942
 							if buffer[position] != rune('R') {
-								goto l842
+								goto l934
 							}
 							position++
 						}
-					l850:
+					l942:
 						{
-							position852, tokenIndex852, depth852 := position, tokenIndex, depth
+							position944, tokenIndex944, depth944 := position, tokenIndex, depth
 							if buffer[position] != rune('e') {
-								goto l853
+								goto l945
 							}
 							position++
-							goto l852
-						l853:
-							position, tokenIndex, depth = position852, tokenIndex852, depth852
+							goto l944
+						l945:
+							position, tokenIndex, depth = position944, tokenIndex944, depth944
 							if buffer[position] != rune('E') {
-								goto l842
+								goto l934
 							}
 							position++
 						}
-					l852:
+					l944:
 						if !_rules[rulesp]() {
-							goto l842
+							goto l934
 						}
 						if !_rules[ruleExpression]() {
-							goto l842
+							goto l934
 						}
-						goto l843
-					l842:
-						position, tokenIndex, depth = position842, tokenIndex842, depth842
+						goto l935
+					l934:
+						position, tokenIndex, depth = position934, tokenIndex934, depth934
 					}
-				l843:
+				l935:
 					depth--
-					add(rulePegText, position841)
+					add(rulePegText, position933)
 				}
-				if !_rules[ruleAction36]() {
-					goto l839
+				if !_rules[ruleAction40]() {
+					goto l931
 				}
 				depth--
-				add(ruleFilter, position840)
+				add(ruleFilter, position932)
 			}
 			return true
-		l839:
-			position, tokenIndex, depth = position839, tokenIndex839, depth839
+		l931:
+			position, tokenIndex, depth = position931, tokenIndex931, depth931
 			return false
 		},
-		/* 49 Grouping <- <(<(sp (('g' / 'G') ('r' / 'R') ('o' / 'O') ('u' / 'U') ('p' / 'P')) sp (('b' / 'B') ('y' / 'Y')) sp GroupList)?> Action37)> */
+		/* 55 Grouping <- <(<(sp (('g' / 'G') ('r' / 'R') ('o' / 'O') ('u' / 'U') ('p' / 'P')) sp (('b' / 'B') ('y' / 'Y')) sp GroupList)?> Action41)> */
 		func() bool {
-			position854, tokenIndex854, depth854 := position, tokenIndex, depth
+			position946, tokenIndex946, depth946 := position, tokenIndex, depth
 			{
-				position855 := position
+				position947 := position
 				depth++
 				{
-					position856 := position
+					position948 := position
 					depth++
 					{
-						position857, tokenIndex857, depth857 := position, tokenIndex, depth
+						position949, tokenIndex949, depth949 := position, tokenIndex, depth
 						if !_rules[rulesp]() {
-							goto l857
+							goto l949
 						}
 						{
-							position859, tokenIndex859, depth859 := position, tokenIndex, depth
+							position951, tokenIndex951, depth951 := position, tokenIndex, depth
 							if buffer[position] != rune('g') {
-								goto l860
+								goto l952
 							}
 							position++
-							goto l859
-						l860:
-							position, tokenIndex, depth = position859, tokenIndex859, depth859
+							goto l951
+						l952:
+							position, tokenIndex, depth = position951, tokenIndex951, depth951
 							if buffer[position] != rune('G') {
-								goto l857
+								goto l949
 							}
 							position++
 						}
-					l859:
+					l951:
 						{
-							position861, tokenIndex861, depth861 := position, tokenIndex, depth
+							position953, tokenIndex953, depth953 := position, tokenIndex, depth
 							if buffer[position] != rune('r') {
-								goto l862
+								goto l954
 							}
 							position++
-							goto l861
-						l862:
-							position, tokenIndex, depth = position861, tokenIndex861, depth861
+							goto l953
+						l954:
+							position, tokenIndex, depth = position953, tokenIndex953, depth953
 							if buffer[position] != rune('R') {
-								goto l857
+								goto l949
 							}
 							position++
 						}
-					l861:
+					l953:
 						{
-							position863, tokenIndex863, depth863 := position, tokenIndex, depth
+							position955, tokenIndex955, depth955 := position, tokenIndex, depth
 							if buffer[position] != rune('o') {
-								goto l864
+								goto l956
 							}
 							position++
-							goto l863
-						l864:
-							position, tokenIndex, depth = position863, tokenIndex863, depth863
+							goto l955
+						l956:
+							position, tokenIndex, depth = position955, tokenIndex955, depth955
 							if buffer[position] != rune('O') {
-								goto l857
+								goto l949
 							}
 							position++
 						}
-					l863:
+					l955:
 						{
-							position865, tokenIndex865, depth865 := position, tokenIndex, depth
+							position957, tokenIndex957, depth957 := position, tokenIndex, depth
 							if buffer[position] != rune('u') {
-								goto l866
+								goto l958
 							}
 							position++
-							goto l865
-						l866:
-							position, tokenIndex, depth = position865, tokenIndex865, depth865
+							goto l957
+						l958:
+							position, tokenIndex, depth = position957, tokenIndex957, depth957
 							if buffer[position] != rune('U') {
-								goto l857
+								goto l949
 							}
 							position++
 						}
-					l865:
+					l957:
 						{
-							position867, tokenIndex867, depth867 := position, tokenIndex, depth
+							position959, tokenIndex959, depth959 := position, tokenIndex, depth
 							if buffer[position] != rune('p') {
-								goto l868
+								goto l960
 							}
 							position++
-							goto l867
-						l868:
-							position, tokenIndex, depth = position867, tokenIndex867, depth867
+							goto l959
+						l960:
+							position, tokenIndex, depth = position959, tokenIndex959, depth959
 							if buffer[position] != rune('P') {
-								goto l857
+								goto l949
 							}
 							position++
 						}
-					l867:
+					l959:
 						if !_rules[rulesp]() {
-							goto l857
+							goto l949
 						}
 						{
-							position869, tokenIndex869, depth869 := position, tokenIndex, depth
+							position961, tokenIndex961, depth961 := position, tokenIndex, depth
 							if buffer[position] != rune('b') {
-								goto l870
+								goto l962
 							}
 							position++
-							goto l869
-						l870:
-							position, tokenIndex, depth = position869, tokenIndex869, depth869
+							goto l961
+						l962:
+							position, tokenIndex, depth = position961, tokenIndex961, depth961
 							if buffer[position] != rune('B') {
-								goto l857
+								goto l949
 							}
 							position++
 						}
-					l869:
+					l961:
 						{
-							position871, tokenIndex871, depth871 := position, tokenIndex, depth
+							position963, tokenIndex963, depth963 := position, tokenIndex, depth
 							if buffer[position] != rune('y') {
-								goto l872
+								goto l964
 							}
 							position++
-							goto l871
-						l872:
-							position, tokenIndex, depth = position871, tokenIndex871, depth871
+							goto l963
+						l964:
+							position, tokenIndex, depth = position963, tokenIndex963, depth963
 							if buffer[position] != rune('Y') {
-								goto l857
+								goto l949
 							}
 							position++
 						}
-					l871:
+					l963:
 						if !_rules[rulesp]() {
-							goto l857
+							goto l949
 						}
 						if !_rules[ruleGroupList]() {
-							goto l857
+							goto l949
 						}
-						goto l858
-					l857:
-						position, tokenIndex, depth = position857, tokenIndex857, depth857
+						goto l950
+					l949:
+						position, tokenIndex, depth = position949, tokenIndex949, depth949
 					}
-				l858:
+				l950:
 					depth--
-					add(rulePegText, position856)
+					add(rulePegText, position948)
 				}
-				if !_rules[ruleAction37]() {
-					goto l854
+				if !_rules[ruleAction41]() {
+					goto l946
 				}
 				depth--
-				add(ruleGrouping, position855)
+				add(ruleGrouping, position947)
 			}
 			return true
-		l854:
-			position, tokenIndex, depth = position854, tokenIndex854, depth854
+		l946:
+			position, tokenIndex, depth = position946, tokenIndex946, depth946
 			return false
 		},
-		/* 50 GroupList <- <(Expression (spOpt ',' spOpt Expression)*)> */
+		/* 56 GroupList <- <(Expression (spOpt ',' spOpt Expression)*)> */
 		func() bool {
-			position873, tokenIndex873, depth873 := position, tokenIndex, depth
+			position965, tokenIndex965, depth965 := position, tokenIndex, depth
 			{
-				position874 := position
+				position966 := position
 				depth++
 				if !_rules[ruleExpression]() {
-					goto l873
+					goto l965
 				}
-			l875:
+			l967:
 				{
-					position876, tokenIndex876, depth876 := position, tokenIndex, depth
+					position968, tokenIndex968, depth968 := position, tokenIndex, depth
 					if !_rules[rulespOpt]() {
-						goto l876
+						goto l968
 					}
 					if buffer[position] != rune(',') {
-						goto l876
+						goto l968
 					}
 					position++
 					if !_rules[rulespOpt]() {
-						goto l876
+						goto l968
 					}
 					if !_rules[ruleExpression]() {
-						goto l876
+						goto l968
 					}
-					goto l875
-				l876:
-					position, tokenIndex, depth = position876, tokenIndex876, depth876
+					goto l967
+				l968:
+					position, tokenIndex, depth = position968, tokenIndex968, depth968
 				}
 				depth--
-				add(ruleGroupList, position874)
+				add(ruleGroupList, position966)
 			}
 			return true
-		l873:
-			position, tokenIndex, depth = position873, tokenIndex873, depth873
+		l965:
+			position, tokenIndex, depth = position965, tokenIndex965, depth965
 			return false
 		},
-		/* 51 Having <- <(<(sp (('h' / 'H') ('a' / 'A') ('v' / 'V') ('i' / 'I') ('n' / 'N') ('g' / 'G')) sp Expression)?> Action38)> */
+		/* 57 Having <- <(<(sp (('h' / 'H') ('a' / 'A') ('v' / 'V') ('i' / 'I') ('n' / 'N') ('g' / 'G')) sp Expression)?> Action42)> */
 		func() bool {
-			position877, tokenIndex877, depth877 := position, tokenIndex, depth
+			position969, tokenIndex969, depth969 := position, tokenIndex, depth
 			{
-				position878 := position
+				position970 := position
 				depth++
 				{
-					position879 := position
+					position971 := position
 					depth++
 					{
-						position880, tokenIndex880, depth880 := position, tokenIndex, depth
+						position972, tokenIndex972, depth972 := position, tokenIndex, depth
 						if !_rules[rulesp]() {
-							goto l880
+							goto l972
 						}
 						{
-							position882, tokenIndex882, depth882 := position, tokenIndex, depth
+							position974, tokenIndex974, depth974 := position, tokenIndex, depth
 							if buffer[position] != rune('h') {
-								goto l883
+								goto l975
 							}
 							position++
-							goto l882
-						l883:
-							position, tokenIndex, depth = position882, tokenIndex882, depth882
+							goto l974
+						l975:
+							position, tokenIndex, depth = position974, tokenIndex974, depth974
 							if buffer[position] != rune('H') {
-								goto l880
+								goto l972
 							}
 							position++
 						}
-					l882:
+					l974:
 						{
-							position884, tokenIndex884, depth884 := position, tokenIndex, depth
+							position976, tokenIndex976, depth976 := position, tokenIndex, depth
 							if buffer[position] != rune('a') {
-								goto l885
+								goto l977
 							}
 							position++
-							goto l884
-						l885:
-							position, tokenIndex, depth = position884, tokenIndex884, depth884
+							goto l976
+						l977:
+							position, tokenIndex, depth = position976, tokenIndex976, depth976
 							if buffer[position] != rune('A') {
-								goto l880
+								goto l972
 							}
 							position++
 						}
-					l884:
+					l976:
 						{
-							position886, tokenIndex886, depth886 := position, tokenIndex, depth
+							position978, tokenIndex978, depth978 := position, tokenIndex, depth
 							if buffer[position] != rune('v') {
-								goto l887
+								goto l979
 							}
 							position++
-							goto l886
-						l887:
-							position, tokenIndex, depth = position886, tokenIndex886, depth886
+							goto l978
+						l979:
+							position, tokenIndex, depth = position978, tokenIndex978, depth978
 							if buffer[position] != rune('V') {
-								goto l880
+								goto l972
 							}
 							position++
 						}
-					l886:
+					l978:
 						{
-							position888, tokenIndex888, depth888 := position, tokenIndex, depth
+							position980, tokenIndex980, depth980 := position, tokenIndex, depth
 							if buffer[position] != rune('i') {
-								goto l889
+								goto l981
 							}
 							position++
-							goto l888
-						l889:
-							position, tokenIndex, depth = position888, tokenIndex888, depth888
+							goto l980
+						l981:
+							position, tokenIndex, depth = position980, tokenIndex980, depth980
 							if buffer[position] != rune('I') {
-								goto l880
+								goto l972
 							}
 							position++
 						}
-					l888:
+					l980:
 						{
-							position890, tokenIndex890, depth890 := position, tokenIndex, depth
+							position982, tokenIndex982, depth982 := position, tokenIndex, depth
 							if buffer[position] != rune('n') {
-								goto l891
+								goto l983
 							}
 							position++
-							goto l890
-						l891:
-							position, tokenIndex, depth = position890, tokenIndex890, depth890
+							goto l982
+						l983:
+							position, tokenIndex, depth = position982, tokenIndex982, depth982
 							if buffer[position] != rune('N') {
-								goto l880
+								goto l972
 							}
 							position++
 						}
-					l890:
+					l982:
 						{
-							position892, tokenIndex892, depth892 := position, tokenIndex, depth
+							position984, tokenIndex984, depth984 := position, tokenIndex, depth
 							if buffer[position] != rune('g') {
-								goto l893
+								goto l985
 							}
 							position++
-							goto l892
-						l893:
-							position, tokenIndex, depth = position892, tokenIndex892, depth892
+							goto l984
+						l985:
+							position, tokenIndex, depth = position984, tokenIndex984, depth984
 							if buffer[position] != rune('G') {
-								goto l880
+								goto l972
 							}
 							position++
 						}
-					l892:
+					l984:
 						if !_rules[rulesp]() {
-							goto l880
+							goto l972
 						}
 						if !_rules[ruleExpression]() {
-							goto l880
+							goto l972
 						}
-						goto l881
-					l880:
-						position, tokenIndex, depth = position880, tokenIndex880, depth880
+						goto l973
+					l972:
+						position, tokenIndex, depth = position972, tokenIndex972, depth972
 					}
-				l881:
+				l973:
 					depth--
-					add(rulePegText, position879)
-				}
-				if !_rules[ruleAction38]() {
-					goto l877
+					add(rulePegText, position971)
 				}
-				depth--
-				add(ruleHaving, position878)
-			}
-			return true
-		l877:
-			position, tokenIndex, depth = position877, tokenIndex877, depth877
-			return false
-		},
-		/* 52 RelationLike <- <(AliasedStreamWindow / (StreamWindow Action39))> */
-		func() bool {
-			position894, tokenIndex894, depth894 := position, tokenIndex, depth
-			{
-				position895 := position
-				depth++
-				{
-					position896, tokenIndex896, depth896 := position, tokenIndex, depth
-					if !_rules[ruleAliasedStreamWindow]() {
-						goto l897
-					}
-					goto l896
-				l897:
-					position, tokenIndex, depth = position896, tokenIndex896, depth896
-					if !_rules[ruleStreamWindow]() {
-						goto l894
-					}
-					if !_rules[ruleAction39]() {
-						goto l894
-					}
+				if !_rules[ruleAction42]() {
+					goto l969
 				}
-			l896:
 				depth--
-				add(ruleRelationLike, position895)
+				add(ruleHaving, position970)
 			}
 			return true
-		l894:
-			position, tokenIndex, depth = position894, tokenIndex894, depth894
+		l969:
+			position, tokenIndex, depth = position969, tokenIndex969, depth969
 			return false
 		},
-		/* 53 AliasedStreamWindow <- <(StreamWindow sp (('a' / 'A') ('s' / 'S')) sp Identifier Action40)> */
+		/* 58 Ordering <- <(<(sp (('o' / 'O') ('r' / 'R') ('d' / 'D') ('e' / 'E') ('r' / 'R')) sp (('b' / 'B') ('y' / 'Y')) sp SortedExpression (spOpt ',' spOpt SortedExpression)*)?> Action43)> */
 		func() bool {
-			position898, tokenIndex898, depth898 := position, tokenIndex, depth
+			position986, tokenIndex986, depth986 := position, tokenIndex, depth
 			{
-				position899 := position
+				position987 := position
 				depth++
-				if !_rules[ruleStreamWindow]() {
-					goto l898
-				}
-				if !_rules[rulesp]() {
-					goto l898
-				}
 				{
-					position900, tokenIndex900, depth900 := position, tokenIndex, depth
-					if buffer[position] != rune('a') {
-						goto l901
-					}
-					position++
-					goto l900
-				l901:
-					position, tokenIndex, depth = position900, tokenIndex900, depth900
-					if buffer[position] != rune('A') {
-						goto l898
-					}
-					position++
-				}
-			l900:
-				{
-					position902, tokenIndex902, depth902 := position, tokenIndex, depth
-					if buffer[position] != rune('s') {
-						goto l903
-					}
-					position++
-					goto l902
-				l903:
-					position, tokenIndex, depth = position902, tokenIndex902, depth902
-					if buffer[position] != rune('S') {
-						goto l898
+					position988 := position
+					depth++
+					{
+						position989, tokenIndex989, depth989 := position, tokenIndex, depth
+						if !_rules[rulesp]() {
+							goto l989
+						}
+						{
+							position991, tokenIndex991, depth991 := position, tokenIndex, depth
+							if buffer[position] != rune('o') {
+								goto l992
+							}
+							position++
+							goto l991
+						l992:
+							position, tokenIndex, depth = position991, tokenIndex991, depth991
+							if buffer[position] != rune('O') {
+								goto l989
+							}
+							position++
+						}
+					l991:
+						{
+							position993, tokenIndex993, depth993 := position, tokenIndex, depth
+							if buffer[position] != rune('r') {
+								goto l994
+							}
+							position++
+							goto l993
+						l994:
+							position, tokenIndex, depth = position993, tokenIndex993, depth993
+							if buffer[position] != rune('R') {
+								goto l989
+							}
+							position++
+						}
+					l993:
+						{
+							position995, tokenIndex995, depth995 := position, tokenIndex, depth
+							if buffer[position] != rune('d') {
+								goto l996
+							}
+							position++
+							goto l995
+						l996:
+							position, tokenIndex, depth = position995, tokenIndex995, depth995
+							if buffer[position] != rune('D') {
+								goto l989
+							}
+							position++
+						}
+					l995:
+						{
+							position997, tokenIndex997, depth997 := position, tokenIndex, depth
+							if buffer[position] != rune('e') {
+								goto l998
+							}
+							position++
+							goto l997
+						l998:
+							position, tokenIndex, depth = position997, tokenIndex997, depth997
+							if buffer[position] != rune('E') {
+								goto l989
+							}
+							position++
+						}
+					l997:
+						{
+							position999, tokenIndex999, depth999 := position, tokenIndex, depth
+							if buffer[position] != rune('r') {
+								goto l1000
+							}
+							position++
+							goto l999
+						l1000:
+							position, tokenIndex, depth = position999, tokenIndex999, depth999
+							if buffer[position] != rune('R') {
+								goto l989
+							}
+							position++
+						}
+					l999:
+						if !_rules[rulesp]() {
+							goto l989
+						}
+						{
+							position1001, tokenIndex1001, depth1001 := position, tokenIndex, depth
+							if buffer[position] != rune('b') {
+								goto l1002
+							}
+							position++
+							goto l1001
+						l1002:
+							position, tokenIndex, depth = position1001, tokenIndex1001, depth1001
+							if buffer[position] != rune('B') {
+								goto l989
+							}
+							position++
+						}
+					l1001:
+						{
+							position1003, tokenIndex1003, depth1003 := position, tokenIndex, depth
+							if buffer[position] != rune('y') {
+								goto l1004
+							}
+							position++
+							goto l1003
+						l1004:
+							position, tokenIndex, depth = position1003, tokenIndex1003, depth1003
+							if buffer[position] != rune('Y') {
+								goto l989
+							}
+							position++
+						}
+					l1003:
+						if !_rules[rulesp]() {
+							goto l989
+						}
+						if !_rules[ruleSortedExpression]() {
+							goto l989
+						}
+					l1005:
+						{
+							position1006, tokenIndex1006, depth1006 := position, tokenIndex, depth
+							if !_rules[rulespOpt]() {
+								goto l1006
+							}
+							if buffer[position] != rune(',') {
+								goto l1006
+							}
+							position++
+							if !_rules[rulespOpt]() {
+								goto l1006
+							}
+							if !_rules[ruleSortedExpression]() {
+								goto l1006
+							}
+							goto l1005
+						l1006:
+							position, tokenIndex, depth = position1006, tokenIndex1006, depth1006
+						}
+						goto l990
+					l989:
+						position, tokenIndex, depth = position989, tokenIndex989, depth989
 					}
-					position++
-				}
-			l902:
-				if !_rules[rulesp]() {
-					goto l898
-				}
-				if !_rules[ruleIdentifier]() {
-					goto l898
+				l990:
+					depth--
+					add(rulePegText, position988)
 				}
-				if !_rules[ruleAction40]() {
-					goto l898
+				if !_rules[ruleAction43]() {
+					goto l986
 				}
 				depth--
-				add(ruleAliasedStreamWindow, position899)
+				add(ruleOrdering, position987)
 			}
 			return true
-		l898:
-			position, tokenIndex, depth = position898, tokenIndex898, depth898
+		l986:
+			position, tokenIndex, depth = position986, tokenIndex986, depth986
 			return false
 		},
-		/* 54 StreamWindow <- <(StreamLike spOpt '[' spOpt (('r' / 'R') ('a' / 'A') ('n' / 'N') ('g' / 'G') ('e' / 'E')) sp Interval CapacitySpecOpt SheddingSpecOpt spOpt ']' Action41)> */
+		/* 59 Limit <- <(<(sp (('l' / 'L') ('i' / 'I') ('m' / 'M') ('i' / 'I') ('t' / 'T')) sp NonNegativeNumericLiteral (sp (('o' / 'O') ('f' / 'F') ('f' / 'F') ('s' / 'S') ('e' / 'E') ('t' / 'T')) sp NonNegativeNumericLiteral)?)?> Action44)> */
 		func() bool {
-			position904, tokenIndex904, depth904 := position, tokenIndex, depth
+			position1007, tokenIndex1007, depth1007 := position, tokenIndex, depth
 			{
-				position905 := position
+				position1008 := position
 				depth++
-				if !_rules[ruleStreamLike]() {
-					goto l904
-				}
-				if !_rules[rulespOpt]() {
-					goto l904
-				}
-				if buffer[position] != rune('[') {
-					goto l904
-				}
-				position++
-				if !_rules[rulespOpt]() {
-					goto l904
-				}
 				{
-					position906, tokenIndex906, depth906 := position, tokenIndex, depth
-					if buffer[position] != rune('r') {
-						goto l907
-					}
-					position++
-					goto l906
-				l907:
-					position, tokenIndex, depth = position906, tokenIndex906, depth906
-					if buffer[position] != rune('R') {
-						goto l904
-					}
-					position++
-				}
-			l906:
-				{
-					position908, tokenIndex908, depth908 := position, tokenIndex, depth
-					if buffer[position] != rune('a') {
-						goto l909
-					}
-					position++
-					goto l908
-				l909:
-					position, tokenIndex, depth = position908, tokenIndex908, depth908
-					if buffer[position] != rune('A') {
-						goto l904
-					}
-					position++
-				}
-			l908:
-				{
-					position910, tokenIndex910, depth910 := position, tokenIndex, depth
-					if buffer[position] != rune('n') {
-						goto l911
-					}
-					position++
-					goto l910
-				l911:
-					position, tokenIndex, depth = position910, tokenIndex910, depth910
-					if buffer[position] != rune('N') {
-						goto l904
-					}
-					position++
-				}
-			l910:
-				{
-					position912, tokenIndex912, depth912 := position, tokenIndex, depth
-					if buffer[position] != rune('g') {
-						goto l913
-					}
-					position++
-					goto l912
-				l913:
-					position, tokenIndex, depth = position912, tokenIndex912, depth912
-					if buffer[position] != rune('G') {
-						goto l904
-					}
-					position++
-				}
-			l912:
-				{
-					position914, tokenIndex914, depth914 := position, tokenIndex, depth
-					if buffer[position] != rune('e') {
-						goto l915
-					}
-					position++
-					goto l914
-				l915:
-					position, tokenIndex, depth = position914, tokenIndex914, depth914
-					if buffer[position] != rune('E') {
-						goto l904
-					}
-					position++
-				}
-			l914:
-				if !_rules[rulesp]() {
-					goto l904
-				}
-				if !_rules[ruleInterval]() {
-					goto l904
-				}
-				if !_rules[ruleCapacitySpecOpt]() {
-					goto l904
-				}
-				if !_rules[ruleSheddingSpecOpt]() {
-					goto l904
-				}
-				if !_rules[rulespOpt]() {
-					goto l904
-				}
-				if buffer[position] != rune(']') {
-					goto l904
-				}
-				position++
-				if !_rules[ruleAction41]() {
-					goto l904
-				}
-				depth--
-				add(ruleStreamWindow, position905)
-			}
-			return true
-		l904:
-			position, tokenIndex, depth = position904, tokenIndex904, depth904
-			return false
-		},
-		/* 55 StreamLike <- <(UDSFFuncApp / Stream)> */
-		func() bool {
-			position916, tokenIndex916, depth916 := position, tokenIndex, depth
-			{
-				position917 := position
-				depth++
-				{
-					position918, tokenIndex918, depth918 := position, tokenIndex, depth
-					if !_rules[ruleUDSFFuncApp]() {
-						goto l919
-					}
-					goto l918
-				l919:
-					position, tokenIndex, depth = position918, tokenIndex918, depth918
-					if !_rules[ruleStream]() {
-						goto l916
-					}
-				}
-			l918:
-				depth--
-				add(ruleStreamLike, position917)
-			}
-			return true
-		l916:
-			position, tokenIndex, depth = position916, tokenIndex916, depth916
-			return false
-		},
-		/* 56 UDSFFuncApp <- <(FuncAppWithoutOrderBy Action42)> */
-		func() bool {
-			position920, tokenIndex920, depth920 := position, tokenIndex, depth
-			{
-				position921 := position
-				depth++
-				if !_rules[ruleFuncAppWithoutOrderBy]() {
-					goto l920
-				}
-				if !_rules[ruleAction42]() {
-					goto l920
-				}
-				depth--
-				add(ruleUDSFFuncApp, position921)
-			}
-			return true
-		l920:
-			position, tokenIndex, depth = position920, tokenIndex920, depth920
-			return false
-		},
-		/* 57 CapacitySpecOpt <- <(<(spOpt ',' spOpt (('b' / 'B') ('u' / 'U') ('f' / 'F') ('f' / 'F') ('e' / 'E') ('r' / 'R')) sp (('s' / 'S') ('i' / 'I') ('z' / 'Z') ('e' / 'E')) sp NonNegativeNumericLiteral)?> Action43)> */
-		func() bool {
-			position922, tokenIndex922, depth922 := position, tokenIndex, depth
-			{
-				position923 := position
-				depth++
-				{
-					position924 := position
+					position1009 := position
 					depth++
 					{
-						position925, tokenIndex925, depth925 := position, tokenIndex, depth
-						if !_rules[rulespOpt]() {
-							goto l925
-						}
-						if buffer[position] != rune(',') {
-							goto l925
-						}
-						position++
-						if !_rules[rulespOpt]() {
-							goto l925
-						}
-						{
-							position927, tokenIndex927, depth927 := position, tokenIndex, depth
-							if buffer[position] != rune('b') {
-								goto l928
-							}
-							position++
-							goto l927
-						l928:
-							position, tokenIndex, depth = position927, tokenIndex927, depth927
-							if buffer[position] != rune('B') {
-								goto l925
-							}
-							position++
+						position1010, tokenIndex1010, depth1010 := position, tokenIndex, depth
+						if !_rules[rulesp]() {
+							goto l1010
 						}
-					l927:
 						{
-							position929, tokenIndex929, depth929 := position, tokenIndex, depth
-							if buffer[position] != rune('u') {
-								goto l930
+							position1012, tokenIndex1012, depth1012 := position, tokenIndex, depth
+							if buffer[position] != rune('l') {
+								goto l1013
 							}
 							position++
-							goto l929
-						l930:
-							position, tokenIndex, depth = position929, tokenIndex929, depth929
-							if buffer[position] != rune('U') {
-								goto l925
+							goto l1012
+						l1013:
+							position, tokenIndex, depth = position1012, tokenIndex1012, depth1012
+							if buffer[position] != rune('L') {
+								goto l1010
 							}
 							position++
 						}
-					l929:
+					l1012:
 						{
-							position931, tokenIndex931, depth931 := position, tokenIndex, depth
-							if buffer[position] != rune('f') {
-								goto l932
+							position1014, tokenIndex1014, depth1014 := position, tokenIndex, depth
+							if buffer[position] != rune('i') {
+								goto l1015
 							}
 							position++
-							goto l931
-						l932:
-							position, tokenIndex, depth = position931, tokenIndex931, depth931
-							if buffer[position] != rune('F') {
-								goto l925
+							goto l1014
+						l1015:
+							position, tokenIndex, depth = position1014, tokenIndex1014, depth1014
+							if buffer[position] != rune('I') {
+								goto l1010
 							}
 							position++
 						}
-					l931:
+					l1014:
 						{
-							position933, tokenIndex933, depth933 := position, tokenIndex, depth
-							if buffer[position] != rune('f') {
-								goto l934
+							position1016, tokenIndex1016, depth1016 := position, tokenIndex, depth
+							if buffer[position] != rune('m') {
+								goto l1017
 							}
 							position++
-							goto l933
-						l934:
-							position, tokenIndex, depth = position933, tokenIndex933, depth933
-							if buffer[position] != rune('F') {
-								goto l925
+							goto l1016
+						l1017:
+							position, tokenIndex, depth = position1016, tokenIndex1016, depth1016
+							if buffer[position] != rune('M') {
+								goto l1010
 							}
 							position++
 						}
-					l933:
+					l1016:
 						{
-							position935, tokenIndex935, depth935 := position, tokenIndex, depth
-							if buffer[position] != rune('e') {
-								goto l936
+							position1018, tokenIndex1018, depth1018 := position, tokenIndex, depth
+							if buffer[position] != rune('i') {
+								goto l1019
 							}
 							position++
-							goto l935
-						l936:
-							position, tokenIndex, depth = position935, tokenIndex935, depth935
-							if buffer[position] != rune('E') {
-								goto l925
+							goto l1018
+						l1019:
+							position, tokenIndex, depth = position1018, tokenIndex1018, depth1018
+							if buffer[position] != rune('I') {
+								goto l1010
 							}
 							position++
 						}
-					l935:
+					l1018:
 						{
-							position937, tokenIndex937, depth937 := position, tokenIndex, depth
-							if buffer[position] != rune('r') {
-								goto l938
+							position1020, tokenIndex1020, depth1020 := position, tokenIndex, depth
+							if buffer[position] != rune('t') {
+								goto l1021
 							}
 							position++
-							goto l937
-						l938:
-							position, tokenIndex, depth = position937, tokenIndex937, depth937
-							if buffer[position] != rune('R') {
-								goto l925
+							goto l1020
+						l1021:
+							position, tokenIndex, depth = position1020, tokenIndex1020, depth1020
+							if buffer[position] != rune('T') {
+								goto l1010
 							}
 							position++
 						}
-					l937:
+					l1020:
 						if !_rules[rulesp]() {
-							goto l925
+							goto l1010
+						}
+						if !_rules[ruleNonNegativeNumericLiteral]() {
+							goto l1010
 						}
 						{
-							position939, tokenIndex939, depth939 := position, tokenIndex, depth
-							if buffer[position] != rune('s') {
-								goto l940
+							position1022, tokenIndex1022, depth1022 := position, tokenIndex, depth
+							if !_rules[rulesp]() {
+								goto l1022
 							}
-							position++
-							goto l939
-						l940:
-							position, tokenIndex, depth = position939, tokenIndex939, depth939
-							if buffer[position] != rune('S') {
-								goto l925
+							{
+								position1024, tokenIndex1024, depth1024 := position, tokenIndex, depth
+								if buffer[position] != rune('o') {
+									goto l1025
+								}
+								position++
+								goto l1024
+							l1025:
+								position, tokenIndex, depth = position1024, tokenIndex1024, depth1024
+								if buffer[position] != rune('O') {
+									goto l1022
+								}
+								position++
 							}
-							position++
-						}
-					l939:
-						{
-							position941, tokenIndex941, depth941 := position, tokenIndex, depth
-							if buffer[position] != rune('i') {
-								goto l942
+						l1024:
+							{
+								position1026, tokenIndex1026, depth1026 := position, tokenIndex, depth
+								if buffer[position] != rune('f') {
+									goto l1027
+								}
+								position++
+								goto l1026
+							l1027:
+								position, tokenIndex, depth = position1026, tokenIndex1026, depth1026
+								if buffer[position] != rune('F') {
+									goto l1022
+								}
+								position++
 							}
-							position++
-							goto l941
-						l942:
-							position, tokenIndex, depth = position941, tokenIndex941, depth941
-							if buffer[position] != rune('I') {
-								goto l925
+						l1026:
+							{
+								position1028, tokenIndex1028, depth1028 := position, tokenIndex, depth
+								if buffer[position] != rune('f') {
+									goto l1029
+								}
+								position++
+								goto l1028
+							l1029:
+								position, tokenIndex, depth = position1028, tokenIndex1028, depth1028
+								if buffer[position] != rune('F') {
+									goto l1022
+								}
+								position++
 							}
-							position++
-						}
-					l941:
-						{
-							position943, tokenIndex943, depth943 := position, tokenIndex, depth
-							if buffer[position] != rune('z') {
-								goto l944
+						l1028:
+							{
+								position1030, tokenIndex1030, depth1030 := position, tokenIndex, depth
+								if buffer[position] != rune('s') {
+									goto l1031
+								}
+								position++
+								goto l1030
+							l1031:
+								position, tokenIndex, depth = position1030, tokenIndex1030, depth1030
+								if buffer[position] != rune('S') {
+									goto l1022
+								}
+								position++
 							}
-							position++
-							goto l943
-						l944:
-							position, tokenIndex, depth = position943, tokenIndex943, depth943
-							if buffer[position] != rune('Z') {
-								goto l925
+						l1030:
+							{
+								position1032, tokenIndex1032, depth1032 := position, tokenIndex, depth
+								if buffer[position] != rune('e') {
+									goto l1033
+								}
+								position++
+								goto l1032
+							l1033:
+								position, tokenIndex, depth = position1032, tokenIndex1032, depth1032
+								if buffer[position] != rune('E') {
+									goto l1022
+								}
+								position++
 							}
-							position++
-						}
-					l943:
-						{
-							position945, tokenIndex945, depth945 := position, tokenIndex, depth
-							if buffer[position] != rune('e') {
-								goto l946
+						l1032:
+							{
+								position1034, tokenIndex1034, depth1034 := position, tokenIndex, depth
+								if buffer[position] != rune('t') {
+									goto l1035
+								}
+								position++
+								goto l1034
+							l1035:
+								position, tokenIndex, depth = position1034, tokenIndex1034, depth1034
+								if buffer[position] != rune('T') {
+									goto l1022
+								}
+								position++
 							}
-							position++
-							goto l945
-						l946:
-							position, tokenIndex, depth = position945, tokenIndex945, depth945
-							if buffer[position] != rune('E') {
-								goto l925
+						l1034:
+							if !_rules[rulesp]() {
+								goto l1022
 							}
-							position++
-						}
-					l945:
-						if !_rules[rulesp]() {
-							goto l925
-						}
-						if !_rules[ruleNonNegativeNumericLiteral]() {
-							goto l925
+							if !_rules[ruleNonNegativeNumericLiteral]() {
+								goto l1022
+							}
+							goto l1023
+						l1022:
+							position, tokenIndex, depth = position1022, tokenIndex1022, depth1022
 						}
-						goto l926
-					l925:
-						position, tokenIndex, depth = position925, tokenIndex925, depth925
+					l1023:
+						goto l1011
+					l1010:
+						position, tokenIndex, depth = position1010, tokenIndex1010, depth1010
 					}
-				l926:
+				l1011:
 					depth--
-					add(rulePegText, position924)
+					add(rulePegText, position1009)
 				}
-				if !_rules[ruleAction43]() {
-					goto l922
+				if !_rules[ruleAction44]() {
+					goto l1007
 				}
 				depth--
-				add(ruleCapacitySpecOpt, position923)
+				add(ruleLimit, position1008)
 			}
 			return true
-		l922:
-			position, tokenIndex, depth = position922, tokenIndex922, depth922
+		l1007:
+			position, tokenIndex, depth = position1007, tokenIndex1007, depth1007
 			return false
 		},
-		/* 58 SheddingSpecOpt <- <(<(spOpt ',' spOpt SheddingOption sp (('i' / 'I') ('f' / 'F')) sp (('f' / 'F') ('u' / 'U') ('l' / 'L') ('l' / 'L')))?> Action44)> */
+		/* 60 RelationLike <- <(AliasedStreamWindow / (!'(' StreamWindow Action45))> */
 		func() bool {
-			position947, tokenIndex947, depth947 := position, tokenIndex, depth
+			position1036, tokenIndex1036, depth1036 := position, tokenIndex, depth
 			{
-				position948 := position
+				position1037 := position
 				depth++
 				{
-					position949 := position
-					depth++
+					position1038, tokenIndex1038, depth1038 := position, tokenIndex, depth
+					if !_rules[ruleAliasedStreamWindow]() {
+						goto l1039
+					}
+					goto l1038
+				l1039:
+					position, tokenIndex, depth = position1038, tokenIndex1038, depth1038
 					{
-						position950, tokenIndex950, depth950 := position, tokenIndex, depth
-						if !_rules[rulespOpt]() {
-							goto l950
-						}
-						if buffer[position] != rune(',') {
-							goto l950
+						position1040, tokenIndex1040, depth1040 := position, tokenIndex, depth
+						if buffer[position] != rune('(') {
+							goto l1040
 						}
 						position++
-						if !_rules[rulespOpt]() {
-							goto l950
-						}
-						if !_rules[ruleSheddingOption]() {
-							goto l950
-						}
-						if !_rules[rulesp]() {
-							goto l950
-						}
-						{
-							position952, tokenIndex952, depth952 := position, tokenIndex, depth
-							if buffer[position] != rune('i') {
-								goto l953
-							}
-							position++
-							goto l952
-						l953:
-							position, tokenIndex, depth = position952, tokenIndex952, depth952
-							if buffer[position] != rune('I') {
-								goto l950
-							}
-							position++
-						}
-					l952:
-						{
-							position954, tokenIndex954, depth954 := position, tokenIndex, depth
-							if buffer[position] != rune('f') {
-								goto l955
-							}
-							position++
-							goto l954
-						l955:
-							position, tokenIndex, depth = position954, tokenIndex954, depth954
-							if buffer[position] != rune('F') {
-								goto l950
-							}
-							position++
-						}
-					l954:
-						if !_rules[rulesp]() {
-							goto l950
-						}
-						{
-							position956, tokenIndex956, depth956 := position, tokenIndex, depth
-							if buffer[position] != rune('f') {
-								goto l957
-							}
-							position++
-							goto l956
-						l957:
-							position, tokenIndex, depth = position956, tokenIndex956, depth956
-							if buffer[position] != rune('F') {
-								goto l950
-							}
-							position++
-						}
-					l956:
-						{
-							position958, tokenIndex958, depth958 := position, tokenIndex, depth
-							if buffer[position] != rune('u') {
-								goto l959
-							}
-							position++
-							goto l958
-						l959:
-							position, tokenIndex, depth = position958, tokenIndex958, depth958
-							if buffer[position] != rune('U') {
-								goto l950
-							}
-							position++
-						}
-					l958:
-						{
-							position960, tokenIndex960, depth960 := position, tokenIndex, depth
-							if buffer[position] != rune('l') {
-								goto l961
-							}
-							position++
-							goto l960
-						l961:
-							position, tokenIndex, depth = position960, tokenIndex960, depth960
-							if buffer[position] != rune('L') {
-								goto l950
-							}
-							position++
-						}
-					l960:
-						{
-							position962, tokenIndex962, depth962 := position, tokenIndex, depth
-							if buffer[position] != rune('l') {
-								goto l963
-							}
-							position++
-							goto l962
-						l963:
-							position, tokenIndex, depth = position962, tokenIndex962, depth962
-							if buffer[position] != rune('L') {
-								goto l950
-							}
-							position++
-						}
-					l962:
-						goto l951
-					l950:
-						position, tokenIndex, depth = position950, tokenIndex950, depth950
+						goto l1036
+					l1040:
+						position, tokenIndex, depth = position1040, tokenIndex1040, depth1040
+					}
+					if !_rules[ruleStreamWindow]() {
+						goto l1036
+					}
+					if !_rules[ruleAction45]() {
+						goto l1036
 					}
-				l951:
-					depth--
-					add(rulePegText, position949)
-				}
-				if !_rules[ruleAction44]() {
-					goto l947
 				}
+			l1038:
 				depth--
-				add(ruleSheddingSpecOpt, position948)
+				add(ruleRelationLike, position1037)
 			}
 			return true
-		l947:
-			position, tokenIndex, depth = position947, tokenIndex947, depth947
+		l1036:
+			position, tokenIndex, depth = position1036, tokenIndex1036, depth1036
 			return false
 		},
-		/* 59 SheddingOption <- <(Wait / DropOldest / DropNewest)> */
+		/* 61 AliasedStreamWindow <- <(StreamWindow sp (('a' / 'A') ('s' / 'S')) sp Identifier Action46)> */
 		func() bool {
-			position964, tokenIndex964, depth964 := position, tokenIndex, depth
+			position1041, tokenIndex1041, depth1041 := position, tokenIndex, depth
 			{
-				position965 := position
+				position1042 := position
 				depth++
+				if !_rules[ruleStreamWindow]() {
+					goto l1041
+				}
+				if !_rules[rulesp]() {
+					goto l1041
+				}
 				{
-					position966, tokenIndex966, depth966 := position, tokenIndex, depth
-					if !_rules[ruleWait]() {
-						goto l967
+					position1043, tokenIndex1043, depth1043 := position, tokenIndex, depth
+					if buffer[position] != rune('a') {
+						goto l1044
 					}
-					goto l966
-				l967:
-					position, tokenIndex, depth = position966, tokenIndex966, depth966
-					if !_rules[ruleDropOldest]() {
-						goto l968
+					position++
+					goto l1043
+				l1044:
+					position, tokenIndex, depth = position1043, tokenIndex1043, depth1043
+					if buffer[position] != rune('A') {
+						goto l1041
 					}
-					goto l966
-				l968:
-					position, tokenIndex, depth = position966, tokenIndex966, depth966
-					if !_rules[ruleDropNewest]() {
-						goto l964
+					position++
+				}
+			l1043:
+				{
+					position1045, tokenIndex1045, depth1045 := position, tokenIndex, depth
+					if buffer[position] != rune('s') {
+						goto l1046
+					}
+					position++
+					goto l1045
+				l1046:
+					position, tokenIndex, depth = position1045, tokenIndex1045, depth1045
+					if buffer[position] != rune('S') {
+						goto l1041
 					}
+					position++
+				}
+			l1045:
+				if !_rules[rulesp]() {
+					goto l1041
+				}
+				if !_rules[ruleIdentifier]() {
+					goto l1041
+				}
+				if !_rules[ruleAction46]() {
+					goto l1041
 				}
-			l966:
 				depth--
-				add(ruleSheddingOption, position965)
+				add(ruleAliasedStreamWindow, position1042)
 			}
 			return true
-		l964:
-			position, tokenIndex, depth = position964, tokenIndex964, depth964
+		l1041:
+			position, tokenIndex, depth = position1041, tokenIndex1041, depth1041
 			return false
 		},
-		/* 60 SourceSinkSpecs <- <(<(sp (('w' / 'W') ('i' / 'I') ('t' / 'T') ('h' / 'H')) sp SourceSinkParam (spOpt ',' spOpt SourceSinkParam)*)?> Action45)> */
+		/* 62 StreamWindow <- <(StreamLike spOpt '[' spOpt (RangeWindowSpec / SessionWindowSpec) WatermarkSpecOpt CapacitySpecOpt SheddingSpecOpt spOpt ']' Action47)> */
 		func() bool {
-			position969, tokenIndex969, depth969 := position, tokenIndex, depth
+			position1047, tokenIndex1047, depth1047 := position, tokenIndex, depth
 			{
-				position970 := position
+				position1048 := position
 				depth++
+				if !_rules[ruleStreamLike]() {
+					goto l1047
+				}
+				if !_rules[rulespOpt]() {
+					goto l1047
+				}
+				if buffer[position] != rune('[') {
+					goto l1047
+				}
+				position++
+				if !_rules[rulespOpt]() {
+					goto l1047
+				}
 				{
-					position971 := position
-					depth++
-					{
-						position972, tokenIndex972, depth972 := position, tokenIndex, depth
-						if !_rules[rulesp]() {
-							goto l972
-						}
-						{
-							position974, tokenIndex974, depth974 := position, tokenIndex, depth
-							if buffer[position] != rune('w') {
-								goto l975
-							}
-							position++
-							goto l974
-						l975:
-							position, tokenIndex, depth = position974, tokenIndex974, depth974
-							if buffer[position] != rune('W') {
-								goto l972
-							}
-							position++
-						}
-					l974:
-						{
-							position976, tokenIndex976, depth976 := position, tokenIndex, depth
-							if buffer[position] != rune('i') {
-								goto l977
-							}
-							position++
-							goto l976
-						l977:
-							position, tokenIndex, depth = position976, tokenIndex976, depth976
-							if buffer[position] != rune('I') {
-								goto l972
-							}
-							position++
-						}
-					l976:
-						{
-							position978, tokenIndex978, depth978 := position, tokenIndex, depth
-							if buffer[position] != rune('t') {
-								goto l979
-							}
-							position++
-							goto l978
-						l979:
-							position, tokenIndex, depth = position978, tokenIndex978, depth978
-							if buffer[position] != rune('T') {
-								goto l972
-							}
-							position++
-						}
-					l978:
-						{
-							position980, tokenIndex980, depth980 := position, tokenIndex, depth
-							if buffer[position] != rune('h') {
-								goto l981
-							}
-							position++
-							goto l980
-						l981:
-							position, tokenIndex, depth = position980, tokenIndex980, depth980
-							if buffer[position] != rune('H') {
-								goto l972
-							}
-							position++
-						}
-					l980:
-						if !_rules[rulesp]() {
-							goto l972
-						}
-						if !_rules[ruleSourceSinkParam]() {
-							goto l972
-						}
-					l982:
-						{
-							position983, tokenIndex983, depth983 := position, tokenIndex, depth
-							if !_rules[rulespOpt]() {
-								goto l983
-							}
-							if buffer[position] != rune(',') {
-								goto l983
-							}
-							position++
-							if !_rules[rulespOpt]() {
-								goto l983
-							}
-							if !_rules[ruleSourceSinkParam]() {
-								goto l983
-							}
-							goto l982
-						l983:
-							position, tokenIndex, depth = position983, tokenIndex983, depth983
-						}
-						goto l973
-					l972:
-						position, tokenIndex, depth = position972, tokenIndex972, depth972
+					position1049, tokenIndex1049, depth1049 := position, tokenIndex, depth
+					if !_rules[ruleRangeWindowSpec]() {
+						goto l1050
+					}
+					goto l1049
+				l1050:
+					position, tokenIndex, depth = position1049, tokenIndex1049, depth1049
+					if !_rules[ruleSessionWindowSpec]() {
+						goto l1047
 					}
-				l973:
-					depth--
-					add(rulePegText, position971)
 				}
-				if !_rules[ruleAction45]() {
-					goto l969
+			l1049:
+				if !_rules[ruleWatermarkSpecOpt]() {
+					goto l1047
+				}
+				if !_rules[ruleCapacitySpecOpt]() {
+					goto l1047
+				}
+				if !_rules[ruleSheddingSpecOpt]() {
+					goto l1047
+				}
+				if !_rules[rulespOpt]() {
+					goto l1047
+				}
+				if buffer[position] != rune(']') {
+					goto l1047
+				}
+				position++
+				if !_rules[ruleAction47]() {
+					goto l1047
 				}
 				depth--
-				add(ruleSourceSinkSpecs, position970)
+				add(ruleStreamWindow, position1048)
 			}
 			return true
-		l969:
-			position, tokenIndex, depth = position969, tokenIndex969, depth969
+		l1047:
+			position, tokenIndex, depth = position1047, tokenIndex1047, depth1047
 			return false
 		},
-		/* 61 UpdateSourceSinkSpecs <- <(<(sp (('s' / 'S') ('e' / 'E') ('t' / 'T')) sp SourceSinkParam (spOpt ',' spOpt SourceSinkParam)*)> Action46)> */
+		/* 63 RangeWindowSpec <- <(('r' / 'R') ('a' / 'A') ('n' / 'N') ('g' / 'G') ('e' / 'E') sp Interval SlideSpecOpt Action48)> */
 		func() bool {
-			position984, tokenIndex984, depth984 := position, tokenIndex, depth
+			position1051, tokenIndex1051, depth1051 := position, tokenIndex, depth
 			{
-				position985 := position
+				position1052 := position
 				depth++
 				{
-					position986 := position
-					depth++
-					if !_rules[rulesp]() {
-						goto l984
+					position1053, tokenIndex1053, depth1053 := position, tokenIndex, depth
+					if buffer[position] != rune('r') {
+						goto l1054
 					}
-					{
-						position987, tokenIndex987, depth987 := position, tokenIndex, depth
-						if buffer[position] != rune('s') {
-							goto l988
-						}
-						position++
-						goto l987
-					l988:
-						position, tokenIndex, depth = position987, tokenIndex987, depth987
-						if buffer[position] != rune('S') {
-							goto l984
-						}
-						position++
+					position++
+					goto l1053
+				l1054:
+					position, tokenIndex, depth = position1053, tokenIndex1053, depth1053
+					if buffer[position] != rune('R') {
+						goto l1051
 					}
-				l987:
-					{
-						position989, tokenIndex989, depth989 := position, tokenIndex, depth
-						if buffer[position] != rune('e') {
-							goto l990
-						}
-						position++
-						goto l989
-					l990:
-						position, tokenIndex, depth = position989, tokenIndex989, depth989
-						if buffer[position] != rune('E') {
-							goto l984
-						}
-						position++
+					position++
+				}
+			l1053:
+				{
+					position1055, tokenIndex1055, depth1055 := position, tokenIndex, depth
+					if buffer[position] != rune('a') {
+						goto l1056
 					}
-				l989:
-					{
-						position991, tokenIndex991, depth991 := position, tokenIndex, depth
-						if buffer[position] != rune('t') {
-							goto l992
-						}
-						position++
-						goto l991
-					l992:
-						position, tokenIndex, depth = position991, tokenIndex991, depth991
-						if buffer[position] != rune('T') {
-							goto l984
-						}
-						position++
+					position++
+					goto l1055
+				l1056:
+					position, tokenIndex, depth = position1055, tokenIndex1055, depth1055
+					if buffer[position] != rune('A') {
+						goto l1051
 					}
-				l991:
-					if !_rules[rulesp]() {
-						goto l984
+					position++
+				}
+			l1055:
+				{
+					position1057, tokenIndex1057, depth1057 := position, tokenIndex, depth
+					if buffer[position] != rune('n') {
+						goto l1058
 					}
-					if !_rules[ruleSourceSinkParam]() {
-						goto l984
+					position++
+					goto l1057
+				l1058:
+					position, tokenIndex, depth = position1057, tokenIndex1057, depth1057
+					if buffer[position] != rune('N') {
+						goto l1051
 					}
-				l993:
-					{
-						position994, tokenIndex994, depth994 := position, tokenIndex, depth
-						if !_rules[rulespOpt]() {
-							goto l994
-						}
-						if buffer[position] != rune(',') {
-							goto l994
-						}
-						position++
-						if !_rules[rulespOpt]() {
-							goto l994
-						}
-						if !_rules[ruleSourceSinkParam]() {
-							goto l994
-						}
-						goto l993
-					l994:
-						position, tokenIndex, depth = position994, tokenIndex994, depth994
+					position++
+				}
+			l1057:
+				{
+					position1059, tokenIndex1059, depth1059 := position, tokenIndex, depth
+					if buffer[position] != rune('g') {
+						goto l1060
 					}
-					depth--
-					add(rulePegText, position986)
+					position++
+					goto l1059
+				l1060:
+					position, tokenIndex, depth = position1059, tokenIndex1059, depth1059
+					if buffer[position] != rune('G') {
+						goto l1051
+					}
+					position++
 				}
-				if !_rules[ruleAction46]() {
-					goto l984
+			l1059:
+				{
+					position1061, tokenIndex1061, depth1061 := position, tokenIndex, depth
+					if buffer[position] != rune('e') {
+						goto l1062
+					}
+					position++
+					goto l1061
+				l1062:
+					position, tokenIndex, depth = position1061, tokenIndex1061, depth1061
+					if buffer[position] != rune('E') {
+						goto l1051
+					}
+					position++
+				}
+			l1061:
+				if !_rules[rulesp]() {
+					goto l1051
+				}
+				if !_rules[ruleInterval]() {
+					goto l1051
+				}
+				if !_rules[ruleSlideSpecOpt]() {
+					goto l1051
+				}
+				if !_rules[ruleAction48]() {
+					goto l1051
 				}
 				depth--
-				add(ruleUpdateSourceSinkSpecs, position985)
+				add(ruleRangeWindowSpec, position1052)
 			}
 			return true
-		l984:
-			position, tokenIndex, depth = position984, tokenIndex984, depth984
+		l1051:
+			position, tokenIndex, depth = position1051, tokenIndex1051, depth1051
 			return false
 		},
-		/* 62 SetOptSpecs <- <(<(sp (('s' / 'S') ('e' / 'E') ('t' / 'T')) sp SourceSinkParam (spOpt ',' spOpt SourceSinkParam)*)?> Action47)> */
+		/* 64 SessionWindowSpec <- <(('s' / 'S') ('e' / 'E') ('s' / 'S') ('s' / 'S') ('i' / 'I') ('o' / 'O') ('n' / 'N') sp (('g' / 'G') ('a' / 'A') ('p' / 'P')) sp TimeInterval Action49)> */
 		func() bool {
-			position995, tokenIndex995, depth995 := position, tokenIndex, depth
+			position1063, tokenIndex1063, depth1063 := position, tokenIndex, depth
 			{
-				position996 := position
+				position1064 := position
 				depth++
 				{
-					position997 := position
-					depth++
-					{
-						position998, tokenIndex998, depth998 := position, tokenIndex, depth
-						if !_rules[rulesp]() {
-							goto l998
-						}
-						{
-							position1000, tokenIndex1000, depth1000 := position, tokenIndex, depth
-							if buffer[position] != rune('s') {
-								goto l1001
-							}
-							position++
-							goto l1000
-						l1001:
-							position, tokenIndex, depth = position1000, tokenIndex1000, depth1000
-							if buffer[position] != rune('S') {
-								goto l998
-							}
-							position++
-						}
-					l1000:
-						{
-							position1002, tokenIndex1002, depth1002 := position, tokenIndex, depth
-							if buffer[position] != rune('e') {
-								goto l1003
-							}
-							position++
-							goto l1002
-						l1003:
-							position, tokenIndex, depth = position1002, tokenIndex1002, depth1002
-							if buffer[position] != rune('E') {
-								goto l998
-							}
-							position++
-						}
-					l1002:
-						{
-							position1004, tokenIndex1004, depth1004 := position, tokenIndex, depth
-							if buffer[position] != rune('t') {
-								goto l1005
-							}
-							position++
-							goto l1004
-						l1005:
-							position, tokenIndex, depth = position1004, tokenIndex1004, depth1004
-							if buffer[position] != rune('T') {
-								goto l998
-							}
-							position++
-						}
-					l1004:
-						if !_rules[rulesp]() {
-							goto l998
-						}
-						if !_rules[ruleSourceSinkParam]() {
-							goto l998
-						}
-					l1006:
-						{
-							position1007, tokenIndex1007, depth1007 := position, tokenIndex, depth
-							if !_rules[rulespOpt]() {
-								goto l1007
-							}
-							if buffer[position] != rune(',') {
-								goto l1007
-							}
-							position++
-							if !_rules[rulespOpt]() {
-								goto l1007
-							}
-							if !_rules[ruleSourceSinkParam]() {
-								goto l1007
-							}
-							goto l1006
-						l1007:
-							position, tokenIndex, depth = position1007, tokenIndex1007, depth1007
-						}
-						goto l999
-					l998:
-						position, tokenIndex, depth = position998, tokenIndex998, depth998
+					position1065, tokenIndex1065, depth1065 := position, tokenIndex, depth
+					if buffer[position] != rune('s') {
+						goto l1066
 					}
-				l999:
-					depth--
-					add(rulePegText, position997)
-				}
-				if !_rules[ruleAction47]() {
-					goto l995
+					position++
+					goto l1065
+				l1066:
+					position, tokenIndex, depth = position1065, tokenIndex1065, depth1065
+					if buffer[position] != rune('S') {
+						goto l1063
+					}
+					position++
 				}
-				depth--
-				add(ruleSetOptSpecs, position996)
-			}
-			return true
-		l995:
-			position, tokenIndex, depth = position995, tokenIndex995, depth995
-			return false
-		},
-		/* 63 StateTagOpt <- <(<(sp (('t' / 'T') ('a' / 'A') ('g' / 'G')) sp Identifier)?> Action48)> */
-		func() bool {
-			position1008, tokenIndex1008, depth1008 := position, tokenIndex, depth
-			{
-				position1009 := position
-				depth++
+			l1065:
 				{
-					position1010 := position
-					depth++
-					{
-						position1011, tokenIndex1011, depth1011 := position, tokenIndex, depth
-						if !_rules[rulesp]() {
-							goto l1011
-						}
-						{
-							position1013, tokenIndex1013, depth1013 := position, tokenIndex, depth
-							if buffer[position] != rune('t') {
-								goto l1014
-							}
-							position++
-							goto l1013
-						l1014:
-							position, tokenIndex, depth = position1013, tokenIndex1013, depth1013
-							if buffer[position] != rune('T') {
-								goto l1011
-							}
-							position++
-						}
-					l1013:
-						{
-							position1015, tokenIndex1015, depth1015 := position, tokenIndex, depth
-							if buffer[position] != rune('a') {
-								goto l1016
-							}
-							position++
-							goto l1015
-						l1016:
-							position, tokenIndex, depth = position1015, tokenIndex1015, depth1015
-							if buffer[position] != rune('A') {
-								goto l1011
-							}
-							position++
-						}
-					l1015:
-						{
-							position1017, tokenIndex1017, depth1017 := position, tokenIndex, depth
-							if buffer[position] != rune('g') {
-								goto l1018
-							}
-							position++
-							goto l1017
-						l1018:
-							position, tokenIndex, depth = position1017, tokenIndex1017, depth1017
-							if buffer[position] != rune('G') {
-								goto l1011
-							}
-							position++
-						}
-					l1017:
-						if !_rules[rulesp]() {
-							goto l1011
-						}
-						if !_rules[ruleIdentifier]() {
-							goto l1011
-						}
-						goto l1012
-					l1011:
-						position, tokenIndex, depth = position1011, tokenIndex1011, depth1011
+					position1067, tokenIndex1067, depth1067 := position, tokenIndex, depth
+					if buffer[position] != rune('e') {
+						goto l1068
 					}
-				l1012:
-					depth--
-					add(rulePegText, position1010)
+					position++
+					goto l1067
+				l1068:
+					position, tokenIndex, depth = position1067, tokenIndex1067, depth1067
+					if buffer[position] != rune('E') {
+						goto l1063
+					}
+					position++
 				}
-				if !_rules[ruleAction48]() {
-					goto l1008
+			l1067:
+				{
+					position1069, tokenIndex1069, depth1069 := position, tokenIndex, depth
+					if buffer[position] != rune('s') {
+						goto l1070
+					}
+					position++
+					goto l1069
+				l1070:
+					position, tokenIndex, depth = position1069, tokenIndex1069, depth1069
+					if buffer[position] != rune('S') {
+						goto l1063
+					}
+					position++
 				}
-				depth--
-				add(ruleStateTagOpt, position1009)
-			}
-			return true
-		l1008:
-			position, tokenIndex, depth = position1008, tokenIndex1008, depth1008
-			return false
-		},
-		/* 64 SourceSinkParam <- <(SourceSinkParamKey spOpt '=' spOpt SourceSinkParamVal Action49)> */
-		func() bool {
-			position1019, tokenIndex1019, depth1019 := position, tokenIndex, depth
-			{
-				position1020 := position
-				depth++
-				if !_rules[ruleSourceSinkParamKey]() {
-					goto l1019
+			l1069:
+				{
+					position1071, tokenIndex1071, depth1071 := position, tokenIndex, depth
+					if buffer[position] != rune('s') {
+						goto l1072
+					}
+					position++
+					goto l1071
+				l1072:
+					position, tokenIndex, depth = position1071, tokenIndex1071, depth1071
+					if buffer[position] != rune('S') {
+						goto l1063
+					}
+					position++
 				}
-				if !_rules[rulespOpt]() {
-					goto l1019
+			l1071:
+				{
+					position1073, tokenIndex1073, depth1073 := position, tokenIndex, depth
+					if buffer[position] != rune('i') {
+						goto l1074
+					}
+					position++
+					goto l1073
+				l1074:
+					position, tokenIndex, depth = position1073, tokenIndex1073, depth1073
+					if buffer[position] != rune('I') {
+						goto l1063
+					}
+					position++
 				}
-				if buffer[position] != rune('=') {
-					goto l1019
+			l1073:
+				{
+					position1075, tokenIndex1075, depth1075 := position, tokenIndex, depth
+					if buffer[position] != rune('o') {
+						goto l1076
+					}
+					position++
+					goto l1075
+				l1076:
+					position, tokenIndex, depth = position1075, tokenIndex1075, depth1075
+					if buffer[position] != rune('O') {
+						goto l1063
+					}
+					position++
 				}
-				position++
-				if !_rules[rulespOpt]() {
-					goto l1019
+			l1075:
+				{
+					position1077, tokenIndex1077, depth1077 := position, tokenIndex, depth
+					if buffer[position] != rune('n') {
+						goto l1078
+					}
+					position++
+					goto l1077
+				l1078:
+					position, tokenIndex, depth = position1077, tokenIndex1077, depth1077
+					if buffer[position] != rune('N') {
+						goto l1063
+					}
+					position++
 				}
-				if !_rules[ruleSourceSinkParamVal]() {
-					goto l1019
+			l1077:
+				if !_rules[rulesp]() {
+					goto l1063
 				}
-				if !_rules[ruleAction49]() {
-					goto l1019
+				{
+					position1079, tokenIndex1079, depth1079 := position, tokenIndex, depth
+					if buffer[position] != rune('g') {
+						goto l1080
+					}
+					position++
+					goto l1079
+				l1080:
+					position, tokenIndex, depth = position1079, tokenIndex1079, depth1079
+					if buffer[position] != rune('G') {
+						goto l1063
+					}
+					position++
 				}
-				depth--
-				add(ruleSourceSinkParam, position1020)
-			}
-			return true
-		l1019:
-			position, tokenIndex, depth = position1019, tokenIndex1019, depth1019
-			return false
-		},
-		/* 65 SourceSinkParamVal <- <(ParamLiteral / ParamArrayExpr / ParamMapExpr)> */
-		func() bool {
-			position1021, tokenIndex1021, depth1021 := position, tokenIndex, depth
-			{
-				position1022 := position
-				depth++
+			l1079:
 				{
-					position1023, tokenIndex1023, depth1023 := position, tokenIndex, depth
-					if !_rules[ruleParamLiteral]() {
-						goto l1024
+					position1081, tokenIndex1081, depth1081 := position, tokenIndex, depth
+					if buffer[position] != rune('a') {
+						goto l1082
 					}
-					goto l1023
-				l1024:
-					position, tokenIndex, depth = position1023, tokenIndex1023, depth1023
-					if !_rules[ruleParamArrayExpr]() {
-						goto l1025
+					position++
+					goto l1081
+				l1082:
+					position, tokenIndex, depth = position1081, tokenIndex1081, depth1081
+					if buffer[position] != rune('A') {
+						goto l1063
 					}
-					goto l1023
-				l1025:
-					position, tokenIndex, depth = position1023, tokenIndex1023, depth1023
-					if !_rules[ruleParamMapExpr]() {
-						goto l1021
+					position++
+				}
+			l1081:
+				{
+					position1083, tokenIndex1083, depth1083 := position, tokenIndex, depth
+					if buffer[position] != rune('p') {
+						goto l1084
+					}
+					position++
+					goto l1083
+				l1084:
+					position, tokenIndex, depth = position1083, tokenIndex1083, depth1083
+					if buffer[position] != rune('P') {
+						goto l1063
 					}
+					position++
+				}
+			l1083:
+				if !_rules[rulesp]() {
+					goto l1063
+				}
+				if !_rules[ruleTimeInterval]() {
+					goto l1063
+				}
+				if !_rules[ruleAction49]() {
+					goto l1063
 				}
-			l1023:
 				depth--
-				add(ruleSourceSinkParamVal, position1022)
+				add(ruleSessionWindowSpec, position1064)
 			}
 			return true
-		l1021:
-			position, tokenIndex, depth = position1021, tokenIndex1021, depth1021
+		l1063:
+			position, tokenIndex, depth = position1063, tokenIndex1063, depth1063
 			return false
 		},
-		/* 66 ParamLiteral <- <(BooleanLiteral / Literal)> */
+		/* 65 StreamLike <- <(SubqueryStream / UDSFFuncApp / Stream)> */
 		func() bool {
-			position1026, tokenIndex1026, depth1026 := position, tokenIndex, depth
+			position1085, tokenIndex1085, depth1085 := position, tokenIndex, depth
 			{
-				position1027 := position
+				position1086 := position
 				depth++
 				{
-					position1028, tokenIndex1028, depth1028 := position, tokenIndex, depth
-					if !_rules[ruleBooleanLiteral]() {
-						goto l1029
+					position1087, tokenIndex1087, depth1087 := position, tokenIndex, depth
+					if !_rules[ruleSubqueryStream]() {
+						goto l1088
 					}
-					goto l1028
-				l1029:
-					position, tokenIndex, depth = position1028, tokenIndex1028, depth1028
-					if !_rules[ruleLiteral]() {
-						goto l1026
+					goto l1087
+				l1088:
+					position, tokenIndex, depth = position1087, tokenIndex1087, depth1087
+					if !_rules[ruleUDSFFuncApp]() {
+						goto l1089
+					}
+					goto l1087
+				l1089:
+					position, tokenIndex, depth = position1087, tokenIndex1087, depth1087
+					if !_rules[ruleStream]() {
+						goto l1085
 					}
 				}
-			l1028:
+			l1087:
 				depth--
-				add(ruleParamLiteral, position1027)
+				add(ruleStreamLike, position1086)
 			}
 			return true
-		l1026:
-			position, tokenIndex, depth = position1026, tokenIndex1026, depth1026
+		l1085:
+			position, tokenIndex, depth = position1085, tokenIndex1085, depth1085
 			return false
 		},
-		/* 67 ParamArrayExpr <- <(<('[' spOpt (ParamLiteral (',' spOpt ParamLiteral)*)? spOpt ','? spOpt ']')> Action50)> */
+		/* 66 SubqueryStream <- <(<('(' spOpt (WithSelectStmt / SelectStmt) spOpt ')')> Action50)> */
 		func() bool {
-			position1030, tokenIndex1030, depth1030 := position, tokenIndex, depth
+			position1090, tokenIndex1090, depth1090 := position, tokenIndex, depth
 			{
-				position1031 := position
+				position1091 := position
 				depth++
 				{
-					position1032 := position
+					position1092 := position
 					depth++
-					if buffer[position] != rune('[') {
-						goto l1030
+					if buffer[position] != rune('(') {
+						goto l1090
 					}
 					position++
 					if !_rules[rulespOpt]() {
-						goto l1030
+						goto l1090
 					}
 					{
-						position1033, tokenIndex1033, depth1033 := position, tokenIndex, depth
-						if !_rules[ruleParamLiteral]() {
-							goto l1033
+						position1093, tokenIndex1093, depth1093 := position, tokenIndex, depth
+						if !_rules[ruleWithSelectStmt]() {
+							goto l1094
 						}
-					l1035:
-						{
-							position1036, tokenIndex1036, depth1036 := position, tokenIndex, depth
-							if buffer[position] != rune(',') {
-								goto l1036
-							}
-							position++
-							if !_rules[rulespOpt]() {
-								goto l1036
-							}
-							if !_rules[ruleParamLiteral]() {
-								goto l1036
-							}
-							goto l1035
-						l1036:
-							position, tokenIndex, depth = position1036, tokenIndex1036, depth1036
-						}
-						goto l1034
-					l1033:
-						position, tokenIndex, depth = position1033, tokenIndex1033, depth1033
-					}
-				l1034:
-					if !_rules[rulespOpt]() {
-						goto l1030
-					}
-					{
-						position1037, tokenIndex1037, depth1037 := position, tokenIndex, depth
-						if buffer[position] != rune(',') {
-							goto l1037
+						goto l1093
+					l1094:
+						position, tokenIndex, depth = position1093, tokenIndex1093, depth1093
+						if !_rules[ruleSelectStmt]() {
+							goto l1090
 						}
-						position++
-						goto l1038
-					l1037:
-						position, tokenIndex, depth = position1037, tokenIndex1037, depth1037
 					}
-				l1038:
+				l1093:
 					if !_rules[rulespOpt]() {
-						goto l1030
+						goto l1090
 					}
-					if buffer[position] != rune(']') {
-						goto l1030
+					if buffer[position] != rune(')') {
+						goto l1090
 					}
 					position++
 					depth--
-					add(rulePegText, position1032)
+					add(rulePegText, position1092)
 				}
 				if !_rules[ruleAction50]() {
-					goto l1030
+					goto l1090
 				}
 				depth--
-				add(ruleParamArrayExpr, position1031)
+				add(ruleSubqueryStream, position1091)
 			}
 			return true
-		l1030:
-			position, tokenIndex, depth = position1030, tokenIndex1030, depth1030
+		l1090:
+			position, tokenIndex, depth = position1090, tokenIndex1090, depth1090
 			return false
 		},
-		/* 68 ParamMapExpr <- <(<('{' spOpt (ParamKeyValuePair (spOpt ',' spOpt ParamKeyValuePair)*)? spOpt '}')> Action51)> */
+		/* 67 UDSFFuncApp <- <(FuncAppWithoutOrderBy Action51)> */
 		func() bool {
-			position1039, tokenIndex1039, depth1039 := position, tokenIndex, depth
+			position1095, tokenIndex1095, depth1095 := position, tokenIndex, depth
 			{
-				position1040 := position
+				position1096 := position
 				depth++
-				{
-					position1041 := position
-					depth++
-					if buffer[position] != rune('{') {
-						goto l1039
-					}
-					position++
-					if !_rules[rulespOpt]() {
-						goto l1039
-					}
-					{
-						position1042, tokenIndex1042, depth1042 := position, tokenIndex, depth
-						if !_rules[ruleParamKeyValuePair]() {
-							goto l1042
-						}
-					l1044:
-						{
-							position1045, tokenIndex1045, depth1045 := position, tokenIndex, depth
-							if !_rules[rulespOpt]() {
-								goto l1045
-							}
-							if buffer[position] != rune(',') {
-								goto l1045
-							}
-							position++
-							if !_rules[rulespOpt]() {
-								goto l1045
-							}
-							if !_rules[ruleParamKeyValuePair]() {
-								goto l1045
-							}
-							goto l1044
-						l1045:
-							position, tokenIndex, depth = position1045, tokenIndex1045, depth1045
-						}
-						goto l1043
-					l1042:
-						position, tokenIndex, depth = position1042, tokenIndex1042, depth1042
-					}
-				l1043:
-					if !_rules[rulespOpt]() {
-						goto l1039
-					}
-					if buffer[position] != rune('}') {
-						goto l1039
-					}
-					position++
-					depth--
-					add(rulePegText, position1041)
+				if !_rules[ruleFuncAppWithoutOrderBy]() {
+					goto l1095
 				}
 				if !_rules[ruleAction51]() {
-					goto l1039
+					goto l1095
 				}
 				depth--
-				add(ruleParamMapExpr, position1040)
+				add(ruleUDSFFuncApp, position1096)
 			}
 			return true
-		l1039:
-			position, tokenIndex, depth = position1039, tokenIndex1039, depth1039
+		l1095:
+			position, tokenIndex, depth = position1095, tokenIndex1095, depth1095
 			return false
 		},
-		/* 69 ParamKeyValuePair <- <(<(StringLiteral spOpt ':' spOpt ParamLiteral)> Action52)> */
+		/* 68 SlideSpecOpt <- <(<(sp (('s' / 'S') ('l' / 'L') ('i' / 'I') ('d' / 'D') ('e' / 'E')) sp Interval)?> Action52)> */
 		func() bool {
-			position1046, tokenIndex1046, depth1046 := position, tokenIndex, depth
+			position1097, tokenIndex1097, depth1097 := position, tokenIndex, depth
 			{
-				position1047 := position
+				position1098 := position
 				depth++
 				{
-					position1048 := position
-					depth++
-					if !_rules[ruleStringLiteral]() {
-						goto l1046
-					}
-					if !_rules[rulespOpt]() {
-						goto l1046
-					}
-					if buffer[position] != rune(':') {
-						goto l1046
-					}
-					position++
-					if !_rules[rulespOpt]() {
-						goto l1046
-					}
-					if !_rules[ruleParamLiteral]() {
-						goto l1046
-					}
-					depth--
-					add(rulePegText, position1048)
-				}
-				if !_rules[ruleAction52]() {
-					goto l1046
-				}
-				depth--
-				add(ruleParamKeyValuePair, position1047)
-			}
-			return true
-		l1046:
-			position, tokenIndex, depth = position1046, tokenIndex1046, depth1046
-			return false
-		},
-		/* 70 PausedOpt <- <(<(sp (Paused / Unpaused))?> Action53)> */
-		func() bool {
-			position1049, tokenIndex1049, depth1049 := position, tokenIndex, depth
-			{
-				position1050 := position
-				depth++
-				{
-					position1051 := position
+					position1099 := position
 					depth++
 					{
-						position1052, tokenIndex1052, depth1052 := position, tokenIndex, depth
+						position1100, tokenIndex1100, depth1100 := position, tokenIndex, depth
 						if !_rules[rulesp]() {
-							goto l1052
+							goto l1100
 						}
 						{
-							position1054, tokenIndex1054, depth1054 := position, tokenIndex, depth
-							if !_rules[rulePaused]() {
-								goto l1055
+							position1102, tokenIndex1102, depth1102 := position, tokenIndex, depth
+							if buffer[position] != rune('s') {
+								goto l1103
 							}
-							goto l1054
-						l1055:
-							position, tokenIndex, depth = position1054, tokenIndex1054, depth1054
-							if !_rules[ruleUnpaused]() {
-								goto l1052
+							position++
+							goto l1102
+						l1103:
+							position, tokenIndex, depth = position1102, tokenIndex1102, depth1102
+							if buffer[position] != rune('S') {
+								goto l1100
 							}
+							position++
 						}
-					l1054:
-						goto l1053
-					l1052:
-						position, tokenIndex, depth = position1052, tokenIndex1052, depth1052
-					}
-				l1053:
-					depth--
-					add(rulePegText, position1051)
-				}
-				if !_rules[ruleAction53]() {
-					goto l1049
-				}
-				depth--
-				add(rulePausedOpt, position1050)
-			}
-			return true
-		l1049:
-			position, tokenIndex, depth = position1049, tokenIndex1049, depth1049
-			return false
-		},
-		/* 71 ExpressionOrWildcard <- <(Wildcard / Expression)> */
-		func() bool {
-			position1056, tokenIndex1056, depth1056 := position, tokenIndex, depth
-			{
-				position1057 := position
-				depth++
-				{
-					position1058, tokenIndex1058, depth1058 := position, tokenIndex, depth
-					if !_rules[ruleWildcard]() {
-						goto l1059
-					}
-					goto l1058
-				l1059:
-					position, tokenIndex, depth = position1058, tokenIndex1058, depth1058
-					if !_rules[ruleExpression]() {
-						goto l1056
-					}
-				}
-			l1058:
-				depth--
-				add(ruleExpressionOrWildcard, position1057)
-			}
-			return true
-		l1056:
-			position, tokenIndex, depth = position1056, tokenIndex1056, depth1056
-			return false
-		},
-		/* 72 Expression <- <orExpr> */
-		func() bool {
-			position1060, tokenIndex1060, depth1060 := position, tokenIndex, depth
-			{
-				position1061 := position
-				depth++
-				if !_rules[ruleorExpr]() {
-					goto l1060
-				}
-				depth--
-				add(ruleExpression, position1061)
-			}
-			return true
-		l1060:
-			position, tokenIndex, depth = position1060, tokenIndex1060, depth1060
-			return false
-		},
-		/* 73 orExpr <- <(<(andExpr (sp Or sp andExpr)*)> Action54)> */
-		func() bool {
-			position1062, tokenIndex1062, depth1062 := position, tokenIndex, depth
-			{
-				position1063 := position
-				depth++
-				{
-					position1064 := position
-					depth++
-					if !_rules[ruleandExpr]() {
-						goto l1062
-					}
-				l1065:
-					{
-						position1066, tokenIndex1066, depth1066 := position, tokenIndex, depth
-						if !_rules[rulesp]() {
-							goto l1066
+					l1102:
+						{
+							position1104, tokenIndex1104, depth1104 := position, tokenIndex, depth
+							if buffer[position] != rune('l') {
+								goto l1105
+							}
+							position++
+							goto l1104
+						l1105:
+							position, tokenIndex, depth = position1104, tokenIndex1104, depth1104
+							if buffer[position] != rune('L') {
+								goto l1100
+							}
+							position++
 						}
-						if !_rules[ruleOr]() {
-							goto l1066
+					l1104:
+						{
+							position1106, tokenIndex1106, depth1106 := position, tokenIndex, depth
+							if buffer[position] != rune('i') {
+								goto l1107
+							}
+							position++
+							goto l1106
+						l1107:
+							position, tokenIndex, depth = position1106, tokenIndex1106, depth1106
+							if buffer[position] != rune('I') {
+								goto l1100
+							}
+							position++
+						}
+					l1106:
+						{
+							position1108, tokenIndex1108, depth1108 := position, tokenIndex, depth
+							if buffer[position] != rune('d') {
+								goto l1109
+							}
+							position++
+							goto l1108
+						l1109:
+							position, tokenIndex, depth = position1108, tokenIndex1108, depth1108
+							if buffer[position] != rune('D') {
+								goto l1100
+							}
+							position++
+						}
+					l1108:
+						{
+							position1110, tokenIndex1110, depth1110 := position, tokenIndex, depth
+							if buffer[position] != rune('e') {
+								goto l1111
+							}
+							position++
+							goto l1110
+						l1111:
+							position, tokenIndex, depth = position1110, tokenIndex1110, depth1110
+							if buffer[position] != rune('E') {
+								goto l1100
+							}
+							position++
 						}
+					l1110:
 						if !_rules[rulesp]() {
-							goto l1066
+							goto l1100
 						}
-						if !_rules[ruleandExpr]() {
-							goto l1066
+						if !_rules[ruleInterval]() {
+							goto l1100
 						}
-						goto l1065
-					l1066:
-						position, tokenIndex, depth = position1066, tokenIndex1066, depth1066
+						goto l1101
+					l1100:
+						position, tokenIndex, depth = position1100, tokenIndex1100, depth1100
 					}
+				l1101:
 					depth--
-					add(rulePegText, position1064)
+					add(rulePegText, position1099)
 				}
-				if !_rules[ruleAction54]() {
-					goto l1062
+				if !_rules[ruleAction52]() {
+					goto l1097
 				}
 				depth--
-				add(ruleorExpr, position1063)
+				add(ruleSlideSpecOpt, position1098)
 			}
 			return true
-		l1062:
-			position, tokenIndex, depth = position1062, tokenIndex1062, depth1062
+		l1097:
+			position, tokenIndex, depth = position1097, tokenIndex1097, depth1097
 			return false
 		},
-		/* 74 andExpr <- <(<(notExpr (sp And sp notExpr)*)> Action55)> */
+		/* 69 WatermarkSpecOpt <- <(<(spOpt ',' spOpt WatermarkSpec)?> Action53)> */
 		func() bool {
-			position1067, tokenIndex1067, depth1067 := position, tokenIndex, depth
+			position1112, tokenIndex1112, depth1112 := position, tokenIndex, depth
 			{
-				position1068 := position
+				position1113 := position
 				depth++
 				{
-					position1069 := position
+					position1114 := position
 					depth++
-					if !_rules[rulenotExpr]() {
-						goto l1067
-					}
-				l1070:
 					{
-						position1071, tokenIndex1071, depth1071 := position, tokenIndex, depth
-						if !_rules[rulesp]() {
-							goto l1071
+						position1115, tokenIndex1115, depth1115 := position, tokenIndex, depth
+						if !_rules[rulespOpt]() {
+							goto l1115
 						}
-						if !_rules[ruleAnd]() {
-							goto l1071
+						if buffer[position] != rune(',') {
+							goto l1115
 						}
-						if !_rules[rulesp]() {
-							goto l1071
+						position++
+						if !_rules[rulespOpt]() {
+							goto l1115
 						}
-						if !_rules[rulenotExpr]() {
-							goto l1071
+						if !_rules[ruleWatermarkSpec]() {
+							goto l1115
 						}
-						goto l1070
-					l1071:
-						position, tokenIndex, depth = position1071, tokenIndex1071, depth1071
+						goto l1116
+					l1115:
+						position, tokenIndex, depth = position1115, tokenIndex1115, depth1115
 					}
+				l1116:
 					depth--
-					add(rulePegText, position1069)
+					add(rulePegText, position1114)
 				}
-				if !_rules[ruleAction55]() {
-					goto l1067
+				if !_rules[ruleAction53]() {
+					goto l1112
 				}
 				depth--
-				add(ruleandExpr, position1068)
+				add(ruleWatermarkSpecOpt, position1113)
 			}
 			return true
-		l1067:
-			position, tokenIndex, depth = position1067, tokenIndex1067, depth1067
+		l1112:
+			position, tokenIndex, depth = position1112, tokenIndex1112, depth1112
 			return false
 		},
-		/* 75 notExpr <- <(<((Not sp)? comparisonExpr)> Action56)> */
+		/* 70 WatermarkSpec <- <(('w' / 'W') ('a' / 'A') ('t' / 'T') ('e' / 'E') ('r' / 'R') ('m' / 'M') ('a' / 'A') ('r' / 'R') ('k' / 'K') sp (('d' / 'D') ('e' / 'E') ('l' / 'L') ('a' / 'A') ('y' / 'Y')) sp TimeInterval AllowedLatenessSpecOpt LateTuplesSpecOpt Action54)> */
 		func() bool {
-			position1072, tokenIndex1072, depth1072 := position, tokenIndex, depth
+			position1117, tokenIndex1117, depth1117 := position, tokenIndex, depth
 			{
-				position1073 := position
+				position1118 := position
 				depth++
 				{
-					position1074 := position
-					depth++
-					{
-						position1075, tokenIndex1075, depth1075 := position, tokenIndex, depth
-						if !_rules[ruleNot]() {
-							goto l1075
-						}
-						if !_rules[rulesp]() {
-							goto l1075
-						}
-						goto l1076
-					l1075:
-						position, tokenIndex, depth = position1075, tokenIndex1075, depth1075
+					position1119, tokenIndex1119, depth1119 := position, tokenIndex, depth
+					if buffer[position] != rune('w') {
+						goto l1120
 					}
-				l1076:
-					if !_rules[rulecomparisonExpr]() {
-						goto l1072
+					position++
+					goto l1119
+				l1120:
+					position, tokenIndex, depth = position1119, tokenIndex1119, depth1119
+					if buffer[position] != rune('W') {
+						goto l1117
 					}
-					depth--
-					add(rulePegText, position1074)
-				}
-				if !_rules[ruleAction56]() {
-					goto l1072
+					position++
 				}
-				depth--
-				add(rulenotExpr, position1073)
-			}
-			return true
-		l1072:
-			position, tokenIndex, depth = position1072, tokenIndex1072, depth1072
-			return false
-		},
-		/* 76 comparisonExpr <- <(<(otherOpExpr (spOpt ComparisonOp spOpt otherOpExpr)?)> Action57)> */
-		func() bool {
-			position1077, tokenIndex1077, depth1077 := position, tokenIndex, depth
-			{
-				position1078 := position
-				depth++
+			l1119:
 				{
-					position1079 := position
-					depth++
-					if !_rules[ruleotherOpExpr]() {
-						goto l1077
+					position1121, tokenIndex1121, depth1121 := position, tokenIndex, depth
+					if buffer[position] != rune('a') {
+						goto l1122
 					}
-					{
-						position1080, tokenIndex1080, depth1080 := position, tokenIndex, depth
-						if !_rules[rulespOpt]() {
-							goto l1080
-						}
-						if !_rules[ruleComparisonOp]() {
-							goto l1080
-						}
-						if !_rules[rulespOpt]() {
-							goto l1080
-						}
-						if !_rules[ruleotherOpExpr]() {
-							goto l1080
-						}
-						goto l1081
-					l1080:
-						position, tokenIndex, depth = position1080, tokenIndex1080, depth1080
+					position++
+					goto l1121
+				l1122:
+					position, tokenIndex, depth = position1121, tokenIndex1121, depth1121
+					if buffer[position] != rune('A') {
+						goto l1117
 					}
-				l1081:
-					depth--
-					add(rulePegText, position1079)
-				}
-				if !_rules[ruleAction57]() {
-					goto l1077
+					position++
 				}
-				depth--
-				add(rulecomparisonExpr, position1078)
-			}
-			return true
-		l1077:
-			position, tokenIndex, depth = position1077, tokenIndex1077, depth1077
-			return false
-		},
-		/* 77 otherOpExpr <- <(<(isExpr (spOpt OtherOp spOpt isExpr)*)> Action58)> */
-		func() bool {
-			position1082, tokenIndex1082, depth1082 := position, tokenIndex, depth
-			{
-				position1083 := position
-				depth++
+			l1121:
 				{
-					position1084 := position
-					depth++
-					if !_rules[ruleisExpr]() {
-						goto l1082
+					position1123, tokenIndex1123, depth1123 := position, tokenIndex, depth
+					if buffer[position] != rune('t') {
+						goto l1124
 					}
-				l1085:
-					{
-						position1086, tokenIndex1086, depth1086 := position, tokenIndex, depth
-						if !_rules[rulespOpt]() {
-							goto l1086
-						}
-						if !_rules[ruleOtherOp]() {
-							goto l1086
-						}
-						if !_rules[rulespOpt]() {
-							goto l1086
-						}
-						if !_rules[ruleisExpr]() {
-							goto l1086
-						}
-						goto l1085
-					l1086:
-						position, tokenIndex, depth = position1086, tokenIndex1086, depth1086
+					position++
+					goto l1123
+				l1124:
+					position, tokenIndex, depth = position1123, tokenIndex1123, depth1123
+					if buffer[position] != rune('T') {
+						goto l1117
 					}
-					depth--
-					add(rulePegText, position1084)
-				}
-				if !_rules[ruleAction58]() {
-					goto l1082
+					position++
 				}
-				depth--
-				add(ruleotherOpExpr, position1083)
-			}
-			return true
-		l1082:
-			position, tokenIndex, depth = position1082, tokenIndex1082, depth1082
-			return false
-		},
-		/* 78 isExpr <- <(<((RowValue sp IsOp sp Missing) / (termExpr (sp IsOp sp NullLiteral)?))> Action59)> */
-		func() bool {
-			position1087, tokenIndex1087, depth1087 := position, tokenIndex, depth
-			{
-				position1088 := position
-				depth++
+			l1123:
 				{
-					position1089 := position
-					depth++
-					{
-						position1090, tokenIndex1090, depth1090 := position, tokenIndex, depth
-						if !_rules[ruleRowValue]() {
-							goto l1091
-						}
-						if !_rules[rulesp]() {
-							goto l1091
-						}
-						if !_rules[ruleIsOp]() {
-							goto l1091
-						}
-						if !_rules[rulesp]() {
-							goto l1091
-						}
-						if !_rules[ruleMissing]() {
-							goto l1091
-						}
-						goto l1090
-					l1091:
-						position, tokenIndex, depth = position1090, tokenIndex1090, depth1090
-						if !_rules[ruletermExpr]() {
-							goto l1087
-						}
-						{
-							position1092, tokenIndex1092, depth1092 := position, tokenIndex, depth
-							if !_rules[rulesp]() {
-								goto l1092
-							}
-							if !_rules[ruleIsOp]() {
-								goto l1092
-							}
-							if !_rules[rulesp]() {
-								goto l1092
-							}
-							if !_rules[ruleNullLiteral]() {
-								goto l1092
-							}
-							goto l1093
-						l1092:
-							position, tokenIndex, depth = position1092, tokenIndex1092, depth1092
-						}
-					l1093:
+					position1125, tokenIndex1125, depth1125 := position, tokenIndex, depth
+					if buffer[position] != rune('e') {
+						goto l1126
 					}
-				l1090:
-					depth--
-					add(rulePegText, position1089)
-				}
-				if !_rules[ruleAction59]() {
-					goto l1087
+					position++
+					goto l1125
+				l1126:
+					position, tokenIndex, depth = position1125, tokenIndex1125, depth1125
+					if buffer[position] != rune('E') {
+						goto l1117
+					}
+					position++
 				}
-				depth--
-				add(ruleisExpr, position1088)
-			}
-			return true
-		l1087:
-			position, tokenIndex, depth = position1087, tokenIndex1087, depth1087
-			return false
-		},
-		/* 79 termExpr <- <(<(productExpr (spOpt PlusMinusOp spOpt productExpr)*)> Action60)> */
-		func() bool {
-			position1094, tokenIndex1094, depth1094 := position, tokenIndex, depth
-			{
-				position1095 := position
-				depth++
+			l1125:
 				{
-					position1096 := position
-					depth++
-					if !_rules[ruleproductExpr]() {
-						goto l1094
+					position1127, tokenIndex1127, depth1127 := position, tokenIndex, depth
+					if buffer[position] != rune('r') {
+						goto l1128
 					}
-				l1097:
-					{
-						position1098, tokenIndex1098, depth1098 := position, tokenIndex, depth
-						if !_rules[rulespOpt]() {
-							goto l1098
-						}
-						if !_rules[rulePlusMinusOp]() {
-							goto l1098
-						}
-						if !_rules[rulespOpt]() {
-							goto l1098
-						}
-						if !_rules[ruleproductExpr]() {
-							goto l1098
-						}
-						goto l1097
-					l1098:
-						position, tokenIndex, depth = position1098, tokenIndex1098, depth1098
+					position++
+					goto l1127
+				l1128:
+					position, tokenIndex, depth = position1127, tokenIndex1127, depth1127
+					if buffer[position] != rune('R') {
+						goto l1117
 					}
-					depth--
-					add(rulePegText, position1096)
-				}
-				if !_rules[ruleAction60]() {
-					goto l1094
+					position++
 				}
-				depth--
-				add(ruletermExpr, position1095)
-			}
-			return true
-		l1094:
-			position, tokenIndex, depth = position1094, tokenIndex1094, depth1094
-			return false
-		},
-		/* 80 productExpr <- <(<(minusExpr (spOpt MultDivOp spOpt minusExpr)*)> Action61)> */
-		func() bool {
-			position1099, tokenIndex1099, depth1099 := position, tokenIndex, depth
-			{
-				position1100 := position
-				depth++
+			l1127:
 				{
-					position1101 := position
-					depth++
-					if !_rules[ruleminusExpr]() {
-						goto l1099
+					position1129, tokenIndex1129, depth1129 := position, tokenIndex, depth
+					if buffer[position] != rune('m') {
+						goto l1130
 					}
-				l1102:
-					{
-						position1103, tokenIndex1103, depth1103 := position, tokenIndex, depth
-						if !_rules[rulespOpt]() {
-							goto l1103
-						}
-						if !_rules[ruleMultDivOp]() {
-							goto l1103
-						}
-						if !_rules[rulespOpt]() {
-							goto l1103
-						}
-						if !_rules[ruleminusExpr]() {
-							goto l1103
-						}
-						goto l1102
-					l1103:
-						position, tokenIndex, depth = position1103, tokenIndex1103, depth1103
+					position++
+					goto l1129
+				l1130:
+					position, tokenIndex, depth = position1129, tokenIndex1129, depth1129
+					if buffer[position] != rune('M') {
+						goto l1117
 					}
-					depth--
-					add(rulePegText, position1101)
-				}
-				if !_rules[ruleAction61]() {
-					goto l1099
+					position++
 				}
-				depth--
-				add(ruleproductExpr, position1100)
-			}
-			return true
-		l1099:
-			position, tokenIndex, depth = position1099, tokenIndex1099, depth1099
-			return false
-		},
-		/* 81 minusExpr <- <(<((UnaryMinus spOpt)? castExpr)> Action62)> */
-		func() bool {
-			position1104, tokenIndex1104, depth1104 := position, tokenIndex, depth
-			{
-				position1105 := position
-				depth++
+			l1129:
 				{
-					position1106 := position
-					depth++
-					{
-						position1107, tokenIndex1107, depth1107 := position, tokenIndex, depth
-						if !_rules[ruleUnaryMinus]() {
-							goto l1107
-						}
-						if !_rules[rulespOpt]() {
-							goto l1107
-						}
-						goto l1108
-					l1107:
-						position, tokenIndex, depth = position1107, tokenIndex1107, depth1107
+					position1131, tokenIndex1131, depth1131 := position, tokenIndex, depth
+					if buffer[position] != rune('a') {
+						goto l1132
 					}
-				l1108:
-					if !_rules[rulecastExpr]() {
-						goto l1104
+					position++
+					goto l1131
+				l1132:
+					position, tokenIndex, depth = position1131, tokenIndex1131, depth1131
+					if buffer[position] != rune('A') {
+						goto l1117
 					}
-					depth--
-					add(rulePegText, position1106)
-				}
-				if !_rules[ruleAction62]() {
-					goto l1104
+					position++
 				}
-				depth--
-				add(ruleminusExpr, position1105)
-			}
-			return true
-		l1104:
-			position, tokenIndex, depth = position1104, tokenIndex1104, depth1104
-			return false
-		},
-		/* 82 castExpr <- <(<(baseExpr (spOpt (':' ':') spOpt Type)?)> Action63)> */
-		func() bool {
-			position1109, tokenIndex1109, depth1109 := position, tokenIndex, depth
-			{
-				position1110 := position
-				depth++
+			l1131:
 				{
-					position1111 := position
-					depth++
-					if !_rules[rulebaseExpr]() {
-						goto l1109
+					position1133, tokenIndex1133, depth1133 := position, tokenIndex, depth
+					if buffer[position] != rune('r') {
+						goto l1134
 					}
-					{
-						position1112, tokenIndex1112, depth1112 := position, tokenIndex, depth
-						if !_rules[rulespOpt]() {
-							goto l1112
-						}
-						if buffer[position] != rune(':') {
-							goto l1112
-						}
-						position++
-						if buffer[position] != rune(':') {
-							goto l1112
-						}
-						position++
-						if !_rules[rulespOpt]() {
-							goto l1112
-						}
-						if !_rules[ruleType]() {
-							goto l1112
-						}
-						goto l1113
-					l1112:
-						position, tokenIndex, depth = position1112, tokenIndex1112, depth1112
+					position++
+					goto l1133
+				l1134:
+					position, tokenIndex, depth = position1133, tokenIndex1133, depth1133
+					if buffer[position] != rune('R') {
+						goto l1117
 					}
-				l1113:
-					depth--
-					add(rulePegText, position1111)
-				}
-				if !_rules[ruleAction63]() {
-					goto l1109
+					position++
 				}
-				depth--
-				add(rulecastExpr, position1110)
-			}
-			return true
-		l1109:
-			position, tokenIndex, depth = position1109, tokenIndex1109, depth1109
-			return false
-		},
-		/* 83 baseExpr <- <(('(' spOpt Expression spOpt ')') / MapExpr / BooleanLiteral / NullLiteral / Case / RowMeta / FuncTypeCast / FuncApp / RowValue / ArrayExpr / Literal)> */
-		func() bool {
-			position1114, tokenIndex1114, depth1114 := position, tokenIndex, depth
-			{
-				position1115 := position
-				depth++
+			l1133:
 				{
-					position1116, tokenIndex1116, depth1116 := position, tokenIndex, depth
-					if buffer[position] != rune('(') {
-						goto l1117
+					position1135, tokenIndex1135, depth1135 := position, tokenIndex, depth
+					if buffer[position] != rune('k') {
+						goto l1136
 					}
 					position++
-					if !_rules[rulespOpt]() {
-						goto l1117
-					}
-					if !_rules[ruleExpression]() {
+					goto l1135
+				l1136:
+					position, tokenIndex, depth = position1135, tokenIndex1135, depth1135
+					if buffer[position] != rune('K') {
 						goto l1117
 					}
-					if !_rules[rulespOpt]() {
-						goto l1117
+					position++
+				}
+			l1135:
+				if !_rules[rulesp]() {
+					goto l1117
+				}
+				{
+					position1137, tokenIndex1137, depth1137 := position, tokenIndex, depth
+					if buffer[position] != rune('d') {
+						goto l1138
 					}
-					if buffer[position] != rune(')') {
+					position++
+					goto l1137
+				l1138:
+					position, tokenIndex, depth = position1137, tokenIndex1137, depth1137
+					if buffer[position] != rune('D') {
 						goto l1117
 					}
 					position++
-					goto l1116
-				l1117:
-					position, tokenIndex, depth = position1116, tokenIndex1116, depth1116
-					if !_rules[ruleMapExpr]() {
-						goto l1118
-					}
-					goto l1116
-				l1118:
-					position, tokenIndex, depth = position1116, tokenIndex1116, depth1116
-					if !_rules[ruleBooleanLiteral]() {
-						goto l1119
-					}
-					goto l1116
-				l1119:
-					position, tokenIndex, depth = position1116, tokenIndex1116, depth1116
-					if !_rules[ruleNullLiteral]() {
-						goto l1120
+				}
+			l1137:
+				{
+					position1139, tokenIndex1139, depth1139 := position, tokenIndex, depth
+					if buffer[position] != rune('e') {
+						goto l1140
 					}
-					goto l1116
-				l1120:
-					position, tokenIndex, depth = position1116, tokenIndex1116, depth1116
-					if !_rules[ruleCase]() {
-						goto l1121
+					position++
+					goto l1139
+				l1140:
+					position, tokenIndex, depth = position1139, tokenIndex1139, depth1139
+					if buffer[position] != rune('E') {
+						goto l1117
 					}
-					goto l1116
-				l1121:
-					position, tokenIndex, depth = position1116, tokenIndex1116, depth1116
-					if !_rules[ruleRowMeta]() {
-						goto l1122
+					position++
+				}
+			l1139:
+				{
+					position1141, tokenIndex1141, depth1141 := position, tokenIndex, depth
+					if buffer[position] != rune('l') {
+						goto l1142
 					}
-					goto l1116
-				l1122:
-					position, tokenIndex, depth = position1116, tokenIndex1116, depth1116
-					if !_rules[ruleFuncTypeCast]() {
-						goto l1123
+					position++
+					goto l1141
+				l1142:
+					position, tokenIndex, depth = position1141, tokenIndex1141, depth1141
+					if buffer[position] != rune('L') {
+						goto l1117
 					}
-					goto l1116
-				l1123:
-					position, tokenIndex, depth = position1116, tokenIndex1116, depth1116
-					if !_rules[ruleFuncApp]() {
-						goto l1124
+					position++
+				}
+			l1141:
+				{
+					position1143, tokenIndex1143, depth1143 := position, tokenIndex, depth
+					if buffer[position] != rune('a') {
+						goto l1144
 					}
-					goto l1116
-				l1124:
-					position, tokenIndex, depth = position1116, tokenIndex1116, depth1116
-					if !_rules[ruleRowValue]() {
-						goto l1125
+					position++
+					goto l1143
+				l1144:
+					position, tokenIndex, depth = position1143, tokenIndex1143, depth1143
+					if buffer[position] != rune('A') {
+						goto l1117
 					}
-					goto l1116
-				l1125:
-					position, tokenIndex, depth = position1116, tokenIndex1116, depth1116
-					if !_rules[ruleArrayExpr]() {
-						goto l1126
+					position++
+				}
+			l1143:
+				{
+					position1145, tokenIndex1145, depth1145 := position, tokenIndex, depth
+					if buffer[position] != rune('y') {
+						goto l1146
 					}
-					goto l1116
-				l1126:
-					position, tokenIndex, depth = position1116, tokenIndex1116, depth1116
-					if !_rules[ruleLiteral]() {
-						goto l1114
+					position++
+					goto l1145
+				l1146:
+					position, tokenIndex, depth = position1145, tokenIndex1145, depth1145
+					if buffer[position] != rune('Y') {
+						goto l1117
 					}
+					position++
+				}
+			l1145:
+				if !_rules[rulesp]() {
+					goto l1117
+				}
+				if !_rules[ruleTimeInterval]() {
+					goto l1117
+				}
+				if !_rules[ruleAllowedLatenessSpecOpt]() {
+					goto l1117
+				}
+				if !_rules[ruleLateTuplesSpecOpt]() {
+					goto l1117
+				}
+				if !_rules[ruleAction54]() {
+					goto l1117
 				}
-			l1116:
 				depth--
-				add(rulebaseExpr, position1115)
+				add(ruleWatermarkSpec, position1118)
 			}
 			return true
-		l1114:
-			position, tokenIndex, depth = position1114, tokenIndex1114, depth1114
+		l1117:
+			position, tokenIndex, depth = position1117, tokenIndex1117, depth1117
 			return false
 		},
-		/* 84 FuncTypeCast <- <(<(('c' / 'C') ('a' / 'A') ('s' / 'S') ('t' / 'T') spOpt '(' spOpt Expression sp (('a' / 'A') ('s' / 'S')) sp Type spOpt ')')> Action64)> */
+		/* 71 AllowedLatenessSpecOpt <- <(<(spOpt ',' spOpt (('a' / 'A') ('l' / 'L') ('l' / 'L') ('o' / 'O') ('w' / 'W') ('e' / 'E') ('d' / 'D')) sp (('l' / 'L') ('a' / 'A') ('t' / 'T') ('e' / 'E') ('n' / 'N') ('e' / 'E') ('s' / 'S') ('s' / 'S')) sp TimeInterval)?> Action55)> */
 		func() bool {
-			position1127, tokenIndex1127, depth1127 := position, tokenIndex, depth
+			position1147, tokenIndex1147, depth1147 := position, tokenIndex, depth
 			{
-				position1128 := position
+				position1148 := position
 				depth++
 				{
-					position1129 := position
+					position1149 := position
 					depth++
 					{
-						position1130, tokenIndex1130, depth1130 := position, tokenIndex, depth
-						if buffer[position] != rune('c') {
-							goto l1131
-						}
-						position++
-						goto l1130
-					l1131:
-						position, tokenIndex, depth = position1130, tokenIndex1130, depth1130
-						if buffer[position] != rune('C') {
-							goto l1127
+						position1150, tokenIndex1150, depth1150 := position, tokenIndex, depth
+						if !_rules[rulespOpt]() {
+							goto l1150
 						}
-						position++
-					}
-				l1130:
-					{
-						position1132, tokenIndex1132, depth1132 := position, tokenIndex, depth
-						if buffer[position] != rune('a') {
-							goto l1133
+						if buffer[position] != rune(',') {
+							goto l1150
 						}
 						position++
-						goto l1132
-					l1133:
-						position, tokenIndex, depth = position1132, tokenIndex1132, depth1132
-						if buffer[position] != rune('A') {
-							goto l1127
+						if !_rules[rulespOpt]() {
+							goto l1150
 						}
-						position++
-					}
-				l1132:
-					{
-						position1134, tokenIndex1134, depth1134 := position, tokenIndex, depth
-						if buffer[position] != rune('s') {
-							goto l1135
+						{
+							position1152, tokenIndex1152, depth1152 := position, tokenIndex, depth
+							if buffer[position] != rune('a') {
+								goto l1153
+							}
+							position++
+							goto l1152
+						l1153:
+							position, tokenIndex, depth = position1152, tokenIndex1152, depth1152
+							if buffer[position] != rune('A') {
+								goto l1150
+							}
+							position++
 						}
-						position++
-						goto l1134
-					l1135:
-						position, tokenIndex, depth = position1134, tokenIndex1134, depth1134
-						if buffer[position] != rune('S') {
-							goto l1127
+					l1152:
+						{
+							position1154, tokenIndex1154, depth1154 := position, tokenIndex, depth
+							if buffer[position] != rune('l') {
+								goto l1155
+							}
+							position++
+							goto l1154
+						l1155:
+							position, tokenIndex, depth = position1154, tokenIndex1154, depth1154
+							if buffer[position] != rune('L') {
+								goto l1150
+							}
+							position++
 						}
-						position++
-					}
-				l1134:
-					{
-						position1136, tokenIndex1136, depth1136 := position, tokenIndex, depth
-						if buffer[position] != rune('t') {
-							goto l1137
+					l1154:
+						{
+							position1156, tokenIndex1156, depth1156 := position, tokenIndex, depth
+							if buffer[position] != rune('l') {
+								goto l1157
+							}
+							position++
+							goto l1156
+						l1157:
+							position, tokenIndex, depth = position1156, tokenIndex1156, depth1156
+							if buffer[position] != rune('L') {
+								goto l1150
+							}
+							position++
 						}
-						position++
-						goto l1136
-					l1137:
-						position, tokenIndex, depth = position1136, tokenIndex1136, depth1136
-						if buffer[position] != rune('T') {
-							goto l1127
+					l1156:
+						{
+							position1158, tokenIndex1158, depth1158 := position, tokenIndex, depth
+							if buffer[position] != rune('o') {
+								goto l1159
+							}
+							position++
+							goto l1158
+						l1159:
+							position, tokenIndex, depth = position1158, tokenIndex1158, depth1158
+							if buffer[position] != rune('O') {
+								goto l1150
+							}
+							position++
+						}
+					l1158:
+						{
+							position1160, tokenIndex1160, depth1160 := position, tokenIndex, depth
+							if buffer[position] != rune('w') {
+								goto l1161
+							}
+							position++
+							goto l1160
+						l1161:
+							position, tokenIndex, depth = position1160, tokenIndex1160, depth1160
+							if buffer[position] != rune('W') {
+								goto l1150
+							}
+							position++
+						}
+					l1160:
+						{
+							position1162, tokenIndex1162, depth1162 := position, tokenIndex, depth
+							if buffer[position] != rune('e') {
+								goto l1163
+							}
+							position++
+							goto l1162
+						l1163:
+							position, tokenIndex, depth = position1162, tokenIndex1162, depth1162
+							if buffer[position] != rune('E') {
+								goto l1150
+							}
+							position++
+						}
+					l1162:
+						{
+							position1164, tokenIndex1164, depth1164 := position, tokenIndex, depth
+							if buffer[position] != rune('d') {
+								goto l1165
+							}
+							position++
+							goto l1164
+						l1165:
+							position, tokenIndex, depth = position1164, tokenIndex1164, depth1164
+							if buffer[position] != rune('D') {
+								goto l1150
+							}
+							position++
+						}
+					l1164:
+						if !_rules[rulesp]() {
+							goto l1150
+						}
+						{
+							position1166, tokenIndex1166, depth1166 := position, tokenIndex, depth
+							if buffer[position] != rune('l') {
+								goto l1167
+							}
+							position++
+							goto l1166
+						l1167:
+							position, tokenIndex, depth = position1166, tokenIndex1166, depth1166
+							if buffer[position] != rune('L') {
+								goto l1150
+							}
+							position++
+						}
+					l1166:
+						{
+							position1168, tokenIndex1168, depth1168 := position, tokenIndex, depth
+							if buffer[position] != rune('a') {
+								goto l1169
+							}
+							position++
+							goto l1168
+						l1169:
+							position, tokenIndex, depth = position1168, tokenIndex1168, depth1168
+							if buffer[position] != rune('A') {
+								goto l1150
+							}
+							position++
+						}
+					l1168:
+						{
+							position1170, tokenIndex1170, depth1170 := position, tokenIndex, depth
+							if buffer[position] != rune('t') {
+								goto l1171
+							}
+							position++
+							goto l1170
+						l1171:
+							position, tokenIndex, depth = position1170, tokenIndex1170, depth1170
+							if buffer[position] != rune('T') {
+								goto l1150
+							}
+							position++
+						}
+					l1170:
+						{
+							position1172, tokenIndex1172, depth1172 := position, tokenIndex, depth
+							if buffer[position] != rune('e') {
+								goto l1173
+							}
+							position++
+							goto l1172
+						l1173:
+							position, tokenIndex, depth = position1172, tokenIndex1172, depth1172
+							if buffer[position] != rune('E') {
+								goto l1150
+							}
+							position++
+						}
+					l1172:
+						{
+							position1174, tokenIndex1174, depth1174 := position, tokenIndex, depth
+							if buffer[position] != rune('n') {
+								goto l1175
+							}
+							position++
+							goto l1174
+						l1175:
+							position, tokenIndex, depth = position1174, tokenIndex1174, depth1174
+							if buffer[position] != rune('N') {
+								goto l1150
+							}
+							position++
+						}
+					l1174:
+						{
+							position1176, tokenIndex1176, depth1176 := position, tokenIndex, depth
+							if buffer[position] != rune('e') {
+								goto l1177
+							}
+							position++
+							goto l1176
+						l1177:
+							position, tokenIndex, depth = position1176, tokenIndex1176, depth1176
+							if buffer[position] != rune('E') {
+								goto l1150
+							}
+							position++
+						}
+					l1176:
+						{
+							position1178, tokenIndex1178, depth1178 := position, tokenIndex, depth
+							if buffer[position] != rune('s') {
+								goto l1179
+							}
+							position++
+							goto l1178
+						l1179:
+							position, tokenIndex, depth = position1178, tokenIndex1178, depth1178
+							if buffer[position] != rune('S') {
+								goto l1150
+							}
+							position++
+						}
+					l1178:
+						{
+							position1180, tokenIndex1180, depth1180 := position, tokenIndex, depth
+							if buffer[position] != rune('s') {
+								goto l1181
+							}
+							position++
+							goto l1180
+						l1181:
+							position, tokenIndex, depth = position1180, tokenIndex1180, depth1180
+							if buffer[position] != rune('S') {
+								goto l1150
+							}
+							position++
+						}
+					l1180:
+						if !_rules[rulesp]() {
+							goto l1150
+						}
+						if !_rules[ruleTimeInterval]() {
+							goto l1150
+						}
+						goto l1151
+					l1150:
+						position, tokenIndex, depth = position1150, tokenIndex1150, depth1150
+					}
+				l1151:
+					depth--
+					add(rulePegText, position1149)
+				}
+				if !_rules[ruleAction55]() {
+					goto l1147
+				}
+				depth--
+				add(ruleAllowedLatenessSpecOpt, position1148)
+			}
+			return true
+		l1147:
+			position, tokenIndex, depth = position1147, tokenIndex1147, depth1147
+			return false
+		},
+		/* 72 LateTuplesSpecOpt <- <(<(spOpt ',' spOpt (('l' / 'L') ('a' / 'A') ('t' / 'T') ('e' / 'E')) sp (('t' / 'T') ('u' / 'U') ('p' / 'P') ('l' / 'L') ('e' / 'E') ('s' / 'S')) sp (('t' / 'T') ('o' / 'O')) sp StreamIdentifier)?> Action56)> */
+		func() bool {
+			position1182, tokenIndex1182, depth1182 := position, tokenIndex, depth
+			{
+				position1183 := position
+				depth++
+				{
+					position1184 := position
+					depth++
+					{
+						position1185, tokenIndex1185, depth1185 := position, tokenIndex, depth
+						if !_rules[rulespOpt]() {
+							goto l1185
+						}
+						if buffer[position] != rune(',') {
+							goto l1185
+						}
+						position++
+						if !_rules[rulespOpt]() {
+							goto l1185
+						}
+						{
+							position1187, tokenIndex1187, depth1187 := position, tokenIndex, depth
+							if buffer[position] != rune('l') {
+								goto l1188
+							}
+							position++
+							goto l1187
+						l1188:
+							position, tokenIndex, depth = position1187, tokenIndex1187, depth1187
+							if buffer[position] != rune('L') {
+								goto l1185
+							}
+							position++
+						}
+					l1187:
+						{
+							position1189, tokenIndex1189, depth1189 := position, tokenIndex, depth
+							if buffer[position] != rune('a') {
+								goto l1190
+							}
+							position++
+							goto l1189
+						l1190:
+							position, tokenIndex, depth = position1189, tokenIndex1189, depth1189
+							if buffer[position] != rune('A') {
+								goto l1185
+							}
+							position++
+						}
+					l1189:
+						{
+							position1191, tokenIndex1191, depth1191 := position, tokenIndex, depth
+							if buffer[position] != rune('t') {
+								goto l1192
+							}
+							position++
+							goto l1191
+						l1192:
+							position, tokenIndex, depth = position1191, tokenIndex1191, depth1191
+							if buffer[position] != rune('T') {
+								goto l1185
+							}
+							position++
+						}
+					l1191:
+						{
+							position1193, tokenIndex1193, depth1193 := position, tokenIndex, depth
+							if buffer[position] != rune('e') {
+								goto l1194
+							}
+							position++
+							goto l1193
+						l1194:
+							position, tokenIndex, depth = position1193, tokenIndex1193, depth1193
+							if buffer[position] != rune('E') {
+								goto l1185
+							}
+							position++
+						}
+					l1193:
+						if !_rules[rulesp]() {
+							goto l1185
+						}
+						{
+							position1195, tokenIndex1195, depth1195 := position, tokenIndex, depth
+							if buffer[position] != rune('t') {
+								goto l1196
+							}
+							position++
+							goto l1195
+						l1196:
+							position, tokenIndex, depth = position1195, tokenIndex1195, depth1195
+							if buffer[position] != rune('T') {
+								goto l1185
+							}
+							position++
+						}
+					l1195:
+						{
+							position1197, tokenIndex1197, depth1197 := position, tokenIndex, depth
+							if buffer[position] != rune('u') {
+								goto l1198
+							}
+							position++
+							goto l1197
+						l1198:
+							position, tokenIndex, depth = position1197, tokenIndex1197, depth1197
+							if buffer[position] != rune('U') {
+								goto l1185
+							}
+							position++
+						}
+					l1197:
+						{
+							position1199, tokenIndex1199, depth1199 := position, tokenIndex, depth
+							if buffer[position] != rune('p') {
+								goto l1200
+							}
+							position++
+							goto l1199
+						l1200:
+							position, tokenIndex, depth = position1199, tokenIndex1199, depth1199
+							if buffer[position] != rune('P') {
+								goto l1185
+							}
+							position++
+						}
+					l1199:
+						{
+							position1201, tokenIndex1201, depth1201 := position, tokenIndex, depth
+							if buffer[position] != rune('l') {
+								goto l1202
+							}
+							position++
+							goto l1201
+						l1202:
+							position, tokenIndex, depth = position1201, tokenIndex1201, depth1201
+							if buffer[position] != rune('L') {
+								goto l1185
+							}
+							position++
+						}
+					l1201:
+						{
+							position1203, tokenIndex1203, depth1203 := position, tokenIndex, depth
+							if buffer[position] != rune('e') {
+								goto l1204
+							}
+							position++
+							goto l1203
+						l1204:
+							position, tokenIndex, depth = position1203, tokenIndex1203, depth1203
+							if buffer[position] != rune('E') {
+								goto l1185
+							}
+							position++
+						}
+					l1203:
+						{
+							position1205, tokenIndex1205, depth1205 := position, tokenIndex, depth
+							if buffer[position] != rune('s') {
+								goto l1206
+							}
+							position++
+							goto l1205
+						l1206:
+							position, tokenIndex, depth = position1205, tokenIndex1205, depth1205
+							if buffer[position] != rune('S') {
+								goto l1185
+							}
+							position++
+						}
+					l1205:
+						if !_rules[rulesp]() {
+							goto l1185
+						}
+						{
+							position1207, tokenIndex1207, depth1207 := position, tokenIndex, depth
+							if buffer[position] != rune('t') {
+								goto l1208
+							}
+							position++
+							goto l1207
+						l1208:
+							position, tokenIndex, depth = position1207, tokenIndex1207, depth1207
+							if buffer[position] != rune('T') {
+								goto l1185
+							}
+							position++
+						}
+					l1207:
+						{
+							position1209, tokenIndex1209, depth1209 := position, tokenIndex, depth
+							if buffer[position] != rune('o') {
+								goto l1210
+							}
+							position++
+							goto l1209
+						l1210:
+							position, tokenIndex, depth = position1209, tokenIndex1209, depth1209
+							if buffer[position] != rune('O') {
+								goto l1185
+							}
+							position++
+						}
+					l1209:
+						if !_rules[rulesp]() {
+							goto l1185
+						}
+						if !_rules[ruleStreamIdentifier]() {
+							goto l1185
+						}
+						goto l1186
+					l1185:
+						position, tokenIndex, depth = position1185, tokenIndex1185, depth1185
+					}
+				l1186:
+					depth--
+					add(rulePegText, position1184)
+				}
+				if !_rules[ruleAction56]() {
+					goto l1182
+				}
+				depth--
+				add(ruleLateTuplesSpecOpt, position1183)
+			}
+			return true
+		l1182:
+			position, tokenIndex, depth = position1182, tokenIndex1182, depth1182
+			return false
+		},
+		/* 73 CapacitySpecOpt <- <(<(spOpt ',' spOpt (('b' / 'B') ('u' / 'U') ('f' / 'F') ('f' / 'F') ('e' / 'E') ('r' / 'R')) sp (('s' / 'S') ('i' / 'I') ('z' / 'Z') ('e' / 'E')) sp NonNegativeNumericLiteral)?> Action57)> */
+		func() bool {
+			position1211, tokenIndex1211, depth1211 := position, tokenIndex, depth
+			{
+				position1212 := position
+				depth++
+				{
+					position1213 := position
+					depth++
+					{
+						position1214, tokenIndex1214, depth1214 := position, tokenIndex, depth
+						if !_rules[rulespOpt]() {
+							goto l1214
+						}
+						if buffer[position] != rune(',') {
+							goto l1214
+						}
+						position++
+						if !_rules[rulespOpt]() {
+							goto l1214
+						}
+						{
+							position1216, tokenIndex1216, depth1216 := position, tokenIndex, depth
+							if buffer[position] != rune('b') {
+								goto l1217
+							}
+							position++
+							goto l1216
+						l1217:
+							position, tokenIndex, depth = position1216, tokenIndex1216, depth1216
+							if buffer[position] != rune('B') {
+								goto l1214
+							}
+							position++
+						}
+					l1216:
+						{
+							position1218, tokenIndex1218, depth1218 := position, tokenIndex, depth
+							if buffer[position] != rune('u') {
+								goto l1219
+							}
+							position++
+							goto l1218
+						l1219:
+							position, tokenIndex, depth = position1218, tokenIndex1218, depth1218
+							if buffer[position] != rune('U') {
+								goto l1214
+							}
+							position++
+						}
+					l1218:
+						{
+							position1220, tokenIndex1220, depth1220 := position, tokenIndex, depth
+							if buffer[position] != rune('f') {
+								goto l1221
+							}
+							position++
+							goto l1220
+						l1221:
+							position, tokenIndex, depth = position1220, tokenIndex1220, depth1220
+							if buffer[position] != rune('F') {
+								goto l1214
+							}
+							position++
+						}
+					l1220:
+						{
+							position1222, tokenIndex1222, depth1222 := position, tokenIndex, depth
+							if buffer[position] != rune('f') {
+								goto l1223
+							}
+							position++
+							goto l1222
+						l1223:
+							position, tokenIndex, depth = position1222, tokenIndex1222, depth1222
+							if buffer[position] != rune('F') {
+								goto l1214
+							}
+							position++
+						}
+					l1222:
+						{
+							position1224, tokenIndex1224, depth1224 := position, tokenIndex, depth
+							if buffer[position] != rune('e') {
+								goto l1225
+							}
+							position++
+							goto l1224
+						l1225:
+							position, tokenIndex, depth = position1224, tokenIndex1224, depth1224
+							if buffer[position] != rune('E') {
+								goto l1214
+							}
+							position++
+						}
+					l1224:
+						{
+							position1226, tokenIndex1226, depth1226 := position, tokenIndex, depth
+							if buffer[position] != rune('r') {
+								goto l1227
+							}
+							position++
+							goto l1226
+						l1227:
+							position, tokenIndex, depth = position1226, tokenIndex1226, depth1226
+							if buffer[position] != rune('R') {
+								goto l1214
+							}
+							position++
+						}
+					l1226:
+						if !_rules[rulesp]() {
+							goto l1214
+						}
+						{
+							position1228, tokenIndex1228, depth1228 := position, tokenIndex, depth
+							if buffer[position] != rune('s') {
+								goto l1229
+							}
+							position++
+							goto l1228
+						l1229:
+							position, tokenIndex, depth = position1228, tokenIndex1228, depth1228
+							if buffer[position] != rune('S') {
+								goto l1214
+							}
+							position++
+						}
+					l1228:
+						{
+							position1230, tokenIndex1230, depth1230 := position, tokenIndex, depth
+							if buffer[position] != rune('i') {
+								goto l1231
+							}
+							position++
+							goto l1230
+						l1231:
+							position, tokenIndex, depth = position1230, tokenIndex1230, depth1230
+							if buffer[position] != rune('I') {
+								goto l1214
+							}
+							position++
+						}
+					l1230:
+						{
+							position1232, tokenIndex1232, depth1232 := position, tokenIndex, depth
+							if buffer[position] != rune('z') {
+								goto l1233
+							}
+							position++
+							goto l1232
+						l1233:
+							position, tokenIndex, depth = position1232, tokenIndex1232, depth1232
+							if buffer[position] != rune('Z') {
+								goto l1214
+							}
+							position++
+						}
+					l1232:
+						{
+							position1234, tokenIndex1234, depth1234 := position, tokenIndex, depth
+							if buffer[position] != rune('e') {
+								goto l1235
+							}
+							position++
+							goto l1234
+						l1235:
+							position, tokenIndex, depth = position1234, tokenIndex1234, depth1234
+							if buffer[position] != rune('E') {
+								goto l1214
+							}
+							position++
+						}
+					l1234:
+						if !_rules[rulesp]() {
+							goto l1214
+						}
+						if !_rules[ruleNonNegativeNumericLiteral]() {
+							goto l1214
+						}
+						goto l1215
+					l1214:
+						position, tokenIndex, depth = position1214, tokenIndex1214, depth1214
+					}
+				l1215:
+					depth--
+					add(rulePegText, position1213)
+				}
+				if !_rules[ruleAction57]() {
+					goto l1211
+				}
+				depth--
+				add(ruleCapacitySpecOpt, position1212)
+			}
+			return true
+		l1211:
+			position, tokenIndex, depth = position1211, tokenIndex1211, depth1211
+			return false
+		},
+		/* 74 SheddingSpecOpt <- <(<(spOpt ',' spOpt SheddingOption sp (('i' / 'I') ('f' / 'F')) sp (('f' / 'F') ('u' / 'U') ('l' / 'L') ('l' / 'L')))?> Action58)> */
+		func() bool {
+			position1236, tokenIndex1236, depth1236 := position, tokenIndex, depth
+			{
+				position1237 := position
+				depth++
+				{
+					position1238 := position
+					depth++
+					{
+						position1239, tokenIndex1239, depth1239 := position, tokenIndex, depth
+						if !_rules[rulespOpt]() {
+							goto l1239
+						}
+						if buffer[position] != rune(',') {
+							goto l1239
+						}
+						position++
+						if !_rules[rulespOpt]() {
+							goto l1239
+						}
+						if !_rules[ruleSheddingOption]() {
+							goto l1239
+						}
+						if !_rules[rulesp]() {
+							goto l1239
+						}
+						{
+							position1241, tokenIndex1241, depth1241 := position, tokenIndex, depth
+							if buffer[position] != rune('i') {
+								goto l1242
+							}
+							position++
+							goto l1241
+						l1242:
+							position, tokenIndex, depth = position1241, tokenIndex1241, depth1241
+							if buffer[position] != rune('I') {
+								goto l1239
+							}
+							position++
+						}
+					l1241:
+						{
+							position1243, tokenIndex1243, depth1243 := position, tokenIndex, depth
+							if buffer[position] != rune('f') {
+								goto l1244
+							}
+							position++
+							goto l1243
+						l1244:
+							position, tokenIndex, depth = position1243, tokenIndex1243, depth1243
+							if buffer[position] != rune('F') {
+								goto l1239
+							}
+							position++
+						}
+					l1243:
+						if !_rules[rulesp]() {
+							goto l1239
+						}
+						{
+							position1245, tokenIndex1245, depth1245 := position, tokenIndex, depth
+							if buffer[position] != rune('f') {
+								goto l1246
+							}
+							position++
+							goto l1245
+						l1246:
+							position, tokenIndex, depth = position1245, tokenIndex1245, depth1245
+							if buffer[position] != rune('F') {
+								goto l1239
+							}
+							position++
+						}
+					l1245:
+						{
+							position1247, tokenIndex1247, depth1247 := position, tokenIndex, depth
+							if buffer[position] != rune('u') {
+								goto l1248
+							}
+							position++
+							goto l1247
+						l1248:
+							position, tokenIndex, depth = position1247, tokenIndex1247, depth1247
+							if buffer[position] != rune('U') {
+								goto l1239
+							}
+							position++
+						}
+					l1247:
+						{
+							position1249, tokenIndex1249, depth1249 := position, tokenIndex, depth
+							if buffer[position] != rune('l') {
+								goto l1250
+							}
+							position++
+							goto l1249
+						l1250:
+							position, tokenIndex, depth = position1249, tokenIndex1249, depth1249
+							if buffer[position] != rune('L') {
+								goto l1239
+							}
+							position++
+						}
+					l1249:
+						{
+							position1251, tokenIndex1251, depth1251 := position, tokenIndex, depth
+							if buffer[position] != rune('l') {
+								goto l1252
+							}
+							position++
+							goto l1251
+						l1252:
+							position, tokenIndex, depth = position1251, tokenIndex1251, depth1251
+							if buffer[position] != rune('L') {
+								goto l1239
+							}
+							position++
+						}
+					l1251:
+						goto l1240
+					l1239:
+						position, tokenIndex, depth = position1239, tokenIndex1239, depth1239
+					}
+				l1240:
+					depth--
+					add(rulePegText, position1238)
+				}
+				if !_rules[ruleAction58]() {
+					goto l1236
+				}
+				depth--
+				add(ruleSheddingSpecOpt, position1237)
+			}
+			return true
+		l1236:
+			position, tokenIndex, depth = position1236, tokenIndex1236, depth1236
+			return false
+		},
+		/* 75 SheddingOption <- <(Wait / DropOldest / DropNewest)> */
+		func() bool {
+			position1253, tokenIndex1253, depth1253 := position, tokenIndex, depth
+			{
+				position1254 := position
+				depth++
+				{
+					position1255, tokenIndex1255, depth1255 := position, tokenIndex, depth
+					if !_rules[ruleWait]() {
+						goto l1256
+					}
+					goto l1255
+				l1256:
+					position, tokenIndex, depth = position1255, tokenIndex1255, depth1255
+					if !_rules[ruleDropOldest]() {
+						goto l1257
+					}
+					goto l1255
+				l1257:
+					position, tokenIndex, depth = position1255, tokenIndex1255, depth1255
+					if !_rules[ruleDropNewest]() {
+						goto l1253
+					}
+				}
+			l1255:
+				depth--
+				add(ruleSheddingOption, position1254)
+			}
+			return true
+		l1253:
+			position, tokenIndex, depth = position1253, tokenIndex1253, depth1253
+			return false
+		},
+		/* 76 SourceSinkSpecs <- <(<(sp (('w' / 'W') ('i' / 'I') ('t' / 'T') ('h' / 'H')) sp SourceSinkParam (spOpt ',' spOpt SourceSinkParam)*)?> Action59)> */
+		func() bool {
+			position1258, tokenIndex1258, depth1258 := position, tokenIndex, depth
+			{
+				position1259 := position
+				depth++
+				{
+					position1260 := position
+					depth++
+					{
+						position1261, tokenIndex1261, depth1261 := position, tokenIndex, depth
+						if !_rules[rulesp]() {
+							goto l1261
+						}
+						{
+							position1263, tokenIndex1263, depth1263 := position, tokenIndex, depth
+							if buffer[position] != rune('w') {
+								goto l1264
+							}
+							position++
+							goto l1263
+						l1264:
+							position, tokenIndex, depth = position1263, tokenIndex1263, depth1263
+							if buffer[position] != rune('W') {
+								goto l1261
+							}
+							position++
+						}
+					l1263:
+						{
+							position1265, tokenIndex1265, depth1265 := position, tokenIndex, depth
+							if buffer[position] != rune('i') {
+								goto l1266
+							}
+							position++
+							goto l1265
+						l1266:
+							position, tokenIndex, depth = position1265, tokenIndex1265, depth1265
+							if buffer[position] != rune('I') {
+								goto l1261
+							}
+							position++
+						}
+					l1265:
+						{
+							position1267, tokenIndex1267, depth1267 := position, tokenIndex, depth
+							if buffer[position] != rune('t') {
+								goto l1268
+							}
+							position++
+							goto l1267
+						l1268:
+							position, tokenIndex, depth = position1267, tokenIndex1267, depth1267
+							if buffer[position] != rune('T') {
+								goto l1261
+							}
+							position++
+						}
+					l1267:
+						{
+							position1269, tokenIndex1269, depth1269 := position, tokenIndex, depth
+							if buffer[position] != rune('h') {
+								goto l1270
+							}
+							position++
+							goto l1269
+						l1270:
+							position, tokenIndex, depth = position1269, tokenIndex1269, depth1269
+							if buffer[position] != rune('H') {
+								goto l1261
+							}
+							position++
+						}
+					l1269:
+						if !_rules[rulesp]() {
+							goto l1261
+						}
+						if !_rules[ruleSourceSinkParam]() {
+							goto l1261
+						}
+					l1271:
+						{
+							position1272, tokenIndex1272, depth1272 := position, tokenIndex, depth
+							if !_rules[rulespOpt]() {
+								goto l1272
+							}
+							if buffer[position] != rune(',') {
+								goto l1272
+							}
+							position++
+							if !_rules[rulespOpt]() {
+								goto l1272
+							}
+							if !_rules[ruleSourceSinkParam]() {
+								goto l1272
+							}
+							goto l1271
+						l1272:
+							position, tokenIndex, depth = position1272, tokenIndex1272, depth1272
+						}
+						goto l1262
+					l1261:
+						position, tokenIndex, depth = position1261, tokenIndex1261, depth1261
+					}
+				l1262:
+					depth--
+					add(rulePegText, position1260)
+				}
+				if !_rules[ruleAction59]() {
+					goto l1258
+				}
+				depth--
+				add(ruleSourceSinkSpecs, position1259)
+			}
+			return true
+		l1258:
+			position, tokenIndex, depth = position1258, tokenIndex1258, depth1258
+			return false
+		},
+		/* 77 UpdateSourceSinkSpecs <- <(<(sp (('s' / 'S') ('e' / 'E') ('t' / 'T')) sp SourceSinkParam (spOpt ',' spOpt SourceSinkParam)*)> Action60)> */
+		func() bool {
+			position1273, tokenIndex1273, depth1273 := position, tokenIndex, depth
+			{
+				position1274 := position
+				depth++
+				{
+					position1275 := position
+					depth++
+					if !_rules[rulesp]() {
+						goto l1273
+					}
+					{
+						position1276, tokenIndex1276, depth1276 := position, tokenIndex, depth
+						if buffer[position] != rune('s') {
+							goto l1277
+						}
+						position++
+						goto l1276
+					l1277:
+						position, tokenIndex, depth = position1276, tokenIndex1276, depth1276
+						if buffer[position] != rune('S') {
+							goto l1273
+						}
+						position++
+					}
+				l1276:
+					{
+						position1278, tokenIndex1278, depth1278 := position, tokenIndex, depth
+						if buffer[position] != rune('e') {
+							goto l1279
+						}
+						position++
+						goto l1278
+					l1279:
+						position, tokenIndex, depth = position1278, tokenIndex1278, depth1278
+						if buffer[position] != rune('E') {
+							goto l1273
+						}
+						position++
+					}
+				l1278:
+					{
+						position1280, tokenIndex1280, depth1280 := position, tokenIndex, depth
+						if buffer[position] != rune('t') {
+							goto l1281
+						}
+						position++
+						goto l1280
+					l1281:
+						position, tokenIndex, depth = position1280, tokenIndex1280, depth1280
+						if buffer[position] != rune('T') {
+							goto l1273
+						}
+						position++
+					}
+				l1280:
+					if !_rules[rulesp]() {
+						goto l1273
+					}
+					if !_rules[ruleSourceSinkParam]() {
+						goto l1273
+					}
+				l1282:
+					{
+						position1283, tokenIndex1283, depth1283 := position, tokenIndex, depth
+						if !_rules[rulespOpt]() {
+							goto l1283
+						}
+						if buffer[position] != rune(',') {
+							goto l1283
+						}
+						position++
+						if !_rules[rulespOpt]() {
+							goto l1283
+						}
+						if !_rules[ruleSourceSinkParam]() {
+							goto l1283
+						}
+						goto l1282
+					l1283:
+						position, tokenIndex, depth = position1283, tokenIndex1283, depth1283
+					}
+					depth--
+					add(rulePegText, position1275)
+				}
+				if !_rules[ruleAction60]() {
+					goto l1273
+				}
+				depth--
+				add(ruleUpdateSourceSinkSpecs, position1274)
+			}
+			return true
+		l1273:
+			position, tokenIndex, depth = position1273, tokenIndex1273, depth1273
+			return false
+		},
+		/* 78 SetOptSpecs <- <(<(sp (('s' / 'S') ('e' / 'E') ('t' / 'T')) sp SourceSinkParam (spOpt ',' spOpt SourceSinkParam)*)?> Action61)> */
+		func() bool {
+			position1284, tokenIndex1284, depth1284 := position, tokenIndex, depth
+			{
+				position1285 := position
+				depth++
+				{
+					position1286 := position
+					depth++
+					{
+						position1287, tokenIndex1287, depth1287 := position, tokenIndex, depth
+						if !_rules[rulesp]() {
+							goto l1287
+						}
+						{
+							position1289, tokenIndex1289, depth1289 := position, tokenIndex, depth
+							if buffer[position] != rune('s') {
+								goto l1290
+							}
+							position++
+							goto l1289
+						l1290:
+							position, tokenIndex, depth = position1289, tokenIndex1289, depth1289
+							if buffer[position] != rune('S') {
+								goto l1287
+							}
+							position++
+						}
+					l1289:
+						{
+							position1291, tokenIndex1291, depth1291 := position, tokenIndex, depth
+							if buffer[position] != rune('e') {
+								goto l1292
+							}
+							position++
+							goto l1291
+						l1292:
+							position, tokenIndex, depth = position1291, tokenIndex1291, depth1291
+							if buffer[position] != rune('E') {
+								goto l1287
+							}
+							position++
+						}
+					l1291:
+						{
+							position1293, tokenIndex1293, depth1293 := position, tokenIndex, depth
+							if buffer[position] != rune('t') {
+								goto l1294
+							}
+							position++
+							goto l1293
+						l1294:
+							position, tokenIndex, depth = position1293, tokenIndex1293, depth1293
+							if buffer[position] != rune('T') {
+								goto l1287
+							}
+							position++
+						}
+					l1293:
+						if !_rules[rulesp]() {
+							goto l1287
+						}
+						if !_rules[ruleSourceSinkParam]() {
+							goto l1287
+						}
+					l1295:
+						{
+							position1296, tokenIndex1296, depth1296 := position, tokenIndex, depth
+							if !_rules[rulespOpt]() {
+								goto l1296
+							}
+							if buffer[position] != rune(',') {
+								goto l1296
+							}
+							position++
+							if !_rules[rulespOpt]() {
+								goto l1296
+							}
+							if !_rules[ruleSourceSinkParam]() {
+								goto l1296
+							}
+							goto l1295
+						l1296:
+							position, tokenIndex, depth = position1296, tokenIndex1296, depth1296
+						}
+						goto l1288
+					l1287:
+						position, tokenIndex, depth = position1287, tokenIndex1287, depth1287
+					}
+				l1288:
+					depth--
+					add(rulePegText, position1286)
+				}
+				if !_rules[ruleAction61]() {
+					goto l1284
+				}
+				depth--
+				add(ruleSetOptSpecs, position1285)
+			}
+			return true
+		l1284:
+			position, tokenIndex, depth = position1284, tokenIndex1284, depth1284
+			return false
+		},
+		/* 79 StateTagOpt <- <(<(sp (('t' / 'T') ('a' / 'A') ('g' / 'G')) sp Identifier)?> Action62)> */
+		func() bool {
+			position1297, tokenIndex1297, depth1297 := position, tokenIndex, depth
+			{
+				position1298 := position
+				depth++
+				{
+					position1299 := position
+					depth++
+					{
+						position1300, tokenIndex1300, depth1300 := position, tokenIndex, depth
+						if !_rules[rulesp]() {
+							goto l1300
+						}
+						{
+							position1302, tokenIndex1302, depth1302 := position, tokenIndex, depth
+							if buffer[position] != rune('t') {
+								goto l1303
+							}
+							position++
+							goto l1302
+						l1303:
+							position, tokenIndex, depth = position1302, tokenIndex1302, depth1302
+							if buffer[position] != rune('T') {
+								goto l1300
+							}
+							position++
+						}
+					l1302:
+						{
+							position1304, tokenIndex1304, depth1304 := position, tokenIndex, depth
+							if buffer[position] != rune('a') {
+								goto l1305
+							}
+							position++
+							goto l1304
+						l1305:
+							position, tokenIndex, depth = position1304, tokenIndex1304, depth1304
+							if buffer[position] != rune('A') {
+								goto l1300
+							}
+							position++
+						}
+					l1304:
+						{
+							position1306, tokenIndex1306, depth1306 := position, tokenIndex, depth
+							if buffer[position] != rune('g') {
+								goto l1307
+							}
+							position++
+							goto l1306
+						l1307:
+							position, tokenIndex, depth = position1306, tokenIndex1306, depth1306
+							if buffer[position] != rune('G') {
+								goto l1300
+							}
+							position++
+						}
+					l1306:
+						if !_rules[rulesp]() {
+							goto l1300
+						}
+						if !_rules[ruleIdentifier]() {
+							goto l1300
+						}
+						goto l1301
+					l1300:
+						position, tokenIndex, depth = position1300, tokenIndex1300, depth1300
+					}
+				l1301:
+					depth--
+					add(rulePegText, position1299)
+				}
+				if !_rules[ruleAction62]() {
+					goto l1297
+				}
+				depth--
+				add(ruleStateTagOpt, position1298)
+			}
+			return true
+		l1297:
+			position, tokenIndex, depth = position1297, tokenIndex1297, depth1297
+			return false
+		},
+		/* 80 SourceSinkParam <- <(SourceSinkParamKey spOpt '=' spOpt SourceSinkParamVal Action63)> */
+		func() bool {
+			position1308, tokenIndex1308, depth1308 := position, tokenIndex, depth
+			{
+				position1309 := position
+				depth++
+				if !_rules[ruleSourceSinkParamKey]() {
+					goto l1308
+				}
+				if !_rules[rulespOpt]() {
+					goto l1308
+				}
+				if buffer[position] != rune('=') {
+					goto l1308
+				}
+				position++
+				if !_rules[rulespOpt]() {
+					goto l1308
+				}
+				if !_rules[ruleSourceSinkParamVal]() {
+					goto l1308
+				}
+				if !_rules[ruleAction63]() {
+					goto l1308
+				}
+				depth--
+				add(ruleSourceSinkParam, position1309)
+			}
+			return true
+		l1308:
+			position, tokenIndex, depth = position1308, tokenIndex1308, depth1308
+			return false
+		},
+		/* 81 SourceSinkParamVal <- <(ParamLiteral / ParamArrayExpr / ParamMapExpr)> */
+		func() bool {
+			position1310, tokenIndex1310, depth1310 := position, tokenIndex, depth
+			{
+				position1311 := position
+				depth++
+				{
+					position1312, tokenIndex1312, depth1312 := position, tokenIndex, depth
+					if !_rules[ruleParamLiteral]() {
+						goto l1313
+					}
+					goto l1312
+				l1313:
+					position, tokenIndex, depth = position1312, tokenIndex1312, depth1312
+					if !_rules[ruleParamArrayExpr]() {
+						goto l1314
+					}
+					goto l1312
+				l1314:
+					position, tokenIndex, depth = position1312, tokenIndex1312, depth1312
+					if !_rules[ruleParamMapExpr]() {
+						goto l1310
+					}
+				}
+			l1312:
+				depth--
+				add(ruleSourceSinkParamVal, position1311)
+			}
+			return true
+		l1310:
+			position, tokenIndex, depth = position1310, tokenIndex1310, depth1310
+			return false
+		},
+		/* 82 ParamLiteral <- <(BooleanLiteral / Literal)> */
+		func() bool {
+			position1315, tokenIndex1315, depth1315 := position, tokenIndex, depth
+			{
+				position1316 := position
+				depth++
+				{
+					position1317, tokenIndex1317, depth1317 := position, tokenIndex, depth
+					if !_rules[ruleBooleanLiteral]() {
+						goto l1318
+					}
+					goto l1317
+				l1318:
+					position, tokenIndex, depth = position1317, tokenIndex1317, depth1317
+					if !_rules[ruleLiteral]() {
+						goto l1315
+					}
+				}
+			l1317:
+				depth--
+				add(ruleParamLiteral, position1316)
+			}
+			return true
+		l1315:
+			position, tokenIndex, depth = position1315, tokenIndex1315, depth1315
+			return false
+		},
+		/* 83 ParamArrayExpr <- <(<('[' spOpt (ParamLiteral (',' spOpt ParamLiteral)*)? spOpt ','? spOpt ']')> Action64)> */
+		func() bool {
+			position1319, tokenIndex1319, depth1319 := position, tokenIndex, depth
+			{
+				position1320 := position
+				depth++
+				{
+					position1321 := position
+					depth++
+					if buffer[position] != rune('[') {
+						goto l1319
+					}
+					position++
+					if !_rules[rulespOpt]() {
+						goto l1319
+					}
+					{
+						position1322, tokenIndex1322, depth1322 := position, tokenIndex, depth
+						if !_rules[ruleParamLiteral]() {
+							goto l1322
+						}
+					l1324:
+						{
+							position1325, tokenIndex1325, depth1325 := position, tokenIndex, depth
+							if buffer[position] != rune(',') {
+								goto l1325
+							}
+							position++
+							if !_rules[rulespOpt]() {
+								goto l1325
+							}
+							if !_rules[ruleParamLiteral]() {
+								goto l1325
+							}
+							goto l1324
+						l1325:
+							position, tokenIndex, depth = position1325, tokenIndex1325, depth1325
+						}
+						goto l1323
+					l1322:
+						position, tokenIndex, depth = position1322, tokenIndex1322, depth1322
+					}
+				l1323:
+					if !_rules[rulespOpt]() {
+						goto l1319
+					}
+					{
+						position1326, tokenIndex1326, depth1326 := position, tokenIndex, depth
+						if buffer[position] != rune(',') {
+							goto l1326
+						}
+						position++
+						goto l1327
+					l1326:
+						position, tokenIndex, depth = position1326, tokenIndex1326, depth1326
+					}
+				l1327:
+					if !_rules[rulespOpt]() {
+						goto l1319
+					}
+					if buffer[position] != rune(']') {
+						goto l1319
+					}
+					position++
+					depth--
+					add(rulePegText, position1321)
+				}
+				if !_rules[ruleAction64]() {
+					goto l1319
+				}
+				depth--
+				add(ruleParamArrayExpr, position1320)
+			}
+			return true
+		l1319:
+			position, tokenIndex, depth = position1319, tokenIndex1319, depth1319
+			return false
+		},
+		/* 84 ParamMapExpr <- <(<('{' spOpt (ParamKeyValuePair (spOpt ',' spOpt ParamKeyValuePair)*)? spOpt '}')> Action65)> */
+		func() bool {
+			position1328, tokenIndex1328, depth1328 := position, tokenIndex, depth
+			{
+				position1329 := position
+				depth++
+				{
+					position1330 := position
+					depth++
+					if buffer[position] != rune('{') {
+						goto l1328
+					}
+					position++
+					if !_rules[rulespOpt]() {
+						goto l1328
+					}
+					{
+						position1331, tokenIndex1331, depth1331 := position, tokenIndex, depth
+						if !_rules[ruleParamKeyValuePair]() {
+							goto l1331
+						}
+					l1333:
+						{
+							position1334, tokenIndex1334, depth1334 := position, tokenIndex, depth
+							if !_rules[rulespOpt]() {
+								goto l1334
+							}
+							if buffer[position] != rune(',') {
+								goto l1334
+							}
+							position++
+							if !_rules[rulespOpt]() {
+								goto l1334
+							}
+							if !_rules[ruleParamKeyValuePair]() {
+								goto l1334
+							}
+							goto l1333
+						l1334:
+							position, tokenIndex, depth = position1334, tokenIndex1334, depth1334
+						}
+						goto l1332
+					l1331:
+						position, tokenIndex, depth = position1331, tokenIndex1331, depth1331
+					}
+				l1332:
+					if !_rules[rulespOpt]() {
+						goto l1328
+					}
+					if buffer[position] != rune('}') {
+						goto l1328
+					}
+					position++
+					depth--
+					add(rulePegText, position1330)
+				}
+				if !_rules[ruleAction65]() {
+					goto l1328
+				}
+				depth--
+				add(ruleParamMapExpr, position1329)
+			}
+			return true
+		l1328:
+			position, tokenIndex, depth = position1328, tokenIndex1328, depth1328
+			return false
+		},
+		/* 85 ParamKeyValuePair <- <(<(StringLiteral spOpt ':' spOpt ParamLiteral)> Action66)> */
+		func() bool {
+			position1335, tokenIndex1335, depth1335 := position, tokenIndex, depth
+			{
+				position1336 := position
+				depth++
+				{
+					position1337 := position
+					depth++
+					if !_rules[ruleStringLiteral]() {
+						goto l1335
+					}
+					if !_rules[rulespOpt]() {
+						goto l1335
+					}
+					if buffer[position] != rune(':') {
+						goto l1335
+					}
+					position++
+					if !_rules[rulespOpt]() {
+						goto l1335
+					}
+					if !_rules[ruleParamLiteral]() {
+						goto l1335
+					}
+					depth--
+					add(rulePegText, position1337)
+				}
+				if !_rules[ruleAction66]() {
+					goto l1335
+				}
+				depth--
+				add(ruleParamKeyValuePair, position1336)
+			}
+			return true
+		l1335:
+			position, tokenIndex, depth = position1335, tokenIndex1335, depth1335
+			return false
+		},
+		/* 86 PausedOpt <- <(<(sp (Paused / Unpaused))?> Action67)> */
+		func() bool {
+			position1338, tokenIndex1338, depth1338 := position, tokenIndex, depth
+			{
+				position1339 := position
+				depth++
+				{
+					position1340 := position
+					depth++
+					{
+						position1341, tokenIndex1341, depth1341 := position, tokenIndex, depth
+						if !_rules[rulesp]() {
+							goto l1341
+						}
+						{
+							position1343, tokenIndex1343, depth1343 := position, tokenIndex, depth
+							if !_rules[rulePaused]() {
+								goto l1344
+							}
+							goto l1343
+						l1344:
+							position, tokenIndex, depth = position1343, tokenIndex1343, depth1343
+							if !_rules[ruleUnpaused]() {
+								goto l1341
+							}
+						}
+					l1343:
+						goto l1342
+					l1341:
+						position, tokenIndex, depth = position1341, tokenIndex1341, depth1341
+					}
+				l1342:
+					depth--
+					add(rulePegText, position1340)
+				}
+				if !_rules[ruleAction67]() {
+					goto l1338
+				}
+				depth--
+				add(rulePausedOpt, position1339)
+			}
+			return true
+		l1338:
+			position, tokenIndex, depth = position1338, tokenIndex1338, depth1338
+			return false
+		},
+		/* 87 ExpressionOrWildcard <- <(Wildcard / Expression)> */
+		func() bool {
+			position1345, tokenIndex1345, depth1345 := position, tokenIndex, depth
+			{
+				position1346 := position
+				depth++
+				{
+					position1347, tokenIndex1347, depth1347 := position, tokenIndex, depth
+					if !_rules[ruleWildcard]() {
+						goto l1348
+					}
+					goto l1347
+				l1348:
+					position, tokenIndex, depth = position1347, tokenIndex1347, depth1347
+					if !_rules[ruleExpression]() {
+						goto l1345
+					}
+				}
+			l1347:
+				depth--
+				add(ruleExpressionOrWildcard, position1346)
+			}
+			return true
+		l1345:
+			position, tokenIndex, depth = position1345, tokenIndex1345, depth1345
+			return false
+		},
+		/* 88 Expression <- <orExpr> */
+		func() bool {
+			position1349, tokenIndex1349, depth1349 := position, tokenIndex, depth
+			{
+				position1350 := position
+				depth++
+				if !_rules[ruleorExpr]() {
+					goto l1349
+				}
+				depth--
+				add(ruleExpression, position1350)
+			}
+			return true
+		l1349:
+			position, tokenIndex, depth = position1349, tokenIndex1349, depth1349
+			return false
+		},
+		/* 89 orExpr <- <(<(andExpr (sp Or sp andExpr)*)> Action68)> */
+		func() bool {
+			position1351, tokenIndex1351, depth1351 := position, tokenIndex, depth
+			{
+				position1352 := position
+				depth++
+				{
+					position1353 := position
+					depth++
+					if !_rules[ruleandExpr]() {
+						goto l1351
+					}
+				l1354:
+					{
+						position1355, tokenIndex1355, depth1355 := position, tokenIndex, depth
+						if !_rules[rulesp]() {
+							goto l1355
+						}
+						if !_rules[ruleOr]() {
+							goto l1355
+						}
+						if !_rules[rulesp]() {
+							goto l1355
+						}
+						if !_rules[ruleandExpr]() {
+							goto l1355
+						}
+						goto l1354
+					l1355:
+						position, tokenIndex, depth = position1355, tokenIndex1355, depth1355
+					}
+					depth--
+					add(rulePegText, position1353)
+				}
+				if !_rules[ruleAction68]() {
+					goto l1351
+				}
+				depth--
+				add(ruleorExpr, position1352)
+			}
+			return true
+		l1351:
+			position, tokenIndex, depth = position1351, tokenIndex1351, depth1351
+			return false
+		},
+		/* 90 andExpr <- <(<(notExpr (sp And sp notExpr)*)> Action69)> */
+		func() bool {
+			position1356, tokenIndex1356, depth1356 := position, tokenIndex, depth
+			{
+				position1357 := position
+				depth++
+				{
+					position1358 := position
+					depth++
+					if !_rules[rulenotExpr]() {
+						goto l1356
+					}
+				l1359:
+					{
+						position1360, tokenIndex1360, depth1360 := position, tokenIndex, depth
+						if !_rules[rulesp]() {
+							goto l1360
+						}
+						if !_rules[ruleAnd]() {
+							goto l1360
+						}
+						if !_rules[rulesp]() {
+							goto l1360
+						}
+						if !_rules[rulenotExpr]() {
+							goto l1360
+						}
+						goto l1359
+					l1360:
+						position, tokenIndex, depth = position1360, tokenIndex1360, depth1360
+					}
+					depth--
+					add(rulePegText, position1358)
+				}
+				if !_rules[ruleAction69]() {
+					goto l1356
+				}
+				depth--
+				add(ruleandExpr, position1357)
+			}
+			return true
+		l1356:
+			position, tokenIndex, depth = position1356, tokenIndex1356, depth1356
+			return false
+		},
+		/* 91 notExpr <- <(<((Not sp)? comparisonExpr)> Action70)> */
+		func() bool {
+			position1361, tokenIndex1361, depth1361 := position, tokenIndex, depth
+			{
+				position1362 := position
+				depth++
+				{
+					position1363 := position
+					depth++
+					{
+						position1364, tokenIndex1364, depth1364 := position, tokenIndex, depth
+						if !_rules[ruleNot]() {
+							goto l1364
+						}
+						if !_rules[rulesp]() {
+							goto l1364
+						}
+						goto l1365
+					l1364:
+						position, tokenIndex, depth = position1364, tokenIndex1364, depth1364
+					}
+				l1365:
+					if !_rules[rulecomparisonExpr]() {
+						goto l1361
+					}
+					depth--
+					add(rulePegText, position1363)
+				}
+				if !_rules[ruleAction70]() {
+					goto l1361
+				}
+				depth--
+				add(rulenotExpr, position1362)
+			}
+			return true
+		l1361:
+			position, tokenIndex, depth = position1361, tokenIndex1361, depth1361
+			return false
+		},
+		/* 92 comparisonExpr <- <(<(otherOpExpr ((spOpt ComparisonOp spOpt otherOpExpr) / (sp PatternOp sp otherOpExpr) / (sp InOp spOpt InList) / (sp BetweenOp sp otherOpExpr sp (('a' / 'A') ('n' / 'N') ('d' / 'D')) sp otherOpExpr))?)> Action71)> */
+		func() bool {
+			position1366, tokenIndex1366, depth1366 := position, tokenIndex, depth
+			{
+				position1367 := position
+				depth++
+				{
+					position1368 := position
+					depth++
+					if !_rules[ruleotherOpExpr]() {
+						goto l1366
+					}
+					{
+						position1369, tokenIndex1369, depth1369 := position, tokenIndex, depth
+						{
+							position1371, tokenIndex1371, depth1371 := position, tokenIndex, depth
+							if !_rules[rulespOpt]() {
+								goto l1372
+							}
+							if !_rules[ruleComparisonOp]() {
+								goto l1372
+							}
+							if !_rules[rulespOpt]() {
+								goto l1372
+							}
+							if !_rules[ruleotherOpExpr]() {
+								goto l1372
+							}
+							goto l1371
+						l1372:
+							position, tokenIndex, depth = position1371, tokenIndex1371, depth1371
+							if !_rules[rulesp]() {
+								goto l1373
+							}
+							if !_rules[rulePatternOp]() {
+								goto l1373
+							}
+							if !_rules[rulesp]() {
+								goto l1373
+							}
+							if !_rules[ruleotherOpExpr]() {
+								goto l1373
+							}
+							goto l1371
+						l1373:
+							position, tokenIndex, depth = position1371, tokenIndex1371, depth1371
+							if !_rules[rulesp]() {
+								goto l1374
+							}
+							if !_rules[ruleInOp]() {
+								goto l1374
+							}
+							if !_rules[rulespOpt]() {
+								goto l1374
+							}
+							if !_rules[ruleInList]() {
+								goto l1374
+							}
+							goto l1371
+						l1374:
+							position, tokenIndex, depth = position1371, tokenIndex1371, depth1371
+							if !_rules[rulesp]() {
+								goto l1369
+							}
+							if !_rules[ruleBetweenOp]() {
+								goto l1369
+							}
+							if !_rules[rulesp]() {
+								goto l1369
+							}
+							if !_rules[ruleotherOpExpr]() {
+								goto l1369
+							}
+							if !_rules[rulesp]() {
+								goto l1369
+							}
+							{
+								position1375, tokenIndex1375, depth1375 := position, tokenIndex, depth
+								if buffer[position] != rune('a') {
+									goto l1376
+								}
+								position++
+								goto l1375
+							l1376:
+								position, tokenIndex, depth = position1375, tokenIndex1375, depth1375
+								if buffer[position] != rune('A') {
+									goto l1369
+								}
+								position++
+							}
+						l1375:
+							{
+								position1377, tokenIndex1377, depth1377 := position, tokenIndex, depth
+								if buffer[position] != rune('n') {
+									goto l1378
+								}
+								position++
+								goto l1377
+							l1378:
+								position, tokenIndex, depth = position1377, tokenIndex1377, depth1377
+								if buffer[position] != rune('N') {
+									goto l1369
+								}
+								position++
+							}
+						l1377:
+							{
+								position1379, tokenIndex1379, depth1379 := position, tokenIndex, depth
+								if buffer[position] != rune('d') {
+									goto l1380
+								}
+								position++
+								goto l1379
+							l1380:
+								position, tokenIndex, depth = position1379, tokenIndex1379, depth1379
+								if buffer[position] != rune('D') {
+									goto l1369
+								}
+								position++
+							}
+						l1379:
+							if !_rules[rulesp]() {
+								goto l1369
+							}
+							if !_rules[ruleotherOpExpr]() {
+								goto l1369
+							}
+						}
+					l1371:
+						goto l1370
+					l1369:
+						position, tokenIndex, depth = position1369, tokenIndex1369, depth1369
+					}
+				l1370:
+					depth--
+					add(rulePegText, position1368)
+				}
+				if !_rules[ruleAction71]() {
+					goto l1366
+				}
+				depth--
+				add(rulecomparisonExpr, position1367)
+			}
+			return true
+		l1366:
+			position, tokenIndex, depth = position1366, tokenIndex1366, depth1366
+			return false
+		},
+		/* 93 InList <- <((<('(' spOpt Expression (spOpt ',' spOpt Expression)* spOpt ')')> Action72) / otherOpExpr)> */
+		func() bool {
+			position1381, tokenIndex1381, depth1381 := position, tokenIndex, depth
+			{
+				position1382 := position
+				depth++
+				{
+					position1383, tokenIndex1383, depth1383 := position, tokenIndex, depth
+					{
+						position1385 := position
+						depth++
+						if buffer[position] != rune('(') {
+							goto l1384
+						}
+						position++
+						if !_rules[rulespOpt]() {
+							goto l1384
+						}
+						if !_rules[ruleExpression]() {
+							goto l1384
+						}
+					l1386:
+						{
+							position1387, tokenIndex1387, depth1387 := position, tokenIndex, depth
+							if !_rules[rulespOpt]() {
+								goto l1387
+							}
+							if buffer[position] != rune(',') {
+								goto l1387
+							}
+							position++
+							if !_rules[rulespOpt]() {
+								goto l1387
+							}
+							if !_rules[ruleExpression]() {
+								goto l1387
+							}
+							goto l1386
+						l1387:
+							position, tokenIndex, depth = position1387, tokenIndex1387, depth1387
+						}
+						if !_rules[rulespOpt]() {
+							goto l1384
+						}
+						if buffer[position] != rune(')') {
+							goto l1384
+						}
+						position++
+						depth--
+						add(rulePegText, position1385)
+					}
+					if !_rules[ruleAction72]() {
+						goto l1384
+					}
+					goto l1383
+				l1384:
+					position, tokenIndex, depth = position1383, tokenIndex1383, depth1383
+					if !_rules[ruleotherOpExpr]() {
+						goto l1381
+					}
+				}
+			l1383:
+				depth--
+				add(ruleInList, position1382)
+			}
+			return true
+		l1381:
+			position, tokenIndex, depth = position1381, tokenIndex1381, depth1381
+			return false
+		},
+		/* 94 otherOpExpr <- <(<(isExpr (spOpt OtherOp spOpt isExpr)*)> Action73)> */
+		func() bool {
+			position1388, tokenIndex1388, depth1388 := position, tokenIndex, depth
+			{
+				position1389 := position
+				depth++
+				{
+					position1390 := position
+					depth++
+					if !_rules[ruleisExpr]() {
+						goto l1388
+					}
+				l1391:
+					{
+						position1392, tokenIndex1392, depth1392 := position, tokenIndex, depth
+						if !_rules[rulespOpt]() {
+							goto l1392
+						}
+						if !_rules[ruleOtherOp]() {
+							goto l1392
+						}
+						if !_rules[rulespOpt]() {
+							goto l1392
+						}
+						if !_rules[ruleisExpr]() {
+							goto l1392
+						}
+						goto l1391
+					l1392:
+						position, tokenIndex, depth = position1392, tokenIndex1392, depth1392
+					}
+					depth--
+					add(rulePegText, position1390)
+				}
+				if !_rules[ruleAction73]() {
+					goto l1388
+				}
+				depth--
+				add(ruleotherOpExpr, position1389)
+			}
+			return true
+		l1388:
+			position, tokenIndex, depth = position1388, tokenIndex1388, depth1388
+			return false
+		},
+		/* 95 isExpr <- <(<((RowValue sp IsOp sp Missing) / (termExpr (sp IsOp sp NullLiteral)?))> Action74)> */
+		func() bool {
+			position1393, tokenIndex1393, depth1393 := position, tokenIndex, depth
+			{
+				position1394 := position
+				depth++
+				{
+					position1395 := position
+					depth++
+					{
+						position1396, tokenIndex1396, depth1396 := position, tokenIndex, depth
+						if !_rules[ruleRowValue]() {
+							goto l1397
+						}
+						if !_rules[rulesp]() {
+							goto l1397
+						}
+						if !_rules[ruleIsOp]() {
+							goto l1397
+						}
+						if !_rules[rulesp]() {
+							goto l1397
+						}
+						if !_rules[ruleMissing]() {
+							goto l1397
+						}
+						goto l1396
+					l1397:
+						position, tokenIndex, depth = position1396, tokenIndex1396, depth1396
+						if !_rules[ruletermExpr]() {
+							goto l1393
+						}
+						{
+							position1398, tokenIndex1398, depth1398 := position, tokenIndex, depth
+							if !_rules[rulesp]() {
+								goto l1398
+							}
+							if !_rules[ruleIsOp]() {
+								goto l1398
+							}
+							if !_rules[rulesp]() {
+								goto l1398
+							}
+							if !_rules[ruleNullLiteral]() {
+								goto l1398
+							}
+							goto l1399
+						l1398:
+							position, tokenIndex, depth = position1398, tokenIndex1398, depth1398
+						}
+					l1399:
+					}
+				l1396:
+					depth--
+					add(rulePegText, position1395)
+				}
+				if !_rules[ruleAction74]() {
+					goto l1393
+				}
+				depth--
+				add(ruleisExpr, position1394)
+			}
+			return true
+		l1393:
+			position, tokenIndex, depth = position1393, tokenIndex1393, depth1393
+			return false
+		},
+		/* 96 termExpr <- <(<(productExpr (spOpt PlusMinusOp spOpt productExpr)*)> Action75)> */
+		func() bool {
+			position1400, tokenIndex1400, depth1400 := position, tokenIndex, depth
+			{
+				position1401 := position
+				depth++
+				{
+					position1402 := position
+					depth++
+					if !_rules[ruleproductExpr]() {
+						goto l1400
+					}
+				l1403:
+					{
+						position1404, tokenIndex1404, depth1404 := position, tokenIndex, depth
+						if !_rules[rulespOpt]() {
+							goto l1404
+						}
+						if !_rules[rulePlusMinusOp]() {
+							goto l1404
+						}
+						if !_rules[rulespOpt]() {
+							goto l1404
+						}
+						if !_rules[ruleproductExpr]() {
+							goto l1404
+						}
+						goto l1403
+					l1404:
+						position, tokenIndex, depth = position1404, tokenIndex1404, depth1404
+					}
+					depth--
+					add(rulePegText, position1402)
+				}
+				if !_rules[ruleAction75]() {
+					goto l1400
+				}
+				depth--
+				add(ruletermExpr, position1401)
+			}
+			return true
+		l1400:
+			position, tokenIndex, depth = position1400, tokenIndex1400, depth1400
+			return false
+		},
+		/* 97 productExpr <- <(<(minusExpr (spOpt MultDivOp spOpt minusExpr)*)> Action76)> */
+		func() bool {
+			position1405, tokenIndex1405, depth1405 := position, tokenIndex, depth
+			{
+				position1406 := position
+				depth++
+				{
+					position1407 := position
+					depth++
+					if !_rules[ruleminusExpr]() {
+						goto l1405
+					}
+				l1408:
+					{
+						position1409, tokenIndex1409, depth1409 := position, tokenIndex, depth
+						if !_rules[rulespOpt]() {
+							goto l1409
+						}
+						if !_rules[ruleMultDivOp]() {
+							goto l1409
+						}
+						if !_rules[rulespOpt]() {
+							goto l1409
+						}
+						if !_rules[ruleminusExpr]() {
+							goto l1409
+						}
+						goto l1408
+					l1409:
+						position, tokenIndex, depth = position1409, tokenIndex1409, depth1409
+					}
+					depth--
+					add(rulePegText, position1407)
+				}
+				if !_rules[ruleAction76]() {
+					goto l1405
+				}
+				depth--
+				add(ruleproductExpr, position1406)
+			}
+			return true
+		l1405:
+			position, tokenIndex, depth = position1405, tokenIndex1405, depth1405
+			return false
+		},
+		/* 98 minusExpr <- <(<((UnaryMinus spOpt)? castExpr)> Action77)> */
+		func() bool {
+			position1410, tokenIndex1410, depth1410 := position, tokenIndex, depth
+			{
+				position1411 := position
+				depth++
+				{
+					position1412 := position
+					depth++
+					{
+						position1413, tokenIndex1413, depth1413 := position, tokenIndex, depth
+						if !_rules[ruleUnaryMinus]() {
+							goto l1413
+						}
+						if !_rules[rulespOpt]() {
+							goto l1413
+						}
+						goto l1414
+					l1413:
+						position, tokenIndex, depth = position1413, tokenIndex1413, depth1413
+					}
+				l1414:
+					if !_rules[rulecastExpr]() {
+						goto l1410
+					}
+					depth--
+					add(rulePegText, position1412)
+				}
+				if !_rules[ruleAction77]() {
+					goto l1410
+				}
+				depth--
+				add(ruleminusExpr, position1411)
+			}
+			return true
+		l1410:
+			position, tokenIndex, depth = position1410, tokenIndex1410, depth1410
+			return false
+		},
+		/* 99 castExpr <- <(<(baseExpr (spOpt (':' ':') spOpt Type)?)> Action78)> */
+		func() bool {
+			position1415, tokenIndex1415, depth1415 := position, tokenIndex, depth
+			{
+				position1416 := position
+				depth++
+				{
+					position1417 := position
+					depth++
+					if !_rules[rulebaseExpr]() {
+						goto l1415
+					}
+					{
+						position1418, tokenIndex1418, depth1418 := position, tokenIndex, depth
+						if !_rules[rulespOpt]() {
+							goto l1418
+						}
+						if buffer[position] != rune(':') {
+							goto l1418
+						}
+						position++
+						if buffer[position] != rune(':') {
+							goto l1418
+						}
+						position++
+						if !_rules[rulespOpt]() {
+							goto l1418
+						}
+						if !_rules[ruleType]() {
+							goto l1418
+						}
+						goto l1419
+					l1418:
+						position, tokenIndex, depth = position1418, tokenIndex1418, depth1418
+					}
+				l1419:
+					depth--
+					add(rulePegText, position1417)
+				}
+				if !_rules[ruleAction78]() {
+					goto l1415
+				}
+				depth--
+				add(rulecastExpr, position1416)
+			}
+			return true
+		l1415:
+			position, tokenIndex, depth = position1415, tokenIndex1415, depth1415
+			return false
+		},
+		/* 100 baseExpr <- <(('(' spOpt Expression spOpt ')') / MapExpr / BooleanLiteral / NullLiteral / Case / IntervalLiteral / RowMeta / FuncTypeCast / Extract / WindowFuncApp / FuncApp / RowValue / ArrayExpr / Literal)> */
+		func() bool {
+			position1420, tokenIndex1420, depth1420 := position, tokenIndex, depth
+			{
+				position1421 := position
+				depth++
+				{
+					position1422, tokenIndex1422, depth1422 := position, tokenIndex, depth
+					if buffer[position] != rune('(') {
+						goto l1423
+					}
+					position++
+					if !_rules[rulespOpt]() {
+						goto l1423
+					}
+					if !_rules[ruleExpression]() {
+						goto l1423
+					}
+					if !_rules[rulespOpt]() {
+						goto l1423
+					}
+					if buffer[position] != rune(')') {
+						goto l1423
+					}
+					position++
+					goto l1422
+				l1423:
+					position, tokenIndex, depth = position1422, tokenIndex1422, depth1422
+					if !_rules[ruleMapExpr]() {
+						goto l1424
+					}
+					goto l1422
+				l1424:
+					position, tokenIndex, depth = position1422, tokenIndex1422, depth1422
+					if !_rules[ruleBooleanLiteral]() {
+						goto l1425
+					}
+					goto l1422
+				l1425:
+					position, tokenIndex, depth = position1422, tokenIndex1422, depth1422
+					if !_rules[ruleNullLiteral]() {
+						goto l1426
+					}
+					goto l1422
+				l1426:
+					position, tokenIndex, depth = position1422, tokenIndex1422, depth1422
+					if !_rules[ruleCase]() {
+						goto l1427
+					}
+					goto l1422
+				l1427:
+					position, tokenIndex, depth = position1422, tokenIndex1422, depth1422
+					if !_rules[ruleIntervalLiteral]() {
+						goto l1428
+					}
+					goto l1422
+				l1428:
+					position, tokenIndex, depth = position1422, tokenIndex1422, depth1422
+					if !_rules[ruleRowMeta]() {
+						goto l1429
+					}
+					goto l1422
+				l1429:
+					position, tokenIndex, depth = position1422, tokenIndex1422, depth1422
+					if !_rules[ruleFuncTypeCast]() {
+						goto l1430
+					}
+					goto l1422
+				l1430:
+					position, tokenIndex, depth = position1422, tokenIndex1422, depth1422
+					if !_rules[ruleExtract]() {
+						goto l1431
+					}
+					goto l1422
+				l1431:
+					position, tokenIndex, depth = position1422, tokenIndex1422, depth1422
+					if !_rules[ruleWindowFuncApp]() {
+						goto l1432
+					}
+					goto l1422
+				l1432:
+					position, tokenIndex, depth = position1422, tokenIndex1422, depth1422
+					if !_rules[ruleFuncApp]() {
+						goto l1433
+					}
+					goto l1422
+				l1433:
+					position, tokenIndex, depth = position1422, tokenIndex1422, depth1422
+					if !_rules[ruleRowValue]() {
+						goto l1434
+					}
+					goto l1422
+				l1434:
+					position, tokenIndex, depth = position1422, tokenIndex1422, depth1422
+					if !_rules[ruleArrayExpr]() {
+						goto l1435
+					}
+					goto l1422
+				l1435:
+					position, tokenIndex, depth = position1422, tokenIndex1422, depth1422
+					if !_rules[ruleLiteral]() {
+						goto l1420
+					}
+				}
+			l1422:
+				depth--
+				add(rulebaseExpr, position1421)
+			}
+			return true
+		l1420:
+			position, tokenIndex, depth = position1420, tokenIndex1420, depth1420
+			return false
+		},
+		/* 101 FuncTypeCast <- <(<(('c' / 'C') ('a' / 'A') ('s' / 'S') ('t' / 'T') spOpt '(' spOpt Expression sp (('a' / 'A') ('s' / 'S')) sp Type spOpt ')')> Action79)> */
+		func() bool {
+			position1436, tokenIndex1436, depth1436 := position, tokenIndex, depth
+			{
+				position1437 := position
+				depth++
+				{
+					position1438 := position
+					depth++
+					{
+						position1439, tokenIndex1439, depth1439 := position, tokenIndex, depth
+						if buffer[position] != rune('c') {
+							goto l1440
+						}
+						position++
+						goto l1439
+					l1440:
+						position, tokenIndex, depth = position1439, tokenIndex1439, depth1439
+						if buffer[position] != rune('C') {
+							goto l1436
+						}
+						position++
+					}
+				l1439:
+					{
+						position1441, tokenIndex1441, depth1441 := position, tokenIndex, depth
+						if buffer[position] != rune('a') {
+							goto l1442
+						}
+						position++
+						goto l1441
+					l1442:
+						position, tokenIndex, depth = position1441, tokenIndex1441, depth1441
+						if buffer[position] != rune('A') {
+							goto l1436
+						}
+						position++
+					}
+				l1441:
+					{
+						position1443, tokenIndex1443, depth1443 := position, tokenIndex, depth
+						if buffer[position] != rune('s') {
+							goto l1444
+						}
+						position++
+						goto l1443
+					l1444:
+						position, tokenIndex, depth = position1443, tokenIndex1443, depth1443
+						if buffer[position] != rune('S') {
+							goto l1436
+						}
+						position++
+					}
+				l1443:
+					{
+						position1445, tokenIndex1445, depth1445 := position, tokenIndex, depth
+						if buffer[position] != rune('t') {
+							goto l1446
+						}
+						position++
+						goto l1445
+					l1446:
+						position, tokenIndex, depth = position1445, tokenIndex1445, depth1445
+						if buffer[position] != rune('T') {
+							goto l1436
+						}
+						position++
+					}
+				l1445:
+					if !_rules[rulespOpt]() {
+						goto l1436
+					}
+					if buffer[position] != rune('(') {
+						goto l1436
+					}
+					position++
+					if !_rules[rulespOpt]() {
+						goto l1436
+					}
+					if !_rules[ruleExpression]() {
+						goto l1436
+					}
+					if !_rules[rulesp]() {
+						goto l1436
+					}
+					{
+						position1447, tokenIndex1447, depth1447 := position, tokenIndex, depth
+						if buffer[position] != rune('a') {
+							goto l1448
+						}
+						position++
+						goto l1447
+					l1448:
+						position, tokenIndex, depth = position1447, tokenIndex1447, depth1447
+						if buffer[position] != rune('A') {
+							goto l1436
+						}
+						position++
+					}
+				l1447:
+					{
+						position1449, tokenIndex1449, depth1449 := position, tokenIndex, depth
+						if buffer[position] != rune('s') {
+							goto l1450
+						}
+						position++
+						goto l1449
+					l1450:
+						position, tokenIndex, depth = position1449, tokenIndex1449, depth1449
+						if buffer[position] != rune('S') {
+							goto l1436
+						}
+						position++
+					}
+				l1449:
+					if !_rules[rulesp]() {
+						goto l1436
+					}
+					if !_rules[ruleType]() {
+						goto l1436
+					}
+					if !_rules[rulespOpt]() {
+						goto l1436
+					}
+					if buffer[position] != rune(')') {
+						goto l1436
+					}
+					position++
+					depth--
+					add(rulePegText, position1438)
+				}
+				if !_rules[ruleAction79]() {
+					goto l1436
+				}
+				depth--
+				add(ruleFuncTypeCast, position1437)
+			}
+			return true
+		l1436:
+			position, tokenIndex, depth = position1436, tokenIndex1436, depth1436
+			return false
+		},
+		/* 102 Extract <- <(('e' / 'E') ('x' / 'X') ('t' / 'T') ('r' / 'R') ('a' / 'A') ('c' / 'C') ('t' / 'T') spOpt '(' spOpt ExtractField sp (('f' / 'F') ('r' / 'R') ('o' / 'O') ('m' / 'M')) sp Expression spOpt ')' Action80)> */
+		func() bool {
+			position1451, tokenIndex1451, depth1451 := position, tokenIndex, depth
+			{
+				position1452 := position
+				depth++
+				{
+					position1453, tokenIndex1453, depth1453 := position, tokenIndex, depth
+					if buffer[position] != rune('e') {
+						goto l1454
+					}
+					position++
+					goto l1453
+				l1454:
+					position, tokenIndex, depth = position1453, tokenIndex1453, depth1453
+					if buffer[position] != rune('E') {
+						goto l1451
+					}
+					position++
+				}
+			l1453:
+				{
+					position1455, tokenIndex1455, depth1455 := position, tokenIndex, depth
+					if buffer[position] != rune('x') {
+						goto l1456
+					}
+					position++
+					goto l1455
+				l1456:
+					position, tokenIndex, depth = position1455, tokenIndex1455, depth1455
+					if buffer[position] != rune('X') {
+						goto l1451
+					}
+					position++
+				}
+			l1455:
+				{
+					position1457, tokenIndex1457, depth1457 := position, tokenIndex, depth
+					if buffer[position] != rune('t') {
+						goto l1458
+					}
+					position++
+					goto l1457
+				l1458:
+					position, tokenIndex, depth = position1457, tokenIndex1457, depth1457
+					if buffer[position] != rune('T') {
+						goto l1451
+					}
+					position++
+				}
+			l1457:
+				{
+					position1459, tokenIndex1459, depth1459 := position, tokenIndex, depth
+					if buffer[position] != rune('r') {
+						goto l1460
+					}
+					position++
+					goto l1459
+				l1460:
+					position, tokenIndex, depth = position1459, tokenIndex1459, depth1459
+					if buffer[position] != rune('R') {
+						goto l1451
+					}
+					position++
+				}
+			l1459:
+				{
+					position1461, tokenIndex1461, depth1461 := position, tokenIndex, depth
+					if buffer[position] != rune('a') {
+						goto l1462
+					}
+					position++
+					goto l1461
+				l1462:
+					position, tokenIndex, depth = position1461, tokenIndex1461, depth1461
+					if buffer[position] != rune('A') {
+						goto l1451
+					}
+					position++
+				}
+			l1461:
+				{
+					position1463, tokenIndex1463, depth1463 := position, tokenIndex, depth
+					if buffer[position] != rune('c') {
+						goto l1464
+					}
+					position++
+					goto l1463
+				l1464:
+					position, tokenIndex, depth = position1463, tokenIndex1463, depth1463
+					if buffer[position] != rune('C') {
+						goto l1451
+					}
+					position++
+				}
+			l1463:
+				{
+					position1465, tokenIndex1465, depth1465 := position, tokenIndex, depth
+					if buffer[position] != rune('t') {
+						goto l1466
+					}
+					position++
+					goto l1465
+				l1466:
+					position, tokenIndex, depth = position1465, tokenIndex1465, depth1465
+					if buffer[position] != rune('T') {
+						goto l1451
+					}
+					position++
+				}
+			l1465:
+				if !_rules[rulespOpt]() {
+					goto l1451
+				}
+				if buffer[position] != rune('(') {
+					goto l1451
+				}
+				position++
+				if !_rules[rulespOpt]() {
+					goto l1451
+				}
+				if !_rules[ruleExtractField]() {
+					goto l1451
+				}
+				if !_rules[rulesp]() {
+					goto l1451
+				}
+				{
+					position1467, tokenIndex1467, depth1467 := position, tokenIndex, depth
+					if buffer[position] != rune('f') {
+						goto l1468
+					}
+					position++
+					goto l1467
+				l1468:
+					position, tokenIndex, depth = position1467, tokenIndex1467, depth1467
+					if buffer[position] != rune('F') {
+						goto l1451
+					}
+					position++
+				}
+			l1467:
+				{
+					position1469, tokenIndex1469, depth1469 := position, tokenIndex, depth
+					if buffer[position] != rune('r') {
+						goto l1470
+					}
+					position++
+					goto l1469
+				l1470:
+					position, tokenIndex, depth = position1469, tokenIndex1469, depth1469
+					if buffer[position] != rune('R') {
+						goto l1451
+					}
+					position++
+				}
+			l1469:
+				{
+					position1471, tokenIndex1471, depth1471 := position, tokenIndex, depth
+					if buffer[position] != rune('o') {
+						goto l1472
+					}
+					position++
+					goto l1471
+				l1472:
+					position, tokenIndex, depth = position1471, tokenIndex1471, depth1471
+					if buffer[position] != rune('O') {
+						goto l1451
+					}
+					position++
+				}
+			l1471:
+				{
+					position1473, tokenIndex1473, depth1473 := position, tokenIndex, depth
+					if buffer[position] != rune('m') {
+						goto l1474
+					}
+					position++
+					goto l1473
+				l1474:
+					position, tokenIndex, depth = position1473, tokenIndex1473, depth1473
+					if buffer[position] != rune('M') {
+						goto l1451
+					}
+					position++
+				}
+			l1473:
+				if !_rules[rulesp]() {
+					goto l1451
+				}
+				if !_rules[ruleExpression]() {
+					goto l1451
+				}
+				if !_rules[rulespOpt]() {
+					goto l1451
+				}
+				if buffer[position] != rune(')') {
+					goto l1451
+				}
+				position++
+				if !_rules[ruleAction80]() {
+					goto l1451
+				}
+				depth--
+				add(ruleExtract, position1452)
+			}
+			return true
+		l1451:
+			position, tokenIndex, depth = position1451, tokenIndex1451, depth1451
+			return false
+		},
+		/* 103 ExtractField <- <(<ident> Action81)> */
+		func() bool {
+			position1475, tokenIndex1475, depth1475 := position, tokenIndex, depth
+			{
+				position1476 := position
+				depth++
+				{
+					position1477 := position
+					depth++
+					if !_rules[ruleident]() {
+						goto l1475
+					}
+					depth--
+					add(rulePegText, position1477)
+				}
+				if !_rules[ruleAction81]() {
+					goto l1475
+				}
+				depth--
+				add(ruleExtractField, position1476)
+			}
+			return true
+		l1475:
+			position, tokenIndex, depth = position1475, tokenIndex1475, depth1475
+			return false
+		},
+		/* 104 FuncApp <- <(FuncAppWithOrderBy / FuncAppWithoutOrderBy)> */
+		func() bool {
+			position1478, tokenIndex1478, depth1478 := position, tokenIndex, depth
+			{
+				position1479 := position
+				depth++
+				{
+					position1480, tokenIndex1480, depth1480 := position, tokenIndex, depth
+					if !_rules[ruleFuncAppWithOrderBy]() {
+						goto l1481
+					}
+					goto l1480
+				l1481:
+					position, tokenIndex, depth = position1480, tokenIndex1480, depth1480
+					if !_rules[ruleFuncAppWithoutOrderBy]() {
+						goto l1478
+					}
+				}
+			l1480:
+				depth--
+				add(ruleFuncApp, position1479)
+			}
+			return true
+		l1478:
+			position, tokenIndex, depth = position1478, tokenIndex1478, depth1478
+			return false
+		},
+		/* 105 WindowFuncApp <- <(FuncApp spOpt (('o' / 'O') ('v' / 'V') ('e' / 'E') ('r' / 'R')) spOpt '(' spOpt WindowPartition WindowOrder spOpt ')' Action82)> */
+		func() bool {
+			position1482, tokenIndex1482, depth1482 := position, tokenIndex, depth
+			{
+				position1483 := position
+				depth++
+				if !_rules[ruleFuncApp]() {
+					goto l1482
+				}
+				if !_rules[rulespOpt]() {
+					goto l1482
+				}
+				{
+					position1484, tokenIndex1484, depth1484 := position, tokenIndex, depth
+					if buffer[position] != rune('o') {
+						goto l1485
+					}
+					position++
+					goto l1484
+				l1485:
+					position, tokenIndex, depth = position1484, tokenIndex1484, depth1484
+					if buffer[position] != rune('O') {
+						goto l1482
+					}
+					position++
+				}
+			l1484:
+				{
+					position1486, tokenIndex1486, depth1486 := position, tokenIndex, depth
+					if buffer[position] != rune('v') {
+						goto l1487
+					}
+					position++
+					goto l1486
+				l1487:
+					position, tokenIndex, depth = position1486, tokenIndex1486, depth1486
+					if buffer[position] != rune('V') {
+						goto l1482
+					}
+					position++
+				}
+			l1486:
+				{
+					position1488, tokenIndex1488, depth1488 := position, tokenIndex, depth
+					if buffer[position] != rune('e') {
+						goto l1489
+					}
+					position++
+					goto l1488
+				l1489:
+					position, tokenIndex, depth = position1488, tokenIndex1488, depth1488
+					if buffer[position] != rune('E') {
+						goto l1482
+					}
+					position++
+				}
+			l1488:
+				{
+					position1490, tokenIndex1490, depth1490 := position, tokenIndex, depth
+					if buffer[position] != rune('r') {
+						goto l1491
+					}
+					position++
+					goto l1490
+				l1491:
+					position, tokenIndex, depth = position1490, tokenIndex1490, depth1490
+					if buffer[position] != rune('R') {
+						goto l1482
+					}
+					position++
+				}
+			l1490:
+				if !_rules[rulespOpt]() {
+					goto l1482
+				}
+				if buffer[position] != rune('(') {
+					goto l1482
+				}
+				position++
+				if !_rules[rulespOpt]() {
+					goto l1482
+				}
+				if !_rules[ruleWindowPartition]() {
+					goto l1482
+				}
+				if !_rules[ruleWindowOrder]() {
+					goto l1482
+				}
+				if !_rules[rulespOpt]() {
+					goto l1482
+				}
+				if buffer[position] != rune(')') {
+					goto l1482
+				}
+				position++
+				if !_rules[ruleAction82]() {
+					goto l1482
+				}
+				depth--
+				add(ruleWindowFuncApp, position1483)
+			}
+			return true
+		l1482:
+			position, tokenIndex, depth = position1482, tokenIndex1482, depth1482
+			return false
+		},
+		/* 106 WindowPartition <- <(<(('p' / 'P') ('a' / 'A') ('r' / 'R') ('t' / 'T') ('i' / 'I') ('t' / 'T') ('i' / 'I') ('o' / 'O') ('n' / 'N') sp (('b' / 'B') ('y' / 'Y')) sp Expression (spOpt ',' spOpt Expression)*)?> Action83)> */
+		func() bool {
+			position1492, tokenIndex1492, depth1492 := position, tokenIndex, depth
+			{
+				position1493 := position
+				depth++
+				{
+					position1494 := position
+					depth++
+					{
+						position1495, tokenIndex1495, depth1495 := position, tokenIndex, depth
+						{
+							position1497, tokenIndex1497, depth1497 := position, tokenIndex, depth
+							if buffer[position] != rune('p') {
+								goto l1498
+							}
+							position++
+							goto l1497
+						l1498:
+							position, tokenIndex, depth = position1497, tokenIndex1497, depth1497
+							if buffer[position] != rune('P') {
+								goto l1495
+							}
+							position++
+						}
+					l1497:
+						{
+							position1499, tokenIndex1499, depth1499 := position, tokenIndex, depth
+							if buffer[position] != rune('a') {
+								goto l1500
+							}
+							position++
+							goto l1499
+						l1500:
+							position, tokenIndex, depth = position1499, tokenIndex1499, depth1499
+							if buffer[position] != rune('A') {
+								goto l1495
+							}
+							position++
+						}
+					l1499:
+						{
+							position1501, tokenIndex1501, depth1501 := position, tokenIndex, depth
+							if buffer[position] != rune('r') {
+								goto l1502
+							}
+							position++
+							goto l1501
+						l1502:
+							position, tokenIndex, depth = position1501, tokenIndex1501, depth1501
+							if buffer[position] != rune('R') {
+								goto l1495
+							}
+							position++
+						}
+					l1501:
+						{
+							position1503, tokenIndex1503, depth1503 := position, tokenIndex, depth
+							if buffer[position] != rune('t') {
+								goto l1504
+							}
+							position++
+							goto l1503
+						l1504:
+							position, tokenIndex, depth = position1503, tokenIndex1503, depth1503
+							if buffer[position] != rune('T') {
+								goto l1495
+							}
+							position++
+						}
+					l1503:
+						{
+							position1505, tokenIndex1505, depth1505 := position, tokenIndex, depth
+							if buffer[position] != rune('i') {
+								goto l1506
+							}
+							position++
+							goto l1505
+						l1506:
+							position, tokenIndex, depth = position1505, tokenIndex1505, depth1505
+							if buffer[position] != rune('I') {
+								goto l1495
+							}
+							position++
+						}
+					l1505:
+						{
+							position1507, tokenIndex1507, depth1507 := position, tokenIndex, depth
+							if buffer[position] != rune('t') {
+								goto l1508
+							}
+							position++
+							goto l1507
+						l1508:
+							position, tokenIndex, depth = position1507, tokenIndex1507, depth1507
+							if buffer[position] != rune('T') {
+								goto l1495
+							}
+							position++
+						}
+					l1507:
+						{
+							position1509, tokenIndex1509, depth1509 := position, tokenIndex, depth
+							if buffer[position] != rune('i') {
+								goto l1510
+							}
+							position++
+							goto l1509
+						l1510:
+							position, tokenIndex, depth = position1509, tokenIndex1509, depth1509
+							if buffer[position] != rune('I') {
+								goto l1495
+							}
+							position++
+						}
+					l1509:
+						{
+							position1511, tokenIndex1511, depth1511 := position, tokenIndex, depth
+							if buffer[position] != rune('o') {
+								goto l1512
+							}
+							position++
+							goto l1511
+						l1512:
+							position, tokenIndex, depth = position1511, tokenIndex1511, depth1511
+							if buffer[position] != rune('O') {
+								goto l1495
+							}
+							position++
+						}
+					l1511:
+						{
+							position1513, tokenIndex1513, depth1513 := position, tokenIndex, depth
+							if buffer[position] != rune('n') {
+								goto l1514
+							}
+							position++
+							goto l1513
+						l1514:
+							position, tokenIndex, depth = position1513, tokenIndex1513, depth1513
+							if buffer[position] != rune('N') {
+								goto l1495
+							}
+							position++
+						}
+					l1513:
+						if !_rules[rulesp]() {
+							goto l1495
+						}
+						{
+							position1515, tokenIndex1515, depth1515 := position, tokenIndex, depth
+							if buffer[position] != rune('b') {
+								goto l1516
+							}
+							position++
+							goto l1515
+						l1516:
+							position, tokenIndex, depth = position1515, tokenIndex1515, depth1515
+							if buffer[position] != rune('B') {
+								goto l1495
+							}
+							position++
+						}
+					l1515:
+						{
+							position1517, tokenIndex1517, depth1517 := position, tokenIndex, depth
+							if buffer[position] != rune('y') {
+								goto l1518
+							}
+							position++
+							goto l1517
+						l1518:
+							position, tokenIndex, depth = position1517, tokenIndex1517, depth1517
+							if buffer[position] != rune('Y') {
+								goto l1495
+							}
+							position++
+						}
+					l1517:
+						if !_rules[rulesp]() {
+							goto l1495
+						}
+						if !_rules[ruleExpression]() {
+							goto l1495
+						}
+					l1519:
+						{
+							position1520, tokenIndex1520, depth1520 := position, tokenIndex, depth
+							if !_rules[rulespOpt]() {
+								goto l1520
+							}
+							if buffer[position] != rune(',') {
+								goto l1520
+							}
+							position++
+							if !_rules[rulespOpt]() {
+								goto l1520
+							}
+							if !_rules[ruleExpression]() {
+								goto l1520
+							}
+							goto l1519
+						l1520:
+							position, tokenIndex, depth = position1520, tokenIndex1520, depth1520
+						}
+						goto l1496
+					l1495:
+						position, tokenIndex, depth = position1495, tokenIndex1495, depth1495
+					}
+				l1496:
+					depth--
+					add(rulePegText, position1494)
+				}
+				if !_rules[ruleAction83]() {
+					goto l1492
+				}
+				depth--
+				add(ruleWindowPartition, position1493)
+			}
+			return true
+		l1492:
+			position, tokenIndex, depth = position1492, tokenIndex1492, depth1492
+			return false
+		},
+		/* 107 WindowOrder <- <(<(spOpt (('o' / 'O') ('r' / 'R') ('d' / 'D') ('e' / 'E') ('r' / 'R')) sp (('b' / 'B') ('y' / 'Y')) sp SortedExpression (spOpt ',' spOpt SortedExpression)*)?> Action84)> */
+		func() bool {
+			position1521, tokenIndex1521, depth1521 := position, tokenIndex, depth
+			{
+				position1522 := position
+				depth++
+				{
+					position1523 := position
+					depth++
+					{
+						position1524, tokenIndex1524, depth1524 := position, tokenIndex, depth
+						if !_rules[rulespOpt]() {
+							goto l1524
+						}
+						{
+							position1526, tokenIndex1526, depth1526 := position, tokenIndex, depth
+							if buffer[position] != rune('o') {
+								goto l1527
+							}
+							position++
+							goto l1526
+						l1527:
+							position, tokenIndex, depth = position1526, tokenIndex1526, depth1526
+							if buffer[position] != rune('O') {
+								goto l1524
+							}
+							position++
+						}
+					l1526:
+						{
+							position1528, tokenIndex1528, depth1528 := position, tokenIndex, depth
+							if buffer[position] != rune('r') {
+								goto l1529
+							}
+							position++
+							goto l1528
+						l1529:
+							position, tokenIndex, depth = position1528, tokenIndex1528, depth1528
+							if buffer[position] != rune('R') {
+								goto l1524
+							}
+							position++
+						}
+					l1528:
+						{
+							position1530, tokenIndex1530, depth1530 := position, tokenIndex, depth
+							if buffer[position] != rune('d') {
+								goto l1531
+							}
+							position++
+							goto l1530
+						l1531:
+							position, tokenIndex, depth = position1530, tokenIndex1530, depth1530
+							if buffer[position] != rune('D') {
+								goto l1524
+							}
+							position++
+						}
+					l1530:
+						{
+							position1532, tokenIndex1532, depth1532 := position, tokenIndex, depth
+							if buffer[position] != rune('e') {
+								goto l1533
+							}
+							position++
+							goto l1532
+						l1533:
+							position, tokenIndex, depth = position1532, tokenIndex1532, depth1532
+							if buffer[position] != rune('E') {
+								goto l1524
+							}
+							position++
+						}
+					l1532:
+						{
+							position1534, tokenIndex1534, depth1534 := position, tokenIndex, depth
+							if buffer[position] != rune('r') {
+								goto l1535
+							}
+							position++
+							goto l1534
+						l1535:
+							position, tokenIndex, depth = position1534, tokenIndex1534, depth1534
+							if buffer[position] != rune('R') {
+								goto l1524
+							}
+							position++
+						}
+					l1534:
+						if !_rules[rulesp]() {
+							goto l1524
+						}
+						{
+							position1536, tokenIndex1536, depth1536 := position, tokenIndex, depth
+							if buffer[position] != rune('b') {
+								goto l1537
+							}
+							position++
+							goto l1536
+						l1537:
+							position, tokenIndex, depth = position1536, tokenIndex1536, depth1536
+							if buffer[position] != rune('B') {
+								goto l1524
+							}
+							position++
+						}
+					l1536:
+						{
+							position1538, tokenIndex1538, depth1538 := position, tokenIndex, depth
+							if buffer[position] != rune('y') {
+								goto l1539
+							}
+							position++
+							goto l1538
+						l1539:
+							position, tokenIndex, depth = position1538, tokenIndex1538, depth1538
+							if buffer[position] != rune('Y') {
+								goto l1524
+							}
+							position++
+						}
+					l1538:
+						if !_rules[rulesp]() {
+							goto l1524
+						}
+						if !_rules[ruleSortedExpression]() {
+							goto l1524
+						}
+					l1540:
+						{
+							position1541, tokenIndex1541, depth1541 := position, tokenIndex, depth
+							if !_rules[rulespOpt]() {
+								goto l1541
+							}
+							if buffer[position] != rune(',') {
+								goto l1541
+							}
+							position++
+							if !_rules[rulespOpt]() {
+								goto l1541
+							}
+							if !_rules[ruleSortedExpression]() {
+								goto l1541
+							}
+							goto l1540
+						l1541:
+							position, tokenIndex, depth = position1541, tokenIndex1541, depth1541
+						}
+						goto l1525
+					l1524:
+						position, tokenIndex, depth = position1524, tokenIndex1524, depth1524
+					}
+				l1525:
+					depth--
+					add(rulePegText, position1523)
+				}
+				if !_rules[ruleAction84]() {
+					goto l1521
+				}
+				depth--
+				add(ruleWindowOrder, position1522)
+			}
+			return true
+		l1521:
+			position, tokenIndex, depth = position1521, tokenIndex1521, depth1521
+			return false
+		},
+		/* 108 FuncAppWithOrderBy <- <(Function spOpt '(' spOpt FuncParams sp ParamsOrder spOpt ')' Action85)> */
+		func() bool {
+			position1542, tokenIndex1542, depth1542 := position, tokenIndex, depth
+			{
+				position1543 := position
+				depth++
+				if !_rules[ruleFunction]() {
+					goto l1542
+				}
+				if !_rules[rulespOpt]() {
+					goto l1542
+				}
+				if buffer[position] != rune('(') {
+					goto l1542
+				}
+				position++
+				if !_rules[rulespOpt]() {
+					goto l1542
+				}
+				if !_rules[ruleFuncParams]() {
+					goto l1542
+				}
+				if !_rules[rulesp]() {
+					goto l1542
+				}
+				if !_rules[ruleParamsOrder]() {
+					goto l1542
+				}
+				if !_rules[rulespOpt]() {
+					goto l1542
+				}
+				if buffer[position] != rune(')') {
+					goto l1542
+				}
+				position++
+				if !_rules[ruleAction85]() {
+					goto l1542
+				}
+				depth--
+				add(ruleFuncAppWithOrderBy, position1543)
+			}
+			return true
+		l1542:
+			position, tokenIndex, depth = position1542, tokenIndex1542, depth1542
+			return false
+		},
+		/* 109 FuncAppWithoutOrderBy <- <(Function spOpt '(' spOpt FuncParams <spOpt> ')' Action86)> */
+		func() bool {
+			position1544, tokenIndex1544, depth1544 := position, tokenIndex, depth
+			{
+				position1545 := position
+				depth++
+				if !_rules[ruleFunction]() {
+					goto l1544
+				}
+				if !_rules[rulespOpt]() {
+					goto l1544
+				}
+				if buffer[position] != rune('(') {
+					goto l1544
+				}
+				position++
+				if !_rules[rulespOpt]() {
+					goto l1544
+				}
+				if !_rules[ruleFuncParams]() {
+					goto l1544
+				}
+				{
+					position1546 := position
+					depth++
+					if !_rules[rulespOpt]() {
+						goto l1544
+					}
+					depth--
+					add(rulePegText, position1546)
+				}
+				if buffer[position] != rune(')') {
+					goto l1544
+				}
+				position++
+				if !_rules[ruleAction86]() {
+					goto l1544
+				}
+				depth--
+				add(ruleFuncAppWithoutOrderBy, position1545)
+			}
+			return true
+		l1544:
+			position, tokenIndex, depth = position1544, tokenIndex1544, depth1544
+			return false
+		},
+		/* 110 FuncParams <- <(<(FuncParam (spOpt ',' spOpt FuncParam)*)?> Action87)> */
+		func() bool {
+			position1547, tokenIndex1547, depth1547 := position, tokenIndex, depth
+			{
+				position1548 := position
+				depth++
+				{
+					position1549 := position
+					depth++
+					{
+						position1550, tokenIndex1550, depth1550 := position, tokenIndex, depth
+						if !_rules[ruleFuncParam]() {
+							goto l1550
+						}
+					l1552:
+						{
+							position1553, tokenIndex1553, depth1553 := position, tokenIndex, depth
+							if !_rules[rulespOpt]() {
+								goto l1553
+							}
+							if buffer[position] != rune(',') {
+								goto l1553
+							}
+							position++
+							if !_rules[rulespOpt]() {
+								goto l1553
+							}
+							if !_rules[ruleFuncParam]() {
+								goto l1553
+							}
+							goto l1552
+						l1553:
+							position, tokenIndex, depth = position1553, tokenIndex1553, depth1553
+						}
+						goto l1551
+					l1550:
+						position, tokenIndex, depth = position1550, tokenIndex1550, depth1550
+					}
+				l1551:
+					depth--
+					add(rulePegText, position1549)
+				}
+				if !_rules[ruleAction87]() {
+					goto l1547
+				}
+				depth--
+				add(ruleFuncParams, position1548)
+			}
+			return true
+		l1547:
+			position, tokenIndex, depth = position1547, tokenIndex1547, depth1547
+			return false
+		},
+		/* 111 FuncParam <- <(Lambda / ExpressionOrWildcard)> */
+		func() bool {
+			position1554, tokenIndex1554, depth1554 := position, tokenIndex, depth
+			{
+				position1555 := position
+				depth++
+				{
+					position1556, tokenIndex1556, depth1556 := position, tokenIndex, depth
+					if !_rules[ruleLambda]() {
+						goto l1557
+					}
+					goto l1556
+				l1557:
+					position, tokenIndex, depth = position1556, tokenIndex1556, depth1556
+					if !_rules[ruleExpressionOrWildcard]() {
+						goto l1554
+					}
+				}
+			l1556:
+				depth--
+				add(ruleFuncParam, position1555)
+			}
+			return true
+		l1554:
+			position, tokenIndex, depth = position1554, tokenIndex1554, depth1554
+			return false
+		},
+		/* 112 Lambda <- <(LambdaParams spOpt ('-' '>') spOpt Expression Action88)> */
+		func() bool {
+			position1558, tokenIndex1558, depth1558 := position, tokenIndex, depth
+			{
+				position1559 := position
+				depth++
+				if !_rules[ruleLambdaParams]() {
+					goto l1558
+				}
+				if !_rules[rulespOpt]() {
+					goto l1558
+				}
+				if buffer[position] != rune('-') {
+					goto l1558
+				}
+				position++
+				if buffer[position] != rune('>') {
+					goto l1558
+				}
+				position++
+				if !_rules[rulespOpt]() {
+					goto l1558
+				}
+				if !_rules[ruleExpression]() {
+					goto l1558
+				}
+				if !_rules[ruleAction88]() {
+					goto l1558
+				}
+				depth--
+				add(ruleLambda, position1559)
+			}
+			return true
+		l1558:
+			position, tokenIndex, depth = position1558, tokenIndex1558, depth1558
+			return false
+		},
+		/* 113 LambdaParams <- <(<(Identifier / ('(' spOpt Identifier (spOpt ',' spOpt Identifier)* spOpt ')'))> Action89)> */
+		func() bool {
+			position1560, tokenIndex1560, depth1560 := position, tokenIndex, depth
+			{
+				position1561 := position
+				depth++
+				{
+					position1562 := position
+					depth++
+					{
+						position1563, tokenIndex1563, depth1563 := position, tokenIndex, depth
+						if !_rules[ruleIdentifier]() {
+							goto l1564
+						}
+						goto l1563
+					l1564:
+						position, tokenIndex, depth = position1563, tokenIndex1563, depth1563
+						if buffer[position] != rune('(') {
+							goto l1560
+						}
+						position++
+						if !_rules[rulespOpt]() {
+							goto l1560
+						}
+						if !_rules[ruleIdentifier]() {
+							goto l1560
+						}
+					l1565:
+						{
+							position1566, tokenIndex1566, depth1566 := position, tokenIndex, depth
+							if !_rules[rulespOpt]() {
+								goto l1566
+							}
+							if buffer[position] != rune(',') {
+								goto l1566
+							}
+							position++
+							if !_rules[rulespOpt]() {
+								goto l1566
+							}
+							if !_rules[ruleIdentifier]() {
+								goto l1566
+							}
+							goto l1565
+						l1566:
+							position, tokenIndex, depth = position1566, tokenIndex1566, depth1566
+						}
+						if !_rules[rulespOpt]() {
+							goto l1560
+						}
+						if buffer[position] != rune(')') {
+							goto l1560
+						}
+						position++
+					}
+				l1563:
+					depth--
+					add(rulePegText, position1562)
+				}
+				if !_rules[ruleAction89]() {
+					goto l1560
+				}
+				depth--
+				add(ruleLambdaParams, position1561)
+			}
+			return true
+		l1560:
+			position, tokenIndex, depth = position1560, tokenIndex1560, depth1560
+			return false
+		},
+		/* 114 ParamsOrder <- <(<(('o' / 'O') ('r' / 'R') ('d' / 'D') ('e' / 'E') ('r' / 'R') sp (('b' / 'B') ('y' / 'Y')) sp SortedExpression (spOpt ',' spOpt SortedExpression)*)> Action90)> */
+		func() bool {
+			position1567, tokenIndex1567, depth1567 := position, tokenIndex, depth
+			{
+				position1568 := position
+				depth++
+				{
+					position1569 := position
+					depth++
+					{
+						position1570, tokenIndex1570, depth1570 := position, tokenIndex, depth
+						if buffer[position] != rune('o') {
+							goto l1571
+						}
+						position++
+						goto l1570
+					l1571:
+						position, tokenIndex, depth = position1570, tokenIndex1570, depth1570
+						if buffer[position] != rune('O') {
+							goto l1567
+						}
+						position++
+					}
+				l1570:
+					{
+						position1572, tokenIndex1572, depth1572 := position, tokenIndex, depth
+						if buffer[position] != rune('r') {
+							goto l1573
+						}
+						position++
+						goto l1572
+					l1573:
+						position, tokenIndex, depth = position1572, tokenIndex1572, depth1572
+						if buffer[position] != rune('R') {
+							goto l1567
+						}
+						position++
+					}
+				l1572:
+					{
+						position1574, tokenIndex1574, depth1574 := position, tokenIndex, depth
+						if buffer[position] != rune('d') {
+							goto l1575
+						}
+						position++
+						goto l1574
+					l1575:
+						position, tokenIndex, depth = position1574, tokenIndex1574, depth1574
+						if buffer[position] != rune('D') {
+							goto l1567
+						}
+						position++
+					}
+				l1574:
+					{
+						position1576, tokenIndex1576, depth1576 := position, tokenIndex, depth
+						if buffer[position] != rune('e') {
+							goto l1577
+						}
+						position++
+						goto l1576
+					l1577:
+						position, tokenIndex, depth = position1576, tokenIndex1576, depth1576
+						if buffer[position] != rune('E') {
+							goto l1567
+						}
+						position++
+					}
+				l1576:
+					{
+						position1578, tokenIndex1578, depth1578 := position, tokenIndex, depth
+						if buffer[position] != rune('r') {
+							goto l1579
+						}
+						position++
+						goto l1578
+					l1579:
+						position, tokenIndex, depth = position1578, tokenIndex1578, depth1578
+						if buffer[position] != rune('R') {
+							goto l1567
+						}
+						position++
+					}
+				l1578:
+					if !_rules[rulesp]() {
+						goto l1567
+					}
+					{
+						position1580, tokenIndex1580, depth1580 := position, tokenIndex, depth
+						if buffer[position] != rune('b') {
+							goto l1581
+						}
+						position++
+						goto l1580
+					l1581:
+						position, tokenIndex, depth = position1580, tokenIndex1580, depth1580
+						if buffer[position] != rune('B') {
+							goto l1567
+						}
+						position++
+					}
+				l1580:
+					{
+						position1582, tokenIndex1582, depth1582 := position, tokenIndex, depth
+						if buffer[position] != rune('y') {
+							goto l1583
+						}
+						position++
+						goto l1582
+					l1583:
+						position, tokenIndex, depth = position1582, tokenIndex1582, depth1582
+						if buffer[position] != rune('Y') {
+							goto l1567
+						}
+						position++
+					}
+				l1582:
+					if !_rules[rulesp]() {
+						goto l1567
+					}
+					if !_rules[ruleSortedExpression]() {
+						goto l1567
+					}
+				l1584:
+					{
+						position1585, tokenIndex1585, depth1585 := position, tokenIndex, depth
+						if !_rules[rulespOpt]() {
+							goto l1585
+						}
+						if buffer[position] != rune(',') {
+							goto l1585
+						}
+						position++
+						if !_rules[rulespOpt]() {
+							goto l1585
+						}
+						if !_rules[ruleSortedExpression]() {
+							goto l1585
+						}
+						goto l1584
+					l1585:
+						position, tokenIndex, depth = position1585, tokenIndex1585, depth1585
+					}
+					depth--
+					add(rulePegText, position1569)
+				}
+				if !_rules[ruleAction90]() {
+					goto l1567
+				}
+				depth--
+				add(ruleParamsOrder, position1568)
+			}
+			return true
+		l1567:
+			position, tokenIndex, depth = position1567, tokenIndex1567, depth1567
+			return false
+		},
+		/* 115 SortedExpression <- <(Expression OrderDirectionOpt Action91)> */
+		func() bool {
+			position1586, tokenIndex1586, depth1586 := position, tokenIndex, depth
+			{
+				position1587 := position
+				depth++
+				if !_rules[ruleExpression]() {
+					goto l1586
+				}
+				if !_rules[ruleOrderDirectionOpt]() {
+					goto l1586
+				}
+				if !_rules[ruleAction91]() {
+					goto l1586
+				}
+				depth--
+				add(ruleSortedExpression, position1587)
+			}
+			return true
+		l1586:
+			position, tokenIndex, depth = position1586, tokenIndex1586, depth1586
+			return false
+		},
+		/* 116 OrderDirectionOpt <- <(<(sp (Ascending / Descending))?> Action92)> */
+		func() bool {
+			position1588, tokenIndex1588, depth1588 := position, tokenIndex, depth
+			{
+				position1589 := position
+				depth++
+				{
+					position1590 := position
+					depth++
+					{
+						position1591, tokenIndex1591, depth1591 := position, tokenIndex, depth
+						if !_rules[rulesp]() {
+							goto l1591
+						}
+						{
+							position1593, tokenIndex1593, depth1593 := position, tokenIndex, depth
+							if !_rules[ruleAscending]() {
+								goto l1594
+							}
+							goto l1593
+						l1594:
+							position, tokenIndex, depth = position1593, tokenIndex1593, depth1593
+							if !_rules[ruleDescending]() {
+								goto l1591
+							}
+						}
+					l1593:
+						goto l1592
+					l1591:
+						position, tokenIndex, depth = position1591, tokenIndex1591, depth1591
+					}
+				l1592:
+					depth--
+					add(rulePegText, position1590)
+				}
+				if !_rules[ruleAction92]() {
+					goto l1588
+				}
+				depth--
+				add(ruleOrderDirectionOpt, position1589)
+			}
+			return true
+		l1588:
+			position, tokenIndex, depth = position1588, tokenIndex1588, depth1588
+			return false
+		},
+		/* 117 ArrayExpr <- <(<('[' spOpt (ExpressionOrWildcard (spOpt ',' spOpt ExpressionOrWildcard)*)? spOpt ','? spOpt ']')> Action93)> */
+		func() bool {
+			position1595, tokenIndex1595, depth1595 := position, tokenIndex, depth
+			{
+				position1596 := position
+				depth++
+				{
+					position1597 := position
+					depth++
+					if buffer[position] != rune('[') {
+						goto l1595
+					}
+					position++
+					if !_rules[rulespOpt]() {
+						goto l1595
+					}
+					{
+						position1598, tokenIndex1598, depth1598 := position, tokenIndex, depth
+						if !_rules[ruleExpressionOrWildcard]() {
+							goto l1598
+						}
+					l1600:
+						{
+							position1601, tokenIndex1601, depth1601 := position, tokenIndex, depth
+							if !_rules[rulespOpt]() {
+								goto l1601
+							}
+							if buffer[position] != rune(',') {
+								goto l1601
+							}
+							position++
+							if !_rules[rulespOpt]() {
+								goto l1601
+							}
+							if !_rules[ruleExpressionOrWildcard]() {
+								goto l1601
+							}
+							goto l1600
+						l1601:
+							position, tokenIndex, depth = position1601, tokenIndex1601, depth1601
+						}
+						goto l1599
+					l1598:
+						position, tokenIndex, depth = position1598, tokenIndex1598, depth1598
+					}
+				l1599:
+					if !_rules[rulespOpt]() {
+						goto l1595
+					}
+					{
+						position1602, tokenIndex1602, depth1602 := position, tokenIndex, depth
+						if buffer[position] != rune(',') {
+							goto l1602
+						}
+						position++
+						goto l1603
+					l1602:
+						position, tokenIndex, depth = position1602, tokenIndex1602, depth1602
+					}
+				l1603:
+					if !_rules[rulespOpt]() {
+						goto l1595
+					}
+					if buffer[position] != rune(']') {
+						goto l1595
+					}
+					position++
+					depth--
+					add(rulePegText, position1597)
+				}
+				if !_rules[ruleAction93]() {
+					goto l1595
+				}
+				depth--
+				add(ruleArrayExpr, position1596)
+			}
+			return true
+		l1595:
+			position, tokenIndex, depth = position1595, tokenIndex1595, depth1595
+			return false
+		},
+		/* 118 MapExpr <- <(<('{' spOpt (KeyValuePair (spOpt ',' spOpt KeyValuePair)*)? spOpt '}')> Action94)> */
+		func() bool {
+			position1604, tokenIndex1604, depth1604 := position, tokenIndex, depth
+			{
+				position1605 := position
+				depth++
+				{
+					position1606 := position
+					depth++
+					if buffer[position] != rune('{') {
+						goto l1604
+					}
+					position++
+					if !_rules[rulespOpt]() {
+						goto l1604
+					}
+					{
+						position1607, tokenIndex1607, depth1607 := position, tokenIndex, depth
+						if !_rules[ruleKeyValuePair]() {
+							goto l1607
+						}
+					l1609:
+						{
+							position1610, tokenIndex1610, depth1610 := position, tokenIndex, depth
+							if !_rules[rulespOpt]() {
+								goto l1610
+							}
+							if buffer[position] != rune(',') {
+								goto l1610
+							}
+							position++
+							if !_rules[rulespOpt]() {
+								goto l1610
+							}
+							if !_rules[ruleKeyValuePair]() {
+								goto l1610
+							}
+							goto l1609
+						l1610:
+							position, tokenIndex, depth = position1610, tokenIndex1610, depth1610
+						}
+						goto l1608
+					l1607:
+						position, tokenIndex, depth = position1607, tokenIndex1607, depth1607
+					}
+				l1608:
+					if !_rules[rulespOpt]() {
+						goto l1604
+					}
+					if buffer[position] != rune('}') {
+						goto l1604
+					}
+					position++
+					depth--
+					add(rulePegText, position1606)
+				}
+				if !_rules[ruleAction94]() {
+					goto l1604
+				}
+				depth--
+				add(ruleMapExpr, position1605)
+			}
+			return true
+		l1604:
+			position, tokenIndex, depth = position1604, tokenIndex1604, depth1604
+			return false
+		},
+		/* 119 KeyValuePair <- <(<(StringLiteral spOpt ':' spOpt ExpressionOrWildcard)> Action95)> */
+		func() bool {
+			position1611, tokenIndex1611, depth1611 := position, tokenIndex, depth
+			{
+				position1612 := position
+				depth++
+				{
+					position1613 := position
+					depth++
+					if !_rules[ruleStringLiteral]() {
+						goto l1611
+					}
+					if !_rules[rulespOpt]() {
+						goto l1611
+					}
+					if buffer[position] != rune(':') {
+						goto l1611
+					}
+					position++
+					if !_rules[rulespOpt]() {
+						goto l1611
+					}
+					if !_rules[ruleExpressionOrWildcard]() {
+						goto l1611
+					}
+					depth--
+					add(rulePegText, position1613)
+				}
+				if !_rules[ruleAction95]() {
+					goto l1611
+				}
+				depth--
+				add(ruleKeyValuePair, position1612)
+			}
+			return true
+		l1611:
+			position, tokenIndex, depth = position1611, tokenIndex1611, depth1611
+			return false
+		},
+		/* 120 Case <- <(ConditionCase / ExpressionCase)> */
+		func() bool {
+			position1614, tokenIndex1614, depth1614 := position, tokenIndex, depth
+			{
+				position1615 := position
+				depth++
+				{
+					position1616, tokenIndex1616, depth1616 := position, tokenIndex, depth
+					if !_rules[ruleConditionCase]() {
+						goto l1617
+					}
+					goto l1616
+				l1617:
+					position, tokenIndex, depth = position1616, tokenIndex1616, depth1616
+					if !_rules[ruleExpressionCase]() {
+						goto l1614
+					}
+				}
+			l1616:
+				depth--
+				add(ruleCase, position1615)
+			}
+			return true
+		l1614:
+			position, tokenIndex, depth = position1614, tokenIndex1614, depth1614
+			return false
+		},
+		/* 121 ConditionCase <- <(('c' / 'C') ('a' / 'A') ('s' / 'S') ('e' / 'E') <((sp WhenThenPair)+ (sp (('e' / 'E') ('l' / 'L') ('s' / 'S') ('e' / 'E')) sp Expression)? sp (('e' / 'E') ('n' / 'N') ('d' / 'D')))> Action96)> */
+		func() bool {
+			position1618, tokenIndex1618, depth1618 := position, tokenIndex, depth
+			{
+				position1619 := position
+				depth++
+				{
+					position1620, tokenIndex1620, depth1620 := position, tokenIndex, depth
+					if buffer[position] != rune('c') {
+						goto l1621
+					}
+					position++
+					goto l1620
+				l1621:
+					position, tokenIndex, depth = position1620, tokenIndex1620, depth1620
+					if buffer[position] != rune('C') {
+						goto l1618
+					}
+					position++
+				}
+			l1620:
+				{
+					position1622, tokenIndex1622, depth1622 := position, tokenIndex, depth
+					if buffer[position] != rune('a') {
+						goto l1623
+					}
+					position++
+					goto l1622
+				l1623:
+					position, tokenIndex, depth = position1622, tokenIndex1622, depth1622
+					if buffer[position] != rune('A') {
+						goto l1618
+					}
+					position++
+				}
+			l1622:
+				{
+					position1624, tokenIndex1624, depth1624 := position, tokenIndex, depth
+					if buffer[position] != rune('s') {
+						goto l1625
+					}
+					position++
+					goto l1624
+				l1625:
+					position, tokenIndex, depth = position1624, tokenIndex1624, depth1624
+					if buffer[position] != rune('S') {
+						goto l1618
+					}
+					position++
+				}
+			l1624:
+				{
+					position1626, tokenIndex1626, depth1626 := position, tokenIndex, depth
+					if buffer[position] != rune('e') {
+						goto l1627
+					}
+					position++
+					goto l1626
+				l1627:
+					position, tokenIndex, depth = position1626, tokenIndex1626, depth1626
+					if buffer[position] != rune('E') {
+						goto l1618
+					}
+					position++
+				}
+			l1626:
+				{
+					position1628 := position
+					depth++
+					if !_rules[rulesp]() {
+						goto l1618
+					}
+					if !_rules[ruleWhenThenPair]() {
+						goto l1618
+					}
+				l1629:
+					{
+						position1630, tokenIndex1630, depth1630 := position, tokenIndex, depth
+						if !_rules[rulesp]() {
+							goto l1630
+						}
+						if !_rules[ruleWhenThenPair]() {
+							goto l1630
+						}
+						goto l1629
+					l1630:
+						position, tokenIndex, depth = position1630, tokenIndex1630, depth1630
+					}
+					{
+						position1631, tokenIndex1631, depth1631 := position, tokenIndex, depth
+						if !_rules[rulesp]() {
+							goto l1631
+						}
+						{
+							position1633, tokenIndex1633, depth1633 := position, tokenIndex, depth
+							if buffer[position] != rune('e') {
+								goto l1634
+							}
+							position++
+							goto l1633
+						l1634:
+							position, tokenIndex, depth = position1633, tokenIndex1633, depth1633
+							if buffer[position] != rune('E') {
+								goto l1631
+							}
+							position++
+						}
+					l1633:
+						{
+							position1635, tokenIndex1635, depth1635 := position, tokenIndex, depth
+							if buffer[position] != rune('l') {
+								goto l1636
+							}
+							position++
+							goto l1635
+						l1636:
+							position, tokenIndex, depth = position1635, tokenIndex1635, depth1635
+							if buffer[position] != rune('L') {
+								goto l1631
+							}
+							position++
+						}
+					l1635:
+						{
+							position1637, tokenIndex1637, depth1637 := position, tokenIndex, depth
+							if buffer[position] != rune('s') {
+								goto l1638
+							}
+							position++
+							goto l1637
+						l1638:
+							position, tokenIndex, depth = position1637, tokenIndex1637, depth1637
+							if buffer[position] != rune('S') {
+								goto l1631
+							}
+							position++
+						}
+					l1637:
+						{
+							position1639, tokenIndex1639, depth1639 := position, tokenIndex, depth
+							if buffer[position] != rune('e') {
+								goto l1640
+							}
+							position++
+							goto l1639
+						l1640:
+							position, tokenIndex, depth = position1639, tokenIndex1639, depth1639
+							if buffer[position] != rune('E') {
+								goto l1631
+							}
+							position++
+						}
+					l1639:
+						if !_rules[rulesp]() {
+							goto l1631
+						}
+						if !_rules[ruleExpression]() {
+							goto l1631
+						}
+						goto l1632
+					l1631:
+						position, tokenIndex, depth = position1631, tokenIndex1631, depth1631
+					}
+				l1632:
+					if !_rules[rulesp]() {
+						goto l1618
+					}
+					{
+						position1641, tokenIndex1641, depth1641 := position, tokenIndex, depth
+						if buffer[position] != rune('e') {
+							goto l1642
+						}
+						position++
+						goto l1641
+					l1642:
+						position, tokenIndex, depth = position1641, tokenIndex1641, depth1641
+						if buffer[position] != rune('E') {
+							goto l1618
 						}
 						position++
 					}
-				l1136:
-					if !_rules[rulespOpt]() {
-						goto l1127
+				l1641:
+					{
+						position1643, tokenIndex1643, depth1643 := position, tokenIndex, depth
+						if buffer[position] != rune('n') {
+							goto l1644
+						}
+						position++
+						goto l1643
+					l1644:
+						position, tokenIndex, depth = position1643, tokenIndex1643, depth1643
+						if buffer[position] != rune('N') {
+							goto l1618
+						}
+						position++
 					}
-					if buffer[position] != rune('(') {
-						goto l1127
+				l1643:
+					{
+						position1645, tokenIndex1645, depth1645 := position, tokenIndex, depth
+						if buffer[position] != rune('d') {
+							goto l1646
+						}
+						position++
+						goto l1645
+					l1646:
+						position, tokenIndex, depth = position1645, tokenIndex1645, depth1645
+						if buffer[position] != rune('D') {
+							goto l1618
+						}
+						position++
+					}
+				l1645:
+					depth--
+					add(rulePegText, position1628)
+				}
+				if !_rules[ruleAction96]() {
+					goto l1618
+				}
+				depth--
+				add(ruleConditionCase, position1619)
+			}
+			return true
+		l1618:
+			position, tokenIndex, depth = position1618, tokenIndex1618, depth1618
+			return false
+		},
+		/* 122 ExpressionCase <- <(('c' / 'C') ('a' / 'A') ('s' / 'S') ('e' / 'E') sp Expression <((sp WhenThenPair)+ (sp (('e' / 'E') ('l' / 'L') ('s' / 'S') ('e' / 'E')) sp Expression)? sp (('e' / 'E') ('n' / 'N') ('d' / 'D')))> Action97)> */
+		func() bool {
+			position1647, tokenIndex1647, depth1647 := position, tokenIndex, depth
+			{
+				position1648 := position
+				depth++
+				{
+					position1649, tokenIndex1649, depth1649 := position, tokenIndex, depth
+					if buffer[position] != rune('c') {
+						goto l1650
+					}
+					position++
+					goto l1649
+				l1650:
+					position, tokenIndex, depth = position1649, tokenIndex1649, depth1649
+					if buffer[position] != rune('C') {
+						goto l1647
+					}
+					position++
+				}
+			l1649:
+				{
+					position1651, tokenIndex1651, depth1651 := position, tokenIndex, depth
+					if buffer[position] != rune('a') {
+						goto l1652
+					}
+					position++
+					goto l1651
+				l1652:
+					position, tokenIndex, depth = position1651, tokenIndex1651, depth1651
+					if buffer[position] != rune('A') {
+						goto l1647
+					}
+					position++
+				}
+			l1651:
+				{
+					position1653, tokenIndex1653, depth1653 := position, tokenIndex, depth
+					if buffer[position] != rune('s') {
+						goto l1654
+					}
+					position++
+					goto l1653
+				l1654:
+					position, tokenIndex, depth = position1653, tokenIndex1653, depth1653
+					if buffer[position] != rune('S') {
+						goto l1647
+					}
+					position++
+				}
+			l1653:
+				{
+					position1655, tokenIndex1655, depth1655 := position, tokenIndex, depth
+					if buffer[position] != rune('e') {
+						goto l1656
+					}
+					position++
+					goto l1655
+				l1656:
+					position, tokenIndex, depth = position1655, tokenIndex1655, depth1655
+					if buffer[position] != rune('E') {
+						goto l1647
+					}
+					position++
+				}
+			l1655:
+				if !_rules[rulesp]() {
+					goto l1647
+				}
+				if !_rules[ruleExpression]() {
+					goto l1647
+				}
+				{
+					position1657 := position
+					depth++
+					if !_rules[rulesp]() {
+						goto l1647
+					}
+					if !_rules[ruleWhenThenPair]() {
+						goto l1647
+					}
+				l1658:
+					{
+						position1659, tokenIndex1659, depth1659 := position, tokenIndex, depth
+						if !_rules[rulesp]() {
+							goto l1659
+						}
+						if !_rules[ruleWhenThenPair]() {
+							goto l1659
+						}
+						goto l1658
+					l1659:
+						position, tokenIndex, depth = position1659, tokenIndex1659, depth1659
+					}
+					{
+						position1660, tokenIndex1660, depth1660 := position, tokenIndex, depth
+						if !_rules[rulesp]() {
+							goto l1660
+						}
+						{
+							position1662, tokenIndex1662, depth1662 := position, tokenIndex, depth
+							if buffer[position] != rune('e') {
+								goto l1663
+							}
+							position++
+							goto l1662
+						l1663:
+							position, tokenIndex, depth = position1662, tokenIndex1662, depth1662
+							if buffer[position] != rune('E') {
+								goto l1660
+							}
+							position++
+						}
+					l1662:
+						{
+							position1664, tokenIndex1664, depth1664 := position, tokenIndex, depth
+							if buffer[position] != rune('l') {
+								goto l1665
+							}
+							position++
+							goto l1664
+						l1665:
+							position, tokenIndex, depth = position1664, tokenIndex1664, depth1664
+							if buffer[position] != rune('L') {
+								goto l1660
+							}
+							position++
+						}
+					l1664:
+						{
+							position1666, tokenIndex1666, depth1666 := position, tokenIndex, depth
+							if buffer[position] != rune('s') {
+								goto l1667
+							}
+							position++
+							goto l1666
+						l1667:
+							position, tokenIndex, depth = position1666, tokenIndex1666, depth1666
+							if buffer[position] != rune('S') {
+								goto l1660
+							}
+							position++
+						}
+					l1666:
+						{
+							position1668, tokenIndex1668, depth1668 := position, tokenIndex, depth
+							if buffer[position] != rune('e') {
+								goto l1669
+							}
+							position++
+							goto l1668
+						l1669:
+							position, tokenIndex, depth = position1668, tokenIndex1668, depth1668
+							if buffer[position] != rune('E') {
+								goto l1660
+							}
+							position++
+						}
+					l1668:
+						if !_rules[rulesp]() {
+							goto l1660
+						}
+						if !_rules[ruleExpression]() {
+							goto l1660
+						}
+						goto l1661
+					l1660:
+						position, tokenIndex, depth = position1660, tokenIndex1660, depth1660
+					}
+				l1661:
+					if !_rules[rulesp]() {
+						goto l1647
+					}
+					{
+						position1670, tokenIndex1670, depth1670 := position, tokenIndex, depth
+						if buffer[position] != rune('e') {
+							goto l1671
+						}
+						position++
+						goto l1670
+					l1671:
+						position, tokenIndex, depth = position1670, tokenIndex1670, depth1670
+						if buffer[position] != rune('E') {
+							goto l1647
+						}
+						position++
+					}
+				l1670:
+					{
+						position1672, tokenIndex1672, depth1672 := position, tokenIndex, depth
+						if buffer[position] != rune('n') {
+							goto l1673
+						}
+						position++
+						goto l1672
+					l1673:
+						position, tokenIndex, depth = position1672, tokenIndex1672, depth1672
+						if buffer[position] != rune('N') {
+							goto l1647
+						}
+						position++
+					}
+				l1672:
+					{
+						position1674, tokenIndex1674, depth1674 := position, tokenIndex, depth
+						if buffer[position] != rune('d') {
+							goto l1675
+						}
+						position++
+						goto l1674
+					l1675:
+						position, tokenIndex, depth = position1674, tokenIndex1674, depth1674
+						if buffer[position] != rune('D') {
+							goto l1647
+						}
+						position++
+					}
+				l1674:
+					depth--
+					add(rulePegText, position1657)
+				}
+				if !_rules[ruleAction97]() {
+					goto l1647
+				}
+				depth--
+				add(ruleExpressionCase, position1648)
+			}
+			return true
+		l1647:
+			position, tokenIndex, depth = position1647, tokenIndex1647, depth1647
+			return false
+		},
+		/* 123 WhenThenPair <- <(('w' / 'W') ('h' / 'H') ('e' / 'E') ('n' / 'N') sp Expression sp (('t' / 'T') ('h' / 'H') ('e' / 'E') ('n' / 'N')) sp ExpressionOrWildcard Action98)> */
+		func() bool {
+			position1676, tokenIndex1676, depth1676 := position, tokenIndex, depth
+			{
+				position1677 := position
+				depth++
+				{
+					position1678, tokenIndex1678, depth1678 := position, tokenIndex, depth
+					if buffer[position] != rune('w') {
+						goto l1679
+					}
+					position++
+					goto l1678
+				l1679:
+					position, tokenIndex, depth = position1678, tokenIndex1678, depth1678
+					if buffer[position] != rune('W') {
+						goto l1676
+					}
+					position++
+				}
+			l1678:
+				{
+					position1680, tokenIndex1680, depth1680 := position, tokenIndex, depth
+					if buffer[position] != rune('h') {
+						goto l1681
+					}
+					position++
+					goto l1680
+				l1681:
+					position, tokenIndex, depth = position1680, tokenIndex1680, depth1680
+					if buffer[position] != rune('H') {
+						goto l1676
+					}
+					position++
+				}
+			l1680:
+				{
+					position1682, tokenIndex1682, depth1682 := position, tokenIndex, depth
+					if buffer[position] != rune('e') {
+						goto l1683
+					}
+					position++
+					goto l1682
+				l1683:
+					position, tokenIndex, depth = position1682, tokenIndex1682, depth1682
+					if buffer[position] != rune('E') {
+						goto l1676
+					}
+					position++
+				}
+			l1682:
+				{
+					position1684, tokenIndex1684, depth1684 := position, tokenIndex, depth
+					if buffer[position] != rune('n') {
+						goto l1685
+					}
+					position++
+					goto l1684
+				l1685:
+					position, tokenIndex, depth = position1684, tokenIndex1684, depth1684
+					if buffer[position] != rune('N') {
+						goto l1676
+					}
+					position++
+				}
+			l1684:
+				if !_rules[rulesp]() {
+					goto l1676
+				}
+				if !_rules[ruleExpression]() {
+					goto l1676
+				}
+				if !_rules[rulesp]() {
+					goto l1676
+				}
+				{
+					position1686, tokenIndex1686, depth1686 := position, tokenIndex, depth
+					if buffer[position] != rune('t') {
+						goto l1687
 					}
 					position++
-					if !_rules[rulespOpt]() {
-						goto l1127
-					}
-					if !_rules[ruleExpression]() {
-						goto l1127
-					}
-					if !_rules[rulesp]() {
-						goto l1127
+					goto l1686
+				l1687:
+					position, tokenIndex, depth = position1686, tokenIndex1686, depth1686
+					if buffer[position] != rune('T') {
+						goto l1676
 					}
-					{
-						position1138, tokenIndex1138, depth1138 := position, tokenIndex, depth
-						if buffer[position] != rune('a') {
-							goto l1139
-						}
-						position++
-						goto l1138
-					l1139:
-						position, tokenIndex, depth = position1138, tokenIndex1138, depth1138
-						if buffer[position] != rune('A') {
-							goto l1127
-						}
-						position++
+					position++
+				}
+			l1686:
+				{
+					position1688, tokenIndex1688, depth1688 := position, tokenIndex, depth
+					if buffer[position] != rune('h') {
+						goto l1689
 					}
-				l1138:
-					{
-						position1140, tokenIndex1140, depth1140 := position, tokenIndex, depth
-						if buffer[position] != rune('s') {
-							goto l1141
-						}
-						position++
-						goto l1140
-					l1141:
-						position, tokenIndex, depth = position1140, tokenIndex1140, depth1140
-						if buffer[position] != rune('S') {
-							goto l1127
-						}
-						position++
+					position++
+					goto l1688
+				l1689:
+					position, tokenIndex, depth = position1688, tokenIndex1688, depth1688
+					if buffer[position] != rune('H') {
+						goto l1676
 					}
-				l1140:
-					if !_rules[rulesp]() {
-						goto l1127
+					position++
+				}
+			l1688:
+				{
+					position1690, tokenIndex1690, depth1690 := position, tokenIndex, depth
+					if buffer[position] != rune('e') {
+						goto l1691
 					}
-					if !_rules[ruleType]() {
-						goto l1127
+					position++
+					goto l1690
+				l1691:
+					position, tokenIndex, depth = position1690, tokenIndex1690, depth1690
+					if buffer[position] != rune('E') {
+						goto l1676
 					}
-					if !_rules[rulespOpt]() {
-						goto l1127
+					position++
+				}
+			l1690:
+				{
+					position1692, tokenIndex1692, depth1692 := position, tokenIndex, depth
+					if buffer[position] != rune('n') {
+						goto l1693
 					}
-					if buffer[position] != rune(')') {
-						goto l1127
+					position++
+					goto l1692
+				l1693:
+					position, tokenIndex, depth = position1692, tokenIndex1692, depth1692
+					if buffer[position] != rune('N') {
+						goto l1676
 					}
 					position++
-					depth--
-					add(rulePegText, position1129)
 				}
-				if !_rules[ruleAction64]() {
-					goto l1127
+			l1692:
+				if !_rules[rulesp]() {
+					goto l1676
+				}
+				if !_rules[ruleExpressionOrWildcard]() {
+					goto l1676
+				}
+				if !_rules[ruleAction98]() {
+					goto l1676
 				}
 				depth--
-				add(ruleFuncTypeCast, position1128)
+				add(ruleWhenThenPair, position1677)
 			}
 			return true
-		l1127:
-			position, tokenIndex, depth = position1127, tokenIndex1127, depth1127
+		l1676:
+			position, tokenIndex, depth = position1676, tokenIndex1676, depth1676
 			return false
 		},
-		/* 85 FuncApp <- <(FuncAppWithOrderBy / FuncAppWithoutOrderBy)> */
+		/* 124 Literal <- <(FloatLiteral / NumericLiteral / StringLiteral)> */
 		func() bool {
-			position1142, tokenIndex1142, depth1142 := position, tokenIndex, depth
+			position1694, tokenIndex1694, depth1694 := position, tokenIndex, depth
 			{
-				position1143 := position
+				position1695 := position
 				depth++
 				{
-					position1144, tokenIndex1144, depth1144 := position, tokenIndex, depth
-					if !_rules[ruleFuncAppWithOrderBy]() {
-						goto l1145
+					position1696, tokenIndex1696, depth1696 := position, tokenIndex, depth
+					if !_rules[ruleFloatLiteral]() {
+						goto l1697
 					}
-					goto l1144
-				l1145:
-					position, tokenIndex, depth = position1144, tokenIndex1144, depth1144
-					if !_rules[ruleFuncAppWithoutOrderBy]() {
-						goto l1142
+					goto l1696
+				l1697:
+					position, tokenIndex, depth = position1696, tokenIndex1696, depth1696
+					if !_rules[ruleNumericLiteral]() {
+						goto l1698
+					}
+					goto l1696
+				l1698:
+					position, tokenIndex, depth = position1696, tokenIndex1696, depth1696
+					if !_rules[ruleStringLiteral]() {
+						goto l1694
 					}
 				}
-			l1144:
+			l1696:
 				depth--
-				add(ruleFuncApp, position1143)
+				add(ruleLiteral, position1695)
 			}
 			return true
-		l1142:
-			position, tokenIndex, depth = position1142, tokenIndex1142, depth1142
+		l1694:
+			position, tokenIndex, depth = position1694, tokenIndex1694, depth1694
 			return false
 		},
-		/* 86 FuncAppWithOrderBy <- <(Function spOpt '(' spOpt FuncParams sp ParamsOrder spOpt ')' Action65)> */
+		/* 125 ComparisonOp <- <(Equal / NotEqual / LessOrEqual / Less / GreaterOrEqual / Greater / NotEqual / RegexpMatch / NotRegexpMatch)> */
 		func() bool {
-			position1146, tokenIndex1146, depth1146 := position, tokenIndex, depth
+			position1699, tokenIndex1699, depth1699 := position, tokenIndex, depth
 			{
-				position1147 := position
+				position1700 := position
 				depth++
-				if !_rules[ruleFunction]() {
-					goto l1146
-				}
-				if !_rules[rulespOpt]() {
-					goto l1146
-				}
-				if buffer[position] != rune('(') {
-					goto l1146
-				}
-				position++
-				if !_rules[rulespOpt]() {
-					goto l1146
-				}
-				if !_rules[ruleFuncParams]() {
-					goto l1146
+				{
+					position1701, tokenIndex1701, depth1701 := position, tokenIndex, depth
+					if !_rules[ruleEqual]() {
+						goto l1702
+					}
+					goto l1701
+				l1702:
+					position, tokenIndex, depth = position1701, tokenIndex1701, depth1701
+					if !_rules[ruleNotEqual]() {
+						goto l1703
+					}
+					goto l1701
+				l1703:
+					position, tokenIndex, depth = position1701, tokenIndex1701, depth1701
+					if !_rules[ruleLessOrEqual]() {
+						goto l1704
+					}
+					goto l1701
+				l1704:
+					position, tokenIndex, depth = position1701, tokenIndex1701, depth1701
+					if !_rules[ruleLess]() {
+						goto l1705
+					}
+					goto l1701
+				l1705:
+					position, tokenIndex, depth = position1701, tokenIndex1701, depth1701
+					if !_rules[ruleGreaterOrEqual]() {
+						goto l1706
+					}
+					goto l1701
+				l1706:
+					position, tokenIndex, depth = position1701, tokenIndex1701, depth1701
+					if !_rules[ruleGreater]() {
+						goto l1707
+					}
+					goto l1701
+				l1707:
+					position, tokenIndex, depth = position1701, tokenIndex1701, depth1701
+					if !_rules[ruleNotEqual]() {
+						goto l1708
+					}
+					goto l1701
+				l1708:
+					position, tokenIndex, depth = position1701, tokenIndex1701, depth1701
+					if !_rules[ruleRegexpMatch]() {
+						goto l1709
+					}
+					goto l1701
+				l1709:
+					position, tokenIndex, depth = position1701, tokenIndex1701, depth1701
+					if !_rules[ruleNotRegexpMatch]() {
+						goto l1699
+					}
 				}
-				if !_rules[rulesp]() {
-					goto l1146
+			l1701:
+				depth--
+				add(ruleComparisonOp, position1700)
+			}
+			return true
+		l1699:
+			position, tokenIndex, depth = position1699, tokenIndex1699, depth1699
+			return false
+		},
+		/* 126 PatternOp <- <(Like / NotLike / ILike / NotILike)> */
+		func() bool {
+			position1710, tokenIndex1710, depth1710 := position, tokenIndex, depth
+			{
+				position1711 := position
+				depth++
+				{
+					position1712, tokenIndex1712, depth1712 := position, tokenIndex, depth
+					if !_rules[ruleLike]() {
+						goto l1713
+					}
+					goto l1712
+				l1713:
+					position, tokenIndex, depth = position1712, tokenIndex1712, depth1712
+					if !_rules[ruleNotLike]() {
+						goto l1714
+					}
+					goto l1712
+				l1714:
+					position, tokenIndex, depth = position1712, tokenIndex1712, depth1712
+					if !_rules[ruleILike]() {
+						goto l1715
+					}
+					goto l1712
+				l1715:
+					position, tokenIndex, depth = position1712, tokenIndex1712, depth1712
+					if !_rules[ruleNotILike]() {
+						goto l1710
+					}
 				}
-				if !_rules[ruleParamsOrder]() {
-					goto l1146
+			l1712:
+				depth--
+				add(rulePatternOp, position1711)
+			}
+			return true
+		l1710:
+			position, tokenIndex, depth = position1710, tokenIndex1710, depth1710
+			return false
+		},
+		/* 127 InOp <- <(InOperator / NotIn)> */
+		func() bool {
+			position1716, tokenIndex1716, depth1716 := position, tokenIndex, depth
+			{
+				position1717 := position
+				depth++
+				{
+					position1718, tokenIndex1718, depth1718 := position, tokenIndex, depth
+					if !_rules[ruleInOperator]() {
+						goto l1719
+					}
+					goto l1718
+				l1719:
+					position, tokenIndex, depth = position1718, tokenIndex1718, depth1718
+					if !_rules[ruleNotIn]() {
+						goto l1716
+					}
 				}
-				if !_rules[rulespOpt]() {
-					goto l1146
+			l1718:
+				depth--
+				add(ruleInOp, position1717)
+			}
+			return true
+		l1716:
+			position, tokenIndex, depth = position1716, tokenIndex1716, depth1716
+			return false
+		},
+		/* 128 BetweenOp <- <(Between / NotBetween)> */
+		func() bool {
+			position1720, tokenIndex1720, depth1720 := position, tokenIndex, depth
+			{
+				position1721 := position
+				depth++
+				{
+					position1722, tokenIndex1722, depth1722 := position, tokenIndex, depth
+					if !_rules[ruleBetween]() {
+						goto l1723
+					}
+					goto l1722
+				l1723:
+					position, tokenIndex, depth = position1722, tokenIndex1722, depth1722
+					if !_rules[ruleNotBetween]() {
+						goto l1720
+					}
 				}
-				if buffer[position] != rune(')') {
-					goto l1146
+			l1722:
+				depth--
+				add(ruleBetweenOp, position1721)
+			}
+			return true
+		l1720:
+			position, tokenIndex, depth = position1720, tokenIndex1720, depth1720
+			return false
+		},
+		/* 129 OtherOp <- <Concat> */
+		func() bool {
+			position1724, tokenIndex1724, depth1724 := position, tokenIndex, depth
+			{
+				position1725 := position
+				depth++
+				if !_rules[ruleConcat]() {
+					goto l1724
 				}
-				position++
-				if !_rules[ruleAction65]() {
-					goto l1146
+				depth--
+				add(ruleOtherOp, position1725)
+			}
+			return true
+		l1724:
+			position, tokenIndex, depth = position1724, tokenIndex1724, depth1724
+			return false
+		},
+		/* 130 IsOp <- <(IsNot / Is)> */
+		func() bool {
+			position1726, tokenIndex1726, depth1726 := position, tokenIndex, depth
+			{
+				position1727 := position
+				depth++
+				{
+					position1728, tokenIndex1728, depth1728 := position, tokenIndex, depth
+					if !_rules[ruleIsNot]() {
+						goto l1729
+					}
+					goto l1728
+				l1729:
+					position, tokenIndex, depth = position1728, tokenIndex1728, depth1728
+					if !_rules[ruleIs]() {
+						goto l1726
+					}
 				}
+			l1728:
 				depth--
-				add(ruleFuncAppWithOrderBy, position1147)
+				add(ruleIsOp, position1727)
 			}
 			return true
-		l1146:
-			position, tokenIndex, depth = position1146, tokenIndex1146, depth1146
+		l1726:
+			position, tokenIndex, depth = position1726, tokenIndex1726, depth1726
 			return false
 		},
-		/* 87 FuncAppWithoutOrderBy <- <(Function spOpt '(' spOpt FuncParams <spOpt> ')' Action66)> */
+		/* 131 PlusMinusOp <- <(Plus / Minus)> */
 		func() bool {
-			position1148, tokenIndex1148, depth1148 := position, tokenIndex, depth
+			position1730, tokenIndex1730, depth1730 := position, tokenIndex, depth
 			{
-				position1149 := position
+				position1731 := position
 				depth++
-				if !_rules[ruleFunction]() {
-					goto l1148
+				{
+					position1732, tokenIndex1732, depth1732 := position, tokenIndex, depth
+					if !_rules[rulePlus]() {
+						goto l1733
+					}
+					goto l1732
+				l1733:
+					position, tokenIndex, depth = position1732, tokenIndex1732, depth1732
+					if !_rules[ruleMinus]() {
+						goto l1730
+					}
 				}
-				if !_rules[rulespOpt]() {
-					goto l1148
+			l1732:
+				depth--
+				add(rulePlusMinusOp, position1731)
+			}
+			return true
+		l1730:
+			position, tokenIndex, depth = position1730, tokenIndex1730, depth1730
+			return false
+		},
+		/* 132 MultDivOp <- <(Multiply / Divide / Modulo)> */
+		func() bool {
+			position1734, tokenIndex1734, depth1734 := position, tokenIndex, depth
+			{
+				position1735 := position
+				depth++
+				{
+					position1736, tokenIndex1736, depth1736 := position, tokenIndex, depth
+					if !_rules[ruleMultiply]() {
+						goto l1737
+					}
+					goto l1736
+				l1737:
+					position, tokenIndex, depth = position1736, tokenIndex1736, depth1736
+					if !_rules[ruleDivide]() {
+						goto l1738
+					}
+					goto l1736
+				l1738:
+					position, tokenIndex, depth = position1736, tokenIndex1736, depth1736
+					if !_rules[ruleModulo]() {
+						goto l1734
+					}
 				}
-				if buffer[position] != rune('(') {
-					goto l1148
+			l1736:
+				depth--
+				add(ruleMultDivOp, position1735)
+			}
+			return true
+		l1734:
+			position, tokenIndex, depth = position1734, tokenIndex1734, depth1734
+			return false
+		},
+		/* 133 Stream <- <(<ident> Action99)> */
+		func() bool {
+			position1739, tokenIndex1739, depth1739 := position, tokenIndex, depth
+			{
+				position1740 := position
+				depth++
+				{
+					position1741 := position
+					depth++
+					if !_rules[ruleident]() {
+						goto l1739
+					}
+					depth--
+					add(rulePegText, position1741)
 				}
-				position++
-				if !_rules[rulespOpt]() {
-					goto l1148
+				if !_rules[ruleAction99]() {
+					goto l1739
 				}
-				if !_rules[ruleFuncParams]() {
-					goto l1148
+				depth--
+				add(ruleStream, position1740)
+			}
+			return true
+		l1739:
+			position, tokenIndex, depth = position1739, tokenIndex1739, depth1739
+			return false
+		},
+		/* 134 RowMeta <- <RowTimestamp> */
+		func() bool {
+			position1742, tokenIndex1742, depth1742 := position, tokenIndex, depth
+			{
+				position1743 := position
+				depth++
+				if !_rules[ruleRowTimestamp]() {
+					goto l1742
 				}
+				depth--
+				add(ruleRowMeta, position1743)
+			}
+			return true
+		l1742:
+			position, tokenIndex, depth = position1742, tokenIndex1742, depth1742
+			return false
+		},
+		/* 135 RowTimestamp <- <(<((ident ':')? ('t' 's' '(' ')'))> Action100)> */
+		func() bool {
+			position1744, tokenIndex1744, depth1744 := position, tokenIndex, depth
+			{
+				position1745 := position
+				depth++
 				{
-					position1150 := position
+					position1746 := position
 					depth++
-					if !_rules[rulespOpt]() {
-						goto l1148
+					{
+						position1747, tokenIndex1747, depth1747 := position, tokenIndex, depth
+						if !_rules[ruleident]() {
+							goto l1747
+						}
+						if buffer[position] != rune(':') {
+							goto l1747
+						}
+						position++
+						goto l1748
+					l1747:
+						position, tokenIndex, depth = position1747, tokenIndex1747, depth1747
 					}
+				l1748:
+					if buffer[position] != rune('t') {
+						goto l1744
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l1744
+					}
+					position++
+					if buffer[position] != rune('(') {
+						goto l1744
+					}
+					position++
+					if buffer[position] != rune(')') {
+						goto l1744
+					}
+					position++
 					depth--
-					add(rulePegText, position1150)
-				}
-				if buffer[position] != rune(')') {
-					goto l1148
+					add(rulePegText, position1746)
 				}
-				position++
-				if !_rules[ruleAction66]() {
-					goto l1148
+				if !_rules[ruleAction100]() {
+					goto l1744
 				}
 				depth--
-				add(ruleFuncAppWithoutOrderBy, position1149)
+				add(ruleRowTimestamp, position1745)
 			}
 			return true
-		l1148:
-			position, tokenIndex, depth = position1148, tokenIndex1148, depth1148
+		l1744:
+			position, tokenIndex, depth = position1744, tokenIndex1744, depth1744
 			return false
 		},
-		/* 88 FuncParams <- <(<(ExpressionOrWildcard (spOpt ',' spOpt ExpressionOrWildcard)*)?> Action67)> */
+		/* 136 RowValue <- <(<((ident ':' !':')? jsonGetPath)> Action101)> */
 		func() bool {
-			position1151, tokenIndex1151, depth1151 := position, tokenIndex, depth
+			position1749, tokenIndex1749, depth1749 := position, tokenIndex, depth
 			{
-				position1152 := position
+				position1750 := position
 				depth++
 				{
-					position1153 := position
+					position1751 := position
 					depth++
 					{
-						position1154, tokenIndex1154, depth1154 := position, tokenIndex, depth
-						if !_rules[ruleExpressionOrWildcard]() {
-							goto l1154
+						position1752, tokenIndex1752, depth1752 := position, tokenIndex, depth
+						if !_rules[ruleident]() {
+							goto l1752
 						}
-					l1156:
+						if buffer[position] != rune(':') {
+							goto l1752
+						}
+						position++
 						{
-							position1157, tokenIndex1157, depth1157 := position, tokenIndex, depth
-							if !_rules[rulespOpt]() {
-								goto l1157
-							}
-							if buffer[position] != rune(',') {
-								goto l1157
+							position1754, tokenIndex1754, depth1754 := position, tokenIndex, depth
+							if buffer[position] != rune(':') {
+								goto l1754
 							}
 							position++
-							if !_rules[rulespOpt]() {
-								goto l1157
-							}
-							if !_rules[ruleExpressionOrWildcard]() {
-								goto l1157
-							}
-							goto l1156
-						l1157:
-							position, tokenIndex, depth = position1157, tokenIndex1157, depth1157
+							goto l1752
+						l1754:
+							position, tokenIndex, depth = position1754, tokenIndex1754, depth1754
 						}
-						goto l1155
-					l1154:
-						position, tokenIndex, depth = position1154, tokenIndex1154, depth1154
+						goto l1753
+					l1752:
+						position, tokenIndex, depth = position1752, tokenIndex1752, depth1752
+					}
+				l1753:
+					if !_rules[rulejsonGetPath]() {
+						goto l1749
 					}
-				l1155:
 					depth--
-					add(rulePegText, position1153)
+					add(rulePegText, position1751)
 				}
-				if !_rules[ruleAction67]() {
-					goto l1151
+				if !_rules[ruleAction101]() {
+					goto l1749
 				}
 				depth--
-				add(ruleFuncParams, position1152)
+				add(ruleRowValue, position1750)
 			}
 			return true
-		l1151:
-			position, tokenIndex, depth = position1151, tokenIndex1151, depth1151
+		l1749:
+			position, tokenIndex, depth = position1749, tokenIndex1749, depth1749
 			return false
 		},
-		/* 89 ParamsOrder <- <(<(('o' / 'O') ('r' / 'R') ('d' / 'D') ('e' / 'E') ('r' / 'R') sp (('b' / 'B') ('y' / 'Y')) sp SortedExpression (spOpt ',' spOpt SortedExpression)*)> Action68)> */
+		/* 137 NumericLiteral <- <(<('-'? [0-9]+)> Action102)> */
 		func() bool {
-			position1158, tokenIndex1158, depth1158 := position, tokenIndex, depth
+			position1755, tokenIndex1755, depth1755 := position, tokenIndex, depth
 			{
-				position1159 := position
+				position1756 := position
 				depth++
 				{
-					position1160 := position
+					position1757 := position
 					depth++
 					{
-						position1161, tokenIndex1161, depth1161 := position, tokenIndex, depth
-						if buffer[position] != rune('o') {
-							goto l1162
-						}
-						position++
-						goto l1161
-					l1162:
-						position, tokenIndex, depth = position1161, tokenIndex1161, depth1161
-						if buffer[position] != rune('O') {
-							goto l1158
+						position1758, tokenIndex1758, depth1758 := position, tokenIndex, depth
+						if buffer[position] != rune('-') {
+							goto l1758
 						}
 						position++
+						goto l1759
+					l1758:
+						position, tokenIndex, depth = position1758, tokenIndex1758, depth1758
 					}
-				l1161:
-					{
-						position1163, tokenIndex1163, depth1163 := position, tokenIndex, depth
-						if buffer[position] != rune('r') {
-							goto l1164
-						}
-						position++
-						goto l1163
-					l1164:
-						position, tokenIndex, depth = position1163, tokenIndex1163, depth1163
-						if buffer[position] != rune('R') {
-							goto l1158
-						}
-						position++
+				l1759:
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l1755
 					}
-				l1163:
+					position++
+				l1760:
 					{
-						position1165, tokenIndex1165, depth1165 := position, tokenIndex, depth
-						if buffer[position] != rune('d') {
-							goto l1166
-						}
-						position++
-						goto l1165
-					l1166:
-						position, tokenIndex, depth = position1165, tokenIndex1165, depth1165
-						if buffer[position] != rune('D') {
-							goto l1158
+						position1761, tokenIndex1761, depth1761 := position, tokenIndex, depth
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l1761
 						}
 						position++
+						goto l1760
+					l1761:
+						position, tokenIndex, depth = position1761, tokenIndex1761, depth1761
 					}
-				l1165:
-					{
-						position1167, tokenIndex1167, depth1167 := position, tokenIndex, depth
-						if buffer[position] != rune('e') {
-							goto l1168
-						}
-						position++
-						goto l1167
-					l1168:
-						position, tokenIndex, depth = position1167, tokenIndex1167, depth1167
-						if buffer[position] != rune('E') {
-							goto l1158
-						}
-						position++
+					depth--
+					add(rulePegText, position1757)
+				}
+				if !_rules[ruleAction102]() {
+					goto l1755
+				}
+				depth--
+				add(ruleNumericLiteral, position1756)
+			}
+			return true
+		l1755:
+			position, tokenIndex, depth = position1755, tokenIndex1755, depth1755
+			return false
+		},
+		/* 138 NonNegativeNumericLiteral <- <(<[0-9]+> Action103)> */
+		func() bool {
+			position1762, tokenIndex1762, depth1762 := position, tokenIndex, depth
+			{
+				position1763 := position
+				depth++
+				{
+					position1764 := position
+					depth++
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l1762
 					}
-				l1167:
+					position++
+				l1765:
 					{
-						position1169, tokenIndex1169, depth1169 := position, tokenIndex, depth
-						if buffer[position] != rune('r') {
-							goto l1170
-						}
-						position++
-						goto l1169
-					l1170:
-						position, tokenIndex, depth = position1169, tokenIndex1169, depth1169
-						if buffer[position] != rune('R') {
-							goto l1158
+						position1766, tokenIndex1766, depth1766 := position, tokenIndex, depth
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l1766
 						}
 						position++
+						goto l1765
+					l1766:
+						position, tokenIndex, depth = position1766, tokenIndex1766, depth1766
 					}
-				l1169:
-					if !_rules[rulesp]() {
-						goto l1158
-					}
+					depth--
+					add(rulePegText, position1764)
+				}
+				if !_rules[ruleAction103]() {
+					goto l1762
+				}
+				depth--
+				add(ruleNonNegativeNumericLiteral, position1763)
+			}
+			return true
+		l1762:
+			position, tokenIndex, depth = position1762, tokenIndex1762, depth1762
+			return false
+		},
+		/* 139 FloatLiteral <- <(<('-'? [0-9]+ '.' [0-9]+)> Action104)> */
+		func() bool {
+			position1767, tokenIndex1767, depth1767 := position, tokenIndex, depth
+			{
+				position1768 := position
+				depth++
+				{
+					position1769 := position
+					depth++
 					{
-						position1171, tokenIndex1171, depth1171 := position, tokenIndex, depth
-						if buffer[position] != rune('b') {
-							goto l1172
-						}
-						position++
-						goto l1171
-					l1172:
-						position, tokenIndex, depth = position1171, tokenIndex1171, depth1171
-						if buffer[position] != rune('B') {
-							goto l1158
+						position1770, tokenIndex1770, depth1770 := position, tokenIndex, depth
+						if buffer[position] != rune('-') {
+							goto l1770
 						}
 						position++
+						goto l1771
+					l1770:
+						position, tokenIndex, depth = position1770, tokenIndex1770, depth1770
+					}
+				l1771:
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l1767
 					}
-				l1171:
+					position++
+				l1772:
 					{
-						position1173, tokenIndex1173, depth1173 := position, tokenIndex, depth
-						if buffer[position] != rune('y') {
-							goto l1174
-						}
-						position++
-						goto l1173
-					l1174:
-						position, tokenIndex, depth = position1173, tokenIndex1173, depth1173
-						if buffer[position] != rune('Y') {
-							goto l1158
+						position1773, tokenIndex1773, depth1773 := position, tokenIndex, depth
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l1773
 						}
 						position++
+						goto l1772
+					l1773:
+						position, tokenIndex, depth = position1773, tokenIndex1773, depth1773
 					}
-				l1173:
-					if !_rules[rulesp]() {
-						goto l1158
+					if buffer[position] != rune('.') {
+						goto l1767
 					}
-					if !_rules[ruleSortedExpression]() {
-						goto l1158
+					position++
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l1767
 					}
-				l1175:
+					position++
+				l1774:
 					{
-						position1176, tokenIndex1176, depth1176 := position, tokenIndex, depth
-						if !_rules[rulespOpt]() {
-							goto l1176
-						}
-						if buffer[position] != rune(',') {
-							goto l1176
+						position1775, tokenIndex1775, depth1775 := position, tokenIndex, depth
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l1775
 						}
 						position++
-						if !_rules[rulespOpt]() {
-							goto l1176
-						}
-						if !_rules[ruleSortedExpression]() {
-							goto l1176
-						}
-						goto l1175
-					l1176:
-						position, tokenIndex, depth = position1176, tokenIndex1176, depth1176
+						goto l1774
+					l1775:
+						position, tokenIndex, depth = position1775, tokenIndex1775, depth1775
 					}
 					depth--
-					add(rulePegText, position1160)
-				}
-				if !_rules[ruleAction68]() {
-					goto l1158
-				}
-				depth--
-				add(ruleParamsOrder, position1159)
-			}
-			return true
-		l1158:
-			position, tokenIndex, depth = position1158, tokenIndex1158, depth1158
-			return false
-		},
-		/* 90 SortedExpression <- <(Expression OrderDirectionOpt Action69)> */
-		func() bool {
-			position1177, tokenIndex1177, depth1177 := position, tokenIndex, depth
-			{
-				position1178 := position
-				depth++
-				if !_rules[ruleExpression]() {
-					goto l1177
-				}
-				if !_rules[ruleOrderDirectionOpt]() {
-					goto l1177
+					add(rulePegText, position1769)
 				}
-				if !_rules[ruleAction69]() {
-					goto l1177
+				if !_rules[ruleAction104]() {
+					goto l1767
 				}
 				depth--
-				add(ruleSortedExpression, position1178)
+				add(ruleFloatLiteral, position1768)
 			}
 			return true
-		l1177:
-			position, tokenIndex, depth = position1177, tokenIndex1177, depth1177
+		l1767:
+			position, tokenIndex, depth = position1767, tokenIndex1767, depth1767
 			return false
 		},
-		/* 91 OrderDirectionOpt <- <(<(sp (Ascending / Descending))?> Action70)> */
+		/* 140 Function <- <(<ident> Action105)> */
 		func() bool {
-			position1179, tokenIndex1179, depth1179 := position, tokenIndex, depth
+			position1776, tokenIndex1776, depth1776 := position, tokenIndex, depth
 			{
-				position1180 := position
+				position1777 := position
 				depth++
 				{
-					position1181 := position
+					position1778 := position
 					depth++
-					{
-						position1182, tokenIndex1182, depth1182 := position, tokenIndex, depth
-						if !_rules[rulesp]() {
-							goto l1182
-						}
-						{
-							position1184, tokenIndex1184, depth1184 := position, tokenIndex, depth
-							if !_rules[ruleAscending]() {
-								goto l1185
-							}
-							goto l1184
-						l1185:
-							position, tokenIndex, depth = position1184, tokenIndex1184, depth1184
-							if !_rules[ruleDescending]() {
-								goto l1182
-							}
-						}
-					l1184:
-						goto l1183
-					l1182:
-						position, tokenIndex, depth = position1182, tokenIndex1182, depth1182
+					if !_rules[ruleident]() {
+						goto l1776
 					}
-				l1183:
 					depth--
-					add(rulePegText, position1181)
+					add(rulePegText, position1778)
 				}
-				if !_rules[ruleAction70]() {
-					goto l1179
+				if !_rules[ruleAction105]() {
+					goto l1776
 				}
 				depth--
-				add(ruleOrderDirectionOpt, position1180)
+				add(ruleFunction, position1777)
 			}
 			return true
-		l1179:
-			position, tokenIndex, depth = position1179, tokenIndex1179, depth1179
+		l1776:
+			position, tokenIndex, depth = position1776, tokenIndex1776, depth1776
 			return false
 		},
-		/* 92 ArrayExpr <- <(<('[' spOpt (ExpressionOrWildcard (spOpt ',' spOpt ExpressionOrWildcard)*)? spOpt ','? spOpt ']')> Action71)> */
+		/* 141 NullLiteral <- <(<(('n' / 'N') ('u' / 'U') ('l' / 'L') ('l' / 'L'))> Action106)> */
 		func() bool {
-			position1186, tokenIndex1186, depth1186 := position, tokenIndex, depth
+			position1779, tokenIndex1779, depth1779 := position, tokenIndex, depth
 			{
-				position1187 := position
+				position1780 := position
 				depth++
 				{
-					position1188 := position
+					position1781 := position
 					depth++
-					if buffer[position] != rune('[') {
-						goto l1186
+					{
+						position1782, tokenIndex1782, depth1782 := position, tokenIndex, depth
+						if buffer[position] != rune('n') {
+							goto l1783
+						}
+						position++
+						goto l1782
+					l1783:
+						position, tokenIndex, depth = position1782, tokenIndex1782, depth1782
+						if buffer[position] != rune('N') {
+							goto l1779
+						}
+						position++
 					}
-					position++
-					if !_rules[rulespOpt]() {
-						goto l1186
+				l1782:
+					{
+						position1784, tokenIndex1784, depth1784 := position, tokenIndex, depth
+						if buffer[position] != rune('u') {
+							goto l1785
+						}
+						position++
+						goto l1784
+					l1785:
+						position, tokenIndex, depth = position1784, tokenIndex1784, depth1784
+						if buffer[position] != rune('U') {
+							goto l1779
+						}
+						position++
 					}
+				l1784:
 					{
-						position1189, tokenIndex1189, depth1189 := position, tokenIndex, depth
-						if !_rules[ruleExpressionOrWildcard]() {
-							goto l1189
+						position1786, tokenIndex1786, depth1786 := position, tokenIndex, depth
+						if buffer[position] != rune('l') {
+							goto l1787
 						}
-					l1191:
-						{
-							position1192, tokenIndex1192, depth1192 := position, tokenIndex, depth
-							if !_rules[rulespOpt]() {
-								goto l1192
-							}
-							if buffer[position] != rune(',') {
-								goto l1192
-							}
-							position++
-							if !_rules[rulespOpt]() {
-								goto l1192
-							}
-							if !_rules[ruleExpressionOrWildcard]() {
-								goto l1192
-							}
-							goto l1191
-						l1192:
-							position, tokenIndex, depth = position1192, tokenIndex1192, depth1192
+						position++
+						goto l1786
+					l1787:
+						position, tokenIndex, depth = position1786, tokenIndex1786, depth1786
+						if buffer[position] != rune('L') {
+							goto l1779
 						}
-						goto l1190
-					l1189:
-						position, tokenIndex, depth = position1189, tokenIndex1189, depth1189
-					}
-				l1190:
-					if !_rules[rulespOpt]() {
-						goto l1186
+						position++
 					}
+				l1786:
 					{
-						position1193, tokenIndex1193, depth1193 := position, tokenIndex, depth
-						if buffer[position] != rune(',') {
-							goto l1193
+						position1788, tokenIndex1788, depth1788 := position, tokenIndex, depth
+						if buffer[position] != rune('l') {
+							goto l1789
+						}
+						position++
+						goto l1788
+					l1789:
+						position, tokenIndex, depth = position1788, tokenIndex1788, depth1788
+						if buffer[position] != rune('L') {
+							goto l1779
 						}
 						position++
-						goto l1194
-					l1193:
-						position, tokenIndex, depth = position1193, tokenIndex1193, depth1193
-					}
-				l1194:
-					if !_rules[rulespOpt]() {
-						goto l1186
-					}
-					if buffer[position] != rune(']') {
-						goto l1186
 					}
-					position++
+				l1788:
 					depth--
-					add(rulePegText, position1188)
+					add(rulePegText, position1781)
 				}
-				if !_rules[ruleAction71]() {
-					goto l1186
+				if !_rules[ruleAction106]() {
+					goto l1779
 				}
 				depth--
-				add(ruleArrayExpr, position1187)
+				add(ruleNullLiteral, position1780)
 			}
 			return true
-		l1186:
-			position, tokenIndex, depth = position1186, tokenIndex1186, depth1186
+		l1779:
+			position, tokenIndex, depth = position1779, tokenIndex1779, depth1779
 			return false
 		},
-		/* 93 MapExpr <- <(<('{' spOpt (KeyValuePair (spOpt ',' spOpt KeyValuePair)*)? spOpt '}')> Action72)> */
+		/* 142 Missing <- <(<(('m' / 'M') ('i' / 'I') ('s' / 'S') ('s' / 'S') ('i' / 'I') ('n' / 'N') ('g' / 'G'))> Action107)> */
 		func() bool {
-			position1195, tokenIndex1195, depth1195 := position, tokenIndex, depth
+			position1790, tokenIndex1790, depth1790 := position, tokenIndex, depth
 			{
-				position1196 := position
+				position1791 := position
 				depth++
 				{
-					position1197 := position
+					position1792 := position
 					depth++
-					if buffer[position] != rune('{') {
-						goto l1195
+					{
+						position1793, tokenIndex1793, depth1793 := position, tokenIndex, depth
+						if buffer[position] != rune('m') {
+							goto l1794
+						}
+						position++
+						goto l1793
+					l1794:
+						position, tokenIndex, depth = position1793, tokenIndex1793, depth1793
+						if buffer[position] != rune('M') {
+							goto l1790
+						}
+						position++
 					}
-					position++
-					if !_rules[rulespOpt]() {
-						goto l1195
+				l1793:
+					{
+						position1795, tokenIndex1795, depth1795 := position, tokenIndex, depth
+						if buffer[position] != rune('i') {
+							goto l1796
+						}
+						position++
+						goto l1795
+					l1796:
+						position, tokenIndex, depth = position1795, tokenIndex1795, depth1795
+						if buffer[position] != rune('I') {
+							goto l1790
+						}
+						position++
 					}
+				l1795:
 					{
-						position1198, tokenIndex1198, depth1198 := position, tokenIndex, depth
-						if !_rules[ruleKeyValuePair]() {
-							goto l1198
+						position1797, tokenIndex1797, depth1797 := position, tokenIndex, depth
+						if buffer[position] != rune('s') {
+							goto l1798
 						}
-					l1200:
-						{
-							position1201, tokenIndex1201, depth1201 := position, tokenIndex, depth
-							if !_rules[rulespOpt]() {
-								goto l1201
-							}
-							if buffer[position] != rune(',') {
-								goto l1201
-							}
-							position++
-							if !_rules[rulespOpt]() {
-								goto l1201
-							}
-							if !_rules[ruleKeyValuePair]() {
-								goto l1201
-							}
-							goto l1200
-						l1201:
-							position, tokenIndex, depth = position1201, tokenIndex1201, depth1201
+						position++
+						goto l1797
+					l1798:
+						position, tokenIndex, depth = position1797, tokenIndex1797, depth1797
+						if buffer[position] != rune('S') {
+							goto l1790
 						}
-						goto l1199
-					l1198:
-						position, tokenIndex, depth = position1198, tokenIndex1198, depth1198
+						position++
 					}
-				l1199:
-					if !_rules[rulespOpt]() {
-						goto l1195
+				l1797:
+					{
+						position1799, tokenIndex1799, depth1799 := position, tokenIndex, depth
+						if buffer[position] != rune('s') {
+							goto l1800
+						}
+						position++
+						goto l1799
+					l1800:
+						position, tokenIndex, depth = position1799, tokenIndex1799, depth1799
+						if buffer[position] != rune('S') {
+							goto l1790
+						}
+						position++
 					}
-					if buffer[position] != rune('}') {
-						goto l1195
+				l1799:
+					{
+						position1801, tokenIndex1801, depth1801 := position, tokenIndex, depth
+						if buffer[position] != rune('i') {
+							goto l1802
+						}
+						position++
+						goto l1801
+					l1802:
+						position, tokenIndex, depth = position1801, tokenIndex1801, depth1801
+						if buffer[position] != rune('I') {
+							goto l1790
+						}
+						position++
 					}
-					position++
+				l1801:
+					{
+						position1803, tokenIndex1803, depth1803 := position, tokenIndex, depth
+						if buffer[position] != rune('n') {
+							goto l1804
+						}
+						position++
+						goto l1803
+					l1804:
+						position, tokenIndex, depth = position1803, tokenIndex1803, depth1803
+						if buffer[position] != rune('N') {
+							goto l1790
+						}
+						position++
+					}
+				l1803:
+					{
+						position1805, tokenIndex1805, depth1805 := position, tokenIndex, depth
+						if buffer[position] != rune('g') {
+							goto l1806
+						}
+						position++
+						goto l1805
+					l1806:
+						position, tokenIndex, depth = position1805, tokenIndex1805, depth1805
+						if buffer[position] != rune('G') {
+							goto l1790
+						}
+						position++
+					}
+				l1805:
 					depth--
-					add(rulePegText, position1197)
+					add(rulePegText, position1792)
 				}
-				if !_rules[ruleAction72]() {
-					goto l1195
+				if !_rules[ruleAction107]() {
+					goto l1790
 				}
 				depth--
-				add(ruleMapExpr, position1196)
+				add(ruleMissing, position1791)
 			}
 			return true
-		l1195:
-			position, tokenIndex, depth = position1195, tokenIndex1195, depth1195
+		l1790:
+			position, tokenIndex, depth = position1790, tokenIndex1790, depth1790
 			return false
 		},
-		/* 94 KeyValuePair <- <(<(StringLiteral spOpt ':' spOpt ExpressionOrWildcard)> Action73)> */
+		/* 143 IntervalLiteral <- <(('i' / 'I') ('n' / 'N') ('t' / 'T') ('e' / 'E') ('r' / 'R') ('v' / 'V') ('a' / 'A') ('l' / 'L') sp (IntervalValue / ('"' spOpt IntervalValue spOpt '"') / ('\'' spOpt IntervalValue spOpt '\'')))> */
 		func() bool {
-			position1202, tokenIndex1202, depth1202 := position, tokenIndex, depth
+			position1807, tokenIndex1807, depth1807 := position, tokenIndex, depth
 			{
-				position1203 := position
+				position1808 := position
 				depth++
 				{
-					position1204 := position
-					depth++
-					if !_rules[ruleStringLiteral]() {
-						goto l1202
+					position1809, tokenIndex1809, depth1809 := position, tokenIndex, depth
+					if buffer[position] != rune('i') {
+						goto l1810
 					}
-					if !_rules[rulespOpt]() {
-						goto l1202
+					position++
+					goto l1809
+				l1810:
+					position, tokenIndex, depth = position1809, tokenIndex1809, depth1809
+					if buffer[position] != rune('I') {
+						goto l1807
 					}
-					if buffer[position] != rune(':') {
-						goto l1202
+					position++
+				}
+			l1809:
+				{
+					position1811, tokenIndex1811, depth1811 := position, tokenIndex, depth
+					if buffer[position] != rune('n') {
+						goto l1812
 					}
 					position++
-					if !_rules[rulespOpt]() {
-						goto l1202
+					goto l1811
+				l1812:
+					position, tokenIndex, depth = position1811, tokenIndex1811, depth1811
+					if buffer[position] != rune('N') {
+						goto l1807
 					}
-					if !_rules[ruleExpressionOrWildcard]() {
-						goto l1202
+					position++
+				}
+			l1811:
+				{
+					position1813, tokenIndex1813, depth1813 := position, tokenIndex, depth
+					if buffer[position] != rune('t') {
+						goto l1814
 					}
-					depth--
-					add(rulePegText, position1204)
+					position++
+					goto l1813
+				l1814:
+					position, tokenIndex, depth = position1813, tokenIndex1813, depth1813
+					if buffer[position] != rune('T') {
+						goto l1807
+					}
+					position++
 				}
-				if !_rules[ruleAction73]() {
-					goto l1202
+			l1813:
+				{
+					position1815, tokenIndex1815, depth1815 := position, tokenIndex, depth
+					if buffer[position] != rune('e') {
+						goto l1816
+					}
+					position++
+					goto l1815
+				l1816:
+					position, tokenIndex, depth = position1815, tokenIndex1815, depth1815
+					if buffer[position] != rune('E') {
+						goto l1807
+					}
+					position++
 				}
-				depth--
-				add(ruleKeyValuePair, position1203)
-			}
-			return true
-		l1202:
-			position, tokenIndex, depth = position1202, tokenIndex1202, depth1202
-			return false
-		},
-		/* 95 Case <- <(ConditionCase / ExpressionCase)> */
-		func() bool {
-			position1205, tokenIndex1205, depth1205 := position, tokenIndex, depth
-			{
-				position1206 := position
-				depth++
+			l1815:
 				{
-					position1207, tokenIndex1207, depth1207 := position, tokenIndex, depth
-					if !_rules[ruleConditionCase]() {
-						goto l1208
+					position1817, tokenIndex1817, depth1817 := position, tokenIndex, depth
+					if buffer[position] != rune('r') {
+						goto l1818
 					}
-					goto l1207
-				l1208:
-					position, tokenIndex, depth = position1207, tokenIndex1207, depth1207
-					if !_rules[ruleExpressionCase]() {
-						goto l1205
+					position++
+					goto l1817
+				l1818:
+					position, tokenIndex, depth = position1817, tokenIndex1817, depth1817
+					if buffer[position] != rune('R') {
+						goto l1807
 					}
+					position++
 				}
-			l1207:
-				depth--
-				add(ruleCase, position1206)
-			}
-			return true
-		l1205:
-			position, tokenIndex, depth = position1205, tokenIndex1205, depth1205
-			return false
-		},
-		/* 96 ConditionCase <- <(('c' / 'C') ('a' / 'A') ('s' / 'S') ('e' / 'E') <((sp WhenThenPair)+ (sp (('e' / 'E') ('l' / 'L') ('s' / 'S') ('e' / 'E')) sp Expression)? sp (('e' / 'E') ('n' / 'N') ('d' / 'D')))> Action74)> */
-		func() bool {
-			position1209, tokenIndex1209, depth1209 := position, tokenIndex, depth
-			{
-				position1210 := position
-				depth++
+			l1817:
 				{
-					position1211, tokenIndex1211, depth1211 := position, tokenIndex, depth
-					if buffer[position] != rune('c') {
-						goto l1212
+					position1819, tokenIndex1819, depth1819 := position, tokenIndex, depth
+					if buffer[position] != rune('v') {
+						goto l1820
 					}
 					position++
-					goto l1211
-				l1212:
-					position, tokenIndex, depth = position1211, tokenIndex1211, depth1211
-					if buffer[position] != rune('C') {
-						goto l1209
+					goto l1819
+				l1820:
+					position, tokenIndex, depth = position1819, tokenIndex1819, depth1819
+					if buffer[position] != rune('V') {
+						goto l1807
 					}
 					position++
 				}
-			l1211:
+			l1819:
 				{
-					position1213, tokenIndex1213, depth1213 := position, tokenIndex, depth
+					position1821, tokenIndex1821, depth1821 := position, tokenIndex, depth
 					if buffer[position] != rune('a') {
-						goto l1214
+						goto l1822
 					}
 					position++
-					goto l1213
-				l1214:
-					position, tokenIndex, depth = position1213, tokenIndex1213, depth1213
+					goto l1821
+				l1822:
+					position, tokenIndex, depth = position1821, tokenIndex1821, depth1821
 					if buffer[position] != rune('A') {
-						goto l1209
+						goto l1807
 					}
 					position++
 				}
-			l1213:
+			l1821:
 				{
-					position1215, tokenIndex1215, depth1215 := position, tokenIndex, depth
-					if buffer[position] != rune('s') {
-						goto l1216
+					position1823, tokenIndex1823, depth1823 := position, tokenIndex, depth
+					if buffer[position] != rune('l') {
+						goto l1824
 					}
 					position++
-					goto l1215
-				l1216:
-					position, tokenIndex, depth = position1215, tokenIndex1215, depth1215
-					if buffer[position] != rune('S') {
-						goto l1209
+					goto l1823
+				l1824:
+					position, tokenIndex, depth = position1823, tokenIndex1823, depth1823
+					if buffer[position] != rune('L') {
+						goto l1807
 					}
 					position++
 				}
-			l1215:
+			l1823:
+				if !_rules[rulesp]() {
+					goto l1807
+				}
 				{
-					position1217, tokenIndex1217, depth1217 := position, tokenIndex, depth
-					if buffer[position] != rune('e') {
-						goto l1218
+					position1825, tokenIndex1825, depth1825 := position, tokenIndex, depth
+					if !_rules[ruleIntervalValue]() {
+						goto l1826
+					}
+					goto l1825
+				l1826:
+					position, tokenIndex, depth = position1825, tokenIndex1825, depth1825
+					if buffer[position] != rune('"') {
+						goto l1827
 					}
 					position++
-					goto l1217
-				l1218:
-					position, tokenIndex, depth = position1217, tokenIndex1217, depth1217
-					if buffer[position] != rune('E') {
-						goto l1209
+					if !_rules[rulespOpt]() {
+						goto l1827
+					}
+					if !_rules[ruleIntervalValue]() {
+						goto l1827
+					}
+					if !_rules[rulespOpt]() {
+						goto l1827
+					}
+					if buffer[position] != rune('"') {
+						goto l1827
+					}
+					position++
+					goto l1825
+				l1827:
+					position, tokenIndex, depth = position1825, tokenIndex1825, depth1825
+					if buffer[position] != rune('\'') {
+						goto l1807
+					}
+					position++
+					if !_rules[rulespOpt]() {
+						goto l1807
+					}
+					if !_rules[ruleIntervalValue]() {
+						goto l1807
+					}
+					if !_rules[rulespOpt]() {
+						goto l1807
+					}
+					if buffer[position] != rune('\'') {
+						goto l1807
 					}
 					position++
 				}
-			l1217:
+			l1825:
+				depth--
+				add(ruleIntervalLiteral, position1808)
+			}
+			return true
+		l1807:
+			position, tokenIndex, depth = position1807, tokenIndex1807, depth1807
+			return false
+		},
+		/* 144 IntervalValue <- <((FloatLiteral / NumericLiteral) sp IntervalLiteralUnit Action108)> */
+		func() bool {
+			position1828, tokenIndex1828, depth1828 := position, tokenIndex, depth
+			{
+				position1829 := position
+				depth++
 				{
-					position1219 := position
-					depth++
-					if !_rules[rulesp]() {
-						goto l1209
+					position1830, tokenIndex1830, depth1830 := position, tokenIndex, depth
+					if !_rules[ruleFloatLiteral]() {
+						goto l1831
 					}
-					if !_rules[ruleWhenThenPair]() {
-						goto l1209
+					goto l1830
+				l1831:
+					position, tokenIndex, depth = position1830, tokenIndex1830, depth1830
+					if !_rules[ruleNumericLiteral]() {
+						goto l1828
 					}
-				l1220:
+				}
+			l1830:
+				if !_rules[rulesp]() {
+					goto l1828
+				}
+				if !_rules[ruleIntervalLiteralUnit]() {
+					goto l1828
+				}
+				if !_rules[ruleAction108]() {
+					goto l1828
+				}
+				depth--
+				add(ruleIntervalValue, position1829)
+			}
+			return true
+		l1828:
+			position, tokenIndex, depth = position1828, tokenIndex1828, depth1828
+			return false
+		},
+		/* 145 IntervalLiteralUnit <- <(<(((('m' / 'M') ('i' / 'I') ('c' / 'C') ('r' / 'R') ('o' / 'O') ('s' / 'S') ('e' / 'E') ('c' / 'C') ('o' / 'O') ('n' / 'N') ('d' / 'D')) / (('m' / 'M') ('i' / 'I') ('l' / 'L') ('l' / 'L') ('i' / 'I') ('s' / 'S') ('e' / 'E') ('c' / 'C') ('o' / 'O') ('n' / 'N') ('d' / 'D')) / (('s' / 'S') ('e' / 'E') ('c' / 'C') ('o' / 'O') ('n' / 'N') ('d' / 'D')) / (('m' / 'M') ('i' / 'I') ('n' / 'N') ('u' / 'U') ('t' / 'T') ('e' / 'E')) / (('h' / 'H') ('o' / 'O') ('u' / 'U') ('r' / 'R')) / (('d' / 'D') ('a' / 'A') ('y' / 'Y')) / (('w' / 'W') ('e' / 'E') ('e' / 'E') ('k' / 'K'))) ('s' / 'S')?)> Action109)> */
+		func() bool {
+			position1832, tokenIndex1832, depth1832 := position, tokenIndex, depth
+			{
+				position1833 := position
+				depth++
+				{
+					position1834 := position
+					depth++
 					{
-						position1221, tokenIndex1221, depth1221 := position, tokenIndex, depth
-						if !_rules[rulesp]() {
-							goto l1221
+						position1835, tokenIndex1835, depth1835 := position, tokenIndex, depth
+						{
+							position1837, tokenIndex1837, depth1837 := position, tokenIndex, depth
+							if buffer[position] != rune('m') {
+								goto l1838
+							}
+							position++
+							goto l1837
+						l1838:
+							position, tokenIndex, depth = position1837, tokenIndex1837, depth1837
+							if buffer[position] != rune('M') {
+								goto l1836
+							}
+							position++
 						}
-						if !_rules[ruleWhenThenPair]() {
-							goto l1221
+					l1837:
+						{
+							position1839, tokenIndex1839, depth1839 := position, tokenIndex, depth
+							if buffer[position] != rune('i') {
+								goto l1840
+							}
+							position++
+							goto l1839
+						l1840:
+							position, tokenIndex, depth = position1839, tokenIndex1839, depth1839
+							if buffer[position] != rune('I') {
+								goto l1836
+							}
+							position++
+						}
+					l1839:
+						{
+							position1841, tokenIndex1841, depth1841 := position, tokenIndex, depth
+							if buffer[position] != rune('c') {
+								goto l1842
+							}
+							position++
+							goto l1841
+						l1842:
+							position, tokenIndex, depth = position1841, tokenIndex1841, depth1841
+							if buffer[position] != rune('C') {
+								goto l1836
+							}
+							position++
+						}
+					l1841:
+						{
+							position1843, tokenIndex1843, depth1843 := position, tokenIndex, depth
+							if buffer[position] != rune('r') {
+								goto l1844
+							}
+							position++
+							goto l1843
+						l1844:
+							position, tokenIndex, depth = position1843, tokenIndex1843, depth1843
+							if buffer[position] != rune('R') {
+								goto l1836
+							}
+							position++
+						}
+					l1843:
+						{
+							position1845, tokenIndex1845, depth1845 := position, tokenIndex, depth
+							if buffer[position] != rune('o') {
+								goto l1846
+							}
+							position++
+							goto l1845
+						l1846:
+							position, tokenIndex, depth = position1845, tokenIndex1845, depth1845
+							if buffer[position] != rune('O') {
+								goto l1836
+							}
+							position++
 						}
-						goto l1220
-					l1221:
-						position, tokenIndex, depth = position1221, tokenIndex1221, depth1221
-					}
-					{
-						position1222, tokenIndex1222, depth1222 := position, tokenIndex, depth
-						if !_rules[rulesp]() {
-							goto l1222
+					l1845:
+						{
+							position1847, tokenIndex1847, depth1847 := position, tokenIndex, depth
+							if buffer[position] != rune('s') {
+								goto l1848
+							}
+							position++
+							goto l1847
+						l1848:
+							position, tokenIndex, depth = position1847, tokenIndex1847, depth1847
+							if buffer[position] != rune('S') {
+								goto l1836
+							}
+							position++
 						}
+					l1847:
 						{
-							position1224, tokenIndex1224, depth1224 := position, tokenIndex, depth
+							position1849, tokenIndex1849, depth1849 := position, tokenIndex, depth
 							if buffer[position] != rune('e') {
-								goto l1225
+								goto l1850
 							}
 							position++
-							goto l1224
-						l1225:
-							position, tokenIndex, depth = position1224, tokenIndex1224, depth1224
+							goto l1849
+						l1850:
+							position, tokenIndex, depth = position1849, tokenIndex1849, depth1849
 							if buffer[position] != rune('E') {
-								goto l1222
+								goto l1836
 							}
 							position++
 						}
-					l1224:
+					l1849:
 						{
-							position1226, tokenIndex1226, depth1226 := position, tokenIndex, depth
+							position1851, tokenIndex1851, depth1851 := position, tokenIndex, depth
+							if buffer[position] != rune('c') {
+								goto l1852
+							}
+							position++
+							goto l1851
+						l1852:
+							position, tokenIndex, depth = position1851, tokenIndex1851, depth1851
+							if buffer[position] != rune('C') {
+								goto l1836
+							}
+							position++
+						}
+					l1851:
+						{
+							position1853, tokenIndex1853, depth1853 := position, tokenIndex, depth
+							if buffer[position] != rune('o') {
+								goto l1854
+							}
+							position++
+							goto l1853
+						l1854:
+							position, tokenIndex, depth = position1853, tokenIndex1853, depth1853
+							if buffer[position] != rune('O') {
+								goto l1836
+							}
+							position++
+						}
+					l1853:
+						{
+							position1855, tokenIndex1855, depth1855 := position, tokenIndex, depth
+							if buffer[position] != rune('n') {
+								goto l1856
+							}
+							position++
+							goto l1855
+						l1856:
+							position, tokenIndex, depth = position1855, tokenIndex1855, depth1855
+							if buffer[position] != rune('N') {
+								goto l1836
+							}
+							position++
+						}
+					l1855:
+						{
+							position1857, tokenIndex1857, depth1857 := position, tokenIndex, depth
+							if buffer[position] != rune('d') {
+								goto l1858
+							}
+							position++
+							goto l1857
+						l1858:
+							position, tokenIndex, depth = position1857, tokenIndex1857, depth1857
+							if buffer[position] != rune('D') {
+								goto l1836
+							}
+							position++
+						}
+					l1857:
+						goto l1835
+					l1836:
+						position, tokenIndex, depth = position1835, tokenIndex1835, depth1835
+						{
+							position1860, tokenIndex1860, depth1860 := position, tokenIndex, depth
+							if buffer[position] != rune('m') {
+								goto l1861
+							}
+							position++
+							goto l1860
+						l1861:
+							position, tokenIndex, depth = position1860, tokenIndex1860, depth1860
+							if buffer[position] != rune('M') {
+								goto l1859
+							}
+							position++
+						}
+					l1860:
+						{
+							position1862, tokenIndex1862, depth1862 := position, tokenIndex, depth
+							if buffer[position] != rune('i') {
+								goto l1863
+							}
+							position++
+							goto l1862
+						l1863:
+							position, tokenIndex, depth = position1862, tokenIndex1862, depth1862
+							if buffer[position] != rune('I') {
+								goto l1859
+							}
+							position++
+						}
+					l1862:
+						{
+							position1864, tokenIndex1864, depth1864 := position, tokenIndex, depth
 							if buffer[position] != rune('l') {
-								goto l1227
+								goto l1865
 							}
 							position++
-							goto l1226
-						l1227:
-							position, tokenIndex, depth = position1226, tokenIndex1226, depth1226
+							goto l1864
+						l1865:
+							position, tokenIndex, depth = position1864, tokenIndex1864, depth1864
 							if buffer[position] != rune('L') {
-								goto l1222
+								goto l1859
 							}
 							position++
 						}
-					l1226:
+					l1864:
 						{
-							position1228, tokenIndex1228, depth1228 := position, tokenIndex, depth
+							position1866, tokenIndex1866, depth1866 := position, tokenIndex, depth
+							if buffer[position] != rune('l') {
+								goto l1867
+							}
+							position++
+							goto l1866
+						l1867:
+							position, tokenIndex, depth = position1866, tokenIndex1866, depth1866
+							if buffer[position] != rune('L') {
+								goto l1859
+							}
+							position++
+						}
+					l1866:
+						{
+							position1868, tokenIndex1868, depth1868 := position, tokenIndex, depth
+							if buffer[position] != rune('i') {
+								goto l1869
+							}
+							position++
+							goto l1868
+						l1869:
+							position, tokenIndex, depth = position1868, tokenIndex1868, depth1868
+							if buffer[position] != rune('I') {
+								goto l1859
+							}
+							position++
+						}
+					l1868:
+						{
+							position1870, tokenIndex1870, depth1870 := position, tokenIndex, depth
 							if buffer[position] != rune('s') {
-								goto l1229
+								goto l1871
 							}
 							position++
-							goto l1228
-						l1229:
-							position, tokenIndex, depth = position1228, tokenIndex1228, depth1228
+							goto l1870
+						l1871:
+							position, tokenIndex, depth = position1870, tokenIndex1870, depth1870
 							if buffer[position] != rune('S') {
-								goto l1222
+								goto l1859
 							}
 							position++
 						}
-					l1228:
+					l1870:
 						{
-							position1230, tokenIndex1230, depth1230 := position, tokenIndex, depth
+							position1872, tokenIndex1872, depth1872 := position, tokenIndex, depth
 							if buffer[position] != rune('e') {
-								goto l1231
+								goto l1873
 							}
 							position++
-							goto l1230
-						l1231:
-							position, tokenIndex, depth = position1230, tokenIndex1230, depth1230
+							goto l1872
+						l1873:
+							position, tokenIndex, depth = position1872, tokenIndex1872, depth1872
 							if buffer[position] != rune('E') {
-								goto l1222
+								goto l1859
 							}
 							position++
 						}
-					l1230:
-						if !_rules[rulesp]() {
-							goto l1222
+					l1872:
+						{
+							position1874, tokenIndex1874, depth1874 := position, tokenIndex, depth
+							if buffer[position] != rune('c') {
+								goto l1875
+							}
+							position++
+							goto l1874
+						l1875:
+							position, tokenIndex, depth = position1874, tokenIndex1874, depth1874
+							if buffer[position] != rune('C') {
+								goto l1859
+							}
+							position++
 						}
-						if !_rules[ruleExpression]() {
-							goto l1222
+					l1874:
+						{
+							position1876, tokenIndex1876, depth1876 := position, tokenIndex, depth
+							if buffer[position] != rune('o') {
+								goto l1877
+							}
+							position++
+							goto l1876
+						l1877:
+							position, tokenIndex, depth = position1876, tokenIndex1876, depth1876
+							if buffer[position] != rune('O') {
+								goto l1859
+							}
+							position++
 						}
-						goto l1223
-					l1222:
-						position, tokenIndex, depth = position1222, tokenIndex1222, depth1222
-					}
-				l1223:
-					if !_rules[rulesp]() {
-						goto l1209
-					}
-					{
-						position1232, tokenIndex1232, depth1232 := position, tokenIndex, depth
-						if buffer[position] != rune('e') {
-							goto l1233
+					l1876:
+						{
+							position1878, tokenIndex1878, depth1878 := position, tokenIndex, depth
+							if buffer[position] != rune('n') {
+								goto l1879
+							}
+							position++
+							goto l1878
+						l1879:
+							position, tokenIndex, depth = position1878, tokenIndex1878, depth1878
+							if buffer[position] != rune('N') {
+								goto l1859
+							}
+							position++
 						}
-						position++
-						goto l1232
-					l1233:
-						position, tokenIndex, depth = position1232, tokenIndex1232, depth1232
-						if buffer[position] != rune('E') {
-							goto l1209
+					l1878:
+						{
+							position1880, tokenIndex1880, depth1880 := position, tokenIndex, depth
+							if buffer[position] != rune('d') {
+								goto l1881
+							}
+							position++
+							goto l1880
+						l1881:
+							position, tokenIndex, depth = position1880, tokenIndex1880, depth1880
+							if buffer[position] != rune('D') {
+								goto l1859
+							}
+							position++
 						}
-						position++
-					}
-				l1232:
-					{
-						position1234, tokenIndex1234, depth1234 := position, tokenIndex, depth
-						if buffer[position] != rune('n') {
-							goto l1235
+					l1880:
+						goto l1835
+					l1859:
+						position, tokenIndex, depth = position1835, tokenIndex1835, depth1835
+						{
+							position1883, tokenIndex1883, depth1883 := position, tokenIndex, depth
+							if buffer[position] != rune('s') {
+								goto l1884
+							}
+							position++
+							goto l1883
+						l1884:
+							position, tokenIndex, depth = position1883, tokenIndex1883, depth1883
+							if buffer[position] != rune('S') {
+								goto l1882
+							}
+							position++
 						}
-						position++
-						goto l1234
-					l1235:
-						position, tokenIndex, depth = position1234, tokenIndex1234, depth1234
-						if buffer[position] != rune('N') {
-							goto l1209
+					l1883:
+						{
+							position1885, tokenIndex1885, depth1885 := position, tokenIndex, depth
+							if buffer[position] != rune('e') {
+								goto l1886
+							}
+							position++
+							goto l1885
+						l1886:
+							position, tokenIndex, depth = position1885, tokenIndex1885, depth1885
+							if buffer[position] != rune('E') {
+								goto l1882
+							}
+							position++
 						}
-						position++
-					}
-				l1234:
-					{
-						position1236, tokenIndex1236, depth1236 := position, tokenIndex, depth
-						if buffer[position] != rune('d') {
-							goto l1237
+					l1885:
+						{
+							position1887, tokenIndex1887, depth1887 := position, tokenIndex, depth
+							if buffer[position] != rune('c') {
+								goto l1888
+							}
+							position++
+							goto l1887
+						l1888:
+							position, tokenIndex, depth = position1887, tokenIndex1887, depth1887
+							if buffer[position] != rune('C') {
+								goto l1882
+							}
+							position++
 						}
-						position++
-						goto l1236
-					l1237:
-						position, tokenIndex, depth = position1236, tokenIndex1236, depth1236
-						if buffer[position] != rune('D') {
-							goto l1209
+					l1887:
+						{
+							position1889, tokenIndex1889, depth1889 := position, tokenIndex, depth
+							if buffer[position] != rune('o') {
+								goto l1890
+							}
+							position++
+							goto l1889
+						l1890:
+							position, tokenIndex, depth = position1889, tokenIndex1889, depth1889
+							if buffer[position] != rune('O') {
+								goto l1882
+							}
+							position++
 						}
-						position++
-					}
-				l1236:
-					depth--
-					add(rulePegText, position1219)
-				}
-				if !_rules[ruleAction74]() {
-					goto l1209
-				}
-				depth--
-				add(ruleConditionCase, position1210)
-			}
-			return true
-		l1209:
-			position, tokenIndex, depth = position1209, tokenIndex1209, depth1209
-			return false
-		},
-		/* 97 ExpressionCase <- <(('c' / 'C') ('a' / 'A') ('s' / 'S') ('e' / 'E') sp Expression <((sp WhenThenPair)+ (sp (('e' / 'E') ('l' / 'L') ('s' / 'S') ('e' / 'E')) sp Expression)? sp (('e' / 'E') ('n' / 'N') ('d' / 'D')))> Action75)> */
-		func() bool {
-			position1238, tokenIndex1238, depth1238 := position, tokenIndex, depth
-			{
-				position1239 := position
-				depth++
-				{
-					position1240, tokenIndex1240, depth1240 := position, tokenIndex, depth
-					if buffer[position] != rune('c') {
-						goto l1241
-					}
-					position++
-					goto l1240
-				l1241:
-					position, tokenIndex, depth = position1240, tokenIndex1240, depth1240
-					if buffer[position] != rune('C') {
-						goto l1238
-					}
-					position++
-				}
-			l1240:
-				{
-					position1242, tokenIndex1242, depth1242 := position, tokenIndex, depth
-					if buffer[position] != rune('a') {
-						goto l1243
-					}
-					position++
-					goto l1242
-				l1243:
-					position, tokenIndex, depth = position1242, tokenIndex1242, depth1242
-					if buffer[position] != rune('A') {
-						goto l1238
-					}
-					position++
-				}
-			l1242:
-				{
-					position1244, tokenIndex1244, depth1244 := position, tokenIndex, depth
-					if buffer[position] != rune('s') {
-						goto l1245
-					}
-					position++
-					goto l1244
-				l1245:
-					position, tokenIndex, depth = position1244, tokenIndex1244, depth1244
-					if buffer[position] != rune('S') {
-						goto l1238
-					}
-					position++
-				}
-			l1244:
-				{
-					position1246, tokenIndex1246, depth1246 := position, tokenIndex, depth
-					if buffer[position] != rune('e') {
-						goto l1247
-					}
-					position++
-					goto l1246
-				l1247:
-					position, tokenIndex, depth = position1246, tokenIndex1246, depth1246
-					if buffer[position] != rune('E') {
-						goto l1238
-					}
-					position++
-				}
-			l1246:
-				if !_rules[rulesp]() {
-					goto l1238
-				}
-				if !_rules[ruleExpression]() {
-					goto l1238
-				}
-				{
-					position1248 := position
-					depth++
-					if !_rules[rulesp]() {
-						goto l1238
-					}
-					if !_rules[ruleWhenThenPair]() {
-						goto l1238
-					}
-				l1249:
-					{
-						position1250, tokenIndex1250, depth1250 := position, tokenIndex, depth
-						if !_rules[rulesp]() {
-							goto l1250
+					l1889:
+						{
+							position1891, tokenIndex1891, depth1891 := position, tokenIndex, depth
+							if buffer[position] != rune('n') {
+								goto l1892
+							}
+							position++
+							goto l1891
+						l1892:
+							position, tokenIndex, depth = position1891, tokenIndex1891, depth1891
+							if buffer[position] != rune('N') {
+								goto l1882
+							}
+							position++
+						}
+					l1891:
+						{
+							position1893, tokenIndex1893, depth1893 := position, tokenIndex, depth
+							if buffer[position] != rune('d') {
+								goto l1894
+							}
+							position++
+							goto l1893
+						l1894:
+							position, tokenIndex, depth = position1893, tokenIndex1893, depth1893
+							if buffer[position] != rune('D') {
+								goto l1882
+							}
+							position++
+						}
+					l1893:
+						goto l1835
+					l1882:
+						position, tokenIndex, depth = position1835, tokenIndex1835, depth1835
+						{
+							position1896, tokenIndex1896, depth1896 := position, tokenIndex, depth
+							if buffer[position] != rune('m') {
+								goto l1897
+							}
+							position++
+							goto l1896
+						l1897:
+							position, tokenIndex, depth = position1896, tokenIndex1896, depth1896
+							if buffer[position] != rune('M') {
+								goto l1895
+							}
+							position++
 						}
-						if !_rules[ruleWhenThenPair]() {
-							goto l1250
+					l1896:
+						{
+							position1898, tokenIndex1898, depth1898 := position, tokenIndex, depth
+							if buffer[position] != rune('i') {
+								goto l1899
+							}
+							position++
+							goto l1898
+						l1899:
+							position, tokenIndex, depth = position1898, tokenIndex1898, depth1898
+							if buffer[position] != rune('I') {
+								goto l1895
+							}
+							position++
 						}
-						goto l1249
-					l1250:
-						position, tokenIndex, depth = position1250, tokenIndex1250, depth1250
-					}
-					{
-						position1251, tokenIndex1251, depth1251 := position, tokenIndex, depth
-						if !_rules[rulesp]() {
-							goto l1251
+					l1898:
+						{
+							position1900, tokenIndex1900, depth1900 := position, tokenIndex, depth
+							if buffer[position] != rune('n') {
+								goto l1901
+							}
+							position++
+							goto l1900
+						l1901:
+							position, tokenIndex, depth = position1900, tokenIndex1900, depth1900
+							if buffer[position] != rune('N') {
+								goto l1895
+							}
+							position++
+						}
+					l1900:
+						{
+							position1902, tokenIndex1902, depth1902 := position, tokenIndex, depth
+							if buffer[position] != rune('u') {
+								goto l1903
+							}
+							position++
+							goto l1902
+						l1903:
+							position, tokenIndex, depth = position1902, tokenIndex1902, depth1902
+							if buffer[position] != rune('U') {
+								goto l1895
+							}
+							position++
+						}
+					l1902:
+						{
+							position1904, tokenIndex1904, depth1904 := position, tokenIndex, depth
+							if buffer[position] != rune('t') {
+								goto l1905
+							}
+							position++
+							goto l1904
+						l1905:
+							position, tokenIndex, depth = position1904, tokenIndex1904, depth1904
+							if buffer[position] != rune('T') {
+								goto l1895
+							}
+							position++
 						}
+					l1904:
 						{
-							position1253, tokenIndex1253, depth1253 := position, tokenIndex, depth
+							position1906, tokenIndex1906, depth1906 := position, tokenIndex, depth
 							if buffer[position] != rune('e') {
-								goto l1254
+								goto l1907
 							}
 							position++
-							goto l1253
-						l1254:
-							position, tokenIndex, depth = position1253, tokenIndex1253, depth1253
+							goto l1906
+						l1907:
+							position, tokenIndex, depth = position1906, tokenIndex1906, depth1906
 							if buffer[position] != rune('E') {
-								goto l1251
+								goto l1895
 							}
 							position++
 						}
-					l1253:
+					l1906:
+						goto l1835
+					l1895:
+						position, tokenIndex, depth = position1835, tokenIndex1835, depth1835
 						{
-							position1255, tokenIndex1255, depth1255 := position, tokenIndex, depth
-							if buffer[position] != rune('l') {
-								goto l1256
+							position1909, tokenIndex1909, depth1909 := position, tokenIndex, depth
+							if buffer[position] != rune('h') {
+								goto l1910
 							}
 							position++
-							goto l1255
-						l1256:
-							position, tokenIndex, depth = position1255, tokenIndex1255, depth1255
-							if buffer[position] != rune('L') {
-								goto l1251
+							goto l1909
+						l1910:
+							position, tokenIndex, depth = position1909, tokenIndex1909, depth1909
+							if buffer[position] != rune('H') {
+								goto l1908
 							}
 							position++
 						}
-					l1255:
+					l1909:
 						{
-							position1257, tokenIndex1257, depth1257 := position, tokenIndex, depth
-							if buffer[position] != rune('s') {
-								goto l1258
+							position1911, tokenIndex1911, depth1911 := position, tokenIndex, depth
+							if buffer[position] != rune('o') {
+								goto l1912
 							}
 							position++
-							goto l1257
-						l1258:
-							position, tokenIndex, depth = position1257, tokenIndex1257, depth1257
-							if buffer[position] != rune('S') {
-								goto l1251
+							goto l1911
+						l1912:
+							position, tokenIndex, depth = position1911, tokenIndex1911, depth1911
+							if buffer[position] != rune('O') {
+								goto l1908
 							}
 							position++
 						}
-					l1257:
+					l1911:
 						{
-							position1259, tokenIndex1259, depth1259 := position, tokenIndex, depth
-							if buffer[position] != rune('e') {
-								goto l1260
+							position1913, tokenIndex1913, depth1913 := position, tokenIndex, depth
+							if buffer[position] != rune('u') {
+								goto l1914
 							}
 							position++
-							goto l1259
-						l1260:
-							position, tokenIndex, depth = position1259, tokenIndex1259, depth1259
-							if buffer[position] != rune('E') {
-								goto l1251
+							goto l1913
+						l1914:
+							position, tokenIndex, depth = position1913, tokenIndex1913, depth1913
+							if buffer[position] != rune('U') {
+								goto l1908
 							}
 							position++
 						}
-					l1259:
-						if !_rules[rulesp]() {
-							goto l1251
+					l1913:
+						{
+							position1915, tokenIndex1915, depth1915 := position, tokenIndex, depth
+							if buffer[position] != rune('r') {
+								goto l1916
+							}
+							position++
+							goto l1915
+						l1916:
+							position, tokenIndex, depth = position1915, tokenIndex1915, depth1915
+							if buffer[position] != rune('R') {
+								goto l1908
+							}
+							position++
 						}
-						if !_rules[ruleExpression]() {
-							goto l1251
+					l1915:
+						goto l1835
+					l1908:
+						position, tokenIndex, depth = position1835, tokenIndex1835, depth1835
+						{
+							position1918, tokenIndex1918, depth1918 := position, tokenIndex, depth
+							if buffer[position] != rune('d') {
+								goto l1919
+							}
+							position++
+							goto l1918
+						l1919:
+							position, tokenIndex, depth = position1918, tokenIndex1918, depth1918
+							if buffer[position] != rune('D') {
+								goto l1917
+							}
+							position++
 						}
-						goto l1252
-					l1251:
-						position, tokenIndex, depth = position1251, tokenIndex1251, depth1251
-					}
-				l1252:
-					if !_rules[rulesp]() {
-						goto l1238
-					}
-					{
-						position1261, tokenIndex1261, depth1261 := position, tokenIndex, depth
-						if buffer[position] != rune('e') {
-							goto l1262
+					l1918:
+						{
+							position1920, tokenIndex1920, depth1920 := position, tokenIndex, depth
+							if buffer[position] != rune('a') {
+								goto l1921
+							}
+							position++
+							goto l1920
+						l1921:
+							position, tokenIndex, depth = position1920, tokenIndex1920, depth1920
+							if buffer[position] != rune('A') {
+								goto l1917
+							}
+							position++
 						}
-						position++
-						goto l1261
-					l1262:
-						position, tokenIndex, depth = position1261, tokenIndex1261, depth1261
-						if buffer[position] != rune('E') {
-							goto l1238
+					l1920:
+						{
+							position1922, tokenIndex1922, depth1922 := position, tokenIndex, depth
+							if buffer[position] != rune('y') {
+								goto l1923
+							}
+							position++
+							goto l1922
+						l1923:
+							position, tokenIndex, depth = position1922, tokenIndex1922, depth1922
+							if buffer[position] != rune('Y') {
+								goto l1917
+							}
+							position++
 						}
-						position++
-					}
-				l1261:
-					{
-						position1263, tokenIndex1263, depth1263 := position, tokenIndex, depth
-						if buffer[position] != rune('n') {
-							goto l1264
+					l1922:
+						goto l1835
+					l1917:
+						position, tokenIndex, depth = position1835, tokenIndex1835, depth1835
+						{
+							position1924, tokenIndex1924, depth1924 := position, tokenIndex, depth
+							if buffer[position] != rune('w') {
+								goto l1925
+							}
+							position++
+							goto l1924
+						l1925:
+							position, tokenIndex, depth = position1924, tokenIndex1924, depth1924
+							if buffer[position] != rune('W') {
+								goto l1832
+							}
+							position++
 						}
-						position++
-						goto l1263
-					l1264:
-						position, tokenIndex, depth = position1263, tokenIndex1263, depth1263
-						if buffer[position] != rune('N') {
-							goto l1238
+					l1924:
+						{
+							position1926, tokenIndex1926, depth1926 := position, tokenIndex, depth
+							if buffer[position] != rune('e') {
+								goto l1927
+							}
+							position++
+							goto l1926
+						l1927:
+							position, tokenIndex, depth = position1926, tokenIndex1926, depth1926
+							if buffer[position] != rune('E') {
+								goto l1832
+							}
+							position++
 						}
-						position++
+					l1926:
+						{
+							position1928, tokenIndex1928, depth1928 := position, tokenIndex, depth
+							if buffer[position] != rune('e') {
+								goto l1929
+							}
+							position++
+							goto l1928
+						l1929:
+							position, tokenIndex, depth = position1928, tokenIndex1928, depth1928
+							if buffer[position] != rune('E') {
+								goto l1832
+							}
+							position++
+						}
+					l1928:
+						{
+							position1930, tokenIndex1930, depth1930 := position, tokenIndex, depth
+							if buffer[position] != rune('k') {
+								goto l1931
+							}
+							position++
+							goto l1930
+						l1931:
+							position, tokenIndex, depth = position1930, tokenIndex1930, depth1930
+							if buffer[position] != rune('K') {
+								goto l1832
+							}
+							position++
+						}
+					l1930:
 					}
-				l1263:
+				l1835:
 					{
-						position1265, tokenIndex1265, depth1265 := position, tokenIndex, depth
-						if buffer[position] != rune('d') {
-							goto l1266
-						}
-						position++
-						goto l1265
-					l1266:
-						position, tokenIndex, depth = position1265, tokenIndex1265, depth1265
-						if buffer[position] != rune('D') {
-							goto l1238
+						position1932, tokenIndex1932, depth1932 := position, tokenIndex, depth
+						{
+							position1934, tokenIndex1934, depth1934 := position, tokenIndex, depth
+							if buffer[position] != rune('s') {
+								goto l1935
+							}
+							position++
+							goto l1934
+						l1935:
+							position, tokenIndex, depth = position1934, tokenIndex1934, depth1934
+							if buffer[position] != rune('S') {
+								goto l1932
+							}
+							position++
 						}
-						position++
+					l1934:
+						goto l1933
+					l1932:
+						position, tokenIndex, depth = position1932, tokenIndex1932, depth1932
 					}
-				l1265:
+				l1933:
 					depth--
-					add(rulePegText, position1248)
-				}
-				if !_rules[ruleAction75]() {
-					goto l1238
-				}
-				depth--
-				add(ruleExpressionCase, position1239)
-			}
-			return true
-		l1238:
-			position, tokenIndex, depth = position1238, tokenIndex1238, depth1238
-			return false
-		},
-		/* 98 WhenThenPair <- <(('w' / 'W') ('h' / 'H') ('e' / 'E') ('n' / 'N') sp Expression sp (('t' / 'T') ('h' / 'H') ('e' / 'E') ('n' / 'N')) sp ExpressionOrWildcard Action76)> */
-		func() bool {
-			position1267, tokenIndex1267, depth1267 := position, tokenIndex, depth
-			{
-				position1268 := position
-				depth++
-				{
-					position1269, tokenIndex1269, depth1269 := position, tokenIndex, depth
-					if buffer[position] != rune('w') {
-						goto l1270
-					}
-					position++
-					goto l1269
-				l1270:
-					position, tokenIndex, depth = position1269, tokenIndex1269, depth1269
-					if buffer[position] != rune('W') {
-						goto l1267
-					}
-					position++
-				}
-			l1269:
-				{
-					position1271, tokenIndex1271, depth1271 := position, tokenIndex, depth
-					if buffer[position] != rune('h') {
-						goto l1272
-					}
-					position++
-					goto l1271
-				l1272:
-					position, tokenIndex, depth = position1271, tokenIndex1271, depth1271
-					if buffer[position] != rune('H') {
-						goto l1267
-					}
-					position++
-				}
-			l1271:
-				{
-					position1273, tokenIndex1273, depth1273 := position, tokenIndex, depth
-					if buffer[position] != rune('e') {
-						goto l1274
-					}
-					position++
-					goto l1273
-				l1274:
-					position, tokenIndex, depth = position1273, tokenIndex1273, depth1273
-					if buffer[position] != rune('E') {
-						goto l1267
-					}
-					position++
-				}
-			l1273:
-				{
-					position1275, tokenIndex1275, depth1275 := position, tokenIndex, depth
-					if buffer[position] != rune('n') {
-						goto l1276
-					}
-					position++
-					goto l1275
-				l1276:
-					position, tokenIndex, depth = position1275, tokenIndex1275, depth1275
-					if buffer[position] != rune('N') {
-						goto l1267
-					}
-					position++
-				}
-			l1275:
-				if !_rules[rulesp]() {
-					goto l1267
-				}
-				if !_rules[ruleExpression]() {
-					goto l1267
-				}
-				if !_rules[rulesp]() {
-					goto l1267
-				}
-				{
-					position1277, tokenIndex1277, depth1277 := position, tokenIndex, depth
-					if buffer[position] != rune('t') {
-						goto l1278
-					}
-					position++
-					goto l1277
-				l1278:
-					position, tokenIndex, depth = position1277, tokenIndex1277, depth1277
-					if buffer[position] != rune('T') {
-						goto l1267
-					}
-					position++
-				}
-			l1277:
-				{
-					position1279, tokenIndex1279, depth1279 := position, tokenIndex, depth
-					if buffer[position] != rune('h') {
-						goto l1280
-					}
-					position++
-					goto l1279
-				l1280:
-					position, tokenIndex, depth = position1279, tokenIndex1279, depth1279
-					if buffer[position] != rune('H') {
-						goto l1267
-					}
-					position++
-				}
-			l1279:
-				{
-					position1281, tokenIndex1281, depth1281 := position, tokenIndex, depth
-					if buffer[position] != rune('e') {
-						goto l1282
-					}
-					position++
-					goto l1281
-				l1282:
-					position, tokenIndex, depth = position1281, tokenIndex1281, depth1281
-					if buffer[position] != rune('E') {
-						goto l1267
-					}
-					position++
-				}
-			l1281:
-				{
-					position1283, tokenIndex1283, depth1283 := position, tokenIndex, depth
-					if buffer[position] != rune('n') {
-						goto l1284
-					}
-					position++
-					goto l1283
-				l1284:
-					position, tokenIndex, depth = position1283, tokenIndex1283, depth1283
-					if buffer[position] != rune('N') {
-						goto l1267
-					}
-					position++
-				}
-			l1283:
-				if !_rules[rulesp]() {
-					goto l1267
-				}
-				if !_rules[ruleExpressionOrWildcard]() {
-					goto l1267
+					add(rulePegText, position1834)
 				}
-				if !_rules[ruleAction76]() {
-					goto l1267
+				if !_rules[ruleAction109]() {
+					goto l1832
 				}
 				depth--
-				add(ruleWhenThenPair, position1268)
+				add(ruleIntervalLiteralUnit, position1833)
 			}
 			return true
-		l1267:
-			position, tokenIndex, depth = position1267, tokenIndex1267, depth1267
+		l1832:
+			position, tokenIndex, depth = position1832, tokenIndex1832, depth1832
 			return false
 		},
-		/* 99 Literal <- <(FloatLiteral / NumericLiteral / StringLiteral)> */
+		/* 146 BooleanLiteral <- <(TRUE / FALSE)> */
 		func() bool {
-			position1285, tokenIndex1285, depth1285 := position, tokenIndex, depth
+			position1936, tokenIndex1936, depth1936 := position, tokenIndex, depth
 			{
-				position1286 := position
+				position1937 := position
 				depth++
 				{
-					position1287, tokenIndex1287, depth1287 := position, tokenIndex, depth
-					if !_rules[ruleFloatLiteral]() {
-						goto l1288
-					}
-					goto l1287
-				l1288:
-					position, tokenIndex, depth = position1287, tokenIndex1287, depth1287
-					if !_rules[ruleNumericLiteral]() {
-						goto l1289
+					position1938, tokenIndex1938, depth1938 := position, tokenIndex, depth
+					if !_rules[ruleTRUE]() {
+						goto l1939
 					}
-					goto l1287
-				l1289:
-					position, tokenIndex, depth = position1287, tokenIndex1287, depth1287
-					if !_rules[ruleStringLiteral]() {
-						goto l1285
+					goto l1938
+				l1939:
+					position, tokenIndex, depth = position1938, tokenIndex1938, depth1938
+					if !_rules[ruleFALSE]() {
+						goto l1936
 					}
 				}
-			l1287:
+			l1938:
 				depth--
-				add(ruleLiteral, position1286)
+				add(ruleBooleanLiteral, position1937)
 			}
 			return true
-		l1285:
-			position, tokenIndex, depth = position1285, tokenIndex1285, depth1285
+		l1936:
+			position, tokenIndex, depth = position1936, tokenIndex1936, depth1936
 			return false
 		},
-		/* 100 ComparisonOp <- <(Equal / NotEqual / LessOrEqual / Less / GreaterOrEqual / Greater / NotEqual)> */
+		/* 147 TRUE <- <(<(('t' / 'T') ('r' / 'R') ('u' / 'U') ('e' / 'E'))> Action110)> */
 		func() bool {
-			position1290, tokenIndex1290, depth1290 := position, tokenIndex, depth
+			position1940, tokenIndex1940, depth1940 := position, tokenIndex, depth
 			{
-				position1291 := position
+				position1941 := position
 				depth++
 				{
-					position1292, tokenIndex1292, depth1292 := position, tokenIndex, depth
-					if !_rules[ruleEqual]() {
-						goto l1293
-					}
-					goto l1292
-				l1293:
-					position, tokenIndex, depth = position1292, tokenIndex1292, depth1292
-					if !_rules[ruleNotEqual]() {
-						goto l1294
-					}
-					goto l1292
-				l1294:
-					position, tokenIndex, depth = position1292, tokenIndex1292, depth1292
-					if !_rules[ruleLessOrEqual]() {
-						goto l1295
-					}
-					goto l1292
-				l1295:
-					position, tokenIndex, depth = position1292, tokenIndex1292, depth1292
-					if !_rules[ruleLess]() {
-						goto l1296
+					position1942 := position
+					depth++
+					{
+						position1943, tokenIndex1943, depth1943 := position, tokenIndex, depth
+						if buffer[position] != rune('t') {
+							goto l1944
+						}
+						position++
+						goto l1943
+					l1944:
+						position, tokenIndex, depth = position1943, tokenIndex1943, depth1943
+						if buffer[position] != rune('T') {
+							goto l1940
+						}
+						position++
 					}
-					goto l1292
-				l1296:
-					position, tokenIndex, depth = position1292, tokenIndex1292, depth1292
-					if !_rules[ruleGreaterOrEqual]() {
-						goto l1297
+				l1943:
+					{
+						position1945, tokenIndex1945, depth1945 := position, tokenIndex, depth
+						if buffer[position] != rune('r') {
+							goto l1946
+						}
+						position++
+						goto l1945
+					l1946:
+						position, tokenIndex, depth = position1945, tokenIndex1945, depth1945
+						if buffer[position] != rune('R') {
+							goto l1940
+						}
+						position++
 					}
-					goto l1292
-				l1297:
-					position, tokenIndex, depth = position1292, tokenIndex1292, depth1292
-					if !_rules[ruleGreater]() {
-						goto l1298
+				l1945:
+					{
+						position1947, tokenIndex1947, depth1947 := position, tokenIndex, depth
+						if buffer[position] != rune('u') {
+							goto l1948
+						}
+						position++
+						goto l1947
+					l1948:
+						position, tokenIndex, depth = position1947, tokenIndex1947, depth1947
+						if buffer[position] != rune('U') {
+							goto l1940
+						}
+						position++
 					}
-					goto l1292
-				l1298:
-					position, tokenIndex, depth = position1292, tokenIndex1292, depth1292
-					if !_rules[ruleNotEqual]() {
-						goto l1290
+				l1947:
+					{
+						position1949, tokenIndex1949, depth1949 := position, tokenIndex, depth
+						if buffer[position] != rune('e') {
+							goto l1950
+						}
+						position++
+						goto l1949
+					l1950:
+						position, tokenIndex, depth = position1949, tokenIndex1949, depth1949
+						if buffer[position] != rune('E') {
+							goto l1940
+						}
+						position++
 					}
+				l1949:
+					depth--
+					add(rulePegText, position1942)
 				}
-			l1292:
-				depth--
-				add(ruleComparisonOp, position1291)
-			}
-			return true
-		l1290:
-			position, tokenIndex, depth = position1290, tokenIndex1290, depth1290
-			return false
-		},
-		/* 101 OtherOp <- <Concat> */
-		func() bool {
-			position1299, tokenIndex1299, depth1299 := position, tokenIndex, depth
-			{
-				position1300 := position
-				depth++
-				if !_rules[ruleConcat]() {
-					goto l1299
+				if !_rules[ruleAction110]() {
+					goto l1940
 				}
 				depth--
-				add(ruleOtherOp, position1300)
+				add(ruleTRUE, position1941)
 			}
 			return true
-		l1299:
-			position, tokenIndex, depth = position1299, tokenIndex1299, depth1299
+		l1940:
+			position, tokenIndex, depth = position1940, tokenIndex1940, depth1940
 			return false
 		},
-		/* 102 IsOp <- <(IsNot / Is)> */
+		/* 148 FALSE <- <(<(('f' / 'F') ('a' / 'A') ('l' / 'L') ('s' / 'S') ('e' / 'E'))> Action111)> */
 		func() bool {
-			position1301, tokenIndex1301, depth1301 := position, tokenIndex, depth
+			position1951, tokenIndex1951, depth1951 := position, tokenIndex, depth
 			{
-				position1302 := position
+				position1952 := position
 				depth++
 				{
-					position1303, tokenIndex1303, depth1303 := position, tokenIndex, depth
-					if !_rules[ruleIsNot]() {
-						goto l1304
+					position1953 := position
+					depth++
+					{
+						position1954, tokenIndex1954, depth1954 := position, tokenIndex, depth
+						if buffer[position] != rune('f') {
+							goto l1955
+						}
+						position++
+						goto l1954
+					l1955:
+						position, tokenIndex, depth = position1954, tokenIndex1954, depth1954
+						if buffer[position] != rune('F') {
+							goto l1951
+						}
+						position++
 					}
-					goto l1303
-				l1304:
-					position, tokenIndex, depth = position1303, tokenIndex1303, depth1303
-					if !_rules[ruleIs]() {
-						goto l1301
+				l1954:
+					{
+						position1956, tokenIndex1956, depth1956 := position, tokenIndex, depth
+						if buffer[position] != rune('a') {
+							goto l1957
+						}
+						position++
+						goto l1956
+					l1957:
+						position, tokenIndex, depth = position1956, tokenIndex1956, depth1956
+						if buffer[position] != rune('A') {
+							goto l1951
+						}
+						position++
 					}
-				}
-			l1303:
-				depth--
-				add(ruleIsOp, position1302)
-			}
-			return true
-		l1301:
-			position, tokenIndex, depth = position1301, tokenIndex1301, depth1301
-			return false
-		},
-		/* 103 PlusMinusOp <- <(Plus / Minus)> */
-		func() bool {
-			position1305, tokenIndex1305, depth1305 := position, tokenIndex, depth
-			{
-				position1306 := position
-				depth++
-				{
-					position1307, tokenIndex1307, depth1307 := position, tokenIndex, depth
-					if !_rules[rulePlus]() {
-						goto l1308
+				l1956:
+					{
+						position1958, tokenIndex1958, depth1958 := position, tokenIndex, depth
+						if buffer[position] != rune('l') {
+							goto l1959
+						}
+						position++
+						goto l1958
+					l1959:
+						position, tokenIndex, depth = position1958, tokenIndex1958, depth1958
+						if buffer[position] != rune('L') {
+							goto l1951
+						}
+						position++
 					}
-					goto l1307
-				l1308:
-					position, tokenIndex, depth = position1307, tokenIndex1307, depth1307
-					if !_rules[ruleMinus]() {
-						goto l1305
+				l1958:
+					{
+						position1960, tokenIndex1960, depth1960 := position, tokenIndex, depth
+						if buffer[position] != rune('s') {
+							goto l1961
+						}
+						position++
+						goto l1960
+					l1961:
+						position, tokenIndex, depth = position1960, tokenIndex1960, depth1960
+						if buffer[position] != rune('S') {
+							goto l1951
+						}
+						position++
+					}
+				l1960:
+					{
+						position1962, tokenIndex1962, depth1962 := position, tokenIndex, depth
+						if buffer[position] != rune('e') {
+							goto l1963
+						}
+						position++
+						goto l1962
+					l1963:
+						position, tokenIndex, depth = position1962, tokenIndex1962, depth1962
+						if buffer[position] != rune('E') {
+							goto l1951
+						}
+						position++
 					}
+				l1962:
+					depth--
+					add(rulePegText, position1953)
+				}
+				if !_rules[ruleAction111]() {
+					goto l1951
 				}
-			l1307:
 				depth--
-				add(rulePlusMinusOp, position1306)
+				add(ruleFALSE, position1952)
 			}
 			return true
-		l1305:
-			position, tokenIndex, depth = position1305, tokenIndex1305, depth1305
+		l1951:
+			position, tokenIndex, depth = position1951, tokenIndex1951, depth1951
 			return false
 		},
-		/* 104 MultDivOp <- <(Multiply / Divide / Modulo)> */
+		/* 149 Wildcard <- <(<((ident ':' !':')? '*')> Action112)> */
 		func() bool {
-			position1309, tokenIndex1309, depth1309 := position, tokenIndex, depth
+			position1964, tokenIndex1964, depth1964 := position, tokenIndex, depth
 			{
-				position1310 := position
+				position1965 := position
 				depth++
 				{
-					position1311, tokenIndex1311, depth1311 := position, tokenIndex, depth
-					if !_rules[ruleMultiply]() {
-						goto l1312
-					}
-					goto l1311
-				l1312:
-					position, tokenIndex, depth = position1311, tokenIndex1311, depth1311
-					if !_rules[ruleDivide]() {
-						goto l1313
+					position1966 := position
+					depth++
+					{
+						position1967, tokenIndex1967, depth1967 := position, tokenIndex, depth
+						if !_rules[ruleident]() {
+							goto l1967
+						}
+						if buffer[position] != rune(':') {
+							goto l1967
+						}
+						position++
+						{
+							position1969, tokenIndex1969, depth1969 := position, tokenIndex, depth
+							if buffer[position] != rune(':') {
+								goto l1969
+							}
+							position++
+							goto l1967
+						l1969:
+							position, tokenIndex, depth = position1969, tokenIndex1969, depth1969
+						}
+						goto l1968
+					l1967:
+						position, tokenIndex, depth = position1967, tokenIndex1967, depth1967
 					}
-					goto l1311
-				l1313:
-					position, tokenIndex, depth = position1311, tokenIndex1311, depth1311
-					if !_rules[ruleModulo]() {
-						goto l1309
+				l1968:
+					if buffer[position] != rune('*') {
+						goto l1964
 					}
+					position++
+					depth--
+					add(rulePegText, position1966)
+				}
+				if !_rules[ruleAction112]() {
+					goto l1964
 				}
-			l1311:
 				depth--
-				add(ruleMultDivOp, position1310)
+				add(ruleWildcard, position1965)
 			}
 			return true
-		l1309:
-			position, tokenIndex, depth = position1309, tokenIndex1309, depth1309
+		l1964:
+			position, tokenIndex, depth = position1964, tokenIndex1964, depth1964
 			return false
 		},
-		/* 105 Stream <- <(<ident> Action77)> */
+		/* 150 StringLiteral <- <(<('"' (('"' '"') / (!'"' .))* '"')> Action113)> */
 		func() bool {
-			position1314, tokenIndex1314, depth1314 := position, tokenIndex, depth
+			position1970, tokenIndex1970, depth1970 := position, tokenIndex, depth
 			{
-				position1315 := position
+				position1971 := position
 				depth++
 				{
-					position1316 := position
+					position1972 := position
 					depth++
-					if !_rules[ruleident]() {
-						goto l1314
+					if buffer[position] != rune('"') {
+						goto l1970
+					}
+					position++
+				l1973:
+					{
+						position1974, tokenIndex1974, depth1974 := position, tokenIndex, depth
+						{
+							position1975, tokenIndex1975, depth1975 := position, tokenIndex, depth
+							if buffer[position] != rune('"') {
+								goto l1976
+							}
+							position++
+							if buffer[position] != rune('"') {
+								goto l1976
+							}
+							position++
+							goto l1975
+						l1976:
+							position, tokenIndex, depth = position1975, tokenIndex1975, depth1975
+							{
+								position1977, tokenIndex1977, depth1977 := position, tokenIndex, depth
+								if buffer[position] != rune('"') {
+									goto l1977
+								}
+								position++
+								goto l1974
+							l1977:
+								position, tokenIndex, depth = position1977, tokenIndex1977, depth1977
+							}
+							if !matchDot() {
+								goto l1974
+							}
+						}
+					l1975:
+						goto l1973
+					l1974:
+						position, tokenIndex, depth = position1974, tokenIndex1974, depth1974
+					}
+					if buffer[position] != rune('"') {
+						goto l1970
 					}
+					position++
 					depth--
-					add(rulePegText, position1316)
-				}
-				if !_rules[ruleAction77]() {
-					goto l1314
+					add(rulePegText, position1972)
 				}
-				depth--
-				add(ruleStream, position1315)
-			}
-			return true
-		l1314:
-			position, tokenIndex, depth = position1314, tokenIndex1314, depth1314
-			return false
-		},
-		/* 106 RowMeta <- <RowTimestamp> */
-		func() bool {
-			position1317, tokenIndex1317, depth1317 := position, tokenIndex, depth
-			{
-				position1318 := position
-				depth++
-				if !_rules[ruleRowTimestamp]() {
-					goto l1317
+				if !_rules[ruleAction113]() {
+					goto l1970
 				}
 				depth--
-				add(ruleRowMeta, position1318)
+				add(ruleStringLiteral, position1971)
 			}
 			return true
-		l1317:
-			position, tokenIndex, depth = position1317, tokenIndex1317, depth1317
+		l1970:
+			position, tokenIndex, depth = position1970, tokenIndex1970, depth1970
 			return false
 		},
-		/* 107 RowTimestamp <- <(<((ident ':')? ('t' 's' '(' ')'))> Action78)> */
+		/* 151 ISTREAM <- <(<(('i' / 'I') ('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M'))> Action114)> */
 		func() bool {
-			position1319, tokenIndex1319, depth1319 := position, tokenIndex, depth
+			position1978, tokenIndex1978, depth1978 := position, tokenIndex, depth
 			{
-				position1320 := position
+				position1979 := position
 				depth++
 				{
-					position1321 := position
+					position1980 := position
 					depth++
 					{
-						position1322, tokenIndex1322, depth1322 := position, tokenIndex, depth
-						if !_rules[ruleident]() {
-							goto l1322
+						position1981, tokenIndex1981, depth1981 := position, tokenIndex, depth
+						if buffer[position] != rune('i') {
+							goto l1982
 						}
-						if buffer[position] != rune(':') {
-							goto l1322
+						position++
+						goto l1981
+					l1982:
+						position, tokenIndex, depth = position1981, tokenIndex1981, depth1981
+						if buffer[position] != rune('I') {
+							goto l1978
 						}
 						position++
-						goto l1323
-					l1322:
-						position, tokenIndex, depth = position1322, tokenIndex1322, depth1322
 					}
-				l1323:
-					if buffer[position] != rune('t') {
-						goto l1319
+				l1981:
+					{
+						position1983, tokenIndex1983, depth1983 := position, tokenIndex, depth
+						if buffer[position] != rune('s') {
+							goto l1984
+						}
+						position++
+						goto l1983
+					l1984:
+						position, tokenIndex, depth = position1983, tokenIndex1983, depth1983
+						if buffer[position] != rune('S') {
+							goto l1978
+						}
+						position++
 					}
-					position++
-					if buffer[position] != rune('s') {
-						goto l1319
+				l1983:
+					{
+						position1985, tokenIndex1985, depth1985 := position, tokenIndex, depth
+						if buffer[position] != rune('t') {
+							goto l1986
+						}
+						position++
+						goto l1985
+					l1986:
+						position, tokenIndex, depth = position1985, tokenIndex1985, depth1985
+						if buffer[position] != rune('T') {
+							goto l1978
+						}
+						position++
 					}
-					position++
-					if buffer[position] != rune('(') {
-						goto l1319
+				l1985:
+					{
+						position1987, tokenIndex1987, depth1987 := position, tokenIndex, depth
+						if buffer[position] != rune('r') {
+							goto l1988
+						}
+						position++
+						goto l1987
+					l1988:
+						position, tokenIndex, depth = position1987, tokenIndex1987, depth1987
+						if buffer[position] != rune('R') {
+							goto l1978
+						}
+						position++
+					}
+				l1987:
+					{
+						position1989, tokenIndex1989, depth1989 := position, tokenIndex, depth
+						if buffer[position] != rune('e') {
+							goto l1990
+						}
+						position++
+						goto l1989
+					l1990:
+						position, tokenIndex, depth = position1989, tokenIndex1989, depth1989
+						if buffer[position] != rune('E') {
+							goto l1978
+						}
+						position++
+					}
+				l1989:
+					{
+						position1991, tokenIndex1991, depth1991 := position, tokenIndex, depth
+						if buffer[position] != rune('a') {
+							goto l1992
+						}
+						position++
+						goto l1991
+					l1992:
+						position, tokenIndex, depth = position1991, tokenIndex1991, depth1991
+						if buffer[position] != rune('A') {
+							goto l1978
+						}
+						position++
 					}
-					position++
-					if buffer[position] != rune(')') {
-						goto l1319
+				l1991:
+					{
+						position1993, tokenIndex1993, depth1993 := position, tokenIndex, depth
+						if buffer[position] != rune('m') {
+							goto l1994
+						}
+						position++
+						goto l1993
+					l1994:
+						position, tokenIndex, depth = position1993, tokenIndex1993, depth1993
+						if buffer[position] != rune('M') {
+							goto l1978
+						}
+						position++
 					}
-					position++
+				l1993:
 					depth--
-					add(rulePegText, position1321)
+					add(rulePegText, position1980)
 				}
-				if !_rules[ruleAction78]() {
-					goto l1319
+				if !_rules[ruleAction114]() {
+					goto l1978
 				}
 				depth--
-				add(ruleRowTimestamp, position1320)
+				add(ruleISTREAM, position1979)
 			}
 			return true
-		l1319:
-			position, tokenIndex, depth = position1319, tokenIndex1319, depth1319
+		l1978:
+			position, tokenIndex, depth = position1978, tokenIndex1978, depth1978
 			return false
 		},
-		/* 108 RowValue <- <(<((ident ':' !':')? jsonGetPath)> Action79)> */
+		/* 152 DSTREAM <- <(<(('d' / 'D') ('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M'))> Action115)> */
 		func() bool {
-			position1324, tokenIndex1324, depth1324 := position, tokenIndex, depth
+			position1995, tokenIndex1995, depth1995 := position, tokenIndex, depth
 			{
-				position1325 := position
+				position1996 := position
 				depth++
 				{
-					position1326 := position
+					position1997 := position
 					depth++
 					{
-						position1327, tokenIndex1327, depth1327 := position, tokenIndex, depth
-						if !_rules[ruleident]() {
-							goto l1327
+						position1998, tokenIndex1998, depth1998 := position, tokenIndex, depth
+						if buffer[position] != rune('d') {
+							goto l1999
 						}
-						if buffer[position] != rune(':') {
-							goto l1327
+						position++
+						goto l1998
+					l1999:
+						position, tokenIndex, depth = position1998, tokenIndex1998, depth1998
+						if buffer[position] != rune('D') {
+							goto l1995
 						}
 						position++
-						{
-							position1329, tokenIndex1329, depth1329 := position, tokenIndex, depth
-							if buffer[position] != rune(':') {
-								goto l1329
-							}
-							position++
-							goto l1327
-						l1329:
-							position, tokenIndex, depth = position1329, tokenIndex1329, depth1329
+					}
+				l1998:
+					{
+						position2000, tokenIndex2000, depth2000 := position, tokenIndex, depth
+						if buffer[position] != rune('s') {
+							goto l2001
 						}
-						goto l1328
-					l1327:
-						position, tokenIndex, depth = position1327, tokenIndex1327, depth1327
+						position++
+						goto l2000
+					l2001:
+						position, tokenIndex, depth = position2000, tokenIndex2000, depth2000
+						if buffer[position] != rune('S') {
+							goto l1995
+						}
+						position++
 					}
-				l1328:
-					if !_rules[rulejsonGetPath]() {
-						goto l1324
+				l2000:
+					{
+						position2002, tokenIndex2002, depth2002 := position, tokenIndex, depth
+						if buffer[position] != rune('t') {
+							goto l2003
+						}
+						position++
+						goto l2002
+					l2003:
+						position, tokenIndex, depth = position2002, tokenIndex2002, depth2002
+						if buffer[position] != rune('T') {
+							goto l1995
+						}
+						position++
 					}
-					depth--
-					add(rulePegText, position1326)
-				}
-				if !_rules[ruleAction79]() {
-					goto l1324
-				}
-				depth--
-				add(ruleRowValue, position1325)
-			}
-			return true
-		l1324:
-			position, tokenIndex, depth = position1324, tokenIndex1324, depth1324
-			return false
-		},
-		/* 109 NumericLiteral <- <(<('-'? [0-9]+)> Action80)> */
-		func() bool {
-			position1330, tokenIndex1330, depth1330 := position, tokenIndex, depth
-			{
-				position1331 := position
-				depth++
-				{
-					position1332 := position
-					depth++
+				l2002:
 					{
-						position1333, tokenIndex1333, depth1333 := position, tokenIndex, depth
-						if buffer[position] != rune('-') {
-							goto l1333
+						position2004, tokenIndex2004, depth2004 := position, tokenIndex, depth
+						if buffer[position] != rune('r') {
+							goto l2005
+						}
+						position++
+						goto l2004
+					l2005:
+						position, tokenIndex, depth = position2004, tokenIndex2004, depth2004
+						if buffer[position] != rune('R') {
+							goto l1995
 						}
 						position++
-						goto l1334
-					l1333:
-						position, tokenIndex, depth = position1333, tokenIndex1333, depth1333
 					}
-				l1334:
-					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l1330
+				l2004:
+					{
+						position2006, tokenIndex2006, depth2006 := position, tokenIndex, depth
+						if buffer[position] != rune('e') {
+							goto l2007
+						}
+						position++
+						goto l2006
+					l2007:
+						position, tokenIndex, depth = position2006, tokenIndex2006, depth2006
+						if buffer[position] != rune('E') {
+							goto l1995
+						}
+						position++
 					}
-					position++
-				l1335:
+				l2006:
 					{
-						position1336, tokenIndex1336, depth1336 := position, tokenIndex, depth
-						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1336
+						position2008, tokenIndex2008, depth2008 := position, tokenIndex, depth
+						if buffer[position] != rune('a') {
+							goto l2009
+						}
+						position++
+						goto l2008
+					l2009:
+						position, tokenIndex, depth = position2008, tokenIndex2008, depth2008
+						if buffer[position] != rune('A') {
+							goto l1995
+						}
+						position++
+					}
+				l2008:
+					{
+						position2010, tokenIndex2010, depth2010 := position, tokenIndex, depth
+						if buffer[position] != rune('m') {
+							goto l2011
+						}
+						position++
+						goto l2010
+					l2011:
+						position, tokenIndex, depth = position2010, tokenIndex2010, depth2010
+						if buffer[position] != rune('M') {
+							goto l1995
 						}
 						position++
-						goto l1335
-					l1336:
-						position, tokenIndex, depth = position1336, tokenIndex1336, depth1336
 					}
+				l2010:
 					depth--
-					add(rulePegText, position1332)
+					add(rulePegText, position1997)
 				}
-				if !_rules[ruleAction80]() {
-					goto l1330
+				if !_rules[ruleAction115]() {
+					goto l1995
 				}
 				depth--
-				add(ruleNumericLiteral, position1331)
+				add(ruleDSTREAM, position1996)
 			}
 			return true
-		l1330:
-			position, tokenIndex, depth = position1330, tokenIndex1330, depth1330
+		l1995:
+			position, tokenIndex, depth = position1995, tokenIndex1995, depth1995
 			return false
 		},
-		/* 110 NonNegativeNumericLiteral <- <(<[0-9]+> Action81)> */
+		/* 153 RSTREAM <- <(<(('r' / 'R') ('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M'))> Action116)> */
 		func() bool {
-			position1337, tokenIndex1337, depth1337 := position, tokenIndex, depth
+			position2012, tokenIndex2012, depth2012 := position, tokenIndex, depth
 			{
-				position1338 := position
+				position2013 := position
 				depth++
 				{
-					position1339 := position
+					position2014 := position
 					depth++
-					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l1337
+					{
+						position2015, tokenIndex2015, depth2015 := position, tokenIndex, depth
+						if buffer[position] != rune('r') {
+							goto l2016
+						}
+						position++
+						goto l2015
+					l2016:
+						position, tokenIndex, depth = position2015, tokenIndex2015, depth2015
+						if buffer[position] != rune('R') {
+							goto l2012
+						}
+						position++
 					}
-					position++
-				l1340:
+				l2015:
 					{
-						position1341, tokenIndex1341, depth1341 := position, tokenIndex, depth
-						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1341
+						position2017, tokenIndex2017, depth2017 := position, tokenIndex, depth
+						if buffer[position] != rune('s') {
+							goto l2018
+						}
+						position++
+						goto l2017
+					l2018:
+						position, tokenIndex, depth = position2017, tokenIndex2017, depth2017
+						if buffer[position] != rune('S') {
+							goto l2012
+						}
+						position++
+					}
+				l2017:
+					{
+						position2019, tokenIndex2019, depth2019 := position, tokenIndex, depth
+						if buffer[position] != rune('t') {
+							goto l2020
+						}
+						position++
+						goto l2019
+					l2020:
+						position, tokenIndex, depth = position2019, tokenIndex2019, depth2019
+						if buffer[position] != rune('T') {
+							goto l2012
+						}
+						position++
+					}
+				l2019:
+					{
+						position2021, tokenIndex2021, depth2021 := position, tokenIndex, depth
+						if buffer[position] != rune('r') {
+							goto l2022
+						}
+						position++
+						goto l2021
+					l2022:
+						position, tokenIndex, depth = position2021, tokenIndex2021, depth2021
+						if buffer[position] != rune('R') {
+							goto l2012
 						}
 						position++
-						goto l1340
-					l1341:
-						position, tokenIndex, depth = position1341, tokenIndex1341, depth1341
 					}
+				l2021:
+					{
+						position2023, tokenIndex2023, depth2023 := position, tokenIndex, depth
+						if buffer[position] != rune('e') {
+							goto l2024
+						}
+						position++
+						goto l2023
+					l2024:
+						position, tokenIndex, depth = position2023, tokenIndex2023, depth2023
+						if buffer[position] != rune('E') {
+							goto l2012
+						}
+						position++
+					}
+				l2023:
+					{
+						position2025, tokenIndex2025, depth2025 := position, tokenIndex, depth
+						if buffer[position] != rune('a') {
+							goto l2026
+						}
+						position++
+						goto l2025
+					l2026:
+						position, tokenIndex, depth = position2025, tokenIndex2025, depth2025
+						if buffer[position] != rune('A') {
+							goto l2012
+						}
+						position++
+					}
+				l2025:
+					{
+						position2027, tokenIndex2027, depth2027 := position, tokenIndex, depth
+						if buffer[position] != rune('m') {
+							goto l2028
+						}
+						position++
+						goto l2027
+					l2028:
+						position, tokenIndex, depth = position2027, tokenIndex2027, depth2027
+						if buffer[position] != rune('M') {
+							goto l2012
+						}
+						position++
+					}
+				l2027:
 					depth--
-					add(rulePegText, position1339)
+					add(rulePegText, position2014)
 				}
-				if !_rules[ruleAction81]() {
-					goto l1337
+				if !_rules[ruleAction116]() {
+					goto l2012
 				}
 				depth--
-				add(ruleNonNegativeNumericLiteral, position1338)
+				add(ruleRSTREAM, position2013)
 			}
 			return true
-		l1337:
-			position, tokenIndex, depth = position1337, tokenIndex1337, depth1337
+		l2012:
+			position, tokenIndex, depth = position2012, tokenIndex2012, depth2012
 			return false
 		},
-		/* 111 FloatLiteral <- <(<('-'? [0-9]+ '.' [0-9]+)> Action82)> */
+		/* 154 TUPLES <- <(<(('t' / 'T') ('u' / 'U') ('p' / 'P') ('l' / 'L') ('e' / 'E') ('s' / 'S'))> Action117)> */
 		func() bool {
-			position1342, tokenIndex1342, depth1342 := position, tokenIndex, depth
+			position2029, tokenIndex2029, depth2029 := position, tokenIndex, depth
 			{
-				position1343 := position
+				position2030 := position
 				depth++
 				{
-					position1344 := position
+					position2031 := position
 					depth++
 					{
-						position1345, tokenIndex1345, depth1345 := position, tokenIndex, depth
-						if buffer[position] != rune('-') {
-							goto l1345
+						position2032, tokenIndex2032, depth2032 := position, tokenIndex, depth
+						if buffer[position] != rune('t') {
+							goto l2033
+						}
+						position++
+						goto l2032
+					l2033:
+						position, tokenIndex, depth = position2032, tokenIndex2032, depth2032
+						if buffer[position] != rune('T') {
+							goto l2029
 						}
 						position++
-						goto l1346
-					l1345:
-						position, tokenIndex, depth = position1345, tokenIndex1345, depth1345
 					}
-				l1346:
-					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l1342
+				l2032:
+					{
+						position2034, tokenIndex2034, depth2034 := position, tokenIndex, depth
+						if buffer[position] != rune('u') {
+							goto l2035
+						}
+						position++
+						goto l2034
+					l2035:
+						position, tokenIndex, depth = position2034, tokenIndex2034, depth2034
+						if buffer[position] != rune('U') {
+							goto l2029
+						}
+						position++
 					}
-					position++
-				l1347:
+				l2034:
 					{
-						position1348, tokenIndex1348, depth1348 := position, tokenIndex, depth
-						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1348
+						position2036, tokenIndex2036, depth2036 := position, tokenIndex, depth
+						if buffer[position] != rune('p') {
+							goto l2037
+						}
+						position++
+						goto l2036
+					l2037:
+						position, tokenIndex, depth = position2036, tokenIndex2036, depth2036
+						if buffer[position] != rune('P') {
+							goto l2029
 						}
 						position++
-						goto l1347
-					l1348:
-						position, tokenIndex, depth = position1348, tokenIndex1348, depth1348
 					}
-					if buffer[position] != rune('.') {
-						goto l1342
+				l2036:
+					{
+						position2038, tokenIndex2038, depth2038 := position, tokenIndex, depth
+						if buffer[position] != rune('l') {
+							goto l2039
+						}
+						position++
+						goto l2038
+					l2039:
+						position, tokenIndex, depth = position2038, tokenIndex2038, depth2038
+						if buffer[position] != rune('L') {
+							goto l2029
+						}
+						position++
 					}
-					position++
-					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l1342
+				l2038:
+					{
+						position2040, tokenIndex2040, depth2040 := position, tokenIndex, depth
+						if buffer[position] != rune('e') {
+							goto l2041
+						}
+						position++
+						goto l2040
+					l2041:
+						position, tokenIndex, depth = position2040, tokenIndex2040, depth2040
+						if buffer[position] != rune('E') {
+							goto l2029
+						}
+						position++
 					}
-					position++
-				l1349:
+				l2040:
 					{
-						position1350, tokenIndex1350, depth1350 := position, tokenIndex, depth
-						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1350
+						position2042, tokenIndex2042, depth2042 := position, tokenIndex, depth
+						if buffer[position] != rune('s') {
+							goto l2043
+						}
+						position++
+						goto l2042
+					l2043:
+						position, tokenIndex, depth = position2042, tokenIndex2042, depth2042
+						if buffer[position] != rune('S') {
+							goto l2029
 						}
 						position++
-						goto l1349
-					l1350:
-						position, tokenIndex, depth = position1350, tokenIndex1350, depth1350
 					}
+				l2042:
 					depth--
-					add(rulePegText, position1344)
+					add(rulePegText, position2031)
 				}
-				if !_rules[ruleAction82]() {
-					goto l1342
+				if !_rules[ruleAction117]() {
+					goto l2029
 				}
 				depth--
-				add(ruleFloatLiteral, position1343)
+				add(ruleTUPLES, position2030)
 			}
 			return true
-		l1342:
-			position, tokenIndex, depth = position1342, tokenIndex1342, depth1342
+		l2029:
+			position, tokenIndex, depth = position2029, tokenIndex2029, depth2029
 			return false
 		},
-		/* 112 Function <- <(<ident> Action83)> */
+		/* 155 MINUTES <- <(<(('m' / 'M') ('i' / 'I') ('n' / 'N') ('u' / 'U') ('t' / 'T') ('e' / 'E') ('s' / 'S'))> Action118)> */
 		func() bool {
-			position1351, tokenIndex1351, depth1351 := position, tokenIndex, depth
+			position2044, tokenIndex2044, depth2044 := position, tokenIndex, depth
 			{
-				position1352 := position
+				position2045 := position
 				depth++
 				{
-					position1353 := position
+					position2046 := position
 					depth++
-					if !_rules[ruleident]() {
-						goto l1351
+					{
+						position2047, tokenIndex2047, depth2047 := position, tokenIndex, depth
+						if buffer[position] != rune('m') {
+							goto l2048
+						}
+						position++
+						goto l2047
+					l2048:
+						position, tokenIndex, depth = position2047, tokenIndex2047, depth2047
+						if buffer[position] != rune('M') {
+							goto l2044
+						}
+						position++
 					}
-					depth--
-					add(rulePegText, position1353)
-				}
-				if !_rules[ruleAction83]() {
-					goto l1351
-				}
-				depth--
-				add(ruleFunction, position1352)
-			}
-			return true
-		l1351:
-			position, tokenIndex, depth = position1351, tokenIndex1351, depth1351
-			return false
-		},
-		/* 113 NullLiteral <- <(<(('n' / 'N') ('u' / 'U') ('l' / 'L') ('l' / 'L'))> Action84)> */
-		func() bool {
-			position1354, tokenIndex1354, depth1354 := position, tokenIndex, depth
-			{
-				position1355 := position
-				depth++
-				{
-					position1356 := position
-					depth++
+				l2047:
+					{
+						position2049, tokenIndex2049, depth2049 := position, tokenIndex, depth
+						if buffer[position] != rune('i') {
+							goto l2050
+						}
+						position++
+						goto l2049
+					l2050:
+						position, tokenIndex, depth = position2049, tokenIndex2049, depth2049
+						if buffer[position] != rune('I') {
+							goto l2044
+						}
+						position++
+					}
+				l2049:
 					{
-						position1357, tokenIndex1357, depth1357 := position, tokenIndex, depth
+						position2051, tokenIndex2051, depth2051 := position, tokenIndex, depth
 						if buffer[position] != rune('n') {
-							goto l1358
+							goto l2052
 						}
 						position++
-						goto l1357
-					l1358:
-						position, tokenIndex, depth = position1357, tokenIndex1357, depth1357
+						goto l2051
+					l2052:
+						position, tokenIndex, depth = position2051, tokenIndex2051, depth2051
 						if buffer[position] != rune('N') {
-							goto l1354
+							goto l2044
 						}
 						position++
 					}
-				l1357:
+				l2051:
 					{
-						position1359, tokenIndex1359, depth1359 := position, tokenIndex, depth
+						position2053, tokenIndex2053, depth2053 := position, tokenIndex, depth
 						if buffer[position] != rune('u') {
-							goto l1360
+							goto l2054
 						}
 						position++
-						goto l1359
-					l1360:
-						position, tokenIndex, depth = position1359, tokenIndex1359, depth1359
+						goto l2053
+					l2054:
+						position, tokenIndex, depth = position2053, tokenIndex2053, depth2053
 						if buffer[position] != rune('U') {
-							goto l1354
+							goto l2044
 						}
 						position++
 					}
-				l1359:
+				l2053:
 					{
-						position1361, tokenIndex1361, depth1361 := position, tokenIndex, depth
-						if buffer[position] != rune('l') {
-							goto l1362
+						position2055, tokenIndex2055, depth2055 := position, tokenIndex, depth
+						if buffer[position] != rune('t') {
+							goto l2056
 						}
 						position++
-						goto l1361
-					l1362:
-						position, tokenIndex, depth = position1361, tokenIndex1361, depth1361
-						if buffer[position] != rune('L') {
-							goto l1354
+						goto l2055
+					l2056:
+						position, tokenIndex, depth = position2055, tokenIndex2055, depth2055
+						if buffer[position] != rune('T') {
+							goto l2044
+						}
+						position++
+					}
+				l2055:
+					{
+						position2057, tokenIndex2057, depth2057 := position, tokenIndex, depth
+						if buffer[position] != rune('e') {
+							goto l2058
+						}
+						position++
+						goto l2057
+					l2058:
+						position, tokenIndex, depth = position2057, tokenIndex2057, depth2057
+						if buffer[position] != rune('E') {
+							goto l2044
 						}
 						position++
 					}
-				l1361:
+				l2057:
 					{
-						position1363, tokenIndex1363, depth1363 := position, tokenIndex, depth
-						if buffer[position] != rune('l') {
-							goto l1364
+						position2059, tokenIndex2059, depth2059 := position, tokenIndex, depth
+						if buffer[position] != rune('s') {
+							goto l2060
 						}
 						position++
-						goto l1363
-					l1364:
-						position, tokenIndex, depth = position1363, tokenIndex1363, depth1363
-						if buffer[position] != rune('L') {
-							goto l1354
+						goto l2059
+					l2060:
+						position, tokenIndex, depth = position2059, tokenIndex2059, depth2059
+						if buffer[position] != rune('S') {
+							goto l2044
 						}
 						position++
 					}
-				l1363:
+				l2059:
 					depth--
-					add(rulePegText, position1356)
+					add(rulePegText, position2046)
 				}
-				if !_rules[ruleAction84]() {
-					goto l1354
+				if !_rules[ruleAction118]() {
+					goto l2044
 				}
 				depth--
-				add(ruleNullLiteral, position1355)
+				add(ruleMINUTES, position2045)
 			}
 			return true
-		l1354:
-			position, tokenIndex, depth = position1354, tokenIndex1354, depth1354
+		l2044:
+			position, tokenIndex, depth = position2044, tokenIndex2044, depth2044
 			return false
 		},
-		/* 114 Missing <- <(<(('m' / 'M') ('i' / 'I') ('s' / 'S') ('s' / 'S') ('i' / 'I') ('n' / 'N') ('g' / 'G'))> Action85)> */
+		/* 156 SECONDS <- <(<(('s' / 'S') ('e' / 'E') ('c' / 'C') ('o' / 'O') ('n' / 'N') ('d' / 'D') ('s' / 'S'))> Action119)> */
 		func() bool {
-			position1365, tokenIndex1365, depth1365 := position, tokenIndex, depth
+			position2061, tokenIndex2061, depth2061 := position, tokenIndex, depth
 			{
-				position1366 := position
+				position2062 := position
 				depth++
 				{
-					position1367 := position
+					position2063 := position
 					depth++
 					{
-						position1368, tokenIndex1368, depth1368 := position, tokenIndex, depth
-						if buffer[position] != rune('m') {
-							goto l1369
+						position2064, tokenIndex2064, depth2064 := position, tokenIndex, depth
+						if buffer[position] != rune('s') {
+							goto l2065
 						}
 						position++
-						goto l1368
-					l1369:
-						position, tokenIndex, depth = position1368, tokenIndex1368, depth1368
-						if buffer[position] != rune('M') {
-							goto l1365
+						goto l2064
+					l2065:
+						position, tokenIndex, depth = position2064, tokenIndex2064, depth2064
+						if buffer[position] != rune('S') {
+							goto l2061
 						}
 						position++
 					}
-				l1368:
+				l2064:
 					{
-						position1370, tokenIndex1370, depth1370 := position, tokenIndex, depth
-						if buffer[position] != rune('i') {
-							goto l1371
+						position2066, tokenIndex2066, depth2066 := position, tokenIndex, depth
+						if buffer[position] != rune('e') {
+							goto l2067
 						}
 						position++
-						goto l1370
-					l1371:
-						position, tokenIndex, depth = position1370, tokenIndex1370, depth1370
-						if buffer[position] != rune('I') {
-							goto l1365
+						goto l2066
+					l2067:
+						position, tokenIndex, depth = position2066, tokenIndex2066, depth2066
+						if buffer[position] != rune('E') {
+							goto l2061
 						}
 						position++
 					}
-				l1370:
+				l2066:
 					{
-						position1372, tokenIndex1372, depth1372 := position, tokenIndex, depth
-						if buffer[position] != rune('s') {
-							goto l1373
+						position2068, tokenIndex2068, depth2068 := position, tokenIndex, depth
+						if buffer[position] != rune('c') {
+							goto l2069
 						}
 						position++
-						goto l1372
-					l1373:
-						position, tokenIndex, depth = position1372, tokenIndex1372, depth1372
-						if buffer[position] != rune('S') {
-							goto l1365
+						goto l2068
+					l2069:
+						position, tokenIndex, depth = position2068, tokenIndex2068, depth2068
+						if buffer[position] != rune('C') {
+							goto l2061
 						}
 						position++
 					}
-				l1372:
+				l2068:
 					{
-						position1374, tokenIndex1374, depth1374 := position, tokenIndex, depth
-						if buffer[position] != rune('s') {
-							goto l1375
+						position2070, tokenIndex2070, depth2070 := position, tokenIndex, depth
+						if buffer[position] != rune('o') {
+							goto l2071
 						}
 						position++
-						goto l1374
-					l1375:
-						position, tokenIndex, depth = position1374, tokenIndex1374, depth1374
-						if buffer[position] != rune('S') {
-							goto l1365
+						goto l2070
+					l2071:
+						position, tokenIndex, depth = position2070, tokenIndex2070, depth2070
+						if buffer[position] != rune('O') {
+							goto l2061
 						}
 						position++
 					}
-				l1374:
+				l2070:
 					{
-						position1376, tokenIndex1376, depth1376 := position, tokenIndex, depth
-						if buffer[position] != rune('i') {
-							goto l1377
+						position2072, tokenIndex2072, depth2072 := position, tokenIndex, depth
+						if buffer[position] != rune('n') {
+							goto l2073
 						}
 						position++
-						goto l1376
-					l1377:
-						position, tokenIndex, depth = position1376, tokenIndex1376, depth1376
-						if buffer[position] != rune('I') {
-							goto l1365
+						goto l2072
+					l2073:
+						position, tokenIndex, depth = position2072, tokenIndex2072, depth2072
+						if buffer[position] != rune('N') {
+							goto l2061
 						}
 						position++
 					}
-				l1376:
+				l2072:
 					{
-						position1378, tokenIndex1378, depth1378 := position, tokenIndex, depth
-						if buffer[position] != rune('n') {
-							goto l1379
+						position2074, tokenIndex2074, depth2074 := position, tokenIndex, depth
+						if buffer[position] != rune('d') {
+							goto l2075
 						}
 						position++
-						goto l1378
-					l1379:
-						position, tokenIndex, depth = position1378, tokenIndex1378, depth1378
-						if buffer[position] != rune('N') {
-							goto l1365
+						goto l2074
+					l2075:
+						position, tokenIndex, depth = position2074, tokenIndex2074, depth2074
+						if buffer[position] != rune('D') {
+							goto l2061
 						}
 						position++
 					}
-				l1378:
+				l2074:
 					{
-						position1380, tokenIndex1380, depth1380 := position, tokenIndex, depth
-						if buffer[position] != rune('g') {
-							goto l1381
+						position2076, tokenIndex2076, depth2076 := position, tokenIndex, depth
+						if buffer[position] != rune('s') {
+							goto l2077
 						}
 						position++
-						goto l1380
-					l1381:
-						position, tokenIndex, depth = position1380, tokenIndex1380, depth1380
-						if buffer[position] != rune('G') {
-							goto l1365
+						goto l2076
+					l2077:
+						position, tokenIndex, depth = position2076, tokenIndex2076, depth2076
+						if buffer[position] != rune('S') {
+							goto l2061
 						}
 						position++
 					}
-				l1380:
+				l2076:
 					depth--
-					add(rulePegText, position1367)
+					add(rulePegText, position2063)
 				}
-				if !_rules[ruleAction85]() {
-					goto l1365
+				if !_rules[ruleAction119]() {
+					goto l2061
 				}
 				depth--
-				add(ruleMissing, position1366)
+				add(ruleSECONDS, position2062)
 			}
 			return true
-		l1365:
-			position, tokenIndex, depth = position1365, tokenIndex1365, depth1365
+		l2061:
+			position, tokenIndex, depth = position2061, tokenIndex2061, depth2061
 			return false
 		},
-		/* 115 BooleanLiteral <- <(TRUE / FALSE)> */
+		/* 157 MILLISECONDS <- <(<(('m' / 'M') ('i' / 'I') ('l' / 'L') ('l' / 'L') ('i' / 'I') ('s' / 'S') ('e' / 'E') ('c' / 'C') ('o' / 'O') ('n' / 'N') ('d' / 'D') ('s' / 'S'))> Action120)> */
 		func() bool {
-			position1382, tokenIndex1382, depth1382 := position, tokenIndex, depth
+			position2078, tokenIndex2078, depth2078 := position, tokenIndex, depth
 			{
-				position1383 := position
+				position2079 := position
 				depth++
 				{
-					position1384, tokenIndex1384, depth1384 := position, tokenIndex, depth
-					if !_rules[ruleTRUE]() {
-						goto l1385
+					position2080 := position
+					depth++
+					{
+						position2081, tokenIndex2081, depth2081 := position, tokenIndex, depth
+						if buffer[position] != rune('m') {
+							goto l2082
+						}
+						position++
+						goto l2081
+					l2082:
+						position, tokenIndex, depth = position2081, tokenIndex2081, depth2081
+						if buffer[position] != rune('M') {
+							goto l2078
+						}
+						position++
 					}
-					goto l1384
-				l1385:
-					position, tokenIndex, depth = position1384, tokenIndex1384, depth1384
-					if !_rules[ruleFALSE]() {
-						goto l1382
+				l2081:
+					{
+						position2083, tokenIndex2083, depth2083 := position, tokenIndex, depth
+						if buffer[position] != rune('i') {
+							goto l2084
+						}
+						position++
+						goto l2083
+					l2084:
+						position, tokenIndex, depth = position2083, tokenIndex2083, depth2083
+						if buffer[position] != rune('I') {
+							goto l2078
+						}
+						position++
 					}
-				}
-			l1384:
-				depth--
-				add(ruleBooleanLiteral, position1383)
-			}
-			return true
-		l1382:
-			position, tokenIndex, depth = position1382, tokenIndex1382, depth1382
-			return false
-		},
-		/* 116 TRUE <- <(<(('t' / 'T') ('r' / 'R') ('u' / 'U') ('e' / 'E'))> Action86)> */
-		func() bool {
-			position1386, tokenIndex1386, depth1386 := position, tokenIndex, depth
-			{
-				position1387 := position
-				depth++
-				{
-					position1388 := position
-					depth++
+				l2083:
 					{
-						position1389, tokenIndex1389, depth1389 := position, tokenIndex, depth
-						if buffer[position] != rune('t') {
-							goto l1390
+						position2085, tokenIndex2085, depth2085 := position, tokenIndex, depth
+						if buffer[position] != rune('l') {
+							goto l2086
 						}
 						position++
-						goto l1389
-					l1390:
-						position, tokenIndex, depth = position1389, tokenIndex1389, depth1389
-						if buffer[position] != rune('T') {
-							goto l1386
+						goto l2085
+					l2086:
+						position, tokenIndex, depth = position2085, tokenIndex2085, depth2085
+						if buffer[position] != rune('L') {
+							goto l2078
 						}
 						position++
 					}
-				l1389:
+				l2085:
 					{
-						position1391, tokenIndex1391, depth1391 := position, tokenIndex, depth
-						if buffer[position] != rune('r') {
-							goto l1392
+						position2087, tokenIndex2087, depth2087 := position, tokenIndex, depth
+						if buffer[position] != rune('l') {
+							goto l2088
 						}
 						position++
-						goto l1391
-					l1392:
-						position, tokenIndex, depth = position1391, tokenIndex1391, depth1391
-						if buffer[position] != rune('R') {
-							goto l1386
+						goto l2087
+					l2088:
+						position, tokenIndex, depth = position2087, tokenIndex2087, depth2087
+						if buffer[position] != rune('L') {
+							goto l2078
 						}
 						position++
 					}
-				l1391:
+				l2087:
 					{
-						position1393, tokenIndex1393, depth1393 := position, tokenIndex, depth
-						if buffer[position] != rune('u') {
-							goto l1394
+						position2089, tokenIndex2089, depth2089 := position, tokenIndex, depth
+						if buffer[position] != rune('i') {
+							goto l2090
 						}
 						position++
-						goto l1393
-					l1394:
-						position, tokenIndex, depth = position1393, tokenIndex1393, depth1393
-						if buffer[position] != rune('U') {
-							goto l1386
+						goto l2089
+					l2090:
+						position, tokenIndex, depth = position2089, tokenIndex2089, depth2089
+						if buffer[position] != rune('I') {
+							goto l2078
+						}
+						position++
+					}
+				l2089:
+					{
+						position2091, tokenIndex2091, depth2091 := position, tokenIndex, depth
+						if buffer[position] != rune('s') {
+							goto l2092
+						}
+						position++
+						goto l2091
+					l2092:
+						position, tokenIndex, depth = position2091, tokenIndex2091, depth2091
+						if buffer[position] != rune('S') {
+							goto l2078
 						}
 						position++
 					}
-				l1393:
+				l2091:
 					{
-						position1395, tokenIndex1395, depth1395 := position, tokenIndex, depth
+						position2093, tokenIndex2093, depth2093 := position, tokenIndex, depth
 						if buffer[position] != rune('e') {
-							goto l1396
+							goto l2094
 						}
 						position++
-						goto l1395
-					l1396:
-						position, tokenIndex, depth = position1395, tokenIndex1395, depth1395
+						goto l2093
+					l2094:
+						position, tokenIndex, depth = position2093, tokenIndex2093, depth2093
 						if buffer[position] != rune('E') {
-							goto l1386
+							goto l2078
 						}
 						position++
 					}
-				l1395:
-					depth--
-					add(rulePegText, position1388)
-				}
-				if !_rules[ruleAction86]() {
-					goto l1386
-				}
-				depth--
-				add(ruleTRUE, position1387)
-			}
-			return true
-		l1386:
-			position, tokenIndex, depth = position1386, tokenIndex1386, depth1386
-			return false
-		},
-		/* 117 FALSE <- <(<(('f' / 'F') ('a' / 'A') ('l' / 'L') ('s' / 'S') ('e' / 'E'))> Action87)> */
-		func() bool {
-			position1397, tokenIndex1397, depth1397 := position, tokenIndex, depth
-			{
-				position1398 := position
-				depth++
-				{
-					position1399 := position
-					depth++
+				l2093:
 					{
-						position1400, tokenIndex1400, depth1400 := position, tokenIndex, depth
-						if buffer[position] != rune('f') {
-							goto l1401
+						position2095, tokenIndex2095, depth2095 := position, tokenIndex, depth
+						if buffer[position] != rune('c') {
+							goto l2096
 						}
 						position++
-						goto l1400
-					l1401:
-						position, tokenIndex, depth = position1400, tokenIndex1400, depth1400
-						if buffer[position] != rune('F') {
-							goto l1397
+						goto l2095
+					l2096:
+						position, tokenIndex, depth = position2095, tokenIndex2095, depth2095
+						if buffer[position] != rune('C') {
+							goto l2078
 						}
 						position++
 					}
-				l1400:
+				l2095:
 					{
-						position1402, tokenIndex1402, depth1402 := position, tokenIndex, depth
-						if buffer[position] != rune('a') {
-							goto l1403
+						position2097, tokenIndex2097, depth2097 := position, tokenIndex, depth
+						if buffer[position] != rune('o') {
+							goto l2098
 						}
 						position++
-						goto l1402
-					l1403:
-						position, tokenIndex, depth = position1402, tokenIndex1402, depth1402
-						if buffer[position] != rune('A') {
-							goto l1397
+						goto l2097
+					l2098:
+						position, tokenIndex, depth = position2097, tokenIndex2097, depth2097
+						if buffer[position] != rune('O') {
+							goto l2078
 						}
 						position++
 					}
-				l1402:
+				l2097:
 					{
-						position1404, tokenIndex1404, depth1404 := position, tokenIndex, depth
-						if buffer[position] != rune('l') {
-							goto l1405
+						position2099, tokenIndex2099, depth2099 := position, tokenIndex, depth
+						if buffer[position] != rune('n') {
+							goto l2100
 						}
 						position++
-						goto l1404
-					l1405:
-						position, tokenIndex, depth = position1404, tokenIndex1404, depth1404
-						if buffer[position] != rune('L') {
-							goto l1397
+						goto l2099
+					l2100:
+						position, tokenIndex, depth = position2099, tokenIndex2099, depth2099
+						if buffer[position] != rune('N') {
+							goto l2078
 						}
 						position++
 					}
-				l1404:
+				l2099:
 					{
-						position1406, tokenIndex1406, depth1406 := position, tokenIndex, depth
-						if buffer[position] != rune('s') {
-							goto l1407
+						position2101, tokenIndex2101, depth2101 := position, tokenIndex, depth
+						if buffer[position] != rune('d') {
+							goto l2102
 						}
 						position++
-						goto l1406
-					l1407:
-						position, tokenIndex, depth = position1406, tokenIndex1406, depth1406
-						if buffer[position] != rune('S') {
-							goto l1397
+						goto l2101
+					l2102:
+						position, tokenIndex, depth = position2101, tokenIndex2101, depth2101
+						if buffer[position] != rune('D') {
+							goto l2078
 						}
 						position++
 					}
-				l1406:
+				l2101:
 					{
-						position1408, tokenIndex1408, depth1408 := position, tokenIndex, depth
-						if buffer[position] != rune('e') {
-							goto l1409
+						position2103, tokenIndex2103, depth2103 := position, tokenIndex, depth
+						if buffer[position] != rune('s') {
+							goto l2104
 						}
 						position++
-						goto l1408
-					l1409:
-						position, tokenIndex, depth = position1408, tokenIndex1408, depth1408
-						if buffer[position] != rune('E') {
-							goto l1397
+						goto l2103
+					l2104:
+						position, tokenIndex, depth = position2103, tokenIndex2103, depth2103
+						if buffer[position] != rune('S') {
+							goto l2078
 						}
 						position++
 					}
-				l1408:
+				l2103:
 					depth--
-					add(rulePegText, position1399)
+					add(rulePegText, position2080)
 				}
-				if !_rules[ruleAction87]() {
-					goto l1397
+				if !_rules[ruleAction120]() {
+					goto l2078
 				}
 				depth--
-				add(ruleFALSE, position1398)
+				add(ruleMILLISECONDS, position2079)
 			}
 			return true
-		l1397:
-			position, tokenIndex, depth = position1397, tokenIndex1397, depth1397
+		l2078:
+			position, tokenIndex, depth = position2078, tokenIndex2078, depth2078
 			return false
 		},
-		/* 118 Wildcard <- <(<((ident ':' !':')? '*')> Action88)> */
+		/* 158 InnerJoin <- <(<((('i' / 'I') ('n' / 'N') ('n' / 'N') ('e' / 'E') ('r' / 'R') sp)? (('j' / 'J') ('o' / 'O') ('i' / 'I') ('n' / 'N')))> Action121)> */
 		func() bool {
-			position1410, tokenIndex1410, depth1410 := position, tokenIndex, depth
+			position2105, tokenIndex2105, depth2105 := position, tokenIndex, depth
 			{
-				position1411 := position
+				position2106 := position
 				depth++
 				{
-					position1412 := position
+					position2107 := position
 					depth++
 					{
-						position1413, tokenIndex1413, depth1413 := position, tokenIndex, depth
-						if !_rules[ruleident]() {
-							goto l1413
+						position2108, tokenIndex2108, depth2108 := position, tokenIndex, depth
+						{
+							position2110, tokenIndex2110, depth2110 := position, tokenIndex, depth
+							if buffer[position] != rune('i') {
+								goto l2111
+							}
+							position++
+							goto l2110
+						l2111:
+							position, tokenIndex, depth = position2110, tokenIndex2110, depth2110
+							if buffer[position] != rune('I') {
+								goto l2108
+							}
+							position++
 						}
-						if buffer[position] != rune(':') {
-							goto l1413
+					l2110:
+						{
+							position2112, tokenIndex2112, depth2112 := position, tokenIndex, depth
+							if buffer[position] != rune('n') {
+								goto l2113
+							}
+							position++
+							goto l2112
+						l2113:
+							position, tokenIndex, depth = position2112, tokenIndex2112, depth2112
+							if buffer[position] != rune('N') {
+								goto l2108
+							}
+							position++
 						}
-						position++
+					l2112:
 						{
-							position1415, tokenIndex1415, depth1415 := position, tokenIndex, depth
-							if buffer[position] != rune(':') {
-								goto l1415
+							position2114, tokenIndex2114, depth2114 := position, tokenIndex, depth
+							if buffer[position] != rune('n') {
+								goto l2115
+							}
+							position++
+							goto l2114
+						l2115:
+							position, tokenIndex, depth = position2114, tokenIndex2114, depth2114
+							if buffer[position] != rune('N') {
+								goto l2108
 							}
 							position++
-							goto l1413
-						l1415:
-							position, tokenIndex, depth = position1415, tokenIndex1415, depth1415
 						}
-						goto l1414
-					l1413:
-						position, tokenIndex, depth = position1413, tokenIndex1413, depth1413
-					}
-				l1414:
-					if buffer[position] != rune('*') {
-						goto l1410
-					}
-					position++
-					depth--
-					add(rulePegText, position1412)
-				}
-				if !_rules[ruleAction88]() {
-					goto l1410
-				}
-				depth--
-				add(ruleWildcard, position1411)
-			}
-			return true
-		l1410:
-			position, tokenIndex, depth = position1410, tokenIndex1410, depth1410
-			return false
-		},
-		/* 119 StringLiteral <- <(<('"' (('"' '"') / (!'"' .))* '"')> Action89)> */
-		func() bool {
-			position1416, tokenIndex1416, depth1416 := position, tokenIndex, depth
-			{
-				position1417 := position
-				depth++
-				{
-					position1418 := position
-					depth++
-					if buffer[position] != rune('"') {
-						goto l1416
-					}
-					position++
-				l1419:
-					{
-						position1420, tokenIndex1420, depth1420 := position, tokenIndex, depth
+					l2114:
 						{
-							position1421, tokenIndex1421, depth1421 := position, tokenIndex, depth
-							if buffer[position] != rune('"') {
-								goto l1422
+							position2116, tokenIndex2116, depth2116 := position, tokenIndex, depth
+							if buffer[position] != rune('e') {
+								goto l2117
 							}
 							position++
-							if buffer[position] != rune('"') {
-								goto l1422
+							goto l2116
+						l2117:
+							position, tokenIndex, depth = position2116, tokenIndex2116, depth2116
+							if buffer[position] != rune('E') {
+								goto l2108
 							}
 							position++
-							goto l1421
-						l1422:
-							position, tokenIndex, depth = position1421, tokenIndex1421, depth1421
-							{
-								position1423, tokenIndex1423, depth1423 := position, tokenIndex, depth
-								if buffer[position] != rune('"') {
-									goto l1423
-								}
-								position++
-								goto l1420
-							l1423:
-								position, tokenIndex, depth = position1423, tokenIndex1423, depth1423
+						}
+					l2116:
+						{
+							position2118, tokenIndex2118, depth2118 := position, tokenIndex, depth
+							if buffer[position] != rune('r') {
+								goto l2119
 							}
-							if !matchDot() {
-								goto l1420
+							position++
+							goto l2118
+						l2119:
+							position, tokenIndex, depth = position2118, tokenIndex2118, depth2118
+							if buffer[position] != rune('R') {
+								goto l2108
 							}
+							position++
 						}
-					l1421:
-						goto l1419
-					l1420:
-						position, tokenIndex, depth = position1420, tokenIndex1420, depth1420
+					l2118:
+						if !_rules[rulesp]() {
+							goto l2108
+						}
+						goto l2109
+					l2108:
+						position, tokenIndex, depth = position2108, tokenIndex2108, depth2108
 					}
-					if buffer[position] != rune('"') {
-						goto l1416
+				l2109:
+					{
+						position2120, tokenIndex2120, depth2120 := position, tokenIndex, depth
+						if buffer[position] != rune('j') {
+							goto l2121
+						}
+						position++
+						goto l2120
+					l2121:
+						position, tokenIndex, depth = position2120, tokenIndex2120, depth2120
+						if buffer[position] != rune('J') {
+							goto l2105
+						}
+						position++
+					}
+				l2120:
+					{
+						position2122, tokenIndex2122, depth2122 := position, tokenIndex, depth
+						if buffer[position] != rune('o') {
+							goto l2123
+						}
+						position++
+						goto l2122
+					l2123:
+						position, tokenIndex, depth = position2122, tokenIndex2122, depth2122
+						if buffer[position] != rune('O') {
+							goto l2105
+						}
+						position++
+					}
+				l2122:
+					{
+						position2124, tokenIndex2124, depth2124 := position, tokenIndex, depth
+						if buffer[position] != rune('i') {
+							goto l2125
+						}
+						position++
+						goto l2124
+					l2125:
+						position, tokenIndex, depth = position2124, tokenIndex2124, depth2124
+						if buffer[position] != rune('I') {
+							goto l2105
+						}
+						position++
+					}
+				l2124:
+					{
+						position2126, tokenIndex2126, depth2126 := position, tokenIndex, depth
+						if buffer[position] != rune('n') {
+							goto l2127
+						}
+						position++
+						goto l2126
+					l2127:
+						position, tokenIndex, depth = position2126, tokenIndex2126, depth2126
+						if buffer[position] != rune('N') {
+							goto l2105
+						}
+						position++
 					}
-					position++
+				l2126:
 					depth--
-					add(rulePegText, position1418)
+					add(rulePegText, position2107)
 				}
-				if !_rules[ruleAction89]() {
-					goto l1416
+				if !_rules[ruleAction121]() {
+					goto l2105
 				}
 				depth--
-				add(ruleStringLiteral, position1417)
+				add(ruleInnerJoin, position2106)
 			}
 			return true
-		l1416:
-			position, tokenIndex, depth = position1416, tokenIndex1416, depth1416
+		l2105:
+			position, tokenIndex, depth = position2105, tokenIndex2105, depth2105
 			return false
 		},
-		/* 120 ISTREAM <- <(<(('i' / 'I') ('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M'))> Action90)> */
+		/* 159 LeftOuterJoin <- <(<(('l' / 'L') ('e' / 'E') ('f' / 'F') ('t' / 'T') sp (('o' / 'O') ('u' / 'U') ('t' / 'T') ('e' / 'E') ('r' / 'R') sp)? (('j' / 'J') ('o' / 'O') ('i' / 'I') ('n' / 'N')))> Action122)> */
 		func() bool {
-			position1424, tokenIndex1424, depth1424 := position, tokenIndex, depth
+			position2128, tokenIndex2128, depth2128 := position, tokenIndex, depth
 			{
-				position1425 := position
+				position2129 := position
 				depth++
 				{
-					position1426 := position
+					position2130 := position
 					depth++
 					{
-						position1427, tokenIndex1427, depth1427 := position, tokenIndex, depth
-						if buffer[position] != rune('i') {
-							goto l1428
+						position2131, tokenIndex2131, depth2131 := position, tokenIndex, depth
+						if buffer[position] != rune('l') {
+							goto l2132
 						}
 						position++
-						goto l1427
-					l1428:
-						position, tokenIndex, depth = position1427, tokenIndex1427, depth1427
-						if buffer[position] != rune('I') {
-							goto l1424
+						goto l2131
+					l2132:
+						position, tokenIndex, depth = position2131, tokenIndex2131, depth2131
+						if buffer[position] != rune('L') {
+							goto l2128
 						}
 						position++
 					}
-				l1427:
+				l2131:
 					{
-						position1429, tokenIndex1429, depth1429 := position, tokenIndex, depth
-						if buffer[position] != rune('s') {
-							goto l1430
+						position2133, tokenIndex2133, depth2133 := position, tokenIndex, depth
+						if buffer[position] != rune('e') {
+							goto l2134
 						}
 						position++
-						goto l1429
-					l1430:
-						position, tokenIndex, depth = position1429, tokenIndex1429, depth1429
-						if buffer[position] != rune('S') {
-							goto l1424
+						goto l2133
+					l2134:
+						position, tokenIndex, depth = position2133, tokenIndex2133, depth2133
+						if buffer[position] != rune('E') {
+							goto l2128
 						}
 						position++
 					}
-				l1429:
+				l2133:
+					{
+						position2135, tokenIndex2135, depth2135 := position, tokenIndex, depth
+						if buffer[position] != rune('f') {
+							goto l2136
+						}
+						position++
+						goto l2135
+					l2136:
+						position, tokenIndex, depth = position2135, tokenIndex2135, depth2135
+						if buffer[position] != rune('F') {
+							goto l2128
+						}
+						position++
+					}
+				l2135:
 					{
-						position1431, tokenIndex1431, depth1431 := position, tokenIndex, depth
+						position2137, tokenIndex2137, depth2137 := position, tokenIndex, depth
 						if buffer[position] != rune('t') {
-							goto l1432
+							goto l2138
 						}
 						position++
-						goto l1431
-					l1432:
-						position, tokenIndex, depth = position1431, tokenIndex1431, depth1431
+						goto l2137
+					l2138:
+						position, tokenIndex, depth = position2137, tokenIndex2137, depth2137
 						if buffer[position] != rune('T') {
-							goto l1424
+							goto l2128
 						}
 						position++
 					}
-				l1431:
+				l2137:
+					if !_rules[rulesp]() {
+						goto l2128
+					}
 					{
-						position1433, tokenIndex1433, depth1433 := position, tokenIndex, depth
-						if buffer[position] != rune('r') {
-							goto l1434
+						position2139, tokenIndex2139, depth2139 := position, tokenIndex, depth
+						{
+							position2141, tokenIndex2141, depth2141 := position, tokenIndex, depth
+							if buffer[position] != rune('o') {
+								goto l2142
+							}
+							position++
+							goto l2141
+						l2142:
+							position, tokenIndex, depth = position2141, tokenIndex2141, depth2141
+							if buffer[position] != rune('O') {
+								goto l2139
+							}
+							position++
+						}
+					l2141:
+						{
+							position2143, tokenIndex2143, depth2143 := position, tokenIndex, depth
+							if buffer[position] != rune('u') {
+								goto l2144
+							}
+							position++
+							goto l2143
+						l2144:
+							position, tokenIndex, depth = position2143, tokenIndex2143, depth2143
+							if buffer[position] != rune('U') {
+								goto l2139
+							}
+							position++
+						}
+					l2143:
+						{
+							position2145, tokenIndex2145, depth2145 := position, tokenIndex, depth
+							if buffer[position] != rune('t') {
+								goto l2146
+							}
+							position++
+							goto l2145
+						l2146:
+							position, tokenIndex, depth = position2145, tokenIndex2145, depth2145
+							if buffer[position] != rune('T') {
+								goto l2139
+							}
+							position++
+						}
+					l2145:
+						{
+							position2147, tokenIndex2147, depth2147 := position, tokenIndex, depth
+							if buffer[position] != rune('e') {
+								goto l2148
+							}
+							position++
+							goto l2147
+						l2148:
+							position, tokenIndex, depth = position2147, tokenIndex2147, depth2147
+							if buffer[position] != rune('E') {
+								goto l2139
+							}
+							position++
+						}
+					l2147:
+						{
+							position2149, tokenIndex2149, depth2149 := position, tokenIndex, depth
+							if buffer[position] != rune('r') {
+								goto l2150
+							}
+							position++
+							goto l2149
+						l2150:
+							position, tokenIndex, depth = position2149, tokenIndex2149, depth2149
+							if buffer[position] != rune('R') {
+								goto l2139
+							}
+							position++
+						}
+					l2149:
+						if !_rules[rulesp]() {
+							goto l2139
+						}
+						goto l2140
+					l2139:
+						position, tokenIndex, depth = position2139, tokenIndex2139, depth2139
+					}
+				l2140:
+					{
+						position2151, tokenIndex2151, depth2151 := position, tokenIndex, depth
+						if buffer[position] != rune('j') {
+							goto l2152
 						}
 						position++
-						goto l1433
-					l1434:
-						position, tokenIndex, depth = position1433, tokenIndex1433, depth1433
-						if buffer[position] != rune('R') {
-							goto l1424
+						goto l2151
+					l2152:
+						position, tokenIndex, depth = position2151, tokenIndex2151, depth2151
+						if buffer[position] != rune('J') {
+							goto l2128
 						}
 						position++
 					}
-				l1433:
+				l2151:
 					{
-						position1435, tokenIndex1435, depth1435 := position, tokenIndex, depth
-						if buffer[position] != rune('e') {
-							goto l1436
+						position2153, tokenIndex2153, depth2153 := position, tokenIndex, depth
+						if buffer[position] != rune('o') {
+							goto l2154
 						}
 						position++
-						goto l1435
-					l1436:
-						position, tokenIndex, depth = position1435, tokenIndex1435, depth1435
-						if buffer[position] != rune('E') {
-							goto l1424
+						goto l2153
+					l2154:
+						position, tokenIndex, depth = position2153, tokenIndex2153, depth2153
+						if buffer[position] != rune('O') {
+							goto l2128
 						}
 						position++
 					}
-				l1435:
+				l2153:
 					{
-						position1437, tokenIndex1437, depth1437 := position, tokenIndex, depth
-						if buffer[position] != rune('a') {
-							goto l1438
+						position2155, tokenIndex2155, depth2155 := position, tokenIndex, depth
+						if buffer[position] != rune('i') {
+							goto l2156
 						}
 						position++
-						goto l1437
-					l1438:
-						position, tokenIndex, depth = position1437, tokenIndex1437, depth1437
-						if buffer[position] != rune('A') {
-							goto l1424
+						goto l2155
+					l2156:
+						position, tokenIndex, depth = position2155, tokenIndex2155, depth2155
+						if buffer[position] != rune('I') {
+							goto l2128
 						}
 						position++
 					}
-				l1437:
+				l2155:
 					{
-						position1439, tokenIndex1439, depth1439 := position, tokenIndex, depth
-						if buffer[position] != rune('m') {
-							goto l1440
+						position2157, tokenIndex2157, depth2157 := position, tokenIndex, depth
+						if buffer[position] != rune('n') {
+							goto l2158
 						}
 						position++
-						goto l1439
-					l1440:
-						position, tokenIndex, depth = position1439, tokenIndex1439, depth1439
-						if buffer[position] != rune('M') {
-							goto l1424
+						goto l2157
+					l2158:
+						position, tokenIndex, depth = position2157, tokenIndex2157, depth2157
+						if buffer[position] != rune('N') {
+							goto l2128
 						}
 						position++
 					}
-				l1439:
+				l2157:
 					depth--
-					add(rulePegText, position1426)
+					add(rulePegText, position2130)
 				}
-				if !_rules[ruleAction90]() {
-					goto l1424
+				if !_rules[ruleAction122]() {
+					goto l2128
 				}
 				depth--
-				add(ruleISTREAM, position1425)
+				add(ruleLeftOuterJoin, position2129)
 			}
 			return true
-		l1424:
-			position, tokenIndex, depth = position1424, tokenIndex1424, depth1424
+		l2128:
+			position, tokenIndex, depth = position2128, tokenIndex2128, depth2128
 			return false
 		},
-		/* 121 DSTREAM <- <(<(('d' / 'D') ('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M'))> Action91)> */
+		/* 160 Wait <- <(<(('w' / 'W') ('a' / 'A') ('i' / 'I') ('t' / 'T'))> Action123)> */
 		func() bool {
-			position1441, tokenIndex1441, depth1441 := position, tokenIndex, depth
+			position2159, tokenIndex2159, depth2159 := position, tokenIndex, depth
 			{
-				position1442 := position
+				position2160 := position
 				depth++
 				{
-					position1443 := position
+					position2161 := position
 					depth++
 					{
-						position1444, tokenIndex1444, depth1444 := position, tokenIndex, depth
-						if buffer[position] != rune('d') {
-							goto l1445
+						position2162, tokenIndex2162, depth2162 := position, tokenIndex, depth
+						if buffer[position] != rune('w') {
+							goto l2163
 						}
 						position++
-						goto l1444
-					l1445:
-						position, tokenIndex, depth = position1444, tokenIndex1444, depth1444
-						if buffer[position] != rune('D') {
-							goto l1441
+						goto l2162
+					l2163:
+						position, tokenIndex, depth = position2162, tokenIndex2162, depth2162
+						if buffer[position] != rune('W') {
+							goto l2159
 						}
 						position++
 					}
-				l1444:
+				l2162:
 					{
-						position1446, tokenIndex1446, depth1446 := position, tokenIndex, depth
-						if buffer[position] != rune('s') {
-							goto l1447
+						position2164, tokenIndex2164, depth2164 := position, tokenIndex, depth
+						if buffer[position] != rune('a') {
+							goto l2165
 						}
 						position++
-						goto l1446
-					l1447:
-						position, tokenIndex, depth = position1446, tokenIndex1446, depth1446
-						if buffer[position] != rune('S') {
-							goto l1441
+						goto l2164
+					l2165:
+						position, tokenIndex, depth = position2164, tokenIndex2164, depth2164
+						if buffer[position] != rune('A') {
+							goto l2159
 						}
 						position++
 					}
-				l1446:
+				l2164:
 					{
-						position1448, tokenIndex1448, depth1448 := position, tokenIndex, depth
-						if buffer[position] != rune('t') {
-							goto l1449
+						position2166, tokenIndex2166, depth2166 := position, tokenIndex, depth
+						if buffer[position] != rune('i') {
+							goto l2167
 						}
 						position++
-						goto l1448
-					l1449:
-						position, tokenIndex, depth = position1448, tokenIndex1448, depth1448
-						if buffer[position] != rune('T') {
-							goto l1441
+						goto l2166
+					l2167:
+						position, tokenIndex, depth = position2166, tokenIndex2166, depth2166
+						if buffer[position] != rune('I') {
+							goto l2159
 						}
 						position++
 					}
-				l1448:
+				l2166:
 					{
-						position1450, tokenIndex1450, depth1450 := position, tokenIndex, depth
-						if buffer[position] != rune('r') {
-							goto l1451
+						position2168, tokenIndex2168, depth2168 := position, tokenIndex, depth
+						if buffer[position] != rune('t') {
+							goto l2169
 						}
 						position++
-						goto l1450
-					l1451:
-						position, tokenIndex, depth = position1450, tokenIndex1450, depth1450
-						if buffer[position] != rune('R') {
-							goto l1441
+						goto l2168
+					l2169:
+						position, tokenIndex, depth = position2168, tokenIndex2168, depth2168
+						if buffer[position] != rune('T') {
+							goto l2159
 						}
 						position++
 					}
-				l1450:
+				l2168:
+					depth--
+					add(rulePegText, position2161)
+				}
+				if !_rules[ruleAction123]() {
+					goto l2159
+				}
+				depth--
+				add(ruleWait, position2160)
+			}
+			return true
+		l2159:
+			position, tokenIndex, depth = position2159, tokenIndex2159, depth2159
+			return false
+		},
+		/* 161 DropOldest <- <(<(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('o' / 'O') ('l' / 'L') ('d' / 'D') ('e' / 'E') ('s' / 'S') ('t' / 'T')))> Action124)> */
+		func() bool {
+			position2170, tokenIndex2170, depth2170 := position, tokenIndex, depth
+			{
+				position2171 := position
+				depth++
+				{
+					position2172 := position
+					depth++
 					{
-						position1452, tokenIndex1452, depth1452 := position, tokenIndex, depth
-						if buffer[position] != rune('e') {
-							goto l1453
+						position2173, tokenIndex2173, depth2173 := position, tokenIndex, depth
+						if buffer[position] != rune('d') {
+							goto l2174
 						}
 						position++
-						goto l1452
-					l1453:
-						position, tokenIndex, depth = position1452, tokenIndex1452, depth1452
-						if buffer[position] != rune('E') {
-							goto l1441
+						goto l2173
+					l2174:
+						position, tokenIndex, depth = position2173, tokenIndex2173, depth2173
+						if buffer[position] != rune('D') {
+							goto l2170
 						}
 						position++
 					}
-				l1452:
+				l2173:
 					{
-						position1454, tokenIndex1454, depth1454 := position, tokenIndex, depth
-						if buffer[position] != rune('a') {
-							goto l1455
+						position2175, tokenIndex2175, depth2175 := position, tokenIndex, depth
+						if buffer[position] != rune('r') {
+							goto l2176
 						}
 						position++
-						goto l1454
-					l1455:
-						position, tokenIndex, depth = position1454, tokenIndex1454, depth1454
-						if buffer[position] != rune('A') {
-							goto l1441
+						goto l2175
+					l2176:
+						position, tokenIndex, depth = position2175, tokenIndex2175, depth2175
+						if buffer[position] != rune('R') {
+							goto l2170
 						}
 						position++
 					}
-				l1454:
+				l2175:
 					{
-						position1456, tokenIndex1456, depth1456 := position, tokenIndex, depth
-						if buffer[position] != rune('m') {
-							goto l1457
+						position2177, tokenIndex2177, depth2177 := position, tokenIndex, depth
+						if buffer[position] != rune('o') {
+							goto l2178
 						}
 						position++
-						goto l1456
-					l1457:
-						position, tokenIndex, depth = position1456, tokenIndex1456, depth1456
-						if buffer[position] != rune('M') {
-							goto l1441
+						goto l2177
+					l2178:
+						position, tokenIndex, depth = position2177, tokenIndex2177, depth2177
+						if buffer[position] != rune('O') {
+							goto l2170
 						}
 						position++
 					}
-				l1456:
-					depth--
-					add(rulePegText, position1443)
-				}
-				if !_rules[ruleAction91]() {
-					goto l1441
-				}
-				depth--
-				add(ruleDSTREAM, position1442)
-			}
-			return true
-		l1441:
-			position, tokenIndex, depth = position1441, tokenIndex1441, depth1441
-			return false
-		},
-		/* 122 RSTREAM <- <(<(('r' / 'R') ('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M'))> Action92)> */
-		func() bool {
-			position1458, tokenIndex1458, depth1458 := position, tokenIndex, depth
-			{
-				position1459 := position
-				depth++
-				{
-					position1460 := position
-					depth++
+				l2177:
 					{
-						position1461, tokenIndex1461, depth1461 := position, tokenIndex, depth
-						if buffer[position] != rune('r') {
-							goto l1462
+						position2179, tokenIndex2179, depth2179 := position, tokenIndex, depth
+						if buffer[position] != rune('p') {
+							goto l2180
 						}
 						position++
-						goto l1461
-					l1462:
-						position, tokenIndex, depth = position1461, tokenIndex1461, depth1461
-						if buffer[position] != rune('R') {
-							goto l1458
+						goto l2179
+					l2180:
+						position, tokenIndex, depth = position2179, tokenIndex2179, depth2179
+						if buffer[position] != rune('P') {
+							goto l2170
 						}
 						position++
 					}
-				l1461:
+				l2179:
+					if !_rules[rulesp]() {
+						goto l2170
+					}
 					{
-						position1463, tokenIndex1463, depth1463 := position, tokenIndex, depth
-						if buffer[position] != rune('s') {
-							goto l1464
+						position2181, tokenIndex2181, depth2181 := position, tokenIndex, depth
+						if buffer[position] != rune('o') {
+							goto l2182
 						}
 						position++
-						goto l1463
-					l1464:
-						position, tokenIndex, depth = position1463, tokenIndex1463, depth1463
-						if buffer[position] != rune('S') {
-							goto l1458
+						goto l2181
+					l2182:
+						position, tokenIndex, depth = position2181, tokenIndex2181, depth2181
+						if buffer[position] != rune('O') {
+							goto l2170
 						}
 						position++
 					}
-				l1463:
+				l2181:
 					{
-						position1465, tokenIndex1465, depth1465 := position, tokenIndex, depth
-						if buffer[position] != rune('t') {
-							goto l1466
+						position2183, tokenIndex2183, depth2183 := position, tokenIndex, depth
+						if buffer[position] != rune('l') {
+							goto l2184
 						}
 						position++
-						goto l1465
-					l1466:
-						position, tokenIndex, depth = position1465, tokenIndex1465, depth1465
-						if buffer[position] != rune('T') {
-							goto l1458
+						goto l2183
+					l2184:
+						position, tokenIndex, depth = position2183, tokenIndex2183, depth2183
+						if buffer[position] != rune('L') {
+							goto l2170
 						}
 						position++
 					}
-				l1465:
+				l2183:
 					{
-						position1467, tokenIndex1467, depth1467 := position, tokenIndex, depth
-						if buffer[position] != rune('r') {
-							goto l1468
+						position2185, tokenIndex2185, depth2185 := position, tokenIndex, depth
+						if buffer[position] != rune('d') {
+							goto l2186
 						}
 						position++
-						goto l1467
-					l1468:
-						position, tokenIndex, depth = position1467, tokenIndex1467, depth1467
-						if buffer[position] != rune('R') {
-							goto l1458
+						goto l2185
+					l2186:
+						position, tokenIndex, depth = position2185, tokenIndex2185, depth2185
+						if buffer[position] != rune('D') {
+							goto l2170
 						}
 						position++
 					}
-				l1467:
+				l2185:
 					{
-						position1469, tokenIndex1469, depth1469 := position, tokenIndex, depth
+						position2187, tokenIndex2187, depth2187 := position, tokenIndex, depth
 						if buffer[position] != rune('e') {
-							goto l1470
+							goto l2188
 						}
 						position++
-						goto l1469
-					l1470:
-						position, tokenIndex, depth = position1469, tokenIndex1469, depth1469
+						goto l2187
+					l2188:
+						position, tokenIndex, depth = position2187, tokenIndex2187, depth2187
 						if buffer[position] != rune('E') {
-							goto l1458
+							goto l2170
 						}
 						position++
 					}
-				l1469:
+				l2187:
 					{
-						position1471, tokenIndex1471, depth1471 := position, tokenIndex, depth
-						if buffer[position] != rune('a') {
-							goto l1472
+						position2189, tokenIndex2189, depth2189 := position, tokenIndex, depth
+						if buffer[position] != rune('s') {
+							goto l2190
 						}
 						position++
-						goto l1471
-					l1472:
-						position, tokenIndex, depth = position1471, tokenIndex1471, depth1471
-						if buffer[position] != rune('A') {
-							goto l1458
+						goto l2189
+					l2190:
+						position, tokenIndex, depth = position2189, tokenIndex2189, depth2189
+						if buffer[position] != rune('S') {
+							goto l2170
 						}
 						position++
 					}
-				l1471:
+				l2189:
 					{
-						position1473, tokenIndex1473, depth1473 := position, tokenIndex, depth
-						if buffer[position] != rune('m') {
-							goto l1474
+						position2191, tokenIndex2191, depth2191 := position, tokenIndex, depth
+						if buffer[position] != rune('t') {
+							goto l2192
 						}
 						position++
-						goto l1473
-					l1474:
-						position, tokenIndex, depth = position1473, tokenIndex1473, depth1473
-						if buffer[position] != rune('M') {
-							goto l1458
+						goto l2191
+					l2192:
+						position, tokenIndex, depth = position2191, tokenIndex2191, depth2191
+						if buffer[position] != rune('T') {
+							goto l2170
 						}
 						position++
 					}
-				l1473:
+				l2191:
 					depth--
-					add(rulePegText, position1460)
+					add(rulePegText, position2172)
 				}
-				if !_rules[ruleAction92]() {
-					goto l1458
+				if !_rules[ruleAction124]() {
+					goto l2170
 				}
 				depth--
-				add(ruleRSTREAM, position1459)
+				add(ruleDropOldest, position2171)
 			}
 			return true
-		l1458:
-			position, tokenIndex, depth = position1458, tokenIndex1458, depth1458
+		l2170:
+			position, tokenIndex, depth = position2170, tokenIndex2170, depth2170
 			return false
 		},
-		/* 123 TUPLES <- <(<(('t' / 'T') ('u' / 'U') ('p' / 'P') ('l' / 'L') ('e' / 'E') ('s' / 'S'))> Action93)> */
+		/* 162 DropNewest <- <(<(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('n' / 'N') ('e' / 'E') ('w' / 'W') ('e' / 'E') ('s' / 'S') ('t' / 'T')))> Action125)> */
 		func() bool {
-			position1475, tokenIndex1475, depth1475 := position, tokenIndex, depth
+			position2193, tokenIndex2193, depth2193 := position, tokenIndex, depth
 			{
-				position1476 := position
+				position2194 := position
 				depth++
 				{
-					position1477 := position
+					position2195 := position
 					depth++
 					{
-						position1478, tokenIndex1478, depth1478 := position, tokenIndex, depth
-						if buffer[position] != rune('t') {
-							goto l1479
+						position2196, tokenIndex2196, depth2196 := position, tokenIndex, depth
+						if buffer[position] != rune('d') {
+							goto l2197
 						}
 						position++
-						goto l1478
-					l1479:
-						position, tokenIndex, depth = position1478, tokenIndex1478, depth1478
-						if buffer[position] != rune('T') {
-							goto l1475
+						goto l2196
+					l2197:
+						position, tokenIndex, depth = position2196, tokenIndex2196, depth2196
+						if buffer[position] != rune('D') {
+							goto l2193
 						}
 						position++
 					}
-				l1478:
+				l2196:
 					{
-						position1480, tokenIndex1480, depth1480 := position, tokenIndex, depth
-						if buffer[position] != rune('u') {
-							goto l1481
+						position2198, tokenIndex2198, depth2198 := position, tokenIndex, depth
+						if buffer[position] != rune('r') {
+							goto l2199
 						}
 						position++
-						goto l1480
-					l1481:
-						position, tokenIndex, depth = position1480, tokenIndex1480, depth1480
-						if buffer[position] != rune('U') {
-							goto l1475
+						goto l2198
+					l2199:
+						position, tokenIndex, depth = position2198, tokenIndex2198, depth2198
+						if buffer[position] != rune('R') {
+							goto l2193
+						}
+						position++
+					}
+				l2198:
+					{
+						position2200, tokenIndex2200, depth2200 := position, tokenIndex, depth
+						if buffer[position] != rune('o') {
+							goto l2201
+						}
+						position++
+						goto l2200
+					l2201:
+						position, tokenIndex, depth = position2200, tokenIndex2200, depth2200
+						if buffer[position] != rune('O') {
+							goto l2193
 						}
 						position++
 					}
-				l1480:
+				l2200:
 					{
-						position1482, tokenIndex1482, depth1482 := position, tokenIndex, depth
+						position2202, tokenIndex2202, depth2202 := position, tokenIndex, depth
 						if buffer[position] != rune('p') {
-							goto l1483
+							goto l2203
 						}
 						position++
-						goto l1482
-					l1483:
-						position, tokenIndex, depth = position1482, tokenIndex1482, depth1482
+						goto l2202
+					l2203:
+						position, tokenIndex, depth = position2202, tokenIndex2202, depth2202
 						if buffer[position] != rune('P') {
-							goto l1475
+							goto l2193
+						}
+						position++
+					}
+				l2202:
+					if !_rules[rulesp]() {
+						goto l2193
+					}
+					{
+						position2204, tokenIndex2204, depth2204 := position, tokenIndex, depth
+						if buffer[position] != rune('n') {
+							goto l2205
+						}
+						position++
+						goto l2204
+					l2205:
+						position, tokenIndex, depth = position2204, tokenIndex2204, depth2204
+						if buffer[position] != rune('N') {
+							goto l2193
 						}
 						position++
 					}
-				l1482:
+				l2204:
 					{
-						position1484, tokenIndex1484, depth1484 := position, tokenIndex, depth
-						if buffer[position] != rune('l') {
-							goto l1485
+						position2206, tokenIndex2206, depth2206 := position, tokenIndex, depth
+						if buffer[position] != rune('e') {
+							goto l2207
 						}
 						position++
-						goto l1484
-					l1485:
-						position, tokenIndex, depth = position1484, tokenIndex1484, depth1484
-						if buffer[position] != rune('L') {
-							goto l1475
+						goto l2206
+					l2207:
+						position, tokenIndex, depth = position2206, tokenIndex2206, depth2206
+						if buffer[position] != rune('E') {
+							goto l2193
+						}
+						position++
+					}
+				l2206:
+					{
+						position2208, tokenIndex2208, depth2208 := position, tokenIndex, depth
+						if buffer[position] != rune('w') {
+							goto l2209
+						}
+						position++
+						goto l2208
+					l2209:
+						position, tokenIndex, depth = position2208, tokenIndex2208, depth2208
+						if buffer[position] != rune('W') {
+							goto l2193
 						}
 						position++
 					}
-				l1484:
+				l2208:
 					{
-						position1486, tokenIndex1486, depth1486 := position, tokenIndex, depth
+						position2210, tokenIndex2210, depth2210 := position, tokenIndex, depth
 						if buffer[position] != rune('e') {
-							goto l1487
+							goto l2211
 						}
 						position++
-						goto l1486
-					l1487:
-						position, tokenIndex, depth = position1486, tokenIndex1486, depth1486
+						goto l2210
+					l2211:
+						position, tokenIndex, depth = position2210, tokenIndex2210, depth2210
 						if buffer[position] != rune('E') {
-							goto l1475
+							goto l2193
+						}
+						position++
+					}
+				l2210:
+					{
+						position2212, tokenIndex2212, depth2212 := position, tokenIndex, depth
+						if buffer[position] != rune('s') {
+							goto l2213
+						}
+						position++
+						goto l2212
+					l2213:
+						position, tokenIndex, depth = position2212, tokenIndex2212, depth2212
+						if buffer[position] != rune('S') {
+							goto l2193
+						}
+						position++
+					}
+				l2212:
+					{
+						position2214, tokenIndex2214, depth2214 := position, tokenIndex, depth
+						if buffer[position] != rune('t') {
+							goto l2215
+						}
+						position++
+						goto l2214
+					l2215:
+						position, tokenIndex, depth = position2214, tokenIndex2214, depth2214
+						if buffer[position] != rune('T') {
+							goto l2193
 						}
 						position++
 					}
-				l1486:
-					{
-						position1488, tokenIndex1488, depth1488 := position, tokenIndex, depth
-						if buffer[position] != rune('s') {
-							goto l1489
-						}
-						position++
-						goto l1488
-					l1489:
-						position, tokenIndex, depth = position1488, tokenIndex1488, depth1488
-						if buffer[position] != rune('S') {
-							goto l1475
-						}
-						position++
+				l2214:
+					depth--
+					add(rulePegText, position2195)
+				}
+				if !_rules[ruleAction125]() {
+					goto l2193
+				}
+				depth--
+				add(ruleDropNewest, position2194)
+			}
+			return true
+		l2193:
+			position, tokenIndex, depth = position2193, tokenIndex2193, depth2193
+			return false
+		},
+		/* 163 StreamIdentifier <- <(<ident> Action126)> */
+		func() bool {
+			position2216, tokenIndex2216, depth2216 := position, tokenIndex, depth
+			{
+				position2217 := position
+				depth++
+				{
+					position2218 := position
+					depth++
+					if !_rules[ruleident]() {
+						goto l2216
+					}
+					depth--
+					add(rulePegText, position2218)
+				}
+				if !_rules[ruleAction126]() {
+					goto l2216
+				}
+				depth--
+				add(ruleStreamIdentifier, position2217)
+			}
+			return true
+		l2216:
+			position, tokenIndex, depth = position2216, tokenIndex2216, depth2216
+			return false
+		},
+		/* 164 SourceSinkType <- <(<ident> Action127)> */
+		func() bool {
+			position2219, tokenIndex2219, depth2219 := position, tokenIndex, depth
+			{
+				position2220 := position
+				depth++
+				{
+					position2221 := position
+					depth++
+					if !_rules[ruleident]() {
+						goto l2219
 					}
-				l1488:
 					depth--
-					add(rulePegText, position1477)
+					add(rulePegText, position2221)
 				}
-				if !_rules[ruleAction93]() {
-					goto l1475
+				if !_rules[ruleAction127]() {
+					goto l2219
 				}
 				depth--
-				add(ruleTUPLES, position1476)
+				add(ruleSourceSinkType, position2220)
 			}
 			return true
-		l1475:
-			position, tokenIndex, depth = position1475, tokenIndex1475, depth1475
+		l2219:
+			position, tokenIndex, depth = position2219, tokenIndex2219, depth2219
+			return false
+		},
+		/* 165 SourceSinkParamKey <- <(<ident> Action128)> */
+		func() bool {
+			position2222, tokenIndex2222, depth2222 := position, tokenIndex, depth
+			{
+				position2223 := position
+				depth++
+				{
+					position2224 := position
+					depth++
+					if !_rules[ruleident]() {
+						goto l2222
+					}
+					depth--
+					add(rulePegText, position2224)
+				}
+				if !_rules[ruleAction128]() {
+					goto l2222
+				}
+				depth--
+				add(ruleSourceSinkParamKey, position2223)
+			}
+			return true
+		l2222:
+			position, tokenIndex, depth = position2222, tokenIndex2222, depth2222
 			return false
 		},
-		/* 124 SECONDS <- <(<(('s' / 'S') ('e' / 'E') ('c' / 'C') ('o' / 'O') ('n' / 'N') ('d' / 'D') ('s' / 'S'))> Action94)> */
+		/* 166 Paused <- <(<(('p' / 'P') ('a' / 'A') ('u' / 'U') ('s' / 'S') ('e' / 'E') ('d' / 'D'))> Action129)> */
 		func() bool {
-			position1490, tokenIndex1490, depth1490 := position, tokenIndex, depth
+			position2225, tokenIndex2225, depth2225 := position, tokenIndex, depth
 			{
-				position1491 := position
+				position2226 := position
 				depth++
 				{
-					position1492 := position
+					position2227 := position
 					depth++
 					{
-						position1493, tokenIndex1493, depth1493 := position, tokenIndex, depth
-						if buffer[position] != rune('s') {
-							goto l1494
+						position2228, tokenIndex2228, depth2228 := position, tokenIndex, depth
+						if buffer[position] != rune('p') {
+							goto l2229
 						}
 						position++
-						goto l1493
-					l1494:
-						position, tokenIndex, depth = position1493, tokenIndex1493, depth1493
-						if buffer[position] != rune('S') {
-							goto l1490
+						goto l2228
+					l2229:
+						position, tokenIndex, depth = position2228, tokenIndex2228, depth2228
+						if buffer[position] != rune('P') {
+							goto l2225
 						}
 						position++
 					}
-				l1493:
+				l2228:
 					{
-						position1495, tokenIndex1495, depth1495 := position, tokenIndex, depth
-						if buffer[position] != rune('e') {
-							goto l1496
+						position2230, tokenIndex2230, depth2230 := position, tokenIndex, depth
+						if buffer[position] != rune('a') {
+							goto l2231
 						}
 						position++
-						goto l1495
-					l1496:
-						position, tokenIndex, depth = position1495, tokenIndex1495, depth1495
-						if buffer[position] != rune('E') {
-							goto l1490
+						goto l2230
+					l2231:
+						position, tokenIndex, depth = position2230, tokenIndex2230, depth2230
+						if buffer[position] != rune('A') {
+							goto l2225
 						}
 						position++
 					}
-				l1495:
+				l2230:
 					{
-						position1497, tokenIndex1497, depth1497 := position, tokenIndex, depth
-						if buffer[position] != rune('c') {
-							goto l1498
+						position2232, tokenIndex2232, depth2232 := position, tokenIndex, depth
+						if buffer[position] != rune('u') {
+							goto l2233
 						}
 						position++
-						goto l1497
-					l1498:
-						position, tokenIndex, depth = position1497, tokenIndex1497, depth1497
-						if buffer[position] != rune('C') {
-							goto l1490
+						goto l2232
+					l2233:
+						position, tokenIndex, depth = position2232, tokenIndex2232, depth2232
+						if buffer[position] != rune('U') {
+							goto l2225
 						}
 						position++
 					}
-				l1497:
+				l2232:
 					{
-						position1499, tokenIndex1499, depth1499 := position, tokenIndex, depth
-						if buffer[position] != rune('o') {
-							goto l1500
+						position2234, tokenIndex2234, depth2234 := position, tokenIndex, depth
+						if buffer[position] != rune('s') {
+							goto l2235
 						}
 						position++
-						goto l1499
-					l1500:
-						position, tokenIndex, depth = position1499, tokenIndex1499, depth1499
-						if buffer[position] != rune('O') {
-							goto l1490
+						goto l2234
+					l2235:
+						position, tokenIndex, depth = position2234, tokenIndex2234, depth2234
+						if buffer[position] != rune('S') {
+							goto l2225
 						}
 						position++
 					}
-				l1499:
+				l2234:
 					{
-						position1501, tokenIndex1501, depth1501 := position, tokenIndex, depth
-						if buffer[position] != rune('n') {
-							goto l1502
+						position2236, tokenIndex2236, depth2236 := position, tokenIndex, depth
+						if buffer[position] != rune('e') {
+							goto l2237
 						}
 						position++
-						goto l1501
-					l1502:
-						position, tokenIndex, depth = position1501, tokenIndex1501, depth1501
-						if buffer[position] != rune('N') {
-							goto l1490
+						goto l2236
+					l2237:
+						position, tokenIndex, depth = position2236, tokenIndex2236, depth2236
+						if buffer[position] != rune('E') {
+							goto l2225
 						}
 						position++
 					}
-				l1501:
+				l2236:
 					{
-						position1503, tokenIndex1503, depth1503 := position, tokenIndex, depth
+						position2238, tokenIndex2238, depth2238 := position, tokenIndex, depth
 						if buffer[position] != rune('d') {
-							goto l1504
+							goto l2239
 						}
 						position++
-						goto l1503
-					l1504:
-						position, tokenIndex, depth = position1503, tokenIndex1503, depth1503
+						goto l2238
+					l2239:
+						position, tokenIndex, depth = position2238, tokenIndex2238, depth2238
 						if buffer[position] != rune('D') {
-							goto l1490
-						}
-						position++
-					}
-				l1503:
-					{
-						position1505, tokenIndex1505, depth1505 := position, tokenIndex, depth
-						if buffer[position] != rune('s') {
-							goto l1506
-						}
-						position++
-						goto l1505
-					l1506:
-						position, tokenIndex, depth = position1505, tokenIndex1505, depth1505
-						if buffer[position] != rune('S') {
-							goto l1490
+							goto l2225
 						}
 						position++
 					}
-				l1505:
+				l2238:
 					depth--
-					add(rulePegText, position1492)
+					add(rulePegText, position2227)
 				}
-				if !_rules[ruleAction94]() {
-					goto l1490
+				if !_rules[ruleAction129]() {
+					goto l2225
 				}
 				depth--
-				add(ruleSECONDS, position1491)
+				add(rulePaused, position2226)
 			}
 			return true
-		l1490:
-			position, tokenIndex, depth = position1490, tokenIndex1490, depth1490
+		l2225:
+			position, tokenIndex, depth = position2225, tokenIndex2225, depth2225
 			return false
 		},
-		/* 125 MILLISECONDS <- <(<(('m' / 'M') ('i' / 'I') ('l' / 'L') ('l' / 'L') ('i' / 'I') ('s' / 'S') ('e' / 'E') ('c' / 'C') ('o' / 'O') ('n' / 'N') ('d' / 'D') ('s' / 'S'))> Action95)> */
+		/* 167 Unpaused <- <(<(('u' / 'U') ('n' / 'N') ('p' / 'P') ('a' / 'A') ('u' / 'U') ('s' / 'S') ('e' / 'E') ('d' / 'D'))> Action130)> */
 		func() bool {
-			position1507, tokenIndex1507, depth1507 := position, tokenIndex, depth
+			position2240, tokenIndex2240, depth2240 := position, tokenIndex, depth
 			{
-				position1508 := position
+				position2241 := position
 				depth++
 				{
-					position1509 := position
+					position2242 := position
 					depth++
 					{
-						position1510, tokenIndex1510, depth1510 := position, tokenIndex, depth
-						if buffer[position] != rune('m') {
-							goto l1511
+						position2243, tokenIndex2243, depth2243 := position, tokenIndex, depth
+						if buffer[position] != rune('u') {
+							goto l2244
 						}
 						position++
-						goto l1510
-					l1511:
-						position, tokenIndex, depth = position1510, tokenIndex1510, depth1510
-						if buffer[position] != rune('M') {
-							goto l1507
+						goto l2243
+					l2244:
+						position, tokenIndex, depth = position2243, tokenIndex2243, depth2243
+						if buffer[position] != rune('U') {
+							goto l2240
 						}
 						position++
 					}
-				l1510:
+				l2243:
 					{
-						position1512, tokenIndex1512, depth1512 := position, tokenIndex, depth
-						if buffer[position] != rune('i') {
-							goto l1513
+						position2245, tokenIndex2245, depth2245 := position, tokenIndex, depth
+						if buffer[position] != rune('n') {
+							goto l2246
 						}
 						position++
-						goto l1512
-					l1513:
-						position, tokenIndex, depth = position1512, tokenIndex1512, depth1512
-						if buffer[position] != rune('I') {
-							goto l1507
+						goto l2245
+					l2246:
+						position, tokenIndex, depth = position2245, tokenIndex2245, depth2245
+						if buffer[position] != rune('N') {
+							goto l2240
 						}
 						position++
 					}
-				l1512:
+				l2245:
 					{
-						position1514, tokenIndex1514, depth1514 := position, tokenIndex, depth
-						if buffer[position] != rune('l') {
-							goto l1515
+						position2247, tokenIndex2247, depth2247 := position, tokenIndex, depth
+						if buffer[position] != rune('p') {
+							goto l2248
 						}
 						position++
-						goto l1514
-					l1515:
-						position, tokenIndex, depth = position1514, tokenIndex1514, depth1514
-						if buffer[position] != rune('L') {
-							goto l1507
+						goto l2247
+					l2248:
+						position, tokenIndex, depth = position2247, tokenIndex2247, depth2247
+						if buffer[position] != rune('P') {
+							goto l2240
 						}
 						position++
 					}
-				l1514:
+				l2247:
 					{
-						position1516, tokenIndex1516, depth1516 := position, tokenIndex, depth
-						if buffer[position] != rune('l') {
-							goto l1517
+						position2249, tokenIndex2249, depth2249 := position, tokenIndex, depth
+						if buffer[position] != rune('a') {
+							goto l2250
 						}
 						position++
-						goto l1516
-					l1517:
-						position, tokenIndex, depth = position1516, tokenIndex1516, depth1516
-						if buffer[position] != rune('L') {
-							goto l1507
+						goto l2249
+					l2250:
+						position, tokenIndex, depth = position2249, tokenIndex2249, depth2249
+						if buffer[position] != rune('A') {
+							goto l2240
 						}
 						position++
 					}
-				l1516:
+				l2249:
 					{
-						position1518, tokenIndex1518, depth1518 := position, tokenIndex, depth
-						if buffer[position] != rune('i') {
-							goto l1519
+						position2251, tokenIndex2251, depth2251 := position, tokenIndex, depth
+						if buffer[position] != rune('u') {
+							goto l2252
 						}
 						position++
-						goto l1518
-					l1519:
-						position, tokenIndex, depth = position1518, tokenIndex1518, depth1518
-						if buffer[position] != rune('I') {
-							goto l1507
+						goto l2251
+					l2252:
+						position, tokenIndex, depth = position2251, tokenIndex2251, depth2251
+						if buffer[position] != rune('U') {
+							goto l2240
 						}
 						position++
 					}
-				l1518:
+				l2251:
 					{
-						position1520, tokenIndex1520, depth1520 := position, tokenIndex, depth
+						position2253, tokenIndex2253, depth2253 := position, tokenIndex, depth
 						if buffer[position] != rune('s') {
-							goto l1521
+							goto l2254
 						}
 						position++
-						goto l1520
-					l1521:
-						position, tokenIndex, depth = position1520, tokenIndex1520, depth1520
+						goto l2253
+					l2254:
+						position, tokenIndex, depth = position2253, tokenIndex2253, depth2253
 						if buffer[position] != rune('S') {
-							goto l1507
+							goto l2240
 						}
 						position++
 					}
-				l1520:
+				l2253:
 					{
-						position1522, tokenIndex1522, depth1522 := position, tokenIndex, depth
+						position2255, tokenIndex2255, depth2255 := position, tokenIndex, depth
 						if buffer[position] != rune('e') {
-							goto l1523
+							goto l2256
 						}
 						position++
-						goto l1522
-					l1523:
-						position, tokenIndex, depth = position1522, tokenIndex1522, depth1522
+						goto l2255
+					l2256:
+						position, tokenIndex, depth = position2255, tokenIndex2255, depth2255
 						if buffer[position] != rune('E') {
-							goto l1507
-						}
-						position++
-					}
-				l1522:
-					{
-						position1524, tokenIndex1524, depth1524 := position, tokenIndex, depth
-						if buffer[position] != rune('c') {
-							goto l1525
-						}
-						position++
-						goto l1524
-					l1525:
-						position, tokenIndex, depth = position1524, tokenIndex1524, depth1524
-						if buffer[position] != rune('C') {
-							goto l1507
-						}
-						position++
-					}
-				l1524:
-					{
-						position1526, tokenIndex1526, depth1526 := position, tokenIndex, depth
-						if buffer[position] != rune('o') {
-							goto l1527
-						}
-						position++
-						goto l1526
-					l1527:
-						position, tokenIndex, depth = position1526, tokenIndex1526, depth1526
-						if buffer[position] != rune('O') {
-							goto l1507
-						}
-						position++
-					}
-				l1526:
-					{
-						position1528, tokenIndex1528, depth1528 := position, tokenIndex, depth
-						if buffer[position] != rune('n') {
-							goto l1529
-						}
-						position++
-						goto l1528
-					l1529:
-						position, tokenIndex, depth = position1528, tokenIndex1528, depth1528
-						if buffer[position] != rune('N') {
-							goto l1507
+							goto l2240
 						}
 						position++
 					}
-				l1528:
+				l2255:
 					{
-						position1530, tokenIndex1530, depth1530 := position, tokenIndex, depth
+						position2257, tokenIndex2257, depth2257 := position, tokenIndex, depth
 						if buffer[position] != rune('d') {
-							goto l1531
+							goto l2258
 						}
 						position++
-						goto l1530
-					l1531:
-						position, tokenIndex, depth = position1530, tokenIndex1530, depth1530
+						goto l2257
+					l2258:
+						position, tokenIndex, depth = position2257, tokenIndex2257, depth2257
 						if buffer[position] != rune('D') {
-							goto l1507
-						}
-						position++
-					}
-				l1530:
-					{
-						position1532, tokenIndex1532, depth1532 := position, tokenIndex, depth
-						if buffer[position] != rune('s') {
-							goto l1533
-						}
-						position++
-						goto l1532
-					l1533:
-						position, tokenIndex, depth = position1532, tokenIndex1532, depth1532
-						if buffer[position] != rune('S') {
-							goto l1507
+							goto l2240
 						}
 						position++
 					}
-				l1532:
+				l2257:
 					depth--
-					add(rulePegText, position1509)
+					add(rulePegText, position2242)
 				}
-				if !_rules[ruleAction95]() {
-					goto l1507
+				if !_rules[ruleAction130]() {
+					goto l2240
 				}
 				depth--
-				add(ruleMILLISECONDS, position1508)
+				add(ruleUnpaused, position2241)
 			}
 			return true
-		l1507:
-			position, tokenIndex, depth = position1507, tokenIndex1507, depth1507
+		l2240:
+			position, tokenIndex, depth = position2240, tokenIndex2240, depth2240
 			return false
 		},
-		/* 126 Wait <- <(<(('w' / 'W') ('a' / 'A') ('i' / 'I') ('t' / 'T'))> Action96)> */
+		/* 168 Ascending <- <(<(('a' / 'A') ('s' / 'S') ('c' / 'C'))> Action131)> */
 		func() bool {
-			position1534, tokenIndex1534, depth1534 := position, tokenIndex, depth
+			position2259, tokenIndex2259, depth2259 := position, tokenIndex, depth
 			{
-				position1535 := position
+				position2260 := position
 				depth++
 				{
-					position1536 := position
+					position2261 := position
 					depth++
 					{
-						position1537, tokenIndex1537, depth1537 := position, tokenIndex, depth
-						if buffer[position] != rune('w') {
-							goto l1538
-						}
-						position++
-						goto l1537
-					l1538:
-						position, tokenIndex, depth = position1537, tokenIndex1537, depth1537
-						if buffer[position] != rune('W') {
-							goto l1534
-						}
-						position++
-					}
-				l1537:
-					{
-						position1539, tokenIndex1539, depth1539 := position, tokenIndex, depth
+						position2262, tokenIndex2262, depth2262 := position, tokenIndex, depth
 						if buffer[position] != rune('a') {
-							goto l1540
+							goto l2263
 						}
 						position++
-						goto l1539
-					l1540:
-						position, tokenIndex, depth = position1539, tokenIndex1539, depth1539
+						goto l2262
+					l2263:
+						position, tokenIndex, depth = position2262, tokenIndex2262, depth2262
 						if buffer[position] != rune('A') {
-							goto l1534
+							goto l2259
 						}
 						position++
 					}
-				l1539:
+				l2262:
 					{
-						position1541, tokenIndex1541, depth1541 := position, tokenIndex, depth
-						if buffer[position] != rune('i') {
-							goto l1542
+						position2264, tokenIndex2264, depth2264 := position, tokenIndex, depth
+						if buffer[position] != rune('s') {
+							goto l2265
 						}
 						position++
-						goto l1541
-					l1542:
-						position, tokenIndex, depth = position1541, tokenIndex1541, depth1541
-						if buffer[position] != rune('I') {
-							goto l1534
+						goto l2264
+					l2265:
+						position, tokenIndex, depth = position2264, tokenIndex2264, depth2264
+						if buffer[position] != rune('S') {
+							goto l2259
 						}
 						position++
 					}
-				l1541:
+				l2264:
 					{
-						position1543, tokenIndex1543, depth1543 := position, tokenIndex, depth
-						if buffer[position] != rune('t') {
-							goto l1544
+						position2266, tokenIndex2266, depth2266 := position, tokenIndex, depth
+						if buffer[position] != rune('c') {
+							goto l2267
 						}
 						position++
-						goto l1543
-					l1544:
-						position, tokenIndex, depth = position1543, tokenIndex1543, depth1543
-						if buffer[position] != rune('T') {
-							goto l1534
+						goto l2266
+					l2267:
+						position, tokenIndex, depth = position2266, tokenIndex2266, depth2266
+						if buffer[position] != rune('C') {
+							goto l2259
 						}
 						position++
 					}
-				l1543:
+				l2266:
 					depth--
-					add(rulePegText, position1536)
+					add(rulePegText, position2261)
 				}
-				if !_rules[ruleAction96]() {
-					goto l1534
+				if !_rules[ruleAction131]() {
+					goto l2259
 				}
 				depth--
-				add(ruleWait, position1535)
+				add(ruleAscending, position2260)
 			}
 			return true
-		l1534:
-			position, tokenIndex, depth = position1534, tokenIndex1534, depth1534
+		l2259:
+			position, tokenIndex, depth = position2259, tokenIndex2259, depth2259
 			return false
 		},
-		/* 127 DropOldest <- <(<(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('o' / 'O') ('l' / 'L') ('d' / 'D') ('e' / 'E') ('s' / 'S') ('t' / 'T')))> Action97)> */
+		/* 169 Descending <- <(<(('d' / 'D') ('e' / 'E') ('s' / 'S') ('c' / 'C'))> Action132)> */
 		func() bool {
-			position1545, tokenIndex1545, depth1545 := position, tokenIndex, depth
+			position2268, tokenIndex2268, depth2268 := position, tokenIndex, depth
 			{
-				position1546 := position
+				position2269 := position
 				depth++
 				{
-					position1547 := position
+					position2270 := position
 					depth++
 					{
-						position1548, tokenIndex1548, depth1548 := position, tokenIndex, depth
+						position2271, tokenIndex2271, depth2271 := position, tokenIndex, depth
 						if buffer[position] != rune('d') {
-							goto l1549
+							goto l2272
 						}
 						position++
-						goto l1548
-					l1549:
-						position, tokenIndex, depth = position1548, tokenIndex1548, depth1548
+						goto l2271
+					l2272:
+						position, tokenIndex, depth = position2271, tokenIndex2271, depth2271
 						if buffer[position] != rune('D') {
-							goto l1545
+							goto l2268
 						}
 						position++
 					}
-				l1548:
+				l2271:
 					{
-						position1550, tokenIndex1550, depth1550 := position, tokenIndex, depth
-						if buffer[position] != rune('r') {
-							goto l1551
+						position2273, tokenIndex2273, depth2273 := position, tokenIndex, depth
+						if buffer[position] != rune('e') {
+							goto l2274
 						}
 						position++
-						goto l1550
-					l1551:
-						position, tokenIndex, depth = position1550, tokenIndex1550, depth1550
-						if buffer[position] != rune('R') {
-							goto l1545
+						goto l2273
+					l2274:
+						position, tokenIndex, depth = position2273, tokenIndex2273, depth2273
+						if buffer[position] != rune('E') {
+							goto l2268
 						}
 						position++
 					}
-				l1550:
+				l2273:
 					{
-						position1552, tokenIndex1552, depth1552 := position, tokenIndex, depth
-						if buffer[position] != rune('o') {
-							goto l1553
+						position2275, tokenIndex2275, depth2275 := position, tokenIndex, depth
+						if buffer[position] != rune('s') {
+							goto l2276
 						}
 						position++
-						goto l1552
-					l1553:
-						position, tokenIndex, depth = position1552, tokenIndex1552, depth1552
-						if buffer[position] != rune('O') {
-							goto l1545
+						goto l2275
+					l2276:
+						position, tokenIndex, depth = position2275, tokenIndex2275, depth2275
+						if buffer[position] != rune('S') {
+							goto l2268
 						}
 						position++
 					}
-				l1552:
+				l2275:
 					{
-						position1554, tokenIndex1554, depth1554 := position, tokenIndex, depth
-						if buffer[position] != rune('p') {
-							goto l1555
+						position2277, tokenIndex2277, depth2277 := position, tokenIndex, depth
+						if buffer[position] != rune('c') {
+							goto l2278
 						}
 						position++
-						goto l1554
-					l1555:
-						position, tokenIndex, depth = position1554, tokenIndex1554, depth1554
-						if buffer[position] != rune('P') {
-							goto l1545
+						goto l2277
+					l2278:
+						position, tokenIndex, depth = position2277, tokenIndex2277, depth2277
+						if buffer[position] != rune('C') {
+							goto l2268
 						}
 						position++
 					}
-				l1554:
-					if !_rules[rulesp]() {
-						goto l1545
+				l2277:
+					depth--
+					add(rulePegText, position2270)
+				}
+				if !_rules[ruleAction132]() {
+					goto l2268
+				}
+				depth--
+				add(ruleDescending, position2269)
+			}
+			return true
+		l2268:
+			position, tokenIndex, depth = position2268, tokenIndex2268, depth2268
+			return false
+		},
+		/* 170 Type <- <(Bool / Int / Float / String / Blob / Timestamp / Array / Map)> */
+		func() bool {
+			position2279, tokenIndex2279, depth2279 := position, tokenIndex, depth
+			{
+				position2280 := position
+				depth++
+				{
+					position2281, tokenIndex2281, depth2281 := position, tokenIndex, depth
+					if !_rules[ruleBool]() {
+						goto l2282
 					}
-					{
-						position1556, tokenIndex1556, depth1556 := position, tokenIndex, depth
-						if buffer[position] != rune('o') {
-							goto l1557
-						}
-						position++
-						goto l1556
-					l1557:
-						position, tokenIndex, depth = position1556, tokenIndex1556, depth1556
-						if buffer[position] != rune('O') {
-							goto l1545
-						}
-						position++
+					goto l2281
+				l2282:
+					position, tokenIndex, depth = position2281, tokenIndex2281, depth2281
+					if !_rules[ruleInt]() {
+						goto l2283
 					}
-				l1556:
-					{
-						position1558, tokenIndex1558, depth1558 := position, tokenIndex, depth
-						if buffer[position] != rune('l') {
-							goto l1559
-						}
-						position++
-						goto l1558
-					l1559:
-						position, tokenIndex, depth = position1558, tokenIndex1558, depth1558
-						if buffer[position] != rune('L') {
-							goto l1545
-						}
-						position++
+					goto l2281
+				l2283:
+					position, tokenIndex, depth = position2281, tokenIndex2281, depth2281
+					if !_rules[ruleFloat]() {
+						goto l2284
+					}
+					goto l2281
+				l2284:
+					position, tokenIndex, depth = position2281, tokenIndex2281, depth2281
+					if !_rules[ruleString]() {
+						goto l2285
+					}
+					goto l2281
+				l2285:
+					position, tokenIndex, depth = position2281, tokenIndex2281, depth2281
+					if !_rules[ruleBlob]() {
+						goto l2286
+					}
+					goto l2281
+				l2286:
+					position, tokenIndex, depth = position2281, tokenIndex2281, depth2281
+					if !_rules[ruleTimestamp]() {
+						goto l2287
+					}
+					goto l2281
+				l2287:
+					position, tokenIndex, depth = position2281, tokenIndex2281, depth2281
+					if !_rules[ruleArray]() {
+						goto l2288
 					}
-				l1558:
+					goto l2281
+				l2288:
+					position, tokenIndex, depth = position2281, tokenIndex2281, depth2281
+					if !_rules[ruleMap]() {
+						goto l2279
+					}
+				}
+			l2281:
+				depth--
+				add(ruleType, position2280)
+			}
+			return true
+		l2279:
+			position, tokenIndex, depth = position2279, tokenIndex2279, depth2279
+			return false
+		},
+		/* 171 Bool <- <(<(('b' / 'B') ('o' / 'O') ('o' / 'O') ('l' / 'L'))> Action133)> */
+		func() bool {
+			position2289, tokenIndex2289, depth2289 := position, tokenIndex, depth
+			{
+				position2290 := position
+				depth++
+				{
+					position2291 := position
+					depth++
 					{
-						position1560, tokenIndex1560, depth1560 := position, tokenIndex, depth
-						if buffer[position] != rune('d') {
-							goto l1561
+						position2292, tokenIndex2292, depth2292 := position, tokenIndex, depth
+						if buffer[position] != rune('b') {
+							goto l2293
 						}
 						position++
-						goto l1560
-					l1561:
-						position, tokenIndex, depth = position1560, tokenIndex1560, depth1560
-						if buffer[position] != rune('D') {
-							goto l1545
+						goto l2292
+					l2293:
+						position, tokenIndex, depth = position2292, tokenIndex2292, depth2292
+						if buffer[position] != rune('B') {
+							goto l2289
 						}
 						position++
 					}
-				l1560:
+				l2292:
 					{
-						position1562, tokenIndex1562, depth1562 := position, tokenIndex, depth
-						if buffer[position] != rune('e') {
-							goto l1563
+						position2294, tokenIndex2294, depth2294 := position, tokenIndex, depth
+						if buffer[position] != rune('o') {
+							goto l2295
 						}
 						position++
-						goto l1562
-					l1563:
-						position, tokenIndex, depth = position1562, tokenIndex1562, depth1562
-						if buffer[position] != rune('E') {
-							goto l1545
+						goto l2294
+					l2295:
+						position, tokenIndex, depth = position2294, tokenIndex2294, depth2294
+						if buffer[position] != rune('O') {
+							goto l2289
 						}
 						position++
 					}
-				l1562:
+				l2294:
 					{
-						position1564, tokenIndex1564, depth1564 := position, tokenIndex, depth
-						if buffer[position] != rune('s') {
-							goto l1565
+						position2296, tokenIndex2296, depth2296 := position, tokenIndex, depth
+						if buffer[position] != rune('o') {
+							goto l2297
 						}
 						position++
-						goto l1564
-					l1565:
-						position, tokenIndex, depth = position1564, tokenIndex1564, depth1564
-						if buffer[position] != rune('S') {
-							goto l1545
+						goto l2296
+					l2297:
+						position, tokenIndex, depth = position2296, tokenIndex2296, depth2296
+						if buffer[position] != rune('O') {
+							goto l2289
 						}
 						position++
 					}
-				l1564:
+				l2296:
 					{
-						position1566, tokenIndex1566, depth1566 := position, tokenIndex, depth
-						if buffer[position] != rune('t') {
-							goto l1567
+						position2298, tokenIndex2298, depth2298 := position, tokenIndex, depth
+						if buffer[position] != rune('l') {
+							goto l2299
 						}
 						position++
-						goto l1566
-					l1567:
-						position, tokenIndex, depth = position1566, tokenIndex1566, depth1566
-						if buffer[position] != rune('T') {
-							goto l1545
+						goto l2298
+					l2299:
+						position, tokenIndex, depth = position2298, tokenIndex2298, depth2298
+						if buffer[position] != rune('L') {
+							goto l2289
 						}
 						position++
 					}
-				l1566:
+				l2298:
 					depth--
-					add(rulePegText, position1547)
+					add(rulePegText, position2291)
 				}
-				if !_rules[ruleAction97]() {
-					goto l1545
+				if !_rules[ruleAction133]() {
+					goto l2289
 				}
 				depth--
-				add(ruleDropOldest, position1546)
+				add(ruleBool, position2290)
 			}
 			return true
-		l1545:
-			position, tokenIndex, depth = position1545, tokenIndex1545, depth1545
+		l2289:
+			position, tokenIndex, depth = position2289, tokenIndex2289, depth2289
 			return false
 		},
-		/* 128 DropNewest <- <(<(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('n' / 'N') ('e' / 'E') ('w' / 'W') ('e' / 'E') ('s' / 'S') ('t' / 'T')))> Action98)> */
+		/* 172 Int <- <(<(('i' / 'I') ('n' / 'N') ('t' / 'T'))> Action134)> */
 		func() bool {
-			position1568, tokenIndex1568, depth1568 := position, tokenIndex, depth
+			position2300, tokenIndex2300, depth2300 := position, tokenIndex, depth
 			{
-				position1569 := position
+				position2301 := position
 				depth++
 				{
-					position1570 := position
+					position2302 := position
 					depth++
 					{
-						position1571, tokenIndex1571, depth1571 := position, tokenIndex, depth
-						if buffer[position] != rune('d') {
-							goto l1572
-						}
-						position++
-						goto l1571
-					l1572:
-						position, tokenIndex, depth = position1571, tokenIndex1571, depth1571
-						if buffer[position] != rune('D') {
-							goto l1568
-						}
-						position++
-					}
-				l1571:
-					{
-						position1573, tokenIndex1573, depth1573 := position, tokenIndex, depth
-						if buffer[position] != rune('r') {
-							goto l1574
-						}
-						position++
-						goto l1573
-					l1574:
-						position, tokenIndex, depth = position1573, tokenIndex1573, depth1573
-						if buffer[position] != rune('R') {
-							goto l1568
-						}
-						position++
-					}
-				l1573:
-					{
-						position1575, tokenIndex1575, depth1575 := position, tokenIndex, depth
-						if buffer[position] != rune('o') {
-							goto l1576
+						position2303, tokenIndex2303, depth2303 := position, tokenIndex, depth
+						if buffer[position] != rune('i') {
+							goto l2304
 						}
 						position++
-						goto l1575
-					l1576:
-						position, tokenIndex, depth = position1575, tokenIndex1575, depth1575
-						if buffer[position] != rune('O') {
-							goto l1568
+						goto l2303
+					l2304:
+						position, tokenIndex, depth = position2303, tokenIndex2303, depth2303
+						if buffer[position] != rune('I') {
+							goto l2300
 						}
 						position++
 					}
-				l1575:
+				l2303:
 					{
-						position1577, tokenIndex1577, depth1577 := position, tokenIndex, depth
-						if buffer[position] != rune('p') {
-							goto l1578
+						position2305, tokenIndex2305, depth2305 := position, tokenIndex, depth
+						if buffer[position] != rune('n') {
+							goto l2306
 						}
 						position++
-						goto l1577
-					l1578:
-						position, tokenIndex, depth = position1577, tokenIndex1577, depth1577
-						if buffer[position] != rune('P') {
-							goto l1568
+						goto l2305
+					l2306:
+						position, tokenIndex, depth = position2305, tokenIndex2305, depth2305
+						if buffer[position] != rune('N') {
+							goto l2300
 						}
 						position++
 					}
-				l1577:
-					if !_rules[rulesp]() {
-						goto l1568
-					}
+				l2305:
 					{
-						position1579, tokenIndex1579, depth1579 := position, tokenIndex, depth
-						if buffer[position] != rune('n') {
-							goto l1580
+						position2307, tokenIndex2307, depth2307 := position, tokenIndex, depth
+						if buffer[position] != rune('t') {
+							goto l2308
 						}
 						position++
-						goto l1579
-					l1580:
-						position, tokenIndex, depth = position1579, tokenIndex1579, depth1579
-						if buffer[position] != rune('N') {
-							goto l1568
+						goto l2307
+					l2308:
+						position, tokenIndex, depth = position2307, tokenIndex2307, depth2307
+						if buffer[position] != rune('T') {
+							goto l2300
 						}
 						position++
 					}
-				l1579:
+				l2307:
+					depth--
+					add(rulePegText, position2302)
+				}
+				if !_rules[ruleAction134]() {
+					goto l2300
+				}
+				depth--
+				add(ruleInt, position2301)
+			}
+			return true
+		l2300:
+			position, tokenIndex, depth = position2300, tokenIndex2300, depth2300
+			return false
+		},
+		/* 173 Float <- <(<(('f' / 'F') ('l' / 'L') ('o' / 'O') ('a' / 'A') ('t' / 'T'))> Action135)> */
+		func() bool {
+			position2309, tokenIndex2309, depth2309 := position, tokenIndex, depth
+			{
+				position2310 := position
+				depth++
+				{
+					position2311 := position
+					depth++
 					{
-						position1581, tokenIndex1581, depth1581 := position, tokenIndex, depth
-						if buffer[position] != rune('e') {
-							goto l1582
+						position2312, tokenIndex2312, depth2312 := position, tokenIndex, depth
+						if buffer[position] != rune('f') {
+							goto l2313
 						}
 						position++
-						goto l1581
-					l1582:
-						position, tokenIndex, depth = position1581, tokenIndex1581, depth1581
-						if buffer[position] != rune('E') {
-							goto l1568
+						goto l2312
+					l2313:
+						position, tokenIndex, depth = position2312, tokenIndex2312, depth2312
+						if buffer[position] != rune('F') {
+							goto l2309
 						}
 						position++
 					}
-				l1581:
+				l2312:
 					{
-						position1583, tokenIndex1583, depth1583 := position, tokenIndex, depth
-						if buffer[position] != rune('w') {
-							goto l1584
+						position2314, tokenIndex2314, depth2314 := position, tokenIndex, depth
+						if buffer[position] != rune('l') {
+							goto l2315
 						}
 						position++
-						goto l1583
-					l1584:
-						position, tokenIndex, depth = position1583, tokenIndex1583, depth1583
-						if buffer[position] != rune('W') {
-							goto l1568
+						goto l2314
+					l2315:
+						position, tokenIndex, depth = position2314, tokenIndex2314, depth2314
+						if buffer[position] != rune('L') {
+							goto l2309
 						}
 						position++
 					}
-				l1583:
+				l2314:
 					{
-						position1585, tokenIndex1585, depth1585 := position, tokenIndex, depth
-						if buffer[position] != rune('e') {
-							goto l1586
+						position2316, tokenIndex2316, depth2316 := position, tokenIndex, depth
+						if buffer[position] != rune('o') {
+							goto l2317
 						}
 						position++
-						goto l1585
-					l1586:
-						position, tokenIndex, depth = position1585, tokenIndex1585, depth1585
-						if buffer[position] != rune('E') {
-							goto l1568
+						goto l2316
+					l2317:
+						position, tokenIndex, depth = position2316, tokenIndex2316, depth2316
+						if buffer[position] != rune('O') {
+							goto l2309
 						}
 						position++
 					}
-				l1585:
+				l2316:
 					{
-						position1587, tokenIndex1587, depth1587 := position, tokenIndex, depth
-						if buffer[position] != rune('s') {
-							goto l1588
+						position2318, tokenIndex2318, depth2318 := position, tokenIndex, depth
+						if buffer[position] != rune('a') {
+							goto l2319
 						}
 						position++
-						goto l1587
-					l1588:
-						position, tokenIndex, depth = position1587, tokenIndex1587, depth1587
-						if buffer[position] != rune('S') {
-							goto l1568
+						goto l2318
+					l2319:
+						position, tokenIndex, depth = position2318, tokenIndex2318, depth2318
+						if buffer[position] != rune('A') {
+							goto l2309
 						}
 						position++
 					}
-				l1587:
+				l2318:
 					{
-						position1589, tokenIndex1589, depth1589 := position, tokenIndex, depth
+						position2320, tokenIndex2320, depth2320 := position, tokenIndex, depth
 						if buffer[position] != rune('t') {
-							goto l1590
+							goto l2321
 						}
 						position++
-						goto l1589
-					l1590:
-						position, tokenIndex, depth = position1589, tokenIndex1589, depth1589
+						goto l2320
+					l2321:
+						position, tokenIndex, depth = position2320, tokenIndex2320, depth2320
 						if buffer[position] != rune('T') {
-							goto l1568
+							goto l2309
 						}
 						position++
 					}
-				l1589:
-					depth--
-					add(rulePegText, position1570)
-				}
-				if !_rules[ruleAction98]() {
-					goto l1568
-				}
-				depth--
-				add(ruleDropNewest, position1569)
-			}
-			return true
-		l1568:
-			position, tokenIndex, depth = position1568, tokenIndex1568, depth1568
-			return false
-		},
-		/* 129 StreamIdentifier <- <(<ident> Action99)> */
-		func() bool {
-			position1591, tokenIndex1591, depth1591 := position, tokenIndex, depth
-			{
-				position1592 := position
-				depth++
-				{
-					position1593 := position
-					depth++
-					if !_rules[ruleident]() {
-						goto l1591
-					}
-					depth--
-					add(rulePegText, position1593)
-				}
-				if !_rules[ruleAction99]() {
-					goto l1591
-				}
-				depth--
-				add(ruleStreamIdentifier, position1592)
-			}
-			return true
-		l1591:
-			position, tokenIndex, depth = position1591, tokenIndex1591, depth1591
-			return false
-		},
-		/* 130 SourceSinkType <- <(<ident> Action100)> */
-		func() bool {
-			position1594, tokenIndex1594, depth1594 := position, tokenIndex, depth
-			{
-				position1595 := position
-				depth++
-				{
-					position1596 := position
-					depth++
-					if !_rules[ruleident]() {
-						goto l1594
-					}
-					depth--
-					add(rulePegText, position1596)
-				}
-				if !_rules[ruleAction100]() {
-					goto l1594
-				}
-				depth--
-				add(ruleSourceSinkType, position1595)
-			}
-			return true
-		l1594:
-			position, tokenIndex, depth = position1594, tokenIndex1594, depth1594
-			return false
-		},
-		/* 131 SourceSinkParamKey <- <(<ident> Action101)> */
-		func() bool {
-			position1597, tokenIndex1597, depth1597 := position, tokenIndex, depth
-			{
-				position1598 := position
-				depth++
-				{
-					position1599 := position
-					depth++
-					if !_rules[ruleident]() {
-						goto l1597
-					}
+				l2320:
 					depth--
-					add(rulePegText, position1599)
+					add(rulePegText, position2311)
 				}
-				if !_rules[ruleAction101]() {
-					goto l1597
+				if !_rules[ruleAction135]() {
+					goto l2309
 				}
 				depth--
-				add(ruleSourceSinkParamKey, position1598)
+				add(ruleFloat, position2310)
 			}
 			return true
-		l1597:
-			position, tokenIndex, depth = position1597, tokenIndex1597, depth1597
+		l2309:
+			position, tokenIndex, depth = position2309, tokenIndex2309, depth2309
 			return false
 		},
-		/* 132 Paused <- <(<(('p' / 'P') ('a' / 'A') ('u' / 'U') ('s' / 'S') ('e' / 'E') ('d' / 'D'))> Action102)> */
+		/* 174 String <- <(<(('s' / 'S') ('t' / 'T') ('r' / 'R') ('i' / 'I') ('n' / 'N') ('g' / 'G'))> Action136)> */
 		func() bool {
-			position1600, tokenIndex1600, depth1600 := position, tokenIndex, depth
+			position2322, tokenIndex2322, depth2322 := position, tokenIndex, depth
 			{
-				position1601 := position
+				position2323 := position
 				depth++
 				{
-					position1602 := position
+					position2324 := position
 					depth++
 					{
-						position1603, tokenIndex1603, depth1603 := position, tokenIndex, depth
-						if buffer[position] != rune('p') {
-							goto l1604
+						position2325, tokenIndex2325, depth2325 := position, tokenIndex, depth
+						if buffer[position] != rune('s') {
+							goto l2326
 						}
 						position++
-						goto l1603
-					l1604:
-						position, tokenIndex, depth = position1603, tokenIndex1603, depth1603
-						if buffer[position] != rune('P') {
-							goto l1600
+						goto l2325
+					l2326:
+						position, tokenIndex, depth = position2325, tokenIndex2325, depth2325
+						if buffer[position] != rune('S') {
+							goto l2322
 						}
 						position++
 					}
-				l1603:
+				l2325:
 					{
-						position1605, tokenIndex1605, depth1605 := position, tokenIndex, depth
-						if buffer[position] != rune('a') {
-							goto l1606
+						position2327, tokenIndex2327, depth2327 := position, tokenIndex, depth
+						if buffer[position] != rune('t') {
+							goto l2328
 						}
 						position++
-						goto l1605
-					l1606:
-						position, tokenIndex, depth = position1605, tokenIndex1605, depth1605
-						if buffer[position] != rune('A') {
-							goto l1600
+						goto l2327
+					l2328:
+						position, tokenIndex, depth = position2327, tokenIndex2327, depth2327
+						if buffer[position] != rune('T') {
+							goto l2322
 						}
 						position++
 					}
-				l1605:
+				l2327:
 					{
-						position1607, tokenIndex1607, depth1607 := position, tokenIndex, depth
-						if buffer[position] != rune('u') {
-							goto l1608
+						position2329, tokenIndex2329, depth2329 := position, tokenIndex, depth
+						if buffer[position] != rune('r') {
+							goto l2330
 						}
 						position++
-						goto l1607
-					l1608:
-						position, tokenIndex, depth = position1607, tokenIndex1607, depth1607
-						if buffer[position] != rune('U') {
-							goto l1600
+						goto l2329
+					l2330:
+						position, tokenIndex, depth = position2329, tokenIndex2329, depth2329
+						if buffer[position] != rune('R') {
+							goto l2322
 						}
 						position++
 					}
-				l1607:
+				l2329:
 					{
-						position1609, tokenIndex1609, depth1609 := position, tokenIndex, depth
-						if buffer[position] != rune('s') {
-							goto l1610
+						position2331, tokenIndex2331, depth2331 := position, tokenIndex, depth
+						if buffer[position] != rune('i') {
+							goto l2332
 						}
 						position++
-						goto l1609
-					l1610:
-						position, tokenIndex, depth = position1609, tokenIndex1609, depth1609
-						if buffer[position] != rune('S') {
-							goto l1600
+						goto l2331
+					l2332:
+						position, tokenIndex, depth = position2331, tokenIndex2331, depth2331
+						if buffer[position] != rune('I') {
+							goto l2322
 						}
 						position++
 					}
-				l1609:
+				l2331:
 					{
-						position1611, tokenIndex1611, depth1611 := position, tokenIndex, depth
-						if buffer[position] != rune('e') {
-							goto l1612
+						position2333, tokenIndex2333, depth2333 := position, tokenIndex, depth
+						if buffer[position] != rune('n') {
+							goto l2334
 						}
 						position++
-						goto l1611
-					l1612:
-						position, tokenIndex, depth = position1611, tokenIndex1611, depth1611
-						if buffer[position] != rune('E') {
-							goto l1600
+						goto l2333
+					l2334:
+						position, tokenIndex, depth = position2333, tokenIndex2333, depth2333
+						if buffer[position] != rune('N') {
+							goto l2322
 						}
 						position++
 					}
-				l1611:
+				l2333:
 					{
-						position1613, tokenIndex1613, depth1613 := position, tokenIndex, depth
-						if buffer[position] != rune('d') {
-							goto l1614
+						position2335, tokenIndex2335, depth2335 := position, tokenIndex, depth
+						if buffer[position] != rune('g') {
+							goto l2336
 						}
 						position++
-						goto l1613
-					l1614:
-						position, tokenIndex, depth = position1613, tokenIndex1613, depth1613
-						if buffer[position] != rune('D') {
-							goto l1600
+						goto l2335
+					l2336:
+						position, tokenIndex, depth = position2335, tokenIndex2335, depth2335
+						if buffer[position] != rune('G') {
+							goto l2322
 						}
 						position++
 					}
-				l1613:
+				l2335:
 					depth--
-					add(rulePegText, position1602)
+					add(rulePegText, position2324)
 				}
-				if !_rules[ruleAction102]() {
-					goto l1600
+				if !_rules[ruleAction136]() {
+					goto l2322
 				}
 				depth--
-				add(rulePaused, position1601)
+				add(ruleString, position2323)
 			}
 			return true
-		l1600:
-			position, tokenIndex, depth = position1600, tokenIndex1600, depth1600
+		l2322:
+			position, tokenIndex, depth = position2322, tokenIndex2322, depth2322
 			return false
 		},
-		/* 133 Unpaused <- <(<(('u' / 'U') ('n' / 'N') ('p' / 'P') ('a' / 'A') ('u' / 'U') ('s' / 'S') ('e' / 'E') ('d' / 'D'))> Action103)> */
+		/* 175 Blob <- <(<(('b' / 'B') ('l' / 'L') ('o' / 'O') ('b' / 'B'))> Action137)> */
 		func() bool {
-			position1615, tokenIndex1615, depth1615 := position, tokenIndex, depth
+			position2337, tokenIndex2337, depth2337 := position, tokenIndex, depth
 			{
-				position1616 := position
+				position2338 := position
 				depth++
 				{
-					position1617 := position
+					position2339 := position
 					depth++
 					{
-						position1618, tokenIndex1618, depth1618 := position, tokenIndex, depth
-						if buffer[position] != rune('u') {
-							goto l1619
+						position2340, tokenIndex2340, depth2340 := position, tokenIndex, depth
+						if buffer[position] != rune('b') {
+							goto l2341
 						}
 						position++
-						goto l1618
-					l1619:
-						position, tokenIndex, depth = position1618, tokenIndex1618, depth1618
-						if buffer[position] != rune('U') {
-							goto l1615
+						goto l2340
+					l2341:
+						position, tokenIndex, depth = position2340, tokenIndex2340, depth2340
+						if buffer[position] != rune('B') {
+							goto l2337
 						}
 						position++
 					}
-				l1618:
+				l2340:
 					{
-						position1620, tokenIndex1620, depth1620 := position, tokenIndex, depth
-						if buffer[position] != rune('n') {
-							goto l1621
+						position2342, tokenIndex2342, depth2342 := position, tokenIndex, depth
+						if buffer[position] != rune('l') {
+							goto l2343
 						}
 						position++
-						goto l1620
-					l1621:
-						position, tokenIndex, depth = position1620, tokenIndex1620, depth1620
-						if buffer[position] != rune('N') {
-							goto l1615
+						goto l2342
+					l2343:
+						position, tokenIndex, depth = position2342, tokenIndex2342, depth2342
+						if buffer[position] != rune('L') {
+							goto l2337
 						}
 						position++
 					}
-				l1620:
+				l2342:
 					{
-						position1622, tokenIndex1622, depth1622 := position, tokenIndex, depth
-						if buffer[position] != rune('p') {
-							goto l1623
+						position2344, tokenIndex2344, depth2344 := position, tokenIndex, depth
+						if buffer[position] != rune('o') {
+							goto l2345
 						}
 						position++
-						goto l1622
-					l1623:
-						position, tokenIndex, depth = position1622, tokenIndex1622, depth1622
-						if buffer[position] != rune('P') {
-							goto l1615
+						goto l2344
+					l2345:
+						position, tokenIndex, depth = position2344, tokenIndex2344, depth2344
+						if buffer[position] != rune('O') {
+							goto l2337
 						}
 						position++
 					}
-				l1622:
+				l2344:
 					{
-						position1624, tokenIndex1624, depth1624 := position, tokenIndex, depth
-						if buffer[position] != rune('a') {
-							goto l1625
+						position2346, tokenIndex2346, depth2346 := position, tokenIndex, depth
+						if buffer[position] != rune('b') {
+							goto l2347
 						}
 						position++
-						goto l1624
-					l1625:
-						position, tokenIndex, depth = position1624, tokenIndex1624, depth1624
-						if buffer[position] != rune('A') {
-							goto l1615
+						goto l2346
+					l2347:
+						position, tokenIndex, depth = position2346, tokenIndex2346, depth2346
+						if buffer[position] != rune('B') {
+							goto l2337
+						}
+						position++
+					}
+				l2346:
+					depth--
+					add(rulePegText, position2339)
+				}
+				if !_rules[ruleAction137]() {
+					goto l2337
+				}
+				depth--
+				add(ruleBlob, position2338)
+			}
+			return true
+		l2337:
+			position, tokenIndex, depth = position2337, tokenIndex2337, depth2337
+			return false
+		},
+		/* 176 Timestamp <- <(<(('t' / 'T') ('i' / 'I') ('m' / 'M') ('e' / 'E') ('s' / 'S') ('t' / 'T') ('a' / 'A') ('m' / 'M') ('p' / 'P'))> Action138)> */
+		func() bool {
+			position2348, tokenIndex2348, depth2348 := position, tokenIndex, depth
+			{
+				position2349 := position
+				depth++
+				{
+					position2350 := position
+					depth++
+					{
+						position2351, tokenIndex2351, depth2351 := position, tokenIndex, depth
+						if buffer[position] != rune('t') {
+							goto l2352
+						}
+						position++
+						goto l2351
+					l2352:
+						position, tokenIndex, depth = position2351, tokenIndex2351, depth2351
+						if buffer[position] != rune('T') {
+							goto l2348
 						}
 						position++
 					}
-				l1624:
+				l2351:
 					{
-						position1626, tokenIndex1626, depth1626 := position, tokenIndex, depth
-						if buffer[position] != rune('u') {
-							goto l1627
+						position2353, tokenIndex2353, depth2353 := position, tokenIndex, depth
+						if buffer[position] != rune('i') {
+							goto l2354
 						}
 						position++
-						goto l1626
-					l1627:
-						position, tokenIndex, depth = position1626, tokenIndex1626, depth1626
-						if buffer[position] != rune('U') {
-							goto l1615
+						goto l2353
+					l2354:
+						position, tokenIndex, depth = position2353, tokenIndex2353, depth2353
+						if buffer[position] != rune('I') {
+							goto l2348
 						}
 						position++
 					}
-				l1626:
+				l2353:
 					{
-						position1628, tokenIndex1628, depth1628 := position, tokenIndex, depth
-						if buffer[position] != rune('s') {
-							goto l1629
+						position2355, tokenIndex2355, depth2355 := position, tokenIndex, depth
+						if buffer[position] != rune('m') {
+							goto l2356
 						}
 						position++
-						goto l1628
-					l1629:
-						position, tokenIndex, depth = position1628, tokenIndex1628, depth1628
-						if buffer[position] != rune('S') {
-							goto l1615
+						goto l2355
+					l2356:
+						position, tokenIndex, depth = position2355, tokenIndex2355, depth2355
+						if buffer[position] != rune('M') {
+							goto l2348
 						}
 						position++
 					}
-				l1628:
+				l2355:
 					{
-						position1630, tokenIndex1630, depth1630 := position, tokenIndex, depth
+						position2357, tokenIndex2357, depth2357 := position, tokenIndex, depth
 						if buffer[position] != rune('e') {
-							goto l1631
+							goto l2358
 						}
 						position++
-						goto l1630
-					l1631:
-						position, tokenIndex, depth = position1630, tokenIndex1630, depth1630
+						goto l2357
+					l2358:
+						position, tokenIndex, depth = position2357, tokenIndex2357, depth2357
 						if buffer[position] != rune('E') {
-							goto l1615
+							goto l2348
 						}
 						position++
 					}
-				l1630:
+				l2357:
 					{
-						position1632, tokenIndex1632, depth1632 := position, tokenIndex, depth
-						if buffer[position] != rune('d') {
-							goto l1633
+						position2359, tokenIndex2359, depth2359 := position, tokenIndex, depth
+						if buffer[position] != rune('s') {
+							goto l2360
 						}
 						position++
-						goto l1632
-					l1633:
-						position, tokenIndex, depth = position1632, tokenIndex1632, depth1632
-						if buffer[position] != rune('D') {
-							goto l1615
+						goto l2359
+					l2360:
+						position, tokenIndex, depth = position2359, tokenIndex2359, depth2359
+						if buffer[position] != rune('S') {
+							goto l2348
 						}
 						position++
 					}
-				l1632:
-					depth--
-					add(rulePegText, position1617)
-				}
-				if !_rules[ruleAction103]() {
-					goto l1615
-				}
-				depth--
-				add(ruleUnpaused, position1616)
-			}
-			return true
-		l1615:
-			position, tokenIndex, depth = position1615, tokenIndex1615, depth1615
-			return false
-		},
-		/* 134 Ascending <- <(<(('a' / 'A') ('s' / 'S') ('c' / 'C'))> Action104)> */
-		func() bool {
-			position1634, tokenIndex1634, depth1634 := position, tokenIndex, depth
-			{
-				position1635 := position
-				depth++
-				{
-					position1636 := position
-					depth++
+				l2359:
+					{
+						position2361, tokenIndex2361, depth2361 := position, tokenIndex, depth
+						if buffer[position] != rune('t') {
+							goto l2362
+						}
+						position++
+						goto l2361
+					l2362:
+						position, tokenIndex, depth = position2361, tokenIndex2361, depth2361
+						if buffer[position] != rune('T') {
+							goto l2348
+						}
+						position++
+					}
+				l2361:
 					{
-						position1637, tokenIndex1637, depth1637 := position, tokenIndex, depth
+						position2363, tokenIndex2363, depth2363 := position, tokenIndex, depth
 						if buffer[position] != rune('a') {
-							goto l1638
+							goto l2364
 						}
 						position++
-						goto l1637
-					l1638:
-						position, tokenIndex, depth = position1637, tokenIndex1637, depth1637
+						goto l2363
+					l2364:
+						position, tokenIndex, depth = position2363, tokenIndex2363, depth2363
 						if buffer[position] != rune('A') {
-							goto l1634
+							goto l2348
 						}
 						position++
 					}
-				l1637:
+				l2363:
 					{
-						position1639, tokenIndex1639, depth1639 := position, tokenIndex, depth
-						if buffer[position] != rune('s') {
-							goto l1640
+						position2365, tokenIndex2365, depth2365 := position, tokenIndex, depth
+						if buffer[position] != rune('m') {
+							goto l2366
 						}
 						position++
-						goto l1639
-					l1640:
-						position, tokenIndex, depth = position1639, tokenIndex1639, depth1639
-						if buffer[position] != rune('S') {
-							goto l1634
+						goto l2365
+					l2366:
+						position, tokenIndex, depth = position2365, tokenIndex2365, depth2365
+						if buffer[position] != rune('M') {
+							goto l2348
 						}
 						position++
 					}
-				l1639:
+				l2365:
 					{
-						position1641, tokenIndex1641, depth1641 := position, tokenIndex, depth
-						if buffer[position] != rune('c') {
-							goto l1642
+						position2367, tokenIndex2367, depth2367 := position, tokenIndex, depth
+						if buffer[position] != rune('p') {
+							goto l2368
 						}
 						position++
-						goto l1641
-					l1642:
-						position, tokenIndex, depth = position1641, tokenIndex1641, depth1641
-						if buffer[position] != rune('C') {
-							goto l1634
+						goto l2367
+					l2368:
+						position, tokenIndex, depth = position2367, tokenIndex2367, depth2367
+						if buffer[position] != rune('P') {
+							goto l2348
 						}
 						position++
 					}
-				l1641:
+				l2367:
 					depth--
-					add(rulePegText, position1636)
+					add(rulePegText, position2350)
 				}
-				if !_rules[ruleAction104]() {
-					goto l1634
+				if !_rules[ruleAction138]() {
+					goto l2348
 				}
 				depth--
-				add(ruleAscending, position1635)
+				add(ruleTimestamp, position2349)
 			}
 			return true
-		l1634:
-			position, tokenIndex, depth = position1634, tokenIndex1634, depth1634
+		l2348:
+			position, tokenIndex, depth = position2348, tokenIndex2348, depth2348
 			return false
 		},
-		/* 135 Descending <- <(<(('d' / 'D') ('e' / 'E') ('s' / 'S') ('c' / 'C'))> Action105)> */
+		/* 177 Array <- <(<(('a' / 'A') ('r' / 'R') ('r' / 'R') ('a' / 'A') ('y' / 'Y'))> Action139)> */
 		func() bool {
-			position1643, tokenIndex1643, depth1643 := position, tokenIndex, depth
+			position2369, tokenIndex2369, depth2369 := position, tokenIndex, depth
 			{
-				position1644 := position
+				position2370 := position
 				depth++
 				{
-					position1645 := position
+					position2371 := position
 					depth++
 					{
-						position1646, tokenIndex1646, depth1646 := position, tokenIndex, depth
-						if buffer[position] != rune('d') {
-							goto l1647
+						position2372, tokenIndex2372, depth2372 := position, tokenIndex, depth
+						if buffer[position] != rune('a') {
+							goto l2373
 						}
 						position++
-						goto l1646
-					l1647:
-						position, tokenIndex, depth = position1646, tokenIndex1646, depth1646
-						if buffer[position] != rune('D') {
-							goto l1643
+						goto l2372
+					l2373:
+						position, tokenIndex, depth = position2372, tokenIndex2372, depth2372
+						if buffer[position] != rune('A') {
+							goto l2369
 						}
 						position++
 					}
-				l1646:
+				l2372:
 					{
-						position1648, tokenIndex1648, depth1648 := position, tokenIndex, depth
-						if buffer[position] != rune('e') {
-							goto l1649
+						position2374, tokenIndex2374, depth2374 := position, tokenIndex, depth
+						if buffer[position] != rune('r') {
+							goto l2375
 						}
 						position++
-						goto l1648
-					l1649:
-						position, tokenIndex, depth = position1648, tokenIndex1648, depth1648
-						if buffer[position] != rune('E') {
-							goto l1643
+						goto l2374
+					l2375:
+						position, tokenIndex, depth = position2374, tokenIndex2374, depth2374
+						if buffer[position] != rune('R') {
+							goto l2369
 						}
 						position++
 					}
-				l1648:
+				l2374:
 					{
-						position1650, tokenIndex1650, depth1650 := position, tokenIndex, depth
-						if buffer[position] != rune('s') {
-							goto l1651
+						position2376, tokenIndex2376, depth2376 := position, tokenIndex, depth
+						if buffer[position] != rune('r') {
+							goto l2377
 						}
 						position++
-						goto l1650
-					l1651:
-						position, tokenIndex, depth = position1650, tokenIndex1650, depth1650
-						if buffer[position] != rune('S') {
-							goto l1643
+						goto l2376
+					l2377:
+						position, tokenIndex, depth = position2376, tokenIndex2376, depth2376
+						if buffer[position] != rune('R') {
+							goto l2369
 						}
 						position++
 					}
-				l1650:
+				l2376:
 					{
-						position1652, tokenIndex1652, depth1652 := position, tokenIndex, depth
-						if buffer[position] != rune('c') {
-							goto l1653
+						position2378, tokenIndex2378, depth2378 := position, tokenIndex, depth
+						if buffer[position] != rune('a') {
+							goto l2379
 						}
 						position++
-						goto l1652
-					l1653:
-						position, tokenIndex, depth = position1652, tokenIndex1652, depth1652
-						if buffer[position] != rune('C') {
-							goto l1643
+						goto l2378
+					l2379:
+						position, tokenIndex, depth = position2378, tokenIndex2378, depth2378
+						if buffer[position] != rune('A') {
+							goto l2369
 						}
 						position++
 					}
-				l1652:
+				l2378:
+					{
+						position2380, tokenIndex2380, depth2380 := position, tokenIndex, depth
+						if buffer[position] != rune('y') {
+							goto l2381
+						}
+						position++
+						goto l2380
+					l2381:
+						position, tokenIndex, depth = position2380, tokenIndex2380, depth2380
+						if buffer[position] != rune('Y') {
+							goto l2369
+						}
+						position++
+					}
+				l2380:
 					depth--
-					add(rulePegText, position1645)
+					add(rulePegText, position2371)
 				}
-				if !_rules[ruleAction105]() {
-					goto l1643
+				if !_rules[ruleAction139]() {
+					goto l2369
 				}
 				depth--
-				add(ruleDescending, position1644)
+				add(ruleArray, position2370)
 			}
 			return true
-		l1643:
-			position, tokenIndex, depth = position1643, tokenIndex1643, depth1643
+		l2369:
+			position, tokenIndex, depth = position2369, tokenIndex2369, depth2369
 			return false
 		},
-		/* 136 Type <- <(Bool / Int / Float / String / Blob / Timestamp / Array / Map)> */
+		/* 178 Map <- <(<(('m' / 'M') ('a' / 'A') ('p' / 'P'))> Action140)> */
 		func() bool {
-			position1654, tokenIndex1654, depth1654 := position, tokenIndex, depth
+			position2382, tokenIndex2382, depth2382 := position, tokenIndex, depth
 			{
-				position1655 := position
+				position2383 := position
 				depth++
 				{
-					position1656, tokenIndex1656, depth1656 := position, tokenIndex, depth
-					if !_rules[ruleBool]() {
-						goto l1657
-					}
-					goto l1656
-				l1657:
-					position, tokenIndex, depth = position1656, tokenIndex1656, depth1656
-					if !_rules[ruleInt]() {
-						goto l1658
-					}
-					goto l1656
-				l1658:
-					position, tokenIndex, depth = position1656, tokenIndex1656, depth1656
-					if !_rules[ruleFloat]() {
-						goto l1659
-					}
-					goto l1656
-				l1659:
-					position, tokenIndex, depth = position1656, tokenIndex1656, depth1656
-					if !_rules[ruleString]() {
-						goto l1660
-					}
-					goto l1656
-				l1660:
-					position, tokenIndex, depth = position1656, tokenIndex1656, depth1656
-					if !_rules[ruleBlob]() {
-						goto l1661
-					}
-					goto l1656
-				l1661:
-					position, tokenIndex, depth = position1656, tokenIndex1656, depth1656
-					if !_rules[ruleTimestamp]() {
-						goto l1662
+					position2384 := position
+					depth++
+					{
+						position2385, tokenIndex2385, depth2385 := position, tokenIndex, depth
+						if buffer[position] != rune('m') {
+							goto l2386
+						}
+						position++
+						goto l2385
+					l2386:
+						position, tokenIndex, depth = position2385, tokenIndex2385, depth2385
+						if buffer[position] != rune('M') {
+							goto l2382
+						}
+						position++
 					}
-					goto l1656
-				l1662:
-					position, tokenIndex, depth = position1656, tokenIndex1656, depth1656
-					if !_rules[ruleArray]() {
-						goto l1663
+				l2385:
+					{
+						position2387, tokenIndex2387, depth2387 := position, tokenIndex, depth
+						if buffer[position] != rune('a') {
+							goto l2388
+						}
+						position++
+						goto l2387
+					l2388:
+						position, tokenIndex, depth = position2387, tokenIndex2387, depth2387
+						if buffer[position] != rune('A') {
+							goto l2382
+						}
+						position++
 					}
-					goto l1656
-				l1663:
-					position, tokenIndex, depth = position1656, tokenIndex1656, depth1656
-					if !_rules[ruleMap]() {
-						goto l1654
+				l2387:
+					{
+						position2389, tokenIndex2389, depth2389 := position, tokenIndex, depth
+						if buffer[position] != rune('p') {
+							goto l2390
+						}
+						position++
+						goto l2389
+					l2390:
+						position, tokenIndex, depth = position2389, tokenIndex2389, depth2389
+						if buffer[position] != rune('P') {
+							goto l2382
+						}
+						position++
 					}
+				l2389:
+					depth--
+					add(rulePegText, position2384)
+				}
+				if !_rules[ruleAction140]() {
+					goto l2382
 				}
-			l1656:
 				depth--
-				add(ruleType, position1655)
+				add(ruleMap, position2383)
 			}
 			return true
-		l1654:
-			position, tokenIndex, depth = position1654, tokenIndex1654, depth1654
+		l2382:
+			position, tokenIndex, depth = position2382, tokenIndex2382, depth2382
 			return false
 		},
-		/* 137 Bool <- <(<(('b' / 'B') ('o' / 'O') ('o' / 'O') ('l' / 'L'))> Action106)> */
+		/* 179 Or <- <(<(('o' / 'O') ('r' / 'R'))> Action141)> */
 		func() bool {
-			position1664, tokenIndex1664, depth1664 := position, tokenIndex, depth
+			position2391, tokenIndex2391, depth2391 := position, tokenIndex, depth
 			{
-				position1665 := position
+				position2392 := position
 				depth++
 				{
-					position1666 := position
+					position2393 := position
 					depth++
 					{
-						position1667, tokenIndex1667, depth1667 := position, tokenIndex, depth
-						if buffer[position] != rune('b') {
-							goto l1668
-						}
-						position++
-						goto l1667
-					l1668:
-						position, tokenIndex, depth = position1667, tokenIndex1667, depth1667
-						if buffer[position] != rune('B') {
-							goto l1664
-						}
-						position++
-					}
-				l1667:
-					{
-						position1669, tokenIndex1669, depth1669 := position, tokenIndex, depth
-						if buffer[position] != rune('o') {
-							goto l1670
-						}
-						position++
-						goto l1669
-					l1670:
-						position, tokenIndex, depth = position1669, tokenIndex1669, depth1669
-						if buffer[position] != rune('O') {
-							goto l1664
-						}
-						position++
-					}
-				l1669:
-					{
-						position1671, tokenIndex1671, depth1671 := position, tokenIndex, depth
+						position2394, tokenIndex2394, depth2394 := position, tokenIndex, depth
 						if buffer[position] != rune('o') {
-							goto l1672
+							goto l2395
 						}
 						position++
-						goto l1671
-					l1672:
-						position, tokenIndex, depth = position1671, tokenIndex1671, depth1671
+						goto l2394
+					l2395:
+						position, tokenIndex, depth = position2394, tokenIndex2394, depth2394
 						if buffer[position] != rune('O') {
-							goto l1664
+							goto l2391
 						}
 						position++
 					}
-				l1671:
+				l2394:
 					{
-						position1673, tokenIndex1673, depth1673 := position, tokenIndex, depth
-						if buffer[position] != rune('l') {
-							goto l1674
+						position2396, tokenIndex2396, depth2396 := position, tokenIndex, depth
+						if buffer[position] != rune('r') {
+							goto l2397
 						}
 						position++
-						goto l1673
-					l1674:
-						position, tokenIndex, depth = position1673, tokenIndex1673, depth1673
-						if buffer[position] != rune('L') {
-							goto l1664
+						goto l2396
+					l2397:
+						position, tokenIndex, depth = position2396, tokenIndex2396, depth2396
+						if buffer[position] != rune('R') {
+							goto l2391
 						}
 						position++
 					}
-				l1673:
+				l2396:
 					depth--
-					add(rulePegText, position1666)
+					add(rulePegText, position2393)
 				}
-				if !_rules[ruleAction106]() {
-					goto l1664
+				if !_rules[ruleAction141]() {
+					goto l2391
 				}
 				depth--
-				add(ruleBool, position1665)
+				add(ruleOr, position2392)
 			}
 			return true
-		l1664:
-			position, tokenIndex, depth = position1664, tokenIndex1664, depth1664
+		l2391:
+			position, tokenIndex, depth = position2391, tokenIndex2391, depth2391
 			return false
 		},
-		/* 138 Int <- <(<(('i' / 'I') ('n' / 'N') ('t' / 'T'))> Action107)> */
+		/* 180 And <- <(<(('a' / 'A') ('n' / 'N') ('d' / 'D'))> Action142)> */
 		func() bool {
-			position1675, tokenIndex1675, depth1675 := position, tokenIndex, depth
+			position2398, tokenIndex2398, depth2398 := position, tokenIndex, depth
 			{
-				position1676 := position
+				position2399 := position
 				depth++
 				{
-					position1677 := position
+					position2400 := position
 					depth++
 					{
-						position1678, tokenIndex1678, depth1678 := position, tokenIndex, depth
-						if buffer[position] != rune('i') {
-							goto l1679
+						position2401, tokenIndex2401, depth2401 := position, tokenIndex, depth
+						if buffer[position] != rune('a') {
+							goto l2402
 						}
 						position++
-						goto l1678
-					l1679:
-						position, tokenIndex, depth = position1678, tokenIndex1678, depth1678
-						if buffer[position] != rune('I') {
-							goto l1675
+						goto l2401
+					l2402:
+						position, tokenIndex, depth = position2401, tokenIndex2401, depth2401
+						if buffer[position] != rune('A') {
+							goto l2398
 						}
 						position++
 					}
-				l1678:
+				l2401:
 					{
-						position1680, tokenIndex1680, depth1680 := position, tokenIndex, depth
+						position2403, tokenIndex2403, depth2403 := position, tokenIndex, depth
 						if buffer[position] != rune('n') {
-							goto l1681
+							goto l2404
 						}
 						position++
-						goto l1680
-					l1681:
-						position, tokenIndex, depth = position1680, tokenIndex1680, depth1680
+						goto l2403
+					l2404:
+						position, tokenIndex, depth = position2403, tokenIndex2403, depth2403
 						if buffer[position] != rune('N') {
-							goto l1675
+							goto l2398
 						}
 						position++
 					}
-				l1680:
+				l2403:
 					{
-						position1682, tokenIndex1682, depth1682 := position, tokenIndex, depth
-						if buffer[position] != rune('t') {
-							goto l1683
+						position2405, tokenIndex2405, depth2405 := position, tokenIndex, depth
+						if buffer[position] != rune('d') {
+							goto l2406
 						}
 						position++
-						goto l1682
-					l1683:
-						position, tokenIndex, depth = position1682, tokenIndex1682, depth1682
-						if buffer[position] != rune('T') {
-							goto l1675
+						goto l2405
+					l2406:
+						position, tokenIndex, depth = position2405, tokenIndex2405, depth2405
+						if buffer[position] != rune('D') {
+							goto l2398
 						}
 						position++
 					}
-				l1682:
+				l2405:
 					depth--
-					add(rulePegText, position1677)
+					add(rulePegText, position2400)
 				}
-				if !_rules[ruleAction107]() {
-					goto l1675
+				if !_rules[ruleAction142]() {
+					goto l2398
 				}
 				depth--
-				add(ruleInt, position1676)
+				add(ruleAnd, position2399)
 			}
 			return true
-		l1675:
-			position, tokenIndex, depth = position1675, tokenIndex1675, depth1675
+		l2398:
+			position, tokenIndex, depth = position2398, tokenIndex2398, depth2398
 			return false
 		},
-		/* 139 Float <- <(<(('f' / 'F') ('l' / 'L') ('o' / 'O') ('a' / 'A') ('t' / 'T'))> Action108)> */
+		/* 181 Not <- <(<(('n' / 'N') ('o' / 'O') ('t' / 'T'))> Action143)> */
 		func() bool {
-			position1684, tokenIndex1684, depth1684 := position, tokenIndex, depth
+			position2407, tokenIndex2407, depth2407 := position, tokenIndex, depth
 			{
-				position1685 := position
+				position2408 := position
 				depth++
 				{
-					position1686 := position
+					position2409 := position
 					depth++
 					{
-						position1687, tokenIndex1687, depth1687 := position, tokenIndex, depth
-						if buffer[position] != rune('f') {
-							goto l1688
-						}
-						position++
-						goto l1687
-					l1688:
-						position, tokenIndex, depth = position1687, tokenIndex1687, depth1687
-						if buffer[position] != rune('F') {
-							goto l1684
-						}
-						position++
-					}
-				l1687:
-					{
-						position1689, tokenIndex1689, depth1689 := position, tokenIndex, depth
-						if buffer[position] != rune('l') {
-							goto l1690
+						position2410, tokenIndex2410, depth2410 := position, tokenIndex, depth
+						if buffer[position] != rune('n') {
+							goto l2411
 						}
 						position++
-						goto l1689
-					l1690:
-						position, tokenIndex, depth = position1689, tokenIndex1689, depth1689
-						if buffer[position] != rune('L') {
-							goto l1684
+						goto l2410
+					l2411:
+						position, tokenIndex, depth = position2410, tokenIndex2410, depth2410
+						if buffer[position] != rune('N') {
+							goto l2407
 						}
 						position++
 					}
-				l1689:
+				l2410:
 					{
-						position1691, tokenIndex1691, depth1691 := position, tokenIndex, depth
+						position2412, tokenIndex2412, depth2412 := position, tokenIndex, depth
 						if buffer[position] != rune('o') {
-							goto l1692
+							goto l2413
 						}
 						position++
-						goto l1691
-					l1692:
-						position, tokenIndex, depth = position1691, tokenIndex1691, depth1691
+						goto l2412
+					l2413:
+						position, tokenIndex, depth = position2412, tokenIndex2412, depth2412
 						if buffer[position] != rune('O') {
-							goto l1684
-						}
-						position++
-					}
-				l1691:
-					{
-						position1693, tokenIndex1693, depth1693 := position, tokenIndex, depth
-						if buffer[position] != rune('a') {
-							goto l1694
-						}
-						position++
-						goto l1693
-					l1694:
-						position, tokenIndex, depth = position1693, tokenIndex1693, depth1693
-						if buffer[position] != rune('A') {
-							goto l1684
+							goto l2407
 						}
 						position++
 					}
-				l1693:
+				l2412:
 					{
-						position1695, tokenIndex1695, depth1695 := position, tokenIndex, depth
+						position2414, tokenIndex2414, depth2414 := position, tokenIndex, depth
 						if buffer[position] != rune('t') {
-							goto l1696
+							goto l2415
 						}
 						position++
-						goto l1695
-					l1696:
-						position, tokenIndex, depth = position1695, tokenIndex1695, depth1695
+						goto l2414
+					l2415:
+						position, tokenIndex, depth = position2414, tokenIndex2414, depth2414
 						if buffer[position] != rune('T') {
-							goto l1684
+							goto l2407
 						}
 						position++
 					}
-				l1695:
+				l2414:
 					depth--
-					add(rulePegText, position1686)
+					add(rulePegText, position2409)
 				}
-				if !_rules[ruleAction108]() {
-					goto l1684
+				if !_rules[ruleAction143]() {
+					goto l2407
 				}
 				depth--
-				add(ruleFloat, position1685)
+				add(ruleNot, position2408)
 			}
 			return true
-		l1684:
-			position, tokenIndex, depth = position1684, tokenIndex1684, depth1684
+		l2407:
+			position, tokenIndex, depth = position2407, tokenIndex2407, depth2407
 			return false
 		},
-		/* 140 String <- <(<(('s' / 'S') ('t' / 'T') ('r' / 'R') ('i' / 'I') ('n' / 'N') ('g' / 'G'))> Action109)> */
+		/* 182 Equal <- <(<'='> Action144)> */
 		func() bool {
-			position1697, tokenIndex1697, depth1697 := position, tokenIndex, depth
+			position2416, tokenIndex2416, depth2416 := position, tokenIndex, depth
 			{
-				position1698 := position
+				position2417 := position
 				depth++
 				{
-					position1699 := position
+					position2418 := position
 					depth++
-					{
-						position1700, tokenIndex1700, depth1700 := position, tokenIndex, depth
-						if buffer[position] != rune('s') {
-							goto l1701
-						}
-						position++
-						goto l1700
-					l1701:
-						position, tokenIndex, depth = position1700, tokenIndex1700, depth1700
-						if buffer[position] != rune('S') {
-							goto l1697
-						}
-						position++
+					if buffer[position] != rune('=') {
+						goto l2416
 					}
-				l1700:
-					{
-						position1702, tokenIndex1702, depth1702 := position, tokenIndex, depth
-						if buffer[position] != rune('t') {
-							goto l1703
-						}
-						position++
-						goto l1702
-					l1703:
-						position, tokenIndex, depth = position1702, tokenIndex1702, depth1702
-						if buffer[position] != rune('T') {
-							goto l1697
-						}
-						position++
+					position++
+					depth--
+					add(rulePegText, position2418)
+				}
+				if !_rules[ruleAction144]() {
+					goto l2416
+				}
+				depth--
+				add(ruleEqual, position2417)
+			}
+			return true
+		l2416:
+			position, tokenIndex, depth = position2416, tokenIndex2416, depth2416
+			return false
+		},
+		/* 183 Less <- <(<'<'> Action145)> */
+		func() bool {
+			position2419, tokenIndex2419, depth2419 := position, tokenIndex, depth
+			{
+				position2420 := position
+				depth++
+				{
+					position2421 := position
+					depth++
+					if buffer[position] != rune('<') {
+						goto l2419
 					}
-				l1702:
-					{
-						position1704, tokenIndex1704, depth1704 := position, tokenIndex, depth
-						if buffer[position] != rune('r') {
-							goto l1705
-						}
-						position++
-						goto l1704
-					l1705:
-						position, tokenIndex, depth = position1704, tokenIndex1704, depth1704
-						if buffer[position] != rune('R') {
-							goto l1697
-						}
-						position++
+					position++
+					depth--
+					add(rulePegText, position2421)
+				}
+				if !_rules[ruleAction145]() {
+					goto l2419
+				}
+				depth--
+				add(ruleLess, position2420)
+			}
+			return true
+		l2419:
+			position, tokenIndex, depth = position2419, tokenIndex2419, depth2419
+			return false
+		},
+		/* 184 LessOrEqual <- <(<('<' '=')> Action146)> */
+		func() bool {
+			position2422, tokenIndex2422, depth2422 := position, tokenIndex, depth
+			{
+				position2423 := position
+				depth++
+				{
+					position2424 := position
+					depth++
+					if buffer[position] != rune('<') {
+						goto l2422
 					}
-				l1704:
-					{
-						position1706, tokenIndex1706, depth1706 := position, tokenIndex, depth
-						if buffer[position] != rune('i') {
-							goto l1707
-						}
-						position++
-						goto l1706
-					l1707:
-						position, tokenIndex, depth = position1706, tokenIndex1706, depth1706
-						if buffer[position] != rune('I') {
-							goto l1697
-						}
-						position++
+					position++
+					if buffer[position] != rune('=') {
+						goto l2422
 					}
-				l1706:
-					{
-						position1708, tokenIndex1708, depth1708 := position, tokenIndex, depth
-						if buffer[position] != rune('n') {
-							goto l1709
-						}
-						position++
-						goto l1708
-					l1709:
-						position, tokenIndex, depth = position1708, tokenIndex1708, depth1708
-						if buffer[position] != rune('N') {
-							goto l1697
-						}
-						position++
+					position++
+					depth--
+					add(rulePegText, position2424)
+				}
+				if !_rules[ruleAction146]() {
+					goto l2422
+				}
+				depth--
+				add(ruleLessOrEqual, position2423)
+			}
+			return true
+		l2422:
+			position, tokenIndex, depth = position2422, tokenIndex2422, depth2422
+			return false
+		},
+		/* 185 Greater <- <(<'>'> Action147)> */
+		func() bool {
+			position2425, tokenIndex2425, depth2425 := position, tokenIndex, depth
+			{
+				position2426 := position
+				depth++
+				{
+					position2427 := position
+					depth++
+					if buffer[position] != rune('>') {
+						goto l2425
 					}
-				l1708:
-					{
-						position1710, tokenIndex1710, depth1710 := position, tokenIndex, depth
-						if buffer[position] != rune('g') {
-							goto l1711
-						}
-						position++
-						goto l1710
-					l1711:
-						position, tokenIndex, depth = position1710, tokenIndex1710, depth1710
-						if buffer[position] != rune('G') {
-							goto l1697
-						}
-						position++
+					position++
+					depth--
+					add(rulePegText, position2427)
+				}
+				if !_rules[ruleAction147]() {
+					goto l2425
+				}
+				depth--
+				add(ruleGreater, position2426)
+			}
+			return true
+		l2425:
+			position, tokenIndex, depth = position2425, tokenIndex2425, depth2425
+			return false
+		},
+		/* 186 GreaterOrEqual <- <(<('>' '=')> Action148)> */
+		func() bool {
+			position2428, tokenIndex2428, depth2428 := position, tokenIndex, depth
+			{
+				position2429 := position
+				depth++
+				{
+					position2430 := position
+					depth++
+					if buffer[position] != rune('>') {
+						goto l2428
+					}
+					position++
+					if buffer[position] != rune('=') {
+						goto l2428
 					}
-				l1710:
+					position++
 					depth--
-					add(rulePegText, position1699)
+					add(rulePegText, position2430)
 				}
-				if !_rules[ruleAction109]() {
-					goto l1697
+				if !_rules[ruleAction148]() {
+					goto l2428
 				}
 				depth--
-				add(ruleString, position1698)
+				add(ruleGreaterOrEqual, position2429)
 			}
 			return true
-		l1697:
-			position, tokenIndex, depth = position1697, tokenIndex1697, depth1697
+		l2428:
+			position, tokenIndex, depth = position2428, tokenIndex2428, depth2428
 			return false
 		},
-		/* 141 Blob <- <(<(('b' / 'B') ('l' / 'L') ('o' / 'O') ('b' / 'B'))> Action110)> */
+		/* 187 NotEqual <- <(<(('!' '=') / ('<' '>'))> Action149)> */
 		func() bool {
-			position1712, tokenIndex1712, depth1712 := position, tokenIndex, depth
+			position2431, tokenIndex2431, depth2431 := position, tokenIndex, depth
 			{
-				position1713 := position
+				position2432 := position
 				depth++
 				{
-					position1714 := position
+					position2433 := position
 					depth++
 					{
-						position1715, tokenIndex1715, depth1715 := position, tokenIndex, depth
-						if buffer[position] != rune('b') {
-							goto l1716
+						position2434, tokenIndex2434, depth2434 := position, tokenIndex, depth
+						if buffer[position] != rune('!') {
+							goto l2435
 						}
 						position++
-						goto l1715
-					l1716:
-						position, tokenIndex, depth = position1715, tokenIndex1715, depth1715
-						if buffer[position] != rune('B') {
-							goto l1712
+						if buffer[position] != rune('=') {
+							goto l2435
 						}
 						position++
-					}
-				l1715:
-					{
-						position1717, tokenIndex1717, depth1717 := position, tokenIndex, depth
-						if buffer[position] != rune('l') {
-							goto l1718
+						goto l2434
+					l2435:
+						position, tokenIndex, depth = position2434, tokenIndex2434, depth2434
+						if buffer[position] != rune('<') {
+							goto l2431
 						}
 						position++
-						goto l1717
-					l1718:
-						position, tokenIndex, depth = position1717, tokenIndex1717, depth1717
-						if buffer[position] != rune('L') {
-							goto l1712
+						if buffer[position] != rune('>') {
+							goto l2431
 						}
 						position++
 					}
-				l1717:
+				l2434:
+					depth--
+					add(rulePegText, position2433)
+				}
+				if !_rules[ruleAction149]() {
+					goto l2431
+				}
+				depth--
+				add(ruleNotEqual, position2432)
+			}
+			return true
+		l2431:
+			position, tokenIndex, depth = position2431, tokenIndex2431, depth2431
+			return false
+		},
+		/* 188 InOperator <- <(<(('i' / 'I') ('n' / 'N'))> Action150)> */
+		func() bool {
+			position2436, tokenIndex2436, depth2436 := position, tokenIndex, depth
+			{
+				position2437 := position
+				depth++
+				{
+					position2438 := position
+					depth++
 					{
-						position1719, tokenIndex1719, depth1719 := position, tokenIndex, depth
-						if buffer[position] != rune('o') {
-							goto l1720
+						position2439, tokenIndex2439, depth2439 := position, tokenIndex, depth
+						if buffer[position] != rune('i') {
+							goto l2440
 						}
 						position++
-						goto l1719
-					l1720:
-						position, tokenIndex, depth = position1719, tokenIndex1719, depth1719
-						if buffer[position] != rune('O') {
-							goto l1712
+						goto l2439
+					l2440:
+						position, tokenIndex, depth = position2439, tokenIndex2439, depth2439
+						if buffer[position] != rune('I') {
+							goto l2436
 						}
 						position++
 					}
-				l1719:
+				l2439:
 					{
-						position1721, tokenIndex1721, depth1721 := position, tokenIndex, depth
-						if buffer[position] != rune('b') {
-							goto l1722
+						position2441, tokenIndex2441, depth2441 := position, tokenIndex, depth
+						if buffer[position] != rune('n') {
+							goto l2442
 						}
 						position++
-						goto l1721
-					l1722:
-						position, tokenIndex, depth = position1721, tokenIndex1721, depth1721
-						if buffer[position] != rune('B') {
-							goto l1712
+						goto l2441
+					l2442:
+						position, tokenIndex, depth = position2441, tokenIndex2441, depth2441
+						if buffer[position] != rune('N') {
+							goto l2436
 						}
 						position++
 					}
-				l1721:
+				l2441:
 					depth--
-					add(rulePegText, position1714)
+					add(rulePegText, position2438)
 				}
-				if !_rules[ruleAction110]() {
-					goto l1712
+				if !_rules[ruleAction150]() {
+					goto l2436
 				}
 				depth--
-				add(ruleBlob, position1713)
+				add(ruleInOperator, position2437)
 			}
 			return true
-		l1712:
-			position, tokenIndex, depth = position1712, tokenIndex1712, depth1712
+		l2436:
+			position, tokenIndex, depth = position2436, tokenIndex2436, depth2436
 			return false
 		},
-		/* 142 Timestamp <- <(<(('t' / 'T') ('i' / 'I') ('m' / 'M') ('e' / 'E') ('s' / 'S') ('t' / 'T') ('a' / 'A') ('m' / 'M') ('p' / 'P'))> Action111)> */
+		/* 189 NotIn <- <(<(('n' / 'N') ('o' / 'O') ('t' / 'T') sp (('i' / 'I') ('n' / 'N')))> Action151)> */
 		func() bool {
-			position1723, tokenIndex1723, depth1723 := position, tokenIndex, depth
+			position2443, tokenIndex2443, depth2443 := position, tokenIndex, depth
 			{
-				position1724 := position
+				position2444 := position
 				depth++
 				{
-					position1725 := position
+					position2445 := position
 					depth++
 					{
-						position1726, tokenIndex1726, depth1726 := position, tokenIndex, depth
-						if buffer[position] != rune('t') {
-							goto l1727
+						position2446, tokenIndex2446, depth2446 := position, tokenIndex, depth
+						if buffer[position] != rune('n') {
+							goto l2447
 						}
 						position++
-						goto l1726
-					l1727:
-						position, tokenIndex, depth = position1726, tokenIndex1726, depth1726
-						if buffer[position] != rune('T') {
-							goto l1723
+						goto l2446
+					l2447:
+						position, tokenIndex, depth = position2446, tokenIndex2446, depth2446
+						if buffer[position] != rune('N') {
+							goto l2443
 						}
 						position++
 					}
-				l1726:
+				l2446:
 					{
-						position1728, tokenIndex1728, depth1728 := position, tokenIndex, depth
-						if buffer[position] != rune('i') {
-							goto l1729
+						position2448, tokenIndex2448, depth2448 := position, tokenIndex, depth
+						if buffer[position] != rune('o') {
+							goto l2449
 						}
 						position++
-						goto l1728
-					l1729:
-						position, tokenIndex, depth = position1728, tokenIndex1728, depth1728
-						if buffer[position] != rune('I') {
-							goto l1723
+						goto l2448
+					l2449:
+						position, tokenIndex, depth = position2448, tokenIndex2448, depth2448
+						if buffer[position] != rune('O') {
+							goto l2443
 						}
 						position++
 					}
-				l1728:
+				l2448:
 					{
-						position1730, tokenIndex1730, depth1730 := position, tokenIndex, depth
-						if buffer[position] != rune('m') {
-							goto l1731
+						position2450, tokenIndex2450, depth2450 := position, tokenIndex, depth
+						if buffer[position] != rune('t') {
+							goto l2451
 						}
 						position++
-						goto l1730
-					l1731:
-						position, tokenIndex, depth = position1730, tokenIndex1730, depth1730
-						if buffer[position] != rune('M') {
-							goto l1723
+						goto l2450
+					l2451:
+						position, tokenIndex, depth = position2450, tokenIndex2450, depth2450
+						if buffer[position] != rune('T') {
+							goto l2443
 						}
 						position++
 					}
-				l1730:
+				l2450:
+					if !_rules[rulesp]() {
+						goto l2443
+					}
 					{
-						position1732, tokenIndex1732, depth1732 := position, tokenIndex, depth
-						if buffer[position] != rune('e') {
-							goto l1733
+						position2452, tokenIndex2452, depth2452 := position, tokenIndex, depth
+						if buffer[position] != rune('i') {
+							goto l2453
 						}
 						position++
-						goto l1732
-					l1733:
-						position, tokenIndex, depth = position1732, tokenIndex1732, depth1732
-						if buffer[position] != rune('E') {
-							goto l1723
+						goto l2452
+					l2453:
+						position, tokenIndex, depth = position2452, tokenIndex2452, depth2452
+						if buffer[position] != rune('I') {
+							goto l2443
 						}
 						position++
 					}
-				l1732:
+				l2452:
 					{
-						position1734, tokenIndex1734, depth1734 := position, tokenIndex, depth
-						if buffer[position] != rune('s') {
-							goto l1735
+						position2454, tokenIndex2454, depth2454 := position, tokenIndex, depth
+						if buffer[position] != rune('n') {
+							goto l2455
 						}
 						position++
-						goto l1734
-					l1735:
-						position, tokenIndex, depth = position1734, tokenIndex1734, depth1734
-						if buffer[position] != rune('S') {
-							goto l1723
+						goto l2454
+					l2455:
+						position, tokenIndex, depth = position2454, tokenIndex2454, depth2454
+						if buffer[position] != rune('N') {
+							goto l2443
 						}
 						position++
 					}
-				l1734:
+				l2454:
+					depth--
+					add(rulePegText, position2445)
+				}
+				if !_rules[ruleAction151]() {
+					goto l2443
+				}
+				depth--
+				add(ruleNotIn, position2444)
+			}
+			return true
+		l2443:
+			position, tokenIndex, depth = position2443, tokenIndex2443, depth2443
+			return false
+		},
+		/* 190 Like <- <(<(('l' / 'L') ('i' / 'I') ('k' / 'K') ('e' / 'E'))> Action152)> */
+		func() bool {
+			position2456, tokenIndex2456, depth2456 := position, tokenIndex, depth
+			{
+				position2457 := position
+				depth++
+				{
+					position2458 := position
+					depth++
 					{
-						position1736, tokenIndex1736, depth1736 := position, tokenIndex, depth
-						if buffer[position] != rune('t') {
-							goto l1737
+						position2459, tokenIndex2459, depth2459 := position, tokenIndex, depth
+						if buffer[position] != rune('l') {
+							goto l2460
 						}
 						position++
-						goto l1736
-					l1737:
-						position, tokenIndex, depth = position1736, tokenIndex1736, depth1736
-						if buffer[position] != rune('T') {
-							goto l1723
+						goto l2459
+					l2460:
+						position, tokenIndex, depth = position2459, tokenIndex2459, depth2459
+						if buffer[position] != rune('L') {
+							goto l2456
 						}
 						position++
 					}
-				l1736:
+				l2459:
 					{
-						position1738, tokenIndex1738, depth1738 := position, tokenIndex, depth
-						if buffer[position] != rune('a') {
-							goto l1739
+						position2461, tokenIndex2461, depth2461 := position, tokenIndex, depth
+						if buffer[position] != rune('i') {
+							goto l2462
 						}
 						position++
-						goto l1738
-					l1739:
-						position, tokenIndex, depth = position1738, tokenIndex1738, depth1738
-						if buffer[position] != rune('A') {
-							goto l1723
+						goto l2461
+					l2462:
+						position, tokenIndex, depth = position2461, tokenIndex2461, depth2461
+						if buffer[position] != rune('I') {
+							goto l2456
 						}
 						position++
 					}
-				l1738:
+				l2461:
 					{
-						position1740, tokenIndex1740, depth1740 := position, tokenIndex, depth
-						if buffer[position] != rune('m') {
-							goto l1741
+						position2463, tokenIndex2463, depth2463 := position, tokenIndex, depth
+						if buffer[position] != rune('k') {
+							goto l2464
 						}
 						position++
-						goto l1740
-					l1741:
-						position, tokenIndex, depth = position1740, tokenIndex1740, depth1740
-						if buffer[position] != rune('M') {
-							goto l1723
+						goto l2463
+					l2464:
+						position, tokenIndex, depth = position2463, tokenIndex2463, depth2463
+						if buffer[position] != rune('K') {
+							goto l2456
 						}
 						position++
 					}
-				l1740:
+				l2463:
 					{
-						position1742, tokenIndex1742, depth1742 := position, tokenIndex, depth
-						if buffer[position] != rune('p') {
-							goto l1743
+						position2465, tokenIndex2465, depth2465 := position, tokenIndex, depth
+						if buffer[position] != rune('e') {
+							goto l2466
 						}
 						position++
-						goto l1742
-					l1743:
-						position, tokenIndex, depth = position1742, tokenIndex1742, depth1742
-						if buffer[position] != rune('P') {
-							goto l1723
+						goto l2465
+					l2466:
+						position, tokenIndex, depth = position2465, tokenIndex2465, depth2465
+						if buffer[position] != rune('E') {
+							goto l2456
 						}
 						position++
 					}
-				l1742:
+				l2465:
 					depth--
-					add(rulePegText, position1725)
+					add(rulePegText, position2458)
 				}
-				if !_rules[ruleAction111]() {
-					goto l1723
+				if !_rules[ruleAction152]() {
+					goto l2456
 				}
 				depth--
-				add(ruleTimestamp, position1724)
+				add(ruleLike, position2457)
 			}
 			return true
-		l1723:
-			position, tokenIndex, depth = position1723, tokenIndex1723, depth1723
+		l2456:
+			position, tokenIndex, depth = position2456, tokenIndex2456, depth2456
 			return false
 		},
-		/* 143 Array <- <(<(('a' / 'A') ('r' / 'R') ('r' / 'R') ('a' / 'A') ('y' / 'Y'))> Action112)> */
+		/* 191 NotLike <- <(<(('n' / 'N') ('o' / 'O') ('t' / 'T') sp (('l' / 'L') ('i' / 'I') ('k' / 'K') ('e' / 'E')))> Action153)> */
 		func() bool {
-			position1744, tokenIndex1744, depth1744 := position, tokenIndex, depth
+			position2467, tokenIndex2467, depth2467 := position, tokenIndex, depth
 			{
-				position1745 := position
+				position2468 := position
 				depth++
 				{
-					position1746 := position
+					position2469 := position
 					depth++
 					{
-						position1747, tokenIndex1747, depth1747 := position, tokenIndex, depth
-						if buffer[position] != rune('a') {
-							goto l1748
+						position2470, tokenIndex2470, depth2470 := position, tokenIndex, depth
+						if buffer[position] != rune('n') {
+							goto l2471
 						}
 						position++
-						goto l1747
-					l1748:
-						position, tokenIndex, depth = position1747, tokenIndex1747, depth1747
-						if buffer[position] != rune('A') {
-							goto l1744
+						goto l2470
+					l2471:
+						position, tokenIndex, depth = position2470, tokenIndex2470, depth2470
+						if buffer[position] != rune('N') {
+							goto l2467
 						}
 						position++
 					}
-				l1747:
+				l2470:
 					{
-						position1749, tokenIndex1749, depth1749 := position, tokenIndex, depth
-						if buffer[position] != rune('r') {
-							goto l1750
+						position2472, tokenIndex2472, depth2472 := position, tokenIndex, depth
+						if buffer[position] != rune('o') {
+							goto l2473
 						}
 						position++
-						goto l1749
-					l1750:
-						position, tokenIndex, depth = position1749, tokenIndex1749, depth1749
-						if buffer[position] != rune('R') {
-							goto l1744
+						goto l2472
+					l2473:
+						position, tokenIndex, depth = position2472, tokenIndex2472, depth2472
+						if buffer[position] != rune('O') {
+							goto l2467
 						}
 						position++
 					}
-				l1749:
+				l2472:
 					{
-						position1751, tokenIndex1751, depth1751 := position, tokenIndex, depth
-						if buffer[position] != rune('r') {
-							goto l1752
+						position2474, tokenIndex2474, depth2474 := position, tokenIndex, depth
+						if buffer[position] != rune('t') {
+							goto l2475
 						}
 						position++
-						goto l1751
-					l1752:
-						position, tokenIndex, depth = position1751, tokenIndex1751, depth1751
-						if buffer[position] != rune('R') {
-							goto l1744
+						goto l2474
+					l2475:
+						position, tokenIndex, depth = position2474, tokenIndex2474, depth2474
+						if buffer[position] != rune('T') {
+							goto l2467
 						}
 						position++
 					}
-				l1751:
-					{
-						position1753, tokenIndex1753, depth1753 := position, tokenIndex, depth
-						if buffer[position] != rune('a') {
-							goto l1754
-						}
-						position++
-						goto l1753
-					l1754:
-						position, tokenIndex, depth = position1753, tokenIndex1753, depth1753
-						if buffer[position] != rune('A') {
-							goto l1744
-						}
-						position++
+				l2474:
+					if !_rules[rulesp]() {
+						goto l2467
 					}
-				l1753:
 					{
-						position1755, tokenIndex1755, depth1755 := position, tokenIndex, depth
-						if buffer[position] != rune('y') {
-							goto l1756
+						position2476, tokenIndex2476, depth2476 := position, tokenIndex, depth
+						if buffer[position] != rune('l') {
+							goto l2477
 						}
 						position++
-						goto l1755
-					l1756:
-						position, tokenIndex, depth = position1755, tokenIndex1755, depth1755
-						if buffer[position] != rune('Y') {
-							goto l1744
+						goto l2476
+					l2477:
+						position, tokenIndex, depth = position2476, tokenIndex2476, depth2476
+						if buffer[position] != rune('L') {
+							goto l2467
 						}
 						position++
 					}
-				l1755:
-					depth--
-					add(rulePegText, position1746)
-				}
-				if !_rules[ruleAction112]() {
-					goto l1744
-				}
-				depth--
-				add(ruleArray, position1745)
-			}
-			return true
-		l1744:
-			position, tokenIndex, depth = position1744, tokenIndex1744, depth1744
-			return false
-		},
-		/* 144 Map <- <(<(('m' / 'M') ('a' / 'A') ('p' / 'P'))> Action113)> */
-		func() bool {
-			position1757, tokenIndex1757, depth1757 := position, tokenIndex, depth
-			{
-				position1758 := position
-				depth++
-				{
-					position1759 := position
-					depth++
+				l2476:
 					{
-						position1760, tokenIndex1760, depth1760 := position, tokenIndex, depth
-						if buffer[position] != rune('m') {
-							goto l1761
+						position2478, tokenIndex2478, depth2478 := position, tokenIndex, depth
+						if buffer[position] != rune('i') {
+							goto l2479
 						}
 						position++
-						goto l1760
-					l1761:
-						position, tokenIndex, depth = position1760, tokenIndex1760, depth1760
-						if buffer[position] != rune('M') {
-							goto l1757
+						goto l2478
+					l2479:
+						position, tokenIndex, depth = position2478, tokenIndex2478, depth2478
+						if buffer[position] != rune('I') {
+							goto l2467
 						}
 						position++
 					}
-				l1760:
+				l2478:
 					{
-						position1762, tokenIndex1762, depth1762 := position, tokenIndex, depth
-						if buffer[position] != rune('a') {
-							goto l1763
+						position2480, tokenIndex2480, depth2480 := position, tokenIndex, depth
+						if buffer[position] != rune('k') {
+							goto l2481
 						}
 						position++
-						goto l1762
-					l1763:
-						position, tokenIndex, depth = position1762, tokenIndex1762, depth1762
-						if buffer[position] != rune('A') {
-							goto l1757
+						goto l2480
+					l2481:
+						position, tokenIndex, depth = position2480, tokenIndex2480, depth2480
+						if buffer[position] != rune('K') {
+							goto l2467
 						}
 						position++
 					}
-				l1762:
+				l2480:
 					{
-						position1764, tokenIndex1764, depth1764 := position, tokenIndex, depth
-						if buffer[position] != rune('p') {
-							goto l1765
+						position2482, tokenIndex2482, depth2482 := position, tokenIndex, depth
+						if buffer[position] != rune('e') {
+							goto l2483
 						}
 						position++
-						goto l1764
-					l1765:
-						position, tokenIndex, depth = position1764, tokenIndex1764, depth1764
-						if buffer[position] != rune('P') {
-							goto l1757
+						goto l2482
+					l2483:
+						position, tokenIndex, depth = position2482, tokenIndex2482, depth2482
+						if buffer[position] != rune('E') {
+							goto l2467
 						}
 						position++
 					}
-				l1764:
+				l2482:
 					depth--
-					add(rulePegText, position1759)
+					add(rulePegText, position2469)
 				}
-				if !_rules[ruleAction113]() {
-					goto l1757
+				if !_rules[ruleAction153]() {
+					goto l2467
 				}
 				depth--
-				add(ruleMap, position1758)
+				add(ruleNotLike, position2468)
 			}
 			return true
-		l1757:
-			position, tokenIndex, depth = position1757, tokenIndex1757, depth1757
+		l2467:
+			position, tokenIndex, depth = position2467, tokenIndex2467, depth2467
 			return false
 		},
-		/* 145 Or <- <(<(('o' / 'O') ('r' / 'R'))> Action114)> */
+		/* 192 ILike <- <(<(('i' / 'I') ('l' / 'L') ('i' / 'I') ('k' / 'K') ('e' / 'E'))> Action154)> */
 		func() bool {
-			position1766, tokenIndex1766, depth1766 := position, tokenIndex, depth
+			position2484, tokenIndex2484, depth2484 := position, tokenIndex, depth
 			{
-				position1767 := position
+				position2485 := position
 				depth++
 				{
-					position1768 := position
+					position2486 := position
 					depth++
 					{
-						position1769, tokenIndex1769, depth1769 := position, tokenIndex, depth
-						if buffer[position] != rune('o') {
-							goto l1770
+						position2487, tokenIndex2487, depth2487 := position, tokenIndex, depth
+						if buffer[position] != rune('i') {
+							goto l2488
 						}
 						position++
-						goto l1769
-					l1770:
-						position, tokenIndex, depth = position1769, tokenIndex1769, depth1769
-						if buffer[position] != rune('O') {
-							goto l1766
+						goto l2487
+					l2488:
+						position, tokenIndex, depth = position2487, tokenIndex2487, depth2487
+						if buffer[position] != rune('I') {
+							goto l2484
 						}
 						position++
 					}
-				l1769:
+				l2487:
 					{
-						position1771, tokenIndex1771, depth1771 := position, tokenIndex, depth
-						if buffer[position] != rune('r') {
-							goto l1772
+						position2489, tokenIndex2489, depth2489 := position, tokenIndex, depth
+						if buffer[position] != rune('l') {
+							goto l2490
 						}
 						position++
-						goto l1771
-					l1772:
-						position, tokenIndex, depth = position1771, tokenIndex1771, depth1771
-						if buffer[position] != rune('R') {
-							goto l1766
+						goto l2489
+					l2490:
+						position, tokenIndex, depth = position2489, tokenIndex2489, depth2489
+						if buffer[position] != rune('L') {
+							goto l2484
 						}
 						position++
 					}
-				l1771:
-					depth--
-					add(rulePegText, position1768)
-				}
-				if !_rules[ruleAction114]() {
-					goto l1766
-				}
-				depth--
-				add(ruleOr, position1767)
-			}
-			return true
-		l1766:
-			position, tokenIndex, depth = position1766, tokenIndex1766, depth1766
-			return false
-		},
-		/* 146 And <- <(<(('a' / 'A') ('n' / 'N') ('d' / 'D'))> Action115)> */
-		func() bool {
-			position1773, tokenIndex1773, depth1773 := position, tokenIndex, depth
-			{
-				position1774 := position
-				depth++
-				{
-					position1775 := position
-					depth++
+				l2489:
 					{
-						position1776, tokenIndex1776, depth1776 := position, tokenIndex, depth
-						if buffer[position] != rune('a') {
-							goto l1777
+						position2491, tokenIndex2491, depth2491 := position, tokenIndex, depth
+						if buffer[position] != rune('i') {
+							goto l2492
 						}
 						position++
-						goto l1776
-					l1777:
-						position, tokenIndex, depth = position1776, tokenIndex1776, depth1776
-						if buffer[position] != rune('A') {
-							goto l1773
+						goto l2491
+					l2492:
+						position, tokenIndex, depth = position2491, tokenIndex2491, depth2491
+						if buffer[position] != rune('I') {
+							goto l2484
 						}
 						position++
 					}
-				l1776:
+				l2491:
 					{
-						position1778, tokenIndex1778, depth1778 := position, tokenIndex, depth
-						if buffer[position] != rune('n') {
-							goto l1779
+						position2493, tokenIndex2493, depth2493 := position, tokenIndex, depth
+						if buffer[position] != rune('k') {
+							goto l2494
 						}
 						position++
-						goto l1778
-					l1779:
-						position, tokenIndex, depth = position1778, tokenIndex1778, depth1778
-						if buffer[position] != rune('N') {
-							goto l1773
+						goto l2493
+					l2494:
+						position, tokenIndex, depth = position2493, tokenIndex2493, depth2493
+						if buffer[position] != rune('K') {
+							goto l2484
 						}
 						position++
 					}
-				l1778:
+				l2493:
 					{
-						position1780, tokenIndex1780, depth1780 := position, tokenIndex, depth
-						if buffer[position] != rune('d') {
-							goto l1781
+						position2495, tokenIndex2495, depth2495 := position, tokenIndex, depth
+						if buffer[position] != rune('e') {
+							goto l2496
 						}
 						position++
-						goto l1780
-					l1781:
-						position, tokenIndex, depth = position1780, tokenIndex1780, depth1780
-						if buffer[position] != rune('D') {
-							goto l1773
+						goto l2495
+					l2496:
+						position, tokenIndex, depth = position2495, tokenIndex2495, depth2495
+						if buffer[position] != rune('E') {
+							goto l2484
 						}
 						position++
 					}
-				l1780:
+				l2495:
 					depth--
-					add(rulePegText, position1775)
+					add(rulePegText, position2486)
 				}
-				if !_rules[ruleAction115]() {
-					goto l1773
+				if !_rules[ruleAction154]() {
+					goto l2484
 				}
 				depth--
-				add(ruleAnd, position1774)
+				add(ruleILike, position2485)
 			}
 			return true
-		l1773:
-			position, tokenIndex, depth = position1773, tokenIndex1773, depth1773
+		l2484:
+			position, tokenIndex, depth = position2484, tokenIndex2484, depth2484
 			return false
 		},
-		/* 147 Not <- <(<(('n' / 'N') ('o' / 'O') ('t' / 'T'))> Action116)> */
+		/* 193 NotILike <- <(<(('n' / 'N') ('o' / 'O') ('t' / 'T') sp (('i' / 'I') ('l' / 'L') ('i' / 'I') ('k' / 'K') ('e' / 'E')))> Action155)> */
 		func() bool {
-			position1782, tokenIndex1782, depth1782 := position, tokenIndex, depth
+			position2497, tokenIndex2497, depth2497 := position, tokenIndex, depth
 			{
-				position1783 := position
+				position2498 := position
 				depth++
 				{
-					position1784 := position
+					position2499 := position
 					depth++
 					{
-						position1785, tokenIndex1785, depth1785 := position, tokenIndex, depth
+						position2500, tokenIndex2500, depth2500 := position, tokenIndex, depth
 						if buffer[position] != rune('n') {
-							goto l1786
+							goto l2501
 						}
 						position++
-						goto l1785
-					l1786:
-						position, tokenIndex, depth = position1785, tokenIndex1785, depth1785
+						goto l2500
+					l2501:
+						position, tokenIndex, depth = position2500, tokenIndex2500, depth2500
 						if buffer[position] != rune('N') {
-							goto l1782
+							goto l2497
 						}
 						position++
 					}
-				l1785:
+				l2500:
 					{
-						position1787, tokenIndex1787, depth1787 := position, tokenIndex, depth
+						position2502, tokenIndex2502, depth2502 := position, tokenIndex, depth
 						if buffer[position] != rune('o') {
-							goto l1788
+							goto l2503
 						}
 						position++
-						goto l1787
-					l1788:
-						position, tokenIndex, depth = position1787, tokenIndex1787, depth1787
+						goto l2502
+					l2503:
+						position, tokenIndex, depth = position2502, tokenIndex2502, depth2502
 						if buffer[position] != rune('O') {
-							goto l1782
+							goto l2497
 						}
 						position++
 					}
-				l1787:
+				l2502:
 					{
-						position1789, tokenIndex1789, depth1789 := position, tokenIndex, depth
+						position2504, tokenIndex2504, depth2504 := position, tokenIndex, depth
 						if buffer[position] != rune('t') {
-							goto l1790
+							goto l2505
 						}
 						position++
-						goto l1789
-					l1790:
-						position, tokenIndex, depth = position1789, tokenIndex1789, depth1789
+						goto l2504
+					l2505:
+						position, tokenIndex, depth = position2504, tokenIndex2504, depth2504
 						if buffer[position] != rune('T') {
-							goto l1782
+							goto l2497
 						}
 						position++
 					}
-				l1789:
-					depth--
-					add(rulePegText, position1784)
-				}
-				if !_rules[ruleAction116]() {
-					goto l1782
-				}
-				depth--
-				add(ruleNot, position1783)
-			}
-			return true
-		l1782:
-			position, tokenIndex, depth = position1782, tokenIndex1782, depth1782
-			return false
-		},
-		/* 148 Equal <- <(<'='> Action117)> */
-		func() bool {
-			position1791, tokenIndex1791, depth1791 := position, tokenIndex, depth
-			{
-				position1792 := position
-				depth++
-				{
-					position1793 := position
-					depth++
-					if buffer[position] != rune('=') {
-						goto l1791
+				l2504:
+					if !_rules[rulesp]() {
+						goto l2497
+					}
+					{
+						position2506, tokenIndex2506, depth2506 := position, tokenIndex, depth
+						if buffer[position] != rune('i') {
+							goto l2507
+						}
+						position++
+						goto l2506
+					l2507:
+						position, tokenIndex, depth = position2506, tokenIndex2506, depth2506
+						if buffer[position] != rune('I') {
+							goto l2497
+						}
+						position++
+					}
+				l2506:
+					{
+						position2508, tokenIndex2508, depth2508 := position, tokenIndex, depth
+						if buffer[position] != rune('l') {
+							goto l2509
+						}
+						position++
+						goto l2508
+					l2509:
+						position, tokenIndex, depth = position2508, tokenIndex2508, depth2508
+						if buffer[position] != rune('L') {
+							goto l2497
+						}
+						position++
+					}
+				l2508:
+					{
+						position2510, tokenIndex2510, depth2510 := position, tokenIndex, depth
+						if buffer[position] != rune('i') {
+							goto l2511
+						}
+						position++
+						goto l2510
+					l2511:
+						position, tokenIndex, depth = position2510, tokenIndex2510, depth2510
+						if buffer[position] != rune('I') {
+							goto l2497
+						}
+						position++
+					}
+				l2510:
+					{
+						position2512, tokenIndex2512, depth2512 := position, tokenIndex, depth
+						if buffer[position] != rune('k') {
+							goto l2513
+						}
+						position++
+						goto l2512
+					l2513:
+						position, tokenIndex, depth = position2512, tokenIndex2512, depth2512
+						if buffer[position] != rune('K') {
+							goto l2497
+						}
+						position++
 					}
-					position++
+				l2512:
+					{
+						position2514, tokenIndex2514, depth2514 := position, tokenIndex, depth
+						if buffer[position] != rune('e') {
+							goto l2515
+						}
+						position++
+						goto l2514
+					l2515:
+						position, tokenIndex, depth = position2514, tokenIndex2514, depth2514
+						if buffer[position] != rune('E') {
+							goto l2497
+						}
+						position++
+					}
+				l2514:
 					depth--
-					add(rulePegText, position1793)
+					add(rulePegText, position2499)
 				}
-				if !_rules[ruleAction117]() {
-					goto l1791
+				if !_rules[ruleAction155]() {
+					goto l2497
 				}
 				depth--
-				add(ruleEqual, position1792)
+				add(ruleNotILike, position2498)
 			}
 			return true
-		l1791:
-			position, tokenIndex, depth = position1791, tokenIndex1791, depth1791
+		l2497:
+			position, tokenIndex, depth = position2497, tokenIndex2497, depth2497
 			return false
 		},
-		/* 149 Less <- <(<'<'> Action118)> */
+		/* 194 RegexpMatch <- <(<'~'> Action156)> */
 		func() bool {
-			position1794, tokenIndex1794, depth1794 := position, tokenIndex, depth
+			position2516, tokenIndex2516, depth2516 := position, tokenIndex, depth
 			{
-				position1795 := position
+				position2517 := position
 				depth++
 				{
-					position1796 := position
+					position2518 := position
 					depth++
-					if buffer[position] != rune('<') {
-						goto l1794
+					if buffer[position] != rune('~') {
+						goto l2516
 					}
 					position++
 					depth--
-					add(rulePegText, position1796)
+					add(rulePegText, position2518)
 				}
-				if !_rules[ruleAction118]() {
-					goto l1794
+				if !_rules[ruleAction156]() {
+					goto l2516
 				}
 				depth--
-				add(ruleLess, position1795)
+				add(ruleRegexpMatch, position2517)
 			}
 			return true
-		l1794:
-			position, tokenIndex, depth = position1794, tokenIndex1794, depth1794
+		l2516:
+			position, tokenIndex, depth = position2516, tokenIndex2516, depth2516
 			return false
 		},
-		/* 150 LessOrEqual <- <(<('<' '=')> Action119)> */
+		/* 195 NotRegexpMatch <- <(<('!' '~')> Action157)> */
 		func() bool {
-			position1797, tokenIndex1797, depth1797 := position, tokenIndex, depth
+			position2519, tokenIndex2519, depth2519 := position, tokenIndex, depth
 			{
-				position1798 := position
+				position2520 := position
 				depth++
 				{
-					position1799 := position
+					position2521 := position
 					depth++
-					if buffer[position] != rune('<') {
-						goto l1797
+					if buffer[position] != rune('!') {
+						goto l2519
 					}
 					position++
-					if buffer[position] != rune('=') {
-						goto l1797
+					if buffer[position] != rune('~') {
+						goto l2519
 					}
 					position++
 					depth--
-					add(rulePegText, position1799)
+					add(rulePegText, position2521)
 				}
-				if !_rules[ruleAction119]() {
-					goto l1797
+				if !_rules[ruleAction157]() {
+					goto l2519
 				}
 				depth--
-				add(ruleLessOrEqual, position1798)
+				add(ruleNotRegexpMatch, position2520)
 			}
 			return true
-		l1797:
-			position, tokenIndex, depth = position1797, tokenIndex1797, depth1797
+		l2519:
+			position, tokenIndex, depth = position2519, tokenIndex2519, depth2519
 			return false
 		},
-		/* 151 Greater <- <(<'>'> Action120)> */
+		/* 196 Between <- <(<(('b' / 'B') ('e' / 'E') ('t' / 'T') ('w' / 'W') ('e' / 'E') ('e' / 'E') ('n' / 'N'))> Action158)> */
 		func() bool {
-			position1800, tokenIndex1800, depth1800 := position, tokenIndex, depth
+			position2522, tokenIndex2522, depth2522 := position, tokenIndex, depth
 			{
-				position1801 := position
+				position2523 := position
 				depth++
 				{
-					position1802 := position
+					position2524 := position
 					depth++
-					if buffer[position] != rune('>') {
-						goto l1800
+					{
+						position2525, tokenIndex2525, depth2525 := position, tokenIndex, depth
+						if buffer[position] != rune('b') {
+							goto l2526
+						}
+						position++
+						goto l2525
+					l2526:
+						position, tokenIndex, depth = position2525, tokenIndex2525, depth2525
+						if buffer[position] != rune('B') {
+							goto l2522
+						}
+						position++
 					}
-					position++
-					depth--
-					add(rulePegText, position1802)
-				}
-				if !_rules[ruleAction120]() {
-					goto l1800
-				}
-				depth--
-				add(ruleGreater, position1801)
-			}
-			return true
-		l1800:
-			position, tokenIndex, depth = position1800, tokenIndex1800, depth1800
-			return false
-		},
-		/* 152 GreaterOrEqual <- <(<('>' '=')> Action121)> */
-		func() bool {
-			position1803, tokenIndex1803, depth1803 := position, tokenIndex, depth
-			{
-				position1804 := position
-				depth++
-				{
-					position1805 := position
-					depth++
-					if buffer[position] != rune('>') {
-						goto l1803
+				l2525:
+					{
+						position2527, tokenIndex2527, depth2527 := position, tokenIndex, depth
+						if buffer[position] != rune('e') {
+							goto l2528
+						}
+						position++
+						goto l2527
+					l2528:
+						position, tokenIndex, depth = position2527, tokenIndex2527, depth2527
+						if buffer[position] != rune('E') {
+							goto l2522
+						}
+						position++
 					}
-					position++
-					if buffer[position] != rune('=') {
-						goto l1803
+				l2527:
+					{
+						position2529, tokenIndex2529, depth2529 := position, tokenIndex, depth
+						if buffer[position] != rune('t') {
+							goto l2530
+						}
+						position++
+						goto l2529
+					l2530:
+						position, tokenIndex, depth = position2529, tokenIndex2529, depth2529
+						if buffer[position] != rune('T') {
+							goto l2522
+						}
+						position++
 					}
-					position++
+				l2529:
+					{
+						position2531, tokenIndex2531, depth2531 := position, tokenIndex, depth
+						if buffer[position] != rune('w') {
+							goto l2532
+						}
+						position++
+						goto l2531
+					l2532:
+						position, tokenIndex, depth = position2531, tokenIndex2531, depth2531
+						if buffer[position] != rune('W') {
+							goto l2522
+						}
+						position++
+					}
+				l2531:
+					{
+						position2533, tokenIndex2533, depth2533 := position, tokenIndex, depth
+						if buffer[position] != rune('e') {
+							goto l2534
+						}
+						position++
+						goto l2533
+					l2534:
+						position, tokenIndex, depth = position2533, tokenIndex2533, depth2533
+						if buffer[position] != rune('E') {
+							goto l2522
+						}
+						position++
+					}
+				l2533:
+					{
+						position2535, tokenIndex2535, depth2535 := position, tokenIndex, depth
+						if buffer[position] != rune('e') {
+							goto l2536
+						}
+						position++
+						goto l2535
+					l2536:
+						position, tokenIndex, depth = position2535, tokenIndex2535, depth2535
+						if buffer[position] != rune('E') {
+							goto l2522
+						}
+						position++
+					}
+				l2535:
+					{
+						position2537, tokenIndex2537, depth2537 := position, tokenIndex, depth
+						if buffer[position] != rune('n') {
+							goto l2538
+						}
+						position++
+						goto l2537
+					l2538:
+						position, tokenIndex, depth = position2537, tokenIndex2537, depth2537
+						if buffer[position] != rune('N') {
+							goto l2522
+						}
+						position++
+					}
+				l2537:
 					depth--
-					add(rulePegText, position1805)
+					add(rulePegText, position2524)
 				}
-				if !_rules[ruleAction121]() {
-					goto l1803
+				if !_rules[ruleAction158]() {
+					goto l2522
 				}
 				depth--
-				add(ruleGreaterOrEqual, position1804)
+				add(ruleBetween, position2523)
 			}
 			return true
-		l1803:
-			position, tokenIndex, depth = position1803, tokenIndex1803, depth1803
+		l2522:
+			position, tokenIndex, depth = position2522, tokenIndex2522, depth2522
 			return false
 		},
-		/* 153 NotEqual <- <(<(('!' '=') / ('<' '>'))> Action122)> */
+		/* 197 NotBetween <- <(<(('n' / 'N') ('o' / 'O') ('t' / 'T') sp (('b' / 'B') ('e' / 'E') ('t' / 'T') ('w' / 'W') ('e' / 'E') ('e' / 'E') ('n' / 'N')))> Action159)> */
 		func() bool {
-			position1806, tokenIndex1806, depth1806 := position, tokenIndex, depth
+			position2539, tokenIndex2539, depth2539 := position, tokenIndex, depth
 			{
-				position1807 := position
+				position2540 := position
 				depth++
 				{
-					position1808 := position
+					position2541 := position
 					depth++
 					{
-						position1809, tokenIndex1809, depth1809 := position, tokenIndex, depth
-						if buffer[position] != rune('!') {
-							goto l1810
+						position2542, tokenIndex2542, depth2542 := position, tokenIndex, depth
+						if buffer[position] != rune('n') {
+							goto l2543
 						}
 						position++
-						if buffer[position] != rune('=') {
-							goto l1810
+						goto l2542
+					l2543:
+						position, tokenIndex, depth = position2542, tokenIndex2542, depth2542
+						if buffer[position] != rune('N') {
+							goto l2539
 						}
 						position++
-						goto l1809
-					l1810:
-						position, tokenIndex, depth = position1809, tokenIndex1809, depth1809
-						if buffer[position] != rune('<') {
-							goto l1806
+					}
+				l2542:
+					{
+						position2544, tokenIndex2544, depth2544 := position, tokenIndex, depth
+						if buffer[position] != rune('o') {
+							goto l2545
 						}
 						position++
-						if buffer[position] != rune('>') {
-							goto l1806
+						goto l2544
+					l2545:
+						position, tokenIndex, depth = position2544, tokenIndex2544, depth2544
+						if buffer[position] != rune('O') {
+							goto l2539
+						}
+						position++
+					}
+				l2544:
+					{
+						position2546, tokenIndex2546, depth2546 := position, tokenIndex, depth
+						if buffer[position] != rune('t') {
+							goto l2547
+						}
+						position++
+						goto l2546
+					l2547:
+						position, tokenIndex, depth = position2546, tokenIndex2546, depth2546
+						if buffer[position] != rune('T') {
+							goto l2539
+						}
+						position++
+					}
+				l2546:
+					if !_rules[rulesp]() {
+						goto l2539
+					}
+					{
+						position2548, tokenIndex2548, depth2548 := position, tokenIndex, depth
+						if buffer[position] != rune('b') {
+							goto l2549
+						}
+						position++
+						goto l2548
+					l2549:
+						position, tokenIndex, depth = position2548, tokenIndex2548, depth2548
+						if buffer[position] != rune('B') {
+							goto l2539
+						}
+						position++
+					}
+				l2548:
+					{
+						position2550, tokenIndex2550, depth2550 := position, tokenIndex, depth
+						if buffer[position] != rune('e') {
+							goto l2551
+						}
+						position++
+						goto l2550
+					l2551:
+						position, tokenIndex, depth = position2550, tokenIndex2550, depth2550
+						if buffer[position] != rune('E') {
+							goto l2539
+						}
+						position++
+					}
+				l2550:
+					{
+						position2552, tokenIndex2552, depth2552 := position, tokenIndex, depth
+						if buffer[position] != rune('t') {
+							goto l2553
+						}
+						position++
+						goto l2552
+					l2553:
+						position, tokenIndex, depth = position2552, tokenIndex2552, depth2552
+						if buffer[position] != rune('T') {
+							goto l2539
+						}
+						position++
+					}
+				l2552:
+					{
+						position2554, tokenIndex2554, depth2554 := position, tokenIndex, depth
+						if buffer[position] != rune('w') {
+							goto l2555
+						}
+						position++
+						goto l2554
+					l2555:
+						position, tokenIndex, depth = position2554, tokenIndex2554, depth2554
+						if buffer[position] != rune('W') {
+							goto l2539
+						}
+						position++
+					}
+				l2554:
+					{
+						position2556, tokenIndex2556, depth2556 := position, tokenIndex, depth
+						if buffer[position] != rune('e') {
+							goto l2557
+						}
+						position++
+						goto l2556
+					l2557:
+						position, tokenIndex, depth = position2556, tokenIndex2556, depth2556
+						if buffer[position] != rune('E') {
+							goto l2539
+						}
+						position++
+					}
+				l2556:
+					{
+						position2558, tokenIndex2558, depth2558 := position, tokenIndex, depth
+						if buffer[position] != rune('e') {
+							goto l2559
+						}
+						position++
+						goto l2558
+					l2559:
+						position, tokenIndex, depth = position2558, tokenIndex2558, depth2558
+						if buffer[position] != rune('E') {
+							goto l2539
+						}
+						position++
+					}
+				l2558:
+					{
+						position2560, tokenIndex2560, depth2560 := position, tokenIndex, depth
+						if buffer[position] != rune('n') {
+							goto l2561
+						}
+						position++
+						goto l2560
+					l2561:
+						position, tokenIndex, depth = position2560, tokenIndex2560, depth2560
+						if buffer[position] != rune('N') {
+							goto l2539
 						}
 						position++
 					}
-				l1809:
+				l2560:
 					depth--
-					add(rulePegText, position1808)
+					add(rulePegText, position2541)
 				}
-				if !_rules[ruleAction122]() {
-					goto l1806
+				if !_rules[ruleAction159]() {
+					goto l2539
 				}
 				depth--
-				add(ruleNotEqual, position1807)
+				add(ruleNotBetween, position2540)
 			}
 			return true
-		l1806:
-			position, tokenIndex, depth = position1806, tokenIndex1806, depth1806
+		l2539:
+			position, tokenIndex, depth = position2539, tokenIndex2539, depth2539
 			return false
 		},
-		/* 154 Concat <- <(<('|' '|')> Action123)> */
+		/* 198 Concat <- <(<('|' '|')> Action160)> */
 		func() bool {
-			position1811, tokenIndex1811, depth1811 := position, tokenIndex, depth
+			position2562, tokenIndex2562, depth2562 := position, tokenIndex, depth
 			{
-				position1812 := position
+				position2563 := position
 				depth++
 				{
-					position1813 := position
+					position2564 := position
 					depth++
 					if buffer[position] != rune('|') {
-						goto l1811
+						goto l2562
 					}
 					position++
 					if buffer[position] != rune('|') {
-						goto l1811
+						goto l2562
 					}
 					position++
 					depth--
-					add(rulePegText, position1813)
+					add(rulePegText, position2564)
 				}
-				if !_rules[ruleAction123]() {
-					goto l1811
+				if !_rules[ruleAction160]() {
+					goto l2562
 				}
 				depth--
-				add(ruleConcat, position1812)
+				add(ruleConcat, position2563)
 			}
 			return true
-		l1811:
-			position, tokenIndex, depth = position1811, tokenIndex1811, depth1811
+		l2562:
+			position, tokenIndex, depth = position2562, tokenIndex2562, depth2562
 			return false
 		},
-		/* 155 Is <- <(<(('i' / 'I') ('s' / 'S'))> Action124)> */
+		/* 199 Is <- <(<(('i' / 'I') ('s' / 'S'))> Action161)> */
 		func() bool {
-			position1814, tokenIndex1814, depth1814 := position, tokenIndex, depth
+			position2565, tokenIndex2565, depth2565 := position, tokenIndex, depth
 			{
-				position1815 := position
+				position2566 := position
 				depth++
 				{
-					position1816 := position
+					position2567 := position
 					depth++
 					{
-						position1817, tokenIndex1817, depth1817 := position, tokenIndex, depth
+						position2568, tokenIndex2568, depth2568 := position, tokenIndex, depth
 						if buffer[position] != rune('i') {
-							goto l1818
+							goto l2569
 						}
 						position++
-						goto l1817
-					l1818:
-						position, tokenIndex, depth = position1817, tokenIndex1817, depth1817
+						goto l2568
+					l2569:
+						position, tokenIndex, depth = position2568, tokenIndex2568, depth2568
 						if buffer[position] != rune('I') {
-							goto l1814
+							goto l2565
 						}
 						position++
 					}
-				l1817:
+				l2568:
 					{
-						position1819, tokenIndex1819, depth1819 := position, tokenIndex, depth
+						position2570, tokenIndex2570, depth2570 := position, tokenIndex, depth
 						if buffer[position] != rune('s') {
-							goto l1820
+							goto l2571
 						}
 						position++
-						goto l1819
-					l1820:
-						position, tokenIndex, depth = position1819, tokenIndex1819, depth1819
+						goto l2570
+					l2571:
+						position, tokenIndex, depth = position2570, tokenIndex2570, depth2570
 						if buffer[position] != rune('S') {
-							goto l1814
+							goto l2565
 						}
 						position++
 					}
-				l1819:
+				l2570:
 					depth--
-					add(rulePegText, position1816)
+					add(rulePegText, position2567)
 				}
-				if !_rules[ruleAction124]() {
-					goto l1814
+				if !_rules[ruleAction161]() {
+					goto l2565
 				}
 				depth--
-				add(ruleIs, position1815)
+				add(ruleIs, position2566)
 			}
 			return true
-		l1814:
-			position, tokenIndex, depth = position1814, tokenIndex1814, depth1814
+		l2565:
+			position, tokenIndex, depth = position2565, tokenIndex2565, depth2565
 			return false
 		},
-		/* 156 IsNot <- <(<(('i' / 'I') ('s' / 'S') sp (('n' / 'N') ('o' / 'O') ('t' / 'T')))> Action125)> */
+		/* 200 IsNot <- <(<(('i' / 'I') ('s' / 'S') sp (('n' / 'N') ('o' / 'O') ('t' / 'T')))> Action162)> */
 		func() bool {
-			position1821, tokenIndex1821, depth1821 := position, tokenIndex, depth
+			position2572, tokenIndex2572, depth2572 := position, tokenIndex, depth
 			{
-				position1822 := position
+				position2573 := position
 				depth++
 				{
-					position1823 := position
+					position2574 := position
 					depth++
 					{
-						position1824, tokenIndex1824, depth1824 := position, tokenIndex, depth
+						position2575, tokenIndex2575, depth2575 := position, tokenIndex, depth
 						if buffer[position] != rune('i') {
-							goto l1825
+							goto l2576
 						}
 						position++
-						goto l1824
-					l1825:
-						position, tokenIndex, depth = position1824, tokenIndex1824, depth1824
+						goto l2575
+					l2576:
+						position, tokenIndex, depth = position2575, tokenIndex2575, depth2575
 						if buffer[position] != rune('I') {
-							goto l1821
+							goto l2572
 						}
 						position++
 					}
-				l1824:
+				l2575:
 					{
-						position1826, tokenIndex1826, depth1826 := position, tokenIndex, depth
+						position2577, tokenIndex2577, depth2577 := position, tokenIndex, depth
 						if buffer[position] != rune('s') {
-							goto l1827
+							goto l2578
 						}
 						position++
-						goto l1826
-					l1827:
-						position, tokenIndex, depth = position1826, tokenIndex1826, depth1826
+						goto l2577
+					l2578:
+						position, tokenIndex, depth = position2577, tokenIndex2577, depth2577
 						if buffer[position] != rune('S') {
-							goto l1821
+							goto l2572
 						}
 						position++
 					}
-				l1826:
+				l2577:
 					if !_rules[rulesp]() {
-						goto l1821
+						goto l2572
 					}
 					{
-						position1828, tokenIndex1828, depth1828 := position, tokenIndex, depth
+						position2579, tokenIndex2579, depth2579 := position, tokenIndex, depth
 						if buffer[position] != rune('n') {
-							goto l1829
+							goto l2580
 						}
 						position++
-						goto l1828
-					l1829:
-						position, tokenIndex, depth = position1828, tokenIndex1828, depth1828
+						goto l2579
+					l2580:
+						position, tokenIndex, depth = position2579, tokenIndex2579, depth2579
 						if buffer[position] != rune('N') {
-							goto l1821
+							goto l2572
 						}
 						position++
 					}
-				l1828:
+				l2579:
 					{
-						position1830, tokenIndex1830, depth1830 := position, tokenIndex, depth
+						position2581, tokenIndex2581, depth2581 := position, tokenIndex, depth
 						if buffer[position] != rune('o') {
-							goto l1831
+							goto l2582
 						}
 						position++
-						goto l1830
-					l1831:
-						position, tokenIndex, depth = position1830, tokenIndex1830, depth1830
+						goto l2581
+					l2582:
+						position, tokenIndex, depth = position2581, tokenIndex2581, depth2581
 						if buffer[position] != rune('O') {
-							goto l1821
+							goto l2572
 						}
 						position++
 					}
-				l1830:
+				l2581:
 					{
-						position1832, tokenIndex1832, depth1832 := position, tokenIndex, depth
+						position2583, tokenIndex2583, depth2583 := position, tokenIndex, depth
 						if buffer[position] != rune('t') {
-							goto l1833
+							goto l2584
 						}
 						position++
-						goto l1832
-					l1833:
-						position, tokenIndex, depth = position1832, tokenIndex1832, depth1832
+						goto l2583
+					l2584:
+						position, tokenIndex, depth = position2583, tokenIndex2583, depth2583
 						if buffer[position] != rune('T') {
-							goto l1821
+							goto l2572
 						}
 						position++
 					}
-				l1832:
+				l2583:
 					depth--
-					add(rulePegText, position1823)
+					add(rulePegText, position2574)
 				}
-				if !_rules[ruleAction125]() {
-					goto l1821
+				if !_rules[ruleAction162]() {
+					goto l2572
 				}
 				depth--
-				add(ruleIsNot, position1822)
+				add(ruleIsNot, position2573)
 			}
 			return true
-		l1821:
-			position, tokenIndex, depth = position1821, tokenIndex1821, depth1821
+		l2572:
+			position, tokenIndex, depth = position2572, tokenIndex2572, depth2572
 			return false
 		},
-		/* 157 Plus <- <(<'+'> Action126)> */
+		/* 201 Plus <- <(<'+'> Action163)> */
 		func() bool {
-			position1834, tokenIndex1834, depth1834 := position, tokenIndex, depth
+			position2585, tokenIndex2585, depth2585 := position, tokenIndex, depth
 			{
-				position1835 := position
+				position2586 := position
 				depth++
 				{
-					position1836 := position
+					position2587 := position
 					depth++
 					if buffer[position] != rune('+') {
-						goto l1834
+						goto l2585
 					}
 					position++
 					depth--
-					add(rulePegText, position1836)
+					add(rulePegText, position2587)
 				}
-				if !_rules[ruleAction126]() {
-					goto l1834
+				if !_rules[ruleAction163]() {
+					goto l2585
 				}
 				depth--
-				add(rulePlus, position1835)
+				add(rulePlus, position2586)
 			}
 			return true
-		l1834:
-			position, tokenIndex, depth = position1834, tokenIndex1834, depth1834
+		l2585:
+			position, tokenIndex, depth = position2585, tokenIndex2585, depth2585
 			return false
 		},
-		/* 158 Minus <- <(<'-'> Action127)> */
+		/* 202 Minus <- <(<'-'> Action164)> */
 		func() bool {
-			position1837, tokenIndex1837, depth1837 := position, tokenIndex, depth
+			position2588, tokenIndex2588, depth2588 := position, tokenIndex, depth
 			{
-				position1838 := position
+				position2589 := position
 				depth++
 				{
-					position1839 := position
+					position2590 := position
 					depth++
 					if buffer[position] != rune('-') {
-						goto l1837
+						goto l2588
 					}
 					position++
 					depth--
-					add(rulePegText, position1839)
+					add(rulePegText, position2590)
 				}
-				if !_rules[ruleAction127]() {
-					goto l1837
+				if !_rules[ruleAction164]() {
+					goto l2588
 				}
 				depth--
-				add(ruleMinus, position1838)
+				add(ruleMinus, position2589)
 			}
 			return true
-		l1837:
-			position, tokenIndex, depth = position1837, tokenIndex1837, depth1837
+		l2588:
+			position, tokenIndex, depth = position2588, tokenIndex2588, depth2588
 			return false
 		},
-		/* 159 Multiply <- <(<'*'> Action128)> */
+		/* 203 Multiply <- <(<'*'> Action165)> */
 		func() bool {
-			position1840, tokenIndex1840, depth1840 := position, tokenIndex, depth
+			position2591, tokenIndex2591, depth2591 := position, tokenIndex, depth
 			{
-				position1841 := position
+				position2592 := position
 				depth++
 				{
-					position1842 := position
+					position2593 := position
 					depth++
 					if buffer[position] != rune('*') {
-						goto l1840
+						goto l2591
 					}
 					position++
 					depth--
-					add(rulePegText, position1842)
+					add(rulePegText, position2593)
 				}
-				if !_rules[ruleAction128]() {
-					goto l1840
+				if !_rules[ruleAction165]() {
+					goto l2591
 				}
 				depth--
-				add(ruleMultiply, position1841)
+				add(ruleMultiply, position2592)
 			}
 			return true
-		l1840:
-			position, tokenIndex, depth = position1840, tokenIndex1840, depth1840
+		l2591:
+			position, tokenIndex, depth = position2591, tokenIndex2591, depth2591
 			return false
 		},
-		/* 160 Divide <- <(<'/'> Action129)> */
+		/* 204 Divide <- <(<'/'> Action166)> */
 		func() bool {
-			position1843, tokenIndex1843, depth1843 := position, tokenIndex, depth
+			position2594, tokenIndex2594, depth2594 := position, tokenIndex, depth
 			{
-				position1844 := position
+				position2595 := position
 				depth++
 				{
-					position1845 := position
+					position2596 := position
 					depth++
 					if buffer[position] != rune('/') {
-						goto l1843
+						goto l2594
 					}
 					position++
 					depth--
-					add(rulePegText, position1845)
+					add(rulePegText, position2596)
 				}
-				if !_rules[ruleAction129]() {
-					goto l1843
+				if !_rules[ruleAction166]() {
+					goto l2594
 				}
 				depth--
-				add(ruleDivide, position1844)
+				add(ruleDivide, position2595)
 			}
 			return true
-		l1843:
-			position, tokenIndex, depth = position1843, tokenIndex1843, depth1843
+		l2594:
+			position, tokenIndex, depth = position2594, tokenIndex2594, depth2594
 			return false
 		},
-		/* 161 Modulo <- <(<'%'> Action130)> */
+		/* 205 Modulo <- <(<'%'> Action167)> */
 		func() bool {
-			position1846, tokenIndex1846, depth1846 := position, tokenIndex, depth
+			position2597, tokenIndex2597, depth2597 := position, tokenIndex, depth
 			{
-				position1847 := position
+				position2598 := position
 				depth++
 				{
-					position1848 := position
+					position2599 := position
 					depth++
 					if buffer[position] != rune('%') {
-						goto l1846
+						goto l2597
 					}
 					position++
 					depth--
-					add(rulePegText, position1848)
+					add(rulePegText, position2599)
 				}
-				if !_rules[ruleAction130]() {
-					goto l1846
+				if !_rules[ruleAction167]() {
+					goto l2597
 				}
 				depth--
-				add(ruleModulo, position1847)
+				add(ruleModulo, position2598)
 			}
 			return true
-		l1846:
-			position, tokenIndex, depth = position1846, tokenIndex1846, depth1846
+		l2597:
+			position, tokenIndex, depth = position2597, tokenIndex2597, depth2597
 			return false
 		},
-		/* 162 UnaryMinus <- <(<'-'> Action131)> */
+		/* 206 UnaryMinus <- <(<'-'> Action168)> */
 		func() bool {
-			position1849, tokenIndex1849, depth1849 := position, tokenIndex, depth
+			position2600, tokenIndex2600, depth2600 := position, tokenIndex, depth
 			{
-				position1850 := position
+				position2601 := position
 				depth++
 				{
-					position1851 := position
+					position2602 := position
 					depth++
 					if buffer[position] != rune('-') {
-						goto l1849
+						goto l2600
 					}
 					position++
 					depth--
-					add(rulePegText, position1851)
+					add(rulePegText, position2602)
 				}
-				if !_rules[ruleAction131]() {
-					goto l1849
+				if !_rules[ruleAction168]() {
+					goto l2600
 				}
 				depth--
-				add(ruleUnaryMinus, position1850)
+				add(ruleUnaryMinus, position2601)
 			}
 			return true
-		l1849:
-			position, tokenIndex, depth = position1849, tokenIndex1849, depth1849
+		l2600:
+			position, tokenIndex, depth = position2600, tokenIndex2600, depth2600
 			return false
 		},
-		/* 163 Identifier <- <(<ident> Action132)> */
+		/* 207 Identifier <- <(<ident> Action169)> */
 		func() bool {
-			position1852, tokenIndex1852, depth1852 := position, tokenIndex, depth
+			position2603, tokenIndex2603, depth2603 := position, tokenIndex, depth
 			{
-				position1853 := position
+				position2604 := position
 				depth++
 				{
-					position1854 := position
+					position2605 := position
 					depth++
 					if !_rules[ruleident]() {
-						goto l1852
+						goto l2603
 					}
 					depth--
-					add(rulePegText, position1854)
+					add(rulePegText, position2605)
 				}
-				if !_rules[ruleAction132]() {
-					goto l1852
+				if !_rules[ruleAction169]() {
+					goto l2603
 				}
 				depth--
-				add(ruleIdentifier, position1853)
+				add(ruleIdentifier, position2604)
 			}
 			return true
-		l1852:
-			position, tokenIndex, depth = position1852, tokenIndex1852, depth1852
+		l2603:
+			position, tokenIndex, depth = position2603, tokenIndex2603, depth2603
 			return false
 		},
-		/* 164 TargetIdentifier <- <(<('*' / jsonSetPath)> Action133)> */
+		/* 208 TargetIdentifier <- <(<('*' / jsonSetPath)> Action170)> */
 		func() bool {
-			position1855, tokenIndex1855, depth1855 := position, tokenIndex, depth
+			position2606, tokenIndex2606, depth2606 := position, tokenIndex, depth
 			{
-				position1856 := position
+				position2607 := position
 				depth++
 				{
-					position1857 := position
+					position2608 := position
 					depth++
 					{
-						position1858, tokenIndex1858, depth1858 := position, tokenIndex, depth
+						position2609, tokenIndex2609, depth2609 := position, tokenIndex, depth
 						if buffer[position] != rune('*') {
-							goto l1859
+							goto l2610
 						}
 						position++
-						goto l1858
-					l1859:
-						position, tokenIndex, depth = position1858, tokenIndex1858, depth1858
+						goto l2609
+					l2610:
+						position, tokenIndex, depth = position2609, tokenIndex2609, depth2609
 						if !_rules[rulejsonSetPath]() {
-							goto l1855
+							goto l2606
 						}
 					}
-				l1858:
+				l2609:
 					depth--
-					add(rulePegText, position1857)
+					add(rulePegText, position2608)
 				}
-				if !_rules[ruleAction133]() {
-					goto l1855
+				if !_rules[ruleAction170]() {
+					goto l2606
 				}
 				depth--
-				add(ruleTargetIdentifier, position1856)
+				add(ruleTargetIdentifier, position2607)
 			}
 			return true
-		l1855:
-			position, tokenIndex, depth = position1855, tokenIndex1855, depth1855
+		l2606:
+			position, tokenIndex, depth = position2606, tokenIndex2606, depth2606
 			return false
 		},
-		/* 165 ident <- <(([a-z] / [A-Z]) ([a-z] / [A-Z] / [0-9] / '_')*)> */
+		/* 209 ident <- <(([a-z] / [A-Z]) ([a-z] / [A-Z] / [0-9] / '_')*)> */
 		func() bool {
-			position1860, tokenIndex1860, depth1860 := position, tokenIndex, depth
+			position2611, tokenIndex2611, depth2611 := position, tokenIndex, depth
 			{
-				position1861 := position
+				position2612 := position
 				depth++
 				{
-					position1862, tokenIndex1862, depth1862 := position, tokenIndex, depth
+					position2613, tokenIndex2613, depth2613 := position, tokenIndex, depth
 					if c := buffer[position]; c < rune('a') || c > rune('z') {
-						goto l1863
+						goto l2614
 					}
 					position++
-					goto l1862
-				l1863:
-					position, tokenIndex, depth = position1862, tokenIndex1862, depth1862
+					goto l2613
+				l2614:
+					position, tokenIndex, depth = position2613, tokenIndex2613, depth2613
 					if c := buffer[position]; c < rune('A') || c > rune('Z') {
-						goto l1860
+						goto l2611
 					}
 					position++
 				}
-			l1862:
-			l1864:
+			l2613:
+			l2615:
 				{
-					position1865, tokenIndex1865, depth1865 := position, tokenIndex, depth
+					position2616, tokenIndex2616, depth2616 := position, tokenIndex, depth
 					{
-						position1866, tokenIndex1866, depth1866 := position, tokenIndex, depth
+						position2617, tokenIndex2617, depth2617 := position, tokenIndex, depth
 						if c := buffer[position]; c < rune('a') || c > rune('z') {
-							goto l1867
+							goto l2618
 						}
 						position++
-						goto l1866
-					l1867:
-						position, tokenIndex, depth = position1866, tokenIndex1866, depth1866
+						goto l2617
+					l2618:
+						position, tokenIndex, depth = position2617, tokenIndex2617, depth2617
 						if c := buffer[position]; c < rune('A') || c > rune('Z') {
-							goto l1868
+							goto l2619
 						}
 						position++
-						goto l1866
-					l1868:
-						position, tokenIndex, depth = position1866, tokenIndex1866, depth1866
+						goto l2617
+					l2619:
+						position, tokenIndex, depth = position2617, tokenIndex2617, depth2617
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1869
+							goto l2620
 						}
 						position++
-						goto l1866
-					l1869:
-						position, tokenIndex, depth = position1866, tokenIndex1866, depth1866
+						goto l2617
+					l2620:
+						position, tokenIndex, depth = position2617, tokenIndex2617, depth2617
 						if buffer[position] != rune('_') {
-							goto l1865
+							goto l2616
 						}
 						position++
 					}
-				l1866:
-					goto l1864
-				l1865:
-					position, tokenIndex, depth = position1865, tokenIndex1865, depth1865
+				l2617:
+					goto l2615
+				l2616:
+					position, tokenIndex, depth = position2616, tokenIndex2616, depth2616
 				}
 				depth--
-				add(ruleident, position1861)
+				add(ruleident, position2612)
 			}
 			return true
-		l1860:
-			position, tokenIndex, depth = position1860, tokenIndex1860, depth1860
+		l2611:
+			position, tokenIndex, depth = position2611, tokenIndex2611, depth2611
 			return false
 		},
-		/* 166 jsonGetPath <- <(jsonPathHead jsonGetPathNonHead*)> */
+		/* 210 jsonGetPath <- <(jsonPathHead jsonGetPathNonHead*)> */
 		func() bool {
-			position1870, tokenIndex1870, depth1870 := position, tokenIndex, depth
+			position2621, tokenIndex2621, depth2621 := position, tokenIndex, depth
 			{
-				position1871 := position
+				position2622 := position
 				depth++
 				if !_rules[rulejsonPathHead]() {
-					goto l1870
+					goto l2621
 				}
-			l1872:
+			l2623:
 				{
-					position1873, tokenIndex1873, depth1873 := position, tokenIndex, depth
+					position2624, tokenIndex2624, depth2624 := position, tokenIndex, depth
 					if !_rules[rulejsonGetPathNonHead]() {
-						goto l1873
+						goto l2624
 					}
-					goto l1872
-				l1873:
-					position, tokenIndex, depth = position1873, tokenIndex1873, depth1873
+					goto l2623
+				l2624:
+					position, tokenIndex, depth = position2624, tokenIndex2624, depth2624
 				}
 				depth--
-				add(rulejsonGetPath, position1871)
+				add(rulejsonGetPath, position2622)
 			}
 			return true
-		l1870:
-			position, tokenIndex, depth = position1870, tokenIndex1870, depth1870
+		l2621:
+			position, tokenIndex, depth = position2621, tokenIndex2621, depth2621
 			return false
 		},
-		/* 167 jsonSetPath <- <(jsonPathHead jsonSetPathNonHead*)> */
+		/* 211 jsonSetPath <- <(jsonPathHead jsonSetPathNonHead*)> */
 		func() bool {
-			position1874, tokenIndex1874, depth1874 := position, tokenIndex, depth
+			position2625, tokenIndex2625, depth2625 := position, tokenIndex, depth
 			{
-				position1875 := position
+				position2626 := position
 				depth++
 				if !_rules[rulejsonPathHead]() {
-					goto l1874
+					goto l2625
 				}
-			l1876:
+			l2627:
 				{
-					position1877, tokenIndex1877, depth1877 := position, tokenIndex, depth
+					position2628, tokenIndex2628, depth2628 := position, tokenIndex, depth
 					if !_rules[rulejsonSetPathNonHead]() {
-						goto l1877
+						goto l2628
 					}
-					goto l1876
-				l1877:
-					position, tokenIndex, depth = position1877, tokenIndex1877, depth1877
+					goto l2627
+				l2628:
+					position, tokenIndex, depth = position2628, tokenIndex2628, depth2628
 				}
 				depth--
-				add(rulejsonSetPath, position1875)
+				add(rulejsonSetPath, position2626)
 			}
 			return true
-		l1874:
-			position, tokenIndex, depth = position1874, tokenIndex1874, depth1874
+		l2625:
+			position, tokenIndex, depth = position2625, tokenIndex2625, depth2625
 			return false
 		},
-		/* 168 jsonPathHead <- <(jsonMapAccessString / jsonMapAccessBracket)> */
+		/* 212 jsonPathHead <- <(jsonMapAccessString / jsonMapAccessBracket)> */
 		func() bool {
-			position1878, tokenIndex1878, depth1878 := position, tokenIndex, depth
+			position2629, tokenIndex2629, depth2629 := position, tokenIndex, depth
 			{
-				position1879 := position
+				position2630 := position
 				depth++
 				{
-					position1880, tokenIndex1880, depth1880 := position, tokenIndex, depth
+					position2631, tokenIndex2631, depth2631 := position, tokenIndex, depth
 					if !_rules[rulejsonMapAccessString]() {
-						goto l1881
+						goto l2632
 					}
-					goto l1880
-				l1881:
-					position, tokenIndex, depth = position1880, tokenIndex1880, depth1880
+					goto l2631
+				l2632:
+					position, tokenIndex, depth = position2631, tokenIndex2631, depth2631
 					if !_rules[rulejsonMapAccessBracket]() {
-						goto l1878
+						goto l2629
 					}
 				}
-			l1880:
+			l2631:
 				depth--
-				add(rulejsonPathHead, position1879)
+				add(rulejsonPathHead, position2630)
 			}
 			return true
-		l1878:
-			position, tokenIndex, depth = position1878, tokenIndex1878, depth1878
+		l2629:
+			position, tokenIndex, depth = position2629, tokenIndex2629, depth2629
 			return false
 		},
-		/* 169 jsonGetPathNonHead <- <(jsonMapMultipleLevel / jsonMapSingleLevel / jsonArrayFullSlice / jsonArrayPartialSlice / jsonArraySlice / jsonArrayAccess)> */
+		/* 213 jsonGetPathNonHead <- <(jsonMapMultipleLevel / jsonMapSingleLevel / jsonArrayFullSlice / jsonArrayPartialSlice / jsonArraySlice / jsonArrayAccess)> */
 		func() bool {
-			position1882, tokenIndex1882, depth1882 := position, tokenIndex, depth
+			position2633, tokenIndex2633, depth2633 := position, tokenIndex, depth
 			{
-				position1883 := position
+				position2634 := position
 				depth++
 				{
-					position1884, tokenIndex1884, depth1884 := position, tokenIndex, depth
+					position2635, tokenIndex2635, depth2635 := position, tokenIndex, depth
 					if !_rules[rulejsonMapMultipleLevel]() {
-						goto l1885
+						goto l2636
 					}
-					goto l1884
-				l1885:
-					position, tokenIndex, depth = position1884, tokenIndex1884, depth1884
+					goto l2635
+				l2636:
+					position, tokenIndex, depth = position2635, tokenIndex2635, depth2635
 					if !_rules[rulejsonMapSingleLevel]() {
-						goto l1886
+						goto l2637
 					}
-					goto l1884
-				l1886:
-					position, tokenIndex, depth = position1884, tokenIndex1884, depth1884
+					goto l2635
+				l2637:
+					position, tokenIndex, depth = position2635, tokenIndex2635, depth2635
 					if !_rules[rulejsonArrayFullSlice]() {
-						goto l1887
+						goto l2638
 					}
-					goto l1884
-				l1887:
-					position, tokenIndex, depth = position1884, tokenIndex1884, depth1884
+					goto l2635
+				l2638:
+					position, tokenIndex, depth = position2635, tokenIndex2635, depth2635
 					if !_rules[rulejsonArrayPartialSlice]() {
-						goto l1888
+						goto l2639
 					}
-					goto l1884
-				l1888:
-					position, tokenIndex, depth = position1884, tokenIndex1884, depth1884
+					goto l2635
+				l2639:
+					position, tokenIndex, depth = position2635, tokenIndex2635, depth2635
 					if !_rules[rulejsonArraySlice]() {
-						goto l1889
+						goto l2640
 					}
-					goto l1884
-				l1889:
-					position, tokenIndex, depth = position1884, tokenIndex1884, depth1884
+					goto l2635
+				l2640:
+					position, tokenIndex, depth = position2635, tokenIndex2635, depth2635
 					if !_rules[rulejsonArrayAccess]() {
-						goto l1882
+						goto l2633
 					}
 				}
-			l1884:
+			l2635:
 				depth--
-				add(rulejsonGetPathNonHead, position1883)
+				add(rulejsonGetPathNonHead, position2634)
 			}
 			return true
-		l1882:
-			position, tokenIndex, depth = position1882, tokenIndex1882, depth1882
+		l2633:
+			position, tokenIndex, depth = position2633, tokenIndex2633, depth2633
 			return false
 		},
-		/* 170 jsonSetPathNonHead <- <(jsonMapSingleLevel / jsonNonNegativeArrayAccess)> */
+		/* 214 jsonSetPathNonHead <- <(jsonMapSingleLevel / jsonNonNegativeArrayAccess)> */
 		func() bool {
-			position1890, tokenIndex1890, depth1890 := position, tokenIndex, depth
+			position2641, tokenIndex2641, depth2641 := position, tokenIndex, depth
 			{
-				position1891 := position
+				position2642 := position
 				depth++
 				{
-					position1892, tokenIndex1892, depth1892 := position, tokenIndex, depth
+					position2643, tokenIndex2643, depth2643 := position, tokenIndex, depth
 					if !_rules[rulejsonMapSingleLevel]() {
-						goto l1893
+						goto l2644
 					}
-					goto l1892
-				l1893:
-					position, tokenIndex, depth = position1892, tokenIndex1892, depth1892
+					goto l2643
+				l2644:
+					position, tokenIndex, depth = position2643, tokenIndex2643, depth2643
 					if !_rules[rulejsonNonNegativeArrayAccess]() {
-						goto l1890
+						goto l2641
 					}
 				}
-			l1892:
+			l2643:
 				depth--
-				add(rulejsonSetPathNonHead, position1891)
+				add(rulejsonSetPathNonHead, position2642)
 			}
 			return true
-		l1890:
-			position, tokenIndex, depth = position1890, tokenIndex1890, depth1890
+		l2641:
+			position, tokenIndex, depth = position2641, tokenIndex2641, depth2641
 			return false
 		},
-		/* 171 jsonMapSingleLevel <- <(('.' jsonMapAccessString) / jsonMapAccessBracket)> */
+		/* 215 jsonMapSingleLevel <- <(('.' jsonMapAccessString) / jsonMapAccessBracket)> */
 		func() bool {
-			position1894, tokenIndex1894, depth1894 := position, tokenIndex, depth
+			position2645, tokenIndex2645, depth2645 := position, tokenIndex, depth
 			{
-				position1895 := position
+				position2646 := position
 				depth++
 				{
-					position1896, tokenIndex1896, depth1896 := position, tokenIndex, depth
+					position2647, tokenIndex2647, depth2647 := position, tokenIndex, depth
 					if buffer[position] != rune('.') {
-						goto l1897
+						goto l2648
 					}
 					position++
 					if !_rules[rulejsonMapAccessString]() {
-						goto l1897
+						goto l2648
 					}
-					goto l1896
-				l1897:
-					position, tokenIndex, depth = position1896, tokenIndex1896, depth1896
+					goto l2647
+				l2648:
+					position, tokenIndex, depth = position2647, tokenIndex2647, depth2647
 					if !_rules[rulejsonMapAccessBracket]() {
-						goto l1894
+						goto l2645
 					}
 				}
-			l1896:
+			l2647:
 				depth--
-				add(rulejsonMapSingleLevel, position1895)
+				add(rulejsonMapSingleLevel, position2646)
 			}
 			return true
-		l1894:
-			position, tokenIndex, depth = position1894, tokenIndex1894, depth1894
+		l2645:
+			position, tokenIndex, depth = position2645, tokenIndex2645, depth2645
 			return false
 		},
-		/* 172 jsonMapMultipleLevel <- <('.' '.' (jsonMapAccessString / jsonMapAccessBracket))> */
+		/* 216 jsonMapMultipleLevel <- <('.' '.' (jsonMapAccessString / jsonMapAccessBracket))> */
 		func() bool {
-			position1898, tokenIndex1898, depth1898 := position, tokenIndex, depth
+			position2649, tokenIndex2649, depth2649 := position, tokenIndex, depth
 			{
-				position1899 := position
+				position2650 := position
 				depth++
 				if buffer[position] != rune('.') {
-					goto l1898
+					goto l2649
 				}
 				position++
 				if buffer[position] != rune('.') {
-					goto l1898
+					goto l2649
 				}
 				position++
 				{
-					position1900, tokenIndex1900, depth1900 := position, tokenIndex, depth
+					position2651, tokenIndex2651, depth2651 := position, tokenIndex, depth
 					if !_rules[rulejsonMapAccessString]() {
-						goto l1901
+						goto l2652
 					}
-					goto l1900
-				l1901:
-					position, tokenIndex, depth = position1900, tokenIndex1900, depth1900
+					goto l2651
+				l2652:
+					position, tokenIndex, depth = position2651, tokenIndex2651, depth2651
 					if !_rules[rulejsonMapAccessBracket]() {
-						goto l1898
+						goto l2649
 					}
 				}
-			l1900:
+			l2651:
 				depth--
-				add(rulejsonMapMultipleLevel, position1899)
+				add(rulejsonMapMultipleLevel, position2650)
 			}
 			return true
-		l1898:
-			position, tokenIndex, depth = position1898, tokenIndex1898, depth1898
+		l2649:
+			position, tokenIndex, depth = position2649, tokenIndex2649, depth2649
 			return false
 		},
-		/* 173 jsonMapAccessString <- <<(([a-z] / [A-Z]) ([a-z] / [A-Z] / [0-9] / '_')*)>> */
+		/* 217 jsonMapAccessString <- <<(([a-z] / [A-Z]) ([a-z] / [A-Z] / [0-9] / '_')*)>> */
 		func() bool {
-			position1902, tokenIndex1902, depth1902 := position, tokenIndex, depth
+			position2653, tokenIndex2653, depth2653 := position, tokenIndex, depth
 			{
-				position1903 := position
+				position2654 := position
 				depth++
 				{
-					position1904 := position
+					position2655 := position
 					depth++
 					{
-						position1905, tokenIndex1905, depth1905 := position, tokenIndex, depth
+						position2656, tokenIndex2656, depth2656 := position, tokenIndex, depth
 						if c := buffer[position]; c < rune('a') || c > rune('z') {
-							goto l1906
+							goto l2657
 						}
 						position++
-						goto l1905
-					l1906:
-						position, tokenIndex, depth = position1905, tokenIndex1905, depth1905
+						goto l2656
+					l2657:
+						position, tokenIndex, depth = position2656, tokenIndex2656, depth2656
 						if c := buffer[position]; c < rune('A') || c > rune('Z') {
-							goto l1902
+							goto l2653
 						}
 						position++
 					}
-				l1905:
-				l1907:
+				l2656:
+				l2658:
 					{
-						position1908, tokenIndex1908, depth1908 := position, tokenIndex, depth
+						position2659, tokenIndex2659, depth2659 := position, tokenIndex, depth
 						{
-							position1909, tokenIndex1909, depth1909 := position, tokenIndex, depth
+							position2660, tokenIndex2660, depth2660 := position, tokenIndex, depth
 							if c := buffer[position]; c < rune('a') || c > rune('z') {
-								goto l1910
+								goto l2661
 							}
 							position++
-							goto l1909
-						l1910:
-							position, tokenIndex, depth = position1909, tokenIndex1909, depth1909
+							goto l2660
+						l2661:
+							position, tokenIndex, depth = position2660, tokenIndex2660, depth2660
 							if c := buffer[position]; c < rune('A') || c > rune('Z') {
-								goto l1911
+								goto l2662
 							}
 							position++
-							goto l1909
-						l1911:
-							position, tokenIndex, depth = position1909, tokenIndex1909, depth1909
+							goto l2660
+						l2662:
+							position, tokenIndex, depth = position2660, tokenIndex2660, depth2660
 							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l1912
+								goto l2663
 							}
 							position++
-							goto l1909
-						l1912:
-							position, tokenIndex, depth = position1909, tokenIndex1909, depth1909
+							goto l2660
+						l2663:
+							position, tokenIndex, depth = position2660, tokenIndex2660, depth2660
 							if buffer[position] != rune('_') {
-								goto l1908
+								goto l2659
 							}
 							position++
 						}
-					l1909:
-						goto l1907
-					l1908:
-						position, tokenIndex, depth = position1908, tokenIndex1908, depth1908
+					l2660:
+						goto l2658
+					l2659:
+						position, tokenIndex, depth = position2659, tokenIndex2659, depth2659
 					}
 					depth--
-					add(rulePegText, position1904)
+					add(rulePegText, position2655)
 				}
 				depth--
-				add(rulejsonMapAccessString, position1903)
+				add(rulejsonMapAccessString, position2654)
 			}
 			return true
-		l1902:
-			position, tokenIndex, depth = position1902, tokenIndex1902, depth1902
+		l2653:
+			position, tokenIndex, depth = position2653, tokenIndex2653, depth2653
 			return false
 		},
-		/* 174 jsonMapAccessBracket <- <('[' doubleQuotedString ']')> */
+		/* 218 jsonMapAccessBracket <- <('[' doubleQuotedString ']')> */
 		func() bool {
-			position1913, tokenIndex1913, depth1913 := position, tokenIndex, depth
+			position2664, tokenIndex2664, depth2664 := position, tokenIndex, depth
 			{
-				position1914 := position
+				position2665 := position
 				depth++
 				if buffer[position] != rune('[') {
-					goto l1913
+					goto l2664
 				}
 				position++
 				if !_rules[ruledoubleQuotedString]() {
-					goto l1913
+					goto l2664
 				}
 				if buffer[position] != rune(']') {
-					goto l1913
+					goto l2664
 				}
 				position++
 				depth--
-				add(rulejsonMapAccessBracket, position1914)
+				add(rulejsonMapAccessBracket, position2665)
 			}
 			return true
-		l1913:
-			position, tokenIndex, depth = position1913, tokenIndex1913, depth1913
+		l2664:
+			position, tokenIndex, depth = position2664, tokenIndex2664, depth2664
 			return false
 		},
-		/* 175 doubleQuotedString <- <('"' <(('"' '"') / (!'"' .))*> '"')> */
+		/* 219 doubleQuotedString <- <('"' <(('"' '"') / (!'"' .))*> '"')> */
 		func() bool {
-			position1915, tokenIndex1915, depth1915 := position, tokenIndex, depth
+			position2666, tokenIndex2666, depth2666 := position, tokenIndex, depth
 			{
-				position1916 := position
+				position2667 := position
 				depth++
 				if buffer[position] != rune('"') {
-					goto l1915
+					goto l2666
 				}
 				position++
 				{
-					position1917 := position
+					position2668 := position
 					depth++
-				l1918:
+				l2669:
 					{
-						position1919, tokenIndex1919, depth1919 := position, tokenIndex, depth
+						position2670, tokenIndex2670, depth2670 := position, tokenIndex, depth
 						{
-							position1920, tokenIndex1920, depth1920 := position, tokenIndex, depth
+							position2671, tokenIndex2671, depth2671 := position, tokenIndex, depth
 							if buffer[position] != rune('"') {
-								goto l1921
+								goto l2672
 							}
 							position++
 							if buffer[position] != rune('"') {
-								goto l1921
+								goto l2672
 							}
 							position++
-							goto l1920
-						l1921:
-							position, tokenIndex, depth = position1920, tokenIndex1920, depth1920
+							goto l2671
+						l2672:
+							position, tokenIndex, depth = position2671, tokenIndex2671, depth2671
 							{
-								position1922, tokenIndex1922, depth1922 := position, tokenIndex, depth
+								position2673, tokenIndex2673, depth2673 := position, tokenIndex, depth
 								if buffer[position] != rune('"') {
-									goto l1922
+									goto l2673
 								}
 								position++
-								goto l1919
-							l1922:
-								position, tokenIndex, depth = position1922, tokenIndex1922, depth1922
+								goto l2670
+							l2673:
+								position, tokenIndex, depth = position2673, tokenIndex2673, depth2673
 							}
 							if !matchDot() {
-								goto l1919
+								goto l2670
 							}
 						}
-					l1920:
-						goto l1918
-					l1919:
-						position, tokenIndex, depth = position1919, tokenIndex1919, depth1919
+					l2671:
+						goto l2669
+					l2670:
+						position, tokenIndex, depth = position2670, tokenIndex2670, depth2670
 					}
 					depth--
-					add(rulePegText, position1917)
+					add(rulePegText, position2668)
 				}
 				if buffer[position] != rune('"') {
-					goto l1915
+					goto l2666
 				}
 				position++
 				depth--
-				add(ruledoubleQuotedString, position1916)
+				add(ruledoubleQuotedString, position2667)
 			}
 			return true
-		l1915:
-			position, tokenIndex, depth = position1915, tokenIndex1915, depth1915
+		l2666:
+			position, tokenIndex, depth = position2666, tokenIndex2666, depth2666
 			return false
 		},
-		/* 176 jsonArrayAccess <- <('[' <('-'? [0-9]+)> ']')> */
+		/* 220 jsonArrayAccess <- <('[' <('-'? [0-9]+)> ']')> */
 		func() bool {
-			position1923, tokenIndex1923, depth1923 := position, tokenIndex, depth
+			position2674, tokenIndex2674, depth2674 := position, tokenIndex, depth
 			{
-				position1924 := position
+				position2675 := position
 				depth++
 				if buffer[position] != rune('[') {
-					goto l1923
+					goto l2674
 				}
 				position++
 				{
-					position1925 := position
+					position2676 := position
 					depth++
 					{
-						position1926, tokenIndex1926, depth1926 := position, tokenIndex, depth
+						position2677, tokenIndex2677, depth2677 := position, tokenIndex, depth
 						if buffer[position] != rune('-') {
-							goto l1926
+							goto l2677
 						}
 						position++
-						goto l1927
-					l1926:
-						position, tokenIndex, depth = position1926, tokenIndex1926, depth1926
+						goto l2678
+					l2677:
+						position, tokenIndex, depth = position2677, tokenIndex2677, depth2677
 					}
-				l1927:
+				l2678:
 					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l1923
+						goto l2674
 					}
 					position++
-				l1928:
+				l2679:
 					{
-						position1929, tokenIndex1929, depth1929 := position, tokenIndex, depth
+						position2680, tokenIndex2680, depth2680 := position, tokenIndex, depth
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1929
+							goto l2680
 						}
 						position++
-						goto l1928
-					l1929:
-						position, tokenIndex, depth = position1929, tokenIndex1929, depth1929
+						goto l2679
+					l2680:
+						position, tokenIndex, depth = position2680, tokenIndex2680, depth2680
 					}
 					depth--
-					add(rulePegText, position1925)
+					add(rulePegText, position2676)
 				}
 				if buffer[position] != rune(']') {
-					goto l1923
+					goto l2674
 				}
 				position++
 				depth--
-				add(rulejsonArrayAccess, position1924)
+				add(rulejsonArrayAccess, position2675)
 			}
 			return true
-		l1923:
-			position, tokenIndex, depth = position1923, tokenIndex1923, depth1923
+		l2674:
+			position, tokenIndex, depth = position2674, tokenIndex2674, depth2674
 			return false
 		},
-		/* 177 jsonNonNegativeArrayAccess <- <('[' <[0-9]+> ']')> */
+		/* 221 jsonNonNegativeArrayAccess <- <('[' <[0-9]+> ']')> */
 		func() bool {
-			position1930, tokenIndex1930, depth1930 := position, tokenIndex, depth
+			position2681, tokenIndex2681, depth2681 := position, tokenIndex, depth
 			{
-				position1931 := position
+				position2682 := position
 				depth++
 				if buffer[position] != rune('[') {
-					goto l1930
+					goto l2681
 				}
 				position++
 				{
-					position1932 := position
+					position2683 := position
 					depth++
 					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l1930
+						goto l2681
 					}
 					position++
-				l1933:
+				l2684:
 					{
-						position1934, tokenIndex1934, depth1934 := position, tokenIndex, depth
+						position2685, tokenIndex2685, depth2685 := position, tokenIndex, depth
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1934
+							goto l2685
 						}
 						position++
-						goto l1933
-					l1934:
-						position, tokenIndex, depth = position1934, tokenIndex1934, depth1934
+						goto l2684
+					l2685:
+						position, tokenIndex, depth = position2685, tokenIndex2685, depth2685
 					}
 					depth--
-					add(rulePegText, position1932)
+					add(rulePegText, position2683)
 				}
 				if buffer[position] != rune(']') {
-					goto l1930
+					goto l2681
 				}
 				position++
 				depth--
-				add(rulejsonNonNegativeArrayAccess, position1931)
+				add(rulejsonNonNegativeArrayAccess, position2682)
 			}
 			return true
-		l1930:
-			position, tokenIndex, depth = position1930, tokenIndex1930, depth1930
+		l2681:
+			position, tokenIndex, depth = position2681, tokenIndex2681, depth2681
 			return false
 		},
-		/* 178 jsonArraySlice <- <('[' <('-'? [0-9]+ ':' '-'? [0-9]+ (':' '-'? [0-9]+)?)> ']')> */
+		/* 222 jsonArraySlice <- <('[' <('-'? [0-9]+ ':' '-'? [0-9]+ (':' '-'? [0-9]+)?)> ']')> */
 		func() bool {
-			position1935, tokenIndex1935, depth1935 := position, tokenIndex, depth
+			position2686, tokenIndex2686, depth2686 := position, tokenIndex, depth
 			{
-				position1936 := position
+				position2687 := position
 				depth++
 				if buffer[position] != rune('[') {
-					goto l1935
+					goto l2686
 				}
 				position++
 				{
-					position1937 := position
+					position2688 := position
 					depth++
 					{
-						position1938, tokenIndex1938, depth1938 := position, tokenIndex, depth
+						position2689, tokenIndex2689, depth2689 := position, tokenIndex, depth
 						if buffer[position] != rune('-') {
-							goto l1938
+							goto l2689
 						}
 						position++
-						goto l1939
-					l1938:
-						position, tokenIndex, depth = position1938, tokenIndex1938, depth1938
+						goto l2690
+					l2689:
+						position, tokenIndex, depth = position2689, tokenIndex2689, depth2689
 					}
-				l1939:
+				l2690:
 					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l1935
+						goto l2686
 					}
 					position++
-				l1940:
+				l2691:
 					{
-						position1941, tokenIndex1941, depth1941 := position, tokenIndex, depth
+						position2692, tokenIndex2692, depth2692 := position, tokenIndex, depth
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1941
+							goto l2692
 						}
 						position++
-						goto l1940
-					l1941:
-						position, tokenIndex, depth = position1941, tokenIndex1941, depth1941
+						goto l2691
+					l2692:
+						position, tokenIndex, depth = position2692, tokenIndex2692, depth2692
 					}
 					if buffer[position] != rune(':') {
-						goto l1935
+						goto l2686
 					}
 					position++
 					{
-						position1942, tokenIndex1942, depth1942 := position, tokenIndex, depth
+						position2693, tokenIndex2693, depth2693 := position, tokenIndex, depth
 						if buffer[position] != rune('-') {
-							goto l1942
+							goto l2693
 						}
 						position++
-						goto l1943
-					l1942:
-						position, tokenIndex, depth = position1942, tokenIndex1942, depth1942
+						goto l2694
+					l2693:
+						position, tokenIndex, depth = position2693, tokenIndex2693, depth2693
 					}
-				l1943:
+				l2694:
 					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l1935
+						goto l2686
 					}
 					position++
-				l1944:
+				l2695:
 					{
-						position1945, tokenIndex1945, depth1945 := position, tokenIndex, depth
+						position2696, tokenIndex2696, depth2696 := position, tokenIndex, depth
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1945
+							goto l2696
 						}
 						position++
-						goto l1944
-					l1945:
-						position, tokenIndex, depth = position1945, tokenIndex1945, depth1945
+						goto l2695
+					l2696:
+						position, tokenIndex, depth = position2696, tokenIndex2696, depth2696
 					}
 					{
-						position1946, tokenIndex1946, depth1946 := position, tokenIndex, depth
+						position2697, tokenIndex2697, depth2697 := position, tokenIndex, depth
 						if buffer[position] != rune(':') {
-							goto l1946
+							goto l2697
 						}
 						position++
 						{
-							position1948, tokenIndex1948, depth1948 := position, tokenIndex, depth
+							position2699, tokenIndex2699, depth2699 := position, tokenIndex, depth
 							if buffer[position] != rune('-') {
-								goto l1948
+								goto l2699
 							}
 							position++
-							goto l1949
-						l1948:
-							position, tokenIndex, depth = position1948, tokenIndex1948, depth1948
+							goto l2700
+						l2699:
+							position, tokenIndex, depth = position2699, tokenIndex2699, depth2699
 						}
-					l1949:
+					l2700:
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1946
+							goto l2697
 						}
 						position++
-					l1950:
+					l2701:
 						{
-							position1951, tokenIndex1951, depth1951 := position, tokenIndex, depth
+							position2702, tokenIndex2702, depth2702 := position, tokenIndex, depth
 							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l1951
+								goto l2702
 							}
 							position++
-							goto l1950
-						l1951:
-							position, tokenIndex, depth = position1951, tokenIndex1951, depth1951
+							goto l2701
+						l2702:
+							position, tokenIndex, depth = position2702, tokenIndex2702, depth2702
 						}
-						goto l1947
-					l1946:
-						position, tokenIndex, depth = position1946, tokenIndex1946, depth1946
+						goto l2698
+					l2697:
+						position, tokenIndex, depth = position2697, tokenIndex2697, depth2697
 					}
-				l1947:
+				l2698:
 					depth--
-					add(rulePegText, position1937)
+					add(rulePegText, position2688)
 				}
 				if buffer[position] != rune(']') {
-					goto l1935
+					goto l2686
 				}
 				position++
 				depth--
-				add(rulejsonArraySlice, position1936)
+				add(rulejsonArraySlice, position2687)
 			}
 			return true
-		l1935:
-			position, tokenIndex, depth = position1935, tokenIndex1935, depth1935
+		l2686:
+			position, tokenIndex, depth = position2686, tokenIndex2686, depth2686
 			return false
 		},
-		/* 179 jsonArrayPartialSlice <- <('[' <((':' '-'? [0-9]+) / ('-'? [0-9]+ ':'))> ']')> */
+		/* 223 jsonArrayPartialSlice <- <('[' <((':' '-'? [0-9]+) / ('-'? [0-9]+ ':'))> ']')> */
 		func() bool {
-			position1952, tokenIndex1952, depth1952 := position, tokenIndex, depth
+			position2703, tokenIndex2703, depth2703 := position, tokenIndex, depth
 			{
-				position1953 := position
+				position2704 := position
 				depth++
 				if buffer[position] != rune('[') {
-					goto l1952
+					goto l2703
 				}
 				position++
 				{
-					position1954 := position
+					position2705 := position
 					depth++
 					{
-						position1955, tokenIndex1955, depth1955 := position, tokenIndex, depth
+						position2706, tokenIndex2706, depth2706 := position, tokenIndex, depth
 						if buffer[position] != rune(':') {
-							goto l1956
+							goto l2707
 						}
 						position++
 						{
-							position1957, tokenIndex1957, depth1957 := position, tokenIndex, depth
+							position2708, tokenIndex2708, depth2708 := position, tokenIndex, depth
 							if buffer[position] != rune('-') {
-								goto l1957
+								goto l2708
 							}
 							position++
-							goto l1958
-						l1957:
-							position, tokenIndex, depth = position1957, tokenIndex1957, depth1957
+							goto l2709
+						l2708:
+							position, tokenIndex, depth = position2708, tokenIndex2708, depth2708
 						}
-					l1958:
+					l2709:
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1956
+							goto l2707
 						}
 						position++
-					l1959:
+					l2710:
 						{
-							position1960, tokenIndex1960, depth1960 := position, tokenIndex, depth
+							position2711, tokenIndex2711, depth2711 := position, tokenIndex, depth
 							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l1960
+								goto l2711
 							}
 							position++
-							goto l1959
-						l1960:
-							position, tokenIndex, depth = position1960, tokenIndex1960, depth1960
+							goto l2710
+						l2711:
+							position, tokenIndex, depth = position2711, tokenIndex2711, depth2711
 						}
-						goto l1955
-					l1956:
-						position, tokenIndex, depth = position1955, tokenIndex1955, depth1955
+						goto l2706
+					l2707:
+						position, tokenIndex, depth = position2706, tokenIndex2706, depth2706
 						{
-							position1961, tokenIndex1961, depth1961 := position, tokenIndex, depth
+							position2712, tokenIndex2712, depth2712 := position, tokenIndex, depth
 							if buffer[position] != rune('-') {
-								goto l1961
+								goto l2712
 							}
 							position++
-							goto l1962
-						l1961:
-							position, tokenIndex, depth = position1961, tokenIndex1961, depth1961
+							goto l2713
+						l2712:
+							position, tokenIndex, depth = position2712, tokenIndex2712, depth2712
 						}
-					l1962:
+					l2713:
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1952
+							goto l2703
 						}
 						position++
-					l1963:
+					l2714:
 						{
-							position1964, tokenIndex1964, depth1964 := position, tokenIndex, depth
+							position2715, tokenIndex2715, depth2715 := position, tokenIndex, depth
 							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l1964
+								goto l2715
 							}
 							position++
-							goto l1963
-						l1964:
-							position, tokenIndex, depth = position1964, tokenIndex1964, depth1964
+							goto l2714
+						l2715:
+							position, tokenIndex, depth = position2715, tokenIndex2715, depth2715
 						}
 						if buffer[position] != rune(':') {
-							goto l1952
+							goto l2703
 						}
 						position++
 					}
-				l1955:
+				l2706:
 					depth--
-					add(rulePegText, position1954)
+					add(rulePegText, position2705)
 				}
 				if buffer[position] != rune(']') {
-					goto l1952
+					goto l2703
 				}
 				position++
 				depth--
-				add(rulejsonArrayPartialSlice, position1953)
+				add(rulejsonArrayPartialSlice, position2704)
 			}
 			return true
-		l1952:
-			position, tokenIndex, depth = position1952, tokenIndex1952, depth1952
+		l2703:
+			position, tokenIndex, depth = position2703, tokenIndex2703, depth2703
 			return false
 		},
-		/* 180 jsonArrayFullSlice <- <('[' ':' ']')> */
+		/* 224 jsonArrayFullSlice <- <('[' ':' ']')> */
 		func() bool {
-			position1965, tokenIndex1965, depth1965 := position, tokenIndex, depth
+			position2716, tokenIndex2716, depth2716 := position, tokenIndex, depth
 			{
-				position1966 := position
+				position2717 := position
 				depth++
 				if buffer[position] != rune('[') {
-					goto l1965
+					goto l2716
 				}
 				position++
 				if buffer[position] != rune(':') {
-					goto l1965
+					goto l2716
 				}
 				position++
 				if buffer[position] != rune(']') {
-					goto l1965
+					goto l2716
 				}
 				position++
 				depth--
-				add(rulejsonArrayFullSlice, position1966)
+				add(rulejsonArrayFullSlice, position2717)
 			}
 			return true
-		l1965:
-			position, tokenIndex, depth = position1965, tokenIndex1965, depth1965
+		l2716:
+			position, tokenIndex, depth = position2716, tokenIndex2716, depth2716
 			return false
 		},
-		/* 181 spElem <- <(' ' / '\t' / '\n' / '\r' / comment / finalComment)> */
+		/* 225 spElem <- <(' ' / '\t' / '\n' / '\r' / comment / finalComment)> */
 		func() bool {
-			position1967, tokenIndex1967, depth1967 := position, tokenIndex, depth
+			position2718, tokenIndex2718, depth2718 := position, tokenIndex, depth
 			{
-				position1968 := position
+				position2719 := position
 				depth++
 				{
-					position1969, tokenIndex1969, depth1969 := position, tokenIndex, depth
+					position2720, tokenIndex2720, depth2720 := position, tokenIndex, depth
 					if buffer[position] != rune(' ') {
-						goto l1970
+						goto l2721
 					}
 					position++
-					goto l1969
-				l1970:
-					position, tokenIndex, depth = position1969, tokenIndex1969, depth1969
+					goto l2720
+				l2721:
+					position, tokenIndex, depth = position2720, tokenIndex2720, depth2720
 					if buffer[position] != rune('\t') {
-						goto l1971
+						goto l2722
 					}
 					position++
-					goto l1969
-				l1971:
-					position, tokenIndex, depth = position1969, tokenIndex1969, depth1969
+					goto l2720
+				l2722:
+					position, tokenIndex, depth = position2720, tokenIndex2720, depth2720
 					if buffer[position] != rune('\n') {
-						goto l1972
+						goto l2723
 					}
 					position++
-					goto l1969
-				l1972:
-					position, tokenIndex, depth = position1969, tokenIndex1969, depth1969
+					goto l2720
+				l2723:
+					position, tokenIndex, depth = position2720, tokenIndex2720, depth2720
 					if buffer[position] != rune('\r') {
-						goto l1973
+						goto l2724
 					}
 					position++
-					goto l1969
-				l1973:
-					position, tokenIndex, depth = position1969, tokenIndex1969, depth1969
+					goto l2720
+				l2724:
+					position, tokenIndex, depth = position2720, tokenIndex2720, depth2720
 					if !_rules[rulecomment]() {
-						goto l1974
+						goto l2725
 					}
-					goto l1969
-				l1974:
-					position, tokenIndex, depth = position1969, tokenIndex1969, depth1969
+					goto l2720
+				l2725:
+					position, tokenIndex, depth = position2720, tokenIndex2720, depth2720
 					if !_rules[rulefinalComment]() {
-						goto l1967
+						goto l2718
 					}
 				}
-			l1969:
+			l2720:
 				depth--
-				add(rulespElem, position1968)
+				add(rulespElem, position2719)
 			}
 			return true
-		l1967:
-			position, tokenIndex, depth = position1967, tokenIndex1967, depth1967
+		l2718:
+			position, tokenIndex, depth = position2718, tokenIndex2718, depth2718
 			return false
 		},
-		/* 182 sp <- <spElem+> */
+		/* 226 sp <- <spElem+> */
 		func() bool {
-			position1975, tokenIndex1975, depth1975 := position, tokenIndex, depth
+			position2726, tokenIndex2726, depth2726 := position, tokenIndex, depth
 			{
-				position1976 := position
+				position2727 := position
 				depth++
 				if !_rules[rulespElem]() {
-					goto l1975
+					goto l2726
 				}
-			l1977:
+			l2728:
 				{
-					position1978, tokenIndex1978, depth1978 := position, tokenIndex, depth
+					position2729, tokenIndex2729, depth2729 := position, tokenIndex, depth
 					if !_rules[rulespElem]() {
-						goto l1978
+						goto l2729
 					}
-					goto l1977
-				l1978:
-					position, tokenIndex, depth = position1978, tokenIndex1978, depth1978
+					goto l2728
+				l2729:
+					position, tokenIndex, depth = position2729, tokenIndex2729, depth2729
 				}
 				depth--
-				add(rulesp, position1976)
+				add(rulesp, position2727)
 			}
 			return true
-		l1975:
-			position, tokenIndex, depth = position1975, tokenIndex1975, depth1975
+		l2726:
+			position, tokenIndex, depth = position2726, tokenIndex2726, depth2726
 			return false
 		},
-		/* 183 spOpt <- <spElem*> */
+		/* 227 spOpt <- <spElem*> */
 		func() bool {
 			{
-				position1980 := position
+				position2731 := position
 				depth++
-			l1981:
+			l2732:
 				{
-					position1982, tokenIndex1982, depth1982 := position, tokenIndex, depth
+					position2733, tokenIndex2733, depth2733 := position, tokenIndex, depth
 					if !_rules[rulespElem]() {
-						goto l1982
+						goto l2733
 					}
-					goto l1981
-				l1982:
-					position, tokenIndex, depth = position1982, tokenIndex1982, depth1982
+					goto l2732
+				l2733:
+					position, tokenIndex, depth = position2733, tokenIndex2733, depth2733
 				}
 				depth--
-				add(rulespOpt, position1980)
+				add(rulespOpt, position2731)
 			}
 			return true
 		},
-		/* 184 comment <- <('-' '-' (!('\r' / '\n') .)* ('\r' / '\n'))> */
+		/* 228 comment <- <('-' '-' (!('\r' / '\n') .)* ('\r' / '\n'))> */
 		func() bool {
-			position1983, tokenIndex1983, depth1983 := position, tokenIndex, depth
+			position2734, tokenIndex2734, depth2734 := position, tokenIndex, depth
 			{
-				position1984 := position
+				position2735 := position
 				depth++
 				if buffer[position] != rune('-') {
-					goto l1983
+					goto l2734
 				}
 				position++
 				if buffer[position] != rune('-') {
-					goto l1983
+					goto l2734
 				}
 				position++
-			l1985:
+			l2736:
 				{
-					position1986, tokenIndex1986, depth1986 := position, tokenIndex, depth
+					position2737, tokenIndex2737, depth2737 := position, tokenIndex, depth
 					{
-						position1987, tokenIndex1987, depth1987 := position, tokenIndex, depth
+						position2738, tokenIndex2738, depth2738 := position, tokenIndex, depth
 						{
-							position1988, tokenIndex1988, depth1988 := position, tokenIndex, depth
+							position2739, tokenIndex2739, depth2739 := position, tokenIndex, depth
 							if buffer[position] != rune('\r') {
-								goto l1989
+								goto l2740
 							}
 							position++
-							goto l1988
-						l1989:
-							position, tokenIndex, depth = position1988, tokenIndex1988, depth1988
+							goto l2739
+						l2740:
+							position, tokenIndex, depth = position2739, tokenIndex2739, depth2739
 							if buffer[position] != rune('\n') {
-								goto l1987
+								goto l2738
 							}
 							position++
 						}
-					l1988:
-						goto l1986
-					l1987:
-						position, tokenIndex, depth = position1987, tokenIndex1987, depth1987
+					l2739:
+						goto l2737
+					l2738:
+						position, tokenIndex, depth = position2738, tokenIndex2738, depth2738
 					}
 					if !matchDot() {
-						goto l1986
+						goto l2737
 					}
-					goto l1985
-				l1986:
-					position, tokenIndex, depth = position1986, tokenIndex1986, depth1986
+					goto l2736
+				l2737:
+					position, tokenIndex, depth = position2737, tokenIndex2737, depth2737
 				}
 				{
-					position1990, tokenIndex1990, depth1990 := position, tokenIndex, depth
+					position2741, tokenIndex2741, depth2741 := position, tokenIndex, depth
 					if buffer[position] != rune('\r') {
-						goto l1991
+						goto l2742
 					}
 					position++
-					goto l1990
-				l1991:
-					position, tokenIndex, depth = position1990, tokenIndex1990, depth1990
+					goto l2741
+				l2742:
+					position, tokenIndex, depth = position2741, tokenIndex2741, depth2741
 					if buffer[position] != rune('\n') {
-						goto l1983
+						goto l2734
 					}
 					position++
 				}
-			l1990:
+			l2741:
 				depth--
-				add(rulecomment, position1984)
+				add(rulecomment, position2735)
 			}
 			return true
-		l1983:
-			position, tokenIndex, depth = position1983, tokenIndex1983, depth1983
+		l2734:
+			position, tokenIndex, depth = position2734, tokenIndex2734, depth2734
 			return false
 		},
-		/* 185 finalComment <- <('-' '-' (!('\r' / '\n') .)* !.)> */
+		/* 229 finalComment <- <('-' '-' (!('\r' / '\n') .)* !.)> */
 		func() bool {
-			position1992, tokenIndex1992, depth1992 := position, tokenIndex, depth
+			position2743, tokenIndex2743, depth2743 := position, tokenIndex, depth
 			{
-				position1993 := position
+				position2744 := position
 				depth++
 				if buffer[position] != rune('-') {
-					goto l1992
+					goto l2743
 				}
 				position++
 				if buffer[position] != rune('-') {
-					goto l1992
+					goto l2743
 				}
 				position++
-			l1994:
+			l2745:
 				{
-					position1995, tokenIndex1995, depth1995 := position, tokenIndex, depth
+					position2746, tokenIndex2746, depth2746 := position, tokenIndex, depth
 					{
-						position1996, tokenIndex1996, depth1996 := position, tokenIndex, depth
+						position2747, tokenIndex2747, depth2747 := position, tokenIndex, depth
 						{
-							position1997, tokenIndex1997, depth1997 := position, tokenIndex, depth
+							position2748, tokenIndex2748, depth2748 := position, tokenIndex, depth
 							if buffer[position] != rune('\r') {
-								goto l1998
+								goto l2749
 							}
 							position++
-							goto l1997
-						l1998:
-							position, tokenIndex, depth = position1997, tokenIndex1997, depth1997
+							goto l2748
+						l2749:
+							position, tokenIndex, depth = position2748, tokenIndex2748, depth2748
 							if buffer[position] != rune('\n') {
-								goto l1996
+								goto l2747
 							}
 							position++
 						}
-					l1997:
-						goto l1995
-					l1996:
-						position, tokenIndex, depth = position1996, tokenIndex1996, depth1996
+					l2748:
+						goto l2746
+					l2747:
+						position, tokenIndex, depth = position2747, tokenIndex2747, depth2747
 					}
 					if !matchDot() {
-						goto l1995
+						goto l2746
 					}
-					goto l1994
-				l1995:
-					position, tokenIndex, depth = position1995, tokenIndex1995, depth1995
+					goto l2745
+				l2746:
+					position, tokenIndex, depth = position2746, tokenIndex2746, depth2746
 				}
 				{
-					position1999, tokenIndex1999, depth1999 := position, tokenIndex, depth
+					position2750, tokenIndex2750, depth2750 := position, tokenIndex, depth
 					if !matchDot() {
-						goto l1999
+						goto l2750
 					}
-					goto l1992
-				l1999:
-					position, tokenIndex, depth = position1999, tokenIndex1999, depth1999
+					goto l2743
+				l2750:
+					position, tokenIndex, depth = position2750, tokenIndex2750, depth2750
 				}
 				depth--
-				add(rulefinalComment, position1993)
+				add(rulefinalComment, position2744)
 			}
 			return true
-		l1992:
-			position, tokenIndex, depth = position1992, tokenIndex1992, depth1992
+		l2743:
+			position, tokenIndex, depth = position2743, tokenIndex2743, depth2743
 			return false
 		},
 		nil,
-		/* 188 Action0 <- <{
+		/* 232 Action0 <- <{
 		    p.IncludeTrailingWhitespace(begin, end)
 		}> */
 		func() bool {
@@ -17607,7 +23887,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 189 Action1 <- <{
+		/* 233 Action1 <- <{
 		    p.IncludeTrailingWhitespace(begin, end)
 		}> */
 		func() bool {
@@ -17616,7 +23896,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 190 Action2 <- <{
+		/* 234 Action2 <- <{
 		    p.AssembleSelect()
 		}> */
 		func() bool {
@@ -17625,8 +23905,8 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 191 Action3 <- <{
-		    p.AssembleSelectUnion(begin, end)
+		/* 235 Action3 <- <{
+		    p.AssembleWithSelect(begin, end)
 		}> */
 		func() bool {
 			{
@@ -17634,8 +23914,8 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 192 Action4 <- <{
-		    p.AssembleCreateStreamAsSelect()
+		/* 236 Action4 <- <{
+		    p.AssembleCommonTableExpression()
 		}> */
 		func() bool {
 			{
@@ -17643,8 +23923,8 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 193 Action5 <- <{
-		    p.AssembleCreateStreamAsSelectUnion()
+		/* 237 Action5 <- <{
+		    p.AssembleSelectUnion(begin, end)
 		}> */
 		func() bool {
 			{
@@ -17652,8 +23932,8 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 194 Action6 <- <{
-		    p.AssembleCreateSource()
+		/* 238 Action6 <- <{
+		    p.AssembleCreateStreamAsSelect()
 		}> */
 		func() bool {
 			{
@@ -17661,8 +23941,8 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 195 Action7 <- <{
-		    p.AssembleCreateSink()
+		/* 239 Action7 <- <{
+		    p.AssembleCreateOrReplaceStreamAsSelect()
 		}> */
 		func() bool {
 			{
@@ -17670,8 +23950,8 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 196 Action8 <- <{
-		    p.AssembleCreateState()
+		/* 240 Action8 <- <{
+		    p.AssembleCreateStreamAsSelectUnion()
 		}> */
 		func() bool {
 			{
@@ -17679,8 +23959,8 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 197 Action9 <- <{
-		    p.AssembleUpdateState()
+		/* 241 Action9 <- <{
+		    p.AssembleCreateSource()
 		}> */
 		func() bool {
 			{
@@ -17688,8 +23968,8 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 198 Action10 <- <{
-		    p.AssembleUpdateSource()
+		/* 242 Action10 <- <{
+		    p.AssembleCreateSink()
 		}> */
 		func() bool {
 			{
@@ -17697,8 +23977,8 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 199 Action11 <- <{
-		    p.AssembleUpdateSink()
+		/* 243 Action11 <- <{
+		    p.AssembleCreateState()
 		}> */
 		func() bool {
 			{
@@ -17706,8 +23986,8 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 200 Action12 <- <{
-		    p.AssembleInsertIntoFrom()
+		/* 244 Action12 <- <{
+		    p.AssembleUpdateState()
 		}> */
 		func() bool {
 			{
@@ -17715,8 +23995,8 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 201 Action13 <- <{
-		    p.AssemblePauseSource()
+		/* 245 Action13 <- <{
+		    p.AssembleUpdateSource()
 		}> */
 		func() bool {
 			{
@@ -17724,8 +24004,8 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 202 Action14 <- <{
-		    p.AssembleResumeSource()
+		/* 246 Action14 <- <{
+		    p.AssembleUpdateSink()
 		}> */
 		func() bool {
 			{
@@ -17733,8 +24013,8 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 203 Action15 <- <{
-		    p.AssembleRewindSource()
+		/* 247 Action15 <- <{
+		    p.AssembleInsertIntoFrom()
 		}> */
 		func() bool {
 			{
@@ -17742,8 +24022,8 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 204 Action16 <- <{
-		    p.AssembleDropSource()
+		/* 248 Action16 <- <{
+		    p.AssemblePauseSource()
 		}> */
 		func() bool {
 			{
@@ -17751,8 +24031,8 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 205 Action17 <- <{
-		    p.AssembleDropStream()
+		/* 249 Action17 <- <{
+		    p.AssembleResumeSource()
 		}> */
 		func() bool {
 			{
@@ -17760,8 +24040,8 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 206 Action18 <- <{
-		    p.AssembleDropSink()
+		/* 250 Action18 <- <{
+		    p.AssembleRewindSource()
 		}> */
 		func() bool {
 			{
@@ -17769,8 +24049,8 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 207 Action19 <- <{
-		    p.AssembleDropState()
+		/* 251 Action19 <- <{
+		    p.AssembleDropSource()
 		}> */
 		func() bool {
 			{
@@ -17778,8 +24058,8 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 208 Action20 <- <{
-		    p.AssembleLoadState()
+		/* 252 Action20 <- <{
+		    p.AssembleDropStream()
 		}> */
 		func() bool {
 			{
@@ -17787,8 +24067,8 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 209 Action21 <- <{
-		    p.AssembleLoadStateOrCreate()
+		/* 253 Action21 <- <{
+		    p.AssembleDropSink()
 		}> */
 		func() bool {
 			{
@@ -17796,8 +24076,8 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 210 Action22 <- <{
-		    p.AssembleSaveState()
+		/* 254 Action22 <- <{
+		    p.AssembleDropState()
 		}> */
 		func() bool {
 			{
@@ -17805,8 +24085,8 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 211 Action23 <- <{
-		    p.AssembleEval(begin, end)
+		/* 255 Action23 <- <{
+		    p.AssembleLoadState()
 		}> */
 		func() bool {
 			{
@@ -17814,8 +24094,8 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 212 Action24 <- <{
-		    p.AssembleEmitter()
+		/* 256 Action24 <- <{
+		    p.AssembleLoadStateOrCreate()
 		}> */
 		func() bool {
 			{
@@ -17823,8 +24103,8 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 213 Action25 <- <{
-		    p.AssembleEmitterOptions(begin, end)
+		/* 257 Action25 <- <{
+		    p.AssembleSaveState()
 		}> */
 		func() bool {
 			{
@@ -17832,8 +24112,8 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 214 Action26 <- <{
-		    p.AssembleEmitterLimit()
+		/* 258 Action26 <- <{
+		    p.AssembleEval(begin, end)
 		}> */
 		func() bool {
 			{
@@ -17841,8 +24121,8 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 215 Action27 <- <{
-		    p.AssembleEmitterSampling(CountBasedSampling, 1)
+		/* 259 Action27 <- <{
+		    p.AssembleEmitter()
 		}> */
 		func() bool {
 			{
@@ -17850,8 +24130,8 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 216 Action28 <- <{
-		    p.AssembleEmitterSampling(RandomizedSampling, 1)
+		/* 260 Action28 <- <{
+		    p.AssembleEmitterOptions(begin, end)
 		}> */
 		func() bool {
 			{
@@ -17859,8 +24139,8 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 217 Action29 <- <{
-		    p.AssembleEmitterSampling(TimeBasedSampling, 1)
+		/* 261 Action29 <- <{
+		    p.AssembleEmitterLimit()
 		}> */
 		func() bool {
 			{
@@ -17868,8 +24148,8 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 218 Action30 <- <{
-		    p.AssembleEmitterSampling(TimeBasedSampling, 0.001)
+		/* 262 Action30 <- <{
+		    p.AssembleEmitterSampling(CountBasedSampling, 1)
 		}> */
 		func() bool {
 			{
@@ -17877,8 +24157,8 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 219 Action31 <- <{
-		    p.AssembleProjections(begin, end)
+		/* 263 Action31 <- <{
+		    p.AssembleEmitterSampling(RandomizedSampling, 1)
 		}> */
 		func() bool {
 			{
@@ -17886,8 +24166,8 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 220 Action32 <- <{
-		    p.AssembleAlias()
+		/* 264 Action32 <- <{
+		    p.AssembleEmitterSampling(TimeBasedSampling, 1)
 		}> */
 		func() bool {
 			{
@@ -17895,937 +24175,1277 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 221 Action33 <- <{
+		/* 265 Action33 <- <{
+		    p.AssembleEmitterSampling(TimeBasedSampling, 0.001)
+		}> */
+		func() bool {
+			{
+				add(ruleAction33, position)
+			}
+			return true
+		},
+		/* 266 Action34 <- <{
+		    p.AssembleProjections(begin, end)
+		}> */
+		func() bool {
+			{
+				add(ruleAction34, position)
+			}
+			return true
+		},
+		/* 267 Action35 <- <{
+		    p.AssembleAlias()
+		}> */
+		func() bool {
+			{
+				add(ruleAction35, position)
+			}
+			return true
+		},
+		/* 268 Action36 <- <{
 		    // This is *always* executed, even if there is no
 		    // FROM clause present in the statement.
 		    p.AssembleWindowedFrom(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction33, position)
+				add(ruleAction36, position)
 			}
 			return true
 		},
-		/* 222 Action34 <- <{
+		/* 269 Action37 <- <{
 		    p.AssembleInterval()
 		}> */
 		func() bool {
 			{
-				add(ruleAction34, position)
+				add(ruleAction37, position)
 			}
 			return true
 		},
-		/* 223 Action35 <- <{
+		/* 270 Action38 <- <{
 		    p.AssembleInterval()
 		}> */
 		func() bool {
 			{
-				add(ruleAction35, position)
+				add(ruleAction38, position)
+			}
+			return true
+		},
+		/* 271 Action39 <- <{
+		    p.AssembleJoin()
+		}> */
+		func() bool {
+			{
+				add(ruleAction39, position)
 			}
 			return true
 		},
-		/* 224 Action36 <- <{
+		/* 272 Action40 <- <{
 		    // This is *always* executed, even if there is no
 		    // WHERE clause present in the statement.
 		    p.AssembleFilter(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction36, position)
+				add(ruleAction40, position)
 			}
 			return true
 		},
-		/* 225 Action37 <- <{
+		/* 273 Action41 <- <{
 		    // This is *always* executed, even if there is no
 		    // GROUP BY clause present in the statement.
 		    p.AssembleGrouping(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction37, position)
+				add(ruleAction41, position)
 			}
 			return true
 		},
-		/* 226 Action38 <- <{
+		/* 274 Action42 <- <{
 		    // This is *always* executed, even if there is no
 		    // HAVING clause present in the statement.
 		    p.AssembleHaving(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction38, position)
+				add(ruleAction42, position)
+			}
+			return true
+		},
+		/* 275 Action43 <- <{
+		    // This is *always* executed, even if there is no
+		    // ORDER BY clause present in the statement.
+		    p.AssembleOrdering(begin, end)
+		}> */
+		func() bool {
+			{
+				add(ruleAction43, position)
+			}
+			return true
+		},
+		/* 276 Action44 <- <{
+		    // This is *always* executed, even if there is no
+		    // LIMIT clause present in the statement.
+		    p.AssembleLimit(begin, end)
+		}> */
+		func() bool {
+			{
+				add(ruleAction44, position)
 			}
 			return true
 		},
-		/* 227 Action39 <- <{
+		/* 277 Action45 <- <{
 		    p.EnsureAliasedStreamWindow()
 		}> */
 		func() bool {
 			{
-				add(ruleAction39, position)
+				add(ruleAction45, position)
 			}
 			return true
 		},
-		/* 228 Action40 <- <{
+		/* 278 Action46 <- <{
 		    p.AssembleAliasedStreamWindow()
 		}> */
 		func() bool {
 			{
-				add(ruleAction40, position)
+				add(ruleAction46, position)
 			}
 			return true
 		},
-		/* 229 Action41 <- <{
+		/* 279 Action47 <- <{
 		    p.AssembleStreamWindow()
 		}> */
 		func() bool {
 			{
-				add(ruleAction41, position)
+				add(ruleAction47, position)
+			}
+			return true
+		},
+		/* 280 Action48 <- <{
+		    p.AssembleRangeWindow()
+		}> */
+		func() bool {
+			{
+				add(ruleAction48, position)
+			}
+			return true
+		},
+		/* 281 Action49 <- <{
+		    p.AssembleSessionWindow()
+		}> */
+		func() bool {
+			{
+				add(ruleAction49, position)
 			}
 			return true
 		},
-		/* 230 Action42 <- <{
+		/* 282 Action50 <- <{
+		    p.AssembleSubqueryStream(begin, end)
+		}> */
+		func() bool {
+			{
+				add(ruleAction50, position)
+			}
+			return true
+		},
+		/* 283 Action51 <- <{
 		    p.AssembleUDSFFuncApp()
 		}> */
 		func() bool {
 			{
-				add(ruleAction42, position)
+				add(ruleAction51, position)
+			}
+			return true
+		},
+		/* 284 Action52 <- <{
+		    p.EnsureSlideSpec(begin, end)
+		}> */
+		func() bool {
+			{
+				add(ruleAction52, position)
+			}
+			return true
+		},
+		/* 285 Action53 <- <{
+		    p.EnsureWatermarkSpec(begin, end)
+		}> */
+		func() bool {
+			{
+				add(ruleAction53, position)
+			}
+			return true
+		},
+		/* 286 Action54 <- <{
+		    p.AssembleWatermark()
+		}> */
+		func() bool {
+			{
+				add(ruleAction54, position)
+			}
+			return true
+		},
+		/* 287 Action55 <- <{
+		    p.EnsureAllowedLatenessSpec(begin, end)
+		}> */
+		func() bool {
+			{
+				add(ruleAction55, position)
+			}
+			return true
+		},
+		/* 288 Action56 <- <{
+		    p.EnsureLateTuplesSpec(begin, end)
+		}> */
+		func() bool {
+			{
+				add(ruleAction56, position)
 			}
 			return true
 		},
-		/* 231 Action43 <- <{
+		/* 289 Action57 <- <{
 		    p.EnsureCapacitySpec(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction43, position)
+				add(ruleAction57, position)
 			}
 			return true
 		},
-		/* 232 Action44 <- <{
+		/* 290 Action58 <- <{
 		    p.EnsureSheddingSpec(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction44, position)
+				add(ruleAction58, position)
 			}
 			return true
 		},
-		/* 233 Action45 <- <{
+		/* 291 Action59 <- <{
 		    p.AssembleSourceSinkSpecs(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction45, position)
+				add(ruleAction59, position)
 			}
 			return true
 		},
-		/* 234 Action46 <- <{
+		/* 292 Action60 <- <{
 		    p.AssembleSourceSinkSpecs(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction46, position)
+				add(ruleAction60, position)
 			}
 			return true
 		},
-		/* 235 Action47 <- <{
+		/* 293 Action61 <- <{
 		    p.AssembleSourceSinkSpecs(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction47, position)
+				add(ruleAction61, position)
 			}
 			return true
 		},
-		/* 236 Action48 <- <{
+		/* 294 Action62 <- <{
 		    p.EnsureIdentifier(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction48, position)
+				add(ruleAction62, position)
 			}
 			return true
 		},
-		/* 237 Action49 <- <{
+		/* 295 Action63 <- <{
 		    p.AssembleSourceSinkParam()
 		}> */
 		func() bool {
 			{
-				add(ruleAction49, position)
+				add(ruleAction63, position)
 			}
 			return true
 		},
-		/* 238 Action50 <- <{
+		/* 296 Action64 <- <{
 		    p.AssembleExpressions(begin, end)
 		    p.AssembleArray()
 		}> */
 		func() bool {
 			{
-				add(ruleAction50, position)
+				add(ruleAction64, position)
 			}
 			return true
 		},
-		/* 239 Action51 <- <{
+		/* 297 Action65 <- <{
 		    p.AssembleMap(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction51, position)
+				add(ruleAction65, position)
 			}
 			return true
 		},
-		/* 240 Action52 <- <{
+		/* 298 Action66 <- <{
 		    p.AssembleKeyValuePair()
 		}> */
 		func() bool {
 			{
-				add(ruleAction52, position)
+				add(ruleAction66, position)
 			}
 			return true
 		},
-		/* 241 Action53 <- <{
+		/* 299 Action67 <- <{
 		    p.EnsureKeywordPresent(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction53, position)
+				add(ruleAction67, position)
+			}
+			return true
+		},
+		/* 300 Action68 <- <{
+		    p.AssembleBinaryOperation(begin, end)
+		}> */
+		func() bool {
+			{
+				add(ruleAction68, position)
+			}
+			return true
+		},
+		/* 301 Action69 <- <{
+		    p.AssembleBinaryOperation(begin, end)
+		}> */
+		func() bool {
+			{
+				add(ruleAction69, position)
+			}
+			return true
+		},
+		/* 302 Action70 <- <{
+		    p.AssembleUnaryPrefixOperation(begin, end)
+		}> */
+		func() bool {
+			{
+				add(ruleAction70, position)
+			}
+			return true
+		},
+		/* 303 Action71 <- <{
+		    p.AssembleComparison(begin, end)
+		}> */
+		func() bool {
+			{
+				add(ruleAction71, position)
+			}
+			return true
+		},
+		/* 304 Action72 <- <{
+		    p.AssembleExpressions(begin, end)
+		    p.AssembleArray()
+		}> */
+		func() bool {
+			{
+				add(ruleAction72, position)
+			}
+			return true
+		},
+		/* 305 Action73 <- <{
+		    p.AssembleBinaryOperation(begin, end)
+		}> */
+		func() bool {
+			{
+				add(ruleAction73, position)
+			}
+			return true
+		},
+		/* 306 Action74 <- <{
+		    p.AssembleBinaryOperation(begin, end)
+		}> */
+		func() bool {
+			{
+				add(ruleAction74, position)
 			}
 			return true
 		},
-		/* 242 Action54 <- <{
+		/* 307 Action75 <- <{
 		    p.AssembleBinaryOperation(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction54, position)
+				add(ruleAction75, position)
 			}
 			return true
 		},
-		/* 243 Action55 <- <{
+		/* 308 Action76 <- <{
 		    p.AssembleBinaryOperation(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction55, position)
+				add(ruleAction76, position)
 			}
 			return true
 		},
-		/* 244 Action56 <- <{
+		/* 309 Action77 <- <{
 		    p.AssembleUnaryPrefixOperation(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction56, position)
+				add(ruleAction77, position)
 			}
 			return true
 		},
-		/* 245 Action57 <- <{
-		    p.AssembleBinaryOperation(begin, end)
+		/* 310 Action78 <- <{
+		    p.AssembleTypeCast(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction57, position)
+				add(ruleAction78, position)
 			}
 			return true
 		},
-		/* 246 Action58 <- <{
-		    p.AssembleBinaryOperation(begin, end)
+		/* 311 Action79 <- <{
+		    p.AssembleTypeCast(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction58, position)
+				add(ruleAction79, position)
 			}
 			return true
 		},
-		/* 247 Action59 <- <{
-		    p.AssembleBinaryOperation(begin, end)
+		/* 312 Action80 <- <{
+		    p.AssembleExtract()
 		}> */
 		func() bool {
 			{
-				add(ruleAction59, position)
+				add(ruleAction80, position)
 			}
 			return true
 		},
-		/* 248 Action60 <- <{
-		    p.AssembleBinaryOperation(begin, end)
+		/* 313 Action81 <- <{
+		    substr := string([]rune(buffer)[begin:end])
+		    p.PushComponent(begin, end, Identifier(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction60, position)
+				add(ruleAction81, position)
 			}
 			return true
 		},
-		/* 249 Action61 <- <{
-		    p.AssembleBinaryOperation(begin, end)
+		/* 314 Action82 <- <{
+		    p.AssembleWindowFuncApp()
 		}> */
 		func() bool {
 			{
-				add(ruleAction61, position)
+				add(ruleAction82, position)
 			}
 			return true
 		},
-		/* 250 Action62 <- <{
-		    p.AssembleUnaryPrefixOperation(begin, end)
+		/* 315 Action83 <- <{
+		    p.AssembleExpressions(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction62, position)
+				add(ruleAction83, position)
 			}
 			return true
 		},
-		/* 251 Action63 <- <{
-		    p.AssembleTypeCast(begin, end)
+		/* 316 Action84 <- <{
+		    p.AssembleExpressions(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction63, position)
+				add(ruleAction84, position)
 			}
 			return true
 		},
-		/* 252 Action64 <- <{
-		    p.AssembleTypeCast(begin, end)
+		/* 317 Action85 <- <{
+		    p.AssembleFuncApp()
 		}> */
 		func() bool {
 			{
-				add(ruleAction64, position)
+				add(ruleAction85, position)
 			}
 			return true
 		},
-		/* 253 Action65 <- <{
+		/* 318 Action86 <- <{
+		    p.AssembleExpressions(begin, end)
 		    p.AssembleFuncApp()
 		}> */
 		func() bool {
 			{
-				add(ruleAction65, position)
+				add(ruleAction86, position)
 			}
 			return true
 		},
-		/* 254 Action66 <- <{
+		/* 319 Action87 <- <{
 		    p.AssembleExpressions(begin, end)
-		    p.AssembleFuncApp()
 		}> */
 		func() bool {
 			{
-				add(ruleAction66, position)
+				add(ruleAction87, position)
 			}
 			return true
 		},
-		/* 255 Action67 <- <{
-		    p.AssembleExpressions(begin, end)
+		/* 320 Action88 <- <{
+		    p.AssembleLambda()
 		}> */
 		func() bool {
 			{
-				add(ruleAction67, position)
+				add(ruleAction88, position)
 			}
 			return true
 		},
-		/* 256 Action68 <- <{
+		/* 321 Action89 <- <{
+		    p.AssembleLambdaParams(begin, end)
+		}> */
+		func() bool {
+			{
+				add(ruleAction89, position)
+			}
+			return true
+		},
+		/* 322 Action90 <- <{
 		    p.AssembleExpressions(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction68, position)
+				add(ruleAction90, position)
 			}
 			return true
 		},
-		/* 257 Action69 <- <{
+		/* 323 Action91 <- <{
 		    p.AssembleSortedExpression()
 		}> */
 		func() bool {
 			{
-				add(ruleAction69, position)
+				add(ruleAction91, position)
 			}
 			return true
 		},
-		/* 258 Action70 <- <{
+		/* 324 Action92 <- <{
 		    p.EnsureKeywordPresent(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction70, position)
+				add(ruleAction92, position)
 			}
 			return true
 		},
-		/* 259 Action71 <- <{
+		/* 325 Action93 <- <{
 		    p.AssembleExpressions(begin, end)
 		    p.AssembleArray()
 		}> */
 		func() bool {
 			{
-				add(ruleAction71, position)
+				add(ruleAction93, position)
 			}
 			return true
 		},
-		/* 260 Action72 <- <{
+		/* 326 Action94 <- <{
 		    p.AssembleMap(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction72, position)
+				add(ruleAction94, position)
 			}
 			return true
 		},
-		/* 261 Action73 <- <{
+		/* 327 Action95 <- <{
 		    p.AssembleKeyValuePair()
 		}> */
 		func() bool {
 			{
-				add(ruleAction73, position)
+				add(ruleAction95, position)
 			}
 			return true
 		},
-		/* 262 Action74 <- <{
+		/* 328 Action96 <- <{
 		    p.AssembleConditionCase(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction74, position)
+				add(ruleAction96, position)
 			}
 			return true
 		},
-		/* 263 Action75 <- <{
+		/* 329 Action97 <- <{
 		    p.AssembleExpressionCase(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction75, position)
+				add(ruleAction97, position)
 			}
 			return true
 		},
-		/* 264 Action76 <- <{
+		/* 330 Action98 <- <{
 		    p.AssembleWhenThenPair()
 		}> */
 		func() bool {
 			{
-				add(ruleAction76, position)
+				add(ruleAction98, position)
 			}
 			return true
 		},
-		/* 265 Action77 <- <{
+		/* 331 Action99 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, NewStream(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction77, position)
+				add(ruleAction99, position)
 			}
 			return true
 		},
-		/* 266 Action78 <- <{
+		/* 332 Action100 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, NewRowMeta(substr, TimestampMeta))
 		}> */
 		func() bool {
 			{
-				add(ruleAction78, position)
+				add(ruleAction100, position)
 			}
 			return true
 		},
-		/* 267 Action79 <- <{
+		/* 333 Action101 <- <{
 		    substr := string([]rune(buffer)[begin:end])
-		    p.PushComponent(begin, end, NewRowValue(substr))
+		    p.AssembleRowValue(begin, end, substr)
 		}> */
 		func() bool {
 			{
-				add(ruleAction79, position)
+				add(ruleAction101, position)
 			}
 			return true
 		},
-		/* 268 Action80 <- <{
+		/* 334 Action102 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, NewNumericLiteral(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction80, position)
+				add(ruleAction102, position)
 			}
 			return true
 		},
-		/* 269 Action81 <- <{
+		/* 335 Action103 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, NewNumericLiteral(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction81, position)
+				add(ruleAction103, position)
 			}
 			return true
 		},
-		/* 270 Action82 <- <{
+		/* 336 Action104 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, NewFloatLiteral(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction82, position)
+				add(ruleAction104, position)
 			}
 			return true
 		},
-		/* 271 Action83 <- <{
+		/* 337 Action105 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, FuncName(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction83, position)
+				add(ruleAction105, position)
 			}
 			return true
 		},
-		/* 272 Action84 <- <{
+		/* 338 Action106 <- <{
 		    p.PushComponent(begin, end, NewNullLiteral())
 		}> */
 		func() bool {
 			{
-				add(ruleAction84, position)
+				add(ruleAction106, position)
 			}
 			return true
 		},
-		/* 273 Action85 <- <{
+		/* 339 Action107 <- <{
 		    p.PushComponent(begin, end, NewMissing())
 		}> */
 		func() bool {
 			{
-				add(ruleAction85, position)
+				add(ruleAction107, position)
+			}
+			return true
+		},
+		/* 340 Action108 <- <{
+		    p.AssembleIntervalLiteral()
+		}> */
+		func() bool {
+			{
+				add(ruleAction108, position)
+			}
+			return true
+		},
+		/* 341 Action109 <- <{
+		    substr := string([]rune(buffer)[begin:end])
+		    p.PushComponent(begin, end, NewIntervalUnit(substr))
+		}> */
+		func() bool {
+			{
+				add(ruleAction109, position)
 			}
 			return true
 		},
-		/* 274 Action86 <- <{
+		/* 342 Action110 <- <{
 		    p.PushComponent(begin, end, NewBoolLiteral(true))
 		}> */
 		func() bool {
 			{
-				add(ruleAction86, position)
+				add(ruleAction110, position)
 			}
 			return true
 		},
-		/* 275 Action87 <- <{
+		/* 343 Action111 <- <{
 		    p.PushComponent(begin, end, NewBoolLiteral(false))
 		}> */
 		func() bool {
 			{
-				add(ruleAction87, position)
+				add(ruleAction111, position)
 			}
 			return true
 		},
-		/* 276 Action88 <- <{
+		/* 344 Action112 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, NewWildcard(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction88, position)
+				add(ruleAction112, position)
 			}
 			return true
 		},
-		/* 277 Action89 <- <{
+		/* 345 Action113 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, NewStringLiteral(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction89, position)
+				add(ruleAction113, position)
 			}
 			return true
 		},
-		/* 278 Action90 <- <{
+		/* 346 Action114 <- <{
 		    p.PushComponent(begin, end, Istream)
 		}> */
 		func() bool {
 			{
-				add(ruleAction90, position)
+				add(ruleAction114, position)
 			}
 			return true
 		},
-		/* 279 Action91 <- <{
+		/* 347 Action115 <- <{
 		    p.PushComponent(begin, end, Dstream)
 		}> */
 		func() bool {
 			{
-				add(ruleAction91, position)
+				add(ruleAction115, position)
 			}
 			return true
 		},
-		/* 280 Action92 <- <{
+		/* 348 Action116 <- <{
 		    p.PushComponent(begin, end, Rstream)
 		}> */
 		func() bool {
 			{
-				add(ruleAction92, position)
+				add(ruleAction116, position)
 			}
 			return true
 		},
-		/* 281 Action93 <- <{
+		/* 349 Action117 <- <{
 		    p.PushComponent(begin, end, Tuples)
 		}> */
 		func() bool {
 			{
-				add(ruleAction93, position)
+				add(ruleAction117, position)
+			}
+			return true
+		},
+		/* 350 Action118 <- <{
+		    p.PushComponent(begin, end, Minutes)
+		}> */
+		func() bool {
+			{
+				add(ruleAction118, position)
 			}
 			return true
 		},
-		/* 282 Action94 <- <{
+		/* 351 Action119 <- <{
 		    p.PushComponent(begin, end, Seconds)
 		}> */
 		func() bool {
 			{
-				add(ruleAction94, position)
+				add(ruleAction119, position)
 			}
 			return true
 		},
-		/* 283 Action95 <- <{
+		/* 352 Action120 <- <{
 		    p.PushComponent(begin, end, Milliseconds)
 		}> */
 		func() bool {
 			{
-				add(ruleAction95, position)
+				add(ruleAction120, position)
+			}
+			return true
+		},
+		/* 353 Action121 <- <{
+		    p.PushComponent(begin, end, InnerJoin)
+		}> */
+		func() bool {
+			{
+				add(ruleAction121, position)
+			}
+			return true
+		},
+		/* 354 Action122 <- <{
+		    p.PushComponent(begin, end, LeftOuterJoin)
+		}> */
+		func() bool {
+			{
+				add(ruleAction122, position)
 			}
 			return true
 		},
-		/* 284 Action96 <- <{
+		/* 355 Action123 <- <{
 		    p.PushComponent(begin, end, Wait)
 		}> */
 		func() bool {
 			{
-				add(ruleAction96, position)
+				add(ruleAction123, position)
 			}
 			return true
 		},
-		/* 285 Action97 <- <{
+		/* 356 Action124 <- <{
 		    p.PushComponent(begin, end, DropOldest)
 		}> */
 		func() bool {
 			{
-				add(ruleAction97, position)
+				add(ruleAction124, position)
 			}
 			return true
 		},
-		/* 286 Action98 <- <{
+		/* 357 Action125 <- <{
 		    p.PushComponent(begin, end, DropNewest)
 		}> */
 		func() bool {
 			{
-				add(ruleAction98, position)
+				add(ruleAction125, position)
 			}
 			return true
 		},
-		/* 287 Action99 <- <{
+		/* 358 Action126 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, StreamIdentifier(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction99, position)
+				add(ruleAction126, position)
 			}
 			return true
 		},
-		/* 288 Action100 <- <{
+		/* 359 Action127 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, SourceSinkType(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction100, position)
+				add(ruleAction127, position)
 			}
 			return true
 		},
-		/* 289 Action101 <- <{
+		/* 360 Action128 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, SourceSinkParamKey(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction101, position)
+				add(ruleAction128, position)
 			}
 			return true
 		},
-		/* 290 Action102 <- <{
+		/* 361 Action129 <- <{
 		    p.PushComponent(begin, end, Yes)
 		}> */
 		func() bool {
 			{
-				add(ruleAction102, position)
+				add(ruleAction129, position)
 			}
 			return true
 		},
-		/* 291 Action103 <- <{
+		/* 362 Action130 <- <{
 		    p.PushComponent(begin, end, No)
 		}> */
 		func() bool {
 			{
-				add(ruleAction103, position)
+				add(ruleAction130, position)
 			}
 			return true
 		},
-		/* 292 Action104 <- <{
+		/* 363 Action131 <- <{
 		    p.PushComponent(begin, end, Yes)
 		}> */
 		func() bool {
 			{
-				add(ruleAction104, position)
+				add(ruleAction131, position)
 			}
 			return true
 		},
-		/* 293 Action105 <- <{
+		/* 364 Action132 <- <{
 		    p.PushComponent(begin, end, No)
 		}> */
 		func() bool {
 			{
-				add(ruleAction105, position)
+				add(ruleAction132, position)
 			}
 			return true
 		},
-		/* 294 Action106 <- <{
+		/* 365 Action133 <- <{
 		    p.PushComponent(begin, end, Bool)
 		}> */
 		func() bool {
 			{
-				add(ruleAction106, position)
+				add(ruleAction133, position)
 			}
 			return true
 		},
-		/* 295 Action107 <- <{
+		/* 366 Action134 <- <{
 		    p.PushComponent(begin, end, Int)
 		}> */
 		func() bool {
 			{
-				add(ruleAction107, position)
+				add(ruleAction134, position)
 			}
 			return true
 		},
-		/* 296 Action108 <- <{
+		/* 367 Action135 <- <{
 		    p.PushComponent(begin, end, Float)
 		}> */
 		func() bool {
 			{
-				add(ruleAction108, position)
+				add(ruleAction135, position)
 			}
 			return true
 		},
-		/* 297 Action109 <- <{
+		/* 368 Action136 <- <{
 		    p.PushComponent(begin, end, String)
 		}> */
 		func() bool {
 			{
-				add(ruleAction109, position)
+				add(ruleAction136, position)
 			}
 			return true
 		},
-		/* 298 Action110 <- <{
+		/* 369 Action137 <- <{
 		    p.PushComponent(begin, end, Blob)
 		}> */
 		func() bool {
 			{
-				add(ruleAction110, position)
+				add(ruleAction137, position)
 			}
 			return true
 		},
-		/* 299 Action111 <- <{
+		/* 370 Action138 <- <{
 		    p.PushComponent(begin, end, Timestamp)
 		}> */
 		func() bool {
 			{
-				add(ruleAction111, position)
+				add(ruleAction138, position)
 			}
 			return true
 		},
-		/* 300 Action112 <- <{
+		/* 371 Action139 <- <{
 		    p.PushComponent(begin, end, Array)
 		}> */
 		func() bool {
 			{
-				add(ruleAction112, position)
+				add(ruleAction139, position)
 			}
 			return true
 		},
-		/* 301 Action113 <- <{
+		/* 372 Action140 <- <{
 		    p.PushComponent(begin, end, Map)
 		}> */
 		func() bool {
 			{
-				add(ruleAction113, position)
+				add(ruleAction140, position)
 			}
 			return true
 		},
-		/* 302 Action114 <- <{
+		/* 373 Action141 <- <{
 		    p.PushComponent(begin, end, Or)
 		}> */
 		func() bool {
 			{
-				add(ruleAction114, position)
+				add(ruleAction141, position)
 			}
 			return true
 		},
-		/* 303 Action115 <- <{
+		/* 374 Action142 <- <{
 		    p.PushComponent(begin, end, And)
 		}> */
 		func() bool {
 			{
-				add(ruleAction115, position)
+				add(ruleAction142, position)
 			}
 			return true
 		},
-		/* 304 Action116 <- <{
+		/* 375 Action143 <- <{
 		    p.PushComponent(begin, end, Not)
 		}> */
 		func() bool {
 			{
-				add(ruleAction116, position)
+				add(ruleAction143, position)
 			}
 			return true
 		},
-		/* 305 Action117 <- <{
+		/* 376 Action144 <- <{
 		    p.PushComponent(begin, end, Equal)
 		}> */
 		func() bool {
 			{
-				add(ruleAction117, position)
+				add(ruleAction144, position)
 			}
 			return true
 		},
-		/* 306 Action118 <- <{
+		/* 377 Action145 <- <{
 		    p.PushComponent(begin, end, Less)
 		}> */
 		func() bool {
 			{
-				add(ruleAction118, position)
+				add(ruleAction145, position)
 			}
 			return true
 		},
-		/* 307 Action119 <- <{
+		/* 378 Action146 <- <{
 		    p.PushComponent(begin, end, LessOrEqual)
 		}> */
 		func() bool {
 			{
-				add(ruleAction119, position)
+				add(ruleAction146, position)
 			}
 			return true
 		},
-		/* 308 Action120 <- <{
+		/* 379 Action147 <- <{
 		    p.PushComponent(begin, end, Greater)
 		}> */
 		func() bool {
 			{
-				add(ruleAction120, position)
+				add(ruleAction147, position)
 			}
 			return true
 		},
-		/* 309 Action121 <- <{
+		/* 380 Action148 <- <{
 		    p.PushComponent(begin, end, GreaterOrEqual)
 		}> */
 		func() bool {
 			{
-				add(ruleAction121, position)
+				add(ruleAction148, position)
 			}
 			return true
 		},
-		/* 310 Action122 <- <{
+		/* 381 Action149 <- <{
 		    p.PushComponent(begin, end, NotEqual)
 		}> */
 		func() bool {
 			{
-				add(ruleAction122, position)
+				add(ruleAction149, position)
+			}
+			return true
+		},
+		/* 382 Action150 <- <{
+		    p.PushComponent(begin, end, In)
+		}> */
+		func() bool {
+			{
+				add(ruleAction150, position)
+			}
+			return true
+		},
+		/* 383 Action151 <- <{
+		    p.PushComponent(begin, end, NotIn)
+		}> */
+		func() bool {
+			{
+				add(ruleAction151, position)
+			}
+			return true
+		},
+		/* 384 Action152 <- <{
+		    p.PushComponent(begin, end, Like)
+		}> */
+		func() bool {
+			{
+				add(ruleAction152, position)
+			}
+			return true
+		},
+		/* 385 Action153 <- <{
+		    p.PushComponent(begin, end, NotLike)
+		}> */
+		func() bool {
+			{
+				add(ruleAction153, position)
+			}
+			return true
+		},
+		/* 386 Action154 <- <{
+		    p.PushComponent(begin, end, ILike)
+		}> */
+		func() bool {
+			{
+				add(ruleAction154, position)
+			}
+			return true
+		},
+		/* 387 Action155 <- <{
+		    p.PushComponent(begin, end, NotILike)
+		}> */
+		func() bool {
+			{
+				add(ruleAction155, position)
+			}
+			return true
+		},
+		/* 388 Action156 <- <{
+		    p.PushComponent(begin, end, RegexpMatch)
+		}> */
+		func() bool {
+			{
+				add(ruleAction156, position)
+			}
+			return true
+		},
+		/* 389 Action157 <- <{
+		    p.PushComponent(begin, end, NotRegexpMatch)
+		}> */
+		func() bool {
+			{
+				add(ruleAction157, position)
+			}
+			return true
+		},
+		/* 390 Action158 <- <{
+		    p.PushComponent(begin, end, Between)
+		}> */
+		func() bool {
+			{
+				add(ruleAction158, position)
+			}
+			return true
+		},
+		/* 391 Action159 <- <{
+		    p.PushComponent(begin, end, NotBetween)
+		}> */
+		func() bool {
+			{
+				add(ruleAction159, position)
 			}
 			return true
 		},
-		/* 311 Action123 <- <{
+		/* 392 Action160 <- <{
 		    p.PushComponent(begin, end, Concat)
 		}> */
 		func() bool {
 			{
-				add(ruleAction123, position)
+				add(ruleAction160, position)
 			}
 			return true
 		},
-		/* 312 Action124 <- <{
+		/* 393 Action161 <- <{
 		    p.PushComponent(begin, end, Is)
 		}> */
 		func() bool {
 			{
-				add(ruleAction124, position)
+				add(ruleAction161, position)
 			}
 			return true
 		},
-		/* 313 Action125 <- <{
+		/* 394 Action162 <- <{
 		    p.PushComponent(begin, end, IsNot)
 		}> */
 		func() bool {
 			{
-				add(ruleAction125, position)
+				add(ruleAction162, position)
 			}
 			return true
 		},
-		/* 314 Action126 <- <{
+		/* 395 Action163 <- <{
 		    p.PushComponent(begin, end, Plus)
 		}> */
 		func() bool {
 			{
-				add(ruleAction126, position)
+				add(ruleAction163, position)
 			}
 			return true
 		},
-		/* 315 Action127 <- <{
+		/* 396 Action164 <- <{
 		    p.PushComponent(begin, end, Minus)
 		}> */
 		func() bool {
 			{
-				add(ruleAction127, position)
+				add(ruleAction164, position)
 			}
 			return true
 		},
-		/* 316 Action128 <- <{
+		/* 397 Action165 <- <{
 		    p.PushComponent(begin, end, Multiply)
 		}> */
 		func() bool {
 			{
-				add(ruleAction128, position)
+				add(ruleAction165, position)
 			}
 			return true
 		},
-		/* 317 Action129 <- <{
+		/* 398 Action166 <- <{
 		    p.PushComponent(begin, end, Divide)
 		}> */
 		func() bool {
 			{
-				add(ruleAction129, position)
+				add(ruleAction166, position)
 			}
 			return true
 		},
-		/* 318 Action130 <- <{
+		/* 399 Action167 <- <{
 		    p.PushComponent(begin, end, Modulo)
 		}> */
 		func() bool {
 			{
-				add(ruleAction130, position)
+				add(ruleAction167, position)
 			}
 			return true
 		},
-		/* 319 Action131 <- <{
+		/* 400 Action168 <- <{
 		    p.PushComponent(begin, end, UnaryMinus)
 		}> */
 		func() bool {
 			{
-				add(ruleAction131, position)
+				add(ruleAction168, position)
 			}
 			return true
 		},
-		/* 320 Action132 <- <{
+		/* 401 Action169 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, Identifier(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction132, position)
+				add(ruleAction169, position)
 			}
 			return true
 		},
-		/* 321 Action133 <- <{
+		/* 402 Action170 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, Identifier(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction133, position)
+				add(ruleAction170, position)
 			}
 			return true
 		},
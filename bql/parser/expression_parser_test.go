@@ -41,6 +41,22 @@ func TestExpressionParser(t *testing.T) {
 				ExpressionsAST{[]Expression{RowValue{"", "b"}}}, nil}, UnspecifiedKeyword}}}}, "count(a ORDER BY count(b))"},
 		`f(2.1, "a")`: {[]Expression{FuncAppAST{FuncName("f"),
 			ExpressionsAST{[]Expression{FloatLiteral{2.1}, StringLiteral{"a"}}}, nil}}, `f(2.1, "a")`},
+		// Window Function Application
+		"row_number() OVER ()": {[]Expression{WindowFuncAppAST{FuncAppAST{FuncName("row_number"),
+			ExpressionsAST{[]Expression{}}, nil}, nil, nil}}, "row_number() OVER ()"},
+		"lag(a) over (partition by b, c)": {[]Expression{WindowFuncAppAST{FuncAppAST{FuncName("lag"),
+			ExpressionsAST{[]Expression{RowValue{"", "a"}}}, nil},
+			[]Expression{RowValue{"", "b"}, RowValue{"", "c"}}, nil}}, "lag(a) OVER (PARTITION BY b, c)"},
+		"a - lag(a, 2) OVER (PARTITION BY b ORDER BY ts() DESC)": {[]Expression{BinaryOpAST{Minus, RowValue{"", "a"},
+			WindowFuncAppAST{FuncAppAST{FuncName("lag"),
+				ExpressionsAST{[]Expression{RowValue{"", "a"}, NumericLiteral{2}}}, nil},
+				[]Expression{RowValue{"", "b"}},
+				[]SortedExpressionAST{{RowMeta{"", TimestampMeta}, No}}}}},
+			"a - lag(a, 2) OVER (PARTITION BY b ORDER BY ts() DESC)"},
+		"first_value(a)OVER(ORDER BY b)": {[]Expression{WindowFuncAppAST{FuncAppAST{FuncName("first_value"),
+			ExpressionsAST{[]Expression{RowValue{"", "a"}}}, nil},
+			nil, []SortedExpressionAST{{RowValue{"", "b"}, UnspecifiedKeyword}}}}, "first_value(a) OVER (ORDER BY b)"},
+		"lag(a) OVER": {nil, ""}, // the parentheses are mandatory
 		// Type Cast
 		"CAST(2.1 AS BOOL)":    {[]Expression{TypeCastAST{FloatLiteral{2.1}, Bool}}, "CAST(2.1 AS BOOL)"},
 		"CAST(2.1 AS INT)":     {[]Expression{TypeCastAST{FloatLiteral{2.1}, Int}}, "CAST(2.1 AS INT)"},
@@ -116,10 +132,10 @@ func TestExpressionParser(t *testing.T) {
 		"CASE WHEN true THEN 3 END":          {[]Expression{ConditionCaseAST{[]WhenThenPairAST{{BoolLiteral{true}, NumericLiteral{3}}}, nil}}, "CASE WHEN TRUE THEN 3 END"},
 		"CASE WHEN false THEN 3 ELSE 6 END":  {[]Expression{ConditionCaseAST{[]WhenThenPairAST{{BoolLiteral{false}, NumericLiteral{3}}}, NumericLiteral{6}}}, "CASE WHEN FALSE THEN 3 ELSE 6 END"},
 		// NumericLiteral
-		"2":    {[]Expression{NumericLiteral{2}}, "2"},
-		"-2":   {[]Expression{UnaryOpAST{UnaryMinus, NumericLiteral{2}}}, "-2"},
-		"- -2": {[]Expression{UnaryOpAST{UnaryMinus, NumericLiteral{-2}}}, "- -2"}, // like PostgreSQL
-		"999999999999999999999999999": {nil, ""}, // int64 overflow
+		"2":                           {[]Expression{NumericLiteral{2}}, "2"},
+		"-2":                          {[]Expression{UnaryOpAST{UnaryMinus, NumericLiteral{2}}}, "-2"},
+		"- -2":                        {[]Expression{UnaryOpAST{UnaryMinus, NumericLiteral{-2}}}, "- -2"}, // like PostgreSQL
+		"999999999999999999999999999": {nil, ""},                                                          // int64 overflow
 		// FloatLiteral
 		"1.2":   {[]Expression{FloatLiteral{1.2}}, "1.2"},
 		"-3.14": {[]Expression{UnaryOpAST{UnaryMinus, FloatLiteral{3.14}}}, "-3.14"},
@@ -141,13 +157,80 @@ func TestExpressionParser(t *testing.T) {
 		// NOT
 		"NOT a": {[]Expression{UnaryOpAST{Not, RowValue{"", "a"}}}, "NOT a"},
 		// Comparisons
-		"a = 2":  {[]Expression{BinaryOpAST{Equal, RowValue{"", "a"}, NumericLiteral{2}}}, "a = 2"},
-		"a < 2":  {[]Expression{BinaryOpAST{Less, RowValue{"", "a"}, NumericLiteral{2}}}, "a < 2"},
-		"a <= 2": {[]Expression{BinaryOpAST{LessOrEqual, RowValue{"", "a"}, NumericLiteral{2}}}, "a <= 2"},
-		"a > 2":  {[]Expression{BinaryOpAST{Greater, RowValue{"", "a"}, NumericLiteral{2}}}, "a > 2"},
-		"a >= 2": {[]Expression{BinaryOpAST{GreaterOrEqual, RowValue{"", "a"}, NumericLiteral{2}}}, "a >= 2"},
-		"a != 2": {[]Expression{BinaryOpAST{NotEqual, RowValue{"", "a"}, NumericLiteral{2}}}, "a != 2"},
-		"a <> 2": {[]Expression{BinaryOpAST{NotEqual, RowValue{"", "a"}, NumericLiteral{2}}}, "a != 2"},
+		"a = 2":   {[]Expression{BinaryOpAST{Equal, RowValue{"", "a"}, NumericLiteral{2}}}, "a = 2"},
+		"a < 2":   {[]Expression{BinaryOpAST{Less, RowValue{"", "a"}, NumericLiteral{2}}}, "a < 2"},
+		"a <= 2":  {[]Expression{BinaryOpAST{LessOrEqual, RowValue{"", "a"}, NumericLiteral{2}}}, "a <= 2"},
+		"a > 2":   {[]Expression{BinaryOpAST{Greater, RowValue{"", "a"}, NumericLiteral{2}}}, "a > 2"},
+		"a >= 2":  {[]Expression{BinaryOpAST{GreaterOrEqual, RowValue{"", "a"}, NumericLiteral{2}}}, "a >= 2"},
+		"a != 2":  {[]Expression{BinaryOpAST{NotEqual, RowValue{"", "a"}, NumericLiteral{2}}}, "a != 2"},
+		"a <> 2":  {[]Expression{BinaryOpAST{NotEqual, RowValue{"", "a"}, NumericLiteral{2}}}, "a != 2"},
+		`a ~ "x"`: {[]Expression{BinaryOpAST{RegexpMatch, RowValue{"", "a"}, StringLiteral{"x"}}}, `a ~ "x"`},
+		`a!~"x"`:  {[]Expression{BinaryOpAST{NotRegexpMatch, RowValue{"", "a"}, StringLiteral{"x"}}}, `a !~ "x"`},
+		// Pattern matching
+		`a LIKE "x%"`:      {[]Expression{BinaryOpAST{Like, RowValue{"", "a"}, StringLiteral{"x%"}}}, `a LIKE "x%"`},
+		`a NOT LIKE "x%"`:  {[]Expression{BinaryOpAST{NotLike, RowValue{"", "a"}, StringLiteral{"x%"}}}, `a NOT LIKE "x%"`},
+		"a ilike b":        {[]Expression{BinaryOpAST{ILike, RowValue{"", "a"}, RowValue{"", "b"}}}, "a ILIKE b"},
+		`a NOT ILIKE "x_"`: {[]Expression{BinaryOpAST{NotILike, RowValue{"", "a"}, StringLiteral{"x_"}}}, `a NOT ILIKE "x_"`},
+		`a LIKE"x"`:        {nil, ""}, // LIKE needs a hard space
+		// INTERVAL
+		"INTERVAL 5 MINUTES": {[]Expression{IntervalLiteral{5, Minutes}}, "INTERVAL 5 MINUTES"},
+		"interval 1.5 hour":  {[]Expression{IntervalLiteral{1.5, Hours}}, "INTERVAL 1.5 HOURS"},
+		`INTERVAL "2 days"`:  {[]Expression{IntervalLiteral{2, Days}}, "INTERVAL 2 DAYS"},
+		"INTERVAL '1 week'":  {[]Expression{IntervalLiteral{1, Weeks}}, "INTERVAL 1 WEEKS"},
+		"INTERVAL 5":         {nil, ""},
+		"INTERVAL 5 TUPLES":  {nil, ""},
+		"interval":           {[]Expression{RowValue{"", "interval"}}, "interval"},
+		"ts() + INTERVAL 10 MILLISECONDS": {[]Expression{BinaryOpAST{Plus, RowMeta{"", TimestampMeta},
+			IntervalLiteral{10, Milliseconds}}}, "ts() + INTERVAL 10 MILLISECONDS"},
+		// EXTRACT
+		"EXTRACT(HOUR FROM ts())": {[]Expression{FuncAppAST{"date_part", ExpressionsAST{[]Expression{
+			StringLiteral{"hour"}, RowMeta{"", TimestampMeta}}}, nil}}, `date_part("hour", ts())`},
+		"extract ( epoch FROM a + b )": {[]Expression{FuncAppAST{"date_part", ExpressionsAST{[]Expression{
+			StringLiteral{"epoch"}, BinaryOpAST{Plus, RowValue{"", "a"}, RowValue{"", "b"}}}}, nil}},
+			`date_part("epoch", a + b)`},
+		"extract(a)": {[]Expression{FuncAppAST{"extract", ExpressionsAST{[]Expression{
+			RowValue{"", "a"}}}, nil}}, "extract(a)"},
+		// Lambda
+		"transform(a, x -> x * 2)": {[]Expression{FuncAppAST{"transform", ExpressionsAST{[]Expression{
+			RowValue{"", "a"}, LambdaAST{[]string{"x"}, BinaryOpAST{Multiply,
+				LambdaVariable{"x", ""}, NumericLiteral{2}}}}}, nil}}, "transform(a, x -> x * 2)"},
+		"reduce(a, 0, (acc,x)->acc + x.v[0] + b + s:x)": {[]Expression{FuncAppAST{"reduce", ExpressionsAST{[]Expression{
+			RowValue{"", "a"}, NumericLiteral{0}, LambdaAST{[]string{"acc", "x"}, BinaryOpAST{Plus,
+				BinaryOpAST{Plus, BinaryOpAST{Plus, LambdaVariable{"acc", ""}, LambdaVariable{"x", ".v[0]"}},
+					RowValue{"", "b"}}, RowValue{"s", "x"}}}}}, nil}},
+			"reduce(a, 0, (acc, x) -> ((acc + x.v[0]) + b) + s:x)"},
+		"f(( x ) -> g(x, y -> x - y), x)": {[]Expression{FuncAppAST{"f", ExpressionsAST{[]Expression{
+			LambdaAST{[]string{"x"}, FuncAppAST{"g", ExpressionsAST{[]Expression{
+				LambdaVariable{"x", ""}, LambdaAST{[]string{"y"}, BinaryOpAST{Minus,
+					LambdaVariable{"x", ""}, LambdaVariable{"y", ""}}}}}, nil}},
+			RowValue{"", "x"}}}, nil}}, "f(x -> g(x, y -> x - y), x)"},
+		"x -> x":    {nil, ""},
+		"f(x -> )":  {nil, ""},
+		"f(1 -> x)": {nil, ""},
+		// IN
+		`a IN (1, "x")`: {[]Expression{BinaryOpAST{In, RowValue{"", "a"},
+			ArrayAST{ExpressionsAST{[]Expression{NumericLiteral{1}, StringLiteral{"x"}}}}}}, `a IN [1, "x"]`},
+		"a NOT IN(b)": {[]Expression{BinaryOpAST{NotIn, RowValue{"", "a"},
+			ArrayAST{ExpressionsAST{[]Expression{RowValue{"", "b"}}}}}}, "a NOT IN [b]"},
+		"a IN [1, 2]": {[]Expression{BinaryOpAST{In, RowValue{"", "a"},
+			ArrayAST{ExpressionsAST{[]Expression{NumericLiteral{1}, NumericLiteral{2}}}}}}, "a IN [1, 2]"},
+		"a IN b":  {[]Expression{BinaryOpAST{In, RowValue{"", "a"}, RowValue{"", "b"}}}, "a IN b"},
+		"a IN ()": {nil, ""},
+		// BETWEEN
+		"a BETWEEN 1 AND b + 2": {[]Expression{BetweenAST{Between, RowValue{"", "a"}, NumericLiteral{1},
+			BinaryOpAST{Plus, RowValue{"", "b"}, NumericLiteral{2}}}}, "a BETWEEN 1 AND b + 2"},
+		"a NOT BETWEEN 1 AND 2 AND b": {[]Expression{BinaryOpAST{And,
+			BetweenAST{NotBetween, RowValue{"", "a"}, NumericLiteral{1}, NumericLiteral{2}},
+			RowValue{"", "b"}}}, "a NOT BETWEEN 1 AND 2 AND b"},
+		"(a BETWEEN 1 AND 2) = ((b < 3) BETWEEN (c OR d) AND 4)": {[]Expression{BinaryOpAST{Equal,
+			BetweenAST{Between, RowValue{"", "a"}, NumericLiteral{1}, NumericLiteral{2}},
+			BetweenAST{Between, BinaryOpAST{Less, RowValue{"", "b"}, NumericLiteral{3}},
+				BinaryOpAST{Or, RowValue{"", "c"}, RowValue{"", "d"}}, NumericLiteral{4}}}},
+			"(a BETWEEN 1 AND 2) = ((b < 3) BETWEEN (c OR d) AND 4)"},
+		"NOT a BETWEEN 1 AND 2": {[]Expression{UnaryOpAST{Not,
+			BetweenAST{Between, RowValue{"", "a"}, NumericLiteral{1}, NumericLiteral{2}}}},
+			"NOT (a BETWEEN 1 AND 2)"},
+		"a BETWEEN 1": {nil, ""},
 		// Other operators
 		"a || 2": {[]Expression{BinaryOpAST{Concat, RowValue{"", "a"}, NumericLiteral{2}}}, "a || 2"},
 		// IS Expressions
@@ -3,6 +3,7 @@ package parser
 import (
 	"fmt"
 	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"strings"
 )
 
 // parseStack is a standard stack implementation, but also holds
@@ -10,6 +11,10 @@ import (
 type parseStack struct {
 	top  *stackElement
 	size int
+	// lambdaScopes holds the parameter names of all lambda
+	// expressions enclosing the expression that is currently
+	// assembled, innermost last
+	lambdaScopes [][]string
 }
 
 // stackElement is a stack-internal data structure that is used
@@ -67,20 +72,25 @@ func (ps *parseStack) Peek() (value *ParsedComponent) {
 // they are components of a SELECT statement, and replaces them by
 // a single SelectStmt element.
 //
-//  EmitterAST
+//  LimitAST
+//  OrderingAST
 //  HavingAST
 //  GroupingAST
 //  FilterAST
 //  WindowedFromAST
 //  ProjectionsAST
+//  EmitterAST
 //   =>
-//  SelectStmt{EmitterAST, ProjectionsAST, WindowedFromAST, FilterAST, GroupingAST, HavingAST}
+//  SelectStmt{EmitterAST, ProjectionsAST, WindowedFromAST, FilterAST, GroupingAST,
+//    HavingAST, OrderingAST, LimitAST}
 func (ps *parseStack) AssembleSelect() {
 	// pop the components from the stack in reverse order
-	_having, _grouping, _filter, _from, _projections, _emitter := ps.pop6()
+	_limit, _ordering, _having, _grouping, _filter, _from, _projections, _emitter := ps.pop8()
 
 	// extract and convert the contained structure
 	// (if this fails, this is a fundamental parser bug => panic ok)
+	limit := _limit.comp.(LimitAST)
+	ordering := _ordering.comp.(OrderingAST)
 	having := _having.comp.(HavingAST)
 	grouping := _grouping.comp.(GroupingAST)
 	filter := _filter.comp.(FilterAST)
@@ -89,8 +99,9 @@ func (ps *parseStack) AssembleSelect() {
 	emitter := _emitter.comp.(EmitterAST)
 
 	// assemble the SelectStmt and push it back
-	s := SelectStmt{emitter, projections, from, filter, grouping, having}
-	se := ParsedComponent{_emitter.begin, _having.end, s}
+	s := SelectStmt{emitter, projections, from, filter, grouping, having,
+		ordering, limit}
+	se := ParsedComponent{_emitter.begin, _limit.end, s}
 	ps.Push(&se)
 }
 
@@ -118,20 +129,48 @@ func (ps *parseStack) AssembleSelectUnion(begin int, end int) {
 // replaces them by a single CreateStreamAsSelectStmt element.
 //
 //  SelectStmt
+//  SourceSinkSpecsAST
 //  StreamIdentifier
 //   =>
-//  CreateStreamAsSelectStmt{StreamIdentifier, SelectStmt}
+//  CreateStreamAsSelectStmt{StreamIdentifier, SelectStmt, SourceSinkSpecsAST}
 func (ps *parseStack) AssembleCreateStreamAsSelect() {
 	// now pop the components from the stack in reverse order
-	_select, _name := ps.pop2()
+	_select, _specs, _name := ps.pop3()
 
 	// extract and convert the contained structure
 	// (if this fails, this is a fundamental parser bug => panic ok)
 	s := _select.comp.(SelectStmt)
+	specs := _specs.comp.(SourceSinkSpecsAST)
 	name := _name.comp.(StreamIdentifier)
 
 	// assemble the SelectStmt and push it back
-	css := CreateStreamAsSelectStmt{name, s}
+	css := CreateStreamAsSelectStmt{name, s, specs}
+	se := ParsedComponent{_name.begin, _select.end, css}
+	ps.Push(&se)
+}
+
+// AssembleCreateOrReplaceStreamAsSelect takes the topmost elements from the
+// stack, assuming they are components of a CREATE OR REPLACE STREAM
+// statement, and replaces them by a single CreateOrReplaceStreamAsSelectStmt
+// element.
+//
+//  SelectStmt
+//  SourceSinkSpecsAST
+//  StreamIdentifier
+//   =>
+//  CreateOrReplaceStreamAsSelectStmt{StreamIdentifier, SelectStmt, SourceSinkSpecsAST}
+func (ps *parseStack) AssembleCreateOrReplaceStreamAsSelect() {
+	// now pop the components from the stack in reverse order
+	_select, _specs, _name := ps.pop3()
+
+	// extract and convert the contained structure
+	// (if this fails, this is a fundamental parser bug => panic ok)
+	s := _select.comp.(SelectStmt)
+	specs := _specs.comp.(SourceSinkSpecsAST)
+	name := _name.comp.(StreamIdentifier)
+
+	// assemble the SelectStmt and push it back
+	css := CreateOrReplaceStreamAsSelectStmt{name, s, specs}
 	se := ParsedComponent{_name.begin, _select.end, css}
 	ps.Push(&se)
 }
@@ -646,31 +685,74 @@ func (ps *parseStack) AssembleAlias() {
 
 // AssembleWindowedFrom takes the elements from the stack that
 // correspond to the input[begin:end] string, makes sure they are all
-// AliasedStreamWindowAST elements and wraps a WindowedFromAST struct
-// around them. If there are no such elements, adds an
-// empty WindowedFromAST struct to the stack.
+// AliasedStreamWindowAST elements (or a JoinAST following the second
+// relation) and wraps a WindowedFromAST struct around them. If there
+// are no such elements, adds an empty WindowedFromAST struct to the
+// stack.
 //
 //  AliasedStreamWindowAST
 //  AliasedStreamWindowAST
 //   =>
-//  WindowedFromAST{[AliasedStreamWindowAST, AliasedStreamWindowAST]}
+//  WindowedFromAST{[AliasedStreamWindowAST, AliasedStreamWindowAST], JoinAST{}}
+// or
+//  JoinAST
+//  AliasedStreamWindowAST
+//  AliasedStreamWindowAST
+//   =>
+//  WindowedFromAST{[AliasedStreamWindowAST, AliasedStreamWindowAST], JoinAST}
 func (ps *parseStack) AssembleWindowedFrom(begin int, end int) {
 	if begin == end {
 		// push an empty FROM clause
 		ps.PushComponent(begin, end, WindowedFromAST{})
 	} else {
 		elems := ps.collectElements(begin, end)
-		rels := make([]AliasedStreamWindowAST, len(elems), len(elems))
-		for i, elem := range elems {
+		rels := make([]AliasedStreamWindowAST, 0, len(elems))
+		join := JoinAST{}
+		for _, elem := range elems {
+			if j, ok := elem.(JoinAST); ok {
+				join = j
+				continue
+			}
 			// (if this conversion fails, this is a fundamental parser bug)
 			e := elem.(AliasedStreamWindowAST)
-			rels[i] = e
+			rels = append(rels, e)
 		}
 		// push the grouped list back
-		ps.PushComponent(begin, end, WindowedFromAST{rels})
+		ps.PushComponent(begin, end, WindowedFromAST{rels, join})
 	}
 }
 
+// AssembleJoin takes the topmost elements from the stack, assuming
+// they are components of a JOIN clause, and replaces them by the
+// joined relation followed by a JoinAST element.
+//
+//  Expression
+//  AliasedStreamWindowAST
+//  JoinType
+//   =>
+//  AliasedStreamWindowAST
+//  JoinAST{JoinType, "", Expression}
+// or
+//  Expression
+//  StreamIdentifier
+//  JoinType
+//   =>
+//  JoinAST{JoinType, StreamIdentifier, Expression}
+func (ps *parseStack) AssembleJoin() {
+	// pop the components from the stack in reverse order
+	_on, _rel, _type := ps.pop3()
+
+	on := _on.comp.(Expression)
+	joinType := _type.comp.(JoinType)
+
+	if table, ok := _rel.comp.(StreamIdentifier); ok {
+		ps.PushComponent(_rel.begin, _on.end, JoinAST{joinType, string(table), on})
+		return
+	}
+	ps.PushComponent(_rel.begin, _rel.end, _rel.comp.(AliasedStreamWindowAST))
+	ps.PushComponent(_on.begin, _on.end, JoinAST{joinType, "", on})
+}
+
 // AssembleInterval takes the topmost elements from the stack, assuming
 // they are components of a RANGE clause, and replaces them by
 // a single IntervalAST element.
@@ -703,6 +785,33 @@ func (ps *parseStack) AssembleInterval() {
 	ps.PushComponent(_num.begin, _unit.end, IntervalAST{FloatLiteral{val}, unit})
 }
 
+// AssembleIntervalLiteral takes the topmost elements from the stack,
+// assuming they are components of an INTERVAL literal, and replaces
+// them by a single IntervalLiteral element.
+//
+//  IntervalUnit
+//  NumericLiteral or FloatLiteral
+//   =>
+//  IntervalLiteral{Value, IntervalUnit}
+func (ps *parseStack) AssembleIntervalLiteral() {
+	// pop the components from the stack in reverse order
+	_unit, _num := ps.pop2()
+
+	// extract and convert the contained structure
+	// (if this fails, this is a fundamental parser bug => panic ok)
+	unit := _unit.comp.(IntervalUnit)
+	var val float64
+	if num, ok := _num.comp.(NumericLiteral); ok {
+		val = float64(num.Value)
+	} else {
+		num := _num.comp.(FloatLiteral)
+		val = num.Value
+	}
+
+	// assemble the IntervalLiteral and push it back
+	ps.PushComponent(_num.begin, _unit.end, IntervalLiteral{val, unit})
+}
+
 /* WHERE clause */
 
 // AssembleFilter takes the expression on top of the stack
@@ -778,6 +887,52 @@ func (ps *parseStack) AssembleHaving(begin int, end int) {
 	}
 }
 
+/* ORDER BY clause */
+
+// AssembleOrdering takes the elements from the stack that
+// correspond to the input[begin:end] string and wraps an
+// OrderingAST struct around them. If there are no such elements,
+// adds an empty OrderingAST struct to the stack.
+//
+//  SortedExpressionAST
+//  SortedExpressionAST
+//   =>
+//  OrderingAST{[SortedExpressionAST, SortedExpressionAST]}
+func (ps *parseStack) AssembleOrdering(begin int, end int) {
+	elems := ps.collectElements(begin, end)
+	var exprs []SortedExpressionAST
+	if len(elems) > 0 {
+		exprs = make([]SortedExpressionAST, len(elems))
+	}
+	for i := range elems {
+		exprs[i] = elems[i].(SortedExpressionAST)
+	}
+	ps.PushComponent(begin, end, OrderingAST{exprs})
+}
+
+/* LIMIT clause */
+
+// AssembleLimit takes the elements from the stack that correspond
+// to the input[begin:end] string and wraps a LimitAST struct around
+// them. If there is no LIMIT clause, an empty LimitAST struct is used.
+//
+//  NumericLiteral (optional, OFFSET)
+//  NumericLiteral
+//   =>
+//  LimitAST{true, NumericLiteral, NumericLiteral}
+func (ps *parseStack) AssembleLimit(begin int, end int) {
+	elems := ps.collectElements(begin, end)
+	if len(elems) == 0 {
+		ps.PushComponent(begin, end, LimitAST{})
+		return
+	}
+	limit := LimitAST{HasLimit: true, Limit: elems[0].(NumericLiteral).Value}
+	if len(elems) > 1 {
+		limit.Offset = elems[1].(NumericLiteral).Value
+	}
+	ps.PushComponent(begin, end, limit)
+}
+
 // AssembleAliasedStreamWindow takes the topmost elements from the stack, assuming
 // they are components of an AS clause, and replaces them by
 // a single AliasedStreamWindowAST element.
@@ -792,6 +947,10 @@ func (ps *parseStack) AssembleAliasedStreamWindow() {
 
 	name := _name.comp.(Identifier)
 	rel := _rel.comp.(StreamWindowAST)
+	if rel.Type == SubqueryStream && rel.Name == "" {
+		// a subquery is referred to by its alias
+		rel.Name = string(name)
+	}
 
 	ps.PushComponent(_rel.begin, _name.end, AliasedStreamWindowAST{rel, string(name)})
 }
@@ -819,21 +978,62 @@ func (ps *parseStack) EnsureAliasedStreamWindow() {
 // they are components of an AS clause, and replaces them by
 // a single StreamWindowAST element.
 //
+//  SheddingOption
+//  NumericLiteral
+//  WatermarkAST
+//  IntervalAST
+//  IntervalAST
 //  IntervalAST
 //  Stream
 //   =>
-//  StreamWindowAST{Stream, IntervalAST}
+//  StreamWindowAST{Stream, IntervalAST, IntervalAST, IntervalAST, WatermarkAST, NumericLiteral, SheddingOption}
 func (ps *parseStack) AssembleStreamWindow() {
 	// pop the components from the stack in reverse order
-	_shedding, _capacity, _range, _rel := ps.pop4()
+	_shedding, _capacity, _watermark, _gap, _slide, _range, _rel := ps.pop7()
 
 	rel := _rel.comp.(Stream)
 	rangeAst := _range.comp.(IntervalAST)
+	slide := _slide.comp.(IntervalAST)
+	gap := _gap.comp.(IntervalAST)
+	watermark := _watermark.comp.(WatermarkAST)
 	capacity := _capacity.comp.(NumericLiteral)
 	shedding := _shedding.comp.(SheddingOption)
 
 	ps.PushComponent(_rel.begin, _shedding.end, StreamWindowAST{rel, rangeAst,
-		capacity.Value, shedding})
+		slide, gap, watermark, capacity.Value, shedding})
+}
+
+// AssembleRangeWindow pushes an IntervalAST with an unspecified unit
+// as the session gap of a RANGE window, so that the stack has the
+// same layout as after AssembleSessionWindow.
+//
+//  IntervalAST
+//  IntervalAST
+//   =>
+//  IntervalAST
+//  IntervalAST
+//  IntervalAST{FloatLiteral{0}, UnspecifiedIntervalUnit}
+func (ps *parseStack) AssembleRangeWindow() {
+	top := ps.Peek()
+	ps.PushComponent(top.end, top.end, IntervalAST{FloatLiteral{0}, UnspecifiedIntervalUnit})
+}
+
+// AssembleSessionWindow takes the topmost element from the stack,
+// assuming it is the gap of a SESSION clause, and pushes IntervalAST
+// elements with an unspecified unit for the RANGE and SLIDE clauses
+// below it.
+//
+//  IntervalAST
+//   =>
+//  IntervalAST{FloatLiteral{0}, UnspecifiedIntervalUnit}
+//  IntervalAST{FloatLiteral{0}, UnspecifiedIntervalUnit}
+//  IntervalAST
+func (ps *parseStack) AssembleSessionWindow() {
+	_gap := ps.Pop()
+	unspecified := IntervalAST{FloatLiteral{0}, UnspecifiedIntervalUnit}
+	ps.PushComponent(_gap.begin, _gap.begin, unspecified)
+	ps.PushComponent(_gap.begin, _gap.begin, unspecified)
+	ps.Push(_gap)
 }
 
 // AssembleUDSFFuncApp takes the topmost elements from the stack,
@@ -849,10 +1049,175 @@ func (ps *parseStack) AssembleUDSFFuncApp() {
 	fun := _fun.comp.(FuncAppAST)
 
 	se := ParsedComponent{_fun.begin, _fun.end,
-		Stream{UDSFStream, string(fun.Function), fun.Expressions}}
+		Stream{UDSFStream, string(fun.Function), fun.Expressions, nil}}
 	ps.Push(&se)
 }
 
+// AssembleSubqueryStream takes the topmost element from the stack,
+// assuming it is the SELECT statement of a subquery in a FROM clause,
+// and replaces it by a single Stream element. The name of the stream
+// is set to the alias of the subquery later.
+//
+//  SelectStmt
+//   =>
+//  Stream{SubqueryStream, "", nil, &SelectStmt}
+func (ps *parseStack) AssembleSubqueryStream(begin int, end int) {
+	_select := ps.Pop()
+
+	sel := _select.comp.(SelectStmt)
+
+	ps.PushComponent(begin, end, Stream{SubqueryStream, "", nil, &sel})
+}
+
+// AssembleCommonTableExpression takes the topmost elements from the
+// stack, assuming they are components of a common table expression
+// in a WITH clause, and replaces them by a single
+// CommonTableExpressionAST element.
+//
+//  SelectStmt
+//  StreamIdentifier
+//   =>
+//  CommonTableExpressionAST{StreamIdentifier, SelectStmt}
+func (ps *parseStack) AssembleCommonTableExpression() {
+	_select, _name := ps.pop2()
+
+	sel := _select.comp.(SelectStmt)
+	name := _name.comp.(StreamIdentifier)
+
+	ps.PushComponent(_name.begin, _select.end, CommonTableExpressionAST{name, sel})
+}
+
+// AssembleWithSelect takes the elements from the stack that correspond
+// to the input[begin:end] string, assuming they are the common table
+// expressions of a WITH clause followed by a SELECT statement, and
+// replaces them by the SELECT statement in which every relation
+// referring to a common table expression is replaced by a subquery.
+// A common table expression can refer to the ones defined before it.
+//
+//  SelectStmt
+//  CommonTableExpressionAST
+//  CommonTableExpressionAST
+//   =>
+//  SelectStmt
+func (ps *parseStack) AssembleWithSelect(begin int, end int) {
+	elems := ps.collectElements(begin, end)
+	ctes := map[string]*SelectStmt{}
+	for _, elem := range elems[:len(elems)-1] {
+		cte := elem.(CommonTableExpressionAST)
+		sel := resolveCommonTableExpressions(cte.Select, ctes)
+		ctes[string(cte.Name)] = &sel
+	}
+	sel := resolveCommonTableExpressions(elems[len(elems)-1].(SelectStmt), ctes)
+	ps.PushComponent(begin, end, sel)
+}
+
+// resolveCommonTableExpressions replaces every relation of a SELECT
+// statement, including the ones of its subqueries, that refers to a
+// common table expression by a subquery computing it.
+func resolveCommonTableExpressions(s SelectStmt, ctes map[string]*SelectStmt) SelectStmt {
+	rels := make([]AliasedStreamWindowAST, len(s.Relations))
+	for i, rel := range s.Relations {
+		switch rel.Type {
+		case ActualStream:
+			if cte, ok := ctes[rel.Name]; ok {
+				rel.Stream = Stream{SubqueryStream, rel.Name, nil, cte}
+			}
+		case SubqueryStream:
+			sub := resolveCommonTableExpressions(*rel.Subquery, ctes)
+			rel.Subquery = &sub
+		}
+		rels[i] = rel
+	}
+	s.Relations = rels
+	return s
+}
+
+// EnsureSlideSpec makes sure that the top element of the stack
+// is the IntervalAST element of the SLIDE clause. If there is no
+// SLIDE clause, an IntervalAST with an unspecified unit is pushed.
+func (ps *parseStack) EnsureSlideSpec(begin int, end int) {
+	ps.ensureIntervalSpec(begin, end)
+}
+
+// AssembleWatermark takes the topmost elements from the stack, assuming
+// they are components of a WATERMARK clause, and replaces them by
+// a single WatermarkAST element.
+//
+//  StreamIdentifier
+//  IntervalAST
+//  IntervalAST
+//   =>
+//  WatermarkAST{IntervalAST, IntervalAST, StreamIdentifier}
+func (ps *parseStack) AssembleWatermark() {
+	// pop the components from the stack in reverse order
+	_late, _lateness, _delay := ps.pop3()
+
+	delay := _delay.comp.(IntervalAST)
+	lateness := _lateness.comp.(IntervalAST)
+	late := _late.comp.(StreamIdentifier)
+
+	ps.PushComponent(_delay.begin, _late.end, WatermarkAST{delay, lateness, late})
+}
+
+// EnsureWatermarkSpec makes sure that the top element of the stack
+// is a WatermarkAST element.
+func (ps *parseStack) EnsureWatermarkSpec(begin int, end int) {
+	top := ps.Peek()
+	if top == nil || top.end <= begin {
+		// there is no item in the given range
+		ps.PushComponent(begin, end, WatermarkAST{})
+	} else {
+		// there is an item in the given range
+		_, ok := top.comp.(WatermarkAST)
+		if !ok {
+			panic(fmt.Sprintf("begin (%d) != end (%d), but there "+
+				"was a %T on the stack", begin, end, top.comp))
+		}
+	}
+}
+
+// EnsureAllowedLatenessSpec makes sure that the top element of the
+// stack is the IntervalAST element of the ALLOWED LATENESS clause.
+func (ps *parseStack) EnsureAllowedLatenessSpec(begin int, end int) {
+	ps.ensureIntervalSpec(begin, end)
+}
+
+// EnsureLateTuplesSpec makes sure that the top element of the stack
+// is the StreamIdentifier element of the LATE TUPLES TO clause. If
+// there is no such clause, an empty StreamIdentifier is pushed.
+func (ps *parseStack) EnsureLateTuplesSpec(begin int, end int) {
+	top := ps.Peek()
+	if top == nil || top.end <= begin {
+		// there is no item in the given range
+		ps.PushComponent(begin, end, StreamIdentifier(""))
+	} else {
+		// there is an item in the given range
+		_, ok := top.comp.(StreamIdentifier)
+		if !ok {
+			panic(fmt.Sprintf("begin (%d) != end (%d), but there "+
+				"was a %T on the stack", begin, end, top.comp))
+		}
+	}
+}
+
+// ensureIntervalSpec makes sure that the top element of the stack
+// is an IntervalAST element in the given range. If there is none,
+// an IntervalAST with an unspecified unit is pushed.
+func (ps *parseStack) ensureIntervalSpec(begin int, end int) {
+	top := ps.Peek()
+	if top == nil || top.end <= begin {
+		// there is no item in the given range
+		ps.PushComponent(begin, end, IntervalAST{FloatLiteral{0}, UnspecifiedIntervalUnit})
+	} else {
+		// there is an item in the given range
+		_, ok := top.comp.(IntervalAST)
+		if !ok {
+			panic(fmt.Sprintf("begin (%d) != end (%d), but there "+
+				"was a %T on the stack", begin, end, top.comp))
+		}
+	}
+}
+
 // EnsureCapacitySpec makes sure that the top element of the stack
 // is a NumericLiteral element.
 func (ps *parseStack) EnsureCapacitySpec(begin int, end int) {
@@ -1027,6 +1392,12 @@ func (ps *parseStack) EnsureKeywordPresent(begin int, end int) {
 //  BinaryOpAST{Operator, BinaryOpAST{Operator, Any, Any}, Any}
 func (ps *parseStack) AssembleBinaryOperation(begin int, end int) {
 	elems := ps.collectElements(begin, end)
+	ps.pushBinaryOperation(begin, end, elems)
+}
+
+// pushBinaryOperation connects the given elements, which were taken
+// from the stack by AssembleBinaryOperation, and pushes the result.
+func (ps *parseStack) pushBinaryOperation(begin int, end int, elems []interface{}) {
 	if len(elems) == 1 {
 		// there is no "binary" operation, push back the single element
 		ps.PushComponent(begin, end, elems[0])
@@ -1048,6 +1419,37 @@ func (ps *parseStack) AssembleBinaryOperation(begin int, end int) {
 	}
 }
 
+// AssembleComparison takes the elements from the stack that correspond
+// to the input[begin:end] string and connects them with the comparison
+// operator between them. If there is just one element, push it back
+// unmodified.
+//
+//  Any
+//   =>
+//  Any
+// or
+//  Any
+//  Operator
+//  Any
+//   =>
+//  BinaryOpAST{Operator, Any, Any}
+// or
+//  Any
+//  Operator (Between or NotBetween)
+//  Any
+//  Any
+//   =>
+//  BetweenAST{Operator, Any, Any, Any}
+func (ps *parseStack) AssembleComparison(begin int, end int) {
+	elems := ps.collectElements(begin, end)
+	if len(elems) == 4 {
+		ps.PushComponent(begin, end, BetweenAST{elems[1].(Operator),
+			elems[0].(Expression), elems[2].(Expression), elems[3].(Expression)})
+		return
+	}
+	ps.pushBinaryOperation(begin, end, elems)
+}
+
 // AssembleUnaryPrefixOperation takes the two elements from the stack that
 // correspond to the input[begin:end] string and adds the given
 // unary operator. If there is just one element, push it back unmodified.
@@ -1132,6 +1534,123 @@ func (ps *parseStack) AssembleFuncApp() {
 	ps.PushComponent(_funcName.begin, _exprs.end, FuncAppAST{funcName, exprs, orderExprs})
 }
 
+// AssembleExtract takes the topmost elements from the stack, assuming
+// they are components of an `EXTRACT(field FROM expr)` expression, and
+// replaces them by a single FuncAppAST element that applies the
+// date_part function.
+//
+//  Expression
+//  Identifier
+//   =>
+//  FuncAppAST{"date_part", {StringLiteral{Identifier}, Expression}}
+func (ps *parseStack) AssembleExtract() {
+	_expr, _field := ps.pop2()
+
+	expr := _expr.comp.(Expression)
+	field := _field.comp.(Identifier)
+
+	exprs := ExpressionsAST{[]Expression{
+		StringLiteral{strings.ToLower(string(field))}, expr}}
+	ps.PushComponent(_field.begin, _expr.end, FuncAppAST{"date_part", exprs, nil})
+}
+
+// AssembleWindowFuncApp takes the topmost elements from the stack,
+// assuming they are components of a function application with an
+// OVER clause, and replaces them by a single WindowFuncAppAST element.
+//
+//  ExpressionsAST (ORDER BY)
+//  ExpressionsAST (PARTITION BY)
+//  FuncAppAST
+//   =>
+//  WindowFuncAppAST{FuncAppAST, [Expression...], [SortedExpressionAST...]}
+func (ps *parseStack) AssembleWindowFuncApp() {
+	_ordering, _partition, _funcApp := ps.pop3()
+
+	// extract and convert the contained structure
+	// (if this fails, this is a fundamental parser bug => panic ok)
+	ordering := _ordering.comp.(ExpressionsAST)
+	partition := _partition.comp.(ExpressionsAST)
+	funcApp := _funcApp.comp.(FuncAppAST)
+
+	var partitionExprs []Expression
+	if len(partition.Expressions) > 0 {
+		partitionExprs = partition.Expressions
+	}
+	var orderExprs []SortedExpressionAST
+	if len(ordering.Expressions) > 0 {
+		orderExprs = make([]SortedExpressionAST, len(ordering.Expressions))
+		for i, e := range ordering.Expressions {
+			orderExprs[i] = e.(SortedExpressionAST)
+		}
+	}
+
+	ps.PushComponent(_funcApp.begin, _ordering.end,
+		WindowFuncAppAST{funcApp, partitionExprs, orderExprs})
+}
+
+// AssembleLambdaParams takes the elements from the stack that
+// correspond to the input[begin:end] string, assuming they are the
+// parameters of a lambda expression, and replaces them by a single
+// LambdaAST element without a body. Until the lambda expression is
+// assembled, references to the parameters are pushed as LambdaVariable
+// by AssembleRowValue.
+//
+//  Identifier
+//  Identifier
+//   =>
+//  LambdaAST{[Identifier, Identifier], nil}
+func (ps *parseStack) AssembleLambdaParams(begin int, end int) {
+	elems := ps.collectElements(begin, end)
+	params := make([]string, len(elems))
+	for i := range elems {
+		params[i] = string(elems[i].(Identifier))
+	}
+	ps.lambdaScopes = append(ps.lambdaScopes, params)
+	ps.PushComponent(begin, end, LambdaAST{params, nil})
+}
+
+// AssembleLambda takes the topmost elements from the stack, assuming
+// they are components of a lambda expression, and replaces them by
+// a single LambdaAST element.
+//
+//  Expression
+//  LambdaAST (without body)
+//   =>
+//  LambdaAST{[Identifier...], Expression}
+func (ps *parseStack) AssembleLambda() {
+	_body, _lambda := ps.pop2()
+
+	body := _body.comp.(Expression)
+	lambda := _lambda.comp.(LambdaAST)
+	lambda.Body = body
+
+	ps.lambdaScopes = ps.lambdaScopes[:len(ps.lambdaScopes)-1]
+	ps.PushComponent(_lambda.begin, _body.end, lambda)
+}
+
+// AssembleRowValue pushes a RowValue for the given column reference,
+// or a LambdaVariable if the reference is not qualified by a relation
+// and refers to a parameter of an enclosing lambda expression.
+func (ps *parseStack) AssembleRowValue(begin int, end int, substr string) {
+	rv := NewRowValue(substr)
+	if rv.Relation == "" {
+		name := rv.Column
+		if i := strings.IndexAny(name, ".["); i >= 0 {
+			name = name[:i]
+		}
+		for i := len(ps.lambdaScopes) - 1; i >= 0; i-- {
+			for _, param := range ps.lambdaScopes[i] {
+				if param == name {
+					ps.PushComponent(begin, end,
+						LambdaVariable{name, rv.Column[len(name):]})
+					return
+				}
+			}
+		}
+	}
+	ps.PushComponent(begin, end, rv)
+}
+
 // AssembleSortedExpression takes the topmost elements from the stack,
 // assuming they are components of an ORDER BY clause, and replaces
 // them by a single SortedExpressionAST element.
@@ -9,8 +9,10 @@ import (
 	"gopkg.in/sensorbee/sensorbee.v0/core"
 	"gopkg.in/sensorbee/sensorbee.v0/data"
 	"math"
+	"strings"
 	"sync"
 	"sync/atomic"
+	"time"
 )
 
 type TopologyBuilder struct {
@@ -21,6 +23,13 @@ type TopologyBuilder struct {
 	SourceCreators SourceCreatorRegistry
 	SinkCreators   SinkCreatorRegistry
 	UDSStorage     udf.UDSStorage
+
+	// CheckpointInterval is the interval at which the window states of
+	// streams created by this TopologyBuilder are saved to UDSStorage.
+	// A saved state is restored when a stream having the same name and
+	// the same SELECT statement is created again, e.g., after restarting
+	// the topology. Checkpoints are disabled when it's 0.
+	CheckpointInterval time.Duration
 }
 
 // TODO: Provide AtomicTopologyBuilder which support building multiple nodes
@@ -121,7 +130,10 @@ func (tb *TopologyBuilder) AddStmt(stmt interface{}) (core.Node, error) {
 		})
 
 	case parser.CreateStreamAsSelectStmt:
-		return tb.createStreamAsSelectStmt(&stmt)
+		return tb.createStreamAsSelectStmt(&stmt, false)
+
+	case parser.CreateOrReplaceStreamAsSelectStmt:
+		return tb.createOrReplaceStreamAsSelectStmt(&stmt)
 
 	case parser.CreateStreamAsSelectUnionStmt:
 		// idea: create an intermediate box for each SELECT substatement,
@@ -137,10 +149,10 @@ func (tb *TopologyBuilder) AddStmt(stmt interface{}) (core.Node, error) {
 			// create a stream with a generated name and recurse
 			tmpName := fmt.Sprintf("sensorbee_tmp_%v", topologyBuilderNextTemporaryID())
 			tmpStmt := parser.CreateStreamAsSelectStmt{
-				parser.StreamIdentifier(tmpName),
-				selStmt,
+				Name:   parser.StreamIdentifier(tmpName),
+				Select: selStmt,
 			}
-			box, err := tb.AddStmt(tmpStmt)
+			box, err := tb.createStreamAsSelectStmt(&tmpStmt, true)
 			if err != nil {
 				removeTmpNodes()
 				return nil, err
@@ -176,6 +188,12 @@ func (tb *TopologyBuilder) AddStmt(stmt interface{}) (core.Node, error) {
 		// load params into map for faster access
 		paramsMap := tb.mkParamsMap(stmt.Params)
 
+		// error handling parameters are common to all types of sinks
+		policy, dlqName, err := mkErrorPolicy(paramsMap)
+		if err != nil {
+			return nil, err
+		}
+
 		// check if we know this type of sink
 		creator, err := tb.SinkCreators.Lookup(string(stmt.Type))
 		if err != nil {
@@ -190,10 +208,24 @@ func (tb *TopologyBuilder) AddStmt(stmt interface{}) (core.Node, error) {
 		if err != nil {
 			return nil, err
 		}
+
+		removeDLQ, err := tb.setUpDeadLetterQueue(policy, dlqName)
+		if err != nil {
+			sink.Close(tb.topology.Context())
+			return nil, err
+		}
+
 		// we insert a sink, but cannot connect it to
 		// any streams yet, therefore we have to keep track
 		// of the SinkDeclarer
-		return tb.topology.AddSink(string(stmt.Name), sink, nil)
+		node, err := tb.topology.AddSink(string(stmt.Name), sink, &core.SinkConfig{
+			ErrorPolicy: policy,
+		})
+		if err != nil {
+			removeDLQ()
+			return nil, err
+		}
+		return node, nil
 
 	case parser.CreateStateStmt:
 		c, err := tb.UDSCreators.Lookup(string(stmt.Type))
@@ -275,10 +307,17 @@ func (tb *TopologyBuilder) AddStmt(stmt interface{}) (core.Node, error) {
 		return nil, tb.topology.Remove(string(stmt.Source))
 
 	case parser.DropStreamStmt:
-		_, err := tb.topology.Box(string(stmt.Stream))
+		bn, err := tb.topology.Box(string(stmt.Stream))
 		if err != nil {
 			return nil, err
 		}
+		if b, ok := bn.Box().(*bqlBox); ok {
+			// the state of a dropped stream must not be restored
+			if err := b.dropCheckpoint(); err != nil {
+				tb.topology.Context().ErrLog(err).WithField("node_name", stmt.Stream).
+					Error("Cannot clear the checkpoint of the stream")
+			}
+		}
 
 		return nil, tb.topology.Remove(string(stmt.Stream))
 
@@ -406,20 +445,119 @@ func (s *udsfSource) Stop(ctx *core.Context) error {
 	return s.f.Terminate(ctx)
 }
 
-func (tb *TopologyBuilder) createStreamAsSelectStmt(stmt *parser.CreateStreamAsSelectStmt) (core.Node, error) {
+// lateTupleSource is a core.Source which emits the tuples that were too
+// late to be processed by a bqlBox. It is created for the stream specified
+// in the LATE TUPLES TO clause of a window and is also the core.Writer
+// passed to the bqlBox.
+type lateTupleSource struct {
+	ch       chan *core.Tuple
+	stopCh   chan struct{}
+	stopOnce sync.Once
+}
+
+var (
+	_ core.Source = &lateTupleSource{}
+	_ core.Writer = &lateTupleSource{}
+)
+
+func newLateTupleSource() *lateTupleSource {
+	return &lateTupleSource{
+		ch:     make(chan *core.Tuple),
+		stopCh: make(chan struct{}),
+	}
+}
+
+func (s *lateTupleSource) GenerateStream(ctx *core.Context, w core.Writer) error {
+	for {
+		select {
+		case <-s.stopCh:
+			return nil
+		case t := <-s.ch:
+			if err := w.Write(ctx, t); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// Write passes the tuple to GenerateStream. Tuples written after the source
+// was stopped are discarded.
+func (s *lateTupleSource) Write(ctx *core.Context, t *core.Tuple) error {
+	select {
+	case <-s.stopCh:
+		return nil
+	case s.ch <- t:
+		return nil
+	}
+}
+
+func (s *lateTupleSource) Stop(ctx *core.Context) error {
+	s.stopOnce.Do(func() {
+		close(s.stopCh)
+	})
+	return nil
+}
+
+// createStreamAsSelectStmt creates a stream executing the SELECT statement.
+// temporary must be true when the stream is created internally, e.g., for
+// a subquery, and isn't named by a user.
+func (tb *TopologyBuilder) createStreamAsSelectStmt(stmt *parser.CreateStreamAsSelectStmt, temporary bool) (core.Node, error) {
+	// error handling parameters are the only parameters of a stream
+	paramsMap := tb.mkParamsMap(stmt.Params)
+	policy, dlqName, err := mkErrorPolicy(paramsMap)
+	if err != nil {
+		return nil, err
+	}
+	for k := range paramsMap {
+		return nil, fmt.Errorf("unknown parameter of a stream: %v", k)
+	}
+
 	// insert a bqlBox that executes the SELECT statement
 	outName := string(stmt.Name)
-	box := NewBQLBox(&stmt.Select, tb.Reg)
+	box := tb.newBQLBox(outName, &stmt.Select, temporary)
+
+	// create the side stream receiving tuples that are too late for
+	// their window (all relations have the same WATERMARK specification)
+	lateName := ""
+	if len(stmt.Select.Relations) > 0 {
+		lateName = string(stmt.Select.Relations[0].Watermark.LateStream)
+	}
+	if lateName != "" {
+		if lateName == outName {
+			return nil, fmt.Errorf("late tuples cannot be written to the stream itself")
+		}
+		src := newLateTupleSource()
+		if _, err := tb.topology.AddSource(lateName, src, nil); err != nil {
+			return nil, err
+		}
+		box.lateWriter = src
+		box.removeLateStream = func() { go tb.topology.Remove(lateName) }
+	}
+
+	removeDLQ, err := tb.setUpDeadLetterQueue(policy, dlqName)
+	if err != nil {
+		if lateName != "" {
+			tb.topology.Remove(lateName)
+		}
+		return nil, err
+	}
+
 	// add all the referenced relations as named inputs
-	dbox, err := tb.topology.AddBox(outName, box, nil)
+	dbox, err := tb.topology.AddBox(outName, box, &core.BoxConfig{
+		ErrorPolicy: policy,
+	})
 	if err != nil {
+		if lateName != "" {
+			tb.topology.Remove(lateName)
+		}
+		removeDLQ()
 		return nil, err
 	}
 	// provide a function to the BQL box to remove itself from the topology
 	box.removeMe = func() { go tb.topology.Remove(outName) }
 
 	removeNodes := true
-	var temporaryNodes []string
+	temporaryNodes, pausedSources, err := tb.connectRelations(dbox, &stmt.Select)
 	defer func() {
 		if !removeNodes {
 			return
@@ -429,11 +567,160 @@ func (tb *TopologyBuilder) createStreamAsSelectStmt(stmt *parser.CreateStreamAsS
 			tb.topology.Remove(n)
 		}
 		tb.topology.Remove(outName)
+		removeDLQ()
 	}()
+	if err != nil {
+		return nil, err
+	}
+	dbox.(core.BoxNode).StopOnDisconnect(core.Inbound)
+
+	// Resume all UDSFs running in the source mode as fairly as possible.
+	for _, sn := range pausedSources {
+		if err := sn.Resume(); err != nil {
+			return nil, err
+		}
+	}
+	removeNodes = false
+	return dbox, nil
+}
+
+// newBQLBox creates a bqlBox executing the SELECT statement of the stream
+// having the given name. A temporary stream doesn't save checkpoints since
+// it's never created again with the same name.
+func (tb *TopologyBuilder) newBQLBox(name string, stmt *parser.SelectStmt, temporary bool) *bqlBox {
+	box := NewBQLBox(stmt, tb.Reg)
+	if tb.CheckpointInterval > 0 && !temporary {
+		box.checkpoint = newBoxCheckpoint(tb.UDSStorage, tb.topology.Name(), name, tb.CheckpointInterval)
+	}
+	return box
+}
 
+// createOrReplaceStreamAsSelectStmt replaces the SELECT statement executed by
+// an existing stream. The new bqlBox takes over all connections to the nodes
+// reading from the stream, so they don't get disconnected. The stream keeps
+// its error policy unless error handling parameters are given. When the
+// stream doesn't exist, it's created in the same way as CREATE STREAM.
+func (tb *TopologyBuilder) createOrReplaceStreamAsSelectStmt(stmt *parser.CreateOrReplaceStreamAsSelectStmt) (core.Node, error) {
+	outName := string(stmt.Name)
+	bn, err := tb.topology.Box(outName)
+	if err != nil {
+		if core.IsNotExist(err) {
+			return tb.createStreamAsSelectStmt(&parser.CreateStreamAsSelectStmt{
+				Name:               stmt.Name,
+				Select:             stmt.Select,
+				SourceSinkSpecsAST: stmt.SourceSinkSpecsAST,
+			}, false)
+		}
+		return nil, err
+	}
+
+	paramsMap := tb.mkParamsMap(stmt.Params)
+	policy, dlqName, err := mkErrorPolicy(paramsMap)
+	if err != nil {
+		return nil, err
+	}
+	for k := range paramsMap {
+		return nil, fmt.Errorf("unknown parameter of a stream: %v", k)
+	}
+
+	box := tb.newBQLBox(outName, &stmt.Select, false)
+	box.removeMe = func() { go tb.topology.Remove(outName) }
+	if box.checkpoint != nil {
+		// the checkpoint belongs to the old box until it's replaced
+		box.checkpoint.disabled = true
+	}
+
+	// When the new statement writes late tuples to the same stream as the
+	// old one, the stream is taken over by the new box. Otherwise, the old
+	// stream is removed when the old box is terminated.
+	old, _ := bn.Box().(*bqlBox)
+	lateName, oldLateName := "", ""
+	if len(stmt.Select.Relations) > 0 {
+		lateName = string(stmt.Select.Relations[0].Watermark.LateStream)
+	}
+	if old != nil && len(old.stmt.Relations) > 0 {
+		oldLateName = string(old.stmt.Relations[0].Watermark.LateStream)
+	}
+	reuseLate := lateName != "" && lateName == oldLateName
+	if lateName != "" {
+		if lateName == outName {
+			return nil, fmt.Errorf("late tuples cannot be written to the stream itself")
+		}
+		if reuseLate {
+			box.lateWriter = old.lateWriter
+		} else {
+			src := newLateTupleSource()
+			if _, err := tb.topology.AddSource(lateName, src, nil); err != nil {
+				return nil, err
+			}
+			box.lateWriter = src
+			box.removeLateStream = func() { go tb.topology.Remove(lateName) }
+		}
+	}
+
+	removeDLQ, err := tb.setUpDeadLetterQueue(policy, dlqName)
+	if err != nil {
+		if lateName != "" && !reuseLate {
+			tb.topology.Remove(lateName)
+		}
+		return nil, err
+	}
+
+	var (
+		temporaryNodes []string
+		pausedSources  []core.SourceNode
+	)
+	node, err := tb.topology.ReplaceBox(outName, box, &core.BoxReplacementConfig{
+		ErrorPolicy: policy,
+	}, func(bn core.BoxNode) error {
+		var err error
+		temporaryNodes, pausedSources, err = tb.connectRelations(bn, &stmt.Select)
+		if err != nil {
+			return err
+		}
+		if reuseLate {
+			box.removeLateStream, old.removeLateStream = old.removeLateStream, nil
+		}
+		if old != nil {
+			// the old box must not overwrite the checkpoint of the new
+			// box when it's terminated
+			old.setCheckpointEnabled(false)
+		}
+		return nil
+	})
+	if err != nil {
+		if old != nil {
+			old.setCheckpointEnabled(true)
+		}
+		for _, n := range temporaryNodes {
+			tb.topology.Remove(n)
+		}
+		if lateName != "" && !reuseLate {
+			tb.topology.Remove(lateName)
+		}
+		removeDLQ()
+		return nil, err
+	}
+
+	box.setCheckpointEnabled(true)
+
+	// Resume all UDSFs running in the source mode as fairly as possible.
+	for _, sn := range pausedSources {
+		if err := sn.Resume(); err != nil {
+			return nil, err
+		}
+	}
+	return node, nil
+}
+
+// connectRelations adds all the relations referenced in the SELECT statement
+// as named inputs of the Box. It returns the names of temporary nodes created
+// for UDSFs or subqueries, which have to be removed by the caller when it
+// fails to set up the stream, even when an error is returned. UDSFs running in
+// the source mode are returned in the paused state.
+func (tb *TopologyBuilder) connectRelations(dbox core.BoxNode, stmt *parser.SelectStmt) (temporaryNodes []string, pausedSources []core.SourceNode, err error) {
 	connected := map[string]bool{}
-	var pausedSources []core.SourceNode
-	for _, rel := range stmt.Select.Relations {
+	for _, rel := range stmt.Relations {
 		switch rel.Type {
 		case parser.ActualStream:
 			if connected[rel.Name] {
@@ -472,35 +759,33 @@ func (tb *TopologyBuilder) createStreamAsSelectStmt(stmt *parser.CreateStreamAsS
 				conf.DropMode = core.DropNone
 			}
 			if err := dbox.Input(rel.Name, conf); err != nil {
-				return nil, err
+				return temporaryNodes, nil, err
 			}
 			connected[rel.Name] = true
 
 		case parser.UDSFStream:
 			sn, name, err := tb.setUpUDSFStream(dbox, &rel)
 			if err != nil {
-				return nil, err
+				return temporaryNodes, nil, err
 			}
 			temporaryNodes = append(temporaryNodes, name)
 			if sn != nil {
 				pausedSources = append(pausedSources, sn)
 			}
 
+		case parser.SubqueryStream:
+			name, err := tb.setUpSubqueryStream(dbox, &rel)
+			if err != nil {
+				return temporaryNodes, nil, err
+			}
+			temporaryNodes = append(temporaryNodes, name)
+
 		default:
-			return nil, fmt.Errorf("input stream of type %s not implemented",
+			return temporaryNodes, nil, fmt.Errorf("input stream of type %s not implemented",
 				rel.Type)
 		}
 	}
-	dbox.(core.BoxNode).StopOnDisconnect(core.Inbound)
-
-	// Resume all UDSFs running in the source mode as fairly as possible.
-	for _, sn := range pausedSources {
-		if err := sn.Resume(); err != nil {
-			return nil, err
-		}
-	}
-	removeNodes = false
-	return dbox, nil
+	return temporaryNodes, pausedSources, nil
 }
 
 // setUpUDSFStream creates a Source or a Box from a UDSF. When it creates a
@@ -548,40 +833,9 @@ func (tb *TopologyBuilder) setUpUDSFStream(subsequentBox core.BoxNode, rel *pars
 
 	temporaryName := fmt.Sprintf("sensorbee_tmp_udsf_%v", topologyBuilderNextTemporaryID())
 	addInput := func() error {
-		alias := rel.Alias
-		if alias == "" {
-			alias = rel.Name
-		}
-		conf := &core.BoxInputConfig{
-			// As opposed to actual streams, for `udsf("s") AS a, udsf("s") AS b`,
-			// there will be *multiple* boxes and we will have one connection to
-			// udsf 1 (the one aliased to `a`) and udsf 2 (the one aliased to `b`).
-			// Therefore we need to have different InputNames for them. Note that
-			// we cannot just take the alias, as there would be the danger of
-			// overriding an input stream with that same name, as in
-			// `FROM x AS b, udsf("s") AS x`, and we should also use a string
-			// that does not possibly conflict with any input name.
-			// Note that `addTupleToBuffer` in defaultSelectExecutionPlan needs
-			// to use that same method.
-			InputName: fmt.Sprintf("%s/%s", rel.Name, alias),
-		}
-		// set capacity of input pipe
-		if rel.Capacity != parser.UnspecifiedCapacity {
-			if rel.Capacity > math.MaxInt32 {
-				return fmt.Errorf("specified buffer capacity %d is too large", rel.Capacity)
-			} else if rel.Capacity < 0 {
-				// the parser should not allow this to happen, actually
-				return fmt.Errorf("specified buffer capacity %d must not be negative", rel.Capacity)
-			}
-			conf.Capacity = int(rel.Capacity)
-		}
-		// set drop mode for box
-		if rel.Shedding == parser.DropOldest {
-			conf.DropMode = core.DropOldest
-		} else if rel.Shedding == parser.DropNewest {
-			conf.DropMode = core.DropLatest
-		} else if rel.Shedding == parser.Wait {
-			conf.DropMode = core.DropNone
+		conf, err := temporaryRelationInputConfig(rel)
+		if err != nil {
+			return err
 		}
 		return subsequentBox.Input(temporaryName, conf)
 	}
@@ -603,7 +857,7 @@ func (tb *TopologyBuilder) setUpUDSFStream(subsequentBox core.BoxNode, rel *pars
 	}
 
 	bn, err := tb.topology.AddBox(temporaryName, newUDSFBox(udsf), &core.BoxConfig{
-	// TODO: add information of the statement
+		// TODO: add information of the statement
 	})
 	if err != nil {
 		return nil, "", err
@@ -623,6 +877,76 @@ func (tb *TopologyBuilder) setUpUDSFStream(subsequentBox core.BoxNode, rel *pars
 	return nil, temporaryName, nil
 }
 
+// setUpSubqueryStream creates an anonymous Box computing a subquery in a
+// FROM clause (or a common table expression referred to there) and
+// connects it to the given Box. It returns the temporary name of the Box,
+// which is stopped and removed when it gets disconnected.
+func (tb *TopologyBuilder) setUpSubqueryStream(subsequentBox core.BoxNode, rel *parser.AliasedStreamWindowAST) (string, error) {
+	conf, err := temporaryRelationInputConfig(rel)
+	if err != nil {
+		return "", err
+	}
+
+	temporaryName := fmt.Sprintf("sensorbee_tmp_subquery_%v", topologyBuilderNextTemporaryID())
+	node, err := tb.createStreamAsSelectStmt(&parser.CreateStreamAsSelectStmt{
+		Name:   parser.StreamIdentifier(temporaryName),
+		Select: *rel.Subquery,
+	}, true)
+	if err != nil {
+		return "", err
+	}
+	if err := subsequentBox.Input(temporaryName, conf); err != nil {
+		tb.topology.Remove(temporaryName)
+		return "", err
+	}
+	bn := node.(core.BoxNode)
+	bn.StopOnDisconnect(core.Inbound | core.Outbound)
+	bn.RemoveOnStop()
+	return temporaryName, nil
+}
+
+// temporaryRelationInputConfig returns the configuration of the input
+// connecting a temporary node created for a relation, such as a UDSF or
+// a subquery, to the Box executing the SELECT statement.
+func temporaryRelationInputConfig(rel *parser.AliasedStreamWindowAST) (*core.BoxInputConfig, error) {
+	alias := rel.Alias
+	if alias == "" {
+		alias = rel.Name
+	}
+	conf := &core.BoxInputConfig{
+		// As opposed to actual streams, for `udsf("s") AS a, udsf("s") AS b`,
+		// there will be *multiple* boxes and we will have one connection to
+		// udsf 1 (the one aliased to `a`) and udsf 2 (the one aliased to `b`).
+		// Therefore we need to have different InputNames for them. Note that
+		// we cannot just take the alias, as there would be the danger of
+		// overriding an input stream with that same name, as in
+		// `FROM x AS b, udsf("s") AS x`, and we should also use a string
+		// that does not possibly conflict with any input name.
+		// Note that `addTupleToBuffer` in defaultSelectExecutionPlan needs
+		// to use that same method.
+		InputName: fmt.Sprintf("%s/%s", rel.Name, alias),
+	}
+	// set capacity of input pipe
+	if rel.Capacity != parser.UnspecifiedCapacity {
+		if rel.Capacity > math.MaxInt32 {
+			return nil, fmt.Errorf("specified buffer capacity %d is too large", rel.Capacity)
+		} else if rel.Capacity < 0 {
+			// the parser should not allow this to happen, actually
+			return nil, fmt.Errorf("specified buffer capacity %d must not be negative", rel.Capacity)
+		}
+		conf.Capacity = int(rel.Capacity)
+	}
+	// set drop mode for box
+	if rel.Shedding == parser.DropOldest {
+		conf.DropMode = core.DropOldest
+	} else if rel.Shedding == parser.DropNewest {
+		conf.DropMode = core.DropLatest
+	} else if rel.Shedding == parser.Wait {
+		conf.DropMode = core.DropNone
+	}
+	return conf, nil
+}
+
 func (tb *TopologyBuilder) mkParamsMap(params []parser.SourceSinkParamAST) data.Map {
 	paramsMap := make(data.Map, len(params))
 	for _, kv := range params {
@@ -631,6 +955,131 @@ func (tb *TopologyBuilder) mkParamsMap(params []parser.SourceSinkParamAST) data.
 	return paramsMap
 }
 
+// mkErrorPolicy creates a core.ErrorPolicy from the following parameters
+// and removes them from params:
+//
+//   - on_error: "drop" (default) or "dlq:<name>". When it's "dlq:<name>",
+//     tuples failed are written to the dead letter source having the name.
+//   - max_retries: the maximum number of retries of a tuple which failed
+//     with a temporary error (default: 0)
+//   - retry_interval: the interval before the first retry in seconds. It's
+//     doubled on every retry (default: 0.1)
+//   - max_retry_interval: the upper bound of the interval (default: 10)
+//
+// It returns the name of the dead letter source separately because the
+// source is created after all parameters are validated. It returns a nil
+// policy when none of the parameters is given.
+func mkErrorPolicy(params data.Map) (*core.ErrorPolicy, string, error) {
+	p := &core.ErrorPolicy{
+		RetryInterval:    100 * time.Millisecond,
+		MaxRetryInterval: 10 * time.Second,
+	}
+	found := false
+	dlqName := ""
+
+	if v, ok := params["on_error"]; ok {
+		found = true
+		delete(params, "on_error")
+		s, err := data.AsString(v)
+		if err != nil {
+			return nil, "", fmt.Errorf("'on_error' parameter must be a string: %v", err)
+		}
+		switch {
+		case s == "drop":
+		case strings.HasPrefix(s, "dlq:"):
+			dlqName = s[len("dlq:"):]
+			if err := core.ValidateSymbol(dlqName); err != nil {
+				return nil, "", fmt.Errorf("invalid dead letter queue name in 'on_error' parameter: %v", err)
+			}
+		default:
+			return nil, "", fmt.Errorf("'on_error' parameter must be \"drop\" or \"dlq:<name>\": %v", s)
+		}
+	}
+
+	if v, ok := params["max_retries"]; ok {
+		found = true
+		delete(params, "max_retries")
+		i, err := data.AsInt(v)
+		if err != nil {
+			return nil, "", fmt.Errorf("'max_retries' parameter must be an integer: %v", err)
+		}
+		if i < 0 {
+			return nil, "", errors.New("'max_retries' parameter must not be negative")
+		}
+		p.MaxRetries = int(i)
+	}
+
+	for _, d := range []struct {
+		name string
+		dst  *time.Duration
+	}{
+		{"retry_interval", &p.RetryInterval},
+		{"max_retry_interval", &p.MaxRetryInterval},
+	} {
+		v, ok := params[d.name]
+		if !ok {
+			continue
+		}
+		found = true
+		delete(params, d.name)
+		i, err := data.ToDuration(v)
+		if err != nil {
+			return nil, "", fmt.Errorf("'%v' parameter should have a duration: %v", d.name, err)
+		}
+		if i < 0 {
+			return nil, "", fmt.Errorf("'%v' parameter must not be negative", d.name)
+		}
+		*d.dst = i
+	}
+
+	if !found {
+		return nil, "", nil
+	}
+	return p, dlqName, nil
+}
+
+// setUpDeadLetterQueue sets the dead letter source having the name to the
+// policy when the name isn't empty. It returns a function removing the source
+// when it has newly been created, which the caller has to call when it fails
+// to add the node having the policy.
+func (tb *TopologyBuilder) setUpDeadLetterQueue(policy *core.ErrorPolicy, name string) (func(), error) {
+	if name == "" {
+		return func() {}, nil
+	}
+	dlq, created, err := tb.deadLetterSource(name)
+	if err != nil {
+		return nil, err
+	}
+	policy.DeadLetter = dlq
+	if !created {
+		return func() {}, nil
+	}
+	return func() {
+		tb.topology.Remove(name)
+	}, nil
+}
+
+// deadLetterSource returns the dead letter source having the name. It creates
+// a new one when the topology doesn't have a node having the name. The second
+// return value is true when the source was created.
+func (tb *TopologyBuilder) deadLetterSource(name string) (*core.DeadLetterSource, bool, error) {
+	if sn, err := tb.topology.Source(name); err == nil {
+		s, ok := sn.Source().(*core.DeadLetterSource)
+		if !ok {
+			return nil, false, fmt.Errorf("source '%v' isn't a dead letter queue", name)
+		}
+		return s, false, nil
+	} else if !core.IsNotExist(err) {
+		return nil, false, err
+	}
+
+	s := core.NewDeadLetterSource()
+	if _, err := tb.topology.AddSource(name, s, nil); err != nil {
+		return nil, false, err
+	}
+	return s, true, nil
+}
+
 type chanSink struct {
 	m      sync.RWMutex
 	ch     chan *core.Tuple
@@ -718,17 +1167,19 @@ func (tb *TopologyBuilder) AddSelectUnionStmt(stmts *parser.SelectUnionStmt) (co
 			//  + a connection (random_string -> sink)
 			tmpName := fmt.Sprintf("sensorbee_tmp_%v", topologyBuilderNextTemporaryID())
 			tmpStmt := parser.CreateStreamAsSelectStmt{
-				parser.StreamIdentifier(tmpName),
-				parser.SelectStmt{
+				Name: parser.StreamIdentifier(tmpName),
+				Select: parser.SelectStmt{
 					stmt.EmitterAST,
 					stmt.ProjectionsAST,
 					stmt.WindowedFromAST,
 					stmt.FilterAST,
 					stmt.GroupingAST,
 					stmt.HavingAST,
+					stmt.OrderingAST,
+					stmt.LimitAST,
 				},
 			}
-			box, err := tb.AddStmt(tmpStmt)
+			box, err := tb.createStreamAsSelectStmt(&tmpStmt, true)
 			if err != nil {
 				return nil, err
 			}
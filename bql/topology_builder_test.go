@@ -1,10 +1,13 @@
 package bql
 
 import (
+	"fmt"
 	. "github.com/smartystreets/goconvey/convey"
 	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
 	"gopkg.in/sensorbee/sensorbee.v0/core"
 	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"io/ioutil"
 	"testing"
 	"time"
 )
@@ -235,6 +238,64 @@ func TestCreateStreamAsSelectStmt(t *testing.T) {
 			})
 		})
 
+		Convey("When running CREATE STREAM AS SELECT with a subquery", func() {
+			numNodes := len(tb.topology.Nodes())
+			err := addBQLToTopology(tb, `CREATE STREAM t AS SELECT ISTREAM x:n FROM
+                (SELECT RSTREAM int AS n FROM s [RANGE 1 TUPLES]) [RANGE 2 TUPLES] AS x`)
+
+			Convey("Then there should be no error", func() {
+				So(err, ShouldBeNil)
+			})
+
+			Convey("Then an anonymous box should be created", func() {
+				So(len(tb.topology.Nodes()), ShouldEqual, numNodes+2)
+			})
+
+			Convey("And when dropping the stream", func() {
+				So(addBQLToTopology(tb, `DROP STREAM t`), ShouldBeNil)
+
+				Convey("Then the anonymous box should eventually be removed", func() {
+					waitForExpectedCondition(func() bool {
+						return len(tb.topology.Nodes()) == numNodes
+					})
+					So(len(tb.topology.Nodes()), ShouldEqual, numNodes)
+				})
+			})
+		})
+
+		Convey("When running CREATE STREAM AS SELECT with common table expressions", func() {
+			numNodes := len(tb.topology.Nodes())
+			err := addBQLToTopology(tb, `CREATE STREAM t AS
+                WITH x AS (SELECT RSTREAM int AS n FROM s [RANGE 1 TUPLES]),
+                     y AS (SELECT RSTREAM n FROM x [RANGE 1 TUPLES] WHERE n > 1)
+                SELECT ISTREAM y:n, z:n AS m FROM y [RANGE 2 TUPLES], x [RANGE 2 TUPLES] AS z`)
+
+			Convey("Then there should be no error", func() {
+				So(err, ShouldBeNil)
+			})
+
+			Convey("Then an anonymous box should be created for each reference", func() {
+				So(len(tb.topology.Nodes()), ShouldEqual, numNodes+4)
+			})
+		})
+
+		Convey("When running CREATE STREAM AS SELECT with a subquery on a non-existing stream", func() {
+			numNodes := len(tb.topology.Nodes())
+			err := addBQLToTopology(tb, `CREATE STREAM t AS SELECT ISTREAM x:n FROM
+                s [RANGE 1 TUPLES], (SELECT RSTREAM int AS n FROM bar [RANGE 1 TUPLES]) [RANGE 2 TUPLES] AS x`)
+
+			Convey("Then there should be an error", func() {
+				So(err, ShouldNotBeNil)
+			})
+
+			Convey("Then no node should be left in the topology", func() {
+				waitForExpectedCondition(func() bool {
+					return len(tb.topology.Nodes()) == numNodes
+				})
+				So(len(tb.topology.Nodes()), ShouldEqual, numNodes)
+			})
+		})
+
 		Convey("When running CREATE STREAM AS SELECT on a non-existing stream", func() {
 			err := addBQLToTopology(tb, `CREATE STREAM t AS SELECT ISTREAM int FROM
                 bar [RANGE 2 SECONDS] WHERE int=2`)
@@ -254,6 +315,376 @@ func TestCreateStreamAsSelectStmt(t *testing.T) {
 				So(err.Error(), ShouldContainSubstring, "already")
 			})
 		})
+
+		Convey("When a stream having a dead letter queue fails to process tuples", func() {
+			So(addBQLToTopology(tb, `
+				CREATE STREAM t WITH on_error="dlq:failed", max_retries=2
+					AS SELECT RSTREAM int / 0 AS x FROM s [RANGE 1 TUPLES];
+				CREATE SINK snk TYPE collector;
+				INSERT INTO snk FROM failed;
+				RESUME SOURCE s;
+			`), ShouldBeNil)
+
+			Convey("Then the tuples should be written to the dead letter queue", func() {
+				sn, err := dt.Sink("snk")
+				So(err, ShouldBeNil)
+				si := sn.Sink().(*tupleCollectorSink)
+				si.Wait(4)
+				t := si.get(0)
+				So(t.Data["node_type"], ShouldEqual, data.String("box"))
+				So(t.Data["node_name"], ShouldEqual, data.String("t"))
+				So(t.Data["retry_count"], ShouldEqual, data.Int(0))
+				So(t.Data["data"], ShouldResemble, data.Map{"int": data.Int(1)})
+			})
+		})
+
+		Convey("When CREATE STREAM AS SELECT with a dead letter queue fails", func() {
+			err := addBQLToTopology(tb, `CREATE STREAM s WITH on_error="dlq:failed"
+				AS SELECT ISTREAM int FROM s [RANGE 2 SECONDS]`)
+
+			Convey("Then the dead letter queue shouldn't be created", func() {
+				So(err, ShouldNotBeNil)
+				_, err := dt.Source("failed")
+				So(core.IsNotExist(err), ShouldBeTrue)
+			})
+		})
+
+		Convey("When running CREATE STREAM AS SELECT with invalid parameters", func() {
+			Convey("Then an unknown parameter should result in an error", func() {
+				err := addBQLToTopology(tb, `CREATE STREAM t WITH foo="bar"
+					AS SELECT ISTREAM int FROM s [RANGE 2 SECONDS]`)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "unknown parameter of a stream: foo")
+			})
+
+			Convey("Then an invalid on_error should result in an error", func() {
+				So(addBQLToTopology(tb, `CREATE STREAM t WITH on_error="retry"
+					AS SELECT ISTREAM int FROM s [RANGE 2 SECONDS]`), ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestCreateOrReplaceStreamAsSelectStmt(t *testing.T) {
+	Convey("Given a BQL TopologyBuilder with source, stream, and sink", t, func() {
+		dt := newTestTopology()
+		Reset(func() {
+			dt.Stop()
+		})
+		tb, err := NewTopologyBuilder(dt)
+		So(err, ShouldBeNil)
+		err = addBQLToTopology(tb, `
+			CREATE PAUSED SOURCE s TYPE dummy WITH num=4;
+			CREATE STREAM t AS SELECT RSTREAM int FROM s [RANGE 1 TUPLES] WHERE int % 2 = 0;
+			CREATE SINK snk TYPE collector;
+			INSERT INTO snk FROM t;`)
+		So(err, ShouldBeNil)
+		bn, err := dt.Box("t")
+		So(err, ShouldBeNil)
+		sin, err := dt.Sink("snk")
+		So(err, ShouldBeNil)
+		si := sin.Sink().(*tupleCollectorSink)
+		numNodes := len(dt.Nodes())
+
+		Convey("When replacing the stream", func() {
+			err := addBQLToTopology(tb, `CREATE OR REPLACE STREAM t AS SELECT RSTREAM int, "new" AS v
+                FROM s [RANGE 1 TUPLES] WHERE int % 2 = 1`)
+			So(err, ShouldBeNil)
+
+			Convey("Then the stream should have the new box", func() {
+				n, err := dt.Box("t")
+				So(err, ShouldBeNil)
+				So(n, ShouldEqual, bn)
+				So(len(n.Box().(*bqlBox).stmt.Projections), ShouldEqual, 2)
+				So(len(dt.Nodes()), ShouldEqual, numNodes)
+			})
+
+			Convey("Then the sink should receive tuples from the new box", func() {
+				So(addBQLToTopology(tb, `RESUME SOURCE s`), ShouldBeNil)
+				si.Wait(2)
+				So(si.get(0).Data, ShouldResemble, data.Map{"int": data.Int(1), "v": data.String("new")})
+				So(si.get(1).Data, ShouldResemble, data.Map{"int": data.Int(3), "v": data.String("new")})
+			})
+		})
+
+		Convey("When replacing the stream with a subquery", func() {
+			err := addBQLToTopology(tb, `CREATE OR REPLACE STREAM t AS SELECT RSTREAM x:n AS int
+                FROM (SELECT RSTREAM int AS n FROM s [RANGE 1 TUPLES] WHERE int > 2) [RANGE 1 TUPLES] AS x`)
+			So(err, ShouldBeNil)
+
+			Convey("Then an anonymous box should be created", func() {
+				So(len(dt.Nodes()), ShouldEqual, numNodes+1)
+			})
+
+			Convey("Then the sink should receive tuples from the new box", func() {
+				So(addBQLToTopology(tb, `RESUME SOURCE s`), ShouldBeNil)
+				si.Wait(2)
+				So(si.get(0).Data, ShouldResemble, data.Map{"int": data.Int(3)})
+				So(si.get(1).Data, ShouldResemble, data.Map{"int": data.Int(4)})
+			})
+
+			Convey("And when replacing the stream again without a subquery", func() {
+				err := addBQLToTopology(tb, `CREATE OR REPLACE STREAM t AS SELECT RSTREAM int
+                    FROM s [RANGE 1 TUPLES]`)
+				So(err, ShouldBeNil)
+
+				Convey("Then the anonymous box should eventually be removed", func() {
+					waitForExpectedCondition(func() bool {
+						return len(dt.Nodes()) == numNodes
+					})
+					So(len(dt.Nodes()), ShouldEqual, numNodes)
+				})
+			})
+		})
+
+		Convey("When replacing the stream with an invalid statement", func() {
+			err := addBQLToTopology(tb, `CREATE OR REPLACE STREAM t AS SELECT RSTREAM int
+                FROM s [RANGE 1 TUPLES], (SELECT RSTREAM int AS n FROM bar [RANGE 1 TUPLES]) [RANGE 1 TUPLES] AS x`)
+
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+
+			Convey("Then the old box should keep running", func() {
+				waitForExpectedCondition(func() bool {
+					return len(dt.Nodes()) == numNodes
+				})
+				So(len(dt.Nodes()), ShouldEqual, numNodes)
+				So(addBQLToTopology(tb, `RESUME SOURCE s`), ShouldBeNil)
+				si.Wait(2)
+				So(si.get(0).Data, ShouldResemble, data.Map{"int": data.Int(2)})
+				So(si.get(1).Data, ShouldResemble, data.Map{"int": data.Int(4)})
+			})
+		})
+
+		Convey("When replacing a stream which doesn't exist", func() {
+			err := addBQLToTopology(tb, `CREATE OR REPLACE STREAM u AS SELECT RSTREAM int
+                FROM s [RANGE 1 TUPLES]`)
+
+			Convey("Then the stream should be created", func() {
+				So(err, ShouldBeNil)
+				_, err := dt.Box("u")
+				So(err, ShouldBeNil)
+			})
+		})
+
+		Convey("When replacing the stream with a dead letter queue", func() {
+			So(addBQLToTopology(tb, `
+				CREATE OR REPLACE STREAM t WITH on_error="dlq:failed"
+					AS SELECT RSTREAM int / 0 AS x FROM s [RANGE 1 TUPLES];
+				CREATE SINK dlq_snk TYPE collector;
+				INSERT INTO dlq_snk FROM failed;`), ShouldBeNil)
+			dsin, err := dt.Sink("dlq_snk")
+			So(err, ShouldBeNil)
+			dsi := dsin.Sink().(*tupleCollectorSink)
+
+			Convey("Then failed tuples should be written to the dead letter queue", func() {
+				So(addBQLToTopology(tb, `RESUME SOURCE s`), ShouldBeNil)
+				dsi.Wait(4)
+				So(dsi.get(0).Data["node_name"], ShouldEqual, data.String("t"))
+				So(dsi.get(0).Data["data"], ShouldResemble, data.Map{"int": data.Int(1)})
+			})
+
+			Convey("And when replacing the stream again without parameters", func() {
+				So(addBQLToTopology(tb, `CREATE OR REPLACE STREAM t
+					AS SELECT RSTREAM int % 0 AS x FROM s [RANGE 1 TUPLES]`), ShouldBeNil)
+
+				Convey("Then the stream should keep the dead letter queue", func() {
+					So(addBQLToTopology(tb, `RESUME SOURCE s`), ShouldBeNil)
+					dsi.Wait(4)
+					So(dsi.get(0).Data["data"], ShouldResemble, data.Map{"int": data.Int(1)})
+				})
+			})
+		})
+
+		Convey("When replacing the stream with an unknown parameter", func() {
+			err := addBQLToTopology(tb, `CREATE OR REPLACE STREAM t WITH foo="bar"
+				AS SELECT RSTREAM int FROM s [RANGE 1 TUPLES]`)
+
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "unknown parameter of a stream: foo")
+				So(bn.Box().(*bqlBox).stmt.Filter, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When replacing a stream which doesn't exist with a dead letter queue", func() {
+			err := addBQLToTopology(tb, `CREATE OR REPLACE STREAM u WITH on_error="dlq:failed"
+				AS SELECT RSTREAM int FROM s [RANGE 1 TUPLES]`)
+
+			Convey("Then the stream and the dead letter queue should be created", func() {
+				So(err, ShouldBeNil)
+				_, err := dt.Box("u")
+				So(err, ShouldBeNil)
+				_, err = dt.Source("failed")
+				So(err, ShouldBeNil)
+			})
+		})
+
+		Convey("When replacing a source", func() {
+			err := addBQLToTopology(tb, `CREATE OR REPLACE STREAM s AS SELECT RSTREAM int
+                FROM t [RANGE 1 TUPLES]`)
+
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "already")
+			})
+		})
+	})
+}
+
+func TestStreamCheckpoint(t *testing.T) {
+	Convey("Given a BQL TopologyBuilder saving checkpoints of streams", t, func() {
+		storage := udf.NewInMemoryUDSStorage()
+		newBuilder := func(interval time.Duration) (core.Topology, *TopologyBuilder) {
+			dt := newTestTopology()
+			tb, err := NewTopologyBuilder(dt)
+			So(err, ShouldBeNil)
+			tb.UDSStorage = storage
+			tb.CheckpointInterval = interval
+			return dt, tb
+		}
+		// runStream creates a stream summing up the values in its window
+		// and returns the results after the source emitted num tuples.
+		runStream := func(tb *TopologyBuilder, stream string, num int) *tupleCollectorSink {
+			So(addBQLToTopology(tb, fmt.Sprintf(`
+				CREATE PAUSED SOURCE s TYPE dummy WITH num=%v;
+				%v;
+				CREATE SINK snk TYPE collector;
+				INSERT INTO snk FROM t;
+				RESUME SOURCE s;`, num, stream)), ShouldBeNil)
+			sin, err := tb.Topology().Sink("snk")
+			So(err, ShouldBeNil)
+			si := sin.Sink().(*tupleCollectorSink)
+			si.Wait(num)
+			return si
+		}
+		stream := `CREATE STREAM t AS SELECT RSTREAM sum(int) AS s FROM s [RANGE 3 TUPLES]`
+
+		dt, tb := newBuilder(time.Hour)
+		runStream(tb, stream, 4)
+
+		Convey("When restarting the topology", func() {
+			So(dt.Stop(), ShouldBeNil)
+			dt2, tb2 := newBuilder(time.Hour)
+			Reset(func() {
+				dt2.Stop()
+			})
+
+			Convey("Then the stream should restore its window", func() {
+				si := runStream(tb2, stream, 1)
+				// 1 is added to the restored window having 2, 3, and 4
+				So(si.get(0).Data, ShouldResemble, data.Map{"s": data.Int(8)})
+			})
+
+			Convey("Then a stream with another statement shouldn't restore the window", func() {
+				si := runStream(tb2, `CREATE STREAM t AS SELECT RSTREAM sum(int) AS s FROM s [RANGE 2 TUPLES]`, 1)
+				So(si.get(0).Data, ShouldResemble, data.Map{"s": data.Int(1)})
+			})
+		})
+
+		Convey("When replacing the stream", func() {
+			So(addBQLToTopology(tb, `CREATE OR REPLACE STREAM t AS SELECT RSTREAM sum(int) AS s FROM s [RANGE 2 TUPLES]`), ShouldBeNil)
+
+			Convey("Then the old box shouldn't save its checkpoint", func() {
+				_, err := storage.Load(dt.Name(), "t", checkpointTag)
+				So(core.IsNotExist(err), ShouldBeTrue)
+			})
+
+			Convey("Then the old statement shouldn't restore the window after restart", func() {
+				So(dt.Stop(), ShouldBeNil)
+				dt2, tb2 := newBuilder(time.Hour)
+				Reset(func() {
+					dt2.Stop()
+				})
+				si := runStream(tb2, stream, 1)
+				So(si.get(0).Data, ShouldResemble, data.Map{"s": data.Int(1)})
+			})
+		})
+
+		Convey("When dropping the stream before restarting the topology", func() {
+			So(addBQLToTopology(tb, `DROP STREAM t`), ShouldBeNil)
+			So(dt.Stop(), ShouldBeNil)
+			dt2, tb2 := newBuilder(time.Hour)
+			Reset(func() {
+				dt2.Stop()
+			})
+
+			Convey("Then the checkpoint should be removed", func() {
+				_, err := storage.Load(dt.Name(), "t", checkpointTag)
+				So(core.IsNotExist(err), ShouldBeTrue)
+			})
+
+			Convey("Then the stream shouldn't restore the window", func() {
+				si := runStream(tb2, stream, 1)
+				So(si.get(0).Data, ShouldResemble, data.Map{"s": data.Int(1)})
+			})
+		})
+
+		Convey("When stopping the topology having a stream with a subquery", func() {
+			So(addBQLToTopology(tb, `CREATE STREAM u AS SELECT RSTREAM x:n AS n
+				FROM (SELECT RSTREAM int AS n FROM s [RANGE 1 TUPLES]) [RANGE 1 TUPLES] AS x`), ShouldBeNil)
+			So(dt.Stop(), ShouldBeNil)
+
+			Convey("Then only the named streams should save checkpoints", func() {
+				l, err := storage.List(dt.Name())
+				So(err, ShouldBeNil)
+				So(l, ShouldHaveLength, 2)
+				So(l, ShouldContainKey, "t")
+				So(l, ShouldContainKey, "u")
+			})
+		})
+	})
+
+	Convey("Given a BQL TopologyBuilder saving checkpoints periodically", t, func() {
+		dt := newTestTopology()
+		Reset(func() {
+			dt.Stop()
+		})
+		tb, err := NewTopologyBuilder(dt)
+		So(err, ShouldBeNil)
+		tb.CheckpointInterval = 10 * time.Millisecond
+		So(addBQLToTopology(tb, `
+			CREATE PAUSED SOURCE s TYPE dummy WITH num=4;
+			CREATE STREAM t AS SELECT RSTREAM sum(int) AS s FROM s [RANGE 3 TUPLES];
+			CREATE SINK snk TYPE collector;
+			INSERT INTO snk FROM t;
+			RESUME SOURCE s;`), ShouldBeNil)
+		sin, err := dt.Sink("snk")
+		So(err, ShouldBeNil)
+		sin.Sink().(*tupleCollectorSink).Wait(4)
+
+		Convey("When the topology is running", func() {
+			load := func() data.Map {
+				r, err := tb.UDSStorage.Load(dt.Name(), "t", checkpointTag)
+				if err != nil {
+					return nil
+				}
+				defer r.Close()
+				b, err := ioutil.ReadAll(r)
+				So(err, ShouldBeNil)
+				m, err := data.UnmarshalMsgpack(b)
+				So(err, ShouldBeNil)
+				return m
+			}
+			waitForExpectedCondition(func() bool {
+				m := load()
+				return m != nil && len(m["tuples"].(data.Array)) == 3
+			})
+
+			Convey("Then the checkpoint should have the tuples in the window", func() {
+				m := load()
+				So(m, ShouldNotBeNil)
+				tuples := m["tuples"].(data.Array)
+				So(tuples, ShouldHaveLength, 3)
+				for i, t := range tuples {
+					d, err := decodeCheckpointValue(t.(data.Map)["data"])
+					So(err, ShouldBeNil)
+					So(d, ShouldResemble, data.Map{"int": data.Int(i + 2)})
+				}
+				So(m["emit_count"], ShouldEqual, data.Int(4))
+			})
+		})
 	})
 }
 
@@ -375,6 +806,96 @@ func TestCreateSinkStmt(t *testing.T) {
 				So(err.Error(), ShouldContainSubstring, "not registered")
 			})
 		})
+
+		Convey("When running CREATE SINK with a dead letter queue", func() {
+			err := addBQLToTopology(tb, `CREATE SINK hoge TYPE collector
+				WITH on_error="dlq:failed", max_retries=3, retry_interval=0.01`)
+			So(err, ShouldBeNil)
+
+			Convey("Then the dead letter queue should be created", func() {
+				sn, err := dt.Source("failed")
+				So(err, ShouldBeNil)
+				So(sn.Source(), ShouldHaveSameTypeAs, &core.DeadLetterSource{})
+			})
+
+			Convey("And when running another CREATE SINK with the same queue", func() {
+				err := addBQLToTopology(tb, `CREATE SINK hoge2 TYPE collector WITH on_error="dlq:failed"`)
+
+				Convey("Then the queue should be shared", func() {
+					So(err, ShouldBeNil)
+				})
+			})
+		})
+
+		Convey("When running CREATE SINK with an existing dead letter source", func() {
+			So(addBQLToTopology(tb, `CREATE PAUSED SOURCE failed TYPE dead_letter`), ShouldBeNil)
+			err := addBQLToTopology(tb, `CREATE SINK hoge TYPE collector WITH on_error="dlq:failed"`)
+
+			Convey("Then the source should be used as the queue", func() {
+				So(err, ShouldBeNil)
+			})
+		})
+
+		Convey("When running CREATE SINK with a source which isn't a dead letter queue", func() {
+			So(addBQLToTopology(tb, `CREATE PAUSED SOURCE failed TYPE dummy`), ShouldBeNil)
+			err := addBQLToTopology(tb, `CREATE SINK hoge TYPE collector WITH on_error="dlq:failed"`)
+
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "isn't a dead letter queue")
+			})
+		})
+
+		Convey("When CREATE SINK with a dead letter queue fails", func() {
+			So(addBQLToTopology(tb, `CREATE SINK hoge TYPE collector`), ShouldBeNil)
+			err := addBQLToTopology(tb, `CREATE SINK hoge TYPE collector WITH on_error="dlq:failed"`)
+
+			Convey("Then the dead letter queue shouldn't be created", func() {
+				So(err, ShouldNotBeNil)
+				_, err := dt.Source("failed")
+				So(core.IsNotExist(err), ShouldBeTrue)
+			})
+		})
+
+		Convey("When running CREATE SINK with invalid error handling parameters", func() {
+			Convey("Then an invalid on_error should result in an error", func() {
+				So(addBQLToTopology(tb, `CREATE SINK hoge TYPE collector WITH on_error="retry"`), ShouldNotBeNil)
+			})
+
+			Convey("Then an invalid dead letter queue name should result in an error", func() {
+				So(addBQLToTopology(tb, `CREATE SINK hoge TYPE collector WITH on_error="dlq:"`), ShouldNotBeNil)
+			})
+
+			Convey("Then a negative max_retries should result in an error", func() {
+				So(addBQLToTopology(tb, `CREATE SINK hoge TYPE collector WITH max_retries=-1`), ShouldNotBeNil)
+			})
+
+			Convey("Then an invalid retry_interval should result in an error", func() {
+				So(addBQLToTopology(tb, `CREATE SINK hoge TYPE collector WITH retry_interval="a"`), ShouldNotBeNil)
+			})
+		})
+
+		Convey("When a sink having a dead letter queue fails to write tuples", func() {
+			So(addBQLToTopology(tb, `
+				CREATE PAUSED SOURCE s TYPE dummy;
+				CREATE SINK fail TYPE failing WITH on_error="dlq:failed", max_retries=2, retry_interval=0.001;
+				CREATE SINK snk TYPE collector;
+				INSERT INTO fail FROM s;
+				INSERT INTO snk FROM failed;
+				RESUME SOURCE s;
+			`), ShouldBeNil)
+
+			Convey("Then the tuples should be written to the dead letter queue", func() {
+				sn, err := dt.Sink("snk")
+				So(err, ShouldBeNil)
+				si := sn.Sink().(*tupleCollectorSink)
+				si.Wait(4)
+				t := si.get(0)
+				So(t.Data["node_name"], ShouldEqual, data.String("fail"))
+				So(t.Data["retry_count"], ShouldEqual, data.Int(2))
+				So(t.Data["data"], ShouldResemble, data.Map{"int": data.Int(1)})
+			})
+		})
 	})
 }
 
@@ -841,6 +1362,33 @@ func TestSelectStmt(t *testing.T) {
 			})
 		})
 
+		Convey("When issuing a SELECT stmt with a subquery", func() {
+			bp := parser.New()
+			numNodes := len(tb.topology.Nodes())
+			istmt, _, err := bp.ParseStmt(`WITH x AS (SELECT RSTREAM int FROM s [RANGE 1 TUPLES])
+                SELECT ISTREAM * FROM x [RANGE 1 TUPLES];`)
+			So(err, ShouldBeNil)
+			stmt := istmt.(parser.SelectStmt)
+			_, ch, err := tb.AddSelectStmt(&stmt)
+			So(err, ShouldBeNil)
+			So(addBQLToTopology(tb, `RESUME SOURCE s;`), ShouldBeNil)
+
+			Convey("Then the chan should receive all tuples", func() {
+				cnt := 0
+				for _ = range ch {
+					cnt++
+				}
+				So(cnt, ShouldEqual, 4)
+
+				Convey("And all temporary nodes should eventually be removed from the topology", func() {
+					waitForExpectedCondition(func() bool {
+						return len(tb.topology.Nodes()) == numNodes
+					})
+					So(len(tb.topology.Nodes()), ShouldEqual, numNodes)
+				})
+			})
+		})
+
 		Convey("When issuing a SELECT stmt referencing an unknown source", func() {
 			bp := parser.New()
 			numNodes := len(tb.topology.Nodes())
@@ -1003,6 +1551,32 @@ func TestEvalStmt(t *testing.T) {
 			})
 		})
 
+		Convey("When issuing an EVAL stmt with a lambda expression without ON", func() {
+			bp := parser.New()
+			istmt, _, err := bp.ParseStmt(`EVAL transform([1, 2], x -> x * 2)`)
+			So(err, ShouldBeNil)
+			stmt := istmt.(parser.EvalStmt)
+			val, err := tb.RunEvalStmt(&stmt)
+
+			Convey("Then the correct result is returned", func() {
+				So(err, ShouldBeNil)
+				So(val, ShouldResemble, data.Array{data.Int(2), data.Int(4)})
+			})
+		})
+
+		Convey("When issuing an EVAL stmt with a lambda expression and a foldable ON expression", func() {
+			bp := parser.New()
+			istmt, _, err := bp.ParseStmt(`EVAL filter(vals, v -> v > min) ON {"vals": [1, 5, 3], "min": 2}`)
+			So(err, ShouldBeNil)
+			stmt := istmt.(parser.EvalStmt)
+			val, err := tb.RunEvalStmt(&stmt)
+
+			Convey("Then the correct result is returned", func() {
+				So(err, ShouldBeNil)
+				So(val, ShouldResemble, data.Array{data.Int(5), data.Int(3)})
+			})
+		})
+
 		Convey("When issuing an EVAL stmt with a non-foldable expression and a non-foldable ON expression", func() {
 			bp := parser.New()
 			istmt, _, err := bp.ParseStmt(`EVAL "日本" || key ON {"key": a}`)
@@ -0,0 +1,56 @@
+package udf
+
+import (
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// IncrementalAggregate is an aggregate function whose result can be
+// maintained incrementally while values enter and leave a window, instead
+// of being computed from all values in the window every time.
+//
+// An IncrementalAggregate is still a UDF and its Call method must return
+// the same result as an AggregateState to which all values in the array
+// passed to Call were added. Only calls with exactly one parameter, which
+// must be an aggregation parameter, are computed incrementally.
+type IncrementalAggregate interface {
+	UDF
+
+	// NewAggregateState creates a new state holding no values. A state is
+	// created for each group of a GROUP BY clause.
+	NewAggregateState(ctx *core.Context) (AggregateState, error)
+}
+
+// ParameterizedIncrementalAggregate is an aggregate function that has
+// parameters other than the aggregation parameter, such as the number of
+// values returned by the function, and can be computed incrementally when
+// those parameters are constants. The aggregation parameter must be the
+// first parameter.
+//
+// Like an IncrementalAggregate, its Call method must return the same result
+// as an AggregateState created with the same values of the other parameters
+// to which all values in the array passed to Call were added.
+type ParameterizedIncrementalAggregate interface {
+	UDF
+
+	// NewParameterizedAggregateState creates a new state holding no values.
+	// params has the values of the parameters other than the aggregation
+	// parameter.
+	NewParameterizedAggregateState(ctx *core.Context, params ...data.Value) (AggregateState, error)
+}
+
+// AggregateState holds the intermediate result of an IncrementalAggregate
+// for one group.
+type AggregateState interface {
+	// Add adds a value to the state. When Add returns an error, the state
+	// is discarded.
+	Add(ctx *core.Context, v data.Value) error
+
+	// Remove removes a value that was previously added to the state. When
+	// Remove returns an error, the state is discarded.
+	Remove(ctx *core.Context, v data.Value) error
+
+	// Result returns the result of the aggregate over all values that
+	// are currently in the state. It must not modify the state.
+	Result(ctx *core.Context) (data.Value, error)
+}
@@ -8,6 +8,7 @@ import (
 	"gopkg.in/sensorbee/sensorbee.v0/data"
 	"math"
 	"sort"
+	"time"
 )
 
 // singleParamAggFunc is a template for aggregate functions that
@@ -35,6 +36,246 @@ func (f *singleParamAggFunc) Call(ctx *core.Context, args ...data.Value) (data.V
 	return f.aggFun(arr)
 }
 
+// incrementalSingleParamAggFunc is a template for aggregate functions
+// that have exactly one parameter and can also be computed incrementally.
+type incrementalSingleParamAggFunc struct {
+	singleParamAggFunc
+	newState func() udf.AggregateState
+}
+
+func (f *incrementalSingleParamAggFunc) NewAggregateState(ctx *core.Context) (udf.AggregateState, error) {
+	return f.newState(), nil
+}
+
+// countState is the udf.AggregateState of count.
+type countState struct {
+	n int64
+}
+
+func (s *countState) Add(ctx *core.Context, v data.Value) error {
+	if v.Type() != data.TypeNull {
+		s.n++
+	}
+	return nil
+}
+
+func (s *countState) Remove(ctx *core.Context, v data.Value) error {
+	if v.Type() != data.TypeNull {
+		if s.n == 0 {
+			return fmt.Errorf("%v was never added", v)
+		}
+		s.n--
+	}
+	return nil
+}
+
+func (s *countState) Result(ctx *core.Context) (data.Value, error) {
+	return data.Int(s.n), nil
+}
+
+// sumState is the udf.AggregateState of sum and, when avg is true, of avg.
+// Integers and floats are summed up separately so that the sum of integers
+// doesn't lose precision. Non-finite floats are only counted since
+// floatSum would stay NaN after removing them again.
+type sumState struct {
+	avg        bool
+	intSum     int64
+	floatSum   float64
+	numInts    int64
+	numFloats  int64
+	numPosInfs int64
+	numNegInfs int64
+	numNaNs    int64
+}
+
+func (s *sumState) update(v data.Value, sign int64) error {
+	switch v.Type() {
+	case data.TypeInt:
+		i, _ := data.AsInt(v)
+		s.intSum += sign * i
+		s.numInts += sign
+	case data.TypeFloat:
+		f, _ := data.AsFloat(v)
+		switch {
+		case math.IsNaN(f):
+			s.numNaNs += sign
+		case math.IsInf(f, 1):
+			s.numPosInfs += sign
+		case math.IsInf(f, -1):
+			s.numNegInfs += sign
+		default:
+			s.floatSum += float64(sign) * f
+		}
+		s.numFloats += sign
+	case data.TypeNull:
+	default:
+		return fmt.Errorf("cannot interpret %s (%T) as a number", v, v)
+	}
+	if s.numInts < 0 || s.numFloats < 0 ||
+		s.numPosInfs < 0 || s.numNegInfs < 0 || s.numNaNs < 0 {
+		return fmt.Errorf("%v was never added", v)
+	}
+	if s.numFloats == 0 {
+		// drop rounding errors left by floats that were removed
+		s.floatSum = 0
+	}
+	return nil
+}
+
+func (s *sumState) Add(ctx *core.Context, v data.Value) error {
+	return s.update(v, 1)
+}
+
+func (s *sumState) Remove(ctx *core.Context, v data.Value) error {
+	return s.update(v, -1)
+}
+
+func (s *sumState) Result(ctx *core.Context) (data.Value, error) {
+	count := s.numInts + s.numFloats
+	if count == 0 {
+		return data.Null{}, nil
+	}
+	sum := float64(s.intSum) + s.floatSum
+	switch {
+	case s.numNaNs > 0 || (s.numPosInfs > 0 && s.numNegInfs > 0):
+		sum = math.NaN()
+	case s.numPosInfs > 0:
+		sum = math.Inf(1)
+	case s.numNegInfs > 0:
+		sum = math.Inf(-1)
+	}
+	if s.avg {
+		return data.Float(sum / float64(count)), nil
+	}
+	if s.numFloats == 0 {
+		return data.Int(s.intSum), nil
+	}
+	return data.Float(sum), nil
+}
+
+// minMaxState is the udf.AggregateState of min and, when max is true, of
+// max. It keeps all values in sorted slices so that removing the current
+// minimum or maximum doesn't require the whole window to be scanned.
+// NaNs cannot be sorted and are only counted; like in minFunc and maxFunc,
+// they never become the minimum or maximum.
+type minMaxState struct {
+	max     bool
+	ints    []int64
+	floats  []float64
+	numNaNs int
+	times   []time.Time
+}
+
+func (s *minMaxState) Add(ctx *core.Context, v data.Value) error {
+	switch v.Type() {
+	case data.TypeInt:
+		i, _ := data.AsInt(v)
+		n := sort.Search(len(s.ints), func(k int) bool { return s.ints[k] >= i })
+		s.ints = append(s.ints, 0)
+		copy(s.ints[n+1:], s.ints[n:])
+		s.ints[n] = i
+	case data.TypeFloat:
+		f, _ := data.AsFloat(v)
+		if math.IsNaN(f) {
+			s.numNaNs++
+			return nil
+		}
+		n := sort.SearchFloat64s(s.floats, f)
+		s.floats = append(s.floats, 0)
+		copy(s.floats[n+1:], s.floats[n:])
+		s.floats[n] = f
+	case data.TypeTimestamp:
+		t, _ := data.AsTimestamp(v)
+		n := sort.Search(len(s.times), func(k int) bool { return !s.times[k].Before(t) })
+		s.times = append(s.times, time.Time{})
+		copy(s.times[n+1:], s.times[n:])
+		s.times[n] = t
+	case data.TypeNull:
+	default:
+		return fmt.Errorf("cannot interpret %s (%T) as a number", v, v)
+	}
+	return nil
+}
+
+func (s *minMaxState) Remove(ctx *core.Context, v data.Value) error {
+	switch v.Type() {
+	case data.TypeInt:
+		i, _ := data.AsInt(v)
+		n := sort.Search(len(s.ints), func(k int) bool { return s.ints[k] >= i })
+		if n < len(s.ints) && s.ints[n] == i {
+			s.ints = append(s.ints[:n], s.ints[n+1:]...)
+			return nil
+		}
+	case data.TypeFloat:
+		f, _ := data.AsFloat(v)
+		if math.IsNaN(f) {
+			if s.numNaNs > 0 {
+				s.numNaNs--
+				return nil
+			}
+			break
+		}
+		n := sort.SearchFloat64s(s.floats, f)
+		if n < len(s.floats) && s.floats[n] == f {
+			s.floats = append(s.floats[:n], s.floats[n+1:]...)
+			return nil
+		}
+	case data.TypeTimestamp:
+		t, _ := data.AsTimestamp(v)
+		n := sort.Search(len(s.times), func(k int) bool { return !s.times[k].Before(t) })
+		if n < len(s.times) && s.times[n].Equal(t) {
+			s.times = append(s.times[:n], s.times[n+1:]...)
+			return nil
+		}
+	case data.TypeNull:
+		return nil
+	}
+	return fmt.Errorf("%v was never added", v)
+}
+
+func (s *minMaxState) Result(ctx *core.Context) (data.Value, error) {
+	hasNumbers := len(s.ints) > 0 || len(s.floats) > 0 || s.numNaNs > 0
+	if len(s.times) > 0 {
+		if hasNumbers {
+			return nil, fmt.Errorf("cannot compare numbers and timestamps")
+		}
+		if s.max {
+			return data.Timestamp(s.times[len(s.times)-1]), nil
+		}
+		return data.Timestamp(s.times[0]), nil
+	}
+	if !hasNumbers {
+		return data.Null{}, nil
+	}
+	// the comparison below is the same as the one of maxFunc and minFunc
+	if s.max {
+		maxInt := int64(math.MinInt64)
+		if len(s.ints) > 0 {
+			maxInt = s.ints[len(s.ints)-1]
+		}
+		maxFloat := -float64(math.MaxFloat64)
+		if len(s.floats) > 0 {
+			maxFloat = s.floats[len(s.floats)-1]
+		}
+		if float64(maxInt) >= maxFloat {
+			return data.Int(maxInt), nil
+		}
+		return data.Float(maxFloat), nil
+	}
+	minInt := int64(math.MaxInt64)
+	if len(s.ints) > 0 {
+		minInt = s.ints[0]
+	}
+	minFloat := float64(math.MaxFloat64)
+	if len(s.floats) > 0 {
+		minFloat = s.floats[0]
+	}
+	if float64(minInt) <= minFloat {
+		return data.Int(minInt), nil
+	}
+	return data.Float(minFloat), nil
+}
+
 // twoParamAggFunc is a template for aggregate functions that
 // have exactly two (aggregation) parameters
 type twoParamAggFunc struct {
@@ -71,16 +312,21 @@ func (f *twoParamAggFunc) Call(ctx *core.Context, args ...data.Value) (data.Valu
 //
 //  Input: anything (aggregated)
 //  Return Type: Int
-var countFunc udf.UDF = &singleParamAggFunc{
-	aggFun: func(arr []data.Value) (data.Value, error) {
-		// count() is O(n) in the spirit of PostgreSQL
-		c := int64(0)
-		for _, item := range arr {
-			if item.Type() != data.TypeNull {
-				c++
+var countFunc udf.UDF = &incrementalSingleParamAggFunc{
+	singleParamAggFunc{
+		aggFun: func(arr []data.Value) (data.Value, error) {
+			// count() is O(n) in the spirit of PostgreSQL
+			c := int64(0)
+			for _, item := range arr {
+				if item.Type() != data.TypeNull {
+					c++
+				}
 			}
-		}
-		return data.Int(c), nil
+			return data.Int(c), nil
+		},
+	},
+	func() udf.AggregateState {
+		return &countState{}
 	},
 }
 
@@ -108,34 +354,39 @@ var arrayAggFunc udf.UDF = &singleParamAggFunc{
 //
 //  Input: Int or Float (aggregated)
 //  Return Type: Float (Null on empty input)
-var avgFunc udf.UDF = &singleParamAggFunc{
-	aggFun: func(arr []data.Value) (data.Value, error) {
-		if len(arr) == 0 {
-			return data.Null{}, nil
-		}
-		sum := float64(0.0)
-		count := int64(0)
-		for _, item := range arr {
-			if item.Type() == data.TypeInt {
-				i, _ := data.AsInt(item)
-				sum += float64(i)
-				count++
-			} else if item.Type() == data.TypeFloat {
-				f, _ := data.AsFloat(item)
-				sum += f
-				count++
-			} else if item.Type() == data.TypeNull {
-				continue
-			} else {
-				return nil, fmt.Errorf("cannot interpret %s (%T) as a number",
-					item, item)
+var avgFunc udf.UDF = &incrementalSingleParamAggFunc{
+	singleParamAggFunc{
+		aggFun: func(arr []data.Value) (data.Value, error) {
+			if len(arr) == 0 {
+				return data.Null{}, nil
 			}
-		}
-		if count == 0 {
-			// only null inputs
-			return data.Null{}, nil
-		}
-		return data.Float(sum / float64(count)), nil
+			sum := float64(0.0)
+			count := int64(0)
+			for _, item := range arr {
+				if item.Type() == data.TypeInt {
+					i, _ := data.AsInt(item)
+					sum += float64(i)
+					count++
+				} else if item.Type() == data.TypeFloat {
+					f, _ := data.AsFloat(item)
+					sum += f
+					count++
+				} else if item.Type() == data.TypeNull {
+					continue
+				} else {
+					return nil, fmt.Errorf("cannot interpret %s (%T) as a number",
+						item, item)
+				}
+			}
+			if count == 0 {
+				// only null inputs
+				return data.Null{}, nil
+			}
+			return data.Float(sum / float64(count)), nil
+		},
+	},
+	func() udf.AggregateState {
+		return &sumState{avg: true}
 	},
 }
 
@@ -322,65 +573,70 @@ var jsonObjectAggFunc udf.UDF = &twoParamAggFunc{
 //
 //  Input: Int or Float (aggregated)
 //  Return Type: same as maximal input value (Null on empty input)
-var maxFunc udf.UDF = &singleParamAggFunc{
-	aggFun: func(arr []data.Value) (data.Value, error) {
-		if len(arr) == 0 {
-			return data.Null{}, nil
-		}
-		// deal with the case of leading nulls and only nulls
-		firstNonNull := -1
-		for i, item := range arr {
-			if item.Type() != data.TypeNull {
-				firstNonNull = i
-				break
+var maxFunc udf.UDF = &incrementalSingleParamAggFunc{
+	singleParamAggFunc{
+		aggFun: func(arr []data.Value) (data.Value, error) {
+			if len(arr) == 0 {
+				return data.Null{}, nil
 			}
-		}
-		if firstNonNull == -1 {
-			return data.Null{}, nil
-		}
-		// if we have timestamp-shaped data
-		if arr[firstNonNull].Type() == data.TypeTimestamp {
-			maxTime, _ := data.AsTimestamp(arr[firstNonNull])
+			// deal with the case of leading nulls and only nulls
+			firstNonNull := -1
+			for i, item := range arr {
+				if item.Type() != data.TypeNull {
+					firstNonNull = i
+					break
+				}
+			}
+			if firstNonNull == -1 {
+				return data.Null{}, nil
+			}
+			// if we have timestamp-shaped data
+			if arr[firstNonNull].Type() == data.TypeTimestamp {
+				maxTime, _ := data.AsTimestamp(arr[firstNonNull])
+				for _, item := range arr[firstNonNull:] {
+					if item.Type() == data.TypeTimestamp {
+						t, _ := data.AsTimestamp(item)
+						if maxTime.Sub(t).Seconds() < 0 {
+							maxTime = t
+						}
+					} else if item.Type() == data.TypeNull {
+						continue
+					} else {
+						return nil, fmt.Errorf("cannot interpret %s (%T) as a timestamp",
+							item, item)
+					}
+				}
+				return data.Timestamp(maxTime), nil
+			}
+			// else: numeric
+			maxFloat := -float64(math.MaxFloat64)
+			maxInt := int64(math.MinInt64)
 			for _, item := range arr[firstNonNull:] {
-				if item.Type() == data.TypeTimestamp {
-					t, _ := data.AsTimestamp(item)
-					if maxTime.Sub(t).Seconds() < 0 {
-						maxTime = t
+				if item.Type() == data.TypeInt {
+					i, _ := data.AsInt(item)
+					if i > maxInt {
+						maxInt = i
+					}
+				} else if item.Type() == data.TypeFloat {
+					f, _ := data.AsFloat(item)
+					if f > maxFloat {
+						maxFloat = f
 					}
 				} else if item.Type() == data.TypeNull {
 					continue
 				} else {
-					return nil, fmt.Errorf("cannot interpret %s (%T) as a timestamp",
+					return nil, fmt.Errorf("cannot interpret %s (%T) as a number",
 						item, item)
 				}
 			}
-			return data.Timestamp(maxTime), nil
-		}
-		// else: numeric
-		maxFloat := -float64(math.MaxFloat64)
-		maxInt := int64(math.MinInt64)
-		for _, item := range arr[firstNonNull:] {
-			if item.Type() == data.TypeInt {
-				i, _ := data.AsInt(item)
-				if i > maxInt {
-					maxInt = i
-				}
-			} else if item.Type() == data.TypeFloat {
-				f, _ := data.AsFloat(item)
-				if f > maxFloat {
-					maxFloat = f
-				}
-			} else if item.Type() == data.TypeNull {
-				continue
-			} else {
-				return nil, fmt.Errorf("cannot interpret %s (%T) as a number",
-					item, item)
+			if float64(maxInt) >= maxFloat {
+				return data.Int(maxInt), nil
 			}
-		}
-		if float64(maxInt) >= maxFloat {
-			return data.Int(maxInt), nil
-		}
-		return data.Float(maxFloat), nil
+			return data.Float(maxFloat), nil
+		},
+	},
+	func() udf.AggregateState {
+		return &minMaxState{max: true}
 	},
 }
 
@@ -392,65 +648,70 @@ var maxFunc udf.UDF = &singleParamAggFunc{
 //
 //  Input: Int or Float (aggregated)
 //  Return Type: same as minimal input value (Null on empty input)
-var minFunc udf.UDF = &singleParamAggFunc{
-	aggFun: func(arr []data.Value) (data.Value, error) {
-		if len(arr) == 0 {
-			return data.Null{}, nil
-		}
-		// deal with the case of leading nulls and only nulls
-		firstNonNull := -1
-		for i, item := range arr {
-			if item.Type() != data.TypeNull {
-				firstNonNull = i
-				break
+var minFunc udf.UDF = &incrementalSingleParamAggFunc{
+	singleParamAggFunc{
+		aggFun: func(arr []data.Value) (data.Value, error) {
+			if len(arr) == 0 {
+				return data.Null{}, nil
 			}
-		}
-		if firstNonNull == -1 {
-			return data.Null{}, nil
-		}
-		// if we have timestamp-shaped data
-		if arr[firstNonNull].Type() == data.TypeTimestamp {
-			minTime, _ := data.AsTimestamp(arr[firstNonNull])
+			// deal with the case of leading nulls and only nulls
+			firstNonNull := -1
+			for i, item := range arr {
+				if item.Type() != data.TypeNull {
+					firstNonNull = i
+					break
+				}
+			}
+			if firstNonNull == -1 {
+				return data.Null{}, nil
+			}
+			// if we have timestamp-shaped data
+			if arr[firstNonNull].Type() == data.TypeTimestamp {
+				minTime, _ := data.AsTimestamp(arr[firstNonNull])
+				for _, item := range arr[firstNonNull:] {
+					if item.Type() == data.TypeTimestamp {
+						t, _ := data.AsTimestamp(item)
+						if minTime.Sub(t).Seconds() > 0 {
+							minTime = t
+						}
+					} else if item.Type() == data.TypeNull {
+						continue
+					} else {
+						return nil, fmt.Errorf("cannot interpret %s (%T) as a timestamp",
+							item, item)
+					}
+				}
+				return data.Timestamp(minTime), nil
+			}
+			// else: numeric
+			minFloat := float64(math.MaxFloat64)
+			minInt := int64(math.MaxInt64)
 			for _, item := range arr[firstNonNull:] {
-				if item.Type() == data.TypeTimestamp {
-					t, _ := data.AsTimestamp(item)
-					if minTime.Sub(t).Seconds() > 0 {
-						minTime = t
+				if item.Type() == data.TypeInt {
+					i, _ := data.AsInt(item)
+					if i < minInt {
+						minInt = i
+					}
+				} else if item.Type() == data.TypeFloat {
+					f, _ := data.AsFloat(item)
+					if f < minFloat {
+						minFloat = f
 					}
 				} else if item.Type() == data.TypeNull {
 					continue
 				} else {
-					return nil, fmt.Errorf("cannot interpret %s (%T) as a timestamp",
+					return nil, fmt.Errorf("cannot interpret %s (%T) as a number",
 						item, item)
 				}
 			}
-			return data.Timestamp(minTime), nil
-		}
-		// else: numeric
-		minFloat := float64(math.MaxFloat64)
-		minInt := int64(math.MaxInt64)
-		for _, item := range arr[firstNonNull:] {
-			if item.Type() == data.TypeInt {
-				i, _ := data.AsInt(item)
-				if i < minInt {
-					minInt = i
-				}
-			} else if item.Type() == data.TypeFloat {
-				f, _ := data.AsFloat(item)
-				if f < minFloat {
-					minFloat = f
-				}
-			} else if item.Type() == data.TypeNull {
-				continue
-			} else {
-				return nil, fmt.Errorf("cannot interpret %s (%T) as a number",
-					item, item)
+			if float64(minInt) <= minFloat {
+				return data.Int(minInt), nil
 			}
-		}
-		if float64(minInt) <= minFloat {
-			return data.Int(minInt), nil
-		}
-		return data.Float(minFloat), nil
+			return data.Float(minFloat), nil
+		},
+	},
+	func() udf.AggregateState {
+		return &minMaxState{}
 	},
 }
 
@@ -513,49 +774,54 @@ var stringAggFunc udf.UDF = &stringAggFuncTmpl{}
 //  Input: Int or Float (aggregated)
 //  Return Type: Float if the input contains a Float, Int otherwise
 //   (Null on empty input)
-var sumFunc udf.UDF = &singleParamAggFunc{
-	aggFun: func(arr []data.Value) (data.Value, error) {
-		if len(arr) == 0 {
-			return data.Null{}, nil
-		}
-		sum := float64(0.0)
-		intSum := int64(0)
-		hadFloat := false
-		onlyNulls := true
-		for _, item := range arr {
-			if item.Type() == data.TypeInt {
-				i, _ := data.AsInt(item)
-				// if intSum overflows here, so be it. maybe later
-				// additions will fix the situation again. if we
-				// try to detect this here and return an error, we
-				// become dependent on the input order of numbers.
-				intSum += i
-				f := float64(i)
-				sum += f
-				onlyNulls = false
-			} else if item.Type() == data.TypeFloat {
-				f, _ := data.AsFloat(item)
-				sum += f
-				hadFloat = true
-				onlyNulls = false
-			} else if item.Type() == data.TypeNull {
-				continue
-			} else {
-				return nil, fmt.Errorf("cannot interpret %s (%T) as a number",
-					item, item)
+var sumFunc udf.UDF = &incrementalSingleParamAggFunc{
+	singleParamAggFunc{
+		aggFun: func(arr []data.Value) (data.Value, error) {
+			if len(arr) == 0 {
+				return data.Null{}, nil
 			}
-		}
-		if onlyNulls {
-			return data.Null{}, nil
-		}
-		if !hadFloat {
-			// if we had only integers, return the integer sum
-			// (this is better than converting the float sum
-			// back to int64 because we inherit Go's way of dealing
-			// with overflows)
-			return data.Int(intSum), nil
-		}
-		return data.Float(sum), nil
+			sum := float64(0.0)
+			intSum := int64(0)
+			hadFloat := false
+			onlyNulls := true
+			for _, item := range arr {
+				if item.Type() == data.TypeInt {
+					i, _ := data.AsInt(item)
+					// if intSum overflows here, so be it. maybe later
+					// additions will fix the situation again. if we
+					// try to detect this here and return an error, we
+					// become dependent on the input order of numbers.
+					intSum += i
+					f := float64(i)
+					sum += f
+					onlyNulls = false
+				} else if item.Type() == data.TypeFloat {
+					f, _ := data.AsFloat(item)
+					sum += f
+					hadFloat = true
+					onlyNulls = false
+				} else if item.Type() == data.TypeNull {
+					continue
+				} else {
+					return nil, fmt.Errorf("cannot interpret %s (%T) as a number",
+						item, item)
+				}
+			}
+			if onlyNulls {
+				return data.Null{}, nil
+			}
+			if !hadFloat {
+				// if we had only integers, return the integer sum
+				// (this is better than converting the float sum
+				// back to int64 because we inherit Go's way of dealing
+				// with overflows)
+				return data.Int(intSum), nil
+			}
+			return data.Float(sum), nil
+		},
+	},
+	func() udf.AggregateState {
+		return &sumState{}
 	},
 }
 
@@ -206,6 +206,49 @@ func TestUnaryAggregateFuncs(t *testing.T) {
 			// incompatible data
 			{data.Array{data.Int(7), data.Timestamp(someTime)}, nil},
 		}},
+		{"variance", varianceFunc, []udfUnaryTestCaseInput{
+			// empty array: Null
+			{data.Array{}, data.Null{}},
+			// array with only Null
+			{data.Array{data.Null{}}, data.Null{}},
+			// a single value: Null
+			{data.Array{data.Int(7)}, data.Null{}},
+			// normal inputs
+			{data.Array{data.Int(1), data.Null{}, data.Float(3.0)}, data.Float(2.0)},
+			{data.Array{data.Int(2), data.Int(4), data.Int(4), data.Int(4),
+				data.Int(5), data.Int(5), data.Int(7), data.Int(9)}, data.Float(32. / 7)},
+			// incompatible data
+			{data.Array{data.Int(7), data.Timestamp(someTime)}, nil},
+		}},
+		{"stddev", stddevFunc, []udfUnaryTestCaseInput{
+			// empty array: Null
+			{data.Array{}, data.Null{}},
+			// array with only Null
+			{data.Array{data.Null{}}, data.Null{}},
+			// a single value: Null
+			{data.Array{data.Int(7)}, data.Null{}},
+			// normal inputs
+			{data.Array{data.Int(1), data.Null{}, data.Float(3.0)}, data.Float(math.Sqrt(2))},
+			{data.Array{data.Int(2), data.Int(4), data.Int(4), data.Int(4),
+				data.Int(5), data.Int(5), data.Int(7), data.Int(9)}, data.Float(math.Sqrt(32. / 7))},
+			// incompatible data
+			{data.Array{data.Int(7), data.String("hoge")}, nil},
+		}},
+		{"mode", modeFunc, []udfUnaryTestCaseInput{
+			// empty array: Null
+			{data.Array{}, data.Null{}},
+			// array with only Null
+			{data.Array{data.Null{}, data.Null{}}, data.Null{}},
+			// normal inputs
+			{data.Array{data.Int(1), data.Int(2), data.Null{}, data.Int(2), data.Int(3)}, data.Int(2)},
+			{data.Array{data.String("a"), data.Null{}, data.Null{}, data.String("b"),
+				data.String("b")}, data.String("b")},
+			// the value appearing first wins a tie
+			{data.Array{data.Int(3), data.Int(2), data.Int(2), data.Int(3)}, data.Int(3)},
+			// values of different types
+			{data.Array{data.Int(1), data.Float(1.0), data.Timestamp(someTime),
+				data.Timestamp(someTime)}, data.Int(1)},
+		}},
 	}
 
 	for _, testCase := range udfUnaryTestCases {
@@ -326,6 +369,80 @@ func TestBinaryAggregateFuncs(t *testing.T) {
 			// array contains non-string
 			{data.Array{data.String("foo"), data.Int(7)}, data.String(", "), nil},
 		}},
+		{"covar", covarFunc, []udfBinaryTestCaseInput{
+			{data.Array{}, data.Array{}, data.Null{}},
+			// normal cases
+			{data.Array{data.Int(1), data.Int(2), data.Int(3), data.Int(4)},
+				data.Array{data.Int(2), data.Int(4), data.Int(6), data.Int(8)}, data.Float(10. / 3)},
+			{data.Array{data.Int(1), data.Int(3), data.Int(2)},
+				data.Array{data.Float(1), data.Float(2), data.Float(3)}, data.Float(0.5)},
+			// pairs with Null are ignored
+			{data.Array{data.Int(1), data.Null{}, data.Int(3), data.Int(2), data.Int(5)},
+				data.Array{data.Int(1), data.Int(7), data.Int(2), data.Int(3), data.Null{}}, data.Float(0.5)},
+			{data.Array{data.Int(1)}, data.Array{data.Int(1)}, data.Null{}},
+			/// fail cases
+			// different length
+			{data.Array{data.Int(1)}, data.Array{data.Int(1), data.Int(2)}, nil},
+			// non-numeric values
+			{data.Array{data.String("foo")}, data.Array{data.Int(1)}, nil},
+		}},
+		{"corr", corrFunc, []udfBinaryTestCaseInput{
+			{data.Array{}, data.Array{}, data.Null{}},
+			// normal cases
+			{data.Array{data.Int(1), data.Int(2), data.Int(3), data.Int(4)},
+				data.Array{data.Int(2), data.Int(4), data.Int(6), data.Int(8)}, data.Float(1)},
+			{data.Array{data.Int(1), data.Int(3), data.Int(2)},
+				data.Array{data.Float(1), data.Float(2), data.Float(3)}, data.Float(0.5)},
+			{data.Array{data.Int(1), data.Null{}, data.Int(3), data.Int(2), data.Int(5)},
+				data.Array{data.Int(1), data.Int(7), data.Int(2), data.Int(3), data.Null{}}, data.Float(0.5)},
+			// constant values
+			{data.Array{data.Int(1), data.Int(2)}, data.Array{data.Int(3), data.Int(3)}, data.Null{}},
+			/// fail cases
+			{data.Array{data.Int(1)}, data.Array{data.Int(1), data.Int(2)}, nil},
+			{data.Array{data.Int(1)}, data.Array{data.Bool(true)}, nil},
+		}},
+		{"regr_slope", regrSlopeFunc, []udfBinaryTestCaseInput{
+			{data.Array{}, data.Array{}, data.Null{}},
+			// normal cases
+			{data.Array{data.Int(1), data.Int(2), data.Int(3), data.Int(4)},
+				data.Array{data.Int(2), data.Int(4), data.Int(6), data.Int(8)}, data.Float(0.5)},
+			{data.Array{data.Int(1), data.Null{}, data.Int(3), data.Int(2), data.Int(5)},
+				data.Array{data.Int(1), data.Int(7), data.Int(2), data.Int(3), data.Null{}}, data.Float(0.5)},
+			// constant x
+			{data.Array{data.Int(1), data.Int(2)}, data.Array{data.Int(3), data.Int(3)}, data.Null{}},
+			/// fail cases
+			{data.Array{data.Int(1)}, data.Array{data.Int(1), data.Int(2)}, nil},
+		}},
+		{"regr_intercept", regrInterceptFunc, []udfBinaryTestCaseInput{
+			{data.Array{}, data.Array{}, data.Null{}},
+			// normal cases
+			{data.Array{data.Int(1), data.Int(2), data.Int(3), data.Int(4)},
+				data.Array{data.Int(2), data.Int(4), data.Int(6), data.Int(8)}, data.Float(0)},
+			{data.Array{data.Int(1), data.Null{}, data.Int(3), data.Int(2), data.Int(5)},
+				data.Array{data.Int(1), data.Int(7), data.Int(2), data.Int(3), data.Null{}}, data.Float(1)},
+			// constant x
+			{data.Array{data.Int(1), data.Int(2)}, data.Array{data.Int(3), data.Int(3)}, data.Null{}},
+			/// fail cases
+			{data.Array{data.Int(1)}, data.Array{data.Int(1), data.Int(2)}, nil},
+		}},
+		{"percentile_cont", percentileContFunc, []udfBinaryTestCaseInput{
+			{data.Array{}, data.Float(0.5), data.Null{}},
+			{data.Array{data.Null{}}, data.Float(0.5), data.Null{}},
+			// normal cases
+			{data.Array{data.Int(4), data.Int(1), data.Int(3), data.Int(2)}, data.Float(0.5), data.Float(2.5)},
+			{data.Array{data.Int(4), data.Int(1), data.Int(3), data.Int(2)}, data.Float(0.25), data.Float(1.75)},
+			{data.Array{data.Int(4), data.Int(1), data.Int(3), data.Int(2)}, data.Int(0), data.Float(1)},
+			{data.Array{data.Int(4), data.Int(1), data.Int(3), data.Int(2)}, data.Int(1), data.Float(4)},
+			{data.Array{data.Null{}, data.Float(3), data.Int(1)}, data.Float(0.5), data.Float(2)},
+			/// fail cases
+			// percentile out of range
+			{data.Array{data.Int(1)}, data.Float(1.5), nil},
+			{data.Array{data.Int(1)}, data.Float(-0.1), nil},
+			// percentile is non-numeric
+			{data.Array{data.Int(1)}, data.String("a"), nil},
+			// array contains non-numeric values
+			{data.Array{data.Int(1), data.String("a")}, data.Float(0.5), nil},
+		}},
 	}
 
 	for _, testCase := range udfBinaryTestCases {
@@ -374,3 +491,160 @@ func TestBinaryAggregateFuncs(t *testing.T) {
 		})
 	}
 }
+
+func TestIncrementalAggregateFuncs(t *testing.T) {
+	someTime := time.Date(2015, time.May, 1, 14, 27, 0, 0, time.UTC)
+	someTimeLater := time.Date(2015, time.May, 1, 14, 28, 0, 0, time.UTC)
+
+	inputs := []data.Array{
+		{data.Int(3), data.Int(7), data.Null{}, data.Int(-2), data.Int(7)},
+		{data.Float(2.5), data.Int(4), data.Float(-1.5), data.Null{}, data.Int(4)},
+		{data.Null{}, data.Float(1.5), data.Float(1.5), data.Float(0.5)},
+		{data.Timestamp(someTimeLater), data.Timestamp(someTime), data.Null{}},
+		{data.Int(1), data.Timestamp(someTime), data.Int(2)},
+		{data.Null{}, data.Null{}},
+	}
+
+	funcs := []struct {
+		name string
+		f    udf.UDF
+	}{
+		{"avg", avgFunc},
+		{"count", countFunc},
+		{"max", maxFunc},
+		{"min", minFunc},
+		{"stddev", stddevFunc},
+		{"sum", sumFunc},
+		{"variance", varianceFunc},
+	}
+
+	for _, fc := range funcs {
+		fc := fc
+		Convey(fmt.Sprintf("Given the %s function", fc.name), t, func() {
+			f, ok := fc.f.(udf.IncrementalAggregate)
+			So(ok, ShouldBeTrue)
+
+			for i, input := range inputs {
+				input := input
+				Convey(fmt.Sprintf("[%d] When adding and removing the values of %s one by one", i, input), func() {
+					s, err := f.NewAggregateState(nil)
+					So(err, ShouldBeNil)
+
+					check := func(window data.Array) {
+						expected, callErr := f.Call(nil, window)
+						actual, err := s.Result(nil)
+						if callErr != nil {
+							So(err, ShouldNotBeNil)
+							return
+						}
+						So(err, ShouldBeNil)
+						if expected.Type() == data.TypeFloat && actual.Type() == data.TypeFloat {
+							So(actual, ShouldAlmostEqual, expected, 0.0000001)
+						} else {
+							So(actual, ShouldResemble, expected)
+						}
+					}
+
+					Convey("Then the result should always be the same as the one of Call", func() {
+						check(data.Array{})
+						for n, v := range input {
+							if err := s.Add(nil, v); err != nil {
+								// the state is discarded, so Call must fail, too
+								_, callErr := f.Call(nil, input[:n+1])
+								So(callErr, ShouldNotBeNil)
+								return
+							}
+							check(input[:n+1])
+						}
+						for n, v := range input {
+							So(s.Remove(nil, v), ShouldBeNil)
+							check(input[n+1:])
+						}
+					})
+				})
+			}
+
+			Convey("When removing a value that was never added", func() {
+				s, err := f.NewAggregateState(nil)
+				So(err, ShouldBeNil)
+				err = s.Remove(nil, data.Int(1))
+
+				Convey("Then it should fail", func() {
+					So(err, ShouldNotBeNil)
+				})
+			})
+
+			Convey("When adding a value that isn't supported", func() {
+				s, err := f.NewAggregateState(nil)
+				So(err, ShouldBeNil)
+				err = s.Add(nil, data.String("hoge"))
+				_, callErr := f.Call(nil, data.Array{data.String("hoge")})
+
+				Convey("Then it should fail if Call fails", func() {
+					So(err != nil, ShouldEqual, callErr != nil)
+				})
+			})
+		})
+	}
+}
+
+func TestIncrementalAggregateFuncsWithNonFiniteValues(t *testing.T) {
+	inputs := []data.Array{
+		{data.Int(1), data.Float(math.NaN()), data.Float(math.Inf(1)),
+			data.Float(math.Inf(-1)), data.Float(math.NaN()), data.Float(2.5)},
+		{data.Int(1), data.Float(math.Inf(1)), data.Float(math.Inf(-1)), data.Float(2.5)},
+	}
+
+	funcs := []struct {
+		name string
+		f    udf.UDF
+	}{
+		{"avg", avgFunc},
+		{"max", maxFunc},
+		{"min", minFunc},
+		{"sum", sumFunc},
+	}
+
+	for _, fc := range funcs {
+		fc := fc
+		Convey(fmt.Sprintf("Given the %s function", fc.name), t, func() {
+			f, ok := fc.f.(udf.IncrementalAggregate)
+			So(ok, ShouldBeTrue)
+
+			for i, input := range inputs {
+				input := input
+				Convey(fmt.Sprintf("[%d] When adding and removing the values of %s one by one", i, input), func() {
+					s, err := f.NewAggregateState(nil)
+					So(err, ShouldBeNil)
+
+					check := func(window data.Array) {
+						expected, err := f.Call(nil, window)
+						So(err, ShouldBeNil)
+						actual, err := s.Result(nil)
+						So(err, ShouldBeNil)
+						if e, err := data.AsFloat(expected); err == nil && math.IsNaN(e) {
+							a, err := data.AsFloat(actual)
+							So(err, ShouldBeNil)
+							So(math.IsNaN(a), ShouldBeTrue)
+						} else if err == nil && !math.IsInf(e, 0) {
+							So(actual, ShouldAlmostEqual, expected, 0.0000001)
+						} else {
+							So(actual, ShouldResemble, expected)
+						}
+					}
+
+					Convey("Then the result should always be the same as the one of Call", func() {
+						for n, v := range input {
+							So(s.Add(nil, v), ShouldBeNil)
+							check(input[:n+1])
+						}
+						for n, v := range input {
+							So(s.Remove(nil, v), ShouldBeNil)
+							check(input[n+1:])
+						}
+					})
+				})
+			}
+		})
+	}
+}
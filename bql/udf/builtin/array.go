@@ -5,6 +5,7 @@ import (
 	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
 	"gopkg.in/sensorbee/sensorbee.v0/core"
 	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"sort"
 )
 
 // arrayLengthFunc returns the length of the given array.
@@ -23,3 +24,177 @@ var arrayLengthFunc udf.UDF = udf.UnaryFunc(func(ctx *core.Context, arg data.Val
 	}
 	return nil, fmt.Errorf("%v is not an array", arg)
 })
+
+// arrayContainsFunc(arr, v) returns true if the array arr contains a
+// value equal to v.
+// See also: data.Equal
+//
+// It can be used in BQL as `array_contains`.
+//
+//  Input: Array, Any
+//  Return Type: Bool
+var arrayContainsFunc udf.UDF = udf.BinaryFunc(func(ctx *core.Context, arr, v data.Value) (data.Value, error) {
+	if arr.Type() == data.TypeNull {
+		return data.Null{}, nil
+	}
+	a, err := data.AsArray(arr)
+	if err != nil {
+		return nil, fmt.Errorf("%v is not an array", arr)
+	}
+	for _, elem := range a {
+		if data.Equal(elem, v) {
+			return data.Bool(true), nil
+		}
+	}
+	return data.Bool(false), nil
+})
+
+type arraySliceFuncTmpl struct {
+	twoParamFunc
+}
+
+func (f *arraySliceFuncTmpl) Call(ctx *core.Context, args ...data.Value) (val data.Value, err error) {
+	if len(args) < 2 || len(args) > 3 {
+		return nil, fmt.Errorf("function takes two or three arguments")
+	}
+	for _, arg := range args {
+		if arg.Type() == data.TypeNull {
+			return data.Null{}, nil
+		}
+	}
+	a, err := data.AsArray(args[0])
+	if err != nil {
+		return nil, fmt.Errorf("%v is not an array", args[0])
+	}
+	// normalize converts an index to the range [0, len(a)],
+	// where negative indexes count from the end of the array
+	normalize := func(v data.Value) (int, error) {
+		i, err := data.AsInt(v)
+		if err != nil {
+			return 0, fmt.Errorf("cannot interpret %s as an integer", v)
+		}
+		if i < 0 {
+			i += int64(len(a))
+		}
+		if i < 0 {
+			return 0, nil
+		} else if i > int64(len(a)) {
+			return len(a), nil
+		}
+		return int(i), nil
+	}
+	from, err := normalize(args[1])
+	if err != nil {
+		return nil, err
+	}
+	to := len(a)
+	if len(args) == 3 {
+		if to, err = normalize(args[2]); err != nil {
+			return nil, err
+		}
+	}
+	if to < from {
+		to = from
+	}
+	res := make(data.Array, to-from)
+	copy(res, a[from:to])
+	return res, nil
+}
+
+// arraySliceFunc(arr, from, [to]) returns the elements of arr from
+// the index `from` (inclusive, 0-based) to the index `to` (exclusive).
+// If `to` is not given, everything until the end of arr is returned.
+// Negative indexes count from the end of the array.
+//
+// It can be used in BQL as `array_slice`.
+//
+//  Input: Array, Int, [Int]
+//  Return Type: Array
+var arraySliceFunc udf.UDF = &arraySliceFuncTmpl{}
+
+// arrayConcatFunc concatenates all arrays given as input arguments.
+// Null values are ignored, non-array arguments lead to an error.
+//
+// It can be used in BQL as `array_concat`.
+//
+//  Input: n * Array
+//  Return Type: Array
+var arrayConcatFunc udf.UDF = &variadicFunc{
+	minParams: 1,
+	varFun: func(args ...data.Value) (data.Value, error) {
+		res := data.Array{}
+		for _, item := range args {
+			if item.Type() == data.TypeArray {
+				a, _ := data.AsArray(item)
+				res = append(res, a...)
+			} else if item.Type() == data.TypeNull {
+				continue
+			} else {
+				return nil, fmt.Errorf("%v is not an array", item)
+			}
+		}
+		return res, nil
+	},
+}
+
+// arrayDistinctFunc removes duplicate values from an array. The
+// first occurrence of each value is kept.
+// See also: data.Equal
+//
+// It can be used in BQL as `array_distinct`.
+//
+//  Input: Array
+//  Return Type: Array
+var arrayDistinctFunc udf.UDF = udf.UnaryFunc(func(ctx *core.Context, arg data.Value) (val data.Value, err error) {
+	if arg.Type() == data.TypeNull {
+		return data.Null{}, nil
+	}
+	a, err := data.AsArray(arg)
+	if err != nil {
+		return nil, fmt.Errorf("%v is not an array", arg)
+	}
+	res := data.Array{}
+	seen := map[data.HashValue][]data.Value{}
+	for _, elem := range a {
+		h := data.Hash(elem)
+		dup := false
+		for _, v := range seen[h] {
+			if data.Equal(v, elem) {
+				dup = true
+				break
+			}
+		}
+		if !dup {
+			seen[h] = append(seen[h], elem)
+			res = append(res, elem)
+		}
+	}
+	return res, nil
+})
+
+type valueSlice data.Array
+
+func (s valueSlice) Len() int           { return len(s) }
+func (s valueSlice) Less(i, j int) bool { return data.Less(s[i], s[j]) }
+func (s valueSlice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// arraySortFunc sorts the elements of an array in ascending order.
+// Values of different types are ordered as described in data.Less.
+//
+// It can be used in BQL as `array_sort`.
+//
+//  Input: Array
+//  Return Type: Array
+var arraySortFunc udf.UDF = udf.UnaryFunc(func(ctx *core.Context, arg data.Value) (val data.Value, err error) {
+	if arg.Type() == data.TypeNull {
+		return data.Null{}, nil
+	}
+	a, err := data.AsArray(arg)
+	if err != nil {
+		return nil, fmt.Errorf("%v is not an array", arg)
+	}
+	res := make(data.Array, len(a))
+	copy(res, a)
+	sort.Stable(valueSlice(res))
+	return res, nil
+})
@@ -80,3 +80,101 @@ func TestUnaryArrayFuncs(t *testing.T) {
 		})
 	}
 }
+
+func TestArrayFuncs(t *testing.T) {
+	arr := data.Array{data.Int(3), data.String("a"), data.Int(1), data.Float(3), data.Null{}}
+
+	testCases := []struct {
+		name     string
+		f        udf.UDF
+		args     []data.Value
+		expected data.Value
+	}{
+		// array_contains
+		{"array_contains", arrayContainsFunc, []data.Value{arr, data.String("a")}, data.Bool(true)},
+		{"array_contains", arrayContainsFunc, []data.Value{arr, data.Int(1)}, data.Bool(true)},
+		{"array_contains", arrayContainsFunc, []data.Value{arr, data.Float(1)}, data.Bool(true)},
+		{"array_contains", arrayContainsFunc, []data.Value{arr, data.Int(2)}, data.Bool(false)},
+		{"array_contains", arrayContainsFunc, []data.Value{data.Null{}, data.Int(2)}, data.Null{}},
+		{"array_contains", arrayContainsFunc, []data.Value{data.Int(2), data.Int(2)}, nil},
+		// array_slice
+		{"array_slice", arraySliceFunc, []data.Value{arr, data.Int(1), data.Int(3)},
+			data.Array{data.String("a"), data.Int(1)}},
+		{"array_slice", arraySliceFunc, []data.Value{arr, data.Int(3)},
+			data.Array{data.Float(3), data.Null{}}},
+		{"array_slice", arraySliceFunc, []data.Value{arr, data.Int(-2), data.Int(-1)},
+			data.Array{data.Float(3)}},
+		{"array_slice", arraySliceFunc, []data.Value{arr, data.Int(4), data.Int(2)}, data.Array{}},
+		{"array_slice", arraySliceFunc, []data.Value{arr, data.Int(-10), data.Int(10)}, arr},
+		{"array_slice", arraySliceFunc, []data.Value{arr, data.Null{}}, data.Null{}},
+		{"array_slice", arraySliceFunc, []data.Value{arr, data.String("a")}, nil},
+		{"array_slice", arraySliceFunc, []data.Value{data.Map{}, data.Int(1)}, nil},
+		// array_concat
+		{"array_concat", arrayConcatFunc, []data.Value{data.Array{data.Int(1)}, data.Null{},
+			data.Array{data.Int(2), data.Int(3)}}, data.Array{data.Int(1), data.Int(2), data.Int(3)}},
+		{"array_concat", arrayConcatFunc, []data.Value{data.Null{}}, data.Array{}},
+		{"array_concat", arrayConcatFunc, []data.Value{data.Array{}, data.Int(1)}, nil},
+		// array_distinct
+		{"array_distinct", arrayDistinctFunc, []data.Value{data.Array{data.Int(1), data.Int(2),
+			data.Float(1), data.Null{}, data.Null{}, data.String("2")}},
+			data.Array{data.Int(1), data.Int(2), data.Null{}, data.String("2")}},
+		{"array_distinct", arrayDistinctFunc, []data.Value{data.Null{}}, data.Null{}},
+		{"array_distinct", arrayDistinctFunc, []data.Value{data.Int(1)}, nil},
+		// array_sort
+		{"array_sort", arraySortFunc, []data.Value{arr},
+			data.Array{data.Null{}, data.Int(1), data.Int(3), data.Float(3), data.String("a")}},
+		{"array_sort", arraySortFunc, []data.Value{data.Array{}}, data.Array{}},
+		{"array_sort", arraySortFunc, []data.Value{data.Null{}}, data.Null{}},
+		{"array_sort", arraySortFunc, []data.Value{data.String("a")}, nil},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		Convey(fmt.Sprintf("Given the %s function", tc.name), t, func() {
+			Convey(fmt.Sprintf("When evaluating it on %v", tc.args), func() {
+				val, err := tc.f.Call(nil, tc.args...)
+
+				if tc.expected == nil {
+					Convey("Then evaluation should fail", func() {
+						So(err, ShouldNotBeNil)
+					})
+				} else {
+					Convey(fmt.Sprintf("Then the result should be %s", tc.expected), func() {
+						So(err, ShouldBeNil)
+						So(val, ShouldResemble, tc.expected)
+					})
+				}
+			})
+
+			Convey("Then it should equal the one in the default registry", func() {
+				arity := len(tc.args)
+				regFun, err := udf.CopyGlobalUDFRegistry(nil).Lookup(tc.name, arity)
+				So(err, ShouldBeNil)
+				if dispatcher, ok := regFun.(*arityDispatcher); ok {
+					switch arity {
+					case 2:
+						regFun = dispatcher.binary
+					case 3:
+						regFun = dispatcher.ternary
+					}
+				}
+				So(regFun, ShouldEqual, tc.f)
+			})
+		})
+	}
+
+	Convey("Given an array", t, func() {
+		a := data.Array{data.Int(2), data.Int(1)}
+
+		Convey("When sorting and slicing it", func() {
+			_, err := arraySortFunc.Call(nil, a)
+			So(err, ShouldBeNil)
+			_, err = arraySliceFunc.Call(nil, a, data.Int(0))
+			So(err, ShouldBeNil)
+
+			Convey("Then the original array should not be modified", func() {
+				So(a, ShouldResemble, data.Array{data.Int(2), data.Int(1)})
+			})
+		})
+	})
+}
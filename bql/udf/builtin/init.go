@@ -64,22 +64,62 @@ func init() {
 	// time functions
 	udf.RegisterGlobalUDF("distance_us", diffUsFunc)
 	udf.RegisterGlobalUDF("clock_timestamp", clockTimestampFunc)
+	udf.RegisterGlobalUDF("date_part", datePartFunc)
+	udf.RegisterGlobalUDF("date_trunc", dateTruncFunc)
+	udf.RegisterGlobalUDF("strftime", toCharFunc)
+	udf.RegisterGlobalUDF("timezone", timezoneFunc)
+	udf.RegisterGlobalUDF("to_char", toCharFunc)
+	udf.RegisterGlobalUDF("to_timestamp", &arityDispatcher{
+		unary: epochToTimestampFunc, binary: parseTimestampFunc,
+		ternary: parseTimestampFunc})
 	// array functions
+	udf.RegisterGlobalUDF("array_concat", arrayConcatFunc)
+	udf.RegisterGlobalUDF("array_contains", arrayContainsFunc)
+	udf.RegisterGlobalUDF("array_distinct", arrayDistinctFunc)
 	udf.RegisterGlobalUDF("array_length", arrayLengthFunc)
+	udf.RegisterGlobalUDF("array_slice", &arityDispatcher{
+		binary: arraySliceFunc, ternary: arraySliceFunc})
+	udf.RegisterGlobalUDF("array_sort", arraySortFunc)
+	// map functions
+	udf.RegisterGlobalUDF("map_keys", mapKeysFunc)
+	udf.RegisterGlobalUDF("map_merge", mapMergeFunc)
+	udf.RegisterGlobalUDF("map_remove", mapRemoveFunc)
+	udf.RegisterGlobalUDF("map_values", mapValuesFunc)
+	// smoothing functions
+	udf.RegisterGlobalUDF("exp_smooth", expSmoothFunc)
+	udf.RegisterGlobalUDF("moving_avg", movingAvgFunc)
 	// aggregate functions
 	udf.RegisterGlobalUDF("array_agg", arrayAggFunc)
 	udf.RegisterGlobalUDF("avg", avgFunc)
+	udf.RegisterGlobalUDF("corr", corrFunc)
 	udf.RegisterGlobalUDF("count", countFunc)
+	udf.RegisterGlobalUDF("covar", covarFunc)
 	udf.RegisterGlobalUDF("bool_and", boolAndFunc)
 	udf.RegisterGlobalUDF("bool_or", boolOrFunc)
 	udf.RegisterGlobalUDF("json_object_agg", jsonObjectAggFunc)
 	udf.RegisterGlobalUDF("max", maxFunc)
 	udf.RegisterGlobalUDF("median", medianFunc)
 	udf.RegisterGlobalUDF("min", minFunc)
+	udf.RegisterGlobalUDF("mode", modeFunc)
+	udf.RegisterGlobalUDF("percentile_cont", percentileContFunc)
+	udf.RegisterGlobalUDF("regr_intercept", regrInterceptFunc)
+	udf.RegisterGlobalUDF("regr_slope", regrSlopeFunc)
+	udf.RegisterGlobalUDF("stddev", stddevFunc)
 	udf.RegisterGlobalUDF("string_agg", stringAggFunc)
 	udf.RegisterGlobalUDF("sum", sumFunc)
+	udf.RegisterGlobalUDF("variance", varianceFunc)
+	// approximate aggregate functions
+	udf.RegisterGlobalUDF("approx_count_distinct", approxCountDistinctFunc)
+	udf.RegisterGlobalUDF("approx_percentile", approxPercentileFunc)
+	udf.RegisterGlobalUDF("top_k", topKFunc)
 	// conversion functions
 	udf.RegisterGlobalUDF("blob_to_raw_string", udf.MustConvertGeneric(blobToRawString))
 	// other functions
 	udf.RegisterGlobalUDF("coalesce", coalesceFunc)
+
+	// stream-generating functions
+	udf.MustRegisterGlobalUDSFCreator("unnest", &unnestUDSFCreator{})
+
+	// states
+	udf.MustRegisterGlobalUDSCreator("keyed_table", udf.UDSCreatorFunc(createKeyedTable))
 }
@@ -0,0 +1,137 @@
+package builtin
+
+import (
+	"errors"
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"sync"
+)
+
+// keyedTable is a udf.TableSharedState holding one row per key. Tuples
+// written to the table (e.g., via the `uds` sink) insert a new row or
+// replace the row having the same key.
+//
+// It can be created in BQL as
+//
+//  CREATE STATE devices TYPE keyed_table WITH key = "id";
+//
+// The `key` parameter is optional and defaults to "id".
+type keyedTable struct {
+	key     string
+	keyPath data.Path
+
+	m          sync.RWMutex
+	rows       map[data.HashValue][]data.Map
+	terminated bool
+}
+
+var (
+	_ udf.TableSharedState = &keyedTable{}
+	_ core.Writer          = &keyedTable{}
+)
+
+func createKeyedTable(ctx *core.Context, params data.Map) (core.SharedState, error) {
+	key := "id"
+	if v, ok := params["key"]; ok {
+		k, err := data.AsString(v)
+		if err != nil {
+			return nil, fmt.Errorf("'key' parameter must be a string: %v", err)
+		}
+		key = k
+	}
+	path, err := data.CompilePath(key)
+	if err != nil {
+		return nil, fmt.Errorf("'key' parameter doesn't have a valid path: %v", err)
+	}
+	return &keyedTable{
+		key:     key,
+		keyPath: path,
+		rows:    map[data.HashValue][]data.Map{},
+	}, nil
+}
+
+func (t *keyedTable) Key() string {
+	return t.key
+}
+
+func (t *keyedTable) Lookup(ctx *core.Context, key data.Value) (data.Map, error) {
+	t.m.RLock()
+	defer t.m.RUnlock()
+	if t.terminated {
+		return nil, errors.New("the table is already terminated")
+	}
+	if _, row := t.find(key); row != nil {
+		return row.Copy(), nil
+	}
+	return nil, nil
+}
+
+func (t *keyedTable) Scan(ctx *core.Context, f func(row data.Map) error) error {
+	t.m.RLock()
+	rows := make([]data.Map, 0, len(t.rows))
+	for _, bucket := range t.rows {
+		for _, row := range bucket {
+			rows = append(rows, row.Copy())
+		}
+	}
+	terminated := t.terminated
+	t.m.RUnlock()
+
+	// f is called without the lock so that it can write to the table
+	if terminated {
+		return errors.New("the table is already terminated")
+	}
+	for _, row := range rows {
+		if err := f(row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// find returns the index of the row having the given key in its bucket
+// and the row itself. The caller must hold the lock.
+func (t *keyedTable) find(key data.Value) (int, data.Map) {
+	for i, row := range t.rows[data.Hash(key)] {
+		if v, err := row.Get(t.keyPath); err == nil && data.Equal(v, key) {
+			return i, row
+		}
+	}
+	return -1, nil
+}
+
+// Write inserts the data of the tuple as a row into the table. When the
+// table already has a row with the same key, the row is replaced.
+func (t *keyedTable) Write(ctx *core.Context, tuple *core.Tuple) error {
+	key, err := tuple.Data.Get(t.keyPath)
+	if err != nil {
+		return fmt.Errorf("the tuple doesn't have the key '%v': %v", t.key, err)
+	}
+	if key.Type() == data.TypeNull {
+		return fmt.Errorf("the key '%v' of the tuple must not be null", t.key)
+	}
+	row := tuple.Data.Copy()
+
+	t.m.Lock()
+	defer t.m.Unlock()
+	if t.terminated {
+		return errors.New("the table is already terminated")
+	}
+	h := data.Hash(key)
+	if i, _ := t.find(key); i >= 0 {
+		t.rows[h][i] = row
+	} else {
+		t.rows[h] = append(t.rows[h], row)
+	}
+	return nil
+}
+
+func (t *keyedTable) Terminate(ctx *core.Context) error {
+	t.m.Lock()
+	defer t.m.Unlock()
+	t.terminated = true
+	t.rows = nil
+	return nil
+}
@@ -0,0 +1,106 @@
+package builtin
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"testing"
+)
+
+func TestKeyedTable(t *testing.T) {
+	ctx := core.NewContext(nil)
+
+	Convey("Given a keyed_table state", t, func() {
+		s, err := createKeyedTable(ctx, data.Map{"key": data.String("id")})
+		So(err, ShouldBeNil)
+		So(s, ShouldImplement, (*udf.TableSharedState)(nil))
+		table := s.(*keyedTable)
+		So(table.Key(), ShouldEqual, "id")
+
+		Convey("When writing rows to it", func() {
+			So(table.Write(ctx, core.NewTuple(data.Map{"id": data.Int(1), "v": data.String("a")})), ShouldBeNil)
+			So(table.Write(ctx, core.NewTuple(data.Map{"id": data.Int(2), "v": data.String("b")})), ShouldBeNil)
+			So(table.Write(ctx, core.NewTuple(data.Map{"id": data.Int(1), "v": data.String("c")})), ShouldBeNil)
+
+			Convey("Then Lookup should return the last row written for a key", func() {
+				row, err := table.Lookup(ctx, data.Int(1))
+				So(err, ShouldBeNil)
+				So(row, ShouldResemble, data.Map{"id": data.Int(1), "v": data.String("c")})
+
+				Convey("And modifying it should not change the table", func() {
+					row["v"] = data.String("d")
+					row, err := table.Lookup(ctx, data.Int(1))
+					So(err, ShouldBeNil)
+					So(row["v"], ShouldEqual, data.String("c"))
+				})
+			})
+
+			Convey("Then Lookup should compare keys by value", func() {
+				row, err := table.Lookup(ctx, data.Float(2))
+				So(err, ShouldBeNil)
+				So(row, ShouldResemble, data.Map{"id": data.Int(2), "v": data.String("b")})
+			})
+
+			Convey("Then Lookup should return nil for a missing key", func() {
+				row, err := table.Lookup(ctx, data.Int(3))
+				So(err, ShouldBeNil)
+				So(row, ShouldBeNil)
+			})
+
+			Convey("Then Scan should return all rows", func() {
+				values := map[string]bool{}
+				So(table.Scan(ctx, func(row data.Map) error {
+					v, _ := data.AsString(row["v"])
+					values[v] = true
+					return nil
+				}), ShouldBeNil)
+				So(values, ShouldResemble, map[string]bool{"b": true, "c": true})
+			})
+		})
+
+		Convey("When writing a row without a key", func() {
+			err := table.Write(ctx, core.NewTuple(data.Map{"v": data.String("a")}))
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When writing a row with a null key", func() {
+			err := table.Write(ctx, core.NewTuple(data.Map{"id": data.Null{}}))
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When the table is terminated", func() {
+			So(table.Terminate(ctx), ShouldBeNil)
+
+			Convey("Then it cannot be used anymore", func() {
+				So(table.Write(ctx, core.NewTuple(data.Map{"id": data.Int(1)})), ShouldNotBeNil)
+				_, err := table.Lookup(ctx, data.Int(1))
+				So(err, ShouldNotBeNil)
+				So(table.Scan(ctx, func(data.Map) error { return nil }), ShouldNotBeNil)
+			})
+		})
+	})
+
+	Convey("Given a keyed_table state without parameters", t, func() {
+		s, err := createKeyedTable(ctx, data.Map{})
+		So(err, ShouldBeNil)
+
+		Convey("Then its key should be id", func() {
+			So(s.(*keyedTable).Key(), ShouldEqual, "id")
+		})
+	})
+
+	Convey("Given a keyed_table state with an invalid key parameter", t, func() {
+		_, err := createKeyedTable(ctx, data.Map{"key": data.Int(1)})
+
+		Convey("Then it should fail", func() {
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
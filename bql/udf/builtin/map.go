@@ -0,0 +1,131 @@
+package builtin
+
+import (
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"sort"
+)
+
+// sortedKeys returns the keys of a map in ascending order.
+func sortedKeys(m data.Map) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// mapKeysFunc returns the keys of a map as an array. The keys
+// are sorted in ascending order.
+//
+// It can be used in BQL as `map_keys`.
+//
+//  Input: Map
+//  Return Type: Array
+var mapKeysFunc udf.UDF = udf.UnaryFunc(func(ctx *core.Context, arg data.Value) (val data.Value, err error) {
+	if arg.Type() == data.TypeNull {
+		return data.Null{}, nil
+	}
+	m, err := data.AsMap(arg)
+	if err != nil {
+		return nil, fmt.Errorf("%v is not a map", arg)
+	}
+	res := make(data.Array, 0, len(m))
+	for _, k := range sortedKeys(m) {
+		res = append(res, data.String(k))
+	}
+	return res, nil
+})
+
+// mapValuesFunc returns the values of a map as an array. The values
+// are in the same order as the keys returned by map_keys.
+//
+// It can be used in BQL as `map_values`.
+//
+//  Input: Map
+//  Return Type: Array
+var mapValuesFunc udf.UDF = udf.UnaryFunc(func(ctx *core.Context, arg data.Value) (val data.Value, err error) {
+	if arg.Type() == data.TypeNull {
+		return data.Null{}, nil
+	}
+	m, err := data.AsMap(arg)
+	if err != nil {
+		return nil, fmt.Errorf("%v is not a map", arg)
+	}
+	res := make(data.Array, 0, len(m))
+	for _, k := range sortedKeys(m) {
+		res = append(res, m[k])
+	}
+	return res, nil
+})
+
+// mapMergeFunc merges all maps given as input arguments into a new
+// map. If a key exists in more than one map, the value of the last
+// one is used. Null values are ignored, non-map arguments lead to
+// an error.
+//
+// It can be used in BQL as `map_merge`.
+//
+//  Input: n * Map
+//  Return Type: Map
+var mapMergeFunc udf.UDF = &variadicFunc{
+	minParams: 1,
+	varFun: func(args ...data.Value) (data.Value, error) {
+		res := data.Map{}
+		for _, item := range args {
+			if item.Type() == data.TypeMap {
+				m, _ := data.AsMap(item)
+				for k, v := range m {
+					res[k] = v
+				}
+			} else if item.Type() == data.TypeNull {
+				continue
+			} else {
+				return nil, fmt.Errorf("%v is not a map", item)
+			}
+		}
+		return res, nil
+	},
+}
+
+// mapRemoveFunc(m, key...) returns a copy of the map m without the
+// given keys. Keys that do not exist in m are ignored.
+//
+// It can be used in BQL as `map_remove`.
+//
+//  Input: Map, n * String
+//  Return Type: Map
+var mapRemoveFunc udf.UDF = &variadicFunc{
+	minParams: 2,
+	varFun: func(args ...data.Value) (data.Value, error) {
+		if args[0].Type() == data.TypeNull {
+			return data.Null{}, nil
+		}
+		m, err := data.AsMap(args[0])
+		if err != nil {
+			return nil, fmt.Errorf("%v is not a map", args[0])
+		}
+		remove := map[string]bool{}
+		for _, item := range args[1:] {
+			if item.Type() == data.TypeNull {
+				continue
+			}
+			k, err := data.AsString(item)
+			if err != nil {
+				return nil, fmt.Errorf("cannot interpret %s (%T) as a string",
+					item, item)
+			}
+			remove[k] = true
+		}
+		res := make(data.Map, len(m))
+		for k, v := range m {
+			if !remove[k] {
+				res[k] = v
+			}
+		}
+		return res, nil
+	},
+}
@@ -0,0 +1,81 @@
+package builtin
+
+import (
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"testing"
+)
+
+func TestMapFuncs(t *testing.T) {
+	m := data.Map{"b": data.Int(2), "a": data.String("x"), "c": data.Null{}}
+
+	testCases := []struct {
+		name     string
+		f        udf.UDF
+		args     []data.Value
+		expected data.Value
+	}{
+		// map_keys
+		{"map_keys", mapKeysFunc, []data.Value{m},
+			data.Array{data.String("a"), data.String("b"), data.String("c")}},
+		{"map_keys", mapKeysFunc, []data.Value{data.Map{}}, data.Array{}},
+		{"map_keys", mapKeysFunc, []data.Value{data.Null{}}, data.Null{}},
+		{"map_keys", mapKeysFunc, []data.Value{data.Array{}}, nil},
+		// map_values
+		{"map_values", mapValuesFunc, []data.Value{m},
+			data.Array{data.String("x"), data.Int(2), data.Null{}}},
+		{"map_values", mapValuesFunc, []data.Value{data.Null{}}, data.Null{}},
+		{"map_values", mapValuesFunc, []data.Value{data.Int(1)}, nil},
+		// map_merge
+		{"map_merge", mapMergeFunc, []data.Value{m, data.Null{}, data.Map{"a": data.Int(1), "d": data.Int(4)}},
+			data.Map{"a": data.Int(1), "b": data.Int(2), "c": data.Null{}, "d": data.Int(4)}},
+		{"map_merge", mapMergeFunc, []data.Value{data.Null{}}, data.Map{}},
+		{"map_merge", mapMergeFunc, []data.Value{m, data.Array{}}, nil},
+		// map_remove
+		{"map_remove", mapRemoveFunc, []data.Value{m, data.String("a"), data.String("d")},
+			data.Map{"b": data.Int(2), "c": data.Null{}}},
+		{"map_remove", mapRemoveFunc, []data.Value{m, data.Null{}}, m},
+		{"map_remove", mapRemoveFunc, []data.Value{data.Null{}, data.String("a")}, data.Null{}},
+		{"map_remove", mapRemoveFunc, []data.Value{m, data.Int(1)}, nil},
+		{"map_remove", mapRemoveFunc, []data.Value{data.Array{}, data.String("a")}, nil},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		Convey(fmt.Sprintf("Given the %s function", tc.name), t, func() {
+			Convey(fmt.Sprintf("When evaluating it on %v", tc.args), func() {
+				val, err := tc.f.Call(nil, tc.args...)
+
+				if tc.expected == nil {
+					Convey("Then evaluation should fail", func() {
+						So(err, ShouldNotBeNil)
+					})
+				} else {
+					Convey(fmt.Sprintf("Then the result should be %s", tc.expected), func() {
+						So(err, ShouldBeNil)
+						So(val, ShouldResemble, tc.expected)
+					})
+				}
+			})
+
+			Convey("Then it should equal the one in the default registry", func() {
+				regFun, err := udf.CopyGlobalUDFRegistry(nil).Lookup(tc.name, len(tc.args))
+				So(err, ShouldBeNil)
+				So(regFun, ShouldEqual, tc.f)
+			})
+		})
+	}
+
+	Convey("Given a map", t, func() {
+		Convey("When removing a key from it", func() {
+			_, err := mapRemoveFunc.Call(nil, m, data.String("a"))
+			So(err, ShouldBeNil)
+
+			Convey("Then the original map should not be modified", func() {
+				So(m, ShouldContainKey, "a")
+			})
+		})
+	})
+}
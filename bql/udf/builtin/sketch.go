@@ -0,0 +1,563 @@
+package builtin
+
+import (
+	"container/heap"
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"math"
+	"sort"
+)
+
+// The aggregate functions in this file compute approximate results using
+// sketches, i.e., summaries of their input that have a bounded size.
+// This way, they can be used with large windows without keeping or
+// sorting all values.
+
+// mixHash improves the distribution of the bits of a hash value computed
+// by data.Hash (the finalizer of MurmurHash3).
+func mixHash(h data.HashValue) uint64 {
+	x := uint64(h)
+	x ^= x >> 33
+	x *= 0xff51afd7ed558ccd
+	x ^= x >> 33
+	x *= 0xc4ceb9fe1a85ec53
+	x ^= x >> 33
+	return x
+}
+
+/* top_k */
+
+// topKCounterFactor is the number of counters the Space-Saving algorithm
+// uses per requested item. The more counters there are, the more
+// accurate the counts are.
+const topKCounterFactor = 10
+
+type topKCounter struct {
+	value data.Value
+	hash  data.HashValue
+	count int64
+	index int
+}
+
+// topKCounters is a min-heap of counters ordered by their count. Counters
+// having the same count are ordered by their values in the reverse order
+// of data.Less, so that the result doesn't depend on the order in which
+// values are added.
+type topKCounters []*topKCounter
+
+func (h topKCounters) Len() int {
+	return len(h)
+}
+
+func (h topKCounters) Less(i, j int) bool {
+	if h[i].count == h[j].count {
+		return data.Less(h[j].value, h[i].value)
+	}
+	return h[i].count < h[j].count
+}
+
+func (h topKCounters) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+func (h *topKCounters) Push(x interface{}) {
+	c := x.(*topKCounter)
+	c.index = len(*h)
+	*h = append(*h, c)
+}
+
+func (h *topKCounters) Pop() interface{} {
+	old := *h
+	c := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return c
+}
+
+type topKFuncTmpl struct {
+}
+
+func (f *topKFuncTmpl) Accept(arity int) bool {
+	return arity == 2
+}
+
+func (f *topKFuncTmpl) Bind(ctx *core.Context, params ...data.Value) (udf.UDAF, error) {
+	k, err := data.AsInt(params[0])
+	if err != nil {
+		return nil, fmt.Errorf("function needs int input, not %T", params[0])
+	}
+	if k <= 0 {
+		return nil, fmt.Errorf("the number of items must be positive, not %v", k)
+	}
+	return &topK{k}, nil
+}
+
+// topK is a udf.UDAF returning the k most frequent values.
+type topK struct {
+	k int64
+}
+
+// topKState uses the Space-Saving algorithm: when a value without a
+// counter arrives and all counters are in use, the counter with the
+// smallest count is taken over by the new value.
+type topKState struct {
+	k        int64
+	capacity int
+	counters topKCounters
+	byHash   map[data.HashValue][]*topKCounter
+}
+
+func (t *topK) Init(ctx *core.Context) (udf.UDAFState, error) {
+	return &topKState{
+		k:        t.k,
+		capacity: int(t.k) * topKCounterFactor,
+		byHash:   map[data.HashValue][]*topKCounter{},
+	}, nil
+}
+
+func (s *topKState) lookup(v data.Value, h data.HashValue) *topKCounter {
+	for _, c := range s.byHash[h] {
+		if data.Equal(c.value, v) {
+			return c
+		}
+	}
+	return nil
+}
+
+// minCount returns the largest count a value not having a counter can
+// have, i.e., the smallest count when all counters are in use.
+func (s *topKState) minCount() int64 {
+	if len(s.counters) < s.capacity {
+		return 0
+	}
+	return s.counters[0].count
+}
+
+func (s *topKState) Accumulate(ctx *core.Context, v data.Value) error {
+	if v.Type() == data.TypeNull {
+		return nil
+	}
+	h := data.Hash(v)
+	if c := s.lookup(v, h); c != nil {
+		c.count++
+		heap.Fix(&s.counters, c.index)
+		return nil
+	}
+	if len(s.counters) < s.capacity {
+		c := &topKCounter{value: v, hash: h, count: 1}
+		heap.Push(&s.counters, c)
+		s.byHash[h] = append(s.byHash[h], c)
+		return nil
+	}
+
+	// replace the value of the smallest counter
+	c := s.counters[0]
+	others := s.byHash[c.hash]
+	for i, o := range others {
+		if o == c {
+			others = append(others[:i], others[i+1:]...)
+			break
+		}
+	}
+	if len(others) == 0 {
+		delete(s.byHash, c.hash)
+	} else {
+		s.byHash[c.hash] = others
+	}
+	c.value, c.hash = v, h
+	c.count++
+	heap.Fix(&s.counters, 0)
+	s.byHash[h] = append(s.byHash[h], c)
+	return nil
+}
+
+// Merge combines two summaries as described in "Mergeable Summaries" by
+// Agarwal et al.: the count of a value is the sum of its counts in both
+// summaries, where a value not having a counter in a summary is counted
+// as the smallest count of the summary. Only the counters having the
+// largest counts are kept.
+func (s *topKState) Merge(ctx *core.Context, other udf.UDAFState) error {
+	o, ok := other.(*topKState)
+	if !ok {
+		return fmt.Errorf("cannot merge %T", other)
+	}
+	sMin, oMin := s.minCount(), o.minCount()
+	all := make(topKCounters, 0, len(s.counters)+len(o.counters))
+	for _, c := range s.counters {
+		n := oMin
+		if oc := o.lookup(c.value, c.hash); oc != nil {
+			n = oc.count
+		}
+		all = append(all, &topKCounter{value: c.value, hash: c.hash, count: c.count + n})
+	}
+	for _, c := range o.counters {
+		if s.lookup(c.value, c.hash) != nil {
+			continue
+		}
+		all = append(all, &topKCounter{value: c.value, hash: c.hash, count: c.count + sMin})
+	}
+
+	sort.Sort(sort.Reverse(all))
+	if len(all) > s.capacity {
+		all = all[:s.capacity]
+	}
+	s.counters = s.counters[:0]
+	s.byHash = make(map[data.HashValue][]*topKCounter, len(all))
+	for _, c := range all {
+		heap.Push(&s.counters, c)
+		s.byHash[c.hash] = append(s.byHash[c.hash], c)
+	}
+	return nil
+}
+
+func (s *topKState) Finalize(ctx *core.Context) (data.Value, error) {
+	if len(s.counters) == 0 {
+		return data.Null{}, nil
+	}
+	// emit the counters with the largest counts
+	sorted := make(topKCounters, len(s.counters))
+	copy(sorted, s.counters)
+	sort.Sort(sort.Reverse(sorted))
+	if int64(len(sorted)) > s.k {
+		sorted = sorted[:s.k]
+	}
+	result := make(data.Array, len(sorted))
+	for i, c := range sorted {
+		result[i] = data.Map{
+			"value": c.value,
+			"count": data.Int(c.count),
+		}
+	}
+	return result, nil
+}
+
+// topKFunc(expr, k) is an aggregate function that returns the k most
+// frequent non-null input values as an array of maps with the keys
+// "value" and "count", ordered by decreasing count. Values having the
+// same count are ordered by their values in ascending order. Counts can be overestimated
+// when there are many distinct values, but a value occurring more than
+// n/(10*k) times out of n values is always returned if it is among the
+// k most frequent ones. When k is a constant, the counters are updated
+// incrementally while values enter and leave windows.
+//
+// It can be used in BQL as `top_k`.
+//
+//  Input: any (aggregated), Int
+//  Return Type: Array (Null on empty input)
+var topKFunc udf.UDF = udf.ConvertParameterizedUDAF(&topKFuncTmpl{})
+
+/* approx_count_distinct */
+
+// hllPrecision is the number of bits of a hash value used to select a
+// register of a HyperLogLog sketch. The standard error of the estimate
+// is 1.04/sqrt(2^hllPrecision), i.e., about 1.6%.
+const hllPrecision = 12
+
+// hyperLogLog is a udf.UDAF estimating the number of distinct values.
+type hyperLogLog struct{}
+
+type hyperLogLogState struct {
+	registers []uint8
+}
+
+func (h *hyperLogLog) Init(ctx *core.Context) (udf.UDAFState, error) {
+	return &hyperLogLogState{make([]uint8, 1<<hllPrecision)}, nil
+}
+
+func (s *hyperLogLogState) Accumulate(ctx *core.Context, v data.Value) error {
+	if v.Type() == data.TypeNull {
+		return nil
+	}
+	x := mixHash(data.Hash(v))
+	idx := x >> (64 - hllPrecision)
+	// the rank is the position of the leftmost 1-bit in the remaining bits
+	rank := uint8(1)
+	for w := x << hllPrecision; rank <= 64-hllPrecision && w&(1<<63) == 0; w <<= 1 {
+		rank++
+	}
+	if rank > s.registers[idx] {
+		s.registers[idx] = rank
+	}
+	return nil
+}
+
+func (s *hyperLogLogState) Merge(ctx *core.Context, other udf.UDAFState) error {
+	o, ok := other.(*hyperLogLogState)
+	if !ok {
+		return fmt.Errorf("cannot merge %T", other)
+	}
+	for i, r := range o.registers {
+		if r > s.registers[i] {
+			s.registers[i] = r
+		}
+	}
+	return nil
+}
+
+func (s *hyperLogLogState) Finalize(ctx *core.Context) (data.Value, error) {
+	m := float64(len(s.registers))
+	sum := 0.0
+	zeros := 0
+	for _, r := range s.registers {
+		sum += math.Ldexp(1, -int(r))
+		if r == 0 {
+			zeros++
+		}
+	}
+	alpha := 0.7213 / (1 + 1.079/m)
+	estimate := alpha * m * m / sum
+	if estimate <= 2.5*m && zeros > 0 {
+		// use linear counting for small cardinalities
+		estimate = m * math.Log(m/float64(zeros))
+	}
+	return data.Int(int64(estimate + 0.5)), nil
+}
+
+// approxCountDistinctFunc is an aggregate function that estimates the
+// number of distinct non-null input values using a HyperLogLog sketch.
+// The relative error is about 1.6%, small numbers are usually exact.
+//
+// It can be used in BQL as `approx_count_distinct`.
+//
+//  Input: any (aggregated)
+//  Return Type: Int
+var approxCountDistinctFunc udf.UDF = udf.ConvertUDAF(&hyperLogLog{})
+
+/* approx_percentile */
+
+// tDigestCompression controls the number of centroids of a t-digest.
+// A t-digest has at most about that many centroids.
+const tDigestCompression = 100
+
+type centroid struct {
+	mean   float64
+	weight float64
+}
+
+// tDigest is a sketch of a distribution of numbers that is accurate
+// for quantiles close to 0 and 1, such as the 99th percentile. Values
+// are buffered and merged with the centroids, which are sorted by
+// their mean, when the buffer is full.
+type tDigest struct {
+	centroids []centroid
+	buffer    []centroid
+	// total is the sum of the weights of the centroids, which doesn't
+	// include the buffer.
+	total float64
+	min   float64
+	max   float64
+}
+
+func newTDigest() *tDigest {
+	return &tDigest{
+		buffer: make([]centroid, 0, 5*tDigestCompression),
+		min:    math.Inf(1),
+		max:    math.Inf(-1),
+	}
+}
+
+func (t *tDigest) add(c centroid) {
+	t.buffer = append(t.buffer, c)
+	if c.mean < t.min {
+		t.min = c.mean
+	}
+	if c.mean > t.max {
+		t.max = c.mean
+	}
+	if len(t.buffer) >= cap(t.buffer) {
+		t.flush()
+	}
+}
+
+// merge adds all values in another t-digest, which isn't modified.
+func (t *tDigest) merge(o *tDigest) {
+	for _, c := range o.centroids {
+		t.add(c)
+	}
+	for _, c := range o.buffer {
+		t.add(c)
+	}
+	// the min and max of o can be more extreme than its centroids
+	if o.min < t.min {
+		t.min = o.min
+	}
+	if o.max > t.max {
+		t.max = o.max
+	}
+}
+
+func (t *tDigest) clone() *tDigest {
+	c := *t
+	c.centroids = append([]centroid(nil), t.centroids...)
+	c.buffer = append(make([]centroid, 0, cap(t.buffer)), t.buffer...)
+	return &c
+}
+
+// scale maps a quantile to an index such that the centroids covering
+// one unit of the index are small close to 0 and 1.
+func (t *tDigest) scale(q float64) float64 {
+	return tDigestCompression / (2 * math.Pi) * math.Asin(2*q-1)
+}
+
+type centroidsByMean []centroid
+
+func (c centroidsByMean) Len() int {
+	return len(c)
+}
+
+func (c centroidsByMean) Less(i, j int) bool {
+	return c[i].mean < c[j].mean
+}
+
+func (c centroidsByMean) Swap(i, j int) {
+	c[i], c[j] = c[j], c[i]
+}
+
+func (t *tDigest) flush() {
+	if len(t.buffer) == 0 {
+		return
+	}
+	sort.Sort(centroidsByMean(t.buffer))
+	// merge the sorted centroids and the sorted buffer
+	all := make([]centroid, 0, len(t.centroids)+len(t.buffer))
+	i, j := 0, 0
+	for i < len(t.centroids) || j < len(t.buffer) {
+		if j == len(t.buffer) || (i < len(t.centroids) && t.centroids[i].mean <= t.buffer[j].mean) {
+			all = append(all, t.centroids[i])
+			i++
+		} else {
+			all = append(all, t.buffer[j])
+			t.total += t.buffer[j].weight
+			j++
+		}
+	}
+	t.buffer = t.buffer[:0]
+
+	// combine neighboring centroids as long as they don't cover
+	// more than one unit of the scale
+	merged := make([]centroid, 0, len(t.centroids)+1)
+	cur := all[0]
+	weightSoFar := 0.0
+	for _, next := range all[1:] {
+		q0 := weightSoFar / t.total
+		q2 := (weightSoFar + cur.weight + next.weight) / t.total
+		if t.scale(q2)-t.scale(q0) <= 1 {
+			w := cur.weight + next.weight
+			cur.mean += (next.mean - cur.mean) * next.weight / w
+			cur.weight = w
+			continue
+		}
+		merged = append(merged, cur)
+		weightSoFar += cur.weight
+		cur = next
+	}
+	t.centroids = append(merged, cur)
+}
+
+// quantile returns the estimated q-quantile, where 0 <= q <= 1. The
+// values of the centroids are assumed to be located around their mean.
+func (t *tDigest) quantile(q float64) float64 {
+	t.flush()
+	if len(t.centroids) == 1 {
+		return t.centroids[0].mean
+	}
+	target := q * t.total
+	weightSoFar := 0.0
+	prevCenter, prevMean := 0.0, t.min
+	for _, c := range t.centroids {
+		center := weightSoFar + c.weight/2
+		if target < center {
+			if center == prevCenter {
+				return c.mean
+			}
+			return prevMean + (c.mean-prevMean)*(target-prevCenter)/(center-prevCenter)
+		}
+		weightSoFar += c.weight
+		prevCenter, prevMean = center, c.mean
+	}
+	if t.total == prevCenter {
+		return t.max
+	}
+	return prevMean + (t.max-prevMean)*(target-prevCenter)/(t.total-prevCenter)
+}
+
+type approxPercentileFuncTmpl struct {
+}
+
+func (f *approxPercentileFuncTmpl) Accept(arity int) bool {
+	return arity == 2
+}
+
+func (f *approxPercentileFuncTmpl) Bind(ctx *core.Context, params ...data.Value) (udf.UDAF, error) {
+	p, err := data.ToFloat(params[0])
+	if err != nil {
+		return nil, fmt.Errorf("function needs numeric input, not %T", params[0])
+	}
+	if p < 0 || p > 1 || math.IsNaN(p) {
+		return nil, fmt.Errorf("percentile must be between 0 and 1, not %v", p)
+	}
+	return &approxPercentile{p}, nil
+}
+
+// approxPercentile is a udf.UDAF estimating the p-quantile with a t-digest.
+type approxPercentile struct {
+	p float64
+}
+
+type approxPercentileState struct {
+	p      float64
+	digest *tDigest
+}
+
+func (a *approxPercentile) Init(ctx *core.Context) (udf.UDAFState, error) {
+	return &approxPercentileState{a.p, newTDigest()}, nil
+}
+
+func (s *approxPercentileState) Accumulate(ctx *core.Context, v data.Value) error {
+	switch v.Type() {
+	case data.TypeInt:
+		i, _ := data.AsInt(v)
+		s.digest.add(centroid{float64(i), 1})
+	case data.TypeFloat:
+		f, _ := data.AsFloat(v)
+		s.digest.add(centroid{f, 1})
+	case data.TypeNull:
+	default:
+		return fmt.Errorf("cannot interpret %s (%T) as a number", v, v)
+	}
+	return nil
+}
+
+func (s *approxPercentileState) Merge(ctx *core.Context, other udf.UDAFState) error {
+	o, ok := other.(*approxPercentileState)
+	if !ok {
+		return fmt.Errorf("cannot merge %T", other)
+	}
+	s.digest.merge(o.digest)
+	return nil
+}
+
+func (s *approxPercentileState) Finalize(ctx *core.Context) (data.Value, error) {
+	if s.digest.max < s.digest.min {
+		// only null inputs
+		return data.Null{}, nil
+	}
+	// quantile flushes the buffer, which must not modify the state
+	return data.Float(s.digest.clone().quantile(s.p)), nil
+}
+
+// approxPercentileFunc(expr, p) is an aggregate function that estimates
+// the p-quantile (0 <= p <= 1) of its input values using a t-digest, so
+// that the values don't have to be sorted. The estimate is most accurate
+// for p close to 0 or 1. Null values are ignored, non-numeric values
+// lead to an error. When p is a constant, the t-digest is updated
+// incrementally while values enter and leave windows.
+//
+// It can be used in BQL as `approx_percentile`.
+//
+//  Input: Int or Float (aggregated), Float
+//  Return Type: Float (Null on empty input)
+var approxPercentileFunc udf.UDF = udf.ConvertParameterizedUDAF(&approxPercentileFuncTmpl{})
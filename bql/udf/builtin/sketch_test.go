@@ -0,0 +1,319 @@
+package builtin
+
+import (
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"math"
+	"testing"
+)
+
+func TestTopKFunc(t *testing.T) {
+	Convey("Given the top_k function", t, func() {
+		f := topKFunc
+
+		Convey("When evaluating it on a few values", func() {
+			arr := data.Array{data.String("a"), data.String("b"), data.Null{},
+				data.String("a"), data.String("c"), data.String("b"), data.String("a")}
+			val, err := f.Call(nil, arr, data.Int(2))
+
+			Convey("Then it should return the most frequent values with their counts", func() {
+				So(err, ShouldBeNil)
+				So(val, ShouldResemble, data.Array{
+					data.Map{"value": data.String("a"), "count": data.Int(3)},
+					data.Map{"value": data.String("b"), "count": data.Int(2)},
+				})
+			})
+		})
+
+		Convey("When evaluating it on values having the same count", func() {
+			arr := data.Array{data.Int(3), data.Int(1), data.Int(2), data.Int(1), data.Int(3)}
+			val, err := f.Call(nil, arr, data.Int(5))
+
+			Convey("Then they should be ordered by their values", func() {
+				So(err, ShouldBeNil)
+				So(val, ShouldResemble, data.Array{
+					data.Map{"value": data.Int(1), "count": data.Int(2)},
+					data.Map{"value": data.Int(3), "count": data.Int(2)},
+					data.Map{"value": data.Int(2), "count": data.Int(1)},
+				})
+			})
+		})
+
+		Convey("When values enter and leave a window", func() {
+			agg, ok := f.(udf.ParameterizedIncrementalAggregate)
+			So(ok, ShouldBeTrue)
+			s, err := agg.NewParameterizedAggregateState(nil, data.Int(2))
+			So(err, ShouldBeNil)
+			window := data.Array{}
+			for i := 0; i < 1000; i++ {
+				v := data.Int(i % 7)
+				if i >= 500 && i%2 == 0 {
+					v = data.Int(100)
+				}
+				So(s.Add(nil, v), ShouldBeNil)
+				window = append(window, v)
+				if len(window) > 100 {
+					So(s.Remove(nil, window[0]), ShouldBeNil)
+					window = window[1:]
+				}
+			}
+
+			Convey("Then the result should be the same as the one of the window", func() {
+				val, err := s.Result(nil)
+				So(err, ShouldBeNil)
+				expected, err := f.Call(nil, window, data.Int(2))
+				So(err, ShouldBeNil)
+				So(val, ShouldResemble, expected)
+				So(val.(data.Array)[0], ShouldResemble, data.Map{"value": data.Int(100), "count": data.Int(50)})
+			})
+		})
+
+		Convey("When creating a state with an invalid parameter", func() {
+			agg := f.(udf.ParameterizedIncrementalAggregate)
+			_, err := agg.NewParameterizedAggregateState(nil, data.Int(0))
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When evaluating it on many distinct values and some heavy hitters", func() {
+			arr := make(data.Array, 0, 10000)
+			for i := 0; i < 10000; i++ {
+				switch {
+				case i%5 == 0:
+					arr = append(arr, data.String("x"))
+				case i%10 == 1:
+					arr = append(arr, data.String("y"))
+				default:
+					arr = append(arr, data.Int(i))
+				}
+			}
+			val, err := f.Call(nil, arr, data.Int(2))
+
+			Convey("Then it should return the heavy hitters", func() {
+				So(err, ShouldBeNil)
+				res, err := data.AsArray(val)
+				So(err, ShouldBeNil)
+				So(len(res), ShouldEqual, 2)
+				for i, expected := range []struct {
+					value string
+					count int64
+				}{{"x", 2000}, {"y", 1000}} {
+					m, err := data.AsMap(res[i])
+					So(err, ShouldBeNil)
+					So(m["value"], ShouldEqual, data.String(expected.value))
+					c, err := data.AsInt(m["count"])
+					So(err, ShouldBeNil)
+					So(c, ShouldBeGreaterThanOrEqualTo, expected.count)
+					// the overestimation is at most n/capacity
+					So(c, ShouldBeLessThanOrEqualTo, expected.count+10000/20)
+				}
+			})
+		})
+
+		Convey("When evaluating it on an empty array", func() {
+			val, err := f.Call(nil, data.Array{}, data.Int(2))
+
+			Convey("Then it should return null", func() {
+				So(err, ShouldBeNil)
+				So(val, ShouldResemble, data.Null{})
+			})
+		})
+
+		Convey("When evaluating it with invalid arguments", func() {
+			for _, args := range [][]data.Value{
+				{data.Array{data.Int(1)}, data.Int(0)},
+				{data.Array{data.Int(1)}, data.String("2")},
+				{data.Int(1), data.Int(2)},
+				{data.Array{data.Int(1)}},
+			} {
+				_, err := f.Call(nil, args...)
+
+				Convey(fmt.Sprintf("Then evaluation should fail for %v", args), func() {
+					So(err, ShouldNotBeNil)
+				})
+			}
+		})
+
+		Convey("Then it should equal the one in the default registry", func() {
+			regFun, err := udf.CopyGlobalUDFRegistry(nil).Lookup("top_k", 2)
+			So(err, ShouldBeNil)
+			So(regFun, ShouldHaveSameTypeAs, f)
+		})
+	})
+}
+
+func TestApproxCountDistinctFunc(t *testing.T) {
+	Convey("Given the approx_count_distinct function", t, func() {
+		f := approxCountDistinctFunc
+
+		Convey("When evaluating it on a few values", func() {
+			arr := data.Array{data.Int(1), data.Float(1), data.String("a"),
+				data.Null{}, data.String("a"), data.Bool(true)}
+			val, err := f.Call(nil, arr)
+
+			Convey("Then it should return the exact number of distinct values", func() {
+				So(err, ShouldBeNil)
+				So(val, ShouldEqual, data.Int(3))
+			})
+		})
+
+		Convey("When evaluating it on an empty array", func() {
+			val, err := f.Call(nil, data.Array{})
+
+			Convey("Then it should return 0", func() {
+				So(err, ShouldBeNil)
+				So(val, ShouldEqual, data.Int(0))
+			})
+		})
+
+		Convey("When evaluating it on many distinct values", func() {
+			arr := make(data.Array, 0, 200000)
+			for i := 0; i < 100000; i++ {
+				arr = append(arr, data.Int(i), data.String(fmt.Sprint(i%50000)))
+			}
+			val, err := f.Call(nil, arr)
+
+			Convey("Then it should return an estimate with a small error", func() {
+				So(err, ShouldBeNil)
+				n, err := data.AsInt(val)
+				So(err, ShouldBeNil)
+				So(n, ShouldAlmostEqual, 150000, 150000*0.05)
+			})
+		})
+
+		Convey("When values enter and leave a window", func() {
+			agg, ok := f.(udf.IncrementalAggregate)
+			So(ok, ShouldBeTrue)
+			s, err := agg.NewAggregateState(nil)
+			So(err, ShouldBeNil)
+			for i := 0; i < 10; i++ {
+				So(s.Add(nil, data.Int(i%4)), ShouldBeNil)
+			}
+			So(s.Remove(nil, data.Int(0)), ShouldBeNil)
+			So(s.Remove(nil, data.Int(1)), ShouldBeNil)
+
+			Convey("Then the result should only count the remaining values", func() {
+				val, err := s.Result(nil)
+				So(err, ShouldBeNil)
+				So(val, ShouldEqual, data.Int(4))
+
+				for i := 2; i < 10; i++ {
+					So(s.Remove(nil, data.Int(i%4)), ShouldBeNil)
+				}
+				val, err = s.Result(nil)
+				So(err, ShouldBeNil)
+				So(val, ShouldEqual, data.Int(0))
+			})
+		})
+
+		Convey("Then it should equal the one in the default registry", func() {
+			regFun, err := udf.CopyGlobalUDFRegistry(nil).Lookup("approx_count_distinct", 1)
+			So(err, ShouldBeNil)
+			So(regFun, ShouldHaveSameTypeAs, f)
+		})
+	})
+}
+
+func TestApproxPercentileFunc(t *testing.T) {
+	Convey("Given the approx_percentile function", t, func() {
+		f := approxPercentileFunc
+
+		Convey("When evaluating it on a few values", func() {
+			arr := data.Array{data.Null{}}
+			for i := 100; i > 0; i-- {
+				arr = append(arr, data.Int(i))
+			}
+
+			Convey("Then it should return the exact percentiles", func() {
+				for _, tc := range []struct {
+					p        data.Value
+					expected float64
+				}{
+					{data.Float(0), 1},
+					{data.Float(0.5), 50.5},
+					{data.Float(0.95), 95.5},
+					{data.Int(1), 100},
+				} {
+					val, err := f.Call(nil, arr, tc.p)
+					So(err, ShouldBeNil)
+					So(val, ShouldAlmostEqual, data.Float(tc.expected), 0.0000001)
+				}
+			})
+		})
+
+		Convey("When evaluating it on many values", func() {
+			arr := make(data.Array, 100000)
+			for i := range arr {
+				// values in [0, 100000) in a scrambled order
+				arr[i] = data.Float((i * 7919) % 100000)
+			}
+
+			Convey("Then it should return estimates with a small error", func() {
+				for _, p := range []float64{0.5, 0.9, 0.99, 0.999} {
+					val, err := f.Call(nil, arr, data.Float(p))
+					So(err, ShouldBeNil)
+					v, err := data.AsFloat(val)
+					So(err, ShouldBeNil)
+					So(v, ShouldAlmostEqual, p*100000, 100)
+				}
+			})
+		})
+
+		Convey("When values enter and leave a window", func() {
+			agg, ok := f.(udf.ParameterizedIncrementalAggregate)
+			So(ok, ShouldBeTrue)
+			s, err := agg.NewParameterizedAggregateState(nil, data.Float(0.5))
+			So(err, ShouldBeNil)
+			for i := 0; i < 3000; i++ {
+				So(s.Add(nil, data.Int(i)), ShouldBeNil)
+				if i >= 1000 {
+					So(s.Remove(nil, data.Int(i-1000)), ShouldBeNil)
+				}
+			}
+
+			Convey("Then the result should only reflect the remaining values", func() {
+				val, err := s.Result(nil)
+				So(err, ShouldBeNil)
+				v, err := data.AsFloat(val)
+				So(err, ShouldBeNil)
+				So(v, ShouldAlmostEqual, 2499.5, 10)
+			})
+		})
+
+		Convey("When evaluating it on an array with only nulls", func() {
+			val, err := f.Call(nil, data.Array{data.Null{}}, data.Float(0.5))
+
+			Convey("Then it should return null", func() {
+				So(err, ShouldBeNil)
+				So(val, ShouldResemble, data.Null{})
+			})
+		})
+
+		Convey("When evaluating it with invalid arguments", func() {
+			for _, args := range [][]data.Value{
+				{data.Array{data.Int(1)}, data.Float(1.5)},
+				{data.Array{data.Int(1)}, data.Float(-0.1)},
+				{data.Array{data.Int(1)}, data.Float(math.NaN())},
+				{data.Array{data.Int(1)}, data.String("a")},
+				{data.Array{data.Int(1), data.String("a")}, data.Float(0.5)},
+				{data.Int(1), data.Float(0.5)},
+			} {
+				_, err := f.Call(nil, args...)
+
+				Convey(fmt.Sprintf("Then evaluation should fail for %v", args), func() {
+					So(err, ShouldNotBeNil)
+				})
+			}
+		})
+
+		Convey("Then it should equal the one in the default registry", func() {
+			regFun, err := udf.CopyGlobalUDFRegistry(nil).Lookup("approx_percentile", 2)
+			So(err, ShouldBeNil)
+			So(regFun, ShouldHaveSameTypeAs, f)
+		})
+	})
+}
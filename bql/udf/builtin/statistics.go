@@ -0,0 +1,525 @@
+package builtin
+
+import (
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"math"
+	"sort"
+)
+
+// numericValue returns the value of an Int or a Float as a float64.
+// ok is false if the value is Null.
+func numericValue(v data.Value) (f float64, ok bool, err error) {
+	switch v.Type() {
+	case data.TypeInt:
+		i, _ := data.AsInt(v)
+		return float64(i), true, nil
+	case data.TypeFloat:
+		f, _ := data.AsFloat(v)
+		return f, true, nil
+	case data.TypeNull:
+		return 0, false, nil
+	default:
+		return 0, false, fmt.Errorf("cannot interpret %s (%T) as a number", v, v)
+	}
+}
+
+// numericValues returns the non-null values of an array as float64s.
+func numericValues(arr []data.Value) ([]float64, error) {
+	vals := make([]float64, 0, len(arr))
+	for _, item := range arr {
+		f, ok, err := numericValue(item)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			vals = append(vals, f)
+		}
+	}
+	return vals, nil
+}
+
+/* stddev, variance */
+
+// varianceState is the udf.AggregateState of variance and, when stddev
+// is true, of stddev. It maintains the mean and the sum of squared
+// differences from the mean using Welford's algorithm, which can also
+// remove values.
+type varianceState struct {
+	stddev bool
+	n      int64
+	mean   float64
+	m2     float64
+}
+
+func (s *varianceState) Add(ctx *core.Context, v data.Value) error {
+	f, ok, err := numericValue(v)
+	if err != nil || !ok {
+		return err
+	}
+	s.n++
+	delta := f - s.mean
+	s.mean += delta / float64(s.n)
+	s.m2 += delta * (f - s.mean)
+	return nil
+}
+
+func (s *varianceState) Remove(ctx *core.Context, v data.Value) error {
+	f, ok, err := numericValue(v)
+	if err != nil || !ok {
+		return err
+	}
+	if s.n == 0 {
+		return fmt.Errorf("%v was never added", v)
+	}
+	s.n--
+	if s.n == 0 {
+		s.mean, s.m2 = 0, 0
+		return nil
+	}
+	delta := f - s.mean
+	s.mean -= delta / float64(s.n)
+	s.m2 -= delta * (f - s.mean)
+	if s.m2 < 0 {
+		// drop rounding errors
+		s.m2 = 0
+	}
+	return nil
+}
+
+func (s *varianceState) Result(ctx *core.Context) (data.Value, error) {
+	if s.n < 2 {
+		return data.Null{}, nil
+	}
+	v := s.m2 / float64(s.n-1)
+	if s.stddev {
+		return data.Float(math.Sqrt(v)), nil
+	}
+	return data.Float(v), nil
+}
+
+// sampleVariance computes the sample variance of all non-null values
+// in an array. ok is false if there are fewer than two values.
+func sampleVariance(arr []data.Value) (v float64, ok bool, err error) {
+	vals, err := numericValues(arr)
+	if err != nil || len(vals) < 2 {
+		return 0, false, err
+	}
+	mean := 0.0
+	for _, f := range vals {
+		mean += f
+	}
+	mean /= float64(len(vals))
+	for _, f := range vals {
+		v += (f - mean) * (f - mean)
+	}
+	return v / float64(len(vals)-1), true, nil
+}
+
+// varianceFunc is an aggregate function that computes the sample
+// variance of all input values. Null values are ignored, non-numeric
+// values lead to an error.
+//
+// It can be used in BQL as `variance`.
+//
+//  Input: Int or Float (aggregated)
+//  Return Type: Float (Null if there are fewer than two values)
+var varianceFunc udf.UDF = &incrementalSingleParamAggFunc{
+	singleParamAggFunc{
+		aggFun: func(arr []data.Value) (data.Value, error) {
+			v, ok, err := sampleVariance(arr)
+			if err != nil || !ok {
+				return data.Null{}, err
+			}
+			return data.Float(v), nil
+		},
+	},
+	func() udf.AggregateState {
+		return &varianceState{}
+	},
+}
+
+// stddevFunc is an aggregate function that computes the sample
+// standard deviation of all input values. Null values are ignored,
+// non-numeric values lead to an error.
+//
+// It can be used in BQL as `stddev`.
+//
+//  Input: Int or Float (aggregated)
+//  Return Type: Float (Null if there are fewer than two values)
+var stddevFunc udf.UDF = &incrementalSingleParamAggFunc{
+	singleParamAggFunc{
+		aggFun: func(arr []data.Value) (data.Value, error) {
+			v, ok, err := sampleVariance(arr)
+			if err != nil || !ok {
+				return data.Null{}, err
+			}
+			return data.Float(math.Sqrt(v)), nil
+		},
+	},
+	func() udf.AggregateState {
+		return &varianceState{stddev: true}
+	},
+}
+
+/* covar, corr, regr_slope, regr_intercept */
+
+// pairMoments holds the sums of squared differences from the means of
+// pairs of numbers (y, x).
+type pairMoments struct {
+	n     int
+	meanY float64
+	meanX float64
+	syy   float64
+	sxx   float64
+	sxy   float64
+}
+
+// newPairMoments computes the moments of the pairs of two arrays in which
+// neither value is Null, like PostgreSQL does for its two-parameter
+// statistical aggregates.
+func newPairMoments(ys, xs []data.Value) (*pairMoments, error) {
+	if len(ys) != len(xs) {
+		return nil, fmt.Errorf("inputs must have same length (%d != %d)",
+			len(ys), len(xs))
+	}
+	yVals := make([]float64, 0, len(ys))
+	xVals := make([]float64, 0, len(xs))
+	for i := range ys {
+		y, yOK, err := numericValue(ys[i])
+		if err != nil {
+			return nil, err
+		}
+		x, xOK, err := numericValue(xs[i])
+		if err != nil {
+			return nil, err
+		}
+		if yOK && xOK {
+			yVals = append(yVals, y)
+			xVals = append(xVals, x)
+		}
+	}
+
+	m := &pairMoments{n: len(yVals)}
+	if m.n == 0 {
+		return m, nil
+	}
+	for i := range yVals {
+		m.meanY += yVals[i]
+		m.meanX += xVals[i]
+	}
+	m.meanY /= float64(m.n)
+	m.meanX /= float64(m.n)
+	for i := range yVals {
+		dy := yVals[i] - m.meanY
+		dx := xVals[i] - m.meanX
+		m.syy += dy * dy
+		m.sxx += dx * dx
+		m.sxy += dy * dx
+	}
+	return m, nil
+}
+
+// pairAggFunc returns a two-parameter aggregate function computing its
+// result from the moments of the pairs of its input values. result
+// returns false if the result is Null.
+func pairAggFunc(result func(m *pairMoments) (float64, bool)) udf.UDF {
+	return &twoParamAggFunc{
+		aggFun: func(ys []data.Value, xs []data.Value) (data.Value, error) {
+			m, err := newPairMoments(ys, xs)
+			if err != nil {
+				return nil, err
+			}
+			if m.n == 0 {
+				return data.Null{}, nil
+			}
+			r, ok := result(m)
+			if !ok {
+				return data.Null{}, nil
+			}
+			return data.Float(r), nil
+		},
+	}
+}
+
+// covarFunc(y, x) is an aggregate function that computes the sample
+// covariance of pairs of input values. Pairs in which either value is
+// Null are ignored, non-numeric values lead to an error.
+//
+// It can be used in BQL as `covar`.
+//
+//  Input: Int or Float (aggregated), Int or Float (aggregated)
+//  Return Type: Float (Null if there are fewer than two pairs)
+var covarFunc = pairAggFunc(func(m *pairMoments) (float64, bool) {
+	if m.n < 2 {
+		return 0, false
+	}
+	return m.sxy / float64(m.n-1), true
+})
+
+// corrFunc(y, x) is an aggregate function that computes the Pearson
+// correlation coefficient of pairs of input values. Pairs in which
+// either value is Null are ignored, non-numeric values lead to an error.
+//
+// It can be used in BQL as `corr`.
+//
+//  Input: Int or Float (aggregated), Int or Float (aggregated)
+//  Return Type: Float (Null on empty input or if either value is constant)
+var corrFunc = pairAggFunc(func(m *pairMoments) (float64, bool) {
+	if m.sxx == 0 || m.syy == 0 {
+		return 0, false
+	}
+	return m.sxy / math.Sqrt(m.sxx*m.syy), true
+})
+
+// regrSlopeFunc(y, x) is an aggregate function that computes the slope
+// of the least-squares-fit linear equation determined by pairs of input
+// values, where y is the dependent and x is the independent variable.
+// Pairs in which either value is Null are ignored, non-numeric values
+// lead to an error.
+//
+// It can be used in BQL as `regr_slope`.
+//
+//  Input: Int or Float (aggregated), Int or Float (aggregated)
+//  Return Type: Float (Null on empty input or if x is constant)
+var regrSlopeFunc = pairAggFunc(func(m *pairMoments) (float64, bool) {
+	if m.sxx == 0 {
+		return 0, false
+	}
+	return m.sxy / m.sxx, true
+})
+
+// regrInterceptFunc(y, x) is an aggregate function that computes the
+// y-intercept of the least-squares-fit linear equation determined by
+// pairs of input values. Pairs in which either value is Null are ignored,
+// non-numeric values lead to an error.
+//
+// It can be used in BQL as `regr_intercept`.
+//
+//  Input: Int or Float (aggregated), Int or Float (aggregated)
+//  Return Type: Float (Null on empty input or if x is constant)
+var regrInterceptFunc = pairAggFunc(func(m *pairMoments) (float64, bool) {
+	if m.sxx == 0 {
+		return 0, false
+	}
+	return m.meanY - m.sxy/m.sxx*m.meanX, true
+})
+
+/* percentile_cont */
+
+type percentileContFuncTmpl struct {
+}
+
+func (f *percentileContFuncTmpl) Accept(arity int) bool {
+	return arity == 2
+}
+
+func (f *percentileContFuncTmpl) IsAggregationParameter(k int) bool {
+	return k == 0
+}
+
+func (f *percentileContFuncTmpl) Call(ctx *core.Context, args ...data.Value) (data.Value, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf("function takes exactly two arguments")
+	}
+	arr, err := data.AsArray(args[0])
+	if err != nil {
+		return nil, fmt.Errorf("function needs array input, not %T", args[0])
+	}
+	p, err := data.ToFloat(args[1])
+	if err != nil {
+		return nil, fmt.Errorf("function needs numeric input, not %T", args[1])
+	}
+	if p < 0 || p > 1 || math.IsNaN(p) {
+		return nil, fmt.Errorf("percentile must be between 0 and 1, not %v", p)
+	}
+	vals, err := numericValues(arr)
+	if err != nil {
+		return nil, err
+	}
+	if len(vals) == 0 {
+		// only null inputs
+		return data.Null{}, nil
+	}
+	sort.Float64s(vals)
+	// interpolate linearly between the two values closest to the
+	// position of the percentile
+	pos := p * float64(len(vals)-1)
+	lower := int(math.Floor(pos))
+	if lower == len(vals)-1 {
+		return data.Float(vals[lower]), nil
+	}
+	frac := pos - float64(lower)
+	return data.Float(vals[lower] + (vals[lower+1]-vals[lower])*frac), nil
+}
+
+// percentileContFunc(expr, p) is an aggregate function that computes the
+// p-quantile (0 <= p <= 1) of its input values, interpolating linearly
+// between adjacent values if needed. Unlike approx_percentile, it sorts
+// all values. Null values are ignored, non-numeric values lead to an error.
+//
+// It can be used in BQL as `percentile_cont`.
+//
+//  Input: Int or Float (aggregated), Float
+//  Return Type: Float (Null on empty input)
+var percentileContFunc udf.UDF = &percentileContFuncTmpl{}
+
+/* mode */
+
+// modeFunc is an aggregate function that returns the most frequent input
+// value. When several values are the most frequent, the one that appears
+// first is returned. Null values are ignored.
+//
+// It can be used in BQL as `mode`.
+//
+//  Input: any (aggregated)
+//  Return Type: same as the most frequent input value (Null on empty input)
+var modeFunc udf.UDF = &singleParamAggFunc{
+	aggFun: func(arr []data.Value) (data.Value, error) {
+		type valueCount struct {
+			value data.Value
+			count int
+		}
+		byHash := map[data.HashValue][]*valueCount{}
+		// counts is ordered by the first occurrence of the values
+		counts := []*valueCount{}
+		for _, item := range arr {
+			if item.Type() == data.TypeNull {
+				continue
+			}
+			h := data.Hash(item)
+			var vc *valueCount
+			for _, c := range byHash[h] {
+				if data.Equal(c.value, item) {
+					vc = c
+					break
+				}
+			}
+			if vc == nil {
+				vc = &valueCount{value: item}
+				byHash[h] = append(byHash[h], vc)
+				counts = append(counts, vc)
+			}
+			vc.count++
+		}
+		if len(counts) == 0 {
+			return data.Null{}, nil
+		}
+		mode := counts[0]
+		for _, c := range counts[1:] {
+			if c.count > mode.count {
+				mode = c
+			}
+		}
+		return mode.value, nil
+	},
+}
+
+/* moving_avg, exp_smooth */
+
+// movingAvgFunc(arr, n) computes the simple moving average of the values
+// in an array, i.e., the i-th element of the result is the average of the
+// i-th value and the n-1 preceding values. Null values yield Null and are
+// not counted in the window; non-numeric values lead to an error. It can
+// be combined with array_agg to smooth the values of a window:
+//
+//  SELECT RSTREAM moving_avg(array_agg(temp ORDER BY ts ASC), 3) AS smoothed
+//      FROM sensor [RANGE 10 TUPLES]
+//
+// It can be used in BQL as `moving_avg`.
+//
+//  Input: Array, Int
+//  Return Type: Array
+var movingAvgFunc udf.UDF = udf.BinaryFunc(func(ctx *core.Context, arr, n data.Value) (data.Value, error) {
+	if arr.Type() == data.TypeNull || n.Type() == data.TypeNull {
+		return data.Null{}, nil
+	}
+	a, err := data.AsArray(arr)
+	if err != nil {
+		return nil, err
+	}
+	size, err := data.AsInt(n)
+	if err != nil {
+		return nil, err
+	}
+	if size <= 0 {
+		return nil, fmt.Errorf("the window size must be positive, not %v", size)
+	}
+	res := make(data.Array, len(a))
+	// the window never has more values than the array
+	capacity := size
+	if int64(len(a)) < capacity {
+		capacity = int64(len(a))
+	}
+	window := make([]float64, 0, capacity)
+	sum := 0.0
+	for i, item := range a {
+		f, ok, err := numericValue(item)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			res[i] = data.Null{}
+			continue
+		}
+		if int64(len(window)) == size {
+			sum -= window[0]
+			window = window[1:]
+		}
+		window = append(window, f)
+		sum += f
+		res[i] = data.Float(sum / float64(len(window)))
+	}
+	return res, nil
+})
+
+// expSmoothFunc(arr, alpha) applies simple exponential smoothing with the
+// smoothing factor alpha (0 < alpha <= 1) to the values in an array. The
+// first element of the result is the first value, and each subsequent
+// element is alpha times the value plus 1-alpha times the previous
+// element. Null values yield Null and don't affect the following elements;
+// non-numeric values lead to an error.
+//
+// It can be used in BQL as `exp_smooth`.
+//
+//  Input: Array, Float
+//  Return Type: Array
+var expSmoothFunc udf.UDF = udf.BinaryFunc(func(ctx *core.Context, arr, alpha data.Value) (data.Value, error) {
+	if arr.Type() == data.TypeNull || alpha.Type() == data.TypeNull {
+		return data.Null{}, nil
+	}
+	a, err := data.AsArray(arr)
+	if err != nil {
+		return nil, err
+	}
+	factor, err := data.ToFloat(alpha)
+	if err != nil {
+		return nil, err
+	}
+	if factor <= 0 || factor > 1 || math.IsNaN(factor) {
+		return nil, fmt.Errorf("the smoothing factor must be in (0, 1], not %v", factor)
+	}
+	res := make(data.Array, len(a))
+	var smoothed float64
+	started := false
+	for i, item := range a {
+		f, ok, err := numericValue(item)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			res[i] = data.Null{}
+			continue
+		}
+		if started {
+			smoothed = factor*f + (1-factor)*smoothed
+		} else {
+			smoothed = f
+			started = true
+		}
+		res[i] = data.Float(smoothed)
+	}
+	return res, nil
+})
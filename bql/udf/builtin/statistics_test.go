@@ -0,0 +1,89 @@
+package builtin
+
+import (
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"math"
+	"testing"
+)
+
+func TestSmoothingFuncs(t *testing.T) {
+	udfBinaryTestCases := []udfBinaryTestCase{
+		{"moving_avg", movingAvgFunc, []udfBinaryTestCaseInput{
+			{data.Null{}, data.Int(2), data.Null{}},
+			{data.Array{data.Int(1)}, data.Null{}, data.Null{}},
+			{data.Array{}, data.Int(2), data.Array{}},
+			// normal cases
+			{data.Array{data.Int(1), data.Int(3), data.Float(8), data.Int(4)}, data.Int(2),
+				data.Array{data.Float(1), data.Float(2), data.Float(5.5), data.Float(6)}},
+			{data.Array{data.Int(1), data.Int(3), data.Float(8), data.Int(4)}, data.Int(3),
+				data.Array{data.Float(1), data.Float(2), data.Float(4), data.Float(5)}},
+			{data.Array{data.Int(1), data.Int(3)}, data.Int(1),
+				data.Array{data.Float(1), data.Float(3)}},
+			// a window larger than the array
+			{data.Array{data.Int(1), data.Int(3)}, data.Int(math.MaxInt64),
+				data.Array{data.Float(1), data.Float(2)}},
+			// Null values are skipped
+			{data.Array{data.Int(1), data.Null{}, data.Int(3), data.Int(5)}, data.Int(2),
+				data.Array{data.Float(1), data.Null{}, data.Float(2), data.Float(4)}},
+			/// fail cases
+			{data.Array{data.Int(1)}, data.Int(0), nil},
+			{data.Array{data.Int(1)}, data.String("a"), nil},
+			{data.Array{data.String("a")}, data.Int(2), nil},
+			{data.Int(1), data.Int(2), nil},
+		}},
+		{"exp_smooth", expSmoothFunc, []udfBinaryTestCaseInput{
+			{data.Null{}, data.Float(0.5), data.Null{}},
+			{data.Array{data.Int(1)}, data.Null{}, data.Null{}},
+			{data.Array{}, data.Float(0.5), data.Array{}},
+			// normal cases
+			{data.Array{data.Int(2), data.Int(4), data.Float(8), data.Int(4)}, data.Float(0.5),
+				data.Array{data.Float(2), data.Float(3), data.Float(5.5), data.Float(4.75)}},
+			{data.Array{data.Int(2), data.Int(4)}, data.Int(1),
+				data.Array{data.Float(2), data.Float(4)}},
+			// Null values are skipped
+			{data.Array{data.Null{}, data.Int(2), data.Null{}, data.Int(4)}, data.Float(0.25),
+				data.Array{data.Null{}, data.Float(2), data.Null{}, data.Float(2.5)}},
+			/// fail cases
+			{data.Array{data.Int(1)}, data.Float(0), nil},
+			{data.Array{data.Int(1)}, data.Float(1.5), nil},
+			{data.Array{data.Int(1)}, data.String("a"), nil},
+			{data.Array{data.Bool(true)}, data.Float(0.5), nil},
+			{data.Int(1), data.Float(0.5), nil},
+		}},
+	}
+
+	for _, testCase := range udfBinaryTestCases {
+		f := testCase.f
+
+		Convey(fmt.Sprintf("Given the %s function", testCase.name), t, func() {
+			for _, tc := range testCase.inputs {
+				tc := tc
+
+				Convey(fmt.Sprintf("When evaluating it on %s (%T) and %s (%T)",
+					tc.input1, tc.input1, tc.input2, tc.input2), func() {
+					val, err := f.Call(nil, tc.input1, tc.input2)
+
+					if tc.expected == nil {
+						Convey("Then evaluation should fail", func() {
+							So(err, ShouldNotBeNil)
+						})
+					} else {
+						Convey(fmt.Sprintf("Then the result should be %s", tc.expected), func() {
+							So(err, ShouldBeNil)
+							So(val, ShouldResemble, tc.expected)
+						})
+					}
+				})
+			}
+
+			Convey("Then it should equal the one in the default registry", func() {
+				regFun, err := udf.CopyGlobalUDFRegistry(nil).Lookup(testCase.name, 2)
+				So(err, ShouldBeNil)
+				So(regFun, ShouldHaveSameTypeAs, f)
+			})
+		})
+	}
+}
@@ -1,10 +1,14 @@
 package builtin
 
 import (
+	"bytes"
 	"fmt"
 	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
 	"gopkg.in/sensorbee/sensorbee.v0/core"
 	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"math"
+	"strings"
+	"sync"
 	"time"
 )
 
@@ -54,3 +58,639 @@ var diffUsFunc udf.UDF = &diffUsFuncTmpl{}
 var clockTimestampFunc = udf.MustConvertGeneric(func() time.Time {
 	return time.Now().In(time.UTC)
 })
+
+// multiParamTimeFunc is a template for time functions taking
+// between minArity and maxArity parameters. If any of the
+// arguments is NULL, the result is NULL.
+type multiParamTimeFunc struct {
+	minArity int
+	maxArity int
+	fun      func(args []data.Value) (data.Value, error)
+}
+
+func (f *multiParamTimeFunc) Accept(arity int) bool {
+	return arity >= f.minArity && arity <= f.maxArity
+}
+
+func (f *multiParamTimeFunc) IsAggregationParameter(k int) bool {
+	return false
+}
+
+func (f *multiParamTimeFunc) Call(ctx *core.Context, args ...data.Value) (val data.Value, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+	if !f.Accept(len(args)) {
+		return nil, fmt.Errorf("function does not support %d arguments", len(args))
+	}
+	for _, arg := range args {
+		if arg.Type() == data.TypeNull {
+			return data.Null{}, nil
+		}
+	}
+	return f.fun(args)
+}
+
+var (
+	locationsMutex sync.Mutex
+	locations      = map[string]*time.Location{}
+)
+
+// loadLocation returns the time zone with the given name, such as
+// "Asia/Tokyo" or "UTC", or a fixed offset such as "+09:00". Time
+// zones are cached because loading them reads the tz database.
+func loadLocation(name string) (*time.Location, error) {
+	locationsMutex.Lock()
+	defer locationsMutex.Unlock()
+	if loc, ok := locations[name]; ok {
+		return loc, nil
+	}
+
+	var loc *time.Location
+	if t, err := time.Parse("-07:00", name); err == nil {
+		_, offset := t.Zone()
+		loc = time.FixedZone(name, offset)
+	} else {
+		l, err := time.LoadLocation(name)
+		if err != nil {
+			return nil, fmt.Errorf("unknown time zone: %s", name)
+		}
+		loc = l
+	}
+	locations[name] = loc
+	return loc, nil
+}
+
+// timestampAndLocation returns the timestamp in args[i] converted to
+// the time zone given in args[j] (if present) or UTC.
+func timestampAndLocation(args []data.Value, i, j int) (time.Time, error) {
+	t, err := data.AsTimestamp(args[i])
+	if err != nil {
+		return time.Time{}, err
+	}
+	loc := time.UTC
+	if j < len(args) {
+		name, err := data.AsString(args[j])
+		if err != nil {
+			return time.Time{}, err
+		}
+		loc, err = loadLocation(name)
+		if err != nil {
+			return time.Time{}, err
+		}
+	}
+	return t.In(loc), nil
+}
+
+func dateTrunc(args []data.Value) (data.Value, error) {
+	field, err := data.AsString(args[0])
+	if err != nil {
+		return nil, err
+	}
+	t, err := timestampAndLocation(args, 1, 2)
+	if err != nil {
+		return nil, err
+	}
+
+	y, mon, d := t.Date()
+	h, m, s := t.Clock()
+	ns := t.Nanosecond()
+	switch strings.ToLower(field) {
+	case "microseconds":
+		ns = ns / 1000 * 1000
+	case "milliseconds":
+		ns = ns / 1000000 * 1000000
+	case "second":
+		ns = 0
+	case "minute":
+		s, ns = 0, 0
+	case "hour":
+		m, s, ns = 0, 0, 0
+	case "day":
+		h, m, s, ns = 0, 0, 0, 0
+	case "week":
+		// weeks start on Monday
+		d -= (int(t.Weekday()) + 6) % 7
+		h, m, s, ns = 0, 0, 0, 0
+	case "month":
+		d, h, m, s, ns = 1, 0, 0, 0, 0
+	case "quarter":
+		mon = mon - (mon-1)%3
+		d, h, m, s, ns = 1, 0, 0, 0, 0
+	case "year":
+		mon, d, h, m, s, ns = time.January, 1, 0, 0, 0, 0
+	default:
+		return nil, fmt.Errorf("unsupported field for date_trunc: %s", field)
+	}
+	return data.Timestamp(time.Date(y, mon, d, h, m, s, ns, t.Location()).In(time.UTC)), nil
+}
+
+// dateTruncFunc(field, t, [zone]) truncates the timestamp t to the
+// precision given by field, which is one of "microseconds",
+// "milliseconds", "second", "minute", "hour", "day", "week" (starting
+// on Monday), "month", "quarter", or "year". Days and larger units are
+// computed in the given time zone, or UTC if omitted.
+//
+// It can be used in BQL as `date_trunc`.
+//
+//  Input: String, Timestamp, [String]
+//  Return Type: Timestamp
+var dateTruncFunc udf.UDF = &multiParamTimeFunc{2, 3, dateTrunc}
+
+func datePart(args []data.Value) (data.Value, error) {
+	field, err := data.AsString(args[0])
+	if err != nil {
+		return nil, err
+	}
+	t, err := timestampAndLocation(args, 1, 2)
+	if err != nil {
+		return nil, err
+	}
+
+	us := t.Nanosecond() / 1000
+	switch strings.ToLower(field) {
+	case "year":
+		return data.Int(t.Year()), nil
+	case "isoyear":
+		y, _ := t.ISOWeek()
+		return data.Int(y), nil
+	case "quarter":
+		return data.Int((int(t.Month())-1)/3 + 1), nil
+	case "month":
+		return data.Int(t.Month()), nil
+	case "week":
+		_, w := t.ISOWeek()
+		return data.Int(w), nil
+	case "day":
+		return data.Int(t.Day()), nil
+	case "doy":
+		return data.Int(t.YearDay()), nil
+	case "dow":
+		return data.Int(t.Weekday()), nil
+	case "isodow":
+		return data.Int((int(t.Weekday())+6)%7 + 1), nil
+	case "hour":
+		return data.Int(t.Hour()), nil
+	case "minute":
+		return data.Int(t.Minute()), nil
+	case "second":
+		return data.Float(float64(t.Second()) + float64(us)/1e6), nil
+	case "milliseconds":
+		return data.Float(float64(t.Second())*1e3 + float64(us)/1e3), nil
+	case "microseconds":
+		return data.Int(t.Second()*1000000 + us), nil
+	case "epoch":
+		return data.Float(float64(t.Unix()) + float64(us)/1e6), nil
+	}
+	return nil, fmt.Errorf("unsupported field for date_part: %s", field)
+}
+
+// datePartFunc(field, t, [zone]) extracts a field from the timestamp
+// t as seen in the given time zone, or UTC if omitted. The following
+// fields return an Int: "year", "isoyear", "quarter", "month", "week"
+// (ISO 8601), "day", "doy" (day of year), "dow" (Sunday is 0),
+// "isodow" (Monday is 1, Sunday is 7), "hour", "minute", and
+// "microseconds" (including the seconds). "second" and "milliseconds"
+// return a Float including fractional parts, and "epoch" returns the
+// number of seconds since 1970-01-01 00:00:00 UTC as a Float.
+//
+// It can be used in BQL as `date_part` or as `EXTRACT(field FROM t)`.
+//
+//  Input: String, Timestamp, [String]
+//  Return Type: Int or Float
+var datePartFunc udf.UDF = &multiParamTimeFunc{2, 3, datePart}
+
+// formatTime formats t according to a strftime-like format string.
+// See toCharFunc for the supported directives.
+func formatTime(t time.Time, format string) (string, error) {
+	var b bytes.Buffer
+	for i := 0; i < len(format); i++ {
+		c := format[i]
+		if c != '%' {
+			b.WriteByte(c)
+			continue
+		}
+		i++
+		if i >= len(format) {
+			return "", fmt.Errorf("format ends with an incomplete directive")
+		}
+		switch format[i] {
+		case 'Y':
+			fmt.Fprintf(&b, "%04d", t.Year())
+		case 'y':
+			fmt.Fprintf(&b, "%02d", t.Year()%100)
+		case 'm':
+			fmt.Fprintf(&b, "%02d", t.Month())
+		case 'd':
+			fmt.Fprintf(&b, "%02d", t.Day())
+		case 'e':
+			fmt.Fprintf(&b, "%2d", t.Day())
+		case 'H':
+			fmt.Fprintf(&b, "%02d", t.Hour())
+		case 'I':
+			fmt.Fprintf(&b, "%02d", (t.Hour()+11)%12+1)
+		case 'M':
+			fmt.Fprintf(&b, "%02d", t.Minute())
+		case 'S':
+			fmt.Fprintf(&b, "%02d", t.Second())
+		case 'f':
+			fmt.Fprintf(&b, "%06d", t.Nanosecond()/1000)
+		case 'p':
+			b.WriteString(t.Format("PM"))
+		case 'a':
+			b.WriteString(t.Format("Mon"))
+		case 'A':
+			b.WriteString(t.Format("Monday"))
+		case 'b':
+			b.WriteString(t.Format("Jan"))
+		case 'B':
+			b.WriteString(t.Format("January"))
+		case 'j':
+			fmt.Fprintf(&b, "%03d", t.YearDay())
+		case 'z':
+			b.WriteString(t.Format("-0700"))
+		case 'Z':
+			b.WriteString(t.Format("MST"))
+		case 'F':
+			b.WriteString(t.Format("2006-01-02"))
+		case 'T':
+			b.WriteString(t.Format("15:04:05"))
+		case '%':
+			b.WriteByte('%')
+		default:
+			return "", fmt.Errorf("unsupported directive: %%%c", format[i])
+		}
+	}
+	return b.String(), nil
+}
+
+func toChar(args []data.Value) (data.Value, error) {
+	t, err := timestampAndLocation(args, 0, 2)
+	if err != nil {
+		return nil, err
+	}
+	format, err := data.AsString(args[1])
+	if err != nil {
+		return nil, err
+	}
+	s, err := formatTime(t, format)
+	if err != nil {
+		return nil, err
+	}
+	return data.String(s), nil
+}
+
+// toCharFunc(t, format, [zone]) formats the timestamp t as seen in
+// the given time zone, or UTC if omitted. The format supports the
+// following strftime directives:
+//
+//  %Y  year (2006)              %y  year without century (06)
+//  %m  month (01-12)            %d  day of month (01-31)
+//  %e  day of month ( 1-31)     %j  day of year (001-366)
+//  %H  hour (00-23)             %I  hour (01-12)
+//  %M  minute (00-59)           %S  second (00-59)
+//  %f  microseconds (000000)    %p  AM or PM
+//  %a  weekday (Mon)            %A  weekday (Monday)
+//  %b  month (Jan)              %B  month (January)
+//  %z  UTC offset (+0900)       %Z  time zone (JST)
+//  %F  same as %Y-%m-%d         %T  same as %H:%M:%S
+//  %%  a literal %
+//
+// It can be used in BQL as `to_char` or `strftime`.
+//
+//  Input: Timestamp, String, [String]
+//  Return Type: String
+var toCharFunc udf.UDF = &multiParamTimeFunc{2, 3, toChar}
+
+// parsedTime has elements of a time parsed by parseTime.
+type parsedTime struct {
+	year, month, day, yday int
+	hasMonthDay            bool
+	hour, minute, second   int
+	nsec                   int
+	hour12, pm, hasAMPM    bool
+	loc                    *time.Location
+	hasOffset              bool
+	zone                   string
+}
+
+// parseTime parses value according to a strftime-like format. Each
+// directive parses its own element of the time, and any other text in
+// the format must appear in value as it is. See parseTimestampFunc for
+// the supported directives.
+func parseTime(value, format string, loc *time.Location) (time.Time, error) {
+	p := &parsedTime{month: 1, day: 1, loc: loc}
+	rest, err := p.parse(value, format)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if rest != "" {
+		return time.Time{}, fmt.Errorf("extra text at the end of the value: %s", rest)
+	}
+	return p.time()
+}
+
+// parse parses value according to format and returns the remaining text
+// of value.
+func (p *parsedTime) parse(value, format string) (string, error) {
+	for i := 0; i < len(format); i++ {
+		c := format[i]
+		if c != '%' {
+			if value == "" || value[0] != c {
+				return "", fmt.Errorf("the value doesn't match the format at %q", format[i:])
+			}
+			value = value[1:]
+			continue
+		}
+		i++
+		if i >= len(format) {
+			return "", fmt.Errorf("format ends with an incomplete directive")
+		}
+
+		var err error
+		switch format[i] {
+		case 'Y':
+			value, p.year, err = parseDigits(value, 4, 4)
+		case 'y':
+			value, p.year, err = parseDigits(value, 2, 2)
+			if p.year >= 69 {
+				p.year += 1900
+			} else {
+				p.year += 2000
+			}
+		case 'm':
+			value, p.month, err = parseDigits(value, 2, 2)
+			p.hasMonthDay = true
+		case 'd':
+			value, p.day, err = parseDigits(value, 2, 2)
+			p.hasMonthDay = true
+		case 'e':
+			value, p.day, err = parseDigits(strings.TrimPrefix(value, " "), 1, 2)
+			p.hasMonthDay = true
+		case 'j':
+			value, p.yday, err = parseDigits(value, 3, 3)
+		case 'H':
+			value, p.hour, err = parseDigits(value, 2, 2)
+		case 'I':
+			value, p.hour, err = parseDigits(value, 2, 2)
+			p.hour12 = true
+		case 'M':
+			value, p.minute, err = parseDigits(value, 2, 2)
+		case 'S':
+			value, p.second, err = parseDigits(value, 2, 2)
+		case 'f':
+			l := len(value)
+			var us int
+			value, us, err = parseDigits(value, 1, 6)
+			for n := l - len(value); n < 6; n++ {
+				us *= 10
+			}
+			p.nsec = us * 1000
+		case 'p':
+			var n int
+			value, n, err = parseName(value, []string{"AM", "PM"})
+			p.pm, p.hasAMPM = n == 1, true
+		case 'a':
+			value, _, err = parseName(value, shortNames(weekdayNames))
+		case 'A':
+			value, _, err = parseName(value, weekdayNames)
+		case 'b':
+			value, p.month, err = parseName(value, shortNames(monthNames))
+			p.month++
+			p.hasMonthDay = true
+		case 'B':
+			value, p.month, err = parseName(value, monthNames)
+			p.month++
+			p.hasMonthDay = true
+		case 'z':
+			value, err = p.parseOffset(value)
+		case 'Z':
+			value, err = p.parseZone(value)
+		case 'F':
+			value, err = p.parse(value, "%Y-%m-%d")
+		case 'T':
+			value, err = p.parse(value, "%H:%M:%S")
+		case '%':
+			if !strings.HasPrefix(value, "%") {
+				err = fmt.Errorf("the value doesn't have '%%'")
+			}
+			value = strings.TrimPrefix(value, "%")
+		default:
+			return "", fmt.Errorf("unsupported directive: %%%c", format[i])
+		}
+		if err != nil {
+			return "", fmt.Errorf("cannot parse %%%c: %v", format[i], err)
+		}
+	}
+	return value, nil
+}
+
+// parseOffset parses a UTC offset such as +0900.
+func (p *parsedTime) parseOffset(value string) (string, error) {
+	if value == "" || (value[0] != '+' && value[0] != '-') {
+		return "", fmt.Errorf("the offset must start with '+' or '-'")
+	}
+	rest, hhmm, err := parseDigits(value[1:], 4, 4)
+	if err != nil {
+		return "", err
+	}
+	if hhmm%100 >= 60 {
+		return "", fmt.Errorf("invalid offset: %v", value[:5])
+	}
+	offset := (hhmm/100*60 + hhmm%100) * 60
+	if value[0] == '-' {
+		offset = -offset
+	}
+	p.loc = time.FixedZone("", offset)
+	p.hasOffset = true
+	return rest, nil
+}
+
+// parseZone parses an abbreviation of a time zone.
+func (p *parsedTime) parseZone(value string) (string, error) {
+	n := 0
+	for n < len(value) && value[n] >= 'A' && value[n] <= 'Z' {
+		n++
+	}
+	if n < 3 {
+		return "", fmt.Errorf("the value doesn't have a time zone abbreviation")
+	}
+	p.zone = value[:n]
+	return value[n:], nil
+}
+
+// time returns the time having the parsed elements after validating them.
+func (p *parsedTime) time() (time.Time, error) {
+	if p.hour12 {
+		if p.hour < 1 || p.hour > 12 {
+			return time.Time{}, fmt.Errorf("hour out of range: %v", p.hour)
+		}
+		if p.hasAMPM {
+			p.hour %= 12
+			if p.pm {
+				p.hour += 12
+			}
+		}
+	}
+	switch {
+	case p.month < 1 || p.month > 12:
+		return time.Time{}, fmt.Errorf("month out of range: %v", p.month)
+	case p.day < 1 || p.day > daysIn(p.year, time.Month(p.month)):
+		return time.Time{}, fmt.Errorf("day out of range: %v", p.day)
+	case p.hour > 23:
+		return time.Time{}, fmt.Errorf("hour out of range: %v", p.hour)
+	case p.minute > 59:
+		return time.Time{}, fmt.Errorf("minute out of range: %v", p.minute)
+	case p.second > 59:
+		return time.Time{}, fmt.Errorf("second out of range: %v", p.second)
+	}
+
+	t := time.Date(p.year, time.Month(p.month), p.day, p.hour, p.minute, p.second, p.nsec, p.loc)
+	if p.yday > 0 {
+		d := time.Date(p.year, time.January, p.yday, p.hour, p.minute, p.second, p.nsec, p.loc)
+		if d.Year() != p.year {
+			return time.Time{}, fmt.Errorf("day of year out of range: %v", p.yday)
+		}
+		if p.hasMonthDay && d.YearDay() != t.YearDay() {
+			return time.Time{}, fmt.Errorf("day of year doesn't match the month and the day")
+		}
+		t = d
+	}
+
+	// As time.Parse does, a time having an abbreviation of a time zone is
+	// in the given location when the location uses the abbreviation at the
+	// time, and in UTC otherwise. A UTC offset takes precedence over it.
+	if p.zone != "" && !p.hasOffset {
+		if name, _ := t.Zone(); name != p.zone {
+			t = time.Date(t.Year(), t.Month(), t.Day(), p.hour, p.minute, p.second, p.nsec, time.UTC)
+		}
+	}
+	return t, nil
+}
+
+// daysIn returns the number of days in the month.
+func daysIn(year int, m time.Month) int {
+	return time.Date(year, m+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// parseDigits parses a number having min to max digits at the beginning
+// of value and returns the remaining text.
+func parseDigits(value string, min, max int) (string, int, error) {
+	n, x := 0, 0
+	for n < max && n < len(value) && value[n] >= '0' && value[n] <= '9' {
+		x = x*10 + int(value[n]-'0')
+		n++
+	}
+	if n < min {
+		return "", 0, fmt.Errorf("the value doesn't have %v digits", min)
+	}
+	return value[n:], x, nil
+}
+
+// parseName parses one of names at the beginning of value ignoring case
+// and returns the remaining text and the index of the name.
+func parseName(value string, names []string) (string, int, error) {
+	for i, n := range names {
+		if len(value) >= len(n) && strings.EqualFold(value[:len(n)], n) {
+			return value[len(n):], i, nil
+		}
+	}
+	return "", 0, fmt.Errorf("the value doesn't have any of %v", strings.Join(names, ", "))
+}
+
+var (
+	weekdayNames = []string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"}
+	monthNames   = []string{"January", "February", "March", "April", "May", "June", "July",
+		"August", "September", "October", "November", "December"}
+)
+
+// shortNames returns the first three letters of names.
+func shortNames(names []string) []string {
+	s := make([]string, len(names))
+	for i, n := range names {
+		s[i] = n[:3]
+	}
+	return s
+}
+
+func parseTimestamp(args []data.Value) (data.Value, error) {
+	s, err := data.AsString(args[0])
+	if err != nil {
+		return nil, err
+	}
+	format, err := data.AsString(args[1])
+	if err != nil {
+		return nil, err
+	}
+	loc := time.UTC
+	if len(args) > 2 {
+		name, err := data.AsString(args[2])
+		if err != nil {
+			return nil, err
+		}
+		loc, err = loadLocation(name)
+		if err != nil {
+			return nil, err
+		}
+	}
+	t, err := parseTime(s, format, loc)
+	if err != nil {
+		return nil, err
+	}
+	return data.Timestamp(t.In(time.UTC)), nil
+}
+
+// parseTimestampFunc(str, format, [zone]) parses str according to a
+// strftime-like format, which supports the same directives as
+// to_char. Other text in the format must appear in str as it is. A
+// time without a UTC offset is interpreted in the given time zone, or
+// UTC if omitted. %f accepts one to six digits.
+//
+// It can be used in BQL as `to_timestamp`.
+//
+//  Input: String, String, [String]
+//  Return Type: Timestamp
+var parseTimestampFunc udf.UDF = &multiParamTimeFunc{2, 3, parseTimestamp}
+
+// epochToTimestampFunc(sec) converts the number of seconds since
+// 1970-01-01 00:00:00 UTC to a Timestamp (in UTC).
+//
+// It can be used in BQL as `to_timestamp`.
+//
+//  Input: Int or Float
+//  Return Type: Timestamp
+var epochToTimestampFunc udf.UDF = &multiParamTimeFunc{1, 1, func(args []data.Value) (data.Value, error) {
+	switch args[0].Type() {
+	case data.TypeInt:
+		sec, _ := data.AsInt(args[0])
+		return data.Timestamp(time.Unix(sec, 0).In(time.UTC)), nil
+	case data.TypeFloat:
+		f, _ := data.AsFloat(args[0])
+		sec := math.Floor(f)
+		// keep microsecond precision as data.Timestamp does
+		us := math.Floor((f-sec)*1e6 + 0.5)
+		return data.Timestamp(time.Unix(int64(sec), int64(us)*1000).In(time.UTC)), nil
+	}
+	return nil, fmt.Errorf("cannot interpret %s as a number", args[0])
+}}
+
+// timezoneFunc(zone, t) returns the wall-clock time of t in the
+// given time zone, expressed as a timestamp in UTC. This is the same
+// as `t AT TIME ZONE zone` in PostgreSQL and can be used to pass the
+// local time to functions such as date_trunc.
+//
+// It can be used in BQL as `timezone`.
+//
+//  Input: String, Timestamp
+//  Return Type: Timestamp
+var timezoneFunc udf.UDF = &multiParamTimeFunc{2, 2, func(args []data.Value) (data.Value, error) {
+	t, err := timestampAndLocation(args, 1, 0)
+	if err != nil {
+		return nil, err
+	}
+	y, mon, d := t.Date()
+	h, m, s := t.Clock()
+	return data.Timestamp(time.Date(y, mon, d, h, m, s, t.Nanosecond(), time.UTC)), nil
+}}
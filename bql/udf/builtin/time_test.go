@@ -123,3 +123,157 @@ func TestBinaryDateFuncs(t *testing.T) {
 		})
 	}
 }
+
+func TestDateTimeFuncs(t *testing.T) {
+	// Friday
+	someTime := time.Date(2015, time.May, 1, 14, 27, 3, 123456000, time.UTC)
+	ts := data.Timestamp(someTime)
+
+	testCases := []struct {
+		name     string
+		f        udf.UDF
+		args     []data.Value
+		expected data.Value
+	}{
+		// date_trunc
+		{"date_trunc", dateTruncFunc, []data.Value{data.String("milliseconds"), ts},
+			data.Timestamp(time.Date(2015, time.May, 1, 14, 27, 3, 123000000, time.UTC))},
+		{"date_trunc", dateTruncFunc, []data.Value{data.String("second"), ts},
+			data.Timestamp(time.Date(2015, time.May, 1, 14, 27, 3, 0, time.UTC))},
+		{"date_trunc", dateTruncFunc, []data.Value{data.String("HOUR"), ts},
+			data.Timestamp(time.Date(2015, time.May, 1, 14, 0, 0, 0, time.UTC))},
+		{"date_trunc", dateTruncFunc, []data.Value{data.String("day"), ts},
+			data.Timestamp(time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC))},
+		{"date_trunc", dateTruncFunc, []data.Value{data.String("day"), ts, data.String("+10:00")},
+			data.Timestamp(time.Date(2015, time.May, 1, 14, 0, 0, 0, time.UTC))},
+		{"date_trunc", dateTruncFunc, []data.Value{data.String("week"), ts},
+			data.Timestamp(time.Date(2015, time.April, 27, 0, 0, 0, 0, time.UTC))},
+		{"date_trunc", dateTruncFunc, []data.Value{data.String("quarter"), ts},
+			data.Timestamp(time.Date(2015, time.April, 1, 0, 0, 0, 0, time.UTC))},
+		{"date_trunc", dateTruncFunc, []data.Value{data.String("year"), ts},
+			data.Timestamp(time.Date(2015, time.January, 1, 0, 0, 0, 0, time.UTC))},
+		{"date_trunc", dateTruncFunc, []data.Value{data.String("decade"), ts}, nil},
+		{"date_trunc", dateTruncFunc, []data.Value{data.String("day"), data.Int(1)}, nil},
+		{"date_trunc", dateTruncFunc, []data.Value{data.String("day"), ts, data.String("Nowhere/Foo")}, nil},
+		{"date_trunc", dateTruncFunc, []data.Value{data.Null{}, ts}, data.Null{}},
+		// date_part
+		{"date_part", datePartFunc, []data.Value{data.String("year"), ts}, data.Int(2015)},
+		{"date_part", datePartFunc, []data.Value{data.String("quarter"), ts}, data.Int(2)},
+		{"date_part", datePartFunc, []data.Value{data.String("month"), ts}, data.Int(5)},
+		{"date_part", datePartFunc, []data.Value{data.String("week"), ts}, data.Int(18)},
+		{"date_part", datePartFunc, []data.Value{data.String("day"), ts}, data.Int(1)},
+		{"date_part", datePartFunc, []data.Value{data.String("doy"), ts}, data.Int(121)},
+		{"date_part", datePartFunc, []data.Value{data.String("dow"), ts}, data.Int(5)},
+		{"date_part", datePartFunc, []data.Value{data.String("isodow"), ts}, data.Int(5)},
+		{"date_part", datePartFunc, []data.Value{data.String("hour"), ts}, data.Int(14)},
+		{"date_part", datePartFunc, []data.Value{data.String("hour"), ts, data.String("+09:00")}, data.Int(23)},
+		{"date_part", datePartFunc, []data.Value{data.String("minute"), ts}, data.Int(27)},
+		{"date_part", datePartFunc, []data.Value{data.String("second"), ts}, data.Float(3.123456)},
+		{"date_part", datePartFunc, []data.Value{data.String("milliseconds"), ts}, data.Float(3123.456)},
+		{"date_part", datePartFunc, []data.Value{data.String("microseconds"), ts}, data.Int(3123456)},
+		{"date_part", datePartFunc, []data.Value{data.String("epoch"), ts}, data.Float(1430490423.123456)},
+		{"date_part", datePartFunc, []data.Value{data.String("century"), ts}, nil},
+		{"date_part", datePartFunc, []data.Value{data.String("year"), data.Null{}}, data.Null{}},
+		// to_char
+		{"to_char", toCharFunc, []data.Value{ts, data.String("%Y-%m-%dT%H:%M:%S.%f")},
+			data.String("2015-05-01T14:27:03.123456")},
+		{"to_char", toCharFunc, []data.Value{ts, data.String("%a %d %b %y, %I:%M %p (%j) 100%%")},
+			data.String("Fri 01 May 15, 02:27 PM (121) 100%")},
+		{"to_char", toCharFunc, []data.Value{ts, data.String("%F %T %z"), data.String("+09:00")},
+			data.String("2015-05-01 23:27:03 +0900")},
+		{"to_char", toCharFunc, []data.Value{ts, data.String("Mon Jan PM MST: %m")},
+			data.String("Mon Jan PM MST: 05")},
+		{"to_char", toCharFunc, []data.Value{ts, data.String("%Q")}, nil},
+		{"to_char", toCharFunc, []data.Value{ts, data.String("%")}, nil},
+		// to_timestamp
+		{"to_timestamp", parseTimestampFunc, []data.Value{
+			data.String("2015-05-01 14:27:03.123456"), data.String("%Y-%m-%d %H:%M:%S.%f")}, ts},
+		{"to_timestamp", parseTimestampFunc, []data.Value{
+			data.String("01/May/2015:23:27:03 +0900"), data.String("%d/%b/%Y:%T %z")},
+			data.Timestamp(time.Date(2015, time.May, 1, 14, 27, 3, 0, time.UTC))},
+		{"to_timestamp", parseTimestampFunc, []data.Value{
+			data.String("2015-05-01 23:27"), data.String("%F %H:%M"), data.String("+09:00")},
+			data.Timestamp(time.Date(2015, time.May, 1, 14, 27, 0, 0, time.UTC))},
+		{"to_timestamp", parseTimestampFunc, []data.Value{
+			data.String("2015-05-01"), data.String("%Y/%m/%d")}, nil},
+		{"to_timestamp", parseTimestampFunc, []data.Value{
+			data.String("2015-05-01"), data.String("2015-%m-%d")},
+			data.Timestamp(time.Date(0, time.May, 1, 0, 0, 0, 0, time.UTC))},
+		{"to_timestamp", parseTimestampFunc, []data.Value{
+			data.String("Month: 05, Mon Jan PM MST 2015"), data.String("Month: %m, Mon Jan PM MST %Y")},
+			data.Timestamp(time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC))},
+		{"to_timestamp", parseTimestampFunc, []data.Value{
+			data.String("Fri May  1 15 02:27:03.5 PM"), data.String("%a %b %e %y %I:%M:%S.%f %p")},
+			data.Timestamp(time.Date(2015, time.May, 1, 14, 27, 3, 500000000, time.UTC))},
+		{"to_timestamp", parseTimestampFunc, []data.Value{
+			data.String("2015 121 23:27 JST"), data.String("%Y %j %H:%M %Z"), data.String("Asia/Tokyo")},
+			data.Timestamp(time.Date(2015, time.May, 1, 14, 27, 0, 0, time.UTC))},
+		{"to_timestamp", parseTimestampFunc, []data.Value{
+			data.String("2015-05-01 14:27 MST"), data.String("%F %H:%M %Z")},
+			data.Timestamp(time.Date(2015, time.May, 1, 14, 27, 0, 0, time.UTC))},
+		{"to_timestamp", parseTimestampFunc, []data.Value{
+			data.String("Month 05"), data.String("Month: %m")}, nil},
+		{"to_timestamp", parseTimestampFunc, []data.Value{
+			data.String("2015-05-01 "), data.String("%F")}, nil},
+		{"to_timestamp", parseTimestampFunc, []data.Value{
+			data.String("2015-02-30"), data.String("%F")}, nil},
+		{"to_timestamp", parseTimestampFunc, []data.Value{
+			data.String("2015-05-01 120"), data.String("%F %j")}, nil},
+		{"to_timestamp", parseTimestampFunc, []data.Value{
+			data.String("13:00 PM"), data.String("%I:%M %p")}, nil},
+		{"to_timestamp", parseTimestampFunc, []data.Value{
+			data.String("3123456"), data.String("%S%f")},
+			data.Timestamp(time.Date(0, time.January, 1, 0, 0, 31, 234560000, time.UTC))},
+		{"to_timestamp", epochToTimestampFunc, []data.Value{data.Int(1430490423)},
+			data.Timestamp(time.Date(2015, time.May, 1, 14, 27, 3, 0, time.UTC))},
+		{"to_timestamp", epochToTimestampFunc, []data.Value{data.Float(1430490423.123456)}, ts},
+		{"to_timestamp", epochToTimestampFunc, []data.Value{data.String("1430490423")}, nil},
+		{"to_timestamp", epochToTimestampFunc, []data.Value{data.Null{}}, data.Null{}},
+		// timezone
+		{"timezone", timezoneFunc, []data.Value{data.String("+09:00"), ts},
+			data.Timestamp(time.Date(2015, time.May, 1, 23, 27, 3, 123456000, time.UTC))},
+		{"timezone", timezoneFunc, []data.Value{data.String("UTC"), ts}, ts},
+		{"timezone", timezoneFunc, []data.Value{data.Int(9), ts}, nil},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		Convey(fmt.Sprintf("Given the %s function", tc.name), t, func() {
+			Convey(fmt.Sprintf("When evaluating it on %v", tc.args), func() {
+				val, err := tc.f.Call(nil, tc.args...)
+
+				if tc.expected == nil {
+					Convey("Then evaluation should fail", func() {
+						So(err, ShouldNotBeNil)
+					})
+				} else {
+					Convey(fmt.Sprintf("Then the result should be %s", tc.expected), func() {
+						So(err, ShouldBeNil)
+						if tc.expected.Type() == data.TypeFloat {
+							So(val, ShouldAlmostEqual, tc.expected, 0.000001)
+						} else {
+							So(val, ShouldResemble, tc.expected)
+						}
+					})
+				}
+			})
+
+			Convey("Then it should equal the one in the default registry", func() {
+				arity := len(tc.args)
+				regFun, err := udf.CopyGlobalUDFRegistry(nil).Lookup(tc.name, arity)
+				So(err, ShouldBeNil)
+				if dispatcher, ok := regFun.(*arityDispatcher); ok {
+					switch arity {
+					case 1:
+						regFun = dispatcher.unary
+					case 2:
+						regFun = dispatcher.binary
+					case 3:
+						regFun = dispatcher.ternary
+					}
+				}
+				So(regFun, ShouldEqual, tc.f)
+			})
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package builtin
+
+import (
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// unnestUDSF emits one tuple per element of an array.
+//
+// In the stream mode, created by `unnest("stream", "path")`, it reads
+// the array at the given path of each tuple in the input stream and
+// emits a copy of the tuple for each element, where the array is
+// replaced by the element. For example,
+//
+//  SELECT RSTREAM * FROM unnest("readings", "values") [RANGE 1 TUPLES]
+//
+// turns {"device": "a", "values": [1, 2]} into {"device": "a", "values": 1}
+// and {"device": "a", "values": 2}. Tuples where the path does not exist
+// or the value is NULL do not emit anything.
+//
+// In the source mode, created by `unnest(array)`, it emits a tuple
+// {"value": element} for each element of the given array.
+type unnestUDSF struct {
+	path  data.Path
+	array data.Array
+}
+
+func (u *unnestUDSF) Process(ctx *core.Context, t *core.Tuple, w core.Writer) error {
+	if u.path == nil {
+		for _, elem := range u.array {
+			if err := w.Write(ctx, core.NewTuple(data.Map{"value": elem})); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	v, err := t.Data.Get(u.path)
+	if err != nil || v.Type() == data.TypeNull {
+		return nil
+	}
+	a, err := data.AsArray(v)
+	if err != nil {
+		return fmt.Errorf("%v is not an array", v)
+	}
+	for _, elem := range a {
+		out := t.Copy()
+		if err := out.Data.Set(u.path, elem); err != nil {
+			return err
+		}
+		if err := w.Write(ctx, out); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (u *unnestUDSF) Terminate(ctx *core.Context) error {
+	return nil
+}
+
+type unnestUDSFCreator struct{}
+
+func (c *unnestUDSFCreator) CreateUDSF(ctx *core.Context, decl udf.UDSFDeclarer, args ...data.Value) (udf.UDSF, error) {
+	switch len(args) {
+	case 1:
+		if args[0].Type() == data.TypeNull {
+			return &unnestUDSF{}, nil
+		}
+		a, err := data.AsArray(args[0])
+		if err != nil {
+			return nil, fmt.Errorf("%v is not an array", args[0])
+		}
+		return &unnestUDSF{array: a}, nil
+
+	case 2:
+		stream, err := data.AsString(args[0])
+		if err != nil {
+			return nil, fmt.Errorf("stream name must be a string: %v", args[0])
+		}
+		p, err := data.AsString(args[1])
+		if err != nil {
+			return nil, fmt.Errorf("path must be a string: %v", args[1])
+		}
+		path, err := data.CompilePath(p)
+		if err != nil {
+			return nil, err
+		}
+		if err := decl.Input(stream, nil); err != nil {
+			return nil, err
+		}
+		return &unnestUDSF{path: path}, nil
+	}
+	return nil, fmt.Errorf("unnest takes one or two arguments")
+}
+
+func (c *unnestUDSFCreator) Accept(arity int) bool {
+	return arity == 1 || arity == 2
+}
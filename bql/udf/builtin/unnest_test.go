@@ -0,0 +1,102 @@
+package builtin
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"testing"
+)
+
+func TestUnnestUDSF(t *testing.T) {
+	Convey("Given the unnest UDSF creator", t, func() {
+		c := &unnestUDSFCreator{}
+		ctx := core.NewContext(nil)
+		var tuples []*core.Tuple
+		w := core.WriterFunc(func(ctx *core.Context, t *core.Tuple) error {
+			tuples = append(tuples, t)
+			return nil
+		})
+
+		Convey("When creating a UDSF with a stream and a path", func() {
+			decl := udf.NewUDSFDeclarer()
+			f, err := c.CreateUDSF(ctx, decl, data.String("s"), data.String("values"))
+			So(err, ShouldBeNil)
+
+			Convey("Then it should have the stream as input", func() {
+				So(decl.ListInputs(), ShouldContainKey, "s")
+			})
+
+			Convey("Then it should emit one tuple per element", func() {
+				in := core.NewTuple(data.Map{
+					"device": data.String("a"),
+					"values": data.Array{data.Int(1), data.Int(2)},
+				})
+				So(f.Process(ctx, in, w), ShouldBeNil)
+				So(len(tuples), ShouldEqual, 2)
+				So(tuples[0].Data, ShouldResemble, data.Map{
+					"device": data.String("a"), "values": data.Int(1)})
+				So(tuples[1].Data, ShouldResemble, data.Map{
+					"device": data.String("a"), "values": data.Int(2)})
+
+				Convey("And the input tuple should not be modified", func() {
+					So(in.Data["values"], ShouldResemble, data.Array{data.Int(1), data.Int(2)})
+				})
+			})
+
+			Convey("Then it should not emit anything for missing or null values", func() {
+				So(f.Process(ctx, core.NewTuple(data.Map{}), w), ShouldBeNil)
+				So(f.Process(ctx, core.NewTuple(data.Map{"values": data.Null{}}), w), ShouldBeNil)
+				So(f.Process(ctx, core.NewTuple(data.Map{"values": data.Array{}}), w), ShouldBeNil)
+				So(tuples, ShouldBeEmpty)
+			})
+
+			Convey("Then it should fail on values which are not arrays", func() {
+				So(f.Process(ctx, core.NewTuple(data.Map{"values": data.Int(1)}), w), ShouldNotBeNil)
+			})
+		})
+
+		Convey("When creating a UDSF with an array", func() {
+			decl := udf.NewUDSFDeclarer()
+			f, err := c.CreateUDSF(ctx, decl, data.Array{data.Int(1), data.String("a")})
+			So(err, ShouldBeNil)
+
+			Convey("Then it should not have any input", func() {
+				So(decl.ListInputs(), ShouldBeEmpty)
+			})
+
+			Convey("Then it should emit one tuple per element", func() {
+				So(f.Process(ctx, core.NewTuple(data.Map{}), w), ShouldBeNil)
+				So(len(tuples), ShouldEqual, 2)
+				So(tuples[0].Data, ShouldResemble, data.Map{"value": data.Int(1)})
+				So(tuples[1].Data, ShouldResemble, data.Map{"value": data.String("a")})
+			})
+		})
+
+		Convey("When creating a UDSF with invalid arguments", func() {
+			for _, args := range [][]data.Value{
+				{data.Int(1)},
+				{data.Int(1), data.String("values")},
+				{data.String("s"), data.Int(1)},
+				{data.String("s"), data.String("values[")},
+			} {
+				_, err := c.CreateUDSF(ctx, udf.NewUDSFDeclarer(), args...)
+
+				Convey("Then creation should fail for "+data.Array(args).String(), func() {
+					So(err, ShouldNotBeNil)
+				})
+			}
+		})
+
+		Convey("Then it should be registered", func() {
+			reg, err := udf.CopyGlobalUDSFCreatorRegistry()
+			So(err, ShouldBeNil)
+			_, err = reg.Lookup("unnest", 1)
+			So(err, ShouldBeNil)
+			_, err = reg.Lookup("unnest", 2)
+			So(err, ShouldBeNil)
+			_, err = reg.Lookup("unnest", 3)
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
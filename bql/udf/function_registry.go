@@ -185,6 +185,9 @@ func (fr *defaultFunctionRegistry) Register(name string, f UDF) error {
 			return fmt.Errorf("invalid name for function: %s", err.Error())
 		}
 	}
+	if reservedFunctionNames[lowerName] {
+		return fmt.Errorf("'%s' is a reserved function name", name)
+	}
 	if _, exists := fr.funcs[lowerName]; exists {
 		return fmt.Errorf("there is already a function named '%s'", name)
 	}
@@ -192,6 +195,18 @@ func (fr *defaultFunctionRegistry) Register(name string, f UDF) error {
 	return nil
 }
 
+// reservedFunctionNames are the names of the higher-order functions that
+// are built into the BQL execution engine. They are resolved before any
+// registered function, so a UDF with one of these names could never be
+// called.
+var reservedFunctionNames = map[string]bool{
+	"transform": true,
+	"filter":    true,
+	"reduce":    true,
+	"any_match": true,
+	"all_match": true,
+}
+
 var (
 	// globalUDFRegistry has UDFs visible to all topologies. Do NOT use this
 	// instance in running topologies because it doesn't have an actual
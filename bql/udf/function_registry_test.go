@@ -119,5 +119,22 @@ func TestDefaultFunctionRegistry(t *testing.T) {
 				So(err, ShouldNotBeNil)
 			})
 		})
+
+		Convey("When adding a function with the name of a higher-order function", func() {
+			fun := func(ctx *core.Context, vs ...data.Value) (data.Value, error) {
+				return data.Bool(true), nil
+			}
+			err := fr.Register("Filter", Func(fun, 2))
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "reserved")
+			})
+
+			Convey("And it should not be registered", func() {
+				_, err := fr.Lookup("filter", 2)
+				So(core.IsNotExist(err), ShouldBeTrue)
+			})
+		})
 	})
 }
@@ -0,0 +1,34 @@
+package udf
+
+import (
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// TableSharedState is a core.SharedState holding keyed rows. A stream can be
+// joined with such a state in the FROM clause of a SELECT statement:
+//
+//  SELECT ISTREAM * FROM readings [RANGE 1 TUPLES]
+//      JOIN devices ON readings:device_id = devices:id
+//
+// When the ON clause compares the key column of the table with a value of
+// the stream, Lookup is used to find the joined row. Otherwise, all rows are
+// scanned with Scan.
+//
+// Rows returned from Lookup or passed to Scan's callback can be modified
+// by the caller, so a table must return copies of its internal data. All
+// methods can be called concurrently.
+type TableSharedState interface {
+	core.SharedState
+
+	// Key returns the name of the column that identifies a row.
+	Key() string
+
+	// Lookup returns the row having the given value in the key column or
+	// nil if there is no such row.
+	Lookup(ctx *core.Context, key data.Value) (data.Map, error)
+
+	// Scan calls f with each row of the table. When f returns an error,
+	// Scan stops and returns the error.
+	Scan(ctx *core.Context, f func(row data.Map) error) error
+}
@@ -0,0 +1,336 @@
+package udf
+
+import (
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// UDAF is a user defined aggregate function. Unlike an aggregate UDF, which
+// receives all values of a group as a data.Array, a UDAF accumulates values
+// into a state one by one, so that the state can be as small as the
+// aggregate needs (e.g. a sketch of the values). A UDAF has exactly one
+// parameter, which is an aggregation parameter.
+//
+// A UDAF can be registered as a UDF with RegisterGlobalUDAF or by converting
+// it with ConvertUDAF:
+//
+//  udf.MustRegisterGlobalUDAF("my_agg", &myAgg{})
+//
+// and then be used like any other aggregate function:
+//
+//  SELECT ISTREAM key, my_agg(value) FROM s [RANGE 1 MINUTES] GROUP BY key
+//
+// The result of a UDAF must not depend on the order in which values are
+// accumulated or states are merged.
+type UDAF interface {
+	// Init creates a new state which hasn't accumulated any value yet.
+	Init(ctx *core.Context) (UDAFState, error)
+}
+
+// UDAFState is the state of a UDAF for one group.
+type UDAFState interface {
+	// Accumulate adds a value to the state.
+	Accumulate(ctx *core.Context, v data.Value) error
+
+	// Merge adds all values accumulated in another state, which was
+	// created by the same UDAF, to the state. The other state must not
+	// be modified.
+	Merge(ctx *core.Context, other UDAFState) error
+
+	// Finalize returns the result of the aggregate over all values in
+	// the state. It can be called more than once and must not modify
+	// the state.
+	Finalize(ctx *core.Context) (data.Value, error)
+}
+
+// RetractableUDAF is a UDAF whose states can also remove values. When a
+// value leaves a window, it is retracted from the state instead of merging
+// partial states of the values remaining in the window.
+type RetractableUDAF interface {
+	UDAF
+
+	// InitRetractable creates a new state like Init.
+	InitRetractable(ctx *core.Context) (RetractableUDAFState, error)
+}
+
+// RetractableUDAFState is the state of a RetractableUDAF.
+type RetractableUDAFState interface {
+	UDAFState
+
+	// Retract removes a value that was previously accumulated.
+	Retract(ctx *core.Context, v data.Value) error
+}
+
+// ParameterizedUDAF is a UDAF which has parameters other than the
+// aggregation parameter, such as the number of values returned. The
+// aggregation parameter is the first parameter. The values of the other
+// parameters are bound before values are accumulated, so they must be
+// constants to compute the aggregate incrementally.
+//
+// A ParameterizedUDAF can be registered as a UDF by converting it with
+// ConvertParameterizedUDAF.
+type ParameterizedUDAF interface {
+	// Accept returns true if the UDAF accepts the given number of
+	// parameters including the aggregation parameter.
+	Accept(arity int) bool
+
+	// Bind returns a UDAF whose states aggregate values with the given
+	// values of the parameters other than the aggregation parameter.
+	Bind(ctx *core.Context, params ...data.Value) (UDAF, error)
+}
+
+type udafFunc struct {
+	f UDAF
+}
+
+// ConvertUDAF creates a UDF from a UDAF. The UDF is an IncrementalAggregate,
+// so that the states of the UDAF are kept while values enter and leave
+// windows.
+func ConvertUDAF(f UDAF) UDF {
+	return &udafFunc{f}
+}
+
+func (u *udafFunc) Call(ctx *core.Context, args ...data.Value) (data.Value, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("function takes exactly one argument")
+	}
+	arr, err := data.AsArray(args[0])
+	if err != nil {
+		return nil, fmt.Errorf("function needs array input, not %T", args[0])
+	}
+	return aggregateUDAF(ctx, u.f, arr)
+}
+
+// aggregateUDAF computes the result of the UDAF over all values in arr.
+func aggregateUDAF(ctx *core.Context, f UDAF, arr data.Array) (data.Value, error) {
+	s, err := f.Init(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range arr {
+		if err := s.Accumulate(ctx, v); err != nil {
+			return nil, err
+		}
+	}
+	return s.Finalize(ctx)
+}
+
+func (u *udafFunc) Accept(arity int) bool {
+	return arity == 1
+}
+
+func (u *udafFunc) IsAggregationParameter(k int) bool {
+	return k == 0
+}
+
+func (u *udafFunc) NewAggregateState(ctx *core.Context) (AggregateState, error) {
+	return newUDAFAggregateState(ctx, u.f)
+}
+
+type parameterizedUDAFFunc struct {
+	f ParameterizedUDAF
+}
+
+// ConvertParameterizedUDAF creates a UDF from a ParameterizedUDAF. The UDF
+// is a ParameterizedIncrementalAggregate.
+func ConvertParameterizedUDAF(f ParameterizedUDAF) UDF {
+	return &parameterizedUDAFFunc{f}
+}
+
+func (u *parameterizedUDAFFunc) Call(ctx *core.Context, args ...data.Value) (data.Value, error) {
+	if !u.f.Accept(len(args)) {
+		return nil, fmt.Errorf("function cannot take %v arguments", len(args))
+	}
+	arr, err := data.AsArray(args[0])
+	if err != nil {
+		return nil, fmt.Errorf("function needs array input, not %T", args[0])
+	}
+	f, err := u.f.Bind(ctx, args[1:]...)
+	if err != nil {
+		return nil, err
+	}
+	return aggregateUDAF(ctx, f, arr)
+}
+
+func (u *parameterizedUDAFFunc) Accept(arity int) bool {
+	return u.f.Accept(arity)
+}
+
+func (u *parameterizedUDAFFunc) IsAggregationParameter(k int) bool {
+	return k == 0
+}
+
+func (u *parameterizedUDAFFunc) NewParameterizedAggregateState(ctx *core.Context, params ...data.Value) (AggregateState, error) {
+	if !u.f.Accept(len(params) + 1) {
+		return nil, fmt.Errorf("function cannot take %v arguments", len(params)+1)
+	}
+	f, err := u.f.Bind(ctx, params...)
+	if err != nil {
+		return nil, err
+	}
+	return newUDAFAggregateState(ctx, f)
+}
+
+// newUDAFAggregateState creates an AggregateState keeping states of the
+// UDAF.
+func newUDAFAggregateState(ctx *core.Context, f UDAF) (AggregateState, error) {
+	if r, ok := f.(RetractableUDAF); ok {
+		s, err := r.InitRetractable(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return &retractingAggregateState{s}, nil
+	}
+	s := &mergingAggregateState{f: f}
+	if err := s.reset(ctx); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// retractingAggregateState is the AggregateState of a RetractableUDAF.
+type retractingAggregateState struct {
+	s RetractableUDAFState
+}
+
+func (r *retractingAggregateState) Add(ctx *core.Context, v data.Value) error {
+	return r.s.Accumulate(ctx, v)
+}
+
+func (r *retractingAggregateState) Remove(ctx *core.Context, v data.Value) error {
+	return r.s.Retract(ctx, v)
+}
+
+func (r *retractingAggregateState) Result(ctx *core.Context) (data.Value, error) {
+	return r.s.Finalize(ctx)
+}
+
+// mergingAggregateState is the AggregateState of a UDAF whose states cannot
+// retract values. It keeps the values like a queue that is implemented with
+// two stacks: Values are accumulated into the back state. When the oldest
+// value is removed and the front stack is empty, all values are moved to
+// the front stack, whose i-th state holds the values from the i-th to the
+// newest value in the stack. The oldest value is then removed by popping
+// the front stack. The result is computed by merging the top of the front
+// stack and the back state, so each value is accumulated only a constant
+// number of times on average.
+//
+// Removing a value that is not the oldest one requires all states to be
+// rebuilt.
+type mergingAggregateState struct {
+	f UDAF
+	// values holds the values in the order in which they were added.
+	// The first len(front) values belong to the front stack, the
+	// others to the back state.
+	values []data.Value
+	// front holds the front stack. Its top is the last element.
+	front []UDAFState
+	back  UDAFState
+}
+
+func (m *mergingAggregateState) reset(ctx *core.Context) error {
+	back, err := m.f.Init(ctx)
+	if err != nil {
+		return err
+	}
+	m.front = nil
+	m.back = back
+	return nil
+}
+
+func (m *mergingAggregateState) Add(ctx *core.Context, v data.Value) error {
+	if err := m.back.Accumulate(ctx, v); err != nil {
+		return err
+	}
+	m.values = append(m.values, v)
+	return nil
+}
+
+func (m *mergingAggregateState) Remove(ctx *core.Context, v data.Value) error {
+	idx := -1
+	for i, w := range m.values {
+		if data.Equal(v, w) {
+			idx = i
+			break
+		}
+	}
+	if idx < 0 {
+		return fmt.Errorf("%v was never added", v)
+	}
+	if idx > 0 {
+		m.values = append(m.values[:idx], m.values[idx+1:]...)
+		// all states have to be rebuilt from the remaining values
+		if err := m.reset(ctx); err != nil {
+			return err
+		}
+		for _, w := range m.values {
+			if err := m.back.Accumulate(ctx, w); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	values := m.values
+	m.values = m.values[1:]
+	if len(m.front) == 0 {
+		// move all values from the back state to the front stack
+		// (including the one to be removed, which is popped below)
+		front := make([]UDAFState, len(values))
+		for i := len(values) - 1; i >= 0; i-- {
+			s, err := m.f.Init(ctx)
+			if err != nil {
+				return err
+			}
+			if i < len(values)-1 {
+				if err := s.Merge(ctx, front[len(values)-2-i]); err != nil {
+					return err
+				}
+			}
+			if err := s.Accumulate(ctx, values[i]); err != nil {
+				return err
+			}
+			front[len(values)-1-i] = s
+		}
+		back, err := m.f.Init(ctx)
+		if err != nil {
+			return err
+		}
+		m.front, m.back = front, back
+	}
+	m.front[len(m.front)-1] = nil
+	m.front = m.front[:len(m.front)-1]
+	return nil
+}
+
+func (m *mergingAggregateState) Result(ctx *core.Context) (data.Value, error) {
+	if len(m.front) == 0 {
+		return m.back.Finalize(ctx)
+	}
+	s, err := m.f.Init(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Merge(ctx, m.front[len(m.front)-1]); err != nil {
+		return nil, err
+	}
+	if err := s.Merge(ctx, m.back); err != nil {
+		return nil, err
+	}
+	return s.Finalize(ctx)
+}
+
+// RegisterGlobalUDAF adds a UDAF which is visible to all topologies like
+// RegisterGlobalUDF.
+func RegisterGlobalUDAF(name string, f UDAF) error {
+	return RegisterGlobalUDF(name, ConvertUDAF(f))
+}
+
+// MustRegisterGlobalUDAF is like RegisterGlobalUDAF but
+// panics if an error occurred.
+func MustRegisterGlobalUDAF(name string, f UDAF) {
+	if err := RegisterGlobalUDAF(name, f); err != nil {
+		panic(fmt.Errorf("udf.MustRegisterGlobalUDAF: cannot register '%v': %v", name, err))
+	}
+}
@@ -0,0 +1,320 @@
+package udf
+
+import (
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"testing"
+)
+
+// testSumUDAF is a RetractableUDAF summing up integers.
+type testSumUDAF struct{}
+
+type testSumState struct {
+	sum int64
+}
+
+func (u *testSumUDAF) Init(ctx *core.Context) (UDAFState, error) {
+	return &testSumState{}, nil
+}
+
+func (u *testSumUDAF) InitRetractable(ctx *core.Context) (RetractableUDAFState, error) {
+	return &testSumState{}, nil
+}
+
+func (s *testSumState) Accumulate(ctx *core.Context, v data.Value) error {
+	i, err := data.AsInt(v)
+	if err != nil {
+		return err
+	}
+	s.sum += i
+	return nil
+}
+
+func (s *testSumState) Retract(ctx *core.Context, v data.Value) error {
+	i, err := data.AsInt(v)
+	if err != nil {
+		return err
+	}
+	s.sum -= i
+	return nil
+}
+
+func (s *testSumState) Merge(ctx *core.Context, other UDAFState) error {
+	s.sum += other.(*testSumState).sum
+	return nil
+}
+
+func (s *testSumState) Finalize(ctx *core.Context) (data.Value, error) {
+	return data.Int(s.sum), nil
+}
+
+// testDistinctUDAF is a UDAF counting distinct values. It counts the
+// number of accumulations and merges to test how often they're done.
+type testDistinctUDAF struct {
+	accumulations int
+}
+
+type testDistinctState struct {
+	u      *testDistinctUDAF
+	values map[string]bool
+}
+
+func (u *testDistinctUDAF) Init(ctx *core.Context) (UDAFState, error) {
+	return &testDistinctState{u, map[string]bool{}}, nil
+}
+
+func (s *testDistinctState) Accumulate(ctx *core.Context, v data.Value) error {
+	if v.Type() == data.TypeBlob {
+		return fmt.Errorf("blobs are not supported")
+	}
+	s.u.accumulations++
+	s.values[v.String()] = true
+	return nil
+}
+
+func (s *testDistinctState) Merge(ctx *core.Context, other UDAFState) error {
+	for v := range other.(*testDistinctState).values {
+		s.values[v] = true
+	}
+	return nil
+}
+
+func (s *testDistinctState) Finalize(ctx *core.Context) (data.Value, error) {
+	return data.Int(len(s.values)), nil
+}
+
+// testScaledSumUDAF is a ParameterizedUDAF summing up integers multiplied
+// by its parameter.
+type testScaledSumUDAF struct{}
+
+func (u *testScaledSumUDAF) Accept(arity int) bool {
+	return arity == 2
+}
+
+func (u *testScaledSumUDAF) Bind(ctx *core.Context, params ...data.Value) (UDAF, error) {
+	scale, err := data.AsInt(params[0])
+	if err != nil {
+		return nil, err
+	}
+	return &testScaledSumBound{scale}, nil
+}
+
+type testScaledSumBound struct {
+	scale int64
+}
+
+func (u *testScaledSumBound) Init(ctx *core.Context) (UDAFState, error) {
+	return &testScaledSumState{scale: u.scale}, nil
+}
+
+type testScaledSumState struct {
+	scale int64
+	testSumState
+}
+
+func (s *testScaledSumState) Merge(ctx *core.Context, other UDAFState) error {
+	s.sum += other.(*testScaledSumState).sum
+	return nil
+}
+
+func (s *testScaledSumState) Finalize(ctx *core.Context) (data.Value, error) {
+	return data.Int(s.sum * s.scale), nil
+}
+
+func TestUDAF(t *testing.T) {
+	ctx := core.NewContext(nil)
+
+	Convey("Given a UDAF converted to a UDF", t, func() {
+		u := &testDistinctUDAF{}
+		f := ConvertUDAF(u)
+
+		Convey("Then it should be an aggregate function with one parameter", func() {
+			So(f.Accept(1), ShouldBeTrue)
+			So(f.Accept(2), ShouldBeFalse)
+			So(f.IsAggregationParameter(0), ShouldBeTrue)
+		})
+
+		Convey("When calling it with an array", func() {
+			v, err := f.Call(ctx, data.Array{data.Int(1), data.Int(2), data.Int(1)})
+
+			Convey("Then it should return the result of the UDAF", func() {
+				So(err, ShouldBeNil)
+				So(v, ShouldEqual, data.Int(2))
+			})
+		})
+
+		Convey("When calling it with a value that cannot be accumulated", func() {
+			_, err := f.Call(ctx, data.Array{data.Int(1), data.Blob{}})
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When calling it with something other than an array", func() {
+			_, err := f.Call(ctx, data.Int(1))
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When creating an aggregate state", func() {
+			So(f, ShouldImplement, (*IncrementalAggregate)(nil))
+			s, err := f.(IncrementalAggregate).NewAggregateState(ctx)
+			So(err, ShouldBeNil)
+
+			values := data.Array{}
+			add := func(v data.Value) {
+				So(s.Add(ctx, v), ShouldBeNil)
+				values = append(values, v)
+			}
+			remove := func(i int) {
+				So(s.Remove(ctx, values[i]), ShouldBeNil)
+				values = append(values[:i:i], values[i+1:]...)
+			}
+			// the expected result is computed with another UDAF so
+			// that its accumulations aren't counted
+			other := ConvertUDAF(&testDistinctUDAF{})
+			check := func() {
+				expected, err := other.Call(ctx, values)
+				So(err, ShouldBeNil)
+				actual, err := s.Result(ctx)
+				So(err, ShouldBeNil)
+				So(actual, ShouldEqual, expected)
+			}
+
+			Convey("Then it should return the same result as Call when values are removed in order", func() {
+				for i := 0; i < 10; i++ {
+					add(data.Int(i % 4))
+					check()
+				}
+				u.accumulations = 0
+				for i := 0; i < 100; i++ {
+					add(data.Int(i % 7))
+					remove(0)
+					check()
+				}
+				// each value should only be accumulated twice
+				So(u.accumulations, ShouldBeLessThanOrEqualTo, 300)
+				for len(values) > 0 {
+					remove(0)
+					check()
+				}
+			})
+
+			Convey("Then it should return the same result as Call when values are removed out of order", func() {
+				for i := 0; i < 10; i++ {
+					add(data.Int(i))
+				}
+				remove(0)
+				remove(3)
+				check()
+				add(data.Int(3))
+				remove(len(values) - 1)
+				check()
+				for len(values) > 0 {
+					remove(0)
+					check()
+				}
+			})
+
+			Convey("Then removing a value that was never added should fail", func() {
+				add(data.Int(1))
+				So(s.Remove(ctx, data.Int(2)), ShouldNotBeNil)
+			})
+		})
+	})
+
+	Convey("Given a RetractableUDAF converted to a UDF", t, func() {
+		f := ConvertUDAF(&testSumUDAF{})
+
+		Convey("When creating an aggregate state", func() {
+			s, err := f.(IncrementalAggregate).NewAggregateState(ctx)
+			So(err, ShouldBeNil)
+
+			Convey("Then it should retract values", func() {
+				So(s.Add(ctx, data.Int(1)), ShouldBeNil)
+				So(s.Add(ctx, data.Int(2)), ShouldBeNil)
+				So(s.Add(ctx, data.Int(3)), ShouldBeNil)
+				So(s.Remove(ctx, data.Int(2)), ShouldBeNil)
+				v, err := s.Result(ctx)
+				So(err, ShouldBeNil)
+				So(v, ShouldEqual, data.Int(4))
+			})
+		})
+	})
+
+	Convey("Given a ParameterizedUDAF converted to a UDF", t, func() {
+		f := ConvertParameterizedUDAF(&testScaledSumUDAF{})
+
+		Convey("Then it should be an aggregate function with two parameters", func() {
+			So(f.Accept(1), ShouldBeFalse)
+			So(f.Accept(2), ShouldBeTrue)
+			So(f.IsAggregationParameter(0), ShouldBeTrue)
+			So(f.IsAggregationParameter(1), ShouldBeFalse)
+		})
+
+		Convey("When calling it with an array and a parameter", func() {
+			v, err := f.Call(ctx, data.Array{data.Int(1), data.Int(2)}, data.Int(3))
+
+			Convey("Then it should return the result of the bound UDAF", func() {
+				So(err, ShouldBeNil)
+				So(v, ShouldEqual, data.Int(9))
+			})
+		})
+
+		Convey("When calling it with an invalid parameter", func() {
+			_, err := f.Call(ctx, data.Array{data.Int(1)}, data.String("a"))
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When creating an aggregate state", func() {
+			So(f, ShouldImplement, (*ParameterizedIncrementalAggregate)(nil))
+			s, err := f.(ParameterizedIncrementalAggregate).NewParameterizedAggregateState(ctx, data.Int(2))
+			So(err, ShouldBeNil)
+
+			Convey("Then it should add and remove values with the parameter", func() {
+				So(s.Add(ctx, data.Int(1)), ShouldBeNil)
+				So(s.Add(ctx, data.Int(2)), ShouldBeNil)
+				So(s.Add(ctx, data.Int(3)), ShouldBeNil)
+				So(s.Remove(ctx, data.Int(1)), ShouldBeNil)
+				v, err := s.Result(ctx)
+				So(err, ShouldBeNil)
+				So(v, ShouldEqual, data.Int(10))
+			})
+		})
+
+		Convey("When creating an aggregate state with a wrong number of parameters", func() {
+			_, err := f.(ParameterizedIncrementalAggregate).NewParameterizedAggregateState(ctx)
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+
+	// goconvey runs the Convey function once for each leaf
+	regErr := RegisterGlobalUDAF("test_udaf_sum", &testSumUDAF{})
+	Convey("Given a UDAF registered as a global UDF", t, func() {
+		So(regErr, ShouldBeNil)
+
+		Convey("Then it can be looked up as unary", func() {
+			f, err := CopyGlobalUDFRegistry(ctx).Lookup("test_udaf_sum", 1)
+			So(err, ShouldBeNil)
+			So(f.IsAggregationParameter(0), ShouldBeTrue)
+		})
+
+		Convey("Then it cannot be registered again", func() {
+			So(RegisterGlobalUDAF("test_udaf_sum", &testSumUDAF{}), ShouldNotBeNil)
+			So(func() {
+				MustRegisterGlobalUDAF("test_udaf_sum", &testSumUDAF{})
+			}, ShouldPanic)
+		})
+	})
+}
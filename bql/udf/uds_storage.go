@@ -50,6 +50,12 @@ type UDSStorage interface {
 	// When a tag is an empty string, "default" will be used.
 	Load(topology, state, tag string) (io.ReadCloser, error)
 
+	// Remove removes the previously saved data of the state. It returns
+	// core.NotExistError when the state doesn't exist.
+	//
+	// When a tag is an empty string, "default" will be used.
+	Remove(topology, state, tag string) error
+
 	// ListTopologies returns a list of topologies that have saved states.
 	ListTopologies() ([]string, error)
 
@@ -126,6 +132,8 @@ func (s *inMemoryUDSStorage) Load(topology, state, tag string) (io.ReadCloser, e
 	if !ok {
 		return nil, core.NotExistError(fmt.Errorf("a topology '%v' was not found", topology))
 	}
+	t.m.RLock()
+	defer t.m.RUnlock()
 	st, ok := t.states[state]
 	if !ok {
 		return nil, core.NotExistError(fmt.Errorf("a UDS '%v' was not found", state))
@@ -137,6 +145,35 @@ func (s *inMemoryUDSStorage) Load(topology, state, tag string) (io.ReadCloser, e
 	return ioutil.NopCloser(bytes.NewReader(data)), nil
 }
 
+func (s *inMemoryUDSStorage) Remove(topology, state, tag string) error {
+	if tag == "" || strings.ToLower(tag) == "default" {
+		tag = "default"
+	} else if err := core.ValidateSymbol(tag); err != nil {
+		return fmt.Errorf("tag is ill-formatted: %v", err)
+	}
+
+	s.m.RLock()
+	defer s.m.RUnlock()
+	t, ok := s.topologies[topology]
+	if !ok {
+		return core.NotExistError(fmt.Errorf("a topology '%v' was not found", topology))
+	}
+	t.m.Lock()
+	defer t.m.Unlock()
+	st, ok := t.states[state]
+	if !ok {
+		return core.NotExistError(fmt.Errorf("a UDS '%v' was not found", state))
+	}
+	if _, ok := st[tag]; !ok {
+		return core.NotExistError(fmt.Errorf("a UDS '%v' doesn't have a tag '%v'", state, tag))
+	}
+	delete(st, tag)
+	if len(st) == 0 {
+		delete(t.states, state)
+	}
+	return nil
+}
+
 func (s *inMemoryUDSStorage) ListTopologies() ([]string, error) {
 	s.m.RLock()
 	defer s.m.RUnlock()
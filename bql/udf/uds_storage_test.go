@@ -110,6 +110,20 @@ func TestInMemoryUDSStorage(t *testing.T) {
 			})
 		})
 
+		Convey("When removing the state", func() {
+			So(s.Remove("test_topology", "state1", ""), ShouldBeNil)
+
+			Convey("Then it shouldn't be able to be loaded", func() {
+				_, err := s.Load("test_topology", "state1", "")
+				So(core.IsNotExist(err), ShouldBeTrue)
+			})
+
+			Convey("Then removing it again should fail", func() {
+				err := s.Remove("test_topology", "state1", "")
+				So(core.IsNotExist(err), ShouldBeTrue)
+			})
+		})
+
 		Convey("When saving another state", func() {
 			w, err := s.Save("test_topology", "state2", "")
 			So(err, ShouldBeNil)
@@ -43,14 +43,21 @@ func NewDefaultUDSFCreatorRegistry() UDSFCreatorRegistry {
 }
 
 func (r *defaultUDSFCreatorRegistry) Register(typeName string, c UDSFCreator) error {
-	if err := core.ValidateSymbol(typeName); err != nil {
-		return fmt.Errorf("invalid name for function: %s", err.Error())
+	lowerName := strings.ToLower(typeName)
+	// some built-in UDSFs have names that are reserved
+	// words, so we need to add exceptions for them
+	switch lowerName {
+	case "unnest":
+		// skip check
+	default:
+		if err := core.ValidateSymbol(typeName); err != nil {
+			return fmt.Errorf("invalid name for function: %s", err.Error())
+		}
 	}
 
 	r.m.Lock()
 	defer r.m.Unlock()
 
-	lowerName := strings.ToLower(typeName)
 	if _, ok := r.creators[lowerName]; ok {
 		return fmt.Errorf("a UDSF type '%v' is already registered", typeName)
 	}
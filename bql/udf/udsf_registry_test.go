@@ -19,6 +19,23 @@ func TestEmptyDefaultUDSFCreatorRegistry(t *testing.T) {
 			})
 		})
 
+		Convey("When adding a creator having a reserved word as its name", func() {
+			err := r.Register("select", MustConvertToUDSFCreator(createDuplicateUDSF))
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When adding a creator named unnest", func() {
+			err := r.Register("unnest", MustConvertToUDSFCreator(createDuplicateUDSF))
+
+			Convey("Then it should succeed although unnest is a reserved word", func() {
+				So(err, ShouldBeNil)
+				So(core.ValidateSymbol("unnest"), ShouldNotBeNil)
+			})
+		})
+
 		Convey("When looking up a nonexistent creator", func() {
 			_, err := r.Lookup("duplicate", 2)
 
@@ -19,12 +19,13 @@ type Statement struct {
 // statements are not supported:
 //
 //	* SELECT (CREATE STREAM is supported)
+//	* CREATE OR REPLACE STREAM
 //	* UPDATE SOURCE/SINK/STATE
 //	* DROP SOURCE/STREAM/SINK/STATE
 //	* SAVE/LOAD STATE (they are automatically saved)
 func NewStatement(s interface{}) (*Statement, error) {
 	switch s.(type) {
-	case parser.SelectStmt, parser.SelectUnionStmt,
+	case parser.SelectStmt, parser.SelectUnionStmt, parser.CreateOrReplaceStreamAsSelectStmt,
 		parser.UpdateSourceStmt, parser.UpdateSinkStmt, parser.UpdateStateStmt,
 		parser.DropSourceStmt, parser.DropStreamStmt, parser.DropSinkStmt, parser.DropStateStmt,
 		parser.SaveStateStmt, parser.LoadStateStmt, parser.LoadStateOrCreateStmt:
@@ -101,6 +102,13 @@ func inputFromSelect(stmt *parser.SelectStmt) ([]string, error) {
 			for n := range decl.ListInputs() {
 				names = append(names, n)
 			}
+
+		case parser.SubqueryStream:
+			ns, err := inputFromSelect(rel.Subquery)
+			if err != nil {
+				return nil, err
+			}
+			names = append(names, ns...)
 		}
 	}
 	return names, nil
@@ -13,6 +13,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/Sirupsen/logrus"
 	"gopkg.in/sensorbee/sensorbee.v0/bql"
@@ -217,6 +218,9 @@ func setUpTopology(name string, logger *logrus.Logger, conf *config.Config, us u
 		return nil, fmt.Errorf("cannot create a new topology builder: %v", err)
 	}
 	tb.UDSStorage = us
+	if t, ok := conf.Topologies[name]; ok {
+		tb.CheckpointInterval = time.Duration(t.CheckpointInterval * float64(time.Second))
+	}
 
 	return tb, nil
 }
@@ -0,0 +1,199 @@
+package core
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+)
+
+// AckableSource is a Source which is notified when the tuples it emitted
+// have completely been processed by the topology. It can be used to
+// provide at-least-once delivery: the source persists the offset
+// acknowledged last (e.g. a line number of a file) and resumes from it
+// when it's created again after a crash or a restart.
+//
+// An AckableSource must set Tuple.BatchID of every tuple it emits to a
+// value (i.e. an offset) that is larger than the BatchID of any tuple it
+// emitted before. A tuple is completely processed when the tuple and all
+// tuples derived from it have been processed by Boxes without an error
+// and written to Sinks without an error. A tuple derived from the tuple
+// is a tuple which a Box created with Tuple.ShallowCopy or Tuple.Copy
+// while processing the tuple. Tuples written by a Box after its Process
+// method returned, for example, by a time-based emitter, aren't tracked.
+//
+// When any of those tuples fails, e.g., because a Sink returned an error,
+// a tuple was dropped from a full queue, or a tuple was dropped because
+// the node writing it had no destination, acknowledgements stop until
+// the source is created again so that the failed tuple is emitted again.
+// Therefore, an AckableSource should only be connected with DropNone.
+// Tuples which are still in queues when the topology stops are never
+// acknowledged, either.
+//
+// A Sink which buffers tuples and writes them to the outside world
+// asynchronously should only return from Write after it has written them
+// if it's used with an AckableSource.
+type AckableSource interface {
+	Source
+
+	// Ack is called when all tuples having a BatchID less than or equal to
+	// the given batchID have completely been processed. Ack is called in
+	// the order of BatchIDs, but it isn't necessarily called for all of
+	// them. Ack is never called concurrently.
+	Ack(ctx *Context, batchID int64) error
+}
+
+// ackTracker tracks tuples emitted by an AckableSource and calls its Ack
+// method when they've completely been processed.
+type ackTracker struct {
+	ctx      *Context
+	nodeName string
+	source   AckableSource
+
+	m sync.Mutex
+	// entries has the tuples which haven't been acknowledged yet in the
+	// order of their emission.
+	entries []*ackEntry
+	// stopped is true when any tuple has failed. Once it's true, new tuples
+	// aren't tracked anymore.
+	stopped bool
+}
+
+func newAckTracker(ctx *Context, nodeName string, s AckableSource) *ackTracker {
+	return &ackTracker{
+		ctx:      ctx,
+		nodeName: nodeName,
+		source:   s,
+	}
+}
+
+// ackWriter registers tuples written by an AckableSource to the tracker
+// before writing them to w.
+type ackWriter struct {
+	tracker *ackTracker
+	w       WriteCloser
+}
+
+func (a *ackWriter) Write(ctx *Context, t *Tuple) error {
+	e := a.tracker.register(t.BatchID)
+	if e == nil {
+		return a.w.Write(ctx, t)
+	}
+	t.ack = e
+	err := a.w.Write(ctx, t)
+	e.release(err == nil)
+	return err
+}
+
+func (a *ackWriter) Close(ctx *Context) error {
+	return a.w.Close(ctx)
+}
+
+// register creates a new entry for a tuple having the given BatchID. The
+// entry has one reference which must be released by the caller. It returns
+// nil when tuples aren't tracked anymore.
+func (a *ackTracker) register(batchID int64) *ackEntry {
+	a.m.Lock()
+	defer a.m.Unlock()
+	if a.stopped {
+		return nil
+	}
+	e := &ackEntry{
+		refs:    1,
+		tracker: a,
+		batchID: batchID,
+	}
+	a.entries = append(a.entries, e)
+	return e
+}
+
+// complete is called when all references to the entry have been released.
+func (a *ackTracker) complete(e *ackEntry) {
+	a.m.Lock()
+	defer a.m.Unlock()
+	if e.done {
+		// A tuple could be written after its entry had completed.
+		return
+	}
+	e.done = true
+
+	if atomic.LoadInt32(&e.failed) != 0 {
+		if !a.stopped {
+			a.stopped = true
+			a.ctx.Log().WithFields(nodeLogFields(NTSource, a.nodeName)).
+				WithField("batch_id", e.batchID).
+				Warn("Stopped acknowledging tuples because a tuple failed")
+		}
+		return
+	}
+
+	n := 0
+	for n < len(a.entries) && a.entries[n].done && atomic.LoadInt32(&a.entries[n].failed) == 0 {
+		n++
+	}
+	if n == 0 {
+		return
+	}
+	batchID := a.entries[n-1].batchID
+	a.entries = a.entries[n:]
+
+	err := func() (err error) {
+		defer func() {
+			if e := recover(); e != nil {
+				err = fmt.Errorf("the source couldn't acknowledge tuples due to panic: %v", e)
+			}
+		}()
+		return a.source.Ack(a.ctx, batchID)
+	}()
+	if err != nil {
+		a.ctx.ErrLog(err).WithFields(nodeLogFields(NTSource, a.nodeName)).
+			WithField("batch_id", batchID).Error("Cannot acknowledge tuples")
+	}
+}
+
+// ackEntry counts the references to a tuple emitted by an AckableSource
+// and tuples derived from it. A reference is acquired whenever one of
+// those tuples is written to a pipe and released when the receiver has
+// processed it.
+type ackEntry struct {
+	// refs is the first field of this struct for 64-bit alignment.
+	refs    int64
+	failed  int32
+	tracker *ackTracker
+	batchID int64
+
+	// done is protected by tracker.m.
+	done bool
+}
+
+// acquire acquires a reference to the entry. It must only be called by
+// a holder of another reference. It does nothing when e is nil.
+func (e *ackEntry) acquire() {
+	if e == nil {
+		return
+	}
+	atomic.AddInt64(&e.refs, 1)
+}
+
+// fail marks the entry as failed without releasing a reference. It's used
+// when a tuple is dropped before it's written to any pipe. It does nothing
+// when e is nil.
+func (e *ackEntry) fail() {
+	if e == nil {
+		return
+	}
+	atomic.StoreInt32(&e.failed, 1)
+}
+
+// release releases a reference to the entry. ok must be false when the
+// tuple couldn't be processed. It does nothing when e is nil.
+func (e *ackEntry) release(ok bool) {
+	if e == nil {
+		return
+	}
+	if !ok {
+		atomic.StoreInt32(&e.failed, 1)
+	}
+	if atomic.AddInt64(&e.refs, -1) == 0 {
+		e.tracker.complete(e)
+	}
+}
@@ -0,0 +1,207 @@
+package core
+
+import (
+	"errors"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"sync"
+	"testing"
+)
+
+// ackableEmitterSource is a TupleEmitterSource recording acknowledgements.
+type ackableEmitterSource struct {
+	*TupleEmitterSource
+	ackM sync.Mutex
+	ackC *sync.Cond
+	acks []int64
+}
+
+func newAckableEmitterSource(ts []*Tuple) *ackableEmitterSource {
+	for _, t := range ts {
+		t.BatchID, _ = data.AsInt(t.Data["seq"])
+	}
+	s := &ackableEmitterSource{
+		TupleEmitterSource: NewTupleEmitterSource(ts),
+	}
+	s.ackC = sync.NewCond(&s.ackM)
+	return s
+}
+
+func (s *ackableEmitterSource) Ack(ctx *Context, batchID int64) error {
+	s.ackM.Lock()
+	defer s.ackM.Unlock()
+	s.acks = append(s.acks, batchID)
+	s.ackC.Broadcast()
+	return nil
+}
+
+// waitForAck waits until the source is acknowledged up to the given BatchID.
+func (s *ackableEmitterSource) waitForAck(batchID int64) {
+	s.ackM.Lock()
+	defer s.ackM.Unlock()
+	for len(s.acks) == 0 || s.acks[len(s.acks)-1] < batchID {
+		s.ackC.Wait()
+	}
+}
+
+func (s *ackableEmitterSource) lastAck() int64 {
+	s.ackM.Lock()
+	defer s.ackM.Unlock()
+	if len(s.acks) == 0 {
+		return 0
+	}
+	return s.acks[len(s.acks)-1]
+}
+
+// failingSink returns an error for a tuple having the given seq.
+type failingSink struct {
+	*TupleCollectorSink
+	failAt int64
+}
+
+func (s *failingSink) Write(ctx *Context, t *Tuple) error {
+	if seq, _ := data.AsInt(t.Data["seq"]); seq == s.failAt {
+		return errors.New("failure")
+	}
+	return s.TupleCollectorSink.Write(ctx, t)
+}
+
+func TestAckableSource(t *testing.T) {
+	Convey("Given a topology with an ackable source", t, func() {
+		/*
+		 *   so -*--> b -*--> si1
+		 *                \-> si2
+		 */
+		dt, err := NewDefaultTopology(NewContext(nil), "dt1")
+		So(err, ShouldBeNil)
+		Reset(func() {
+			dt.Stop()
+		})
+
+		so := newAckableEmitterSource(freshTuples())
+		_, err = dt.AddSource("source", so, &SourceConfig{
+			PausedOnStartup: true,
+		})
+		So(err, ShouldBeNil)
+
+		bn, err := dt.AddBox("box", BoxFunc(forwardBox), nil)
+		So(err, ShouldBeNil)
+		So(bn.Input("source", nil), ShouldBeNil)
+
+		si1 := NewTupleCollectorSink()
+		sin1, err := dt.AddSink("sink1", si1, nil)
+		So(err, ShouldBeNil)
+		So(sin1.Input("box", nil), ShouldBeNil)
+
+		Convey("When all tuples are written to the sinks", func() {
+			si2 := NewTupleCollectorSink()
+			sin2, err := dt.AddSink("sink2", si2, nil)
+			So(err, ShouldBeNil)
+			So(sin2.Input("box", nil), ShouldBeNil)
+
+			son, err := dt.Source("source")
+			So(err, ShouldBeNil)
+			So(son.Resume(), ShouldBeNil)
+			si1.Wait(8)
+			si2.Wait(8)
+
+			Convey("Then the source should be acknowledged up to the last tuple", func() {
+				so.waitForAck(8)
+				So(so.lastAck(), ShouldEqual, 8)
+			})
+
+			Convey("Then acknowledgements should be in order", func() {
+				so.waitForAck(8)
+				so.ackM.Lock()
+				defer so.ackM.Unlock()
+				for i := 1; i < len(so.acks); i++ {
+					So(so.acks[i], ShouldBeGreaterThan, so.acks[i-1])
+				}
+			})
+		})
+
+		Convey("When a sink fails to write a tuple", func() {
+			si2 := &failingSink{NewTupleCollectorSink(), 3}
+			sin2, err := dt.AddSink("sink2", si2, nil)
+			So(err, ShouldBeNil)
+			So(sin2.Input("box", nil), ShouldBeNil)
+
+			son, err := dt.Source("source")
+			So(err, ShouldBeNil)
+			So(son.Resume(), ShouldBeNil)
+			si1.Wait(8)
+			si2.Wait(7)
+
+			Convey("Then the source shouldn't be acknowledged beyond the failed tuple", func() {
+				so.waitForAck(2)
+				So(dt.Stop(), ShouldBeNil)
+				So(so.lastAck(), ShouldEqual, 2)
+			})
+		})
+
+		Convey("When a box fails to process a tuple", func() {
+			fail := true
+			b := &stubForwardBox{proc: func() error {
+				if fail {
+					fail = false
+					return errors.New("failure")
+				}
+				return nil
+			}}
+			bn2, err := dt.AddBox("box2", b, nil)
+			So(err, ShouldBeNil)
+			So(bn2.Input("source", nil), ShouldBeNil)
+
+			son, err := dt.Source("source")
+			So(err, ShouldBeNil)
+			So(son.Resume(), ShouldBeNil)
+			si1.Wait(8)
+
+			Convey("Then the source shouldn't be acknowledged at all", func() {
+				So(dt.Stop(), ShouldBeNil)
+				So(so.lastAck(), ShouldEqual, 0)
+			})
+		})
+
+		Convey("When a box has no destination", func() {
+			bn2, err := dt.AddBox("box2", BoxFunc(forwardBox), nil)
+			So(err, ShouldBeNil)
+			So(bn2.Input("source", nil), ShouldBeNil)
+
+			son, err := dt.Source("source")
+			So(err, ShouldBeNil)
+			So(son.Resume(), ShouldBeNil)
+			si1.Wait(8)
+
+			Convey("Then the source shouldn't be acknowledged at all", func() {
+				So(dt.Stop(), ShouldBeNil)
+				So(so.lastAck(), ShouldEqual, 0)
+			})
+		})
+	})
+
+	Convey("Given an ackable source", t, func() {
+		so := newAckableEmitterSource(freshTuples())
+
+		Convey("When wrapping it with NewRewindableSource", func() {
+			s := NewRewindableSource(so)
+
+			Convey("Then it should still be ackable", func() {
+				So(s, ShouldImplement, (*AckableSource)(nil))
+				So(s.(AckableSource).Ack(nil, 5), ShouldBeNil)
+				So(so.lastAck(), ShouldEqual, 5)
+			})
+		})
+
+		Convey("When wrapping it with ImplementSourceStop", func() {
+			s := ImplementSourceStop(so)
+
+			Convey("Then it should still be ackable", func() {
+				So(s, ShouldImplement, (*AckableSource)(nil))
+				So(s, ShouldNotImplement, (*RewindableSource)(nil))
+				So(s.(AckableSource).Ack(nil, 5), ShouldBeNil)
+				So(so.lastAck(), ShouldEqual, 5)
+			})
+		})
+	})
+}
@@ -3,6 +3,7 @@ package core
 import (
 	"fmt"
 	"strings"
+	"sync"
 )
 
 // A Box is an elementary building block of a SensorBee topology.
@@ -162,9 +163,17 @@ func checkBoxInputName(b Box, boxName string, inputName string) error {
 // boxWriterAdapter provides a Writer interface which writes tuples to a Box.
 // It also records traces input and output tuples.
 type boxWriterAdapter struct {
+	// m protects box and inputNames from being replaced while it's
+	// processing a tuple.
+	m    sync.RWMutex
 	box  Box
 	name string
 	dst  *traceWriter
+
+	// inputNames has the input names of the current Box after it replaced
+	// another Box. Tuples having other input names were sent to the previous
+	// Box and are dropped. It's nil when the Box hasn't been replaced.
+	inputNames map[string]struct{}
 }
 
 func newBoxWriterAdapter(b Box, name string, dst WriteCloser) *boxWriterAdapter {
@@ -178,5 +187,24 @@ func newBoxWriterAdapter(b Box, name string, dst WriteCloser) *boxWriterAdapter
 
 func (wa *boxWriterAdapter) Write(ctx *Context, t *Tuple) error {
 	tracing(t, ctx, ETInput, wa.name)
+	wa.m.RLock()
+	defer wa.m.RUnlock()
+	if wa.inputNames != nil {
+		if _, ok := wa.inputNames[t.InputName]; !ok {
+			ctx.droppedTuple(t, NTBox, wa.name, ETInput,
+				fmt.Errorf("the tuple having input name '%v' was sent to the replaced box", t.InputName))
+			return nil
+		}
+	}
 	return wa.box.Process(ctx, t, wa.dst)
 }
+
+// addInputName adds an input name of the current Box when it has replaced
+// another Box.
+func (wa *boxWriterAdapter) addInputName(name string) {
+	wa.m.Lock()
+	defer wa.m.Unlock()
+	if wa.inputNames != nil {
+		wa.inputNames[name] = struct{}{}
+	}
+}
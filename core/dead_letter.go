@@ -0,0 +1,156 @@
+package core
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// ErrorPolicy controls how a Box or a Sink handles a tuple which it failed to
+// process or write.
+type ErrorPolicy struct {
+	// MaxRetries is the maximum number of retries of a tuple which failed
+	// with a temporary error (i.e. IsTemporaryError(err) == true). The tuple
+	// isn't retried when it's 0. Tuples failed with other errors are never
+	// retried.
+	MaxRetries int
+
+	// RetryInterval is the interval before the first retry. The interval is
+	// doubled on every retry up to MaxRetryInterval. When it's 0, retries are
+	// done without an interval.
+	RetryInterval time.Duration
+
+	// MaxRetryInterval is the upper bound of the interval between retries.
+	// The interval isn't bounded when it's 0.
+	MaxRetryInterval time.Duration
+
+	// DeadLetter receives tuples which failed even after retries instead of
+	// dropping them. A tuple written to DeadLetter has the following fields
+	// in Data:
+	//
+	//	- node_type: the type of the node which failed
+	//	- node_name: the name of the node which failed
+	//	- error: the error returned last
+	//	- retry_count: the number of retries done before giving up
+	//	- timestamp: the original timestamp of the tuple
+	//	- proc_timestamp: the original processing timestamp of the tuple
+	//	- data: the original content of the tuple
+	//
+	// When DeadLetter returns an error, the tuple is dropped and reported as
+	// a dropped tuple. Tuples which have already been written to a dead
+	// letter queue once are dropped when they fail again to avoid infinite
+	// loops. A node shouldn't receive tuples from its own dead letter queue
+	// because it could result in a deadlock when its input queue is full.
+	DeadLetter Writer
+}
+
+// retryInterval returns the interval before the given number of retry.
+// retryCount starts from 1.
+func (p *ErrorPolicy) retryInterval(retryCount int) time.Duration {
+	d := p.RetryInterval
+	for i := 1; i < retryCount && d > 0; i++ {
+		d *= 2
+		if p.MaxRetryInterval > 0 && d >= p.MaxRetryInterval {
+			break
+		}
+	}
+	if p.MaxRetryInterval > 0 && d > p.MaxRetryInterval {
+		d = p.MaxRetryInterval
+	}
+	return d
+}
+
+// writeDeadLetter writes a tuple failed with the error to DeadLetter. It
+// returns false when the tuple couldn't be written.
+func (p *ErrorPolicy) writeDeadLetter(ctx *Context, t *Tuple, nodeType NodeType, nodeName string,
+	retryCount int, err error) bool {
+	if p == nil || p.DeadLetter == nil || t.Flags.IsSet(TFDeadLetter) {
+		return false
+	}
+
+	dt := t.ShallowCopy()
+	dt.Data = data.Map{
+		"node_type":      data.String(nodeType.String()),
+		"node_name":      data.String(nodeName),
+		"error":          data.String(err.Error()),
+		"retry_count":    data.Int(retryCount),
+		"timestamp":      data.Timestamp(t.Timestamp),
+		"proc_timestamp": data.Timestamp(t.ProcTimestamp),
+		"data":           t.Data,
+	}
+	dt.Flags.Set(TFDeadLetter)
+	if err := p.DeadLetter.Write(ctx, dt); err != nil {
+		ctx.ErrLog(err).WithFields(nodeLogFields(nodeType, nodeName)).
+			Error("Cannot write a tuple to the dead letter queue")
+		return false
+	}
+	return true
+}
+
+// DeadLetterSource is a Source which emits tuples written to it. It's
+// supposed to be used as ErrorPolicy.DeadLetter of Boxes and Sinks so that
+// tuples they failed to process can be processed by other nodes.
+type DeadLetterSource struct {
+	w     Writer
+	m     sync.RWMutex
+	state *topologyStateHolder
+}
+
+var (
+	_ Source = &DeadLetterSource{}
+	_ Writer = &DeadLetterSource{}
+)
+
+// NewDeadLetterSource returns a new DeadLetterSource.
+func NewDeadLetterSource() *DeadLetterSource {
+	s := &DeadLetterSource{}
+	s.state = newTopologyStateHolder(&s.m)
+	return s
+}
+
+// GenerateStream emits tuples written to the source until it's stopped.
+func (s *DeadLetterSource) GenerateStream(ctx *Context, w Writer) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if s.state.getWithoutLock() >= TSStopping {
+		return errors.New("the source is already stopped")
+	}
+	s.w = w
+	s.state.setWithoutLock(TSRunning)
+	defer s.state.setWithoutLock(TSStopped)
+	s.state.waitWithoutLock(TSStopping)
+	return nil
+}
+
+// Write emits the tuple from the source. It returns an error when the source
+// isn't running.
+func (s *DeadLetterSource) Write(ctx *Context, t *Tuple) error {
+	s.m.RLock()
+	defer s.m.RUnlock()
+	if s.state.getWithoutLock() != TSRunning {
+		return errors.New("the dead letter queue isn't running")
+	}
+	return s.w.Write(ctx, t)
+}
+
+// Stop stops the source.
+func (s *DeadLetterSource) Stop(ctx *Context) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+	switch s.state.getWithoutLock() {
+	case TSStopping:
+		s.state.waitWithoutLock(TSStopped)
+		return nil
+	case TSStopped:
+		return nil
+	case TSInitialized:
+		// GenerateStream hasn't been called yet.
+		s.state.setWithoutLock(TSStopped)
+		return nil
+	}
+	s.state.setWithoutLock(TSStopping)
+	s.state.waitWithoutLock(TSStopped)
+	return nil
+}
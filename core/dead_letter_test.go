@@ -0,0 +1,262 @@
+package core
+
+import (
+	"errors"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"sync"
+	"testing"
+	"time"
+)
+
+// flakySink fails to write each tuple for the given number of times.
+type flakySink struct {
+	*TupleCollectorSink
+	failures  int
+	temporary bool
+
+	m     sync.Mutex
+	count map[int64]int
+}
+
+func (s *flakySink) Write(ctx *Context, t *Tuple) error {
+	seq, _ := data.AsInt(t.Data["seq"])
+	s.m.Lock()
+	n := s.count[seq]
+	s.count[seq]++
+	s.m.Unlock()
+	if n < s.failures {
+		err := errors.New("failure")
+		if s.temporary {
+			return TemporaryError(err)
+		}
+		return err
+	}
+	return s.TupleCollectorSink.Write(ctx, t)
+}
+
+func TestErrorPolicy(t *testing.T) {
+	Convey("Given a topology with a dead letter queue", t, func() {
+		/*
+		 *   so -*--> si
+		 *   dlq -*--> dsi
+		 */
+		dt, err := NewDefaultTopology(NewContext(nil), "dt1")
+		So(err, ShouldBeNil)
+		Reset(func() {
+			dt.Stop()
+		})
+
+		dlq := NewDeadLetterSource()
+		_, err = dt.AddSource("dlq", dlq, nil)
+		So(err, ShouldBeNil)
+
+		dsi := NewTupleCollectorSink()
+		dsin, err := dt.AddSink("dead_letters", dsi, nil)
+		So(err, ShouldBeNil)
+		So(dsin.Input("dlq", nil), ShouldBeNil)
+
+		so := NewTupleEmitterSource(freshTuples())
+		_, err = dt.AddSource("source", so, &SourceConfig{
+			PausedOnStartup: true,
+		})
+		So(err, ShouldBeNil)
+
+		addSink := func(si Sink, p *ErrorPolicy) {
+			sin, err := dt.AddSink("sink", si, &SinkConfig{
+				ErrorPolicy: p,
+			})
+			So(err, ShouldBeNil)
+			So(sin.Input("source", nil), ShouldBeNil)
+
+			son, err := dt.Source("source")
+			So(err, ShouldBeNil)
+			So(son.Resume(), ShouldBeNil)
+		}
+
+		Convey("When a sink temporarily fails less than the max retries", func() {
+			si := &flakySink{
+				TupleCollectorSink: NewTupleCollectorSink(),
+				failures:           2,
+				temporary:          true,
+				count:              map[int64]int{},
+			}
+			addSink(si, &ErrorPolicy{
+				MaxRetries:    2,
+				RetryInterval: time.Millisecond,
+				DeadLetter:    dlq,
+			})
+
+			Convey("Then the sink should write all tuples", func() {
+				si.Wait(8)
+				So(dt.Stop(), ShouldBeNil)
+				So(si.len(), ShouldEqual, 8)
+				So(dsi.len(), ShouldEqual, 0)
+			})
+		})
+
+		Convey("When a sink temporarily fails more than the max retries", func() {
+			si := &flakySink{
+				TupleCollectorSink: NewTupleCollectorSink(),
+				failures:           3,
+				temporary:          true,
+				count:              map[int64]int{},
+			}
+			addSink(si, &ErrorPolicy{
+				MaxRetries: 2,
+				DeadLetter: dlq,
+			})
+
+			Convey("Then all tuples should be written to the dead letter queue", func() {
+				dsi.Wait(8)
+				So(si.len(), ShouldEqual, 0)
+
+				t := dsi.get(0)
+				So(t.Flags.IsSet(TFDeadLetter), ShouldBeTrue)
+				So(t.Data["node_type"], ShouldEqual, data.String("sink"))
+				So(t.Data["node_name"], ShouldEqual, data.String("sink"))
+				So(t.Data["error"], ShouldEqual, data.String("failure"))
+				So(t.Data["retry_count"], ShouldEqual, data.Int(2))
+				So(t.Data["data"], ShouldResemble, data.Map{"seq": data.Int(1)})
+				So(t.Data, ShouldContainKey, "timestamp")
+				So(t.Data, ShouldContainKey, "proc_timestamp")
+			})
+		})
+
+		Convey("When a sink fails with a permanent error", func() {
+			si := &flakySink{
+				TupleCollectorSink: NewTupleCollectorSink(),
+				failures:           1,
+				count:              map[int64]int{},
+			}
+			addSink(si, &ErrorPolicy{
+				MaxRetries: 2,
+				DeadLetter: dlq,
+			})
+
+			Convey("Then tuples should be written to the dead letter queue without retries", func() {
+				dsi.Wait(8)
+				So(si.len(), ShouldEqual, 0)
+				So(dsi.get(0).Data["retry_count"], ShouldEqual, data.Int(0))
+			})
+		})
+
+		Convey("When a sink fails without a dead letter queue", func() {
+			si := &flakySink{
+				TupleCollectorSink: NewTupleCollectorSink(),
+				failures:           1,
+				temporary:          true,
+				count:              map[int64]int{},
+			}
+			addSink(si, &ErrorPolicy{
+				MaxRetries: 1,
+			})
+
+			Convey("Then the sink should write all tuples after a retry", func() {
+				si.Wait(8)
+				So(dt.Stop(), ShouldBeNil)
+				So(si.len(), ShouldEqual, 8)
+				So(dsi.len(), ShouldEqual, 0)
+			})
+		})
+
+		Convey("When a sink is stopped while waiting to retry a tuple", func() {
+			si := &flakySink{
+				TupleCollectorSink: NewTupleCollectorSink(),
+				failures:           100,
+				temporary:          true,
+				count:              map[int64]int{},
+			}
+			addSink(si, &ErrorPolicy{
+				MaxRetries:    2,
+				RetryInterval: time.Hour,
+				DeadLetter:    dlq,
+			})
+			for {
+				si.m.Lock()
+				n := len(si.count)
+				si.m.Unlock()
+				if n > 0 {
+					break
+				}
+				time.Sleep(time.Millisecond)
+			}
+			sin, err := dt.Sink("sink")
+			So(err, ShouldBeNil)
+			ch := make(chan error, 1)
+			go func() {
+				ch <- sin.Stop()
+			}()
+
+			Convey("Then the sink should stop without waiting for the retry interval", func() {
+				select {
+				case err := <-ch:
+					So(err, ShouldBeNil)
+				case <-time.After(5 * time.Second):
+					So("the sink didn't stop", ShouldBeNil)
+				}
+				dsi.Wait(1)
+				So(dsi.get(0).Data["retry_count"], ShouldEqual, data.Int(0))
+			})
+		})
+
+		Convey("When a box fails to process tuples", func() {
+			bn, err := dt.AddBox("box", &stubForwardBox{proc: func() error {
+				return errors.New("failure")
+			}}, &BoxConfig{
+				ErrorPolicy: &ErrorPolicy{
+					DeadLetter: dlq,
+				},
+			})
+			So(err, ShouldBeNil)
+			So(bn.Input("source", nil), ShouldBeNil)
+
+			son, err := dt.Source("source")
+			So(err, ShouldBeNil)
+			So(son.Resume(), ShouldBeNil)
+
+			Convey("Then tuples should be written to the dead letter queue", func() {
+				dsi.Wait(8)
+				So(dsi.get(0).Data["node_type"], ShouldEqual, data.String("box"))
+				So(dsi.get(0).Data["node_name"], ShouldEqual, data.String("box"))
+			})
+		})
+	})
+
+	Convey("Given an error policy", t, func() {
+		p := &ErrorPolicy{
+			RetryInterval:    time.Second,
+			MaxRetryInterval: 5 * time.Second,
+		}
+
+		Convey("When computing retry intervals", func() {
+			Convey("Then they should exponentially increase up to the max", func() {
+				So(p.retryInterval(1), ShouldEqual, time.Second)
+				So(p.retryInterval(2), ShouldEqual, 2*time.Second)
+				So(p.retryInterval(3), ShouldEqual, 4*time.Second)
+				So(p.retryInterval(4), ShouldEqual, 5*time.Second)
+				So(p.retryInterval(100), ShouldEqual, 5*time.Second)
+			})
+		})
+
+		Convey("When the max interval isn't given", func() {
+			p.MaxRetryInterval = 0
+
+			Convey("Then the interval shouldn't be bounded", func() {
+				So(p.retryInterval(5), ShouldEqual, 16*time.Second)
+			})
+		})
+
+		Convey("When writing a tuple which was already written to a dead letter queue", func() {
+			si := NewTupleCollectorSink()
+			p.DeadLetter = si
+			tu := NewTuple(data.Map{})
+			tu.Flags.Set(TFDeadLetter)
+
+			Convey("Then it should be dropped", func() {
+				So(p.writeDeadLetter(NewContext(nil), tu, NTSink, "sink", 0, errors.New("failure")), ShouldBeFalse)
+				So(si.len(), ShouldEqual, 0)
+			})
+		})
+	})
+}
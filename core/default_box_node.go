@@ -3,6 +3,7 @@ package core
 import (
 	"fmt"
 	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"sync"
 )
 
 type defaultBoxNode struct {
@@ -10,11 +11,37 @@ type defaultBoxNode struct {
 	config *BoxConfig
 	srcs   *dataSources
 	box    Box
+	writer *boxWriterAdapter
 	dsts   *dataDestinations
 
 	gracefulStopEnabled bool
 	stopOnDisconnectDir ConnDir
 	runErr              error
+
+	// replaceMutex prevents the box from being replaced concurrently.
+	replaceMutex sync.Mutex
+
+	// replacement is not nil while the box is being replaced. It's protected
+	// by stateMutex.
+	replacement *boxReplacement
+}
+
+// boxReplacement has inputs of a new Box which will replace the current Box
+// of a box node.
+type boxReplacement struct {
+	box Box
+
+	// errorPolicy replaces the error policy of the node when it isn't nil.
+	errorPolicy *ErrorPolicy
+
+	// pending has receivers of nodes newly connected to the box node. They
+	// aren't added to the data sources of the node until the replacement
+	// is committed so that the current Box doesn't receive their tuples.
+	pending map[string]*pipeReceiver
+
+	// reused has configurations of inputs from nodes which are already
+	// connected to the box node.
+	reused map[string]*BoxInputConfig
 }
 
 func (db *defaultBoxNode) Type() NodeType {
@@ -22,6 +49,8 @@ func (db *defaultBoxNode) Type() NodeType {
 }
 
 func (db *defaultBoxNode) Box() Box {
+	db.stateMutex.Lock()
+	defer db.stateMutex.Unlock()
 	return db.box
 }
 
@@ -38,9 +67,17 @@ func (db *defaultBoxNode) Input(refname string, config *BoxInputConfig) error {
 		return err
 	}
 
-	if err := checkBoxInputName(db.box, db.name, config.inputName()); err != nil {
+	db.stateMutex.Lock()
+	b, r := db.box, db.replacement
+	db.stateMutex.Unlock()
+	if r != nil {
+		return db.inputReplacement(r, s, config)
+	}
+
+	if err := checkBoxInputName(b, db.name, config.inputName()); err != nil {
 		return err
 	}
+	db.writer.addInputName(config.inputName())
 
 	recv, send := newPipe(config.inputName(), config.capacity())
 	send.dropMode = config.DropMode
@@ -54,6 +91,188 @@ func (db *defaultBoxNode) Input(refname string, config *BoxInputConfig) error {
 	return nil
 }
 
+// inputReplacement adds an input of the new Box while the box is being
+// replaced. Inputs from nodes already connected to the box node are only
+// recorded and applied when the replacement is committed.
+func (db *defaultBoxNode) inputReplacement(r *boxReplacement, s dataSource, config *BoxInputConfig) error {
+	if err := checkBoxInputName(r.box, db.name, config.inputName()); err != nil {
+		return err
+	}
+
+	name := s.Name()
+	db.stateMutex.Lock()
+	_, reused := r.reused[name]
+	db.stateMutex.Unlock()
+	if reused {
+		return fmt.Errorf("node '%v' is already receiving tuples from '%v'", db.name, name)
+	}
+
+	if _, ok := db.srcs.receivers()[name]; ok {
+		db.stateMutex.Lock()
+		r.reused[name] = config
+		db.stateMutex.Unlock()
+		return nil
+	}
+
+	// The destinations of s reject the pipe when it's already pending.
+	recv, send := newPipe(config.inputName(), config.capacity())
+	send.dropMode = config.DropMode
+	if err := s.destinations().add(db.name, send); err != nil {
+		return err
+	}
+	db.stateMutex.Lock()
+	r.pending[name] = recv
+	db.stateMutex.Unlock()
+	return nil
+}
+
+// replaceBox replaces the Box of the node with b. See Topology.ReplaceBox
+// for details.
+func (db *defaultBoxNode) replaceBox(b Box, config *BoxReplacementConfig, setUp func(BoxNode) error) error {
+	db.replaceMutex.Lock()
+	defer db.replaceMutex.Unlock()
+	if db.state.Get() >= TSStopping {
+		return fmt.Errorf("the box '%v' is already stopped", db.name)
+	}
+
+	if err := initBox(db.topology.ctx, b); err != nil {
+		return err
+	}
+
+	r := &boxReplacement{
+		box:         b,
+		errorPolicy: config.ErrorPolicy,
+		pending:     map[string]*pipeReceiver{},
+		reused:      map[string]*BoxInputConfig{},
+	}
+	db.stateMutex.Lock()
+	db.replacement = r
+	db.stateMutex.Unlock()
+
+	committed := false
+	defer func() {
+		db.stateMutex.Lock()
+		db.replacement = nil
+		db.stateMutex.Unlock()
+		if committed {
+			return
+		}
+
+		for _, recv := range r.pending {
+			// Nobody reads tuples from pending receivers, so they have to be
+			// drained before closing them. Otherwise, senders might block.
+			go func(recv *pipeReceiver) {
+				for _ = range recv.in {
+				}
+			}(recv)
+			recv.close()
+		}
+		db.terminateBox(b)
+	}()
+
+	if err := setUp(db); err != nil {
+		return err
+	}
+
+	old, err := db.commitReplacement(r)
+	if err != nil {
+		return err
+	}
+	committed = true
+	db.terminateBox(old)
+
+	// Reconfigure inputs after the new Box starts to process tuples because
+	// sources writing tuples to the node might block while the Box is being
+	// swapped.
+	for name, recv := range db.srcs.receivers() {
+		if _, ok := r.pending[name]; ok {
+			continue
+		}
+		config, reused := r.reused[name]
+		if reused && recv.sender.hasConfig(config) {
+			continue
+		}
+
+		s, err := db.topology.dataSource(name)
+		if err != nil {
+			db.srcs.remove(name)
+			continue
+		}
+		if !reused {
+			s.destinations().remove(db.name)
+			db.srcs.remove(name)
+			continue
+		}
+
+		newRecv, send := newPipe(config.inputName(), config.capacity())
+		send.dropMode = config.DropMode
+		if err := s.destinations().replace(db.name, send); err != nil {
+			db.srcs.remove(name)
+			continue
+		}
+		db.srcs.remove(name)
+		if err := db.srcs.add(name, newRecv); err != nil {
+			s.destinations().remove(db.name)
+		}
+	}
+	return nil
+}
+
+// commitReplacement swaps the Box and the error policy of the node after
+// connecting pending inputs. It returns the previous Box.
+//
+// Inputs which aren't reused by the new Box are disconnected after the swap,
+// and tuples already queued in their pipes are still written to the node.
+// Therefore, the writer only accepts input names of the new Box from then on.
+func (db *defaultBoxNode) commitReplacement(r *boxReplacement) (Box, error) {
+	// Acquiring the lock of the writer waits for the current Box to finish
+	// processing a tuple and stops it from processing a new one.
+	db.writer.m.Lock()
+	defer db.writer.m.Unlock()
+
+	names := map[string]struct{}{}
+	for name, recv := range r.pending {
+		if err := db.srcs.add(name, recv); err != nil {
+			return nil, err
+		}
+		names[recv.sender.inputName] = struct{}{}
+	}
+	db.stateMutex.Lock()
+	for _, config := range r.reused {
+		names[config.inputName()] = struct{}{}
+	}
+	old := db.box
+	db.box = r.box
+	if r.errorPolicy != nil {
+		db.config.ErrorPolicy = r.errorPolicy
+		db.srcs.setErrorPolicy(r.errorPolicy)
+	}
+	db.stateMutex.Unlock()
+	db.writer.box = r.box
+	db.writer.inputNames = names
+	return old, nil
+}
+
+// terminateBox terminates a Box which has been replaced or failed to replace
+// the current Box.
+func (db *defaultBoxNode) terminateBox(b Box) {
+	sb, ok := b.(StatefulBox)
+	if !ok {
+		return
+	}
+
+	defer func() {
+		if e := recover(); e != nil {
+			db.topology.ctx.ErrLog(fmt.Errorf("%v", e)).WithFields(nodeLogFields(NTBox, db.name)).
+				Error("Cannot terminate the replaced box due to panic")
+		}
+	}()
+	if err := sb.Terminate(db.topology.ctx); err != nil {
+		db.topology.ctx.ErrLog(err).WithFields(nodeLogFields(NTBox, db.name)).
+			Error("Cannot terminate the replaced box")
+	}
+}
+
 func (db *defaultBoxNode) run() (runErr error) {
 	if err := db.checkAndPrepareForRunning("box"); err != nil {
 		return err
@@ -73,7 +292,7 @@ func (db *defaultBoxNode) run() (runErr error) {
 			db.dsts.Close(db.topology.ctx)
 			db.state.Set(TSStopped)
 		}()
-		if sb, ok := db.box.(StatefulBox); ok {
+		if sb, ok := db.Box().(StatefulBox); ok {
 			if err := sb.Terminate(db.topology.ctx); err != nil {
 				if db.runErr == nil {
 					db.runErr = err
@@ -85,8 +304,7 @@ func (db *defaultBoxNode) run() (runErr error) {
 		}
 	}()
 	db.state.Set(TSRunning)
-	w := newBoxWriterAdapter(db.box, db.name, db.dsts)
-	db.runErr = db.srcs.pour(db.topology.ctx, w, 1) // TODO: make parallelism configurable
+	db.runErr = db.srcs.pour(db.topology.ctx, db.writer, 1) // TODO: make parallelism configurable
 	return
 }
 
@@ -134,6 +352,7 @@ func (db *defaultBoxNode) Status() data.Map {
 	gstop := db.gracefulStopEnabled
 	connDir := db.stopOnDisconnectDir
 	removeOnStop := db.config.RemoveOnStop
+	b := db.box
 	db.stateMutex.Unlock()
 
 	m := data.Map{
@@ -150,7 +369,7 @@ func (db *defaultBoxNode) Status() data.Map {
 	if st == TSStopped && db.runErr != nil {
 		m["error"] = data.String(db.runErr.Error())
 	}
-	if b, ok := db.box.(Statuser); ok {
+	if b, ok := b.(Statuser); ok {
 		m["box"] = b.Status()
 	}
 	return m
@@ -56,7 +56,14 @@ func (ds *defaultSourceNode) run() (runErr error) {
 		return
 	}
 
-	ds.runErr = ds.source.GenerateStream(ds.topology.ctx, newTraceWriter(ds.dsts, ETOutput, ds.name))
+	var w WriteCloser = ds.dsts
+	if s, ok := ds.source.(AckableSource); ok {
+		w = &ackWriter{
+			tracker: newAckTracker(ds.topology.ctx, ds.name, s),
+			w:       w,
+		}
+	}
+	ds.runErr = ds.source.GenerateStream(ds.topology.ctx, newTraceWriter(w, ETOutput, ds.name))
 	return
 }
 
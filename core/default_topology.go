@@ -160,22 +160,8 @@ func (t *defaultTopology) AddBox(name string, b Box, config *BoxConfig) (BoxNode
 		return nil, err
 	}
 
-	if sb, ok := b.(StatefulBox); ok {
-		err := func() (err error) {
-			defer func() {
-				if e := recover(); e != nil {
-					if er, ok := e.(error); ok {
-						err = er
-					} else {
-						err = fmt.Errorf("the box cannot be initialized due to panic: %v", e)
-					}
-				}
-			}()
-			return sb.Init(t.ctx)
-		}()
-		if err != nil {
-			return nil, err
-		}
+	if err := initBox(t.ctx, b); err != nil {
+		return nil, err
 	}
 
 	db := &defaultBoxNode{
@@ -186,7 +172,9 @@ func (t *defaultTopology) AddBox(name string, b Box, config *BoxConfig) (BoxNode
 	}
 	db.config = &BoxConfig{}
 	*db.config = *config
+	db.srcs.errorPolicy = config.ErrorPolicy
 	db.dsts.callback = db.dstCallback
+	db.writer = newBoxWriterAdapter(b, name, db.dsts)
 	t.boxes[strings.ToLower(name)] = db
 
 	go func() {
@@ -211,6 +199,46 @@ func (t *defaultTopology) AddBox(name string, b Box, config *BoxConfig) (BoxNode
 	return db, nil
 }
 
+// initBox initializes the Box if it's a StatefulBox.
+func initBox(ctx *Context, b Box) (err error) {
+	sb, ok := b.(StatefulBox)
+	if !ok {
+		return nil
+	}
+
+	defer func() {
+		if e := recover(); e != nil {
+			if er, ok := e.(error); ok {
+				err = er
+			} else {
+				err = fmt.Errorf("the box cannot be initialized due to panic: %v", e)
+			}
+		}
+	}()
+	return sb.Init(ctx)
+}
+
+func (t *defaultTopology) ReplaceBox(name string, b Box, config *BoxReplacementConfig, setUp func(BoxNode) error) (BoxNode, error) {
+	if t.state.Get() >= TSStopping {
+		return nil, fmt.Errorf("the topology is already stopped")
+	}
+
+	t.nodeMutex.RLock()
+	db, ok := t.boxes[strings.ToLower(name)]
+	t.nodeMutex.RUnlock()
+	if !ok {
+		return nil, NotExistError(fmt.Errorf("box '%v' was not found", name))
+	}
+
+	if config == nil {
+		config = &BoxReplacementConfig{}
+	}
+	if err := db.replaceBox(b, config, setUp); err != nil {
+		return nil, err
+	}
+	return db, nil
+}
+
 func (t *defaultTopology) AddSink(name string, s Sink, config *SinkConfig) (SinkNode, error) {
 	// Sink must be closed when AddSink fails before creating a sink node
 	closeSinkFlag := false
@@ -258,6 +286,7 @@ func (t *defaultTopology) AddSink(name string, s Sink, config *SinkConfig) (Sink
 	}
 	ds.config = &SinkConfig{}
 	*ds.config = *config
+	ds.srcs.errorPolicy = config.ErrorPolicy
 	t.sinks[strings.ToLower(name)] = ds
 
 	go func() {
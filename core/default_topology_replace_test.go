@@ -0,0 +1,243 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"testing"
+)
+
+// markBox writes input tuples with a "box" field having the given name.
+func markBox(name string) Box {
+	return BoxFunc(func(ctx *Context, t *Tuple, w Writer) error {
+		out := t.Copy()
+		out.Data["box"] = data.String(name)
+		return w.Write(ctx, out)
+	})
+}
+
+func TestDefaultTopologyReplaceBox(t *testing.T) {
+	Convey("Given a topology having two sources", t, func() {
+		/*
+		 *   so1 -*--> b -*--> si
+		 *   so2
+		 */
+		dt, err := NewDefaultTopology(NewContext(nil), "dt1")
+		So(err, ShouldBeNil)
+		t := dt.(*defaultTopology)
+		Reset(func() {
+			t.Stop()
+		})
+
+		so1 := NewTupleIncrementalEmitterSource(freshTuples())
+		son1, err := t.AddSource("source1", so1, nil)
+		So(err, ShouldBeNil)
+		so2 := NewTupleIncrementalEmitterSource(freshTuples())
+		son2, err := t.AddSource("source2", so2, nil)
+		So(err, ShouldBeNil)
+
+		b1 := newTerminateChecker(markBox("b1"))
+		bn, err := t.AddBox("box", b1, nil)
+		So(err, ShouldBeNil)
+		So(bn.Input("source1", nil), ShouldBeNil)
+
+		si := NewTupleCollectorSink()
+		sin, err := t.AddSink("sink", si, nil)
+		So(err, ShouldBeNil)
+		So(sin.Input("box", nil), ShouldBeNil)
+
+		so1.EmitTuples(4)
+		si.Wait(4)
+		b2 := newTerminateChecker(markBox("b2"))
+
+		Convey("When replacing the box with the same input", func() {
+			n, err := t.ReplaceBox("box", b2, nil, func(bn BoxNode) error {
+				return bn.Input("source1", nil)
+			})
+			So(err, ShouldBeNil)
+			so1.EmitTuples(4)
+			si.Wait(8)
+
+			Convey("Then the node should be the same", func() {
+				So(n, ShouldPointTo, bn)
+				So(n.Box(), ShouldPointTo, b2)
+			})
+
+			Convey("Then the old box should be terminated", func() {
+				So(b1.terminateCnt, ShouldEqual, 1)
+				So(b2.terminateCnt, ShouldEqual, 0)
+			})
+
+			Convey("Then the sink should receive all tuples in order", func() {
+				for i := 0; i < 8; i++ {
+					tu := si.get(i)
+					So(tu.Data["seq"], ShouldEqual, data.Int(i+1))
+					if i < 4 {
+						So(tu.Data["box"], ShouldEqual, data.String("b1"))
+					} else {
+						So(tu.Data["box"], ShouldEqual, data.String("b2"))
+					}
+				}
+			})
+		})
+
+		Convey("When replacing the box with a different input", func() {
+			_, err := t.ReplaceBox("box", b2, nil, func(bn BoxNode) error {
+				return bn.Input("source2", nil)
+			})
+			So(err, ShouldBeNil)
+
+			Convey("Then the box should be disconnected from the old input", func() {
+				So(son1.(*defaultSourceNode).dsts.len(), ShouldEqual, 0)
+				So(son2.(*defaultSourceNode).dsts.len(), ShouldEqual, 1)
+			})
+
+			Convey("Then the new box should receive tuples from the new input", func() {
+				so2.EmitTuples(2)
+				si.Wait(6)
+				So(si.get(4).Data["box"], ShouldEqual, data.String("b2"))
+				So(si.get(5).Data["seq"], ShouldEqual, data.Int(2))
+			})
+		})
+
+		Convey("When replacing the box with a different input configuration", func() {
+			_, err := t.ReplaceBox("box", b2, nil, func(bn BoxNode) error {
+				return bn.Input("source1", &BoxInputConfig{
+					Capacity: 10,
+				})
+			})
+			So(err, ShouldBeNil)
+
+			Convey("Then the input should be reconfigured", func() {
+				st := bn.Status()
+				q, err := st.Get(data.MustCompilePath("input_stats.inputs.source1.queue_size"))
+				So(err, ShouldBeNil)
+				So(q, ShouldEqual, data.Int(10))
+			})
+
+			Convey("Then the new box should receive tuples", func() {
+				so1.EmitTuples(2)
+				si.Wait(6)
+				So(si.get(5).Data["box"], ShouldEqual, data.String("b2"))
+			})
+		})
+
+		Convey("When replacing the box with a different input name while tuples are queued", func() {
+			release := make(chan struct{})
+			processing := make(chan struct{}, 10)
+			b3 := BoxFunc(func(ctx *Context, t *Tuple, w Writer) error {
+				processing <- struct{}{}
+				<-release
+				return w.Write(ctx, t)
+			})
+			bn, err := t.AddBox("box3", b3, nil)
+			So(err, ShouldBeNil)
+			So(bn.Input("source2", &BoxInputConfig{InputName: "a"}), ShouldBeNil)
+			so2.EmitTuples(3)
+			<-processing
+
+			names := make(chan string, 10)
+			b4 := BoxFunc(func(ctx *Context, t *Tuple, w Writer) error {
+				names <- t.InputName
+				if t.InputName != "b" {
+					return fmt.Errorf("unexpected input name: %v", t.InputName)
+				}
+				return w.Write(ctx, t)
+			})
+			setUp := make(chan struct{})
+			ch := make(chan error, 1)
+			go func() {
+				_, err := t.ReplaceBox("box3", b4, nil, func(bn BoxNode) error {
+					defer close(setUp)
+					return bn.Input("source2", &BoxInputConfig{InputName: "b"})
+				})
+				ch <- err
+			}()
+			// the old box is still processing the first tuple, so the rest
+			// of them stay queued until the replacement has been set up
+			<-setUp
+			close(release)
+			So(<-ch, ShouldBeNil)
+
+			Convey("Then the new box should only receive tuples having the new input name", func() {
+				so2.EmitTuples(2)
+				So(<-names, ShouldEqual, "b")
+				So(<-names, ShouldEqual, "b")
+				So(bn.Status()["input_stats"].(data.Map)["num_errors"], ShouldEqual, data.Int(0))
+			})
+		})
+
+		Convey("When replacing the box with an error policy", func() {
+			dlq := NewDeadLetterSource()
+			_, err := t.AddSource("dlq", dlq, nil)
+			So(err, ShouldBeNil)
+			dsi := NewTupleCollectorSink()
+			dsin, err := t.AddSink("dead_letters", dsi, nil)
+			So(err, ShouldBeNil)
+			So(dsin.Input("dlq", nil), ShouldBeNil)
+
+			failing := BoxFunc(func(ctx *Context, t *Tuple, w Writer) error {
+				return errors.New("failure")
+			})
+			_, err = t.ReplaceBox("box", failing, &BoxReplacementConfig{
+				ErrorPolicy: &ErrorPolicy{DeadLetter: dlq},
+			}, func(bn BoxNode) error {
+				return bn.Input("source1", nil)
+			})
+			So(err, ShouldBeNil)
+			so1.EmitTuples(2)
+
+			Convey("Then the new policy should be applied to the failed tuples", func() {
+				dsi.Wait(2)
+				So(dsi.get(0).Data["data"].(data.Map)["seq"], ShouldEqual, data.Int(5))
+				So(dsi.get(1).Data["data"].(data.Map)["seq"], ShouldEqual, data.Int(6))
+			})
+		})
+
+		Convey("When setting up the new box fails", func() {
+			_, err := t.ReplaceBox("box", b2, nil, func(bn BoxNode) error {
+				if err := bn.Input("source2", nil); err != nil {
+					return err
+				}
+				return errors.New("failure")
+			})
+			So(err, ShouldNotBeNil)
+
+			Convey("Then the new box should be terminated", func() {
+				So(b1.terminateCnt, ShouldEqual, 0)
+				So(b2.terminateCnt, ShouldEqual, 1)
+				So(bn.Box(), ShouldPointTo, b1)
+			})
+
+			Convey("Then the old box should keep processing tuples", func() {
+				so1.EmitTuples(2)
+				si.Wait(6)
+				So(si.get(5).Data["box"], ShouldEqual, data.String("b1"))
+			})
+		})
+
+		Convey("When adding the same input twice while replacing the box", func() {
+			_, err := t.ReplaceBox("box", b2, nil, func(bn BoxNode) error {
+				So(bn.Input("source1", nil), ShouldBeNil)
+				return bn.Input("source1", nil)
+			})
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+				So(bn.Box(), ShouldPointTo, b1)
+			})
+		})
+
+		Convey("When replacing a box which doesn't exist", func() {
+			_, err := t.ReplaceBox("no_such_box", b2, nil, func(bn BoxNode) error {
+				return nil
+			})
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+				So(IsNotExist(err), ShouldBeTrue)
+			})
+		})
+	})
+}
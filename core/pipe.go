@@ -6,6 +6,7 @@ import (
 	"reflect"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"gopkg.in/sensorbee/sensorbee.v0/data"
 )
@@ -117,6 +118,8 @@ func (s *pipeSender) write(ctx *Context, in *Tuple, droppedTuple func(*Tuple)) e
 	}
 	t.InputName = s.inputName
 
+	// The reference has to be acquired before the receiver could release it.
+	t.ack.acquire()
 	if s.dropMode == DropNone {
 		s.out <- t
 	} else {
@@ -127,6 +130,7 @@ func (s *pipeSender) write(ctx *Context, in *Tuple, droppedTuple func(*Tuple)) e
 				break sendLoop
 			default:
 				if s.dropMode == DropLatest {
+					t.ack.release(false)
 					droppedTuple(t)
 					return nil
 				}
@@ -135,6 +139,7 @@ func (s *pipeSender) write(ctx *Context, in *Tuple, droppedTuple func(*Tuple)) e
 				// again in the next iteration. This loop can cause starvation.
 				select {
 				case dropped := <-s.out:
+					dropped.ack.release(false)
 					droppedTuple(dropped)
 				default: // Another thread may drop it before this thread does.
 				}
@@ -185,6 +190,27 @@ func (s *pipeSender) registered(name string, dst *dataDestinations) {
 	}{name, dst})
 }
 
+// unregistered removes the given destination from the sender. It is called
+// when the sender is replaced with another one in the destination so that
+// closing the sender doesn't remove the new one.
+func (s *pipeSender) unregistered(dst *dataDestinations) {
+	s.rwm.Lock()
+	defer s.rwm.Unlock()
+	for i, d := range s.registeredDsts {
+		if d.dst == dst {
+			s.registeredDsts = append(s.registeredDsts[:i], s.registeredDsts[i+1:]...)
+			return
+		}
+	}
+}
+
+// hasConfig returns true when the sender writes tuples with the same input
+// name, capacity, and drop mode as the given configuration.
+func (s *pipeSender) hasConfig(config *BoxInputConfig) bool {
+	return s.inputName == config.inputName() && cap(s.out) == config.capacity() &&
+		s.dropMode == config.DropMode
+}
+
 func (s *pipeSender) count() int64 {
 	return atomic.LoadInt64(&s.cnt)
 }
@@ -219,6 +245,13 @@ type dataSources struct {
 	nodeType NodeType
 	nodeName string
 
+	// errorPolicy is the policy applied to tuples which the node failed to
+	// process. It can be nil. It has to be accessed through policy and
+	// setErrorPolicy once pour is called. It's protected by policyM rather
+	// than m because m is held while pouring threads receive messages.
+	policyM     sync.RWMutex
+	errorPolicy *ErrorPolicy
+
 	// m protects state, recvs, and msgChs.
 	m     sync.RWMutex
 	state *topologyStateHolder
@@ -228,6 +261,11 @@ type dataSources struct {
 	// msgChs is a slice of channels which are connected to goroutines
 	// pouring tuples. They receive controlling messages through this channel.
 	msgChs []chan<- *dataSourcesMessage
+
+	// stopCh is closed when stop is called. Goroutines pouring tuples wait
+	// on it while backing off before retrying a tuple so that they don't
+	// block stop until the retry interval elapses.
+	stopCh chan struct{}
 }
 
 func newDataSources(nodeType NodeType, nodeName string) *dataSources {
@@ -235,6 +273,7 @@ func newDataSources(nodeType NodeType, nodeName string) *dataSources {
 		nodeType: nodeType,
 		nodeName: nodeName,
 		recvs:    map[string]*pipeReceiver{},
+		stopCh:   make(chan struct{}),
 	}
 	s.state = newTopologyStateHolder(&s.m)
 	return s
@@ -320,6 +359,32 @@ func (s *dataSources) remove(name string) {
 	r.close() // This eventually closes the channel.
 }
 
+// receivers returns a copy of the map containing all pipe receivers.
+func (s *dataSources) receivers() map[string]*pipeReceiver {
+	s.m.RLock()
+	defer s.m.RUnlock()
+	m := make(map[string]*pipeReceiver, len(s.recvs))
+	for name, r := range s.recvs {
+		m[name] = r
+	}
+	return m
+}
+
+// policy returns the current error policy.
+func (s *dataSources) policy() *ErrorPolicy {
+	s.policyM.RLock()
+	defer s.policyM.RUnlock()
+	return s.errorPolicy
+}
+
+// setErrorPolicy replaces the error policy. It can be called while the data
+// sources are pouring tuples.
+func (s *dataSources) setErrorPolicy(p *ErrorPolicy) {
+	s.policyM.Lock()
+	defer s.policyM.Unlock()
+	s.errorPolicy = p
+}
+
 // pour pours out tuples for the target Writer. The target must directly be
 // connected to a Box or a Sink.
 func (s *dataSources) pour(ctx *Context, w Writer, parallelism int) error {
@@ -515,8 +580,38 @@ func (s *dataSources) pouringThread(ctx *Context, w Writer, cs []reflect.SelectC
 	gracefulStopEnabled := false
 	stopOnDisconnect := false
 
-	reportDT := func(t *Tuple, err error) {
+	// handleError retries writing the tuple failed with the error according
+	// to the error policy. It returns true when the tuple has finally been
+	// written or sent to the dead letter queue, and the error of the last
+	// attempt. When the tuple still fails, it's written to the dead
+	// letter queue or reported as a dropped tuple. Retrying is given up
+	// when stop is called during the backoff.
+	handleError := func(t *Tuple, err error) (bool, error) {
+		retryCount := 0
+		p := s.policy()
+		if p != nil {
+		retryLoop:
+			for ; retryCount < p.MaxRetries && IsTemporaryError(err) && !IsFatalError(err); retryCount++ {
+				if d := p.retryInterval(retryCount + 1); d > 0 {
+					timer := time.NewTimer(d)
+					select {
+					case <-timer.C:
+					case <-s.stopCh:
+						timer.Stop()
+						break retryLoop
+					}
+				}
+				if err = w.Write(ctx, t); err == nil {
+					return true, nil
+				}
+				atomic.AddInt64(&s.numErrors, 1)
+			}
+		}
+		if p.writeDeadLetter(ctx, t, s.nodeType, s.nodeName, retryCount, err) {
+			return true, err
+		}
 		ctx.droppedTuple(t, s.nodeType, s.nodeName, ETInput, err)
+		return false, err
 	}
 
 receiveLoop:
@@ -592,24 +687,21 @@ receiveLoop:
 
 			err := w.Write(ctx, t)
 			if err == nil {
+				t.ack.release(true)
 				break
 			}
 
 			atomic.AddInt64(&s.numErrors, 1)
-			switch {
-			case IsFatalError(err):
+
+			// A temporary error is retried when the error policy allows it.
+			// Other tuples are skipped. A tuple written to the dead letter
+			// queue is considered to be processed.
+			handled, err := handleError(t, err)
+			t.ack.release(handled)
+			if IsFatalError(err) {
 				// logging is done by pour method
 				retErr = err
-				reportDT(t, err)
 				return
-
-			case IsTemporaryError(err):
-				// TODO: retry
-				reportDT(t, err) // TODO: don't write a tuple until retry fails
-
-			default:
-				// Skip this tuple
-				reportDT(t, err)
 			}
 		}
 	}
@@ -650,6 +742,9 @@ func (s *dataSources) stop(ctx *Context) {
 	}
 	s.recvs = nil
 
+	// stopCh must be closed before sending the message because a goroutine
+	// waiting to retry a tuple doesn't receive it until the wait ends.
+	close(s.stopCh)
 	s.sendMessageWithoutLock(&dataSourcesMessage{
 		cmd: ddscStop,
 	})
@@ -778,6 +873,29 @@ func (d *dataDestinations) remove(name string) {
 	}
 }
 
+// replace replaces the sender having the name with the new one and closes
+// the old sender. Because it's done while holding the lock, every tuple is
+// written to exactly one of the senders.
+func (d *dataDestinations) replace(name string, s *pipeSender) error {
+	d.rwm.Lock()
+	defer d.rwm.Unlock()
+	if d.dsts == nil {
+		return fmt.Errorf("node '%v' already closed its output", d.nodeName)
+	}
+
+	old, ok := d.dsts[name]
+	if !ok {
+		return fmt.Errorf("node '%v' doesn't have the destination '%v'", d.nodeName, name)
+	}
+	d.dsts[name] = s
+	s.registered(name, d)
+
+	// The old sender must not remove the new one when it's closed.
+	old.unregistered(d)
+	old.close()
+	return nil
+}
+
 func (d *dataDestinations) len() int {
 	d.rwm.RLock()
 	defer d.rwm.RUnlock()
@@ -815,6 +933,7 @@ func (d *dataDestinations) Write(ctx *Context, t *Tuple) error {
 
 	if len(d.dsts) == 0 {
 		atomic.AddInt64(&d.numDropped, 1)
+		t.ack.fail()
 		if ctx.Flags.DestinationlessTupleLog.Enabled() {
 			ctx.droppedTuple(t, d.nodeType, d.nodeName, ETOutput, errors.New("no output destination is connected"))
 		}
@@ -846,6 +965,10 @@ func (d *dataDestinations) Write(ctx *Context, t *Tuple) error {
 			closed = append(closed, name)
 		}
 	}
+	if len(closed) == len(d.dsts) {
+		// the tuple wasn't written to any destination
+		t.ack.fail()
+	}
 
 	if closed != nil {
 		shouldUnlock = false
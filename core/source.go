@@ -102,6 +102,7 @@ var (
 // if the given source implements them:
 //
 //	* Statuser
+//	* AckableSource
 //
 // Known issue: There's one problem with NewRewindableSource. Stop method could
 // block when the original source's GenerateStream doesn't generate any tuple
@@ -109,7 +110,11 @@ var (
 // whether the source is stopped is only determined by the error returned from
 // Write.
 func NewRewindableSource(s Source) RewindableSource {
-	return newRewindableSource(s, true)
+	r := newRewindableSource(s, true)
+	if _, ok := s.(AckableSource); ok {
+		return &ackableRewindableSource{r}
+	}
+	return r
 }
 
 func newRewindableSource(s Source, rewindEnabled bool) *rewindableSource {
@@ -296,9 +301,13 @@ func (r *rewindableSource) Status() data.Map {
 // RewindableSource interface.
 func ImplementSourceStop(s Source) Source {
 	// This is implemented as a rewindableSource with rewind disabled.
-	return &nonRewindableSourceAdapter{
+	n := &nonRewindableSourceAdapter{
 		rewindableSource: newRewindableSource(s, false),
 	}
+	if _, ok := s.(AckableSource); ok {
+		return &ackableNonRewindableSourceAdapter{n}
+	}
+	return n
 }
 
 // nonRewindableSourceAdapter wraps rewindableSource but doesn't provide
@@ -312,3 +321,23 @@ func (n *nonRewindableSourceAdapter) Rewind() {
 	// defined in rewindableSource so that the source returned from
 	// ImplementSourceStop becomes incompatible with RewindableSource interface.
 }
+
+// ackableRewindableSource is a rewindableSource passing acknowledgements to
+// the original source implementing AckableSource.
+type ackableRewindableSource struct {
+	*rewindableSource
+}
+
+func (a *ackableRewindableSource) Ack(ctx *Context, batchID int64) error {
+	return a.source.(AckableSource).Ack(ctx, batchID)
+}
+
+// ackableNonRewindableSourceAdapter is a nonRewindableSourceAdapter passing
+// acknowledgements to the original source implementing AckableSource.
+type ackableNonRewindableSourceAdapter struct {
+	*nonRewindableSourceAdapter
+}
+
+func (a *ackableNonRewindableSourceAdapter) Ack(ctx *Context, batchID int64) error {
+	return a.source.(AckableSource).Ack(ctx, batchID)
+}
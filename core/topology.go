@@ -29,6 +29,21 @@ type Topology interface {
 	// registration of the same instance of a Box.
 	AddBox(name string, b Box, config *BoxConfig) (BoxNode, error)
 
+	// ReplaceBox replaces the Box of the box node having the name with b
+	// without disconnecting the node from its destinations. b is initialized
+	// first and setUp is called with the box node so that the caller can add
+	// inputs of the new Box through BoxNode.Input. Inputs from nodes which
+	// are already connected to the box node are kept as they are unless their
+	// configurations change. Other inputs are disconnected after the
+	// replacement. When setUp returns an error, inputs added in setUp are
+	// disconnected and the node keeps running with the original Box.
+	//
+	// All tuples are processed by either the original Box or the new Box
+	// and no tuple is lost during the replacement. The original Box is
+	// terminated after the replacement. setUp must not stop the box node.
+	// config can be nil.
+	ReplaceBox(name string, b Box, config *BoxReplacementConfig, setUp func(BoxNode) error) (BoxNode, error)
+
 	// AddSink adds a Sink to the topology. It returns SinkNode and the
 	// caller can configure inputs or other settings of the Sink node through it.
 	AddSink(name string, s Sink, config *SinkConfig) (SinkNode, error)
@@ -111,18 +126,34 @@ type BoxConfig struct {
 	// If it is true, the box is removed.
 	RemoveOnStop bool
 
+	// ErrorPolicy controls how the box handles tuples which it failed to
+	// process. When it's nil, those tuples are dropped without retries.
+	ErrorPolicy *ErrorPolicy
+
 	// Meta contains meta information of the box. This field won't be used
 	// by core package and application can store any form of information
 	// related to the box.
 	Meta interface{}
 }
 
+// BoxReplacementConfig has configuration parameters of Topology.ReplaceBox.
+type BoxReplacementConfig struct {
+	// ErrorPolicy replaces the error policy of the box node when the new Box
+	// starts to process tuples. When it's nil, the box node keeps the
+	// current policy.
+	ErrorPolicy *ErrorPolicy
+}
+
 // SinkConfig has configuration parameters of a Sink node.
 type SinkConfig struct {
 	// RemoveOnStop is a flag which indicates the stop state of the topology.
 	// If it is true, the sink is removed.
 	RemoveOnStop bool
 
+	// ErrorPolicy controls how the sink handles tuples which it failed to
+	// write. When it's nil, those tuples are dropped without retries.
+	ErrorPolicy *ErrorPolicy
+
 	// Meta contains meta information of the sink. This field won't be used
 	// by core package and application can store any form of information
 	// related to the sink.
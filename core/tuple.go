@@ -30,7 +30,9 @@ type Tuple struct {
 	// Tuple.
 	ProcTimestamp time.Time
 
-	// BatchID is reserved for future use.
+	// BatchID is an offset of the tuple assigned by the AckableSource that
+	// emitted it. Tuples derived from the tuple by ShallowCopy or Copy
+	// have the same BatchID. See AckableSource for details.
 	BatchID int64
 
 	// Flags has bit flags which controls behavior of this tuple. When a Box
@@ -42,6 +44,11 @@ type Tuple struct {
 	// Trace is used during debugging to trace to way of a Tuple through
 	// a topology. See the documentation for TraceEvent.
 	Trace []TraceEvent
+
+	// ack is the entry tracking the tuple emitted by an AckableSource
+	// which this tuple was derived from. It's nil when the tuple isn't
+	// tracked.
+	ack *ackEntry
 }
 
 // AddEvent adds a TraceEvent to this Tuple's trace. This is not
@@ -132,6 +139,11 @@ const (
 	//	(false, true): a tuple returned from ShallowCopy
 	//	(false, false): a tuple returned from NewTuple or Copy
 	TFSharedData
+
+	// TFDeadLetter is a flag which is set when a tuple is written to a dead
+	// letter queue. Once this flag is set to a tuple, the tuple will not be
+	// written to a dead letter queue again. See ErrorPolicy for details.
+	TFDeadLetter
 )
 
 // Set sets a set of flags at once.
@@ -44,6 +44,14 @@ func mustToBool(v data.Value) bool {
 	return b
 }
 
+func mustToFloat(v data.Value) float64 {
+	f, err := data.ToFloat(v)
+	if err != nil {
+		panic(err)
+	}
+	return f
+}
+
 func validate(schema *gojsonschema.Schema, m data.Map) error {
 	// GoLoader marshal and unmarshal the map.
 	res, err := schema.Validate(gojsonschema.NewGoLoader(m))
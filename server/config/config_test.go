@@ -62,7 +62,8 @@ func TestConfigToMap(t *testing.T) {
 					BQLFile: "t1.bql",
 				},
 				"t2": &Topology{
-					BQLFile: "t2.bql",
+					BQLFile:            "t2.bql",
+					CheckpointInterval: 30,
 				},
 			},
 			Storage: &Storage{
@@ -90,10 +91,12 @@ func TestConfigToMap(t *testing.T) {
 					},
 					"topologies": data.Map{
 						"t1": data.Map{
-							"bql_file": data.String("t1.bql"),
+							"bql_file":            data.String("t1.bql"),
+							"checkpoint_interval": data.Float(0),
 						},
 						"t2": data.Map{
-							"bql_file": data.String("t2.bql"),
+							"bql_file":            data.String("t2.bql"),
+							"checkpoint_interval": data.Float(30),
 						},
 					},
 					"storage": data.Map{
@@ -9,14 +9,16 @@ import (
 func TestTopologies(t *testing.T) {
 	Convey("Given a JSON config for logging section", t, func() {
 		Convey("When the config is valid", func() {
-			ts, err := NewTopologies(toMap(`{"test1":{},"test2":{"bql_file":"/path/to/hoge.bql"},"test3":null}`))
+			ts, err := NewTopologies(toMap(`{"test1":{},"test2":{"bql_file":"/path/to/hoge.bql","checkpoint_interval":60},"test3":null}`))
 			So(err, ShouldBeNil)
 
 			Convey("Then it should have given parameters", func() {
 				So(ts["test1"].Name, ShouldEqual, "test1")
 				So(ts["test1"].BQLFile, ShouldEqual, "")
+				So(ts["test1"].CheckpointInterval, ShouldEqual, 0)
 				So(ts["test2"].Name, ShouldEqual, "test2")
 				So(ts["test2"].BQLFile, ShouldEqual, "/path/to/hoge.bql")
+				So(ts["test2"].CheckpointInterval, ShouldEqual, 60)
 				So(ts["test3"].Name, ShouldEqual, "test3")
 				So(ts["test3"].BQLFile, ShouldEqual, "")
 			})
@@ -40,6 +42,22 @@ func TestTopologies(t *testing.T) {
 			})
 		})
 
+		Convey("When validating checkpoint_interval", func() {
+			for _, c := range []string{"0", "0.5", "300"} {
+				Convey(fmt.Sprint("Then it should accept ", c), func() {
+					_, err := NewTopologies(toMap(fmt.Sprintf(`{"test":{"checkpoint_interval":%v}}`, c)))
+					So(err, ShouldBeNil)
+				})
+			}
+
+			for _, c := range []string{"-1", `"1s"`} {
+				Convey(fmt.Sprint("Then it should reject ", c), func() {
+					_, err := NewTopologies(toMap(fmt.Sprintf(`{"test":{"checkpoint_interval":%v}}`, c)))
+					So(err, ShouldNotBeNil)
+				})
+			}
+		})
+
 		Convey("When validating bql_file", func() {
 			for _, b := range []string{"a", "test.bql", "/path/to/hoge.bql"} {
 				Convey(fmt.Sprint("Then it should accept ", b), func() {
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"time"
 
 	"github.com/Sirupsen/logrus"
 	"github.com/gocraft/web"
@@ -102,6 +103,10 @@ func SetUpContextAndRouter(prefix string, jascoRoot *web.Router, gvariables *Con
 		return nil, err
 	}
 
+	// http sources can have any path, so requests to them are handled before
+	// routing.
+	jascoRoot.Middleware(ingestHTTPSource)
+
 	router := jascoRoot.Subrouter(Context{}, "/")
 	router.Middleware(func(c *Context, rw web.ResponseWriter, req *web.Request, next web.NextMiddlewareFunc) {
 		c.logger = gvars.Logger
@@ -191,6 +196,7 @@ func setUpTopology(logger *logrus.Logger, name string, conf *config.Config, us u
 		return nil, err
 	}
 	tb.UDSStorage = us
+	tb.CheckpointInterval = time.Duration(conf.Topologies[name].CheckpointInterval * float64(time.Second))
 
 	bqlFilePath := conf.Topologies[name].BQLFile
 	if bqlFilePath == "" {
@@ -25,4 +25,14 @@ const (
 	// nonWebSocketRequestErrorCode is returned when a requested action only
 	// supports WebSocket and a request is a regular HTTP request.
 	nonWebSocketRequestErrorCode = "E0008"
+
+	// httpSourceInvalidBodyErrorCode is returned when an http source cannot
+	// parse a request body. When the body has a broken record, Error.Meta
+	// has an error message in Meta["error"].
+	httpSourceInvalidBodyErrorCode = "E0009"
+
+	// httpSourceUnavailableErrorCode is returned when an http source cannot
+	// accept records because it's busy or stopped. The client can retry the
+	// request later.
+	httpSourceUnavailableErrorCode = "E0010"
 )
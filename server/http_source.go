@@ -0,0 +1,383 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+	"sync"
+
+	"github.com/gocraft/web"
+	"gopkg.in/pfnet/jasco.v1"
+	"gopkg.in/sensorbee/sensorbee.v0/bql"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+const (
+	// statusTooManyRequests is same as http.StatusTooManyRequests, which
+	// isn't available before Go 1.6.
+	statusTooManyRequests = 429
+)
+
+// httpSource is a source receiving records posted to its path on the API
+// server. Records in a request are queued at once and GenerateStream writes
+// them as tuples. When the queue doesn't have enough room for a request
+// because tuples are written slower than they're posted, the request is
+// rejected with 429 Too Many Requests. Requests are still queued while the
+// source is paused, so they're rejected once the queue gets full. A request
+// is rejected with 503 Service Unavailable after the source is stopped.
+// Tuples remaining in the queue when the source is stopped are discarded.
+type httpSource struct {
+	ioParams    *bql.IOParams
+	path        string
+	maxBodySize int64
+	queue       chan *core.Tuple
+
+	// m serializes sending tuples to the queue.
+	m      sync.Mutex
+	stopCh chan struct{}
+}
+
+func (s *httpSource) GenerateStream(ctx *core.Context, w core.Writer) error {
+	// The path is registered here because Stop, which unregisters it, isn't
+	// called when GenerateStream isn't called.
+	if err := globalHTTPSourceRegistry.register(s); err != nil {
+		return err
+	}
+	defer globalHTTPSourceRegistry.unregister(s)
+
+	for {
+		select {
+		case <-s.stopCh:
+			return nil
+		case t := <-s.queue:
+			if err := w.Write(ctx, t); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *httpSource) Stop(ctx *core.Context) error {
+	close(s.stopCh)
+	return nil
+}
+
+func (s *httpSource) Status() data.Map {
+	return data.Map{
+		"path":       data.String(s.path),
+		"queue_size": data.Int(cap(s.queue)),
+		"num_queued": data.Int(len(s.queue)),
+	}
+}
+
+// ingest queues tuples created from records in the request body. It returns
+// the number of queued tuples. Either all records in the body are queued or
+// none of them is queued.
+func (s *httpSource) ingest(rw http.ResponseWriter, req *http.Request) (int, *jasco.Error) {
+	ts, e := s.parseBody(req)
+	if e != nil {
+		return 0, e
+	}
+	if len(ts) > cap(s.queue) {
+		return 0, jasco.NewError(httpSourceInvalidBodyErrorCode, "The request has too many records",
+			http.StatusRequestEntityTooLarge, nil)
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+	select {
+	case <-s.stopCh:
+		return 0, jasco.NewError(httpSourceUnavailableErrorCode, "The source is stopped",
+			http.StatusServiceUnavailable, nil)
+	default:
+	}
+	if cap(s.queue)-len(s.queue) < len(ts) {
+		rw.Header().Set("Retry-After", "1")
+		return 0, jasco.NewError(httpSourceUnavailableErrorCode, "The source is busy",
+			statusTooManyRequests, nil)
+	}
+
+	// Sending tuples doesn't block because the queue has enough room and
+	// only this method, which holds the lock, sends tuples to the queue.
+	for _, t := range ts {
+		s.queue <- t
+	}
+	return len(ts), nil
+}
+
+// parseBody creates tuples from the request body. The format of the body is
+// decided by its Content-Type:
+//
+//	- application/json: an object or an array of objects
+//	- application/x-ndjson, application/x-jsonlines, application/jsonl:
+//	  JSON Lines having an object in each line
+//	- application/x-www-form-urlencoded, multipart/form-data: a form which
+//	  is converted into one record
+func (s *httpSource) parseBody(req *http.Request) ([]*core.Tuple, *jasco.Error) {
+	mt, _, err := mime.ParseMediaType(req.Header.Get("Content-Type"))
+	if err != nil {
+		return nil, jasco.NewError(httpSourceInvalidBodyErrorCode, "The request doesn't have a valid Content-Type",
+			http.StatusUnsupportedMediaType, err)
+	}
+
+	b, err := ioutil.ReadAll(io.LimitReader(req.Body, s.maxBodySize+1))
+	if err != nil {
+		return nil, jasco.NewError(httpSourceInvalidBodyErrorCode, "Cannot read the request body",
+			http.StatusBadRequest, err)
+	}
+	if int64(len(b)) > s.maxBodySize {
+		return nil, jasco.NewError(httpSourceInvalidBodyErrorCode, "The request body is too large",
+			http.StatusRequestEntityTooLarge, nil)
+	}
+
+	var ms []data.Map
+	switch mt {
+	case "application/json":
+		ms, err = parseJSONRecords(b)
+	case "application/x-ndjson", "application/x-jsonlines", "application/jsonl":
+		ms, err = parseJSONLRecords(b)
+	case "application/x-www-form-urlencoded", "multipart/form-data":
+		req.Body = ioutil.NopCloser(bytes.NewReader(b))
+		ms, err = parseFormRecord(req, s.maxBodySize)
+	default:
+		return nil, jasco.NewError(httpSourceInvalidBodyErrorCode, fmt.Sprintf("Content-Type '%v' is not supported", mt),
+			http.StatusUnsupportedMediaType, nil)
+	}
+	if err != nil {
+		e := jasco.NewError(httpSourceInvalidBodyErrorCode, "Cannot parse the request body",
+			http.StatusBadRequest, err)
+		e.Meta["error"] = err.Error()
+		return nil, e
+	}
+
+	ts := make([]*core.Tuple, len(ms))
+	for i, m := range ms {
+		ts[i] = core.NewTuple(m)
+	}
+	return ts, nil
+}
+
+func parseJSONRecords(b []byte) ([]data.Map, error) {
+	b = bytes.TrimSpace(b)
+	if len(b) > 0 && b[0] == '[' {
+		var ms []data.Map
+		if err := json.Unmarshal(b, &ms); err != nil {
+			return nil, err
+		}
+		for i, m := range ms {
+			if m == nil {
+				return nil, fmt.Errorf("the record at %v is not an object", i)
+			}
+		}
+		return ms, nil
+	}
+
+	var m data.Map
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	if m == nil {
+		return nil, errors.New("the record is not an object")
+	}
+	return []data.Map{m}, nil
+}
+
+func parseJSONLRecords(b []byte) ([]data.Map, error) {
+	var ms []data.Map
+	for i, line := range bytes.Split(b, []byte{'\n'}) {
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+		var m data.Map
+		if err := json.Unmarshal(line, &m); err != nil {
+			return nil, fmt.Errorf("cannot parse line %v: %v", i+1, err)
+		}
+		if m == nil {
+			return nil, fmt.Errorf("line %v is not an object", i+1)
+		}
+		ms = append(ms, m)
+	}
+	return ms, nil
+}
+
+// parseFormRecord converts a form into a record. A field having only one
+// value becomes a string and a field having multiple values becomes an array
+// of strings. Uploaded files are ignored.
+func parseFormRecord(req *http.Request, maxMemory int64) ([]data.Map, error) {
+	if err := req.ParseMultipartForm(maxMemory); err != nil && err != http.ErrNotMultipart {
+		return nil, err
+	}
+
+	m := data.Map{}
+	add := func(values map[string][]string) {
+		for k, vs := range values {
+			if len(vs) == 1 {
+				m[k] = data.String(vs[0])
+				continue
+			}
+			a := make(data.Array, len(vs))
+			for i, v := range vs {
+				a[i] = data.String(v)
+			}
+			m[k] = a
+		}
+	}
+	add(req.PostForm)
+	if req.MultipartForm != nil {
+		add(req.MultipartForm.Value)
+	}
+	if len(m) == 0 {
+		return nil, errors.New("the form doesn't have any field")
+	}
+	return []data.Map{m}, nil
+}
+
+// httpSourceRegistry manages paths of running http sources.
+type httpSourceRegistry struct {
+	m       sync.RWMutex
+	sources map[string]*httpSource
+}
+
+var globalHTTPSourceRegistry = &httpSourceRegistry{
+	sources: map[string]*httpSource{},
+}
+
+func (r *httpSourceRegistry) register(s *httpSource) error {
+	r.m.Lock()
+	defer r.m.Unlock()
+	if _, ok := r.sources[s.path]; ok {
+		return fmt.Errorf("path '%v' is already used by another http source", s.path)
+	}
+	r.sources[s.path] = s
+	return nil
+}
+
+func (r *httpSourceRegistry) unregister(s *httpSource) {
+	r.m.Lock()
+	defer r.m.Unlock()
+	if r.sources[s.path] == s {
+		delete(r.sources, s.path)
+	}
+}
+
+func (r *httpSourceRegistry) lookup(path string) *httpSource {
+	r.m.RLock()
+	defer r.m.RUnlock()
+	return r.sources[path]
+}
+
+// ingestHTTPSource is a middleware of the root router which passes requests
+// to http sources having the requested paths. Because the root router's
+// middleware runs before routing, sources can use paths which aren't
+// registered in the router.
+func ingestHTTPSource(c *jasco.Context, rw web.ResponseWriter, req *web.Request, next web.NextMiddlewareFunc) {
+	s := globalHTTPSourceRegistry.lookup(req.URL.Path)
+	if s == nil {
+		next(rw, req)
+		return
+	}
+	c.AddLogField("node_type", core.NTSource.String())
+	c.AddLogField("node_name", s.ioParams.Name)
+
+	if req.Method != "POST" {
+		rw.Header().Set("Allow", "POST")
+		c.RenderError(jasco.NewError(requestResourceNotFoundErrorCode, "The source only accepts POST requests",
+			http.StatusMethodNotAllowed, nil))
+		return
+	}
+
+	n, e := s.ingest(rw, req.Request)
+	if e != nil {
+		if e.Err != nil {
+			c.ErrLog(e.Err).Error("Cannot accept the request")
+		}
+		c.RenderError(e)
+		return
+	}
+	c.Render(map[string]interface{}{
+		"count": n,
+	})
+}
+
+// createHTTPSource creates a source receiving records posted to the API
+// server. See newHTTPSource for its parameters.
+func createHTTPSource(ctx *core.Context, ioParams *bql.IOParams, params data.Map) (core.Source, error) {
+	s, err := newHTTPSource(ioParams, params)
+	if err != nil {
+		return nil, err
+	}
+	return core.ImplementSourceStop(s), nil
+}
+
+// newHTTPSource creates an httpSource. It has following parameters:
+//
+//	- path: the path of the endpoint, e.g. "/ingest/s"
+//	- queue_size: the maximum number of tuples waiting to be written
+//	  (default: 1024)
+//	- max_body_size: the maximum size of a request body in bytes
+//	  (default: 10MiB)
+func newHTTPSource(ioParams *bql.IOParams, params data.Map) (*httpSource, error) {
+	v, ok := params["path"]
+	if !ok {
+		return nil, errors.New("'path' parameter is missing")
+	}
+	p, err := data.AsString(v)
+	if err != nil {
+		return nil, fmt.Errorf("'path' parameter must be a string: %v", err)
+	}
+	if !strings.HasPrefix(p, "/") || path.Clean(p) != p || p == "/" {
+		return nil, fmt.Errorf("'path' parameter must be a clean absolute path: %v", p)
+	}
+	if p == "/api" || strings.HasPrefix(p, "/api/") {
+		return nil, fmt.Errorf("'path' parameter must not be under /api: %v", p)
+	}
+	if globalHTTPSourceRegistry.lookup(p) != nil {
+		return nil, fmt.Errorf("path '%v' is already used by another http source", p)
+	}
+
+	queueSize := int64(1024)
+	if v, ok := params["queue_size"]; ok {
+		q, err := data.AsInt(v)
+		if err != nil {
+			return nil, fmt.Errorf("'queue_size' parameter must be an integer: %v", err)
+		}
+		if q <= 0 {
+			return nil, errors.New("'queue_size' parameter must be positive")
+		}
+		queueSize = q
+	}
+
+	maxBodySize := int64(10 << 20)
+	if v, ok := params["max_body_size"]; ok {
+		m, err := data.AsInt(v)
+		if err != nil {
+			return nil, fmt.Errorf("'max_body_size' parameter must be an integer: %v", err)
+		}
+		if m <= 0 {
+			return nil, errors.New("'max_body_size' parameter must be positive")
+		}
+		maxBodySize = m
+	}
+
+	s := &httpSource{
+		ioParams:    ioParams,
+		path:        p,
+		maxBodySize: maxBodySize,
+		queue:       make(chan *core.Tuple, queueSize),
+		stopCh:      make(chan struct{}),
+	}
+	return s, nil
+}
+
+func init() {
+	bql.MustRegisterGlobalSourceCreator("http", bql.SourceCreatorFunc(createHTTPSource))
+}
@@ -0,0 +1,304 @@
+package server
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+type httpSourceTestWriter struct {
+	m  sync.Mutex
+	c  *sync.Cond
+	ts []*core.Tuple
+
+	// Write blocks while blocked is true. blocking is true while Write is
+	// blocked.
+	blocked  bool
+	blocking bool
+}
+
+func (w *httpSourceTestWriter) Write(ctx *core.Context, t *core.Tuple) error {
+	w.m.Lock()
+	defer w.m.Unlock()
+	for w.blocked {
+		w.blocking = true
+		w.c.Broadcast()
+		w.c.Wait()
+	}
+	w.blocking = false
+	w.ts = append(w.ts, t)
+	w.c.Broadcast()
+	return nil
+}
+
+func (w *httpSourceTestWriter) waitForBlocking() {
+	w.m.Lock()
+	defer w.m.Unlock()
+	for !w.blocking {
+		w.c.Wait()
+	}
+}
+
+func (w *httpSourceTestWriter) unblock() {
+	w.m.Lock()
+	defer w.m.Unlock()
+	w.blocked = false
+	w.c.Broadcast()
+}
+
+func (w *httpSourceTestWriter) wait(n int) []data.Map {
+	w.m.Lock()
+	defer w.m.Unlock()
+	for len(w.ts) < n {
+		w.c.Wait()
+	}
+	ms := make([]data.Map, len(w.ts))
+	for i, t := range w.ts {
+		ms[i] = t.Data
+	}
+	return ms
+}
+
+func TestHTTPSource(t *testing.T) {
+	ctx := core.NewContext(nil)
+
+	Convey("Given an http source", t, func() {
+		params := data.Map{
+			"path":       data.String("/ingest/test_http_source"),
+			"queue_size": data.Int(3),
+		}
+		s, err := newHTTPSource(&bql.IOParams{Name: "s"}, params)
+		So(err, ShouldBeNil)
+		// src is wrapped in the same way as createHTTPSource does
+		src := core.ImplementSourceStop(s)
+
+		post := func(contentType string, body []byte) (*httptest.ResponseRecorder, int, int) {
+			req, err := http.NewRequest("POST", s.path, bytes.NewReader(body))
+			So(err, ShouldBeNil)
+			req.Header.Set("Content-Type", contentType)
+			rc := httptest.NewRecorder()
+			n, e := s.ingest(rc, req)
+			if e != nil {
+				return rc, n, e.Status
+			}
+			return rc, n, http.StatusOK
+		}
+		queued := func() []data.Map {
+			var ms []data.Map
+			for len(s.queue) > 0 {
+				ms = append(ms, (<-s.queue).Data)
+			}
+			return ms
+		}
+
+		Convey("When posting a JSON object", func() {
+			_, n, status := post("application/json", []byte(`{"a":1}`))
+
+			Convey("Then it should be queued", func() {
+				So(status, ShouldEqual, http.StatusOK)
+				So(n, ShouldEqual, 1)
+				So(queued(), ShouldResemble, []data.Map{{"a": data.Int(1)}})
+			})
+		})
+
+		Convey("When posting a JSON array", func() {
+			_, n, status := post("application/json; charset=utf-8", []byte(`[{"a":1},{"a":2}]`))
+
+			Convey("Then all records should be queued", func() {
+				So(status, ShouldEqual, http.StatusOK)
+				So(n, ShouldEqual, 2)
+				So(queued(), ShouldResemble, []data.Map{{"a": data.Int(1)}, {"a": data.Int(2)}})
+			})
+		})
+
+		Convey("When posting JSON Lines", func() {
+			_, n, status := post("application/x-ndjson", []byte("{\"a\":1}\n\n{\"a\":2}\n"))
+
+			Convey("Then each line should be queued", func() {
+				So(status, ShouldEqual, http.StatusOK)
+				So(n, ShouldEqual, 2)
+				So(queued(), ShouldResemble, []data.Map{{"a": data.Int(1)}, {"a": data.Int(2)}})
+			})
+		})
+
+		Convey("When posting JSON Lines having a broken line", func() {
+			_, _, status := post("application/x-ndjson", []byte("{\"a\":1}\n{\"a\":\n"))
+
+			Convey("Then no record should be queued", func() {
+				So(status, ShouldEqual, http.StatusBadRequest)
+				So(queued(), ShouldBeEmpty)
+			})
+		})
+
+		Convey("When posting a form", func() {
+			_, n, status := post("application/x-www-form-urlencoded", []byte("a=1&b=2&b=3"))
+
+			Convey("Then it should be queued as a record", func() {
+				So(status, ShouldEqual, http.StatusOK)
+				So(n, ShouldEqual, 1)
+				So(queued(), ShouldResemble, []data.Map{{
+					"a": data.String("1"),
+					"b": data.Array{data.String("2"), data.String("3")},
+				}})
+			})
+		})
+
+		Convey("When posting a multipart form", func() {
+			b := bytes.NewBuffer(nil)
+			mw := multipart.NewWriter(b)
+			So(mw.WriteField("a", "1"), ShouldBeNil)
+			So(mw.Close(), ShouldBeNil)
+			_, n, status := post(mw.FormDataContentType(), b.Bytes())
+
+			Convey("Then it should be queued as a record", func() {
+				So(status, ShouldEqual, http.StatusOK)
+				So(n, ShouldEqual, 1)
+				So(queued(), ShouldResemble, []data.Map{{"a": data.String("1")}})
+			})
+		})
+
+		Convey("When posting an unsupported content", func() {
+			_, _, status := post("text/plain", []byte("a"))
+
+			Convey("Then it should be rejected", func() {
+				So(status, ShouldEqual, http.StatusUnsupportedMediaType)
+			})
+		})
+
+		Convey("When posting more records than the queue has room for", func() {
+			_, _, status := post("application/json", []byte(`[{"a":1},{"a":2}]`))
+			So(status, ShouldEqual, http.StatusOK)
+			rc, _, status := post("application/json", []byte(`[{"a":3},{"a":4}]`))
+
+			Convey("Then it should be rejected as too many requests", func() {
+				So(status, ShouldEqual, statusTooManyRequests)
+				So(rc.Header().Get("Retry-After"), ShouldNotBeBlank)
+				So(len(queued()), ShouldEqual, 2)
+			})
+		})
+
+		Convey("When posting more records than the queue size", func() {
+			_, _, status := post("application/json", []byte(`[{"a":1},{"a":2},{"a":3},{"a":4}]`))
+
+			Convey("Then it should be rejected as too large", func() {
+				So(status, ShouldEqual, http.StatusRequestEntityTooLarge)
+			})
+		})
+
+		Convey("When the source is running", func() {
+			w := &httpSourceTestWriter{}
+			w.c = sync.NewCond(&w.m)
+			ch := make(chan error, 1)
+			go func() {
+				ch <- src.GenerateStream(ctx, w)
+			}()
+			stop := func() {
+				So(src.Stop(ctx), ShouldBeNil)
+				So(<-ch, ShouldBeNil)
+			}
+
+			Convey("Then posted records should be written", func() {
+				_, _, status := post("application/json", []byte(`[{"a":1},{"a":2}]`))
+				So(status, ShouldEqual, http.StatusOK)
+				w.wait(2)
+				_, _, status = post("application/json", []byte(`[{"a":3},{"a":4}]`))
+				So(status, ShouldEqual, http.StatusOK)
+				So(w.wait(4), ShouldResemble, []data.Map{
+					{"a": data.Int(1)}, {"a": data.Int(2)}, {"a": data.Int(3)}, {"a": data.Int(4)},
+				})
+				stop()
+			})
+
+			Convey("Then another source having the same path cannot be created", func() {
+				for globalHTTPSourceRegistry.lookup(s.path) == nil {
+					// wait until the source is registered
+					time.Sleep(time.Millisecond)
+				}
+				_, err := createHTTPSource(ctx, &bql.IOParams{Name: "s2"}, params)
+				So(err, ShouldNotBeNil)
+				stop()
+			})
+
+			Convey("Then records posted to the paused source should be written after it's resumed", func() {
+				So(src.(core.Resumable).Pause(ctx), ShouldBeNil)
+				_, _, status := post("application/json", []byte(`{"a":1}`))
+				So(status, ShouldEqual, http.StatusOK)
+				So(src.(core.Resumable).Resume(ctx), ShouldBeNil)
+				So(w.wait(1), ShouldResemble, []data.Map{{"a": data.Int(1)}})
+				stop()
+			})
+
+			Convey("Then Stop should wait until the source stops writing a tuple", func() {
+				w.m.Lock()
+				w.blocked = true
+				w.m.Unlock()
+				_, _, status := post("application/json", []byte(`{"a":1}`))
+				So(status, ShouldEqual, http.StatusOK)
+				w.waitForBlocking()
+				stopped := make(chan error, 1)
+				go func() {
+					stopped <- src.Stop(ctx)
+				}()
+				w.unblock()
+				So(<-stopped, ShouldBeNil)
+				select {
+				case err := <-ch:
+					So(err, ShouldBeNil)
+				default:
+					So("GenerateStream is still running", ShouldBeEmpty)
+				}
+			})
+
+			Convey("Then posting to the stopped source should fail", func() {
+				stop()
+				_, _, status := post("application/json", []byte(`{"a":1}`))
+				So(status, ShouldEqual, http.StatusServiceUnavailable)
+				So(globalHTTPSourceRegistry.lookup(s.path), ShouldBeNil)
+			})
+		})
+	})
+
+	Convey("Given invalid parameters of http source", t, func() {
+		params := data.Map{
+			"path": data.String("/ingest/test_http_source"),
+		}
+		create := func() error {
+			_, err := createHTTPSource(ctx, &bql.IOParams{Name: "s"}, params)
+			return err
+		}
+
+		Convey("Then a missing path should be rejected", func() {
+			delete(params, "path")
+			So(create(), ShouldNotBeNil)
+		})
+
+		Convey("Then a relative path should be rejected", func() {
+			params["path"] = data.String("ingest")
+			So(create(), ShouldNotBeNil)
+		})
+
+		Convey("Then a path under /api should be rejected", func() {
+			params["path"] = data.String("/api/v1/ingest")
+			So(create(), ShouldNotBeNil)
+		})
+
+		Convey("Then a non-positive queue_size should be rejected", func() {
+			params["queue_size"] = data.Int(0)
+			So(create(), ShouldNotBeNil)
+		})
+
+		Convey("Then a non-positive max_body_size should be rejected", func() {
+			params["max_body_size"] = data.Int(-1)
+			So(create(), ShouldNotBeNil)
+		})
+	})
+}
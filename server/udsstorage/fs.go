@@ -91,6 +91,15 @@ func (s *fsUDSStorage) Load(topology, state, tag string) (io.ReadCloser, error)
 	return f, nil
 }
 
+func (s *fsUDSStorage) Remove(topology, state, tag string) error {
+	if tag == "" || strings.ToLower(tag) == "default" {
+		tag = "default"
+	} else if err := core.ValidateSymbol(tag); err != nil {
+		return err
+	}
+	return os.Remove(s.stateFilepath(topology, state, tag))
+}
+
 var (
 	fsUDSStorageFilePathRegexp = regexp.MustCompile(`^(.+)-(.+)-(.+).state$`)
 )